@@ -4,14 +4,14 @@
 package ibm
 
 import (
-	"crypto/tls"
 	"errors"
 	"fmt"
 	"log"
-	"net"
 	gohttp "net/http"
+	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	// Added code for the Power Colo Offering
@@ -52,6 +52,7 @@ import (
 	vpc "github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/apache/openwhisk-client-go/whisk"
 	jwt "github.com/dgrijalva/jwt-go"
+	httptransport "github.com/go-openapi/runtime/client"
 	slsession "github.com/softlayer/softlayer-go/session"
 
 	bluemix "github.com/IBM-Cloud/bluemix-go"
@@ -81,13 +82,13 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/schematics"
 	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/bluemix-go/authentication"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/http"
 	"github.com/IBM-Cloud/bluemix-go/rest"
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
 	ibmpisession "github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 )
 
 // RetryAPIDelay - retry api delay
@@ -162,6 +163,18 @@ type Config struct {
 
 	// Zone
 	Zone string
+
+	// Visibility of the service endpoints: public, private or public-and-private
+	Visibility string
+
+	// Endpoints overrides the service endpoints, keyed by service name
+	Endpoints map[string]string
+
+	// EndpointsFile is the path of a JSON file with service endpoints keyed by region
+	EndpointsFile string
+
+	// Tags are the default and ignored tags of the taggable resources
+	Tags TagsConfig
 }
 
 //Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -171,6 +184,14 @@ type Session struct {
 
 	// BluemixSession is the the Bluemix session used to connect to the Bluemix API
 	BluemixSession *bxsession.Session
+
+	// HTTPClient is the client shared by all the service clients, its transport retries
+	// throttled and failed requests
+	HTTPClient *gohttp.Client
+
+	// KeyProtectTransport shares the connections of HTTPClient without retrying, the
+	// Key Protect clients retry the requests themselves
+	KeyProtectTransport gohttp.RoundTripper
 }
 
 // ClientSession ...
@@ -186,6 +207,7 @@ type ClientSession interface {
 	FunctionClient() (*whisk.Client, error)
 	GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error)
 	GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error)
+	GlobalTaggingAPIv1() (*globaltaggingv1.GlobalTaggingV1, error)
 	ICDAPI() (icdv4.ICDServiceAPI, error)
 	IAMAPI() (iamv1.IAMServiceAPI, error)
 	IAMPAPAPI() (iampapv1.IAMPAPAPI, error)
@@ -240,426 +262,1294 @@ type ClientSession interface {
 	IAMIdentityV1API() (*iamidentity.IamIdentityV1, error)
 	ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error)
 	CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error)
+	TagsConfig() TagsConfig
+	HTTPClient() *gohttp.Client
 }
 
+// clientSession builds each service client on first use. Every client is
+// guarded by its own sync.Once so that the client and the error returned
+// while configuring it are cached for the lifetime of the provider.
 type clientSession struct {
 	session *Session
+	config  *Config
+
+	// endpoints resolves the endpoint of each service
+	endpoints *serviceEndpointResolver
+
+	bluemixSessionErr error
+
+	// IAM authentication of the bluemix session (API key or refresh token)
+	iamAuthOnce sync.Once
+	iamAuthErr  error
+
+	// UAA authentication of the bluemix session, required by Cloud Foundry based services
+	uaaAuthOnce sync.Once
+	uaaAuthErr  error
+
+	// Authenticator shared by the IBM Cloud platform SDK clients
+	authenticatorOnce sync.Once
+	authenticatorErr  error
+	authenticatorAPI  core.Authenticator
 
-	apigatewayErr error
-	apigatewayAPI *apigateway.ApiGatewayControllerApiV1
+	apigatewayOnce sync.Once
+	apigatewayErr  error
+	apigatewayAPI  *apigateway.ApiGatewayControllerApiV1
 
+	accountConfigOnce    sync.Once
 	accountConfigErr     error
 	bmxAccountServiceAPI accountv2.AccountServiceAPI
 
+	accountV1ConfigOnce    sync.Once
 	accountV1ConfigErr     error
 	bmxAccountv1ServiceAPI accountv1.AccountServiceAPI
 
-	bmxUserDetails  *UserConfig
-	bmxUserFetchErr error
+	bmxUserDetailsOnce sync.Once
+	bmxUserDetails     *UserConfig
+	bmxUserFetchErr    error
 
+	csConfigOnce sync.Once
 	csConfigErr  error
 	csServiceAPI containerv1.ContainerServiceAPI
 
+	csv2ConfigOnce sync.Once
 	csv2ConfigErr  error
 	csv2ServiceAPI containerv2.ContainerServiceAPI
 
+	crv1ConfigOnce sync.Once
 	crv1ConfigErr  error
 	crv1ServiceAPI registryv1.RegistryServiceAPI
 
+	stxConfigOnce sync.Once
 	stxConfigErr  error
 	stxServiceAPI schematics.SchematicsServiceAPI
 
-	certManagementErr error
-	certManagementAPI certificatemanager.CertificateManagerServiceAPI
+	certManagementOnce sync.Once
+	certManagementErr  error
+	certManagementAPI  certificatemanager.CertificateManagerServiceAPI
 
+	cfConfigOnce sync.Once
 	cfConfigErr  error
 	cfServiceAPI mccpv2.MccpServiceAPI
 
+	cisConfigOnce sync.Once
 	cisConfigErr  error
 	cisServiceAPI cisv1.CisServiceAPI
 
-	functionConfigErr error
-	functionClient    *whisk.Client
+	functionConfigOnce sync.Once
+	functionConfigErr  error
+	functionClient     *whisk.Client
 
+	globalSearchConfigOnce sync.Once
 	globalSearchConfigErr  error
 	globalSearchServiceAPI globalsearchv2.GlobalSearchServiceAPI
 
+	globalTaggingConfigOnce sync.Once
 	globalTaggingConfigErr  error
 	globalTaggingServiceAPI globaltaggingv3.GlobalTaggingServiceAPI
 
+	// Global Tagging v1 with the tag types
+	globalTaggingV1Once sync.Once
+	globalTaggingV1Err  error
+	globalTaggingV1API  *globaltaggingv1.GlobalTaggingV1
+
+	iamPAPConfigOnce sync.Once
 	iamPAPConfigErr  error
 	iamPAPServiceAPI iampapv1.IAMPAPAPI
 
+	iamPAPConfigOncev2 sync.Once
 	iamPAPConfigErrv2  error
 	iamPAPServiceAPIv2 iampapv2.IAMPAPAPIV2
 
+	iamUUMConfigOnce sync.Once
 	iamUUMConfigErr  error
 	iamUUMServiceAPI iamuumv1.IAMUUMServiceAPI
 
+	iamUUMConfigOnceV2 sync.Once
 	iamUUMConfigErrV2  error
 	iamUUMServiceAPIV2 iamuumv2.IAMUUMServiceAPIv2
 
+	iamConfigOnce sync.Once
 	iamConfigErr  error
 	iamServiceAPI iamv1.IAMServiceAPI
 
-	userManagementErr error
-	userManagementAPI usermanagementv2.UserManagementAPI
+	userManagementOnce sync.Once
+	userManagementErr  error
+	userManagementAPI  usermanagementv2.UserManagementAPI
 
+	icdConfigOnce sync.Once
 	icdConfigErr  error
 	icdServiceAPI icdv4.ICDServiceAPI
 
+	resourceControllerConfigOnce sync.Once
 	resourceControllerConfigErr  error
 	resourceControllerServiceAPI controller.ResourceControllerAPI
 
+	resourceControllerConfigOncev2 sync.Once
 	resourceControllerConfigErrv2  error
 	resourceControllerServiceAPIv2 controllerv2.ResourceControllerAPIV2
 
+	resourceManagementConfigOnce sync.Once
 	resourceManagementConfigErr  error
 	resourceManagementServiceAPI management.ResourceManagementAPI
 
+	resourceManagementConfigOncev2 sync.Once
 	resourceManagementConfigErrv2  error
 	resourceManagementServiceAPIv2 managementv2.ResourceManagementAPIv2
 
+	resourceCatalogConfigOnce sync.Once
 	resourceCatalogConfigErr  error
 	resourceCatalogServiceAPI catalog.ResourceCatalogAPI
 
-	powerConfigErr error
-	ibmpiConfigErr error
-	ibmpiSession   *ibmpisession.IBMPISession
+	powerConfigOnce sync.Once
+	powerConfigErr  error
+	ibmpiSession    *ibmpisession.IBMPISession
 
-	kpErr error
-	kpAPI *kp.API
+	kpOnce sync.Once
+	kpErr  error
+	kpAPI  *kp.API
 
-	kmsErr error
-	kmsAPI *kp.API
+	kmsOnce sync.Once
+	kmsErr  error
+	kmsAPI  *kp.API
 
-	hpcsEndpointErr error
-	hpcsEndpointAPI hpcs.HPCSV2
+	hpcsEndpointOnce sync.Once
+	hpcsEndpointErr  error
+	hpcsEndpointAPI  hpcs.HPCSV2
 
+	pDNSOnce   sync.Once
 	pDNSClient *dns.DnsSvcsV1
 	pDNSErr    error
 
-	bluemixSessionErr error
-
-	vpcClassicErr error
-	vpcClassicAPI *vpcclassic.VpcClassicV1
+	vpcClassicOnce sync.Once
+	vpcClassicErr  error
+	vpcClassicAPI  *vpcclassic.VpcClassicV1
 
-	vpcErr error
-	vpcAPI *vpc.VpcV1
+	vpcOnce sync.Once
+	vpcErr  error
+	vpcAPI  *vpc.VpcV1
 
-	directlinkAPI *dl.DirectLinkV1
-	directlinkErr error
-	dlProviderAPI *dlProviderV2.DirectLinkProviderV2
-	dlProviderErr error
+	directlinkOnce sync.Once
+	directlinkAPI  *dl.DirectLinkV1
+	directlinkErr  error
+	dlProviderOnce sync.Once
+	dlProviderAPI  *dlProviderV2.DirectLinkProviderV2
+	dlProviderErr  error
 
-	cosConfigErr error
-	cosConfigAPI *cosconfig.ResourceConfigurationV1
+	cosConfigOnce sync.Once
+	cosConfigErr  error
+	cosConfigAPI  *cosconfig.ResourceConfigurationV1
 
-	transitgatewayAPI *tg.TransitGatewayApisV1
-	transitgatewayErr error
+	transitgatewayOnce sync.Once
+	transitgatewayAPI  *tg.TransitGatewayApisV1
+	transitgatewayErr  error
 
-	functionIAMNamespaceAPI functions.FunctionServiceAPI
-	functionIAMNamespaceErr error
+	functionIAMNamespaceOnce sync.Once
+	functionIAMNamespaceAPI  functions.FunctionServiceAPI
+	functionIAMNamespaceErr  error
 
 	// CIS Zones
+	cisZonesOnce     sync.Once
 	cisZonesErr      error
 	cisZonesV1Client *ciszonesv1.ZonesV1
 
 	// CIS dns service options
+	cisDNSOnce          sync.Once
 	cisDNSErr           error
 	cisDNSRecordsClient *cisdnsrecordsv1.DnsRecordsV1
 
 	// CIS dns bulk service options
+	cisDNSBulkOnce         sync.Once
 	cisDNSBulkErr          error
 	cisDNSRecordBulkClient *cisdnsbulkv1.DnsRecordBulkV1
 
 	// CIS Global Load Balancer Pool service options
+	cisGLBPoolOnce   sync.Once
 	cisGLBPoolErr    error
 	cisGLBPoolClient *cisglbpoolv0.GlobalLoadBalancerPoolsV0
 
 	// CIS GLB service options
+	cisGLBOnce   sync.Once
 	cisGLBErr    error
 	cisGLBClient *cisglbv1.GlobalLoadBalancerV1
 
 	// CIS GLB health check service options
+	cisGLBHealthCheckOnce   sync.Once
 	cisGLBHealthCheckErr    error
 	cisGLBHealthCheckClient *cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1
 
 	// CIS IP service options
+	cisIPOnce   sync.Once
 	cisIPErr    error
 	cisIPClient *cisipv1.CisIpApiV1
 
 	// CIS Zone Rate Limits service options
+	cisRLOnce   sync.Once
 	cisRLErr    error
 	cisRLClient *cisratelimitv1.ZoneRateLimitsV1
 
 	// CIS Page Rules service options
+	cisPageRuleOnce   sync.Once
 	cisPageRuleErr    error
 	cisPageRuleClient *cispagerulev1.PageRuleApiV1
 
 	// CIS Edge Functions service options
+	cisEdgeFunctionOnce   sync.Once
 	cisEdgeFunctionErr    error
 	cisEdgeFunctionClient *cisedgefunctionv1.EdgeFunctionsApiV1
 
 	// CIS SSL certificate service options
+	cisSSLOnce   sync.Once
 	cisSSLErr    error
 	cisSSLClient *cissslv1.SslCertificateApiV1
 
 	// CIS WAF Package service options
+	cisWAFPackageOnce   sync.Once
 	cisWAFPackageErr    error
 	cisWAFPackageClient *ciswafpackagev1.WafRulePackagesApiV1
 
 	// CIS Zone Setting service options
+	cisDomainSettingsOnce   sync.Once
 	cisDomainSettingsErr    error
 	cisDomainSettingsClient *cisdomainsettingsv1.ZonesSettingsV1
 
 	// CIS Routing service options
+	cisRoutingOnce   sync.Once
 	cisRoutingErr    error
 	cisRoutingClient *cisroutingv1.RoutingV1
 
 	// CIS WAF Group service options
+	cisWAFGroupOnce   sync.Once
 	cisWAFGroupErr    error
 	cisWAFGroupClient *ciswafgroupv1.WafRuleGroupsApiV1
 
 	// CIS Caching service options
+	cisCacheOnce   sync.Once
 	cisCacheErr    error
 	cisCacheClient *ciscachev1.CachingApiV1
 
 	// CIS Custom Pages service options
+	cisCustomPageOnce   sync.Once
 	cisCustomPageErr    error
 	cisCustomPageClient *ciscustompagev1.CustomPagesV1
 
 	// CIS Firewall Access rule service option
+	cisAccessRuleOnce   sync.Once
 	cisAccessRuleErr    error
 	cisAccessRuleClient *cisaccessrulev1.ZoneFirewallAccessRulesV1
 
 	// CIS User Agent Blocking Rule service option
+	cisUARuleOnce   sync.Once
 	cisUARuleErr    error
 	cisUARuleClient *cisuarulev1.UserAgentBlockingRulesV1
 
 	// CIS Firewall Lockdwon Rule service option
+	cisLockdownOnce   sync.Once
 	cisLockdownErr    error
 	cisLockdownClient *cislockdownv1.ZoneLockdownV1
 
 	// CIS Range app service option
+	cisRangeAppOnce   sync.Once
 	cisRangeAppErr    error
 	cisRangeAppClient *cisrangeappv1.RangeApplicationsV1
 
 	// CIS WAF rule service options
+	cisWAFRuleOnce   sync.Once
 	cisWAFRuleErr    error
 	cisWAFRuleClient *ciswafrulev1.WafRulesApiV1
 	//IAM Identity Option
-	iamIdentityErr error
-	iamIdentityAPI *iamidentity.IamIdentityV1
+	iamIdentityOnce sync.Once
+	iamIdentityErr  error
+	iamIdentityAPI  *iamidentity.IamIdentityV1
 
 	//Resource Manager Option
-	resourceManagerErr error
-	resourceManagerAPI *resourcemanager.ResourceManagerV2
+	resourceManagerOnce sync.Once
+	resourceManagerErr  error
+	resourceManagerAPI  *resourcemanager.ResourceManagerV2
 
 	//Catalog Management Option
+	catalogManagementOnce      sync.Once
 	catalogManagementClient    *catalogmanagementv1.CatalogManagementV1
 	catalogManagementClientErr error
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
-	return session.catalogManagementClient, session.catalogManagementClientErr
+// authenticateIAM authenticates the bluemix session against IAM the first time a
+// client needs it, either with the API key or by refreshing the provided token.
+func (sess *clientSession) authenticateIAM() error {
+	sess.iamAuthOnce.Do(func() {
+		if sess.bluemixSessionErr != nil {
+			sess.iamAuthErr = sess.bluemixSessionErr
+			return
+		}
+		bmxSess := sess.session.BluemixSession
+
+		if bmxSess.Config.BluemixAPIKey != "" {
+			err := authenticateAPIKey(bmxSess)
+			if err != nil {
+				sess.iamAuthErr = fmt.Errorf("Error occured while fetching auth key: %q", err)
+				return
+			}
+		}
+
+		if bmxSess.Config.IAMAccessToken != "" && bmxSess.Config.BluemixAPIKey == "" {
+			err := refreshToken(bmxSess)
+			if err != nil {
+				sess.iamAuthErr = fmt.Errorf("Error occured while refreshing the token: %q", err)
+				return
+			}
+		}
+
+		if sess.session.SoftLayerSession != nil && sess.session.SoftLayerSession.IAMToken != "" {
+			sess.session.SoftLayerSession.IAMToken = bmxSess.Config.IAMAccessToken
+			sess.session.SoftLayerSession.IAMRefreshToken = bmxSess.Config.IAMRefreshToken
+		}
+	})
+	return sess.iamAuthErr
+}
+
+// authenticateUAA fetches the UAA tokens used by the Cloud Foundry based services.
+// It is only attempted when the provider is configured with an API key.
+func (sess *clientSession) authenticateUAA() error {
+	sess.uaaAuthOnce.Do(func() {
+		if err := sess.authenticateIAM(); err != nil {
+			sess.uaaAuthErr = err
+			return
+		}
+		bmxSess := sess.session.BluemixSession
+		if bmxSess.Config.BluemixAPIKey == "" {
+			return
+		}
+		err := authenticateCF(bmxSess)
+		if err != nil {
+			sess.uaaAuthErr = fmt.Errorf("Error occured while fetching UAA auth key: %q", err)
+		}
+	})
+	return sess.uaaAuthErr
+}
+
+// bluemixClientSession returns the IAM authenticated bluemix session used to build the bluemix-go clients
+func (sess *clientSession) bluemixClientSession() (*bxsession.Session, error) {
+	if err := sess.authenticateIAM(); err != nil {
+		return nil, err
+	}
+	return sess.session.BluemixSession, nil
+}
+
+// serviceEndpoint returns the endpoint of the service, see serviceEndpointResolver
+func (sess *clientSession) serviceEndpoint(service, publicURL string) (string, error) {
+	return sess.endpoints.endpoint(service, publicURL)
+}
+
+// authenticator returns the authenticator shared by the IBM Cloud platform SDK clients
+func (sess *clientSession) authenticator() (core.Authenticator, error) {
+	sess.authenticatorOnce.Do(func() {
+		if sess.bluemixSessionErr != nil {
+			sess.authenticatorErr = sess.bluemixSessionErr
+			return
+		}
+		if sess.config.BluemixAPIKey != "" {
+			endpoint, err := sess.serviceEndpoint("iam", "https://iam.cloud.ibm.com")
+			if err != nil {
+				sess.authenticatorErr = err
+				return
+			}
+			sess.authenticatorAPI = &core.IamAuthenticator{
+				ApiKey: sess.config.BluemixAPIKey,
+				URL:    endpoint + "/identity/token",
+				Client: sess.session.HTTPClient,
+			}
+			return
+		}
+		if err := sess.authenticateIAM(); err != nil {
+			sess.authenticatorErr = err
+			return
+		}
+		token := sess.session.BluemixSession.Config.IAMAccessToken
+		if strings.HasPrefix(token, "Bearer") {
+			token = token[7:]
+		}
+		sess.authenticatorAPI = &core.BearerTokenAuthenticator{
+			BearerToken: token,
+		}
+	})
+	return sess.authenticatorAPI, sess.authenticatorErr
+}
+
+func (sess *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	sess.catalogManagementOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.catalogManagementClientErr = err
+			return
+		}
+		// Construct an "options" struct for creating the service client.
+		catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
+		endpoint, err := sess.serviceEndpoint("catalog_management", catalogManagementURL)
+		if err != nil {
+			sess.catalogManagementClientErr = err
+			return
+		}
+		catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
+			URL:           endpoint,
+			Authenticator: authenticator,
+		}
+
+		// Construct the service client.
+		sess.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
+		if err != nil {
+			sess.catalogManagementClientErr = fmt.Errorf("Error occurred while configuring Catalog Management API service: %q", err)
+			return
+		}
+		sess.catalogManagementClient.Service.SetHTTPClient(sess.session.HTTPClient)
+		// Add custom header for analytics
+		sess.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	})
+	return sess.catalogManagementClient, sess.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.accountConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.accountConfigErr = err
+			return
+		}
+		sess.bmxAccountServiceAPI, err = accountv2.New(bmxSess)
+		if err != nil {
+			sess.accountConfigErr = fmt.Errorf("Error occured while configuring  Account Service: %q", err)
+		}
+	})
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.accountV1ConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.accountV1ConfigErr = err
+			return
+		}
+		sess.bmxAccountv1ServiceAPI, err = accountv1.New(bmxSess)
+		if err != nil {
+			sess.accountV1ConfigErr = fmt.Errorf("Error occured while configuring Bluemix Accountv1 Service: %q", err)
+		}
+	})
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
-	return sess.session.BluemixSession, sess.bluemixSessionErr
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
+	if sess.bluemixSessionErr != nil {
+		return sess.session.BluemixSession, sess.bluemixSessionErr
+	}
+	if err := sess.authenticateIAM(); err != nil {
+		return sess.session.BluemixSession, err
+	}
+	if err := sess.authenticateUAA(); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+	return sess.session.BluemixSession, nil
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
+	sess.bmxUserDetailsOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.bmxUserFetchErr = fmt.Errorf("Error occured while fetching auth key for account user details: %q", err)
+			return
+		}
+		sess.bmxUserDetails, err = fetchUserDetails(bmxSess, sess.config.Generation)
+		if err != nil {
+			sess.bmxUserFetchErr = fmt.Errorf("Error occured while fetching account user details: %q", err)
+		}
+	})
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.csConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.csConfigErr = err
+			return
+		}
+		sess.csServiceAPI, err = containerv1.New(bmxSess)
+		if err != nil {
+			sess.csConfigErr = fmt.Errorf("Error occured while configuring Container Service for K8s cluster: %q", err)
+		}
+	})
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.csv2ConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.csv2ConfigErr = err
+			return
+		}
+		sess.csv2ServiceAPI, err = containerv2.New(bmxSess)
+		if err != nil {
+			sess.csv2ConfigErr = fmt.Errorf("Error occured while configuring vpc Container Service for K8s cluster: %q", err)
+		}
+	})
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
-// ContainerRegistryAPI provides v2Container Service APIs ...
-func (sess clientSession) ContainerRegistryAPI() (registryv1.RegistryServiceAPI, error) {
+// ContainerRegistryAPI provides Container Registry Service APIs ...
+func (sess *clientSession) ContainerRegistryAPI() (registryv1.RegistryServiceAPI, error) {
+	sess.crv1ConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.crv1ConfigErr = err
+			return
+		}
+		sess.crv1ServiceAPI, err = registryv1.New(bmxSess)
+		if err != nil {
+			sess.crv1ConfigErr = fmt.Errorf("Error occured while configuring Container Registry: %q", err)
+		}
+	})
 	return sess.crv1ServiceAPI, sess.crv1ConfigErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsAPI() (schematics.SchematicsServiceAPI, error) {
+func (sess *clientSession) SchematicsAPI() (schematics.SchematicsServiceAPI, error) {
+	sess.stxConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.stxConfigErr = err
+			return
+		}
+		sess.stxServiceAPI, err = schematics.New(bmxSess)
+		if err != nil {
+			sess.stxConfigErr = fmt.Errorf("Error occured while fetching schematics Configuration: %q", err)
+		}
+	})
 	return sess.stxServiceAPI, sess.stxConfigErr
 }
 
 // CisAPI provides Cloud Internet Services APIs ...
-func (sess clientSession) CisAPI() (cisv1.CisServiceAPI, error) {
+func (sess *clientSession) CisAPI() (cisv1.CisServiceAPI, error) {
+	sess.cisConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.cisConfigErr = err
+			return
+		}
+		sess.cisServiceAPI, err = cisv1.New(bmxSess)
+		if err != nil {
+			sess.cisConfigErr = fmt.Errorf("Error occured while configuring Cloud Internet Services: %q", err)
+		}
+	})
 	return sess.cisServiceAPI, sess.cisConfigErr
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.functionConfigOnce.Do(func() {
+		if err := sess.authenticateIAM(); err != nil {
+			sess.functionConfigErr = fmt.Errorf("Error occured while fetching auth key for function: %q", err)
+			return
+		}
+		if err := sess.authenticateUAA(); err != nil {
+			sess.functionConfigErr = fmt.Errorf("Error occured while fetching auth key for function: %q", err)
+			return
+		}
+		functionConfig := sess.session.BluemixSession.Config
+		if endpoint, ok := sess.endpoints.endpoints["functions"]; ok {
+			functionConfig = functionConfig.Copy(&bluemix.Config{Endpoint: &endpoint})
+		}
+		sess.functionClient, sess.functionConfigErr = FunctionClient(functionConfig)
+	})
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.globalSearchConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.globalSearchConfigErr = err
+			return
+		}
+		sess.globalSearchServiceAPI, err = globalsearchv2.New(bmxSess)
+		if err != nil {
+			sess.globalSearchConfigErr = fmt.Errorf("Error occured while configuring Global Search: %q", err)
+		}
+	})
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.globalTaggingConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.globalTaggingConfigErr = err
+			return
+		}
+		sess.globalTaggingServiceAPI, err = globaltaggingv3.New(bmxSess)
+		if err != nil {
+			sess.globalTaggingConfigErr = fmt.Errorf("Error occured while configuring Global Tagging: %q", err)
+		}
+	})
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
+// GlobalTaggingAPIv1 provides the Global Tagging APIs of the user, access and service tags
+func (sess *clientSession) GlobalTaggingAPIv1() (*globaltaggingv1.GlobalTaggingV1, error) {
+	sess.globalTaggingV1Once.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.globalTaggingV1Err = err
+			return
+		}
+		endpoint, err := sess.serviceEndpoint("global_tagging", globaltaggingv1.DefaultServiceURL)
+		if err != nil {
+			sess.globalTaggingV1Err = err
+			return
+		}
+		globalTaggingOptions := &globaltaggingv1.GlobalTaggingV1Options{
+			Authenticator: authenticator,
+			URL:           endpoint,
+		}
+		sess.globalTaggingV1API, err = globaltaggingv1.NewGlobalTaggingV1(globalTaggingOptions)
+		if err != nil {
+			sess.globalTaggingV1Err = fmt.Errorf("Error occured while configuring Global Tagging: %q", err)
+			return
+		}
+		sess.globalTaggingV1API.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
+	return sess.globalTaggingV1API, sess.globalTaggingV1Err
+}
+
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.hpcsEndpointOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.hpcsEndpointErr = err
+			return
+		}
+		sess.hpcsEndpointAPI, err = hpcs.New(bmxSess)
+		if err != nil {
+			sess.hpcsEndpointErr = fmt.Errorf("Error occured while configuring hpcs Endpoint: %q", err)
+		}
+	})
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // IAMAPI provides IAM PAP APIs ...
-func (sess clientSession) IAMAPI() (iamv1.IAMServiceAPI, error) {
+func (sess *clientSession) IAMAPI() (iamv1.IAMServiceAPI, error) {
+	sess.iamConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.iamConfigErr = err
+			return
+		}
+		sess.iamServiceAPI, err = iamv1.New(bmxSess)
+		if err != nil {
+			sess.iamConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAM Service: %q", err)
+		}
+	})
 	return sess.iamServiceAPI, sess.iamConfigErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.userManagementOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.userManagementErr = err
+			return
+		}
+		sess.userManagementAPI, err = usermanagementv2.New(bmxSess)
+		if err != nil {
+			sess.userManagementErr = fmt.Errorf("Error occured while configuring user management service: %q", err)
+		}
+	})
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAMPAPAPI provides IAM PAP APIs ...
-func (sess clientSession) IAMPAPAPI() (iampapv1.IAMPAPAPI, error) {
+func (sess *clientSession) IAMPAPAPI() (iampapv1.IAMPAPAPI, error) {
+	sess.iamPAPConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.iamPAPConfigErr = err
+			return
+		}
+		sess.iamPAPServiceAPI, err = iampapv1.New(bmxSess)
+		if err != nil {
+			sess.iamPAPConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAMPAP Service: %q", err)
+		}
+	})
 	return sess.iamPAPServiceAPI, sess.iamPAPConfigErr
 }
 
 // IAMPAPAPIV2 provides IAM PAP APIs ...
-func (sess clientSession) IAMPAPAPIV2() (iampapv2.IAMPAPAPIV2, error) {
+func (sess *clientSession) IAMPAPAPIV2() (iampapv2.IAMPAPAPIV2, error) {
+	sess.iamPAPConfigOncev2.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.iamPAPConfigErrv2 = err
+			return
+		}
+		sess.iamPAPServiceAPIv2, err = iampapv2.New(bmxSess)
+		if err != nil {
+			sess.iamPAPConfigErrv2 = fmt.Errorf("Error occured while configuring Bluemix IAMPAP Service: %q", err)
+		}
+	})
 	return sess.iamPAPServiceAPIv2, sess.iamPAPConfigErrv2
 }
 
 // IAMUUMAPI provides IAM UUM APIs ...
-func (sess clientSession) IAMUUMAPI() (iamuumv1.IAMUUMServiceAPI, error) {
+func (sess *clientSession) IAMUUMAPI() (iamuumv1.IAMUUMServiceAPI, error) {
+	sess.iamUUMConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.iamUUMConfigErr = err
+			return
+		}
+		sess.iamUUMServiceAPI, err = iamuumv1.New(bmxSess)
+		if err != nil {
+			sess.iamUUMConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAMUUM Service: %q", err)
+		}
+	})
 	return sess.iamUUMServiceAPI, sess.iamUUMConfigErr
 }
 
 // IAMUUMAPIV2 provides IAM UUM APIs ...
-func (sess clientSession) IAMUUMAPIV2() (iamuumv2.IAMUUMServiceAPIv2, error) {
+func (sess *clientSession) IAMUUMAPIV2() (iamuumv2.IAMUUMServiceAPIv2, error) {
+	sess.iamUUMConfigOnceV2.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.iamUUMConfigErrV2 = err
+			return
+		}
+		sess.iamUUMServiceAPIV2, err = iamuumv2.New(bmxSess)
+		if err != nil {
+			sess.iamUUMConfigErrV2 = fmt.Errorf("Error occured while configuring Bluemix IAMUUM Service: %q", err)
+		}
+	})
 	return sess.iamUUMServiceAPIV2, sess.iamUUMConfigErrV2
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.icdConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.icdConfigErr = err
+			return
+		}
+		sess.icdServiceAPI, err = icdv4.New(bmxSess)
+		if err != nil {
+			sess.icdConfigErr = fmt.Errorf("Error occured while configuring IBM Cloud Database Services: %q", err)
+		}
+	})
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.cfConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.cfConfigErr = err
+			return
+		}
+		if err := sess.authenticateUAA(); err != nil {
+			log.Printf("[WARN] %s", err)
+		}
+		sess.cfServiceAPI, err = mccpv2.New(bmxSess)
+		if err != nil {
+			sess.cfConfigErr = fmt.Errorf("Error occured while configuring MCCP service: %q", err)
+		}
+	})
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.resourceCatalogConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.resourceCatalogConfigErr = err
+			return
+		}
+		sess.resourceCatalogServiceAPI, err = catalog.New(bmxSess)
+		if err != nil {
+			sess.resourceCatalogConfigErr = fmt.Errorf("Error occured while configuring Resource Catalog service: %q", err)
+		}
+	})
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPI ...
-func (sess clientSession) ResourceManagementAPI() (management.ResourceManagementAPI, error) {
+func (sess *clientSession) ResourceManagementAPI() (management.ResourceManagementAPI, error) {
+	sess.resourceManagementConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.resourceManagementConfigErr = err
+			return
+		}
+		sess.resourceManagementServiceAPI, err = management.New(bmxSess)
+		if err != nil {
+			sess.resourceManagementConfigErr = fmt.Errorf("Error occured while configuring Resource Management service: %q", err)
+		}
+	})
 	return sess.resourceManagementServiceAPI, sess.resourceManagementConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.resourceManagementConfigOncev2.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.resourceManagementConfigErrv2 = err
+			return
+		}
+		sess.resourceManagementServiceAPIv2, err = managementv2.New(bmxSess)
+		if err != nil {
+			sess.resourceManagementConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Management service: %q", err)
+		}
+	})
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.resourceControllerConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.resourceControllerConfigErr = err
+			return
+		}
+		sess.resourceControllerServiceAPI, err = controller.New(bmxSess)
+		if err != nil {
+			sess.resourceControllerConfigErr = fmt.Errorf("Error occured while configuring Resource Controller service: %q", err)
+		}
+	})
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.resourceControllerConfigOncev2.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.resourceControllerConfigErrv2 = err
+			return
+		}
+		sess.resourceControllerServiceAPIv2, err = controllerv2.New(bmxSess)
+		if err != nil {
+			sess.resourceControllerConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Controller v2 service: %q", err)
+		}
+	})
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
+// TagsConfig returns the default and ignored tags of the provider configuration
+func (sess *clientSession) TagsConfig() TagsConfig {
+	if sess.config == nil {
+		return TagsConfig{}
+	}
+	return sess.config.Tags
+}
+
+// HTTPClient returns the client shared by the service clients, for the APIs
+// called without a service client
+func (sess *clientSession) HTTPClient() *gohttp.Client {
+	if sess.session == nil || sess.session.HTTPClient == nil {
+		return gohttp.DefaultClient
+	}
+	return sess.session.HTTPClient
+}
+
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
+	if sess.session.SoftLayerSession.IAMToken != "" && sess.bluemixSessionErr == nil {
+		// The SoftLayer session shares the IAM token of the bluemix session
+		if err := sess.authenticateIAM(); err != nil {
+			log.Printf("[WARN] %s", err)
+		}
+	}
 	return sess.session.SoftLayerSession
 }
 
 // CertManagementAPI provides Certificate  management APIs ...
-func (sess clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+func (sess *clientSession) CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error) {
+	sess.certManagementOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.certManagementErr = err
+			return
+		}
+		sess.certManagementAPI, err = certificatemanager.New(bmxSess)
+		if err != nil {
+			sess.certManagementErr = fmt.Errorf("Error occured while configuring Certificate manager service: %q", err)
+		}
+	})
 	return sess.certManagementAPI, sess.certManagementErr
 }
 
 //apigatewayAPI provides API Gateway APIs
-func (sess clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+func (sess *clientSession) APIGateway() (*apigateway.ApiGatewayControllerApiV1, error) {
+	sess.apigatewayOnce.Do(func() {
+		if sess.bluemixSessionErr != nil {
+			sess.apigatewayErr = sess.bluemixSessionErr
+			return
+		}
+		apicurl := fmt.Sprintf("https://api.%s.apigw.cloud.ibm.com/controller", sess.config.Region)
+		endpoint, err := sess.serviceEndpoint("apigateway", apicurl)
+		if err != nil {
+			sess.apigatewayErr = err
+			return
+		}
+		APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
+			URL:           endpoint,
+			Authenticator: &core.NoAuthAuthenticator{},
+		}
+		sess.apigatewayAPI, err = apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
+		if err != nil {
+			sess.apigatewayErr = fmt.Errorf("Error occured while configuring  APIGateway service: %q", err)
+			return
+		}
+		sess.apigatewayAPI.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	return sess.apigatewayAPI, sess.apigatewayErr
 }
 
-func (sess clientSession) keyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) keyProtectAPI() (*kp.Client, error) {
+	sess.kpOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.kpErr = err
+			return
+		}
+		kpurl := fmt.Sprintf("https://%s.kms.cloud.ibm.com", sess.config.Region)
+		endpoint, err := sess.serviceEndpoint("kms", kpurl)
+		if err != nil {
+			sess.kpErr = err
+			return
+		}
+		options := kp.ClientConfig{
+			BaseURL:       endpoint,
+			Authorization: bmxSess.Config.IAMAccessToken,
+			// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
+			Verbose: kp.VerboseFailOnly,
+		}
+		sess.kpAPI, err = kp.New(options, sess.session.KeyProtectTransport)
+		if err != nil {
+			sess.kpErr = fmt.Errorf("Error occured while configuring Key Protect Service: %q", err)
+		}
+	})
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) keyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) keyManagementAPI() (*kp.Client, error) {
+	sess.kmsOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.kmsErr = err
+			return
+		}
+		kmsurl := fmt.Sprintf("https://%s.kms.cloud.ibm.com", sess.config.Region)
+		endpoint, err := sess.serviceEndpoint("kms", kmsurl)
+		if err != nil {
+			sess.kmsErr = err
+			return
+		}
+		kmsOptions := kp.ClientConfig{
+			BaseURL:       endpoint,
+			Authorization: bmxSess.Config.IAMAccessToken,
+			// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
+			Verbose: kp.VerboseFailOnly,
+		}
+		sess.kmsAPI, err = kp.New(kmsOptions, sess.session.KeyProtectTransport)
+		if err != nil {
+			sess.kmsErr = fmt.Errorf("Error occured while configuring key Service: %q", err)
+		}
+	})
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcClassicV1API() (*vpcclassic.VpcClassicV1, error) {
+func (sess *clientSession) VpcClassicV1API() (*vpcclassic.VpcClassicV1, error) {
+	sess.vpcClassicOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.vpcClassicErr = err
+			return
+		}
+		vpcclassicurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", sess.config.Region)
+		endpoint, err := sess.serviceEndpoint("vpc_classic", vpcclassicurl)
+		if err != nil {
+			sess.vpcClassicErr = err
+			return
+		}
+		vpcclassicoptions := &vpcclassic.VpcClassicV1Options{
+			URL:           endpoint,
+			Authenticator: authenticator,
+		}
+		sess.vpcClassicAPI, err = vpcclassic.NewVpcClassicV1(vpcclassicoptions)
+		if err != nil {
+			sess.vpcClassicErr = fmt.Errorf("Error occured while configuring vpc classic service: %q", err)
+			return
+		}
+		sess.vpcClassicAPI.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	return sess.vpcClassicAPI, sess.vpcClassicErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.vpcOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.vpcErr = err
+			return
+		}
+		vpcurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", sess.config.Region)
+		endpoint, err := sess.serviceEndpoint("vpc", vpcurl)
+		if err != nil {
+			sess.vpcErr = err
+			return
+		}
+		vpcoptions := &vpc.VpcV1Options{
+			URL:           endpoint,
+			Authenticator: authenticator,
+		}
+		sess.vpcAPI, err = vpc.NewVpcV1(vpcoptions)
+		if err != nil {
+			sess.vpcErr = fmt.Errorf("Error occured while configuring vpc service: %q", err)
+			return
+		}
+		sess.vpcAPI.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
-	return sess.directlinkAPI, sess.directlinkErr
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.directlinkOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.directlinkErr = err
+			return
+		}
+		endpoint, err := sess.serviceEndpoint("directlink", "https://directlink.cloud.ibm.com/v1")
+		if err != nil {
+			sess.directlinkErr = err
+			return
+		}
+		directlinkOptions := &dl.DirectLinkV1Options{
+			URL:           endpoint,
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		}
+		sess.directlinkAPI, err = dl.NewDirectLinkV1(directlinkOptions)
+		if err != nil {
+			sess.directlinkErr = fmt.Errorf("Error occured while configuring Direct Link Service: %s", err)
+			return
+		}
+		sess.directlinkAPI.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
+	return sess.directlinkAPI, sess.directlinkErr
 }
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.dlProviderOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.dlProviderErr = err
+			return
+		}
+		endpoint, err := sess.serviceEndpoint("directlink_provider", "https://directlink.cloud.ibm.com/provider/v2")
+		if err != nil {
+			sess.dlProviderErr = err
+			return
+		}
+		directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
+			URL:           endpoint,
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		}
+		sess.dlProviderAPI, err = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
+		if err != nil {
+			sess.dlProviderErr = fmt.Errorf("Error occured while configuring Direct Link Provider Service: %s", err)
+			return
+		}
+		sess.dlProviderAPI.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.cosConfigOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cosConfigErr = err
+			return
+		}
+		endpoint, err := sess.serviceEndpoint("cos_config", "https://config.cloud-object-storage.cloud.ibm.com/v1")
+		if err != nil {
+			sess.cosConfigErr = err
+			return
+		}
+		cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
+			Authenticator: authenticator,
+			URL:           endpoint,
+		}
+		sess.cosConfigAPI, err = cosconfig.NewResourceConfigurationV1(cosconfigoptions)
+		if err != nil {
+			sess.cosConfigErr = fmt.Errorf("Error occured while configuring COS config service: %q", err)
+			return
+		}
+		sess.cosConfigAPI.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.transitgatewayOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.transitgatewayErr = err
+			return
+		}
+		endpoint, err := sess.serviceEndpoint("transit_gateway", "https://transit.cloud.ibm.com/v1")
+		if err != nil {
+			sess.transitgatewayErr = err
+			return
+		}
+		transitgatewayOptions := &tg.TransitGatewayApisV1Options{
+			URL:           endpoint,
+			Authenticator: authenticator,
+			Version:       CreateVersionDate(),
+		}
+		sess.transitgatewayAPI, err = tg.NewTransitGatewayApisV1(transitgatewayOptions)
+		if err != nil {
+			sess.transitgatewayErr = fmt.Errorf("Error occured while configuring Transit Gateway Service: %s", err)
+			return
+		}
+		sess.transitgatewayAPI.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.powerConfigOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.powerConfigErr = fmt.Errorf("Error occured while fetching the auth key for power iaas: %q", err)
+			return
+		}
+		var userAccount string
+		if userDetails, _ := sess.BluemixUserDetails(); userDetails != nil {
+			userAccount = userDetails.userAccount
+		}
+		c := sess.config
+		sess.ibmpiSession, sess.powerConfigErr = ibmpisession.New(bmxSess.Config.IAMAccessToken, c.Region, false, (c.BluemixTimeout * 10000000000), userAccount, c.Zone)
+		if sess.powerConfigErr != nil {
+			return
+		}
+		runtime, ok := sess.ibmpiSession.Power.Transport.(*httptransport.Runtime)
+		if !ok {
+			return
+		}
+		runtime.Transport = sess.session.HTTPClient.Transport
+		if endpoint, ok := sess.endpoints.endpoints["power"]; ok {
+			powerURL, err := url.Parse(endpoint)
+			if err != nil || powerURL.Host == "" {
+				sess.powerConfigErr = fmt.Errorf("Error occured while configuring the power endpoint %q: host is missing or invalid", endpoint)
+				return
+			}
+			runtime.Host = powerURL.Host
+		}
+	})
 	return sess.ibmpiSession, sess.powerConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.pDNSOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.pDNSErr = err
+			return
+		}
+		endpoint, err := sess.serviceEndpoint("private_dns", "https://api.dns-svcs.cloud.ibm.com/v1")
+		if err != nil {
+			sess.pDNSErr = err
+			return
+		}
+		dnsOptions := &dns.DnsSvcsV1Options{
+			URL:           endpoint,
+			Authenticator: authenticator,
+		}
+		sess.pDNSClient, err = dns.NewDnsSvcsV1(dnsOptions)
+		if err != nil {
+			sess.pDNSErr = fmt.Errorf("Error occured while configuring PrivateDNS Service: %s", err)
+			return
+		}
+		sess.pDNSClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.functionIAMNamespaceOnce.Do(func() {
+		bmxSess, err := sess.bluemixClientSession()
+		if err != nil {
+			sess.functionIAMNamespaceErr = err
+			return
+		}
+		sess.functionIAMNamespaceAPI, err = functions.New(bmxSess)
+		if err != nil {
+			sess.functionIAMNamespaceErr = fmt.Errorf("Error occured while configuring Cloud Funciton Service : %q", err)
+		}
+	})
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
+// cisEndpoint returns the endpoint shared by all CIS service clients
+func (sess *clientSession) cisEndpoint() (string, error) {
+	return sess.serviceEndpoint("cis", "https://api.cis.cloud.ibm.com")
+}
+
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.cisZonesOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisZonesErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisZonesErr = err
+			return
+		}
+		opt := &ciszonesv1.ZonesV1Options{
+			URL:           endpoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		sess.cisZonesV1Client, err = ciszonesv1.NewZonesV1(opt)
+		if err != nil {
+			sess.cisZonesErr = fmt.Errorf("Error occured while configuring CIS Zones service: %s", err)
+			return
+		}
+		sess.cisZonesV1Client.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -667,7 +1557,31 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.cisDNSOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisDNSErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisDNSErr = err
+			return
+		}
+		opt := &cisdnsrecordsv1.DnsRecordsV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisDNSRecordsClient, err = cisdnsrecordsv1.NewDnsRecordsV1(opt)
+		if err != nil {
+			sess.cisDNSErr = fmt.Errorf("Error occured while configuring CIS DNS Service: %s", err)
+			return
+		}
+		sess.cisDNSRecordsClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -675,7 +1589,31 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.cisDNSBulkOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisDNSBulkErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisDNSBulkErr = err
+			return
+		}
+		opt := &cisdnsbulkv1.DnsRecordBulkV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisDNSRecordBulkClient, err = cisdnsbulkv1.NewDnsRecordBulkV1(opt)
+		if err != nil {
+			sess.cisDNSBulkErr = fmt.Errorf("Error occured while configuration CIS DNS bulk service : %s", err)
+			return
+		}
+		sess.cisDNSRecordBulkClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -683,7 +1621,30 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.cisGLBPoolOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisGLBPoolErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisGLBPoolErr = err
+			return
+		}
+		opt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
+			URL:           endpoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		sess.cisGLBPoolClient, err = cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(opt)
+		if err != nil {
+			sess.cisGLBPoolErr = fmt.Errorf("Error occured while configuring CIS GLB Pool service: %s", err)
+			return
+		}
+		sess.cisGLBPoolClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -691,7 +1652,31 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.cisGLBOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisGLBErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisGLBErr = err
+			return
+		}
+		opt := &cisglbv1.GlobalLoadBalancerV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisGLBClient, err = cisglbv1.NewGlobalLoadBalancerV1(opt)
+		if err != nil {
+			sess.cisGLBErr = fmt.Errorf("Error occured while configuring CIS GLB service: %s", err)
+			return
+		}
+		sess.cisGLBClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -699,7 +1684,30 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.cisGLBHealthCheckOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisGLBHealthCheckErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisGLBHealthCheckErr = err
+			return
+		}
+		opt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
+			URL:           endpoint,
+			Crn:           core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		sess.cisGLBHealthCheckClient, err = cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(opt)
+		if err != nil {
+			sess.cisGLBHealthCheckErr = fmt.Errorf("Error occured while configuring CIS GLB Health Check service: %s", err)
+			return
+		}
+		sess.cisGLBHealthCheckClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -707,7 +1715,31 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.cisRLOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisRLErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisRLErr = err
+			return
+		}
+		opt := &cisratelimitv1.ZoneRateLimitsV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisRLClient, err = cisratelimitv1.NewZoneRateLimitsV1(opt)
+		if err != nil {
+			sess.cisRLErr = fmt.Errorf("Error occured while cofiguring CIS Zone Rate Limit service: %s", err)
+			return
+		}
+		sess.cisRLClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -715,7 +1747,29 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.cisIPOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisIPErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisIPErr = err
+			return
+		}
+		opt := &cisipv1.CisIpApiV1Options{
+			URL:           endpoint,
+			Authenticator: authenticator,
+		}
+		sess.cisIPClient, err = cisipv1.NewCisIpApiV1(opt)
+		if err != nil {
+			sess.cisIPErr = fmt.Errorf("Error occured while configuring CIS IP service: %s", err)
+			return
+		}
+		sess.cisIPClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -723,7 +1777,31 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.cisPageRuleOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisPageRuleErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisPageRuleErr = err
+			return
+		}
+		opt := &cispagerulev1.PageRuleApiV1Options{
+			URL:           endpoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		sess.cisPageRuleClient, err = cispagerulev1.NewPageRuleApiV1(opt)
+		if err != nil {
+			sess.cisPageRuleErr = fmt.Errorf("Error occured while cofiguring CIS Page Rule service: %s", err)
+			return
+		}
+		sess.cisPageRuleClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -731,7 +1809,31 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.cisEdgeFunctionOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisEdgeFunctionErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisEdgeFunctionErr = err
+			return
+		}
+		opt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisEdgeFunctionClient, err = cisedgefunctionv1.NewEdgeFunctionsApiV1(opt)
+		if err != nil {
+			sess.cisEdgeFunctionErr = fmt.Errorf("Error occured while configuring CIS Edge Function service: %s", err)
+			return
+		}
+		sess.cisEdgeFunctionClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -739,7 +1841,31 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.cisSSLOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisSSLErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisSSLErr = err
+			return
+		}
+		opt := &cissslv1.SslCertificateApiV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisSSLClient, err = cissslv1.NewSslCertificateApiV1(opt)
+		if err != nil {
+			sess.cisSSLErr = fmt.Errorf("Error occured while configuring CIS SSL certificate service: %s", err)
+			return
+		}
+		sess.cisSSLClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -747,7 +1873,31 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.cisWAFPackageOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisWAFPackageErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisWAFPackageErr = err
+			return
+		}
+		opt := &ciswafpackagev1.WafRulePackagesApiV1Options{
+			URL:           endpoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		sess.cisWAFPackageClient, err = ciswafpackagev1.NewWafRulePackagesApiV1(opt)
+		if err != nil {
+			sess.cisWAFPackageErr = fmt.Errorf("Error occured while configuration CIS WAF Package service: %s", err)
+			return
+		}
+		sess.cisWAFPackageClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -755,7 +1905,31 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.cisDomainSettingsOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisDomainSettingsErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisDomainSettingsErr = err
+			return
+		}
+		opt := &cisdomainsettingsv1.ZonesSettingsV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisDomainSettingsClient, err = cisdomainsettingsv1.NewZonesSettingsV1(opt)
+		if err != nil {
+			sess.cisDomainSettingsErr = fmt.Errorf("Error occured while configuring CIS Domain Settings service: %s", err)
+			return
+		}
+		sess.cisDomainSettingsClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -763,7 +1937,31 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.cisRoutingOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisRoutingErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisRoutingErr = err
+			return
+		}
+		opt := &cisroutingv1.RoutingV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisRoutingClient, err = cisroutingv1.NewRoutingV1(opt)
+		if err != nil {
+			sess.cisRoutingErr = fmt.Errorf("Error occured while configuring CIS Routing service: %s", err)
+			return
+		}
+		sess.cisRoutingClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -771,7 +1969,31 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.cisWAFGroupOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisWAFGroupErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisWAFGroupErr = err
+			return
+		}
+		opt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
+			URL:           endpoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		sess.cisWAFGroupClient, err = ciswafgroupv1.NewWafRuleGroupsApiV1(opt)
+		if err != nil {
+			sess.cisWAFGroupErr = fmt.Errorf("Error occured while configuring CIS WAF Group service: %s", err)
+			return
+		}
+		sess.cisWAFGroupClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -779,15 +2001,63 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.cisCacheOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisCacheErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisCacheErr = err
+			return
+		}
+		opt := &ciscachev1.CachingApiV1Options{
+			URL:           endpoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		sess.cisCacheClient, err = ciscachev1.NewCachingApiV1(opt)
+		if err != nil {
+			sess.cisCacheErr = fmt.Errorf("Error occured while configuring CIS Caching service: %s", err)
+			return
+		}
+		sess.cisCacheClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
 	return sess.cisCacheClient.Clone(), nil
 }
 
-// CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+// CIS Custom Pages
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.cisCustomPageOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisCustomPageErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisCustomPageErr = err
+			return
+		}
+		opt := &ciscustompagev1.CustomPagesV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisCustomPageClient, err = ciscustompagev1.NewCustomPagesV1(opt)
+		if err != nil {
+			sess.cisCustomPageErr = fmt.Errorf("Error occured while configuring CIS Custom Pages service: %s", err)
+			return
+		}
+		sess.cisCustomPageClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -795,7 +2065,31 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.cisAccessRuleOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisAccessRuleErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisAccessRuleErr = err
+			return
+		}
+		opt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisAccessRuleClient, err = cisaccessrulev1.NewZoneFirewallAccessRulesV1(opt)
+		if err != nil {
+			sess.cisAccessRuleErr = fmt.Errorf("Error occured while configuring CIS Firewall Access Rule service: %s", err)
+			return
+		}
+		sess.cisAccessRuleClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -803,7 +2097,31 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.cisUARuleOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisUARuleErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisUARuleErr = err
+			return
+		}
+		opt := &cisuarulev1.UserAgentBlockingRulesV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisUARuleClient, err = cisuarulev1.NewUserAgentBlockingRulesV1(opt)
+		if err != nil {
+			sess.cisUARuleErr = fmt.Errorf("Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s", err)
+			return
+		}
+		sess.cisUARuleClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -811,7 +2129,31 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.cisLockdownOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisLockdownErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisLockdownErr = err
+			return
+		}
+		opt := &cislockdownv1.ZoneLockdownV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisLockdownClient, err = cislockdownv1.NewZoneLockdownV1(opt)
+		if err != nil {
+			sess.cisLockdownErr = fmt.Errorf("Error occured while configuring CIS Firewall Lockdown Rule service: %s", err)
+			return
+		}
+		sess.cisLockdownClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -819,7 +2161,31 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.cisRangeAppOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisRangeAppErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisRangeAppErr = err
+			return
+		}
+		opt := &cisrangeappv1.RangeApplicationsV1Options{
+			URL:            endpoint,
+			Crn:            core.StringPtr(""),
+			ZoneIdentifier: core.StringPtr(""),
+			Authenticator:  authenticator,
+		}
+		sess.cisRangeAppClient, err = cisrangeappv1.NewRangeApplicationsV1(opt)
+		if err != nil {
+			sess.cisRangeAppErr = fmt.Errorf("Error occured while configuring CIS Range Application rule service: %s", err)
+			return
+		}
+		sess.cisRangeAppClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -827,7 +2193,31 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.cisWAFRuleOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.cisWAFRuleErr = err
+			return
+		}
+		endpoint, err := sess.cisEndpoint()
+		if err != nil {
+			sess.cisWAFRuleErr = err
+			return
+		}
+		opt := &ciswafrulev1.WafRulesApiV1Options{
+			URL:           endpoint,
+			Crn:           core.StringPtr(""),
+			ZoneID:        core.StringPtr(""),
+			Authenticator: authenticator,
+		}
+		sess.cisWAFRuleClient, err = ciswafrulev1.NewWafRulesApiV1(opt)
+		if err != nil {
+			sess.cisWAFRuleErr = fmt.Errorf("Error occured while configuring CIS WAF Rules service: %s", err)
+			return
+		}
+		sess.cisWAFRuleClient.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -835,815 +2225,87 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.iamIdentityOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.iamIdentityErr = err
+			return
+		}
+		// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
+		endpoint, err := sess.serviceEndpoint("iam", "https://iam.cloud.ibm.com")
+		if err != nil {
+			sess.iamIdentityErr = err
+			return
+		}
+		iamIdentityOptions := &iamidentity.IamIdentityV1Options{
+			Authenticator: authenticator,
+			URL:           endpoint,
+		}
+		sess.iamIdentityAPI, err = iamidentity.NewIamIdentityV1(iamIdentityOptions)
+		if err != nil {
+			sess.iamIdentityErr = fmt.Errorf("Error occured while configuring IAM Identity service: %q", err)
+			return
+		}
+		sess.iamIdentityAPI.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.resourceManagerOnce.Do(func() {
+		authenticator, err := sess.authenticator()
+		if err != nil {
+			sess.resourceManagerErr = err
+			return
+		}
+		endpoint, err := sess.serviceEndpoint("resource_manager", "https://resource-controller.cloud.ibm.com/v2")
+		if err != nil {
+			sess.resourceManagerErr = err
+			return
+		}
+		resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
+			Authenticator: authenticator,
+			URL:           endpoint,
+		}
+		sess.resourceManagerAPI, err = resourcemanager.NewResourceManagerV2(resourceManagerOptions)
+		if err != nil {
+			sess.resourceManagerErr = fmt.Errorf("Error occured while configuring Resource Manager service: %q", err)
+			return
+		}
+		sess.resourceManagerAPI.Service.SetHTTPClient(sess.session.HTTPClient)
+	})
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-// ClientSession configures and returns a fully initialized ClientSession
+// ClientSession configures and returns a ClientSession. Authentication and the
+// service clients are set up lazily, the first time each client is requested.
 func (c *Config) ClientSession() (interface{}, error) {
-	sess, err := newSession(c)
+	endpoints, err := c.serviceEndpoints()
+	if err != nil {
+		return nil, err
+	}
+	sess, err := newSession(c, endpoints)
 	if err != nil {
 		return nil, err
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
-	session := clientSession{
-		session: sess,
+	session := &clientSession{
+		session:   sess,
+		config:    c,
+		endpoints: endpoints,
 	}
 
 	if sess.BluemixSession == nil {
 		//Can be nil only  if bluemix_api_key is not provided
 		log.Println("Skipping Bluemix Clients configuration")
 		session.bluemixSessionErr = errEmptyBluemixCredentials
-		session.accountConfigErr = errEmptyBluemixCredentials
-		session.accountV1ConfigErr = errEmptyBluemixCredentials
-		session.csConfigErr = errEmptyBluemixCredentials
-		session.csv2ConfigErr = errEmptyBluemixCredentials
-		session.crv1ConfigErr = errEmptyBluemixCredentials
-		session.kpErr = errEmptyBluemixCredentials
-		session.kmsErr = errEmptyBluemixCredentials
-		session.stxConfigErr = errEmptyBluemixCredentials
-		session.cfConfigErr = errEmptyBluemixCredentials
-		session.cisConfigErr = errEmptyBluemixCredentials
-		session.functionConfigErr = errEmptyBluemixCredentials
-		session.globalSearchConfigErr = errEmptyBluemixCredentials
-		session.globalTaggingConfigErr = errEmptyBluemixCredentials
-		session.hpcsEndpointErr = errEmptyBluemixCredentials
-		session.iamConfigErr = errEmptyBluemixCredentials
-		session.iamPAPConfigErr = errEmptyBluemixCredentials
-		session.iamPAPConfigErrv2 = errEmptyBluemixCredentials
-		session.iamUUMConfigErr = errEmptyBluemixCredentials
-		session.iamUUMConfigErrV2 = errEmptyBluemixCredentials
-		session.icdConfigErr = errEmptyBluemixCredentials
-		session.resourceCatalogConfigErr = errEmptyBluemixCredentials
-		session.resourceManagementConfigErr = errEmptyBluemixCredentials
-		session.resourceManagementConfigErrv2 = errEmptyBluemixCredentials
-		session.resourceControllerConfigErr = errEmptyBluemixCredentials
-		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
-		session.powerConfigErr = errEmptyBluemixCredentials
-		session.ibmpiConfigErr = errEmptyBluemixCredentials
-		session.userManagementErr = errEmptyBluemixCredentials
-		session.certManagementErr = errEmptyBluemixCredentials
-		session.vpcClassicErr = errEmptyBluemixCredentials
-		session.vpcErr = errEmptyBluemixCredentials
-		session.apigatewayErr = errEmptyBluemixCredentials
-		session.pDNSErr = errEmptyBluemixCredentials
-		session.bmxUserFetchErr = errEmptyBluemixCredentials
-		session.directlinkErr = errEmptyBluemixCredentials
-		session.dlProviderErr = errEmptyBluemixCredentials
-		session.cosConfigErr = errEmptyBluemixCredentials
-		session.transitgatewayErr = errEmptyBluemixCredentials
-		session.functionIAMNamespaceErr = errEmptyBluemixCredentials
-		session.cisDNSErr = errEmptyBluemixCredentials
-		session.cisDNSBulkErr = errEmptyBluemixCredentials
-		session.cisGLBPoolErr = errEmptyBluemixCredentials
-		session.cisGLBErr = errEmptyBluemixCredentials
-		session.cisGLBHealthCheckErr = errEmptyBluemixCredentials
-		session.cisIPErr = errEmptyBluemixCredentials
-		session.cisZonesErr = errEmptyBluemixCredentials
-		session.cisRLErr = errEmptyBluemixCredentials
-		session.cisPageRuleErr = errEmptyBluemixCredentials
-		session.cisEdgeFunctionErr = errEmptyBluemixCredentials
-		session.cisSSLErr = errEmptyBluemixCredentials
-		session.cisWAFPackageErr = errEmptyBluemixCredentials
-		session.cisDomainSettingsErr = errEmptyBluemixCredentials
-		session.cisRoutingErr = errEmptyBluemixCredentials
-		session.cisWAFGroupErr = errEmptyBluemixCredentials
-		session.cisCacheErr = errEmptyBluemixCredentials
-		session.cisCustomPageErr = errEmptyBluemixCredentials
-		session.cisAccessRuleErr = errEmptyBluemixCredentials
-		session.cisUARuleErr = errEmptyBluemixCredentials
-		session.cisLockdownErr = errEmptyBluemixCredentials
-		session.cisRangeAppErr = errEmptyBluemixCredentials
-		session.cisWAFRuleErr = errEmptyBluemixCredentials
-		session.iamIdentityErr = errEmptyBluemixCredentials
-
 		return session, nil
 	}
 
-	if sess.BluemixSession.Config.BluemixAPIKey != "" {
-		err = authenticateAPIKey(sess.BluemixSession)
-		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying IAM Authentication %d", count)
-				err = authenticateAPIKey(sess.BluemixSession)
-			}
-			if err != nil {
-				session.bmxUserFetchErr = fmt.Errorf("Error occured while fetching auth key for account user details: %q", err)
-				session.functionConfigErr = fmt.Errorf("Error occured while fetching auth key for function: %q", err)
-				session.powerConfigErr = fmt.Errorf("Error occured while fetching the auth key for power iaas: %q", err)
-				session.ibmpiConfigErr = fmt.Errorf("Error occured while fetching the auth key for power iaas: %q", err)
-			}
-		}
-		err = authenticateCF(sess.BluemixSession)
-		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying CF Authentication %d", count)
-				err = authenticateCF(sess.BluemixSession)
-			}
-			if err != nil {
-				session.functionConfigErr = fmt.Errorf("Error occured while fetching auth key for function: %q", err)
-			}
-		}
-	}
-
-	if sess.BluemixSession.Config.IAMAccessToken != "" && sess.BluemixSession.Config.BluemixAPIKey == "" {
-		err := refreshToken(sess.BluemixSession)
-		if err != nil {
-			for count := c.RetryCount; count >= 0; count-- {
-				if err == nil || !isRetryable(err) {
-					break
-				}
-				time.Sleep(c.RetryDelay)
-				log.Printf("Retrying refresh token %d", count)
-				err = refreshToken(sess.BluemixSession)
-			}
-			if err != nil {
-				return nil, fmt.Errorf("Error occured while refreshing the token: %q", err)
-			}
-		}
-
-	}
-	userConfig, err := fetchUserDetails(sess.BluemixSession, c.Generation, c.RetryCount, c.RetryDelay)
-	if err != nil {
-		session.bmxUserFetchErr = fmt.Errorf("Error occured while fetching account user details: %q", err)
-	}
-	session.bmxUserDetails = userConfig
-
-	if sess.SoftLayerSession != nil && sess.SoftLayerSession.IAMToken != "" {
-		sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
-		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
-	}
-
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
-
 	BluemixRegion = sess.BluemixSession.Config.Region
 
-	accv1API, err := accountv1.New(sess.BluemixSession)
-	if err != nil {
-		session.accountV1ConfigErr = fmt.Errorf("Error occured while configuring Bluemix Accountv1 Service: %q", err)
-	}
-	session.bmxAccountv1ServiceAPI = accv1API
-
-	accAPI, err := accountv2.New(sess.BluemixSession)
-	if err != nil {
-		session.accountConfigErr = fmt.Errorf("Error occured while configuring  Account Service: %q", err)
-	}
-	session.bmxAccountServiceAPI = accAPI
-
-	cfAPI, err := mccpv2.New(sess.BluemixSession)
-	if err != nil {
-		session.cfConfigErr = fmt.Errorf("Error occured while configuring MCCP service: %q", err)
-	}
-	session.cfServiceAPI = cfAPI
-
-	clusterAPI, err := containerv1.New(sess.BluemixSession)
-	if err != nil {
-		session.csConfigErr = fmt.Errorf("Error occured while configuring Container Service for K8s cluster: %q", err)
-	}
-	session.csServiceAPI = clusterAPI
-
-	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.csv2ConfigErr = fmt.Errorf("Error occured while configuring vpc Container Service for K8s cluster: %q", err)
-	}
-	session.csv2ServiceAPI = v2clusterAPI
-
-	v1registryAPI, err := registryv1.New(sess.BluemixSession)
-	if err != nil {
-		session.crv1ConfigErr = fmt.Errorf("Error occured while configuring Container Registry: %q", err)
-	}
-	session.crv1ServiceAPI = v1registryAPI
-
-	hpcsAPI, err := hpcs.New(sess.BluemixSession)
-	if err != nil {
-		session.hpcsEndpointErr = fmt.Errorf("Error occured while configuring hpcs Endpoint: %q", err)
-	}
-	session.hpcsEndpointAPI = hpcsAPI
-
-	kpurl := fmt.Sprintf("https://%s.kms.cloud.ibm.com", c.Region)
-	options := kp.ClientConfig{
-		BaseURL:       envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
-		Authorization: sess.BluemixSession.Config.IAMAccessToken,
-		// InstanceID:    "42fET57nnadurKXzXAedFLOhGqETfIGYxOmQXkFgkJV9",
-		Verbose: kp.VerboseFailOnly,
-	}
-	kpAPIclient, err := kp.New(options, kp.DefaultTransport())
-	if err != nil {
-		session.kpErr = fmt.Errorf("Error occured while configuring Key Protect Service: %q", err)
-	}
-	session.kpAPI = kpAPIclient
-
-	kmsurl := fmt.Sprintf("https://%s.kms.cloud.ibm.com", c.Region)
-	kmsOptions := kp.ClientConfig{
-		BaseURL:       envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
-		Authorization: sess.BluemixSession.Config.IAMAccessToken,
-		// InstanceID:    "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8",
-		Verbose: kp.VerboseFailOnly,
-	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
-	if err != nil {
-		session.kmsErr = fmt.Errorf("Error occured while configuring key Service: %q", err)
-	}
-	session.kmsAPI = kmsAPIclient
-
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" {
-		authenticator = &core.IamAuthenticator{
-			ApiKey: c.BluemixAPIKey,
-			URL:    envFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, "https://iam.cloud.ibm.com") + "/identity/token",
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
-	}
-
-	// Construct an "options" struct for creating the service client.
-	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
-	catalogManagementClientOptions := &catalogmanagementv1.CatalogManagementV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"}, catalogManagementURL),
-		Authenticator: authenticator,
-	}
-
-	// Construct the service client.
-	session.catalogManagementClient, err = catalogmanagementv1.NewCatalogManagementV1(catalogManagementClientOptions)
-	if err == nil {
-		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-		// Add custom header for analytics
-		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
-			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
-		})
-	} else {
-		session.catalogManagementClientErr = fmt.Errorf("Error occurred while configuring Catalog Management API service: %q", err)
-	}
-
-	vpcclassicurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", c.Region)
-	vpcclassicoptions := &vpcclassic.VpcClassicV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_IS_API_ENDPOINT"}, vpcclassicurl),
-		Authenticator: authenticator,
-	}
-	vpcclassicclient, err := vpcclassic.NewVpcClassicV1(vpcclassicoptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("Error occured while configuring vpc classic service: %q", err)
-	}
-	session.vpcClassicAPI = vpcclassicclient
-
-	vpcurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", c.Region)
-	vpcoptions := &vpc.VpcV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
-		Authenticator: authenticator,
-	}
-	vpcclient, err := vpc.NewVpcV1(vpcoptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("Error occured while configuring vpc service: %q", err)
-	}
-	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
-	}
-	session.vpcAPI = vpcclient
-
-	//cosconfigurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", c.Region)
-	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
-		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_COS_CONFIG_ENDPOINT"}, "https://config.cloud-object-storage.cloud.ibm.com/v1"),
-	}
-	cosconfigclient, err := cosconfig.NewResourceConfigurationV1(cosconfigoptions)
-	if err != nil {
-		session.cosConfigErr = fmt.Errorf("Error occured while configuring COS config service: %q", err)
-	}
-	session.cosConfigAPI = cosconfigclient
-
-	schematicService, err := schematics.New(sess.BluemixSession)
-	if err != nil {
-		session.stxConfigErr = fmt.Errorf("Error occured while fetching schematics Configuration: %q", err)
-	}
-	session.stxServiceAPI = schematicService
-
-	cisAPI, err := cisv1.New(sess.BluemixSession)
-	if err != nil {
-		session.cisConfigErr = fmt.Errorf("Error occured while configuring Cloud Internet Services: %q", err)
-	}
-	session.cisServiceAPI = cisAPI
-
-	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
-	if err != nil {
-		session.globalSearchConfigErr = fmt.Errorf("Error occured while configuring Global Search: %q", err)
-	}
-	session.globalSearchServiceAPI = globalSearchAPI
-
-	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
-	if err != nil {
-		session.globalTaggingConfigErr = fmt.Errorf("Error occured while configuring Global Tagging: %q", err)
-	}
-	session.globalTaggingServiceAPI = globalTaggingAPI
-
-	iampap, err := iampapv1.New(sess.BluemixSession)
-	if err != nil {
-		session.iamPAPConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAMPAP Service: %q", err)
-	}
-	session.iamPAPServiceAPI = iampap
-
-	iampapv2, err := iampapv2.New(sess.BluemixSession)
-	if err != nil {
-		session.iamPAPConfigErrv2 = fmt.Errorf("Error occured while configuring Bluemix IAMPAP Service: %q", err)
-	}
-	session.iamPAPServiceAPIv2 = iampapv2
-
-	iam, err := iamv1.New(sess.BluemixSession)
-	if err != nil {
-		session.iamConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAM Service: %q", err)
-	}
-	session.iamServiceAPI = iam
-
-	iamuum, err := iamuumv1.New(sess.BluemixSession)
-	if err != nil {
-		session.iamUUMConfigErr = fmt.Errorf("Error occured while configuring Bluemix IAMUUM Service: %q", err)
-	}
-	session.iamUUMServiceAPI = iamuum
-
-	iamuumv2, err := iamuumv2.New(sess.BluemixSession)
-	if err != nil {
-		session.iamUUMConfigErrV2 = fmt.Errorf("Error occured while configuring Bluemix IAMUUM Service: %q", err)
-	}
-	session.iamUUMServiceAPIV2 = iamuumv2
-
-	icdAPI, err := icdv4.New(sess.BluemixSession)
-	if err != nil {
-		session.icdConfigErr = fmt.Errorf("Error occured while configuring IBM Cloud Database Services: %q", err)
-	}
-	session.icdServiceAPI = icdAPI
-
-	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceCatalogConfigErr = fmt.Errorf("Error occured while configuring Resource Catalog service: %q", err)
-	}
-	session.resourceCatalogServiceAPI = resourceCatalogAPI
-
-	resourceManagementAPI, err := management.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceManagementConfigErr = fmt.Errorf("Error occured while configuring Resource Management service: %q", err)
-	}
-	session.resourceManagementServiceAPI = resourceManagementAPI
-
-	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceManagementConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Management service: %q", err)
-	}
-	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
-
-	resourceControllerAPI, err := controller.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErr = fmt.Errorf("Error occured while configuring Resource Controller service: %q", err)
-	}
-	session.resourceControllerServiceAPI = resourceControllerAPI
-
-	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
-	if err != nil {
-		session.resourceControllerConfigErrv2 = fmt.Errorf("Error occured while configuring Resource Controller v2 service: %q", err)
-	}
-	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
-
-	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
-	if err != nil {
-		session.userManagementErr = fmt.Errorf("Error occured while configuring user management service: %q", err)
-	}
-	session.userManagementAPI = userManagementAPI
-	certManagementAPI, err := certificatemanager.New(sess.BluemixSession)
-	if err != nil {
-		session.certManagementErr = fmt.Errorf("Error occured while configuring Certificate manager service: %q", err)
-	}
-	session.certManagementAPI = certManagementAPI
-
-	namespaceFunction, err := functions.New(sess.BluemixSession)
-	if err != nil {
-		session.functionIAMNamespaceErr = fmt.Errorf("Error occured while configuring Cloud Funciton Service : %q", err)
-	}
-	session.functionIAMNamespaceAPI = namespaceFunction
-
-	apicurl := fmt.Sprintf("https://api.%s.apigw.cloud.ibm.com/controller", c.Region)
-	APIGatewayControllerAPIV1Options := &apigateway.ApiGatewayControllerApiV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_API_GATEWAY_ENDPOINT"}, apicurl),
-		Authenticator: &core.NoAuthAuthenticator{},
-	}
-	apigatewayAPI, err := apigateway.NewApiGatewayControllerApiV1(APIGatewayControllerAPIV1Options)
-	if err != nil {
-		session.apigatewayErr = fmt.Errorf("Error occured while configuring  APIGateway service: %q", err)
-	}
-	session.apigatewayAPI = apigatewayAPI
-
-	ibmpisession, err := ibmpisession.New(sess.BluemixSession.Config.IAMAccessToken, c.Region, false, (c.BluemixTimeout * 10000000000), session.bmxUserDetails.userAccount, c.Zone)
-	if err != nil {
-		session.ibmpiConfigErr = err
-		return nil, err
-	}
-
-	session.ibmpiSession = ibmpisession
-
-	dnsOptions := &dns.DnsSvcsV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"}, "https://api.dns-svcs.cloud.ibm.com/v1"),
-		Authenticator: authenticator,
-	}
-
-	session.pDNSClient, session.pDNSErr = dns.NewDnsSvcsV1(dnsOptions)
-	if session.pDNSErr != nil {
-		session.pDNSErr = fmt.Errorf("Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
-	}
-	version := time.Now().Format("2006-01-02")
-
-	directlinkOptions := &dl.DirectLinkV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_DL_API_ENDPOINT"}, "https://directlink.cloud.ibm.com/v1"),
-		Authenticator: authenticator,
-		Version:       &version,
-	}
-
-	session.directlinkAPI, session.directlinkErr = dl.NewDirectLinkV1(directlinkOptions)
-	if session.directlinkErr != nil {
-		session.directlinkErr = fmt.Errorf("Error occured while configuring Direct Link Service: %s", session.directlinkErr)
-	}
-
-	//Direct link provider
-	directLinkProviderV2Options := &dlProviderV2.DirectLinkProviderV2Options{
-		URL:           envFallBack([]string{"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"}, "https://directlink.cloud.ibm.com/provider/v2"),
-		Authenticator: authenticator,
-		Version:       &version,
-	}
-
-	session.dlProviderAPI, session.dlProviderErr = dlProviderV2.NewDirectLinkProviderV2(directLinkProviderV2Options)
-	if session.dlProviderErr != nil {
-		session.dlProviderErr = fmt.Errorf("Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
-	}
-	transitgatewayOptions := &tg.TransitGatewayApisV1Options{
-		URL:           envFallBack([]string{"IBMCLOUD_TG_API_ENDPOINT"}, "https://transit.cloud.ibm.com/v1"),
-		Authenticator: authenticator,
-		Version:       CreateVersionDate(),
-	}
-
-	session.transitgatewayAPI, session.transitgatewayErr = tg.NewTransitGatewayApisV1(transitgatewayOptions)
-	if session.transitgatewayErr != nil {
-		session.transitgatewayErr = fmt.Errorf("Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
-	}
-
-	// CIS Service instances starts here.
-	cisEndPoint := envFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, "https://api.cis.cloud.ibm.com")
-
-	// IBM Network CIS Zones service
-	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisZonesV1Client, session.cisZonesErr = ciszonesv1.NewZonesV1(cisZonesV1Opt)
-	if session.cisZonesErr != nil {
-		session.cisZonesErr = fmt.Errorf(
-			"Error occured while configuring CIS Zones service: %s",
-			session.cisZonesErr)
-	}
-
-	// IBM Network CIS DNS Record service
-	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordsClient, session.cisDNSErr = cisdnsrecordsv1.NewDnsRecordsV1(cisDNSRecordsOpt)
-	if session.cisDNSErr != nil {
-		session.cisDNSErr = fmt.Errorf("Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
-	}
-
-	// IBM Network CIS DNS Record bulk service
-	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDNSRecordBulkClient, session.cisDNSBulkErr = cisdnsbulkv1.NewDnsRecordBulkV1(cisDNSRecordBulkOpt)
-	if session.cisDNSBulkErr != nil {
-		session.cisDNSBulkErr = fmt.Errorf(
-			"Error occured while configuration CIS DNS bulk service : %s",
-			session.cisDNSBulkErr)
-	}
-
-	// IBM Network CIS Global load balancer pool
-	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBPoolClient, session.cisGLBPoolErr =
-		cisglbpoolv0.NewGlobalLoadBalancerPoolsV0(cisGLBPoolOpt)
-	if session.cisGLBPoolErr != nil {
-		session.cisGLBPoolErr =
-			fmt.Errorf("Error occured while configuring CIS GLB Pool service: %s",
-				session.cisGLBPoolErr)
-	}
-
-	// IBM Network CIS Global load balancer
-	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
-		URL:            cisEndPoint,
-		Authenticator:  authenticator,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-	}
-	session.cisGLBClient, session.cisGLBErr = cisglbv1.NewGlobalLoadBalancerV1(cisGLBOpt)
-	if session.cisGLBErr != nil {
-		session.cisGLBErr =
-			fmt.Errorf("Error occured while configuring CIS GLB service: %s",
-				session.cisGLBErr)
-	}
-
-	// IBM Network CIS Global load balancer health check/monitor
-	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisGLBHealthCheckClient, session.cisGLBHealthCheckErr =
-		cisglbhealthcheckv1.NewGlobalLoadBalancerMonitorV1(cisGLBHealthCheckOpt)
-	if session.cisGLBHealthCheckErr != nil {
-		session.cisGLBHealthCheckErr =
-			fmt.Errorf("Error occured while configuring CIS GLB Health Check service: %s",
-				session.cisGLBHealthCheckErr)
-	}
-
-	// IBM Network CIS IP
-	cisIPOpt := &cisipv1.CisIpApiV1Options{
-		URL:           cisEndPoint,
-		Authenticator: authenticator,
-	}
-	session.cisIPClient, session.cisIPErr = cisipv1.NewCisIpApiV1(cisIPOpt)
-	if session.cisIPErr != nil {
-		session.cisIPErr = fmt.Errorf("Error occured while configuring CIS IP service: %s",
-			session.cisIPErr)
-	}
-
-	// IBM Network CIS Zone Rate Limit
-	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRLClient, session.cisRLErr = cisratelimitv1.NewZoneRateLimitsV1(cisRLOpt)
-	if session.cisRLErr != nil {
-		session.cisRLErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Zone Rate Limit service: %s",
-			session.cisRLErr)
-	}
-
-	// IBM Network CIS Page Rules
-	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisPageRuleClient, session.cisPageRuleErr = cispagerulev1.NewPageRuleApiV1(cisPageRuleOpt)
-	if session.cisPageRuleErr != nil {
-		session.cisPageRuleErr = fmt.Errorf(
-			"Error occured while cofiguring CIS Page Rule service: %s",
-			session.cisPageRuleErr)
-	}
-
-	// IBM Network CIS Edge Function
-	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisEdgeFunctionClient, session.cisEdgeFunctionErr =
-		cisedgefunctionv1.NewEdgeFunctionsApiV1(cisEdgeFunctionOpt)
-	if session.cisEdgeFunctionErr != nil {
-		session.cisEdgeFunctionErr =
-			fmt.Errorf("Error occured while configuring CIS Edge Function service: %s",
-				session.cisEdgeFunctionErr)
-	}
-
-	// IBM Network CIS SSL certificate
-	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-
-	session.cisSSLClient, session.cisSSLErr = cissslv1.NewSslCertificateApiV1(cisSSLOpt)
-	if session.cisSSLErr != nil {
-		session.cisSSLErr =
-			fmt.Errorf("Error occured while configuring CIS SSL certificate service: %s",
-				session.cisSSLErr)
-	}
-
-	// IBM Network CIS WAF Package
-	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFPackageClient, session.cisWAFPackageErr =
-		ciswafpackagev1.NewWafRulePackagesApiV1(cisWAFPackageOpt)
-	if session.cisWAFPackageErr != nil {
-		session.cisWAFPackageErr =
-			fmt.Errorf("Error occured while configuration CIS WAF Package service: %s",
-				session.cisWAFPackageErr)
-	}
-
-	// IBM Network CIS Domain settings
-	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisDomainSettingsClient, session.cisDomainSettingsErr =
-		cisdomainsettingsv1.NewZonesSettingsV1(cisDomainSettingsOpt)
-	if session.cisDomainSettingsErr != nil {
-		session.cisDomainSettingsErr =
-			fmt.Errorf("Error occured while configuring CIS Domain Settings service: %s",
-				session.cisDomainSettingsErr)
-	}
-
-	// IBM Network CIS Routing
-	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRoutingClient, session.cisRoutingErr =
-		cisroutingv1.NewRoutingV1(cisRoutingOpt)
-	if session.cisRoutingErr != nil {
-		session.cisRoutingErr =
-			fmt.Errorf("Error occured while configuring CIS Routing service: %s",
-				session.cisRoutingErr)
-	}
-
-	// IBM Network CIS WAF Group
-	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFGroupClient, session.cisWAFGroupErr =
-		ciswafgroupv1.NewWafRuleGroupsApiV1(cisWAFGroupOpt)
-	if session.cisWAFGroupErr != nil {
-		session.cisWAFGroupErr =
-			fmt.Errorf("Error occured while configuring CIS WAF Group service: %s",
-				session.cisWAFGroupErr)
-	}
-
-	// IBM Network CIS Cache service
-	cisCacheOpt := &ciscachev1.CachingApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisCacheClient, session.cisCacheErr =
-		ciscachev1.NewCachingApiV1(cisCacheOpt)
-	if session.cisCacheErr != nil {
-		session.cisCacheErr =
-			fmt.Errorf("Error occured while configuring CIS Caching service: %s",
-				session.cisCacheErr)
-	}
-
-	// IBM Network CIS Custom pages service
-	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-
-	session.cisCustomPageClient, session.cisCustomPageErr =
-		ciscustompagev1.NewCustomPagesV1(cisCustomPageOpt)
-	if session.cisCustomPageErr != nil {
-		session.cisCustomPageErr =
-			fmt.Errorf("Error occured while configuring CIS Custom Pages service: %s",
-				session.cisCustomPageErr)
-	}
-
-	// IBM Network CIS Firewall Access rule
-	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisAccessRuleClient, session.cisAccessRuleErr =
-		cisaccessrulev1.NewZoneFirewallAccessRulesV1(cisAccessRuleOpt)
-	if session.cisAccessRuleErr != nil {
-		session.cisAccessRuleErr =
-			fmt.Errorf("Error occured while configuring CIS Firewall Access Rule service: %s",
-				session.cisAccessRuleErr)
-	}
-
-	// IBM Network CIS Firewall User Agent Blocking rule
-	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisUARuleClient, session.cisUARuleErr =
-		cisuarulev1.NewUserAgentBlockingRulesV1(cisUARuleOpt)
-	if session.cisUARuleErr != nil {
-		session.cisUARuleErr =
-			fmt.Errorf("Error occured while configuring CIS Firewall User Agent Blocking Rule service: %s",
-				session.cisUARuleErr)
-	}
-
-	// IBM Network CIS Firewall Lockdown rule
-	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisLockdownClient, session.cisLockdownErr =
-		cislockdownv1.NewZoneLockdownV1(cisLockdownOpt)
-	if session.cisLockdownErr != nil {
-		session.cisLockdownErr =
-			fmt.Errorf("Error occured while configuring CIS Firewall Lockdown Rule service: %s",
-				session.cisLockdownErr)
-	}
-
-	// IBM Network CIS Range Application rule
-	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
-		URL:            cisEndPoint,
-		Crn:            core.StringPtr(""),
-		ZoneIdentifier: core.StringPtr(""),
-		Authenticator:  authenticator,
-	}
-	session.cisRangeAppClient, session.cisRangeAppErr =
-		cisrangeappv1.NewRangeApplicationsV1(cisRangeAppOpt)
-	if session.cisRangeAppErr != nil {
-		session.cisRangeAppErr =
-			fmt.Errorf("Error occured while configuring CIS Range Application rule service: %s",
-				session.cisRangeAppErr)
-	}
-
-	// IBM Network CIS WAF Rule Service
-	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
-		URL:           cisEndPoint,
-		Crn:           core.StringPtr(""),
-		ZoneID:        core.StringPtr(""),
-		Authenticator: authenticator,
-	}
-	session.cisWAFRuleClient, session.cisWAFRuleErr =
-		ciswafrulev1.NewWafRulesApiV1(cisWAFRuleOpt)
-	if session.cisWAFRuleErr != nil {
-		session.cisWAFRuleErr = fmt.Errorf(
-			"Error occured while configuring CIS WAF Rules service: %s",
-			session.cisWAFRuleErr)
-	}
-	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
-	iamIdentityOptions := &iamidentity.IamIdentityV1Options{
-		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, "https://iam.cloud.ibm.com"),
-	}
-	iamIdentityClient, err := iamidentity.NewIamIdentityV1(iamIdentityOptions)
-	if err != nil {
-		session.vpcErr = fmt.Errorf("Error occured while configuring IAM Identity service: %q", err)
-	}
-	session.iamIdentityAPI = iamIdentityClient
-
-	resourceManagerOptions := &resourcemanager.ResourceManagerV2Options{
-		Authenticator: authenticator,
-		URL:           envFallBack([]string{"IBMCLOUD_RESOURCE_MANAGER_API_ENDPOINT"}, "https://resource-controller.cloud.ibm.com/v2"),
-	}
-	resourceManagerClient, err := resourcemanager.NewResourceManagerV2(resourceManagerOptions)
-	if err != nil {
-		session.resourceManagerErr = fmt.Errorf("Error occured while configuring Resource Manager service: %q", err)
-	}
-	if resourceManagerClient != nil {
-		resourceManagerClient.EnableRetries(c.RetryCount, c.RetryDelay)
-	}
-	session.resourceManagerAPI = resourceManagerClient
-
 	return session, nil
 }
 
@@ -1653,17 +2315,32 @@ func CreateVersionDate() *string {
 	return &version
 }
 
-func newSession(c *Config) (*Session, error) {
+func newSession(c *Config, endpoints *serviceEndpointResolver) (*Session, error) {
 	ibmSession := &Session{}
 
+	// All the clients share one transport, the retries of the bluemix-go and SoftLayer
+	// clients are disabled so that throttled requests are only retried by the transport.
+	// The Key Protect clients always retry, so their transport doesn't.
+	// The timeout is set per attempt on the transport so that it doesn't cut the waits
+	// between retries.
+	transport := newRetryTransport(c.RetryCount, c.RetryDelay, c.BluemixTimeout)
+	ibmSession.HTTPClient = &gohttp.Client{
+		Transport: transport,
+	}
+	ibmSession.KeyProtectTransport = transport.withMaxRetries(0)
+	noRetries := 0
+
 	softlayerSession := &slsession.Session{
-		Endpoint:  c.SoftLayerEndpointURL,
-		Timeout:   c.SoftLayerTimeout,
-		UserName:  c.SoftLayerUserName,
-		APIKey:    c.SoftLayerAPIKey,
-		Debug:     os.Getenv("TF_LOG") != "",
-		Retries:   c.RetryCount,
-		RetryWait: c.RetryDelay,
+		Endpoint: c.SoftLayerEndpointURL,
+		Timeout:  c.SoftLayerTimeout,
+		UserName: c.SoftLayerUserName,
+		APIKey:   c.SoftLayerAPIKey,
+		Debug:    os.Getenv("TF_LOG") != "",
+		Retries:  noRetries,
+		// The SoftLayer session sets the timeout on its client, so it gets its own
+		HTTPClient: &gohttp.Client{
+			Transport: transport.withAttemptTimeout(0),
+		},
 	}
 
 	if c.IAMToken != "" {
@@ -1691,11 +2368,13 @@ func newSession(c *Config) (*Session, error) {
 			IAMRefreshToken: c.IAMRefreshToken,
 			//Comment out debug mode for v0.12
 			//Debug:           os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			RetryDelay:      &c.RetryDelay,
+			MaxRetries:      &noRetries,
+			HTTPClient:      ibmSession.HTTPClient,
+			EndpointLocator: newEndpointLocator(endpoints),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -1711,11 +2390,13 @@ func newSession(c *Config) (*Session, error) {
 			BluemixAPIKey: c.BluemixAPIKey,
 			//Comment out debug mode for v0.12
 			//Debug:         os.Getenv("TF_LOG") != "",
-			HTTPTimeout:   c.BluemixTimeout,
-			Region:        c.Region,
-			ResourceGroup: c.ResourceGroup,
-			RetryDelay:    &c.RetryDelay,
-			MaxRetries:    &c.RetryCount,
+			HTTPTimeout:     c.BluemixTimeout,
+			Region:          c.Region,
+			ResourceGroup:   c.ResourceGroup,
+			RetryDelay:      &c.RetryDelay,
+			MaxRetries:      &noRetries,
+			HTTPClient:      ibmSession.HTTPClient,
+			EndpointLocator: newEndpointLocator(endpoints),
 			//PowerServiceInstance: c.PowerServiceInstance,
 		}
 		sess, err := bxsession.New(bmxConfig)
@@ -1734,6 +2415,7 @@ func authenticateAPIKey(sess *bxsession.Session) error {
 		DefaultHeader: gohttp.Header{
 			"User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -1747,6 +2429,7 @@ func authenticateCF(sess *bxsession.Session) error {
 		DefaultHeader: gohttp.Header{
 			"User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -1754,7 +2437,7 @@ func authenticateCF(sess *bxsession.Session) error {
 	return tokenRefresher.AuthenticateAPIKey(config.BluemixAPIKey)
 }
 
-func fetchUserDetails(sess *bxsession.Session, generation, retries int, retryDelay time.Duration) (*UserConfig, error) {
+func fetchUserDetails(sess *bxsession.Session, generation int) (*UserConfig, error) {
 	config := sess.Config
 	user := UserConfig{}
 	var bluemixToken string
@@ -1770,14 +2453,6 @@ func fetchUserDetails(sess *bxsession.Session, generation, retries int, retryDel
 	})
 	//TODO validate with key
 	if err != nil && !strings.Contains(err.Error(), "key is of invalid type") {
-		if retries > 0 {
-			if config.BluemixAPIKey != "" {
-				time.Sleep(retryDelay)
-				log.Printf("Retrying authentication for user details %d", retries)
-				_ = authenticateAPIKey(sess)
-				return fetchUserDetails(sess, generation, retries-1, retryDelay)
-			}
-		}
 		return &user, err
 	}
 	claims := token.Claims.(jwt.MapClaims)
@@ -1804,6 +2479,7 @@ func refreshToken(sess *bxsession.Session) error {
 		DefaultHeader: gohttp.Header{
 			"User-Agent": []string{http.UserAgent()},
 		},
+		HTTPClient: config.HTTPClient,
 	})
 	if err != nil {
 		return err
@@ -1824,39 +2500,3 @@ func envFallBack(envs []string, defaultValue string) string {
 	}
 	return defaultValue
 }
-
-// DefaultTransport ...
-func DefaultTransport() gohttp.RoundTripper {
-	transport := &gohttp.Transport{
-		Proxy:               gohttp.ProxyFromEnvironment,
-		DisableKeepAlives:   true,
-		MaxIdleConnsPerHost: -1,
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: false,
-		},
-	}
-	return transport
-}
-
-func isRetryable(err error) bool {
-	if bmErr, ok := err.(bmxerror.RequestFailure); ok {
-		switch bmErr.StatusCode() {
-		case 408, 504, 599, 429, 500, 502, 520, 503:
-			return true
-		}
-	}
-
-	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(*net.OpError); ok && netErr.Timeout() {
-		return true
-	}
-
-	if netErr, ok := err.(net.UnknownNetworkError); ok && netErr.Timeout() {
-		return true
-	}
-
-	return false
-}
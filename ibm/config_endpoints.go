@@ -0,0 +1,348 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// serviceEndpointDescriptions lists the services whose endpoint can be overridden
+// in the provider endpoints block and in the endpoints file
+var serviceEndpointDescriptions = map[string]string{
+	"account":             "Account Management service endpoint",
+	"apigateway":          "API Gateway service endpoint",
+	"catalog_management":  "Catalog Management service endpoint",
+	"certificate_manager": "Certificate Manager service endpoint",
+	"cis":                 "Cloud Internet Services endpoint",
+	"container":           "Kubernetes Service endpoint",
+	"container_registry":  "Container Registry service endpoint",
+	"cos_config":          "Cloud Object Storage resource configuration endpoint",
+	"directlink":          "Direct Link service endpoint",
+	"directlink_provider": "Direct Link provider service endpoint",
+	"functions":           "Cloud Functions service endpoint",
+	"global_search":       "Global Search service endpoint",
+	"global_tagging":      "Global Tagging service endpoint",
+	"hpcs":                "Hyper Protect Crypto Services endpoint",
+	"iam":                 "IAM service endpoint",
+	"icd":                 "Cloud Databases service endpoint",
+	"kms":                 "Key Protect service endpoint",
+	"mccp":                "Cloud Foundry service endpoint",
+	"power":               "Power Systems Virtual Server service endpoint",
+	"private_dns":         "DNS Services endpoint",
+	"resource_catalog":    "Global Catalog service endpoint",
+	"resource_controller": "Resource Controller service endpoint",
+	"resource_management": "Resource Management service endpoint",
+	"resource_manager":    "Resource Manager v2 service endpoint",
+	"schematics":          "Schematics service endpoint",
+	"transit_gateway":     "Transit Gateway service endpoint",
+	"uaa":                 "UAA service endpoint",
+	"user_management":     "User Management service endpoint",
+	"vpc":                 "VPC Generation 2 service endpoint",
+	"vpc_classic":         "VPC Generation 1 service endpoint",
+}
+
+func serviceEndpointNames() []string {
+	names := make([]string, 0, len(serviceEndpointDescriptions))
+	for name := range serviceEndpointDescriptions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// serviceEndpointEnvs lists the environment variables that override the endpoint of a service
+var serviceEndpointEnvs = map[string][]string{
+	"apigateway":          {"IBMCLOUD_API_GATEWAY_ENDPOINT"},
+	"catalog_management":  {"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT"},
+	"cis":                 {"IBMCLOUD_CIS_API_ENDPOINT"},
+	"cos_config":          {"IBMCLOUD_COS_CONFIG_ENDPOINT"},
+	"directlink":          {"IBMCLOUD_DL_API_ENDPOINT"},
+	"directlink_provider": {"IBMCLOUD_DL_PROVIDER_API_ENDPOINT"},
+	"iam":                 {"IBMCLOUD_IAM_API_ENDPOINT"},
+	"kms":                 {"IBMCLOUD_KP_API_ENDPOINT"},
+	"private_dns":         {"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT"},
+	"resource_manager":    {"IBMCLOUD_RESOURCE_MANAGER_API_ENDPOINT"},
+	"transit_gateway":     {"IBMCLOUD_TG_API_ENDPOINT"},
+	"vpc":                 {"IBMCLOUD_IS_NG_API_ENDPOINT"},
+	"vpc_classic":         {"IBMCLOUD_IS_API_ENDPOINT"},
+}
+
+const (
+	visibilityPublic           = "public"
+	visibilityPrivate          = "private"
+	visibilityPublicAndPrivate = "public-and-private"
+)
+
+// privateEndpoint is the private endpoint of a service. The url of a regional
+// endpoint is formatted with the region and is only available in the listed regions.
+type privateEndpoint struct {
+	url     string
+	regions []string
+}
+
+var vpcPrivateRegions = []string{"us-south", "us-east", "eu-gb", "eu-de", "jp-tok", "jp-osa", "au-syd", "ca-tor", "br-sao"}
+
+var privateServiceEndpoints = map[string]privateEndpoint{
+	"cis":                 {url: "https://api.private.cis.cloud.ibm.com"},
+	"cos_config":          {url: "https://config.private.cloud-object-storage.cloud.ibm.com/v1"},
+	"directlink":          {url: "https://private.directlink.cloud.ibm.com/v1"},
+	"directlink_provider": {url: "https://private.directlink.cloud.ibm.com/provider/v2"},
+	"iam":                 {url: "https://private.iam.cloud.ibm.com"},
+	"kms":                 {url: "https://private.%s.kms.cloud.ibm.com", regions: []string{"us-south", "us-east", "eu-gb", "eu-de", "au-syd", "jp-tok", "jp-osa"}},
+	"private_dns":         {url: "https://api.private.dns-svcs.cloud.ibm.com/v1"},
+	"resource_controller": {url: "https://private.resource-controller.cloud.ibm.com"},
+	"resource_management": {url: "https://private.resource-controller.cloud.ibm.com"},
+	"resource_manager":    {url: "https://private.resource-controller.cloud.ibm.com/v2"},
+	"transit_gateway":     {url: "https://private.transit.cloud.ibm.com/v1"},
+	"vpc":                 {url: "https://%s.private.iaas.cloud.ibm.com/v1", regions: vpcPrivateRegions},
+	"vpc_classic":         {url: "https://%s.private.iaas.cloud.ibm.com/v1", regions: vpcPrivateRegions},
+}
+
+// privatePlatformServices are the IBM Cloud platform SDK clients that are switched to
+// their private endpoint, and validated when the provider is configured, for private visibility
+var privatePlatformServices = []string{"cis", "cos_config", "directlink", "directlink_provider", "iam", "private_dns", "resource_manager", "transit_gateway", "vpc", "vpc_classic"}
+
+func privateServiceEndpoint(service, region string) (string, error) {
+	endpoint, ok := privateServiceEndpoints[service]
+	if !ok {
+		return "", fmt.Errorf("The %s service does not support private endpoints, use visibility %q or %q or set the endpoint in the provider endpoints", service, visibilityPublic, visibilityPublicAndPrivate)
+	}
+	if endpoint.regions == nil {
+		return endpoint.url, nil
+	}
+	for _, r := range endpoint.regions {
+		if r == region {
+			return fmt.Sprintf(endpoint.url, region), nil
+		}
+	}
+	return "", fmt.Errorf("The private endpoint of the %s service is not available in region %s, supported regions are %q", service, region, endpoint.regions)
+}
+
+// serviceEndpointResolver resolves the endpoint used for each service
+type serviceEndpointResolver struct {
+	region     string
+	visibility string
+	// endpoints holds the endpoints set in the provider block or in the endpoints file
+	endpoints map[string]string
+}
+
+// endpoint resolves the endpoint of a service. An endpoint set in the provider takes
+// precedence over the environment variables, which take precedence over the private
+// endpoint selected by the visibility. Otherwise the public url is returned.
+func (r *serviceEndpointResolver) endpoint(service, publicURL string) (string, error) {
+	if endpoint, ok := r.endpoints[service]; ok {
+		return endpoint, nil
+	}
+	if endpoint := envFallBack(serviceEndpointEnvs[service], ""); endpoint != "" {
+		return endpoint, nil
+	}
+	switch r.visibility {
+	case visibilityPrivate:
+		return privateServiceEndpoint(service, r.region)
+	case visibilityPublicAndPrivate:
+		if endpoint, err := privateServiceEndpoint(service, r.region); err == nil {
+			return endpoint, nil
+		}
+	}
+	return publicURL, nil
+}
+
+// validate checks that every platform service has an endpoint for private visibility
+func (r *serviceEndpointResolver) validate() error {
+	if r.visibility != visibilityPrivate {
+		return nil
+	}
+	for _, service := range privatePlatformServices {
+		if _, err := r.endpoint(service, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serviceEndpoints returns the endpoint resolver for the configured region and visibility.
+// The endpoints set in the provider block take precedence over the endpoints file.
+func (c *Config) serviceEndpoints() (*serviceEndpointResolver, error) {
+	resolver := &serviceEndpointResolver{
+		region:     c.Region,
+		visibility: c.Visibility,
+		endpoints:  map[string]string{},
+	}
+	switch c.Visibility {
+	case "", visibilityPublic, visibilityPrivate, visibilityPublicAndPrivate:
+	default:
+		return nil, fmt.Errorf("Unsupported visibility %q, supported values are %q", c.Visibility, []string{visibilityPublic, visibilityPrivate, visibilityPublicAndPrivate})
+	}
+	if c.EndpointsFile != "" {
+		fileEndpoints, err := readEndpointsFile(c.EndpointsFile)
+		if err != nil {
+			return nil, err
+		}
+		for service, endpoint := range fileEndpoints[c.Region] {
+			resolver.endpoints[service] = endpoint
+		}
+	}
+	for service, endpoint := range c.Endpoints {
+		if _, ok := serviceEndpointDescriptions[service]; !ok {
+			return nil, fmt.Errorf("Unknown service %q in endpoints, supported services are %q", service, serviceEndpointNames())
+		}
+		if endpoint != "" {
+			resolver.endpoints[service] = endpoint
+		}
+	}
+	if err := resolver.validate(); err != nil {
+		return nil, err
+	}
+	return resolver, nil
+}
+
+// readEndpointsFile reads a JSON file of service endpoints keyed by region, for example
+//
+//	{"us-south": {"vpc": "https://us-south.iaas.cloud.ibm.com/v1", "iam": "https://private.iam.cloud.ibm.com"}}
+func readEndpointsFile(file string) (map[string]map[string]string, error) {
+	path, err := homedir.Expand(file)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding the endpoints file path %s: %s", file, err)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the endpoints file %s: %s", file, err)
+	}
+	fileEndpoints := map[string]map[string]string{}
+	if err := json.Unmarshal(content, &fileEndpoints); err != nil {
+		return nil, fmt.Errorf("Error parsing the endpoints file %s: %s", file, err)
+	}
+	for region, serviceEndpoints := range fileEndpoints {
+		for service := range serviceEndpoints {
+			if _, ok := serviceEndpointDescriptions[service]; !ok {
+				return nil, fmt.Errorf("Unknown service %q for region %s in the endpoints file %s, supported services are %q", service, region, file, serviceEndpointNames())
+			}
+		}
+	}
+	return fileEndpoints, nil
+}
+
+// endpointLocator resolves the bluemix-go service endpoints, returning the configured
+// override or the private endpoint when there is one and the default endpoint otherwise
+type endpointLocator struct {
+	endpoints.EndpointLocator
+	resolver *serviceEndpointResolver
+}
+
+func newEndpointLocator(resolver *serviceEndpointResolver) endpoints.EndpointLocator {
+	return endpointLocator{
+		EndpointLocator: endpoints.NewEndpointLocator(resolver.region),
+		resolver:        resolver,
+	}
+}
+
+// endpoint resolves the endpoint of a bluemix-go service like serviceEndpointResolver.endpoint,
+// the default endpoint honours the environment variables envs of the service. For private
+// visibility a service without a private endpoint fails instead of using its public endpoint.
+func (e endpointLocator) endpoint(service string, envs []string, defaultEndpoint func() (string, error)) (string, error) {
+	if endpoint, ok := e.resolver.endpoints[service]; ok {
+		return endpoint, nil
+	}
+	for _, env := range envs {
+		if os.Getenv(env) != "" {
+			return defaultEndpoint()
+		}
+	}
+	switch e.resolver.visibility {
+	case visibilityPrivate:
+		return privateServiceEndpoint(service, e.resolver.region)
+	case visibilityPublicAndPrivate:
+		if endpoint, err := privateServiceEndpoint(service, e.resolver.region); err == nil {
+			return endpoint, nil
+		}
+	}
+	return defaultEndpoint()
+}
+
+func (e endpointLocator) AccountManagementEndpoint() (string, error) {
+	return e.endpoint("account", []string{"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT"}, e.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (e endpointLocator) CertificateManagerEndpoint() (string, error) {
+	return e.endpoint("certificate_manager", []string{"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT"}, e.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (e endpointLocator) CFAPIEndpoint() (string, error) {
+	return e.endpoint("mccp", []string{"IBMCLOUD_CF_API_ENDPOINT"}, e.EndpointLocator.CFAPIEndpoint)
+}
+
+func (e endpointLocator) ContainerEndpoint() (string, error) {
+	return e.endpoint("container", []string{"IBMCLOUD_CS_API_ENDPOINT"}, e.EndpointLocator.ContainerEndpoint)
+}
+
+func (e endpointLocator) ContainerRegistryEndpoint() (string, error) {
+	return e.endpoint("container_registry", []string{"IBMCLOUD_CR_API_ENDPOINT"}, e.EndpointLocator.ContainerRegistryEndpoint)
+}
+
+func (e endpointLocator) CisEndpoint() (string, error) {
+	return e.endpoint("cis", []string{"IBMCLOUD_CIS_API_ENDPOINT"}, e.EndpointLocator.CisEndpoint)
+}
+
+func (e endpointLocator) GlobalSearchEndpoint() (string, error) {
+	return e.endpoint("global_search", []string{"IBMCLOUD_GS_API_ENDPOINT"}, e.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (e endpointLocator) GlobalTaggingEndpoint() (string, error) {
+	return e.endpoint("global_tagging", []string{"IBMCLOUD_GT_API_ENDPOINT"}, e.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (e endpointLocator) IAMEndpoint() (string, error) {
+	return e.endpoint("iam", []string{"IBMCLOUD_IAM_API_ENDPOINT"}, e.EndpointLocator.IAMEndpoint)
+}
+
+func (e endpointLocator) IAMPAPEndpoint() (string, error) {
+	return e.endpoint("iam", []string{"IBMCLOUD_IAMPAP_API_ENDPOINT"}, e.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (e endpointLocator) ICDEndpoint() (string, error) {
+	return e.endpoint("icd", []string{"IBMCLOUD_ICD_API_ENDPOINT"}, e.EndpointLocator.ICDEndpoint)
+}
+
+func (e endpointLocator) MCCPAPIEndpoint() (string, error) {
+	return e.endpoint("mccp", []string{"IBMCLOUD_MCCP_API_ENDPOINT"}, e.EndpointLocator.MCCPAPIEndpoint)
+}
+
+func (e endpointLocator) ResourceManagementEndpoint() (string, error) {
+	return e.endpoint("resource_management", []string{"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT"}, e.EndpointLocator.ResourceManagementEndpoint)
+}
+
+func (e endpointLocator) ResourceControllerEndpoint() (string, error) {
+	return e.endpoint("resource_controller", []string{"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT"}, e.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (e endpointLocator) ResourceCatalogEndpoint() (string, error) {
+	return e.endpoint("resource_catalog", []string{"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT"}, e.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (e endpointLocator) UAAEndpoint() (string, error) {
+	return e.endpoint("uaa", []string{"IBMCLOUD_UAA_ENDPOINT"}, e.EndpointLocator.UAAEndpoint)
+}
+
+func (e endpointLocator) SchematicsEndpoint() (string, error) {
+	return e.endpoint("schematics", []string{"IBMCLOUD_SCHEMATICS_API_ENDPOINT"}, e.EndpointLocator.SchematicsEndpoint)
+}
+
+func (e endpointLocator) UserManagementEndpoint() (string, error) {
+	return e.endpoint("user_management", []string{"IBMCLOUD_USER_MANAGEMENT_ENDPOINT"}, e.EndpointLocator.UserManagementEndpoint)
+}
+
+func (e endpointLocator) HpcsEndpoint() (string, error) {
+	return e.endpoint("hpcs", []string{"IBMCLOUD_HPCS_API_ENDPOINT"}, e.EndpointLocator.HpcsEndpoint)
+}
+
+func (e endpointLocator) FunctionsEndpoint() (string, error) {
+	return e.endpoint("functions", []string{"IBMCLOUD_FUNCTIONS_API_ENDPOINT"}, e.EndpointLocator.FunctionsEndpoint)
+}
@@ -20,12 +20,15 @@ const DefaultServiceURL = "https://us-south.functions.cloud.ibm.com"
 //FunctionClient ...
 func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 	baseEndpoint := getBaseURL(c.Region)
+	if c.Endpoint != nil {
+		baseEndpoint = *c.Endpoint
+	}
 	u, err := url.Parse(fmt.Sprintf("%s/api", baseEndpoint))
 	if err != nil {
 		return nil, err
 	}
 
-	functionsClient, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	functionsClient, err := whisk.NewClient(functionsHTTPClient(c), &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -33,6 +36,14 @@ func FunctionClient(c *bluemix.Config) (*whisk.Client, error) {
 	return functionsClient, err
 }
 
+// functionsHTTPClient returns the client shared by the provider when the config has one
+func functionsHTTPClient(c *bluemix.Config) *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
 //getBaseURL ..
 func getBaseURL(region string) string {
 	baseEndpoint := fmt.Sprintf(DefaultServiceURL)
@@ -52,7 +63,7 @@ func getBaseURL(region string) string {
  */
 func setupOpenWhiskClientConfig(namespace string, c *bluemix.Config, functionNamespace functions.FunctionServiceAPI) (*whisk.Client, error) {
 	u, _ := url.Parse(fmt.Sprintf("https://%s.functions.cloud.ibm.com/api", c.Region))
-	wskClient, _ := whisk.NewClient(http.DefaultClient, &whisk.Config{
+	wskClient, _ := whisk.NewClient(functionsHTTPClient(c), &whisk.Config{
 		Host:    u.Host,
 		Version: "v1",
 	})
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	c := &Config{Region: "us-south"}
+	meta, err := c.ClientSession()
+	assert.NilError(t, err)
+
+	_, err = meta.(ClientSession).VpcV1API()
+	assert.Equal(t, errEmptyBluemixCredentials, err)
+
+	_, err = meta.(ClientSession).CisDNSRecordClientSession()
+	assert.Equal(t, errEmptyBluemixCredentials, err)
+
+	_, err = meta.(ClientSession).ResourceControllerAPI()
+	assert.Equal(t, errEmptyBluemixCredentials, err)
+}
+
+func TestClientSessionBuildsClientsOnFirstUse(t *testing.T) {
+	c := &Config{
+		BluemixAPIKey: "test-api-key",
+		Region:        "us-south",
+	}
+	meta, err := c.ClientSession()
+	assert.NilError(t, err)
+
+	sess := meta.(*clientSession)
+	assert.Assert(t, is.Nil(sess.vpcAPI))
+
+	vpcClient, err := sess.VpcV1API()
+	assert.NilError(t, err)
+	assert.Assert(t, vpcClient != nil)
+	assert.Equal(t, "https://us-south.iaas.cloud.ibm.com/v1", vpcClient.Service.GetServiceURL())
+
+	again, err := sess.VpcV1API()
+	assert.NilError(t, err)
+	assert.Assert(t, vpcClient == again)
+
+	// Building the VPC client must not configure unrelated services
+	assert.Assert(t, is.Nil(sess.transitgatewayAPI))
+	assert.Assert(t, is.Nil(sess.cisDNSRecordsClient))
+}
+
+func TestConfigServiceEndpoints(t *testing.T) {
+	file, err := ioutil.TempFile("", "endpoints-*.json")
+	assert.NilError(t, err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(`{
+		"us-south": {"vpc": "https://file.vpc.example.com/v1", "cis": "https://file.cis.example.com"},
+		"eu-de": {"vpc": "https://eu-de.vpc.example.com/v1"}
+	}`)
+	assert.NilError(t, err)
+	file.Close()
+
+	c := &Config{
+		Region:        "us-south",
+		EndpointsFile: file.Name(),
+		Endpoints: map[string]string{
+			"vpc": "https://provider.vpc.example.com/v1",
+			"iam": "",
+		},
+	}
+	endpoints, err := c.serviceEndpoints()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, map[string]string{
+		"vpc": "https://provider.vpc.example.com/v1",
+		"cis": "https://file.cis.example.com",
+	}, endpoints.endpoints)
+
+	c.Endpoints = map[string]string{"unknown": "https://example.com"}
+	_, err = c.serviceEndpoints()
+	assert.Assert(t, err != nil)
+}
+
+func TestClientSessionWithCustomEndpoints(t *testing.T) {
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/identity/token":
+			fmt.Fprintf(w, `{"access_token": "token", "refresh_token": "refresh", "token_type": "Bearer", "expires_in": 3600, "expiration": %d}`, time.Now().Add(time.Hour).Unix())
+		case "/v1/vpcs":
+			fmt.Fprint(w, `{"vpcs": [{"id": "vpc-id", "name": "mock-vpc"}]}`)
+		default:
+			gohttp.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	c := &Config{
+		BluemixAPIKey: "test-api-key",
+		Region:        "us-south",
+		Endpoints: map[string]string{
+			"iam":                 server.URL,
+			"vpc":                 server.URL + "/v1",
+			"resource_controller": server.URL,
+		},
+	}
+	meta, err := c.ClientSession()
+	assert.NilError(t, err)
+
+	vpcClient, err := meta.(ClientSession).VpcV1API()
+	assert.NilError(t, err)
+	vpcs, _, err := vpcClient.ListVpcs(&vpcv1.ListVpcsOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, "mock-vpc", *vpcs.Vpcs[0].Name)
+
+	bmxSess := meta.(*clientSession).session.BluemixSession
+	endpoint, err := bmxSess.Config.EndpointLocator.ResourceControllerEndpoint()
+	assert.NilError(t, err)
+	assert.Equal(t, server.URL, endpoint)
+	endpoint, err = bmxSess.Config.EndpointLocator.IAMEndpoint()
+	assert.NilError(t, err)
+	assert.Equal(t, server.URL, endpoint)
+}
+
+func TestServiceEndpointVisibility(t *testing.T) {
+	resolver := &serviceEndpointResolver{region: "us-south", visibility: visibilityPublic}
+	endpoint, err := resolver.endpoint("vpc", "https://us-south.iaas.cloud.ibm.com/v1")
+	assert.NilError(t, err)
+	assert.Equal(t, "https://us-south.iaas.cloud.ibm.com/v1", endpoint)
+
+	resolver.visibility = visibilityPrivate
+	endpoint, err = resolver.endpoint("vpc", "https://us-south.iaas.cloud.ibm.com/v1")
+	assert.NilError(t, err)
+	assert.Equal(t, "https://us-south.private.iaas.cloud.ibm.com/v1", endpoint)
+
+	_, err = resolver.endpoint("catalog_management", "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta")
+	assert.Assert(t, err != nil)
+
+	resolver.visibility = visibilityPublicAndPrivate
+	endpoint, err = resolver.endpoint("catalog_management", "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta")
+	assert.NilError(t, err)
+	assert.Equal(t, "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta", endpoint)
+
+	locator := newEndpointLocator(resolver)
+	endpoint, err = locator.ICDEndpoint()
+	assert.NilError(t, err)
+	assert.Equal(t, "https://api.us-south.databases.cloud.ibm.com", endpoint)
+	endpoint, err = locator.ResourceControllerEndpoint()
+	assert.NilError(t, err)
+	assert.Equal(t, "https://private.resource-controller.cloud.ibm.com", endpoint)
+
+	resolver.endpoints = map[string]string{"vpc": "https://vpc.example.com/v1"}
+	endpoint, err = resolver.endpoint("vpc", "https://us-south.iaas.cloud.ibm.com/v1")
+	assert.NilError(t, err)
+	assert.Equal(t, "https://vpc.example.com/v1", endpoint)
+}
+
+func TestClientSessionPrivateVisibility(t *testing.T) {
+	c := &Config{
+		BluemixAPIKey: "test-api-key",
+		Region:        "us-south",
+		Visibility:    visibilityPrivate,
+	}
+	meta, err := c.ClientSession()
+	assert.NilError(t, err)
+
+	vpcClient, err := meta.(ClientSession).VpcV1API()
+	assert.NilError(t, err)
+	assert.Equal(t, "https://us-south.private.iaas.cloud.ibm.com/v1", vpcClient.Service.GetServiceURL())
+
+	_, err = meta.(ClientSession).CatalogManagementV1()
+	assert.Assert(t, err != nil)
+
+	bmxSess := meta.(*clientSession).session.BluemixSession
+	endpoint, err := bmxSess.Config.EndpointLocator.IAMEndpoint()
+	assert.NilError(t, err)
+	assert.Equal(t, "https://private.iam.cloud.ibm.com", endpoint)
+
+	// The bluemix-go services without a private endpoint don't fall back to their public endpoint
+	_, err = bmxSess.Config.EndpointLocator.ICDEndpoint()
+	assert.Error(t, err, `The icd service does not support private endpoints, use visibility "public" or "public-and-private" or set the endpoint in the provider endpoints`)
+	_, err = meta.(ClientSession).ICDAPI()
+	assert.Assert(t, err != nil)
+	os.Setenv("IBMCLOUD_ICD_API_ENDPOINT", "https://icd.example.com")
+	defer os.Unsetenv("IBMCLOUD_ICD_API_ENDPOINT")
+	endpoint, err = bmxSess.Config.EndpointLocator.ICDEndpoint()
+	assert.NilError(t, err)
+	assert.Equal(t, "https://icd.example.com", endpoint)
+
+	// Private endpoints of the VPC service are not available in every region
+	c.Region = "in-che"
+	_, err = c.ClientSession()
+	assert.Assert(t, err != nil)
+}
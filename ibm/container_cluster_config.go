@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	gohttp "net/http"
+	"path"
+	"strings"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/ghodss/yaml"
+)
+
+// containerConfigClient is the part of the bluemix-go REST client embedded
+// in the container service APIs that is used to download the kubeconfig
+// without writing it to the disk.
+type containerConfigClient interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+}
+
+// containerClusterConfig holds a self contained kubeconfig and the
+// credentials it carries.
+type containerClusterConfig struct {
+	ConfigYAML       string
+	Host             string
+	Token            string
+	CACertificate    string
+	AdminCertificate string
+	AdminKey         string
+}
+
+// classicClusterConfig downloads the kubeconfig archive of a classic cluster
+// in memory. The certificates of the archive are inlined in the kubeconfig.
+func classicClusterConfig(csClient v1.ContainerServiceAPI, name string, admin bool, target v1.ClusterTargetHeader) (containerClusterConfig, error) {
+	restClient, ok := csClient.(containerConfigClient)
+	if !ok {
+		return containerClusterConfig{}, fmt.Errorf("The container service client doesn't support downloading the cluster config in memory")
+	}
+	rawURL := fmt.Sprintf("/v1/clusters/%s/config", name)
+	if admin {
+		rawURL += "/admin"
+	}
+	var archive bytes.Buffer
+	if _, err := restClient.Get(rawURL, &archive, target.ToMap()); err != nil {
+		return containerClusterConfig{}, err
+	}
+	kubeconfig, files, err := readClusterConfigArchive(archive.Bytes())
+	if err != nil {
+		return containerClusterConfig{}, err
+	}
+
+	// Openshift clusters authenticate with an Openshift token instead of the IAM token
+	clusterInfo, err := csClient.Clusters().FindWithOutShowResourcesCompatible(name, target)
+	if err == nil && clusterInfo.Type == "openshift" {
+		kubeconfig, err = csClient.Clusters().FetchOCTokenForKubeConfig(kubeconfig, &clusterInfo, clusterInfo.IsStagingSatelliteCluster())
+		if err != nil {
+			return containerClusterConfig{}, err
+		}
+	}
+	return inlineClusterConfig(kubeconfig, files)
+}
+
+// vpcClusterConfig fetches the kubeconfig of a VPC cluster in memory.
+func vpcClusterConfig(csClient v2.ContainerServiceAPI, name string, admin bool, target v2.ClusterTargetHeader) (containerClusterConfig, error) {
+	restClient, ok := csClient.(containerConfigClient)
+	if !ok {
+		return containerClusterConfig{}, fmt.Errorf("The VPC container service client doesn't support downloading the cluster config in memory")
+	}
+	params := map[string]interface{}{
+		"cluster": name,
+		"admin":   admin,
+		"format":  "yaml",
+	}
+	var kubeconfig bytes.Buffer
+	if _, err := restClient.Post("/v2/applyRBACAndGetKubeconfig", params, &kubeconfig, target.ToMap()); err != nil {
+		return containerClusterConfig{}, err
+	}
+	return inlineClusterConfig(kubeconfig.Bytes(), nil)
+}
+
+// readClusterConfigArchive returns the kubeconfig and the other files of a
+// cluster config archive, the files are keyed by their base name.
+func readClusterConfigArchive(data []byte) ([]byte, map[string][]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading the cluster config archive: %s", err)
+	}
+	var kubeconfig []byte
+	files := map[string][]byte{}
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, nil, err
+		}
+		var content bytes.Buffer
+		_, err = content.ReadFrom(rc)
+		rc.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		name := path.Base(f.Name)
+		if strings.HasSuffix(name, ".yml") || strings.HasSuffix(name, ".yaml") {
+			kubeconfig = content.Bytes()
+			continue
+		}
+		files[name] = content.Bytes()
+	}
+	if kubeconfig == nil {
+		return nil, nil, fmt.Errorf("Unable to locate kube config in zip archive")
+	}
+	return kubeconfig, files, nil
+}
+
+// inlineClusterConfig replaces the certificate files referenced by the
+// kubeconfig with their content and extracts the credentials of the
+// kubeconfig.
+func inlineClusterConfig(kubeconfig []byte, files map[string][]byte) (containerClusterConfig, error) {
+	clusterConfig := containerClusterConfig{}
+	data, err := yaml.YAMLToJSON(kubeconfig)
+	if err != nil {
+		return clusterConfig, fmt.Errorf("Error parsing the cluster config: %s", err)
+	}
+	config := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return clusterConfig, fmt.Errorf("Error parsing the cluster config: %s", err)
+	}
+
+	clusters, _ := config["clusters"].([]interface{})
+	for _, c := range clusters {
+		cluster := kubeconfigSection(c, "cluster")
+		if cluster == nil {
+			continue
+		}
+		inlineKubeconfigFile(cluster, "certificate-authority", files)
+		if clusterConfig.Host == "" {
+			clusterConfig.Host, _ = cluster["server"].(string)
+			clusterConfig.CACertificate = kubeconfigData(cluster, "certificate-authority-data")
+		}
+	}
+
+	// The token of the user of the current context wins over the others
+	currentUser := kubeconfigCurrentUser(config)
+	users, _ := config["users"].([]interface{})
+	for _, u := range users {
+		user := kubeconfigSection(u, "user")
+		if user == nil {
+			continue
+		}
+		inlineKubeconfigFile(user, "client-certificate", files)
+		inlineKubeconfigFile(user, "client-key", files)
+		if clusterConfig.AdminCertificate == "" {
+			clusterConfig.AdminCertificate = kubeconfigData(user, "client-certificate-data")
+			clusterConfig.AdminKey = kubeconfigData(user, "client-key-data")
+		}
+		if token := kubeconfigToken(user); token != "" {
+			if kubeconfigName(u) == currentUser || clusterConfig.Token == "" {
+				clusterConfig.Token = token
+			}
+		}
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return clusterConfig, err
+	}
+	clusterConfig.ConfigYAML = string(out)
+	return clusterConfig, nil
+}
+
+func kubeconfigCurrentUser(config map[string]interface{}) string {
+	current, _ := config["current-context"].(string)
+	contexts, _ := config["contexts"].([]interface{})
+	for _, c := range contexts {
+		if kubeconfigName(c) != current {
+			continue
+		}
+		context := kubeconfigSection(c, "context")
+		user, _ := context["user"].(string)
+		return user
+	}
+	return ""
+}
+
+func kubeconfigName(entry interface{}) string {
+	named, _ := entry.(map[string]interface{})
+	name, _ := named["name"].(string)
+	return name
+}
+
+func kubeconfigSection(entry interface{}, key string) map[string]interface{} {
+	named, ok := entry.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	section, _ := named[key].(map[string]interface{})
+	return section
+}
+
+// inlineKubeconfigFile replaces the file reference key of a kubeconfig
+// section with the <key>-data value, when the file is known.
+func inlineKubeconfigFile(section map[string]interface{}, key string, files map[string][]byte) {
+	file, ok := section[key].(string)
+	if !ok {
+		return
+	}
+	content, ok := files[path.Base(file)]
+	if !ok {
+		return
+	}
+	section[key+"-data"] = base64.StdEncoding.EncodeToString(content)
+	delete(section, key)
+}
+
+func kubeconfigData(section map[string]interface{}, key string) string {
+	encoded, ok := section[key].(string)
+	if !ok {
+		return ""
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return ""
+	}
+	return string(decoded)
+}
+
+func kubeconfigToken(user map[string]interface{}) string {
+	if token, ok := user["token"].(string); ok {
+		return token
+	}
+	authProvider, _ := user["auth-provider"].(map[string]interface{})
+	config, _ := authProvider["config"].(map[string]interface{})
+	token, _ := config["id-token"].(string)
+	return token
+}
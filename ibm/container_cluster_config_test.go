@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+const testAdminKubeconfig = `apiVersion: v1
+clusters:
+- name: mycluster/abc
+  cluster:
+    certificate-authority: ca-dal10-mycluster.pem
+    server: https://c1.us-south.containers.cloud.ibm.com:30426
+contexts:
+- name: mycluster/abc
+  context:
+    cluster: mycluster/abc
+    user: admin
+current-context: mycluster/abc
+kind: Config
+users:
+- name: admin
+  user:
+    client-certificate: admin.pem
+    client-key: admin-key.pem
+`
+
+const testUserKubeconfig = `apiVersion: v1
+clusters:
+- name: mycluster/abc
+  cluster:
+    certificate-authority-data: Q0EgQ0VSVA==
+    server: https://c1.us-south.containers.cloud.ibm.com:30426
+contexts:
+- name: mycluster/abc
+  context:
+    cluster: mycluster/abc
+    user: IAM#user@ibm.com
+current-context: mycluster/abc
+kind: Config
+users:
+- name: other
+  user:
+    token: other-token
+- name: IAM#user@ibm.com
+  user:
+    auth-provider:
+      name: oidc
+      config:
+        id-token: id-token
+        refresh-token: refresh-token
+`
+
+func testClusterConfigArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadClusterConfigArchive(t *testing.T) {
+	archive := testClusterConfigArchive(t, map[string]string{
+		"kubeConfig-admin/kube-config-dal10-mycluster.yml": testAdminKubeconfig,
+		"kubeConfig-admin/ca-dal10-mycluster.pem":          "CA CERT",
+		"kubeConfig-admin/admin.pem":                       "ADMIN CERT",
+		"kubeConfig-admin/admin-key.pem":                   "ADMIN KEY",
+	})
+	kubeconfig, files, err := readClusterConfigArchive(archive)
+	assert.NilError(t, err)
+	assert.Equal(t, testAdminKubeconfig, string(kubeconfig))
+	assert.DeepEqual(t, map[string][]byte{
+		"ca-dal10-mycluster.pem": []byte("CA CERT"),
+		"admin.pem":              []byte("ADMIN CERT"),
+		"admin-key.pem":          []byte("ADMIN KEY"),
+	}, files)
+
+	_, _, err = readClusterConfigArchive(testClusterConfigArchive(t, map[string]string{"kubeConfig/admin.pem": "ADMIN CERT"}))
+	assert.Assert(t, err != nil)
+
+	_, _, err = readClusterConfigArchive([]byte("not a zip"))
+	assert.Assert(t, err != nil)
+}
+
+func TestInlineClusterConfigAdmin(t *testing.T) {
+	files := map[string][]byte{
+		"ca-dal10-mycluster.pem": []byte("CA CERT"),
+		"admin.pem":              []byte("ADMIN CERT"),
+		"admin-key.pem":          []byte("ADMIN KEY"),
+	}
+	config, err := inlineClusterConfig([]byte(testAdminKubeconfig), files)
+	assert.NilError(t, err)
+	assert.Equal(t, "https://c1.us-south.containers.cloud.ibm.com:30426", config.Host)
+	assert.Equal(t, "CA CERT", config.CACertificate)
+	assert.Equal(t, "ADMIN CERT", config.AdminCertificate)
+	assert.Equal(t, "ADMIN KEY", config.AdminKey)
+	assert.Assert(t, is.Len(config.Token, 0))
+
+	// The kubeconfig doesn't reference any file anymore
+	assert.Assert(t, !strings.Contains(config.ConfigYAML, ".pem"))
+	assert.Assert(t, is.Contains(config.ConfigYAML, "certificate-authority-data: "+base64.StdEncoding.EncodeToString([]byte("CA CERT"))))
+	assert.Assert(t, is.Contains(config.ConfigYAML, "client-certificate-data: "+base64.StdEncoding.EncodeToString([]byte("ADMIN CERT"))))
+	assert.Assert(t, is.Contains(config.ConfigYAML, "client-key-data: "+base64.StdEncoding.EncodeToString([]byte("ADMIN KEY"))))
+	assert.Assert(t, strings.Contains(config.ConfigYAML, "current-context: mycluster/abc"))
+}
+
+func TestInlineClusterConfigToken(t *testing.T) {
+	config, err := inlineClusterConfig([]byte(testUserKubeconfig), nil)
+	assert.NilError(t, err)
+	assert.Equal(t, "https://c1.us-south.containers.cloud.ibm.com:30426", config.Host)
+	assert.Equal(t, "CA CERT", config.CACertificate)
+	assert.Equal(t, "id-token", config.Token)
+	assert.Assert(t, is.Len(config.AdminCertificate, 0))
+	assert.Assert(t, is.Len(config.AdminKey, 0))
+
+	_, err = inlineClusterConfig([]byte("clusters: [\n"), nil)
+	assert.Assert(t, err != nil)
+}
@@ -0,0 +1,767 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	workerUpdateStrategy              = "worker_update_strategy"
+	workerUpdateStrategyMaxSurge      = "max_surge"
+	workerUpdateStrategyMaxUnavail    = "max_unavailable"
+	workerUpdateStrategyPoolOrder     = "pool_order"
+	workerUpdateStrategyPauseOnFail   = "pause_on_failure"
+	workerUpdateProgress              = "worker_update_progress"
+	workerUpdateProgressStatus        = "status"
+	workerUpdateProgressTargetVersion = "target_version"
+	workerUpdateProgressPending       = "pending_workers"
+	workerUpdateProgressUpdated       = "updated_workers"
+	workerUpdateProgressFailed        = "failed_workers"
+
+	workerUpdateStatusInProgress = "in_progress"
+	workerUpdateStatusPaused     = "paused"
+	workerUpdateStatusCompleted  = "completed"
+)
+
+// workerUpdateStrategySchema returns the schema of the strategy of the updates of the workers of a
+// cluster or of a worker pool
+func workerUpdateStrategySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The strategy of the updates of the worker nodes to the Kubernetes version of the master",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				workerUpdateStrategyMaxSurge: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of worker nodes per zone added to a worker pool while its worker nodes are updated",
+				},
+				workerUpdateStrategyMaxUnavail: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of worker nodes of a worker pool updated at the same time",
+				},
+				workerUpdateStrategyPoolOrder: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The names of the worker pools in the order of their updates, the other worker pools are updated afterwards in the order of their names",
+				},
+				workerUpdateStrategyPauseOnFail: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Pause the update when a worker node fails to update, else the other worker nodes are updated before the failure is reported",
+				},
+			},
+		},
+	}
+}
+
+// workerUpdateProgressSchema returns the schema of the progress of the update of the workers, it
+// is recorded in the state so that an interrupted or paused update is resumed by the next apply
+func workerUpdateProgressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The progress of the last update of the worker nodes",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				workerUpdateProgressStatus: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The status of the update, in_progress, paused or completed",
+				},
+				workerUpdateProgressTargetVersion: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Kubernetes version the worker nodes are updated to",
+				},
+				workerUpdateProgressPending: {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of worker nodes left to update",
+				},
+				workerUpdateProgressUpdated: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The IDs of the updated worker nodes",
+				},
+				workerUpdateProgressFailed: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The IDs of the worker nodes that failed to update",
+				},
+			},
+		},
+	}
+}
+
+// containerWorkerUpdateStrategy is the strategy of the updates of the workers
+type containerWorkerUpdateStrategy struct {
+	MaxSurge       int
+	MaxUnavailable int
+	PoolOrder      []string
+	PauseOnFailure bool
+}
+
+// expandWorkerUpdateStrategy returns the strategy of the configuration, the workers are updated one
+// at a time and the update stops at the first failure by default
+func expandWorkerUpdateStrategy(d *schema.ResourceData) containerWorkerUpdateStrategy {
+	strategy := containerWorkerUpdateStrategy{MaxUnavailable: 1, PauseOnFailure: true}
+	l, ok := d.GetOk(workerUpdateStrategy)
+	if !ok || len(l.([]interface{})) == 0 || l.([]interface{})[0] == nil {
+		return strategy
+	}
+	m := l.([]interface{})[0].(map[string]interface{})
+	strategy.MaxSurge = m[workerUpdateStrategyMaxSurge].(int)
+	if maxUnavailable := m[workerUpdateStrategyMaxUnavail].(int); maxUnavailable > 0 {
+		strategy.MaxUnavailable = maxUnavailable
+	}
+	strategy.PoolOrder = expandStringList(m[workerUpdateStrategyPoolOrder].([]interface{}))
+	strategy.PauseOnFailure = m[workerUpdateStrategyPauseOnFail].(bool)
+	return strategy
+}
+
+// containerWorkerUpdateProgress is the progress of the update of the workers
+type containerWorkerUpdateProgress struct {
+	Status         string
+	TargetVersion  string
+	PendingWorkers int
+	UpdatedWorkers []string
+	FailedWorkers  []string
+}
+
+func flattenWorkerUpdateProgress(progress containerWorkerUpdateProgress) []map[string]interface{} {
+	updated := progress.UpdatedWorkers
+	if updated == nil {
+		updated = []string{}
+	}
+	failed := progress.FailedWorkers
+	if failed == nil {
+		failed = []string{}
+	}
+	return []map[string]interface{}{{
+		workerUpdateProgressStatus:        progress.Status,
+		workerUpdateProgressTargetVersion: progress.TargetVersion,
+		workerUpdateProgressPending:       progress.PendingWorkers,
+		workerUpdateProgressUpdated:       updated,
+		workerUpdateProgressFailed:        failed,
+	}}
+}
+
+// previousWorkerUpdateProgress returns the progress of the update of the workers recorded in the
+// state before the apply
+func previousWorkerUpdateProgress(d *schema.ResourceData) containerWorkerUpdateProgress {
+	progress := containerWorkerUpdateProgress{}
+	old, _ := d.GetChange(workerUpdateProgress)
+	l, ok := old.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return progress
+	}
+	m := l[0].(map[string]interface{})
+	progress.Status = m[workerUpdateProgressStatus].(string)
+	progress.TargetVersion = m[workerUpdateProgressTargetVersion].(string)
+	progress.PendingWorkers = m[workerUpdateProgressPending].(int)
+	progress.UpdatedWorkers = expandStringList(m[workerUpdateProgressUpdated].([]interface{}))
+	progress.FailedWorkers = expandStringList(m[workerUpdateProgressFailed].([]interface{}))
+	return progress
+}
+
+// workerUpdateResumable returns whether an update of the workers was interrupted or paused
+func workerUpdateResumable(d *schema.ResourceData) bool {
+	status := previousWorkerUpdateProgress(d).Status
+	return status == workerUpdateStatusInProgress || status == workerUpdateStatusPaused
+}
+
+// resourceContainerWorkerUpdateCustomizeDiff plans the resumption of an interrupted or paused update
+// of the workers
+func resourceContainerWorkerUpdateCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	status := diff.Get(workerUpdateProgress + ".0." + workerUpdateProgressStatus).(string)
+	if status == workerUpdateStatusInProgress || status == workerUpdateStatusPaused {
+		return diff.SetNewComputed(workerUpdateProgress)
+	}
+	return nil
+}
+
+// containerWorker is a worker of a cluster
+type containerWorker struct {
+	ID   string
+	Pool string
+}
+
+// containerWorkerUpdates updates the workers of a cluster to the version of its master, the workers of
+// the classic clusters are updated in place and the workers of the VPC clusters are replaced
+type containerWorkerUpdates interface {
+	// TargetVersion returns the version the workers are updated to
+	TargetVersion() string
+	// OutdatedWorkers returns the workers that are not at the target version
+	OutdatedWorkers() ([]containerWorker, error)
+	// WorkerPoolSize returns the number of workers per zone of the worker pool
+	WorkerPoolSize(pool string) (int, error)
+	// ResizeWorkerPool sets the number of workers per zone of the worker pool
+	ResizeWorkerPool(pool string, size int) error
+	// WorkerPoolWorkers returns the IDs of the workers of the worker pool that are not deleted
+	WorkerPoolWorkers(pool string) ([]string, error)
+	// RemoveWorkers removes the workers from their worker pool and waits for them to be deleted
+	RemoveWorkers(ctx context.Context, ids []string, timeout time.Duration) error
+	// WaitForWorkerPool waits for all the workers of the worker pool to be ready
+	WaitForWorkerPool(ctx context.Context, pool string, timeout time.Duration) error
+	// UpdateWorkers updates the workers and waits for them to be ready, it returns the IDs of the
+	// workers that failed to update
+	UpdateWorkers(ctx context.Context, workers []containerWorker, timeout time.Duration) []string
+}
+
+// orderWorkerPools returns the worker pools of the workers, in the order of the strategy then in the
+// order of their names
+func orderWorkerPools(workers []containerWorker, poolOrder []string) []string {
+	pools := map[string]bool{}
+	for _, worker := range workers {
+		pools[worker.Pool] = true
+	}
+	ordered := make([]string, 0, len(pools))
+	for _, pool := range poolOrder {
+		if pools[pool] {
+			ordered = append(ordered, pool)
+			delete(pools, pool)
+		}
+	}
+	remaining := make([]string, 0, len(pools))
+	for pool := range pools {
+		remaining = append(remaining, pool)
+	}
+	sort.Strings(remaining)
+	return append(ordered, remaining...)
+}
+
+// updateContainerWorkers updates the outdated workers pool by pool, in batches of the max unavailable
+// workers of the strategy. The progress is recorded after each batch so that the next apply resumes
+// an interrupted update.
+func updateContainerWorkers(ctx context.Context, d *schema.ResourceData, updates containerWorkerUpdates, strategy containerWorkerUpdateStrategy, timeout time.Duration) error {
+	workers, err := updates.OutdatedWorkers()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the workers to update: %s", err)
+	}
+
+	progress := containerWorkerUpdateProgress{
+		Status:         workerUpdateStatusInProgress,
+		TargetVersion:  updates.TargetVersion(),
+		PendingWorkers: len(workers),
+	}
+	if previous := previousWorkerUpdateProgress(d); previous.TargetVersion == progress.TargetVersion && previous.Status != workerUpdateStatusCompleted {
+		log.Printf("[INFO] Resuming the update of the workers to %s", progress.TargetVersion)
+		progress.UpdatedWorkers = previous.UpdatedWorkers
+	}
+	d.Set(workerUpdateProgress, flattenWorkerUpdateProgress(progress))
+
+	for _, pool := range orderWorkerPools(workers, strategy.PoolOrder) {
+		poolWorkers := []containerWorker{}
+		for _, worker := range workers {
+			if worker.Pool == pool {
+				poolWorkers = append(poolWorkers, worker)
+			}
+		}
+		err = updateContainerWorkerPool(ctx, d, updates, strategy, pool, poolWorkers, &progress, timeout)
+		if err != nil {
+			progress.Status = workerUpdateStatusPaused
+			d.Set(workerUpdateProgress, flattenWorkerUpdateProgress(progress))
+			return err
+		}
+	}
+
+	if len(progress.FailedWorkers) > 0 {
+		progress.Status = workerUpdateStatusPaused
+		d.Set(workerUpdateProgress, flattenWorkerUpdateProgress(progress))
+		return fmt.Errorf("The workers %s failed to update to %s, the update is resumed by the next apply", strings.Join(progress.FailedWorkers, ", "), progress.TargetVersion)
+	}
+	progress.Status = workerUpdateStatusCompleted
+	d.Set(workerUpdateProgress, flattenWorkerUpdateProgress(progress))
+	return nil
+}
+
+// updateContainerWorkerPool updates the workers of a worker pool, the worker pool is grown by the max
+// surge of the strategy during the update
+func updateContainerWorkerPool(ctx context.Context, d *schema.ResourceData, updates containerWorkerUpdates, strategy containerWorkerUpdateStrategy, pool string, workers []containerWorker, progress *containerWorkerUpdateProgress, timeout time.Duration) (err error) {
+	if strategy.MaxSurge > 0 {
+		var size int
+		var previous, current, surge []string
+		size, err = updates.WorkerPoolSize(pool)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the size of the worker pool %s: %s", pool, err)
+		}
+		previous, err = updates.WorkerPoolWorkers(pool)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the workers of the worker pool %s: %s", pool, err)
+		}
+		err = updates.ResizeWorkerPool(pool, size+strategy.MaxSurge)
+		if err != nil {
+			return fmt.Errorf("Error adding the surge workers to the worker pool %s: %s", pool, err)
+		}
+		// Shrinking the worker pool lets the service pick the workers to remove, so the surge
+		// workers are removed by their IDs before the size of the worker pool is restored
+		defer func() {
+			if surge == nil {
+				// The update of the workers didn't start, the workers added since the resize
+				// are the surge workers
+				if current, listErr := updates.WorkerPoolWorkers(pool); listErr == nil {
+					surge = newContainerWorkerIDs(previous, current)
+				}
+			}
+			removeErr := removeContainerSurgeWorkers(ctx, updates, pool, size, surge, timeout)
+			if removeErr != nil && err == nil {
+				err = removeErr
+			}
+		}()
+		err = updates.WaitForWorkerPool(ctx, pool, timeout)
+		if err != nil {
+			return fmt.Errorf("Error waiting for the surge workers of the worker pool %s: %s", pool, err)
+		}
+		current, err = updates.WorkerPoolWorkers(pool)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the surge workers of the worker pool %s: %s", pool, err)
+		}
+		surge = newContainerWorkerIDs(previous, current)
+		log.Printf("[INFO] The surge workers %s were added to the worker pool %s", strings.Join(surge, ", "), pool)
+	}
+
+	for start := 0; start < len(workers); start += strategy.MaxUnavailable {
+		end := start + strategy.MaxUnavailable
+		if end > len(workers) {
+			end = len(workers)
+		}
+		batch := workers[start:end]
+		failed := updates.UpdateWorkers(ctx, batch, timeout)
+
+		failedIDs := map[string]bool{}
+		for _, id := range failed {
+			failedIDs[id] = true
+		}
+		for _, worker := range batch {
+			if failedIDs[worker.ID] {
+				progress.FailedWorkers = append(progress.FailedWorkers, worker.ID)
+			} else {
+				progress.UpdatedWorkers = append(progress.UpdatedWorkers, worker.ID)
+			}
+		}
+		progress.PendingWorkers -= len(batch)
+		d.Set(workerUpdateProgress, flattenWorkerUpdateProgress(*progress))
+
+		if len(failed) > 0 && strategy.PauseOnFailure {
+			return fmt.Errorf("The workers %s of the worker pool %s failed to update to %s, the update is paused and is resumed by the next apply", strings.Join(failed, ", "), pool, progress.TargetVersion)
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("The update of the workers to %s was interrupted, it is resumed by the next apply: %s", progress.TargetVersion, ctx.Err())
+		}
+	}
+	return nil
+}
+
+// removeContainerSurgeWorkers removes the surge workers from the worker pool, then restores the size
+// of the worker pool, which already has that number of workers per zone
+func removeContainerSurgeWorkers(ctx context.Context, updates containerWorkerUpdates, pool string, size int, surge []string, timeout time.Duration) error {
+	if len(surge) > 0 {
+		err := updates.RemoveWorkers(ctx, surge, timeout)
+		if err != nil {
+			return fmt.Errorf("Error removing the surge workers %s from the worker pool %s: %s", strings.Join(surge, ", "), pool, err)
+		}
+	}
+	err := updates.ResizeWorkerPool(pool, size)
+	if err != nil {
+		return fmt.Errorf("Error restoring the size of the worker pool %s: %s", pool, err)
+	}
+	return nil
+}
+
+// newContainerWorkerIDs returns the IDs of current that are not in previous
+func newContainerWorkerIDs(previous, current []string) []string {
+	known := map[string]bool{}
+	for _, id := range previous {
+		known[id] = true
+	}
+	added := []string{}
+	for _, id := range current {
+		if !known[id] {
+			added = append(added, id)
+		}
+	}
+	return added
+}
+
+// workerKubeVersionOutdated returns whether a worker at the version is updated to the version of the
+// master, when the MAJOR.MINOR versions differ or when the patch version is requested and targeted
+func workerKubeVersionOutdated(workerVersion, targetVersion, masterVersion, patchVersion string) bool {
+	if strings.Split(workerVersion, "_")[0] != strings.Split(masterVersion, "_")[0] {
+		return true
+	}
+	workerParts := strings.Split(workerVersion, ".")
+	targetParts := strings.Split(targetVersion, ".")
+	if patchVersion == "" || len(workerParts) < 3 || len(targetParts) < 3 {
+		return false
+	}
+	return workerParts[2] != patchVersion && targetParts[2] == patchVersion
+}
+
+// classicWorkerUpdates updates the workers of a classic cluster in place
+type classicWorkerUpdates struct {
+	client        v1.ContainerServiceAPI
+	clusterID     string
+	pool          string
+	masterVersion string
+	patchVersion  string
+	target        v1.ClusterTargetHeader
+}
+
+func (u *classicWorkerUpdates) TargetVersion() string {
+	if u.patchVersion != "" {
+		return fmt.Sprintf("%s (patch %s)", u.masterVersion, u.patchVersion)
+	}
+	return u.masterVersion
+}
+
+func (u *classicWorkerUpdates) OutdatedWorkers() ([]containerWorker, error) {
+	var workers []v1.Worker
+	var err error
+	if u.pool != "" {
+		workers, err = u.client.Workers().ListByWorkerPool(u.clusterID, u.pool, false, u.target)
+	} else {
+		workers, err = u.client.Workers().List(u.clusterID, u.target)
+	}
+	if err != nil {
+		return nil, err
+	}
+	outdated := []containerWorker{}
+	for _, worker := range workers {
+		if worker.State != workerDeleteState && workerKubeVersionOutdated(worker.KubeVersion, worker.TargetVersion, u.masterVersion, u.patchVersion) {
+			outdated = append(outdated, containerWorker{ID: worker.ID, Pool: worker.PoolName})
+		}
+	}
+	return outdated, nil
+}
+
+func (u *classicWorkerUpdates) WorkerPoolSize(pool string) (int, error) {
+	workerPool, err := u.client.WorkerPools().GetWorkerPool(u.clusterID, pool, u.target)
+	return workerPool.Size, err
+}
+
+func (u *classicWorkerUpdates) ResizeWorkerPool(pool string, size int) error {
+	return u.client.WorkerPools().ResizeWorkerPool(u.clusterID, pool, size, u.target)
+}
+
+func (u *classicWorkerUpdates) WorkerPoolWorkers(pool string) ([]string, error) {
+	workers, err := u.client.Workers().ListByWorkerPool(u.clusterID, pool, false, u.target)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, worker := range workers {
+		if worker.State != workerDeleteState {
+			ids = append(ids, worker.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (u *classicWorkerUpdates) RemoveWorkers(ctx context.Context, ids []string, timeout time.Duration) error {
+	for _, id := range ids {
+		err := u.client.Workers().Delete(u.clusterID, id, u.target)
+		if err != nil {
+			return err
+		}
+	}
+	for _, id := range ids {
+		workerID := id
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{workerDeletePending},
+			Target:  []string{workerDeleteState},
+			Refresh: func() (interface{}, string, error) {
+				worker, err := u.client.Workers().Get(workerID, u.target)
+				if err != nil || worker.State == workerDeleteState {
+					return worker, workerDeleteState, nil
+				}
+				return worker, workerDeletePending, nil
+			},
+			Timeout:    timeout,
+			Delay:      10 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("Error waiting for worker %s to be removed: %s", workerID, err)
+		}
+	}
+	return nil
+}
+
+func (u *classicWorkerUpdates) WaitForWorkerPool(ctx context.Context, pool string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", workerProvisioning},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := u.client.Workers().ListByWorkerPool(u.clusterID, pool, false, u.target)
+			if err != nil {
+				return nil, "retry", nil
+			}
+			for _, worker := range workers {
+				if worker.State != workerDeleteState && (strings.Contains(worker.KubeVersion, "pending") || worker.State != workerNormal || worker.Status != workerReadyState) {
+					return workers, workerProvisioning, nil
+				}
+			}
+			return workers, workerNormal, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func (u *classicWorkerUpdates) UpdateWorkers(ctx context.Context, workers []containerWorker, timeout time.Duration) []string {
+	failed := []string{}
+	started := []containerWorker{}
+	for _, worker := range workers {
+		err := u.client.Workers().Update(u.clusterID, worker.ID, v1.WorkerUpdateParam{Action: "update"}, u.target)
+		if err != nil {
+			log.Printf("[ERROR] Error updating worker %s: %s", worker.ID, err)
+			failed = append(failed, worker.ID)
+			continue
+		}
+		started = append(started, worker)
+	}
+	for _, worker := range started {
+		workerID := worker.ID
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"retry", versionUpdating},
+			Target:  []string{workerNormal},
+			Refresh: func() (interface{}, string, error) {
+				w, err := u.client.Workers().Get(workerID, u.target)
+				if err != nil {
+					return nil, "retry", nil
+				}
+				if w.State == workerNormal && w.Status == workerReadyState && !strings.Contains(w.KubeVersion, "pending") && !workerKubeVersionOutdated(w.KubeVersion, w.TargetVersion, u.masterVersion, u.patchVersion) {
+					return w, workerNormal, nil
+				}
+				return w, versionUpdating, nil
+			},
+			Timeout:    timeout,
+			Delay:      10 * time.Second,
+			MinTimeout: 10 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			log.Printf("[ERROR] Error waiting for worker %s to update: %s", workerID, err)
+			failed = append(failed, workerID)
+		}
+	}
+	return failed
+}
+
+// vpcWorkerUpdates updates the workers of a VPC cluster by replacing them
+type vpcWorkerUpdates struct {
+	client        v2.ContainerServiceAPI
+	poolClient    v1.ContainerServiceAPI
+	clusterID     string
+	pool          string
+	masterVersion string
+	patchVersion  string
+	target        v2.ClusterTargetHeader
+}
+
+func (u *vpcWorkerUpdates) TargetVersion() string {
+	if u.patchVersion != "" {
+		return fmt.Sprintf("%s (patch %s)", u.masterVersion, u.patchVersion)
+	}
+	return u.masterVersion
+}
+
+func (u *vpcWorkerUpdates) listWorkers(pool string) ([]v2.Worker, error) {
+	if pool != "" {
+		return u.client.Workers().ListByWorkerPool(u.clusterID, pool, false, u.target)
+	}
+	return u.client.Workers().ListWorkers(u.clusterID, false, u.target)
+}
+
+func (u *vpcWorkerUpdates) OutdatedWorkers() ([]containerWorker, error) {
+	workers, err := u.listWorkers(u.pool)
+	if err != nil {
+		return nil, err
+	}
+	outdated := []containerWorker{}
+	for _, worker := range workers {
+		if worker.LifeCycle.ActualState != workerDeleteState && workerKubeVersionOutdated(worker.KubeVersion.Actual, worker.KubeVersion.Target, u.masterVersion, u.patchVersion) {
+			outdated = append(outdated, containerWorker{ID: worker.ID, Pool: worker.PoolName})
+		}
+	}
+	return outdated, nil
+}
+
+func (u *vpcWorkerUpdates) WorkerPoolSize(pool string) (int, error) {
+	workerPool, err := u.client.WorkerPools().GetWorkerPool(u.clusterID, pool, u.target)
+	return workerPool.WorkerCount, err
+}
+
+func (u *vpcWorkerUpdates) ResizeWorkerPool(pool string, size int) error {
+	return u.poolClient.WorkerPools().ResizeWorkerPool(u.clusterID, pool, size, v1.ClusterTargetHeader{ResourceGroup: u.target.ResourceGroup})
+}
+
+func (u *vpcWorkerUpdates) WorkerPoolWorkers(pool string) ([]string, error) {
+	workers, err := u.listWorkers(pool)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, worker := range workers {
+		if worker.LifeCycle.ActualState != workerDeleteState {
+			ids = append(ids, worker.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (u *vpcWorkerUpdates) RemoveWorkers(ctx context.Context, ids []string, timeout time.Duration) error {
+	for _, id := range ids {
+		err := u.poolClient.Workers().Delete(u.clusterID, id, v1.ClusterTargetHeader{ResourceGroup: u.target.ResourceGroup})
+		if err != nil {
+			return err
+		}
+	}
+	for _, id := range ids {
+		if err := u.waitForWorkerDeleted(ctx, id, timeout); err != nil {
+			return fmt.Errorf("Error waiting for worker %s to be removed: %s", id, err)
+		}
+	}
+	return nil
+}
+
+// waitForWorkerDeleted waits for the worker to be deleted
+func (u *vpcWorkerUpdates) waitForWorkerDeleted(ctx context.Context, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workerDeletePending},
+		Target:  []string{workerDeleteState},
+		Refresh: func() (interface{}, string, error) {
+			worker, err := u.client.Workers().Get(u.clusterID, id, u.target)
+			if err != nil || worker.LifeCycle.ActualState == workerDeleteState {
+				return worker, workerDeleteState, nil
+			}
+			return worker, workerDeletePending, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// waitForWorkers waits for the number of workers of the worker pool, all at the version of the master
+func (u *vpcWorkerUpdates) waitForWorkers(ctx context.Context, pool string, count int, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", workerProvisioning},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := u.listWorkers(pool)
+			if err != nil {
+				return nil, "retry", nil
+			}
+			active := 0
+			for _, worker := range workers {
+				if worker.LifeCycle.ActualState == workerDeleteState {
+					continue
+				}
+				active++
+				if worker.Health.State != workerNormal || workerKubeVersionOutdated(worker.KubeVersion.Actual, worker.KubeVersion.Target, u.masterVersion, u.patchVersion) {
+					return workers, workerProvisioning, nil
+				}
+			}
+			if count > 0 && active < count {
+				return workers, workerProvisioning, nil
+			}
+			return workers, workerNormal, nil
+		},
+		Timeout:                   timeout,
+		Delay:                     10 * time.Second,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func (u *vpcWorkerUpdates) WaitForWorkerPool(ctx context.Context, pool string, timeout time.Duration) error {
+	return u.waitForWorkers(ctx, pool, 0, timeout)
+}
+
+func (u *vpcWorkerUpdates) UpdateWorkers(ctx context.Context, workers []containerWorker, timeout time.Duration) []string {
+	if len(workers) == 0 {
+		return nil
+	}
+	pool := workers[0].Pool
+	current, err := u.listWorkers(pool)
+	if err != nil {
+		log.Printf("[ERROR] Error retrieving the workers of the worker pool %s: %s", pool, err)
+		return containerWorkerIDs(workers)
+	}
+	count := 0
+	for _, worker := range current {
+		if worker.LifeCycle.ActualState != workerDeleteState {
+			count++
+		}
+	}
+
+	failed := []string{}
+	replaced := []string{}
+	for _, worker := range workers {
+		_, err := u.client.Workers().ReplaceWokerNode(u.clusterID, worker.ID, u.target)
+		// As API returns http response 204 NO CONTENT, error raised will be exempted.
+		if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+			log.Printf("[ERROR] Error replacing worker %s: %s", worker.ID, err)
+			failed = append(failed, worker.ID)
+			count--
+			continue
+		}
+		replaced = append(replaced, worker.ID)
+	}
+
+	for _, id := range replaced {
+		if err := u.waitForWorkerDeleted(ctx, id, timeout); err != nil {
+			log.Printf("[ERROR] Error waiting for worker %s to be replaced: %s", id, err)
+			return append(failed, replaced...)
+		}
+	}
+
+	if err := u.waitForWorkers(ctx, pool, count, timeout); err != nil {
+		log.Printf("[ERROR] Error waiting for the replaced workers of the worker pool %s: %s", pool, err)
+		return append(failed, replaced...)
+	}
+	return failed
+}
+
+func containerWorkerIDs(workers []containerWorker) []string {
+	ids := make([]string, 0, len(workers))
+	for _, worker := range workers {
+		ids = append(ids, worker.ID)
+	}
+	return ids
+}
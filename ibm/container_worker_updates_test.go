@@ -0,0 +1,309 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+// fakeWorkerUpdates is an in-memory single zone cluster whose workers are updated immediately, except
+// for the failing workers. Shrinking a worker pool removes its first workers.
+type fakeWorkerUpdates struct {
+	workers     []containerWorker
+	updated     map[string]bool
+	failing     map[string]bool
+	poolSizes   map[string]int
+	poolWorkers map[string][]string
+	batches     [][]string
+	resizes     []string
+	removed     []string
+}
+
+func newFakeWorkerUpdates(workers ...containerWorker) *fakeWorkerUpdates {
+	f := &fakeWorkerUpdates{
+		workers:     workers,
+		updated:     map[string]bool{},
+		failing:     map[string]bool{},
+		poolSizes:   map[string]int{},
+		poolWorkers: map[string][]string{},
+	}
+	for _, worker := range workers {
+		f.poolWorkers[worker.Pool] = append(f.poolWorkers[worker.Pool], worker.ID)
+		f.poolSizes[worker.Pool]++
+	}
+	return f
+}
+
+func (f *fakeWorkerUpdates) TargetVersion() string {
+	return "1.20.4"
+}
+
+func (f *fakeWorkerUpdates) OutdatedWorkers() ([]containerWorker, error) {
+	outdated := []containerWorker{}
+	for _, worker := range f.workers {
+		if !f.updated[worker.ID] {
+			outdated = append(outdated, worker)
+		}
+	}
+	return outdated, nil
+}
+
+func (f *fakeWorkerUpdates) WorkerPoolSize(pool string) (int, error) {
+	return f.poolSizes[pool], nil
+}
+
+func (f *fakeWorkerUpdates) ResizeWorkerPool(pool string, size int) error {
+	f.resizes = append(f.resizes, fmt.Sprintf("%s=%d", pool, size))
+	f.poolSizes[pool] = size
+	workers := f.poolWorkers[pool]
+	for len(workers) < size {
+		workers = append(workers, fmt.Sprintf("%s-%d", pool, len(workers)+1))
+	}
+	if len(workers) > size {
+		f.removed = append(f.removed, workers[:len(workers)-size]...)
+		workers = workers[len(workers)-size:]
+	}
+	f.poolWorkers[pool] = workers
+	return nil
+}
+
+func (f *fakeWorkerUpdates) WorkerPoolWorkers(pool string) ([]string, error) {
+	return append([]string{}, f.poolWorkers[pool]...), nil
+}
+
+func (f *fakeWorkerUpdates) RemoveWorkers(ctx context.Context, ids []string, timeout time.Duration) error {
+	for _, id := range ids {
+		for pool, workers := range f.poolWorkers {
+			for i, worker := range workers {
+				if worker == id {
+					f.poolWorkers[pool] = append(workers[:i:i], workers[i+1:]...)
+					f.removed = append(f.removed, id)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (f *fakeWorkerUpdates) WaitForWorkerPool(ctx context.Context, pool string, timeout time.Duration) error {
+	return nil
+}
+
+func (f *fakeWorkerUpdates) UpdateWorkers(ctx context.Context, workers []containerWorker, timeout time.Duration) []string {
+	failed := []string{}
+	f.batches = append(f.batches, containerWorkerIDs(workers))
+	for _, worker := range workers {
+		if f.failing[worker.ID] {
+			failed = append(failed, worker.ID)
+			continue
+		}
+		f.updated[worker.ID] = true
+	}
+	return failed
+}
+
+func testWorkerUpdateResourceData(t *testing.T, strategy map[string]interface{}, progress *containerWorkerUpdateProgress) *schema.ResourceData {
+	r := resourceIBMContainerVpcWorkerPool()
+	raw := map[string]interface{}{}
+	if strategy != nil {
+		raw[workerUpdateStrategy] = []interface{}{strategy}
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	if progress == nil {
+		return d
+	}
+	d.SetId("cluster1/pool1")
+	d.Set(workerUpdateProgress, flattenWorkerUpdateProgress(*progress))
+	return r.Data(d.State())
+}
+
+func TestUpdateContainerWorkersDefaultStrategy(t *testing.T) {
+	updates := newFakeWorkerUpdates(
+		containerWorker{ID: "w1", Pool: "default"},
+		containerWorker{ID: "w2", Pool: "default"},
+	)
+	d := testWorkerUpdateResourceData(t, nil, nil)
+
+	err := updateContainerWorkers(context.Background(), d, updates, expandWorkerUpdateStrategy(d), time.Minute)
+	assert.NilError(t, err)
+	// The workers are updated one at a time without surge
+	assert.DeepEqual(t, [][]string{{"w1"}, {"w2"}}, updates.batches)
+	assert.Assert(t, is.Len(updates.resizes, 0))
+	assert.Equal(t, workerUpdateStatusCompleted, d.Get("worker_update_progress.0.status"))
+	assert.Equal(t, "1.20.4", d.Get("worker_update_progress.0.target_version"))
+	assert.Equal(t, 0, d.Get("worker_update_progress.0.pending_workers"))
+	assert.DeepEqual(t, []interface{}{"w1", "w2"}, d.Get("worker_update_progress.0.updated_workers"))
+}
+
+func TestUpdateContainerWorkersStrategy(t *testing.T) {
+	updates := newFakeWorkerUpdates(
+		containerWorker{ID: "a1", Pool: "alpha"},
+		containerWorker{ID: "b1", Pool: "beta"},
+		containerWorker{ID: "b2", Pool: "beta"},
+		containerWorker{ID: "b3", Pool: "beta"},
+		containerWorker{ID: "c1", Pool: "gamma"},
+	)
+	d := testWorkerUpdateResourceData(t, map[string]interface{}{
+		workerUpdateStrategyMaxSurge:    1,
+		workerUpdateStrategyMaxUnavail:  2,
+		workerUpdateStrategyPoolOrder:   []interface{}{"beta"},
+		workerUpdateStrategyPauseOnFail: true,
+	}, nil)
+
+	err := updateContainerWorkers(context.Background(), d, updates, expandWorkerUpdateStrategy(d), time.Minute)
+	assert.NilError(t, err)
+	// The pools of the order are updated first, the others in the order of their names, in batches
+	// of max_unavailable workers
+	assert.DeepEqual(t, [][]string{{"b1", "b2"}, {"b3"}, {"a1"}, {"c1"}}, updates.batches)
+	// Every pool is grown by max_surge during its update, then its surge workers are removed and its
+	// size is restored
+	assert.DeepEqual(t, []string{"beta=4", "beta=3", "alpha=2", "alpha=1", "gamma=2", "gamma=1"}, updates.resizes)
+	assert.DeepEqual(t, []string{"beta-4", "alpha-2", "gamma-2"}, updates.removed)
+	assert.DeepEqual(t, []string{"b1", "b2", "b3"}, updates.poolWorkers["beta"])
+	assert.Equal(t, workerUpdateStatusCompleted, d.Get("worker_update_progress.0.status"))
+}
+
+func TestUpdateContainerWorkersPauseOnFailure(t *testing.T) {
+	updates := newFakeWorkerUpdates(
+		containerWorker{ID: "w1", Pool: "default"},
+		containerWorker{ID: "w2", Pool: "default"},
+		containerWorker{ID: "w3", Pool: "default"},
+	)
+	updates.failing["w2"] = true
+	d := testWorkerUpdateResourceData(t, nil, nil)
+
+	err := updateContainerWorkers(context.Background(), d, updates, expandWorkerUpdateStrategy(d), time.Minute)
+	assert.Assert(t, err != nil)
+	// The update stops at the failing worker and is recorded as paused
+	assert.DeepEqual(t, [][]string{{"w1"}, {"w2"}}, updates.batches)
+	assert.Equal(t, workerUpdateStatusPaused, d.Get("worker_update_progress.0.status"))
+	assert.Equal(t, 1, d.Get("worker_update_progress.0.pending_workers"))
+	assert.DeepEqual(t, []interface{}{"w1"}, d.Get("worker_update_progress.0.updated_workers"))
+	assert.DeepEqual(t, []interface{}{"w2"}, d.Get("worker_update_progress.0.failed_workers"))
+}
+
+func TestUpdateContainerWorkersContinueOnFailure(t *testing.T) {
+	updates := newFakeWorkerUpdates(
+		containerWorker{ID: "w1", Pool: "default"},
+		containerWorker{ID: "w2", Pool: "default"},
+		containerWorker{ID: "w3", Pool: "default"},
+	)
+	updates.failing["w2"] = true
+	d := testWorkerUpdateResourceData(t, map[string]interface{}{
+		workerUpdateStrategyMaxUnavail:  1,
+		workerUpdateStrategyPauseOnFail: false,
+	}, nil)
+
+	err := updateContainerWorkers(context.Background(), d, updates, expandWorkerUpdateStrategy(d), time.Minute)
+	assert.Assert(t, err != nil)
+	// The other workers are updated before the failure is reported
+	assert.DeepEqual(t, [][]string{{"w1"}, {"w2"}, {"w3"}}, updates.batches)
+	assert.Equal(t, workerUpdateStatusPaused, d.Get("worker_update_progress.0.status"))
+	assert.DeepEqual(t, []interface{}{"w1", "w3"}, d.Get("worker_update_progress.0.updated_workers"))
+	assert.DeepEqual(t, []interface{}{"w2"}, d.Get("worker_update_progress.0.failed_workers"))
+}
+
+func TestUpdateContainerWorkersResume(t *testing.T) {
+	updates := newFakeWorkerUpdates(
+		containerWorker{ID: "w1", Pool: "default"},
+		containerWorker{ID: "w2", Pool: "default"},
+	)
+	updates.updated["w1"] = true
+	d := testWorkerUpdateResourceData(t, nil, &containerWorkerUpdateProgress{
+		Status:         workerUpdateStatusInProgress,
+		TargetVersion:  "1.20.4",
+		PendingWorkers: 1,
+		UpdatedWorkers: []string{"w1"},
+	})
+	assert.Assert(t, workerUpdateResumable(d))
+
+	err := updateContainerWorkers(context.Background(), d, updates, expandWorkerUpdateStrategy(d), time.Minute)
+	assert.NilError(t, err)
+	// Only the outdated worker is updated and the previous progress is kept
+	assert.DeepEqual(t, [][]string{{"w2"}}, updates.batches)
+	assert.Equal(t, workerUpdateStatusCompleted, d.Get("worker_update_progress.0.status"))
+	assert.DeepEqual(t, []interface{}{"w1", "w2"}, d.Get("worker_update_progress.0.updated_workers"))
+
+	// The progress of an update to another version is not kept
+	d = testWorkerUpdateResourceData(t, nil, &containerWorkerUpdateProgress{
+		Status:         workerUpdateStatusPaused,
+		TargetVersion:  "1.19.8",
+		UpdatedWorkers: []string{"w0"},
+	})
+	updates.updated["w2"] = false
+	err = updateContainerWorkers(context.Background(), d, updates, expandWorkerUpdateStrategy(d), time.Minute)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []interface{}{"w2"}, d.Get("worker_update_progress.0.updated_workers"))
+}
+
+func TestWorkerKubeVersionOutdated(t *testing.T) {
+	testcases := []struct {
+		worker, target, master, patch string
+		outdated                      bool
+	}{
+		{"1.19.8_1535", "1.19.8_1535", "1.19.8_1535", "", false},
+		{"1.19.8_1535", "1.20.4_1531", "1.20.4_1531", "", true},
+		{"1.20.2_1530", "1.20.4_1531", "1.20.4_1531", "", true},
+		{"1.20.4_1530", "1.20.4_1531", "1.20.4_1531", "", false},
+		{"1.20.4_1530", "1.20.4_1531", "1.20.4_1531", "4_1531", true},
+		{"1.20.4_1531", "1.20.4_1531", "1.20.4_1531", "4_1531", false},
+		{"1.20.4_1530", "1.20.4_1530", "1.20.4_1531", "4_1531", false},
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.outdated, workerKubeVersionOutdated(tc.worker, tc.target, tc.master, tc.patch), "%+v", tc)
+	}
+}
+
+func TestResourceIBMContainerVpcWorkerPoolCustomizeDiff(t *testing.T) {
+	r := resourceIBMContainerVpcWorkerPool()
+	config := map[string]interface{}{
+		"cluster":            "cluster1",
+		"flavor":             "bx2.4x16",
+		"worker_pool_name":   "pool1",
+		"vpc_id":             "vpc1",
+		"worker_count":       1,
+		"update_all_workers": true,
+		"zones": []interface{}{map[string]interface{}{
+			"name":      "us-south-1",
+			"subnet_id": "subnet1",
+		}},
+	}
+	state := func(outdated int, status string) *terraform.InstanceState {
+		d := schema.TestResourceDataRaw(t, r.Schema, config)
+		d.SetId("cluster1/pool1")
+		d.Set("outdated_workers", outdated)
+		if status != "" {
+			d.Set(workerUpdateProgress, flattenWorkerUpdateProgress(containerWorkerUpdateProgress{Status: status, TargetVersion: "1.20.4"}))
+		}
+		return d.State()
+	}
+
+	// The workers are up to date
+	diff, err := r.Diff(context.Background(), state(0, workerUpdateStatusCompleted), terraform.NewResourceConfigRaw(config), nil)
+	assert.NilError(t, err)
+	if diff != nil {
+		assert.Assert(t, is.Nil(diff.Attributes["outdated_workers"]))
+		assert.Assert(t, is.Nil(diff.Attributes["worker_update_progress.#"]))
+	}
+
+	// The outdated workers are updated
+	diff, err = r.Diff(context.Background(), state(2, workerUpdateStatusCompleted), terraform.NewResourceConfigRaw(config), nil)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil)
+	assert.Equal(t, "0", diff.Attributes["outdated_workers"].New)
+
+	// A paused update is resumed
+	diff, err = r.Diff(context.Background(), state(0, workerUpdateStatusPaused), terraform.NewResourceConfigRaw(config), nil)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil)
+	assert.Assert(t, diff.Attributes["worker_update_progress.#"].NewComputed)
+}
@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMAccount() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMAccountRead,
+		ReadContext: dataSourceIBMAccountRead,
 
 		Schema: map[string]*schema.Schema{
 			"org_guid": {
@@ -47,28 +49,28 @@ func dataSourceIBMAccount() *schema.Resource {
 	}
 }
 
-func dataSourceIBMAccountRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	bmxSess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	accClient, err := meta.(ClientSession).BluemixAcccountAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	orgGUID := d.Get("org_guid").(string)
 	account, err := accClient.Accounts().FindByOrg(orgGUID, bmxSess.Config.Region)
 	if err != nil {
-		return fmt.Errorf("Error retrieving organisation: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving organisation: %s", err))
 	}
 
 	accountv1Client, err := meta.(ClientSession).BluemixAcccountv1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	accountUsers, err := accountv1Client.Accounts().GetAccountUsers(account.GUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving users in account: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving users in account: %s", err))
 	}
 	accountUsersMap := make([]map[string]string, 0, len(accountUsers))
 	for _, user := range accountUsers {
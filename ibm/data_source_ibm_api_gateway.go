@@ -4,17 +4,19 @@
 package ibm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
 
 	apigatewaysdk "github.com/IBM/apigateway-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMApiGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMApiGatewayRead,
+		ReadContext: dataSourceIBMApiGatewayRead,
 		Schema: map[string]*schema.Schema{
 			"service_instance_crn": {
 				Type:        schema.TypeString,
@@ -110,14 +112,14 @@ func dataSourceIBMApiGateway() *schema.Resource {
 	}
 }
 
-func dataSourceIBMApiGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMApiGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	payload := &apigatewaysdk.GetAllEndpointsOptions{}
 	oauthtoken := sess.Config.IAMAccessToken
@@ -127,7 +129,7 @@ func dataSourceIBMApiGatewayRead(d *schema.ResourceData, meta interface{}) error
 	payload.ServiceInstanceCrn = &serviceInstanceCrn
 	allendpoints, response, err := endpointservice.GetAllEndpoints(payload)
 	if err != nil {
-		return fmt.Errorf("Error Getting All Endpoint: %s,%s", err, response)
+		return diagFromErr(fmt.Errorf("Error Getting All Endpoint: %s,%s", err, response))
 	}
 	endpointsMap := make([]map[string]interface{}, 0, len(*allendpoints))
 
@@ -141,7 +143,7 @@ func dataSourceIBMApiGatewayRead(d *schema.ResourceData, meta interface{}) error
 
 		swagger, err := endpointservice.GetEndpointSwagger(swaggerPayload)
 		if err != nil {
-			return fmt.Errorf("Error Getting All Endpoint: %s,%s", err, swagger)
+			return diagFromErr(fmt.Errorf("Error Getting All Endpoint: %s,%s", err, swagger))
 		}
 		doc := swagger.Result
 		str, err := json.Marshal(doc)
@@ -161,7 +163,7 @@ func dataSourceIBMApiGatewayRead(d *schema.ResourceData, meta interface{}) error
 		}
 		allsubscriptions, response, err := endpointservice.GetAllSubscriptions(SubscriptionPayload)
 		if err != nil {
-			return fmt.Errorf("Error Getting All Endpoint: %s %s", err, response)
+			return diagFromErr(fmt.Errorf("Error Getting All Endpoint: %s %s", err, response))
 		}
 		subscriptionMap := make([]map[string]interface{}, 0, len(*allsubscriptions))
 		for _, subscription := range *allsubscriptions {
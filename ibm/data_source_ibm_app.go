@@ -4,12 +4,15 @@
 package ibm
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMApp() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMAppRead,
+		ReadContext: dataSourceIBMAppRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -90,10 +93,10 @@ func dataSourceIBMApp() *schema.Resource {
 	}
 }
 
-func dataSourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	appAPI := cfClient.Apps()
 	name := d.Get("name").(string)
@@ -101,7 +104,7 @@ func dataSourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
 
 	app, err := appAPI.FindByName(spaceGUID, name)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(app.GUID)
 	d.Set("memory", app.Memory)
@@ -119,14 +122,14 @@ func dataSourceIBMAppRead(d *schema.ResourceData, meta interface{}) error {
 
 	route, err := appAPI.ListRoutes(app.GUID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if len(route) > 0 {
 		d.Set("route_guid", flattenRoute(route))
 	}
 	svcBindings, err := appAPI.ListServiceBindings(app.GUID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if len(svcBindings) > 0 {
 		d.Set("service_instance_guid", flattenServiceBindings(svcBindings))
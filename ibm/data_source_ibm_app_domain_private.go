@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMAppDomainPrivate() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMAppDomainPrivateRead,
+		ReadContext: dataSourceIBMAppDomainPrivateRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -23,15 +25,15 @@ func dataSourceIBMAppDomainPrivate() *schema.Resource {
 	}
 }
 
-func dataSourceIBMAppDomainPrivateRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMAppDomainPrivateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	domainName := d.Get("name").(string)
 	prdomain, err := cfAPI.PrivateDomains().FindByName(domainName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving domain: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving domain: %s", err))
 	}
 	d.SetId(prdomain.GUID)
 	return nil
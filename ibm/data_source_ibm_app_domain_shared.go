@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMAppDomainShared() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMAppDomainSharedRead,
+		ReadContext: dataSourceIBMAppDomainSharedRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -24,15 +26,15 @@ func dataSourceIBMAppDomainShared() *schema.Resource {
 	}
 }
 
-func dataSourceIBMAppDomainSharedRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMAppDomainSharedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	domainName := d.Get("name").(string)
 	shdomain, err := cfClient.SharedDomains().FindByName(domainName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving shared domain: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving shared domain: %s", err))
 	}
 	d.SetId(shdomain.GUID)
 	return nil
@@ -4,17 +4,19 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMAppRoute() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMAppRouteRead,
+		ReadContext: dataSourceIBMAppRouteRead,
 
 		Schema: map[string]*schema.Schema{
 			"space_guid": {
@@ -48,10 +50,10 @@ func dataSourceIBMAppRoute() *schema.Resource {
 	}
 }
 
-func dataSourceIBMAppRouteRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMAppRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	spaceAPI := cfClient.Spaces()
 	spaceGUID := d.Get("space_guid").(string)
@@ -74,14 +76,14 @@ func dataSourceIBMAppRouteRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	route, err := spaceAPI.ListRoutes(spaceGUID, params)
 	if err != nil {
-		return fmt.Errorf("Error retrieving route: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving route: %s", err))
 	}
 	if len(route) == 0 {
-		return fmt.Errorf("No route satifies the given parameters")
+		return diagFromErr(fmt.Errorf("No route satifies the given parameters"))
 	}
 
 	if len(route) > 1 {
-		return fmt.Errorf("More than one route satifies the given parameters")
+		return diagFromErr(fmt.Errorf("More than one route satifies the given parameters"))
 	}
 
 	d.SetId(route[0].GUID)
@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataIBMCertificateManagerCertificate() *schema.Resource {
 	return &schema.Resource{
-		Read: dataIBMCertificateManagerCertificateRead,
+		ReadContext: dataIBMCertificateManagerCertificateRead,
 		Schema: map[string]*schema.Schema{
 			"certificate_manager_instance_id": {
 				Type:     schema.TypeString,
@@ -91,17 +93,17 @@ func dataIBMCertificateManagerCertificate() *schema.Resource {
 		},
 	}
 }
-func dataIBMCertificateManagerCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func dataIBMCertificateManagerCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceID := d.Get("certificate_manager_instance_id").(string)
 	certName := d.Get("name").(string)
 
 	certificateList, err := cmService.Certificate().ListCertificates(instanceID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	record := make([]map[string]interface{}, 0)
 	for _, cert := range certificateList {
@@ -109,7 +111,7 @@ func dataIBMCertificateManagerCertificateRead(d *schema.ResourceData, meta inter
 			certificate := make(map[string]interface{})
 			certificatedata, err := cmService.Certificate().GetCertData(cert.ID)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			certificate["cert_id"] = certificatedata.ID
 			certificate["name"] = certificatedata.Name
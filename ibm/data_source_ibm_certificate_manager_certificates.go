@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataIBMCertificateManagerCertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataIBMCertificateManagerCertificatesRead,
+		ReadContext: dataIBMCertificateManagerCertificatesRead,
 		Schema: map[string]*schema.Schema{
 			"certificate_manager_instance_id": {
 				Type:        schema.TypeString,
@@ -83,15 +85,15 @@ func dataIBMCertificateManagerCertificates() *schema.Resource {
 		},
 	}
 }
-func dataIBMCertificateManagerCertificatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataIBMCertificateManagerCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceID := d.Get("certificate_manager_instance_id").(string)
 	result, err := cmService.Certificate().ListCertificates(instanceID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	record := make([]map[string]interface{}, len(result))
 	for i, c := range result {
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 
@@ -11,12 +12,13 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev1/controller"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMCISInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISInstanceRead,
+		ReadContext: dataSourceIBMCISInstanceRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -92,10 +94,10 @@ func dataSourceIBMCISInstance() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsAPI := rsConClient.ResourceServiceInstance()
 	name := d.Get("name").(string)
@@ -109,14 +111,14 @@ func dataSourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		rsInstQuery.ResourceGroupID = defaultRg
 	}
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
@@ -124,7 +126,7 @@ func dataSourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) erro
 
 		serviceOff, err := rsCatRepo.FindByName(service.(string), true)
 		if err != nil {
-			return fmt.Errorf("Error retrieving service offering: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 		}
 
 		rsInstQuery.ServiceID = serviceOff[0].ID
@@ -134,7 +136,7 @@ func dataSourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) erro
 
 	instances, err = rsAPI.ListInstances(rsInstQuery)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var filteredInstances []models.ServiceInstance
 	var location string
@@ -151,14 +153,14 @@ func dataSourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if len(filteredInstances) == 0 {
-		return fmt.Errorf("No resource instance found with name [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or service", name)
+		return diagFromErr(fmt.Errorf("No resource instance found with name [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or service", name))
 	}
 
 	var instance models.ServiceInstance
 
 	if len(filteredInstances) > 1 {
-		return fmt.Errorf(
-			"More than one resource instance found with name matching [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or service", name)
+		return diagFromErr(fmt.Errorf(
+			"More than one resource instance found with name matching [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or service", name))
 	}
 	instance = filteredInstances[0]
 
@@ -169,14 +171,14 @@ func dataSourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("guid", instance.Guid)
 	serviceOff, err := rsCatRepo.GetServiceName(instance.ServiceID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	d.Set("service", serviceOff)
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(instance.ServicePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 
@@ -187,7 +189,7 @@ func dataSourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) erro
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, rcontroller+"/internet-svcs/"+url.QueryEscape(instance.Crn.String()))
 
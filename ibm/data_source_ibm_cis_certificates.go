@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,7 +28,7 @@ const (
 
 func dataIBMCISCertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataIBMCISCertificatesRead,
+		ReadContext: dataIBMCISCertificatesRead,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -107,10 +109,10 @@ func dataIBMCISCertificates() *schema.Resource {
 		},
 	}
 }
-func dataIBMCISCertificatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataIBMCISCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -120,7 +122,7 @@ func dataIBMCISCertificatesRead(d *schema.ResourceData, meta interface{}) error
 	result, response, err := cisClient.ListCertificates(opt)
 	if err != nil {
 		log.Printf("List all certificates failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	certificatesList := make([]interface{}, 0)
 	for _, instance := range result.Result {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,7 +19,7 @@ const (
 
 func dataSourceIBMCISCustomCertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISCustomCertificatesRead,
+		ReadContext: dataSourceIBMCISCustomCertificatesRead,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:     schema.TypeString,
@@ -96,10 +98,10 @@ func dataSourceIBMCISCustomCertificates() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISCustomCertificatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISCustomCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -108,7 +110,7 @@ func dataSourceIBMCISCustomCertificatesRead(d *schema.ResourceData, meta interfa
 	opt := cisClient.NewListCustomCertificatesOptions()
 	result, resp, err := cisClient.ListCustomCertificates(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to list custom certificates: %v", resp)
+		return diagFromErr(fmt.Errorf("Failed to list custom certificates: %v", resp))
 	}
 	certsList := make([]map[string]interface{}, 0)
 	for _, r := range result.Result {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,8 +19,8 @@ const (
 
 func dataSourceIBMCISCustomPages() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataSourceIBMCISCustomPagesRead,
-		Importer: &schema.ResourceImporter{},
+		ReadContext: dataSourceIBMCISCustomPagesRead,
+		Importer:    &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
@@ -87,10 +89,10 @@ func dataSourceIBMCISCustomPages() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISCustomPagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISCustomPagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisCustomPageClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID := d.Get(cisDomainID).(string)
@@ -101,7 +103,7 @@ func dataSourceIBMCISCustomPagesRead(d *schema.ResourceData, meta interface{}) e
 	result, response, err := cisClient.ListZoneCustomPages(opt)
 	if err != nil {
 		log.Printf("List custom pages failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	customPagesOutput := make([]map[string]interface{}, 0)
 	for _, instance := range result.Result {
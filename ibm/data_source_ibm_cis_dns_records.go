@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,8 +23,8 @@ const (
 
 func dataSourceIBMCISDNSRecords() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataSourceIBMCISDNSRecordsRead,
-		Importer: &schema.ResourceImporter{},
+		ReadContext: dataSourceIBMCISDNSRecordsRead,
+		Importer:    &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Read: schema.DefaultTimeout(10 * time.Minute),
@@ -123,7 +125,7 @@ func dataSourceIBMCISDNSRecords() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISDNSRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		crn     string
 		zoneID  string
@@ -131,7 +133,7 @@ func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) er
 	)
 	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// session options
@@ -143,7 +145,7 @@ func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) er
 	if file, ok := d.GetOk(cisDNSRecordsExportFile); ok {
 		sess, err := meta.(ClientSession).CisDNSRecordBulkClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		sess.Crn = core.StringPtr(crn)
 		sess.ZoneIdentifier = core.StringPtr(zoneID)
@@ -151,18 +153,18 @@ func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) er
 		result, response, err := sess.GetDnsRecordsBulk(opt)
 		if err != nil {
 			log.Printf("Error exporting dns records: %s", response)
-			return err
+			return diagFromErr(err)
 		}
 		buf, err := ioutil.ReadAll(result)
 		if err != nil {
 			log.Printf("Error while reading io reader")
-			return err
+			return diagFromErr(err)
 		}
 
 		f, err := os.Create(file.(string))
 		if err != nil {
 			log.Printf("Error opening file: %v", err)
-			return err
+			return diagFromErr(err)
 		}
 		defer f.Close()
 		f.Write(buf)
@@ -175,7 +177,7 @@ func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) er
 	result, response, err := sess.ListAllDnsRecords(opt)
 	if err != nil {
 		log.Printf("Error reading dns records: %s", response)
-		return err
+		return diagFromErr(err)
 	}
 
 	records = make([]map[string]interface{}, 0)
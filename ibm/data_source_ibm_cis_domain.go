@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMCISDomain() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISDomainRead,
+		ReadContext: dataSourceIBMCISDomainRead,
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -52,11 +54,11 @@ func dataSourceIBMCISDomain() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISDomainRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var zoneFound bool
 	cisClient, err := meta.(ClientSession).CisZonesV1ClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -69,7 +71,7 @@ func dataSourceIBMCISDomainRead(d *schema.ResourceData, meta interface{}) error
 	zones, resp, err := cisClient.ListZones(opt)
 	if err != nil {
 		log.Printf("dataSourcCISdomainRead - ListZones Failed %s\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	for _, zone := range zones.Result {
@@ -87,7 +89,7 @@ func dataSourceIBMCISDomainRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if zoneFound == false {
-		return fmt.Errorf("Given zone does not exist. Please specify correct domain")
+		return diagFromErr(fmt.Errorf("Given zone does not exist. Please specify correct domain"))
 	}
 
 	return nil
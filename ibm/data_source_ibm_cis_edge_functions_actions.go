@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,7 +28,7 @@ const (
 
 func dataSourceIBMCISEdgeFunctionsActions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISEdgeFunctionsActionsRead,
+		ReadContext: dataSourceIBMCISEdgeFunctionsActionsRead,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -102,10 +104,10 @@ func dataSourceIBMCISEdgeFunctionsActions() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISEdgeFunctionsActionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISEdgeFunctionsActionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -115,7 +117,7 @@ func dataSourceIBMCISEdgeFunctionsActionsRead(d *schema.ResourceData, meta inter
 	opt := cisClient.NewListEdgeFunctionsActionsOptions()
 	result, _, err := cisClient.ListEdgeFunctionsActions(opt)
 	if err != nil {
-		return fmt.Errorf("Error: %v", err)
+		return diagFromErr(fmt.Errorf("Error: %v", err))
 	}
 	scriptInfo := make([]map[string]interface{}, 0)
 	for _, script := range result.Result {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,7 +17,7 @@ const cisEdgeFunctionsTriggers = "cis_edge_functions_triggers"
 
 func dataSourceIBMCISEdgeFunctionsTriggers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISEdgeFunctionsTriggerRead,
+		ReadContext: dataSourceIBMCISEdgeFunctionsTriggerRead,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -66,10 +68,10 @@ func dataSourceIBMCISEdgeFunctionsTriggers() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISEdgeFunctionsTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -79,7 +81,7 @@ func dataSourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta inter
 	opt := cisClient.NewListEdgeFunctionsTriggersOptions()
 	result, _, err := cisClient.ListEdgeFunctionsTriggers(opt)
 	if err != nil {
-		return fmt.Errorf("Error listing edge functions triggers: %v", err)
+		return diagFromErr(fmt.Errorf("Error listing edge functions triggers: %v", err))
 	}
 	triggerInfo := make([]map[string]interface{}, 0)
 	for _, trigger := range result.Result {
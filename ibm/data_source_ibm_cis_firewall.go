@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataIBMCISFirewallsRecord() *schema.Resource {
 	return &schema.Resource{
-		Read: dataIBMCISFirewallRecordRead,
+		ReadContext: dataIBMCISFirewallRecordRead,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -176,7 +178,7 @@ func dataIBMCISFirewallsRecord() *schema.Resource {
 		},
 	}
 }
-func dataIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) error {
+func dataIBMCISFirewallRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
 	firewallType := d.Get(cisFirewallType).(string)
@@ -184,7 +186,7 @@ func dataIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) erro
 	if firewallType == cisFirewallTypeLockdowns {
 		cisClient, err := meta.(ClientSession).CisLockdownClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -192,7 +194,7 @@ func dataIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) erro
 		result, response, err := cisClient.ListAllZoneLockownRules(opt)
 		if err != nil {
 			log.Printf("List all zone lockdown rules failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 		lockdownList := make([]map[string]interface{}, 0)
 		for _, instance := range result.Result {
@@ -220,7 +222,7 @@ func dataIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) erro
 	} else if firewallType == cisFirewallTypeAccessRules {
 		cisClient, err := meta.(ClientSession).CisAccessRuleClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -228,7 +230,7 @@ func dataIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) erro
 		result, response, err := cisClient.ListAllZoneAccessRules(opt)
 		if err != nil {
 			log.Printf("List all zone access rules failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 		accessRuleList := make([]interface{}, 0)
 		for _, instance := range result.Result {
@@ -248,7 +250,7 @@ func dataIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) erro
 	} else if firewallType == cisFirewallTypeUARules {
 		cisClient, err := meta.(ClientSession).CisUARuleClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -256,7 +258,7 @@ func dataIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) erro
 		result, response, err := cisClient.ListAllZoneUserAgentRules(opt)
 		if err != nil {
 			log.Printf("List all zone ua rules failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 		uaRuleList := make([]interface{}, 0)
 		for _, instance := range result.Result {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -143,15 +145,15 @@ func dataSourceIBMCISGlbs() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Read: schema.DefaultTimeout(10 * time.Minute),
 		},
-		Read:     dataSourceCISGlbsRead,
-		Importer: &schema.ResourceImporter{},
+		ReadContext: dataSourceCISGlbsRead,
+		Importer:    &schema.ResourceImporter{},
 	}
 }
 
-func dataSourceCISGlbsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceCISGlbsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -164,7 +166,7 @@ func dataSourceCISGlbsRead(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.ListAllLoadBalancers(opt)
 	if err != nil {
 		log.Printf("[WARN] List all GLB failed: %v\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 	glbs := result.Result
 
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,8 +19,8 @@ const (
 
 func dataSourceIBMCISHealthChecks() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataSourceIBMCISGLBHealthCheckRead,
-		Importer: &schema.ResourceImporter{},
+		ReadContext: dataSourceIBMCISGLBHealthCheckRead,
+		Importer:    &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Read: schema.DefaultTimeout(10 * time.Minute),
@@ -144,10 +146,10 @@ func dataSourceIBMCISHealthChecks() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISGLBHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISGLBHealthCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -158,7 +160,7 @@ func dataSourceIBMCISGLBHealthCheckRead(d *schema.ResourceData, meta interface{}
 	result, resp, err := sess.ListAllLoadBalancerMonitors(opt)
 	if err != nil {
 		log.Printf("Error listing global load balancer health check detail: %s", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	monitors := make([]map[string]interface{}, 0)
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,7 +19,7 @@ const (
 
 func dataSourceIBMCISIP() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISIPRead,
+		ReadContext: dataSourceIBMCISIPRead,
 
 		Schema: map[string]*schema.Schema{
 			cisIPv4CIDRs: {
@@ -34,16 +36,16 @@ func dataSourceIBMCISIP() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISIPRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisIPClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	opt := cisClient.NewListIpsOptions()
 	result, response, err := cisClient.ListIps(opt)
 	if err != nil {
 		log.Printf("Failed to list IP addresses: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set(cisIPv4CIDRs, flattenStringList(result.Result.Ipv4Cidrs))
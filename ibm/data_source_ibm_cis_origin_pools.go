@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,8 +19,8 @@ const (
 
 func dataSourceIBMCISOriginPools() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataSourceIBMCISGLBPoolsRead,
-		Importer: &schema.ResourceImporter{},
+		ReadContext: dataSourceIBMCISGLBPoolsRead,
+		Importer:    &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Read: schema.DefaultTimeout(10 * time.Minute),
@@ -142,10 +144,10 @@ func dataSourceIBMCISOriginPools() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISGLBPoolsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISGLBPoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -155,7 +157,7 @@ func dataSourceIBMCISGLBPoolsRead(d *schema.ResourceData, meta interface{}) erro
 	result, resp, err := cisClient.ListAllLoadBalancerPools(opt)
 	if err != nil {
 		log.Printf("Error listing global load balancer pools detail: %s", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	pools := make([]map[string]interface{}, 0)
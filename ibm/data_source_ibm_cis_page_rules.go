@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,8 +19,8 @@ const (
 
 func dataSourceIBMCISPageRules() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataSourceIBMCISPageRulesRead,
-		Importer: &schema.ResourceImporter{},
+		ReadContext: dataSourceIBMCISPageRulesRead,
+		Importer:    &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Read: schema.DefaultTimeout(10 * time.Minute),
@@ -129,10 +131,10 @@ func dataSourceIBMCISPageRules() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISPageRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISPageRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -145,7 +147,7 @@ func dataSourceIBMCISPageRulesRead(d *schema.ResourceData, meta interface{}) err
 	result, resp, err := sess.ListPageRules(opt)
 	if err != nil {
 		log.Printf("Error listing page rules detail: %s", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	pageRules := make([]map[string]interface{}, 0)
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,7 +17,7 @@ const cisRangeApps = "range_apps"
 
 func dataSourceIBMCISRangeApps() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISRangeAppsRead,
+		ReadContext: dataSourceIBMCISRangeAppsRead,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -122,10 +124,10 @@ func dataSourceIBMCISRangeApps() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISRangeAppsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISRangeAppsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -134,7 +136,7 @@ func dataSourceIBMCISRangeAppsRead(d *schema.ResourceData, meta interface{}) err
 	opt := cisClient.NewListRangeAppsOptions()
 	result, resp, err := cisClient.ListRangeApps(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to list range applications: %v", resp)
+		return diagFromErr(fmt.Errorf("Failed to list range applications: %v", resp))
 	}
 	apps := make([]map[string]interface{}, 0)
 	for _, i := range result.Result {
@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMCISRateLimit() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISRateLimitRead,
+		ReadContext: dataSourceIBMCISRateLimitRead,
 		Schema: map[string]*schema.Schema{
 			"cis_id": {
 				Type:     schema.TypeString,
@@ -182,22 +184,22 @@ func dataSourceIBMCISRateLimit() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISRateLimitRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISRateLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisID := d.Get("cis_id").(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get("domain_id").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewListAllZoneRateLimitsOptions()
 	rateLimitRecord, resp, err := cisClient.ListAllZoneRateLimits(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to read RateLimit: %v", resp)
+		return diagFromErr(fmt.Errorf("Failed to read RateLimit: %v", resp))
 	}
 	rules := make([]map[string]interface{}, 0)
 	for _, r := range rateLimitRecord.Result {
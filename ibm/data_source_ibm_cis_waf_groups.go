@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,7 +17,7 @@ const cisWAFGroups = "waf_groups"
 
 func dataSourceIBMCISWAFGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISWAFGroupsRead,
+		ReadContext: dataSourceIBMCISWAFGroupsRead,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -80,10 +82,10 @@ func dataSourceIBMCISWAFGroups() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISWAFGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISWAFGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFGroupClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -98,7 +100,7 @@ func dataSourceIBMCISWAFGroupsRead(d *schema.ResourceData, meta interface{}) err
 	result, resp, err := cisClient.ListWafRuleGroups(opt)
 	if err != nil {
 		log.Printf("List waf rule groups failed: %s\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 	wafGroups := []interface{}{}
 	for _, i := range result.Result {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,7 +19,7 @@ const (
 
 func dataSourceIBMCISWAFPackages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISWAFPackagesRead,
+		ReadContext: dataSourceIBMCISWAFPackagesRead,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -68,10 +70,10 @@ func dataSourceIBMCISWAFPackages() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISWAFPackagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISWAFPackagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFPackageClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -83,7 +85,7 @@ func dataSourceIBMCISWAFPackagesRead(d *schema.ResourceData, meta interface{}) e
 	result, resp, err := cisClient.ListWafPackages(opt)
 	if err != nil {
 		log.Printf("Error listing waf packages detail: %s", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	packages := make([]interface{}, 0)
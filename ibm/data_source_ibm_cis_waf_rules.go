@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,7 +17,7 @@ const cisWAFRules = "waf_rules"
 
 func dataSourceIBMCISWAFRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCISWAFRuleRead,
+		ReadContext: dataSourceIBMCISWAFRuleRead,
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -104,10 +106,10 @@ func dataSourceIBMCISWAFRules() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCISWAFRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFRuleClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -122,7 +124,7 @@ func dataSourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error
 	result, response, err := cisClient.ListWafRules(opt)
 	if err != nil {
 		log.Printf("List waf rules failed %s\n", response)
-		return err
+		return diagFromErr(err)
 	}
 	rules := []interface{}{}
 	for _, i := range result.Result {
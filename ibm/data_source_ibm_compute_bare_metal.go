@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -33,7 +35,7 @@ const (
 
 func dataSourceIBMComputeBareMetal() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMComputeBareMetalRead,
+		ReadContext: dataSourceIBMComputeBareMetalRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -241,7 +243,7 @@ func dataSourceIBMComputeBareMetal() *schema.Resource {
 	}
 }
 
-func dataSourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMComputeBareMetalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -272,10 +274,10 @@ func dataSourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{})
 			BareMetalMask).GetHardware()
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving bare metal server details for %s: %s", globalIdentifier, err)
+			return diagFromErr(fmt.Errorf("Error retrieving bare metal server details for %s: %s", globalIdentifier, err))
 		}
 		if len(bms) == 0 {
-			return fmt.Errorf("No bare metal server found with identifier %s", globalIdentifier)
+			return diagFromErr(fmt.Errorf("No bare metal server found with identifier %s", globalIdentifier))
 		}
 
 	} else {
@@ -285,10 +287,10 @@ func dataSourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{})
 			BareMetalMask).GetHardware()
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving bare metal server for host %s: %s", hostname, err)
+			return diagFromErr(fmt.Errorf("Error retrieving bare metal server for host %s: %s", hostname, err))
 		}
 		if len(bms) == 0 {
-			return fmt.Errorf("No bare metal server with hostname %s and domain  %s", hostname, domain)
+			return diagFromErr(fmt.Errorf("No bare metal server with hostname %s and domain  %s", hostname, domain))
 		}
 
 	}
@@ -299,10 +301,10 @@ func dataSourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{})
 		if mostRecent {
 			bm = mostRecentBareMetal(bms)
 		} else {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"More than one bare metals found with host matching [%s] and domain "+
 					"matching [%s]. Set 'most_recent' to true in your configuration to force the most recent bare metal "+
-					"to be used", hostname, domain)
+					"to be used", hostname, domain))
 		}
 	} else {
 		bm = bms[0]
@@ -367,7 +369,7 @@ func dataSourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{})
 	).Id(*bm.Id).GetBackendNetworkComponents()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving bare metal server network: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving bare metal server network: %s", err))
 	}
 
 	if len(backendNetworkComponent) > 2 && bm.PrimaryBackendNetworkComponent != nil {
@@ -417,7 +419,7 @@ func dataSourceIBMComputeBareMetalRead(d *schema.ResourceData, meta interface{})
 	}
 	err = readSecondaryIPAddresses(d, meta, bm.PrimaryIpAddress)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/filter"
 	"github.com/softlayer/softlayer-go/services"
@@ -13,7 +15,7 @@ import (
 
 func dataSourceIBMComputeImageTemplate() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMComputeImageTemplateRead,
+		ReadContext: dataSourceIBMComputeImageTemplateRead,
 
 		// TODO: based on need add properties for visibility, type of image,
 		// notes, size, shared on accounts if needed
@@ -33,7 +35,7 @@ func dataSourceIBMComputeImageTemplate() *schema.Resource {
 	}
 }
 
-func dataSourceIBMComputeImageTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMComputeImageTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -43,7 +45,7 @@ func dataSourceIBMComputeImageTemplateRead(d *schema.ResourceData, meta interfac
 		Mask("id,name").
 		GetBlockDeviceTemplateGroups()
 	if err != nil {
-		return fmt.Errorf("Error looking up image template [%s]: %s", name, err)
+		return diagFromErr(fmt.Errorf("Error looking up image template [%s]: %s", name, err))
 	}
 
 	for _, imageTemplate := range imageTemplates {
@@ -61,7 +63,7 @@ func dataSourceIBMComputeImageTemplateRead(d *schema.ResourceData, meta interfac
 		Filter(filter.Path("name").Eq(name).Build()).
 		GetPublicImages()
 	if err != nil {
-		return fmt.Errorf("Error looking up image template [%s] among the public images: %s", name, err)
+		return diagFromErr(fmt.Errorf("Error looking up image template [%s] among the public images: %s", name, err))
 	}
 
 	if len(pubImageTemplates) > 0 {
@@ -70,5 +72,5 @@ func dataSourceIBMComputeImageTemplateRead(d *schema.ResourceData, meta interfac
 		return nil
 	}
 
-	return fmt.Errorf("Could not find image template with name [%s]", name)
+	return diagFromErr(fmt.Errorf("Could not find image template with name [%s]", name))
 }
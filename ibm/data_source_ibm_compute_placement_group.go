@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -16,7 +18,7 @@ import (
 
 func dataSourceIBMComputePlacementGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMComputePlacementGroupRead,
+		ReadContext: dataSourceIBMComputePlacementGroupRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -63,7 +65,7 @@ func dataSourceIBMComputePlacementGroup() *schema.Resource {
 	}
 }
 
-func dataSourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMComputePlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -74,7 +76,7 @@ func dataSourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interfa
 		Mask("id,name,rule[name],guests[id,domain,hostname],backendRouter[hostname,datacenter[name]]").GetPlacementGroups()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving placement group: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving placement group: %s", err))
 	}
 
 	grps := []datatypes.Virtual_PlacementGroup{}
@@ -86,7 +88,7 @@ func dataSourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interfa
 	}
 
 	if len(grps) == 0 {
-		return fmt.Errorf("No placement group found with name [%s]", name)
+		return diagFromErr(fmt.Errorf("No placement group found with name [%s]", name))
 	}
 
 	var grp datatypes.Virtual_PlacementGroup
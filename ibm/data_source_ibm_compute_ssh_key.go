@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -16,7 +18,7 @@ import (
 
 func dataSourceIBMComputeSSHKey() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMComputeSSHKeyRead,
+		ReadContext: dataSourceIBMComputeSSHKeyRead,
 
 		Schema: map[string]*schema.Schema{
 			"label": &schema.Schema{
@@ -54,7 +56,7 @@ func dataSourceIBMComputeSSHKey() *schema.Resource {
 	}
 }
 
-func dataSourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMComputeSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -67,10 +69,10 @@ func dataSourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) er
 		GetSshKeys()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving SSH key: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving SSH key: %s", err))
 	}
 	if len(keys) == 0 {
-		return fmt.Errorf("No ssh key found with name [%s]", label)
+		return diagFromErr(fmt.Errorf("No ssh key found with name [%s]", label))
 	}
 
 	var key datatypes.Security_Ssh_Key
@@ -78,11 +80,11 @@ func dataSourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) er
 		if mostRecent {
 			key = mostRecentSSHKey(keys)
 		} else {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"More than one ssh key found with label matching [%s]. "+
 					"Either set 'most_recent' to true in your "+
 					"configuration to force the most recent ssh key "+
-					"to be used, or ensure that the label is unique", label)
+					"to be used, or ensure that the label is unique", label))
 		}
 	} else {
 		key = keys[0]
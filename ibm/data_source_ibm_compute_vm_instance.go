@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -15,7 +17,7 @@ import (
 
 func dataSourceIBMComputeVmInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMComputeVmInstanceRead,
+		ReadContext: dataSourceIBMComputeVmInstanceRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -141,7 +143,7 @@ func dataSourceIBMComputeVmInstance() *schema.Resource {
 	}
 }
 
-func dataSourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMComputeVmInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -159,10 +161,10 @@ func dataSourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}
 	).GetVirtualGuests()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving virtual guest details for host %s: %s", hostname, err)
+		return diagFromErr(fmt.Errorf("Error retrieving virtual guest details for host %s: %s", hostname, err))
 	}
 	if len(vgs) == 0 {
-		return fmt.Errorf("No virtual guest with hostname %s and domain  %s", hostname, domain)
+		return diagFromErr(fmt.Errorf("No virtual guest with hostname %s and domain  %s", hostname, domain))
 	}
 
 	var vg datatypes.Virtual_Guest
@@ -170,10 +172,10 @@ func dataSourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}
 		if mostRecent {
 			vg = mostRecentVirtualGuest(vgs)
 		} else {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"More than one virtual guest found with host matching [%s] and domain "+
 					"matching [%s]. Set 'most_recent' to true in your configuration to force the most recent virtual guest "+
-					"to be used", hostname, domain)
+					"to be used", hostname, domain))
 		}
 	} else {
 		vg = vgs[0]
@@ -228,7 +230,7 @@ func dataSourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}
 
 	err = readSecondaryIPAddresses(d, meta, vg.PrimaryIpAddress)
 	if err != nil {
-		return fmt.Errorf("Error retrieving virtual guest details for host %s: %s", hostname, err)
+		return diagFromErr(fmt.Errorf("Error retrieving virtual guest details for host %s: %s", hostname, err))
 	}
 	return nil
 }
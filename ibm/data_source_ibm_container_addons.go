@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
@@ -13,7 +15,7 @@ import (
 
 func datasourceIBMContainerAddOns() *schema.Resource {
 	return &schema.Resource{
-		Read: datasourceIBMContainerAddOnsRead,
+		ReadContext: datasourceIBMContainerAddOnsRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster": {
@@ -94,22 +96,22 @@ func datasourceIBMContainerAddOns() *schema.Resource {
 		},
 	}
 }
-func datasourceIBMContainerAddOnsRead(d *schema.ResourceData, meta interface{}) error {
+func datasourceIBMContainerAddOnsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cluster := d.Get("cluster").(string)
 
 	result, err := addOnAPI.GetAddons(cluster, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set("cluster", cluster)
 	addOns, err := flattenAddOnsList(result)
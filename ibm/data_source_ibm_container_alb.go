@@ -4,12 +4,15 @@
 package ibm
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMContainerALB() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerALBRead,
+		ReadContext: dataSourceIBMContainerALBRead,
 
 		Schema: map[string]*schema.Schema{
 			"alb_id": {
@@ -56,10 +59,10 @@ func dataSourceIBMContainerALB() *schema.Resource {
 	}
 }
 
-func dataSourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerALBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	albID := d.Get("alb_id").(string)
@@ -67,11 +70,11 @@ func dataSourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) err
 	albAPI := albClient.Albs()
 	targetEnv, err := getAlbTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	albConfig, err := albAPI.GetALB(albID, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(albID)
@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMContainerALBCert() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerALBCertRead,
+		ReadContext: dataSourceIBMContainerALBCertRead,
 
 		Schema: map[string]*schema.Schema{
 			"cert_crn": {
@@ -77,10 +79,10 @@ func dataSourceIBMContainerALBCert() *schema.Resource {
 	}
 }
 
-func dataSourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerALBCertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	clusterID := d.Get("cluster_id").(string)
@@ -90,7 +92,7 @@ func dataSourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{})
 	ingressAPI := ingressClient.Ingresses()
 	ingressSecretConfig, err := ingressAPI.GetIngressSecret(clusterID, secretName, namespace)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set("cluster_id", ingressSecretConfig.Cluster)
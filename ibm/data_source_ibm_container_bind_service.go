@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMContainerBindService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerBindServiceRead,
+		ReadContext: dataSourceIBMContainerBindServiceRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name_id": {
@@ -47,10 +49,10 @@ func dataSourceIBMContainerBindService() *schema.Resource {
 	}
 }
 
-func dataSourceIBMContainerBindServiceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerBindServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	clusterNameID := d.Get("cluster_name_id").(string)
@@ -61,17 +63,17 @@ func dataSourceIBMContainerBindServiceRead(d *schema.ResourceData, meta interfac
 	} else if serviceInstanceID, ok := d.GetOk("service_instance_id"); ok {
 		serviceInstanceNameID = serviceInstanceID.(string)
 	} else {
-		return fmt.Errorf("Please set either service_instance_name or service_instance_id")
+		return diagFromErr(fmt.Errorf("Please set either service_instance_name or service_instance_id"))
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	boundService, err := csClient.Clusters().FindServiceBoundToCluster(clusterNameID, serviceInstanceNameID, namespaceID, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set("namespace_id", boundService.Namespace)
 	d.Set("service_instance_name", boundService.ServiceName)
@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMContainerCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerClusterRead,
+		ReadContext: dataSourceIBMContainerClusterRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name_id": {
@@ -341,10 +343,10 @@ func dataSourceIBMContainerCluster() *schema.Resource {
 	}
 }
 
-func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	csAPI := csClient.Clusters()
 	wrkAPI := csClient.Workers()
@@ -353,7 +355,7 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var name string
@@ -366,11 +368,11 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 	}
 	clusterFields, err := csAPI.Find(name, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving cluster: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving cluster: %s", err))
 	}
 	workerFields, err := wrkAPI.List(name, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving workers for cluster: %s", err))
 	}
 	workers := make([]string, len(workerFields))
 	for i, worker := range workerFields {
@@ -382,7 +384,7 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 	if listBoundedServices {
 		servicesBoundToCluster, err := csAPI.ListServicesBoundToCluster(name, "", targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving services bound to cluster: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving services bound to cluster: %s", err))
 		}
 		for _, service := range servicesBoundToCluster {
 			boundedService := make(map[string]interface{})
@@ -396,12 +398,12 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 
 	workerPools, err := workerPoolsAPI.ListWorkerPools(name, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving worker pools of the cluster %s: %s", name, err)
+		return diagFromErr(fmt.Errorf("Error retrieving worker pools of the cluster %s: %s", name, err))
 	}
 
 	albs, err := albsAPI.ListClusterALBs(name, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "The specified cluster is a lite cluster.") && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") && !strings.Contains(err.Error(), "The specified cluster is a free cluster.") {
-		return fmt.Errorf("Error retrieving alb's of the cluster %s: %s", name, err)
+		return diagFromErr(fmt.Errorf("Error retrieving alb's of the cluster %s: %s", name, err))
 	}
 
 	filterType := d.Get("alb_type").(string)
@@ -428,13 +430,13 @@ func dataSourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{})
 
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 	apikeyAPI := csClient.Apikeys()
 	apikeyConfig, err := apikeyAPI.GetApiKeyInfo(name, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set("api_key_id", apikeyConfig.ID)
 	d.Set("api_key_owner_name", apikeyConfig.Name)
@@ -4,10 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"path/filepath"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 
@@ -17,7 +20,7 @@ import (
 
 func dataSourceIBMContainerClusterConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerClusterConfigRead,
+		ReadContext: dataSourceIBMContainerClusterConfigRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -56,10 +59,18 @@ func dataSourceIBMContainerClusterConfig() *schema.Resource {
 				Required:    true,
 			},
 			"config_dir": {
-				Description: "The directory where the cluster config to be downloaded. Default is home directory ",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
+				Description:   "The directory where the cluster config to be downloaded. Default is home directory ",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"in_memory"},
+			},
+			"in_memory": {
+				Description:   "If set to true the cluster config is returned in the config_yaml attribute and nothing is written to the disk",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"config_dir"},
 			},
 			"download": {
 				Description: "If set to false will not download the config, otherwise they are downloaded each time but onto the same path for a given cluster name/id",
@@ -79,6 +90,12 @@ func dataSourceIBMContainerClusterConfig() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"config_yaml": {
+				Description: "The kubernetes config yml with the certificates inlined, set when in_memory is true",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
 			"config_file_path": {
 				Description: "The absolute path to the kubernetes config yml file ",
 				Type:        schema.TypeString,
@@ -107,17 +124,18 @@ func dataSourceIBMContainerClusterConfig() *schema.Resource {
 				Computed: true,
 			},
 			"token": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 		},
 	}
 }
 
-func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerClusterConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	csAPI := csClient.Clusters()
 	name := d.Get("cluster_name_id").(string)
@@ -126,10 +144,17 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	configDir := d.Get("config_dir").(string)
 	network := d.Get("network").(bool)
 
+	if d.Get("in_memory").(bool) {
+		if network {
+			return diagFromErr(fmt.Errorf("The Calico network config can't be downloaded when in_memory is set to true"))
+		}
+		return dataSourceIBMContainerClusterConfigReadInMemory(d, meta)
+	}
+
 	if len(configDir) == 0 {
 		configDir, err = homedir.Dir()
 		if err != nil {
-			return fmt.Errorf("Error fetching homedir: %s", err)
+			return diagFromErr(fmt.Errorf("Error fetching homedir: %s", err))
 		}
 	}
 	configDir, _ = filepath.Abs(configDir)
@@ -140,20 +165,20 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 		expectedDir := v1.ComputeClusterConfigDir(configDir, name, admin)
 		configPath = filepath.Join(expectedDir, "config.yml")
 		if !helpers.FileExists(configPath) {
-			return fmt.Errorf(`Couldn't  find the cluster config at expected path %s. Please set "download" to true to download the new config`, configPath)
+			return diagFromErr(fmt.Errorf(`Couldn't  find the cluster config at expected path %s. Please set "download" to true to download the new config`, configPath))
 		}
 		d.Set("config_file_path", configPath)
 
 	} else {
 		targetEnv, err := getClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		if network {
 			// For the Network config we need to gather the certs so we must override the admin value
 			calicoConfigFilePath, clusterKeyDetails, err := csAPI.StoreConfigDetail(name, configDir, admin || true, network, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error downloading the cluster config [%s]: %s", name, err)
+				return diagFromErr(fmt.Errorf("Error downloading the cluster config [%s]: %s", name, err))
 			}
 			d.Set("calico_config_file_path", calicoConfigFilePath)
 			d.Set("admin_key", clusterKeyDetails.AdminKey)
@@ -166,7 +191,7 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 		} else {
 			clusterKeyDetails, err := csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error downloading the cluster config [%s]: %s", name, err)
+				return diagFromErr(fmt.Errorf("Error downloading the cluster config [%s]: %s", name, err))
 			}
 			d.Set("admin_key", clusterKeyDetails.AdminKey)
 			d.Set("admin_certificate", clusterKeyDetails.Admin)
@@ -181,3 +206,51 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	d.Set("config_dir", configDir)
 	return nil
 }
+
+// dataSourceIBMContainerClusterConfigReadInMemory downloads the cluster config
+// on each read, so that the short lived IAM token of the config is renewed
+// whenever terraform refreshes the data source.
+func dataSourceIBMContainerClusterConfigReadInMemory(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("cluster_name_id").(string)
+	admin := d.Get("admin").(bool)
+
+	csv2Client, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	v2TargetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	cls, err := csv2Client.Clusters().GetCluster(name, v2TargetEnv)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error retrieving the cluster [%s]: %s", name, err))
+	}
+
+	var clusterConfig containerClusterConfig
+	if strings.HasPrefix(cls.Provider, "vpc") {
+		clusterConfig, err = vpcClusterConfig(csv2Client, name, admin, v2TargetEnv)
+	} else {
+		csClient, csErr := meta.(ClientSession).ContainerAPI()
+		if csErr != nil {
+			return diagFromErr(csErr)
+		}
+		targetEnv, targetErr := getClusterTargetHeader(d, meta)
+		if targetErr != nil {
+			return diagFromErr(targetErr)
+		}
+		clusterConfig, err = classicClusterConfig(csClient, name, admin, targetEnv)
+	}
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error downloading the cluster config [%s]: %s", name, err))
+	}
+
+	d.SetId(name)
+	d.Set("config_yaml", clusterConfig.ConfigYAML)
+	d.Set("admin_key", clusterConfig.AdminKey)
+	d.Set("admin_certificate", clusterConfig.AdminCertificate)
+	d.Set("ca_certificate", clusterConfig.CACertificate)
+	d.Set("host", clusterConfig.Host)
+	d.Set("token", clusterConfig.Token)
+	return nil
+}
@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMContainerClusterVersions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerClusterVersionsRead,
+		ReadContext: dataSourceIBMContainerClusterVersionsRead,
 
 		Schema: map[string]*schema.Schema{
 			"org_guid": {
@@ -60,15 +62,15 @@ func dataSourceIBMContainerClusterVersions() *schema.Resource {
 	}
 }
 
-func dataSourceIBMContainerClusterVersionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerClusterVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	verAPI := csClient.KubeVersions()
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	availableVersions, _ := verAPI.ListV1(targetEnv)
@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMContainerClusterWorker() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerClusterWorkerRead,
+		ReadContext: dataSourceIBMContainerClusterWorkerRead,
 
 		Schema: map[string]*schema.Schema{
 			"worker_id": {
@@ -83,22 +85,22 @@ func dataSourceIBMContainerClusterWorker() *schema.Resource {
 	}
 }
 
-func dataSourceIBMContainerClusterWorkerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerClusterWorkerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	wrkAPI := csClient.Workers()
 	workerID := d.Get("worker_id").(string)
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	workerFields, err := wrkAPI.Get(workerID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving worker: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving worker: %s", err))
 	}
 
 	d.SetId(workerFields.ID)
@@ -110,7 +112,7 @@ func dataSourceIBMContainerClusterWorkerRead(d *schema.ResourceData, meta interf
 	d.Set("public_ip", workerFields.PublicIP)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 
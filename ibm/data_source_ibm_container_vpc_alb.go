@@ -4,6 +4,9 @@
 package ibm
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
@@ -11,7 +14,7 @@ import (
 
 func dataSourceIBMContainerVPCClusterALB() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerVpcALBRead,
+		ReadContext: dataSourceIBMContainerVpcALBRead,
 		Schema: map[string]*schema.Schema{
 			"alb_id": {
 				Type:        schema.TypeString,
@@ -62,10 +65,10 @@ func dataSourceIBMContainerVPCClusterALB() *schema.Resource {
 	}
 }
 
-func dataSourceIBMContainerVpcALBRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerVpcALBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	albID := d.Get("alb_id").(string)
@@ -74,7 +77,7 @@ func dataSourceIBMContainerVpcALBRead(d *schema.ResourceData, meta interface{})
 
 	albConfig, err := albAPI.GetAlb(albID, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set("alb_type", albConfig.AlbType)
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,7 +19,7 @@ const (
 
 func dataSourceIBMContainerVPCCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerClusterVPCRead,
+		ReadContext: dataSourceIBMContainerClusterVPCRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name_id": {
@@ -286,15 +288,15 @@ func dataSourceIBMContainerVPCCluster() *schema.Resource {
 	}
 }
 
-func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerClusterVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var clusterID string
@@ -308,7 +310,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 
 	cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving container vpc cluster: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving container vpc cluster: %s", err))
 	}
 
 	d.SetId(cls.ID)
@@ -336,7 +338,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 
 	workerFields, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving workers for cluster: %s", err))
 	}
 	workers := make([]string, len(workerFields))
 	for i, worker := range workerFields {
@@ -348,7 +350,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 	//Get worker pools
 	pools, err := csClient.WorkerPools().ListWorkerPools(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving worker pools for container vpc cluster: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving worker pools for container vpc cluster: %s", err))
 	}
 
 	d.Set("worker_pools", flattenVpcWorkerPools(pools))
@@ -356,7 +358,7 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 	if !strings.HasSuffix(cls.MasterKubeVersion, _OPENSHIFT) {
 		albs, err := csClient.Albs().ListClusterAlbs(clusterID, targetEnv)
 		if err != nil && !strings.Contains(err.Error(), "The specified cluster is a lite cluster.") {
-			return fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err)
+			return diagFromErr(fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err))
 		}
 
 		filterType := d.Get("alb_type").(string)
@@ -372,20 +374,20 @@ func dataSourceIBMContainerClusterVPCRead(d *schema.ResourceData, meta interface
 	d.Set("tags", tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	csClientv1, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	apikeyAPI := csClientv1.Apikeys()
 	v1targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	apikeyConfig, err := apikeyAPI.GetApiKeyInfo(clusterID, v1targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if &apikeyConfig != nil {
 		if &apikeyConfig.Name != nil {
@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMContainerVPCClusterWorker() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerVPCClusterWorkerRead,
+		ReadContext: dataSourceIBMContainerVPCClusterWorkerRead,
 
 		Schema: map[string]*schema.Schema{
 			"worker_id": {
@@ -83,15 +85,15 @@ func dataSourceIBMContainerVPCClusterWorker() *schema.Resource {
 	}
 }
 
-func dataSourceIBMContainerVPCClusterWorkerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerVPCClusterWorkerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	wrkAPI := csClient.Workers()
@@ -100,7 +102,7 @@ func dataSourceIBMContainerVPCClusterWorkerRead(d *schema.ResourceData, meta int
 
 	workerFields, err := wrkAPI.Get(clusterID, workerID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving worker: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving worker: %s", err))
 	}
 
 	d.SetId(workerFields.ID)
@@ -112,7 +114,7 @@ func dataSourceIBMContainerVPCClusterWorkerRead(d *schema.ResourceData, meta int
 	d.Set("network_interfaces", flattenNetworkInterfaces(workerFields.NetworkInterfaces))
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 
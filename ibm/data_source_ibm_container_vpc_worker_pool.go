@@ -4,12 +4,15 @@
 package ibm
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMContainerVpcClusterWorkerPool() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerVpcClusterWorkerPoolRead,
+		ReadContext: dataSourceIBMContainerVpcClusterWorkerPoolRead,
 		Schema: map[string]*schema.Schema{
 			"cluster": {
 				Type:        schema.TypeString,
@@ -66,22 +69,22 @@ func dataSourceIBMContainerVpcClusterWorkerPool() *schema.Resource {
 		},
 	}
 }
-func dataSourceIBMContainerVpcClusterWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerVpcClusterWorkerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	clusterName := d.Get("cluster").(string)
 	workerPoolName := d.Get("worker_pool_name").(string)
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(clusterName, workerPoolName, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var zones = make([]map[string]interface{}, 0)
@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMContainerWorkerPool() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerWorkerPoolRead,
+		ReadContext: dataSourceIBMContainerWorkerPoolRead,
 
 		Schema: map[string]*schema.Schema{
 			"cluster": {
@@ -104,10 +106,10 @@ func dataSourceIBMContainerWorkerPool() *schema.Resource {
 	}
 }
 
-func dataSourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerWorkerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	workerPoolName := d.Get("worker_pool_name").(string)
 	cluster := d.Get("cluster").(string)
@@ -115,12 +117,12 @@ func dataSourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolName, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	machineType := workerPool.MachineType
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,7 +24,7 @@ var bucketTypes = []string{"single_site_location", "region_location", "cross_reg
 
 func dataSourceIBMCosBucket() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMCosBucketRead,
+		ReadContext: dataSourceIBMCosBucketRead,
 
 		Schema: map[string]*schema.Schema{
 			"bucket_name": {
@@ -190,11 +192,11 @@ func dataSourceIBMCosBucket() *schema.Resource {
 	}
 }
 
-func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMCosBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	bucketName := d.Get("bucket_name").(string)
 	serviceID := d.Get("resource_instance_id").(string)
@@ -207,11 +209,11 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 	}
 	apiEndpoint = envFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 	if apiEndpoint == "" {
-		return fmt.Errorf("The endpoint doesn't exists for given location %s and endpoint type %s", bucketRegion, endpointType)
+		return diagFromErr(fmt.Errorf("The endpoint doesn't exists for given location %s and endpoint type %s", bucketRegion, endpointType))
 	}
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
@@ -239,29 +241,29 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 	}
 	err = s3Client.WaitUntilBucketExists(headInput)
 	if err != nil {
-		return fmt.Errorf("failed waiting for bucket %s to be created, %v",
-			bucketName, err)
+		return diagFromErr(fmt.Errorf("failed waiting for bucket %s to be created, %v",
+			bucketName, err))
 	}
 	bucketLocationInput := &s3.GetBucketLocationInput{
 		Bucket: aws.String(bucketName),
 	}
 	bucketLocationConstraint, err := s3Client.GetBucketLocation(bucketLocationInput)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	bLocationConstraint := *bucketLocationConstraint.LocationConstraint
 
 	singleSiteLocationRegex, err := regexp.Compile("^[a-z]{3}[0-9][0-9]-[a-z]{4,8}$")
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	regionLocationRegex, err := regexp.Compile("^[a-z]{2}-[a-z]{2,5}-[a-z]{4,8}$")
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crossRegionLocationRegex, err := regexp.Compile("^[a-z]{2}-[a-z]{4,8}$")
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if singleSiteLocationRegex.MatchString(bLocationConstraint) {
@@ -279,10 +281,16 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 
 	head, err := s3Client.HeadBucket(headInput)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	bucketID := fmt.Sprintf("%s:%s:%s:meta:%s:%s:%s", strings.Replace(serviceID, "::", "", -1), "bucket", bucketName, bucketLocationConvert(bucketType), bucketRegion, endpointType)
-	d.SetId(bucketID)
+	bucketID := cosBucketID{
+		bucketName:   bucketName,
+		bucketType:   bucketLocationConvert(bucketType),
+		location:     bucketRegion,
+		endpointType: endpointType,
+		serviceID:    serviceID,
+	}
+	d.SetId(bucketID.String())
 	d.Set("key_protect", head.IBMSSEKPCrkId)
 	bucketCRN := fmt.Sprintf("%s:%s:%s", strings.Replace(serviceID, "::", "", -1), "bucket", bucketName)
 	d.Set("crn", bucketCRN)
@@ -296,7 +304,7 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 
 	sess, err := meta.(ClientSession).CosConfigV1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if endpointType == "private" {
@@ -305,7 +313,7 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 	bucketPtr, response, err := sess.GetBucketConfig(getBucketConfigOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error in getting bucket info rule: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error in getting bucket info rule: %s\n%s", err, response))
 	}
 
 	if bucketPtr != nil {
@@ -331,7 +339,7 @@ func dataSourceIBMCosBucketRead(d *schema.ResourceData, meta interface{}) error
 	lifecycleptr, err := s3Client.GetBucketLifecycleConfiguration(gInput)
 
 	if (err != nil && !strings.Contains(err.Error(), "NoSuchLifecycleConfiguration: The lifecycle configuration does not exist")) && (err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
-		return err
+		return diagFromErr(err)
 	}
 
 	if lifecycleptr != nil {
@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	registryv1 "github.com/IBM-Cloud/bluemix-go/api/container/registryv1"
@@ -13,7 +15,7 @@ import (
 
 func dataIBMContainerRegistryNamespaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataIBMContainerRegistryNamespacesRead,
+		ReadContext: dataIBMContainerRegistryNamespacesRead,
 
 		Schema: map[string]*schema.Schema{
 			"namespaces": {
@@ -54,16 +56,16 @@ func dataIBMContainerRegistryNamespaces() *schema.Resource {
 	}
 }
 
-func dataIBMContainerRegistryNamespacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataIBMContainerRegistryNamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	accountID := userDetails.userAccount
 
 	crClient, err := meta.(ClientSession).ContainerRegistryAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	target := registryv1.NamespaceTargetHeader{
 		AccountID: accountID,
@@ -73,7 +75,7 @@ func dataIBMContainerRegistryNamespacesRead(d *schema.ResourceData, meta interfa
 
 	response, err := crAPI.GetDetailedNamespaces(target)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	namespaces := []map[string]interface{}{}
 	for _, ns := range response {
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"path/filepath"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/icd/icdv4"
@@ -20,7 +22,7 @@ import (
 
 func dataSourceIBMDatabaseInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDatabaseInstanceRead,
+		ReadContext: dataSourceIBMDatabaseInstanceRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -535,10 +537,10 @@ func dataSourceIBMDatabaseInstance() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDatabaseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsAPI := rsConClient.ResourceServiceInstance()
 	name := d.Get("name").(string)
@@ -552,14 +554,14 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		rsInstQuery.ResourceGroupID = defaultRg
 	}
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
@@ -567,7 +569,7 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 
 		serviceOff, err := rsCatRepo.FindByName(service.(string), true)
 		if err != nil {
-			return fmt.Errorf("Error retrieving database offering: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving database offering: %s", err))
 		}
 
 		rsInstQuery.ServiceID = serviceOff[0].ID
@@ -577,7 +579,7 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 
 	instances, err = rsAPI.ListInstances(rsInstQuery)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var filteredInstances []models.ServiceInstance
 	var location string
@@ -594,14 +596,14 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if len(filteredInstances) == 0 {
-		return fmt.Errorf("No resource instance found with name [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or database", name)
+		return diagFromErr(fmt.Errorf("No resource instance found with name [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or database", name))
 	}
 
 	var instance models.ServiceInstance
 
 	if len(filteredInstances) > 1 {
-		return fmt.Errorf(
-			"More than one resource instance found with name matching [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or database", name)
+		return diagFromErr(fmt.Errorf(
+			"More than one resource instance found with name matching [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or database", name))
 	}
 	instance = filteredInstances[0]
 
@@ -609,8 +611,8 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 
 	err = GetTags(d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error on get of resource instance (%s) tags: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error on get of resource instance (%s) tags: %s", d.Id(), err))
 	}
 
 	d.Set("name", instance.Name)
@@ -621,14 +623,14 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 
 	serviceOff, err := rsCatRepo.GetServiceName(instance.ServiceID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	d.Set("service", serviceOff)
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(instance.ServicePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 
@@ -639,22 +641,22 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, rcontroller+"/services/"+url.QueryEscape(instance.Crn.String()))
 
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
-		return fmt.Errorf("Error getting database client settings: %s", err)
+		return diagFromErr(fmt.Errorf("Error getting database client settings: %s", err))
 	}
 
 	icdId := EscapeUrlParm(instance.ID)
 	cdb, err := icdClient.Cdbs().GetCdb(icdId)
 	if err != nil {
 		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-			return fmt.Errorf("The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", err)
+			return diagFromErr(fmt.Errorf("The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", err))
 		}
-		return fmt.Errorf("Error getting database config for: %s with error %s\n", icdId, err)
+		return diagFromErr(fmt.Errorf("Error getting database config for: %s with error %s\n", icdId, err))
 	}
 	d.Set("adminuser", cdb.AdminUser)
 	d.Set("version", cdb.Version)
@@ -669,7 +671,7 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 
 	groupList, err := icdClient.Groups().GetGroups(icdId)
 	if err != nil {
-		return fmt.Errorf("Error getting database groups: %s", err)
+		return diagFromErr(fmt.Errorf("Error getting database groups: %s", err))
 	}
 	d.Set("groups", flattenIcdGroups(groupList))
 	d.Set("members_memory_allocation_mb", groupList.Groups[0].Memory.AllocationMb)
@@ -677,13 +679,13 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 
 	autoSclaingGroup, err := icdClient.AutoScaling().GetAutoScaling(icdId, "member")
 	if err != nil {
-		return fmt.Errorf("Error getting database groups: %s", err)
+		return diagFromErr(fmt.Errorf("Error getting database groups: %s", err))
 	}
 	d.Set("auto_scaling", flattenICDAutoScalingGroup(autoSclaingGroup))
 
 	whitelist, err := icdClient.Whitelists().GetWhitelist(icdId)
 	if err != nil {
-		return fmt.Errorf("Error getting database whitelist: %s", err)
+		return diagFromErr(fmt.Errorf("Error getting database whitelist: %s", err))
 	}
 	d.Set("whitelist", flattenWhitelist(whitelist))
 
@@ -709,7 +711,7 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 		userName := user.UserName
 		csEntry, err := getConnectionString(d, userName, connectionEndpoint, meta)
 		if err != nil {
-			return fmt.Errorf("Error getting user connection string for user (%s): %s", userName, err)
+			return diagFromErr(fmt.Errorf("Error getting user connection string for user (%s): %s", userName, err))
 		}
 		connectionStrings = append(connectionStrings, csEntry)
 	}
@@ -718,14 +720,14 @@ func dataSourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{})
 	connStr := connectionStrings[0]
 	certFile, err := filepath.Abs(connStr.CertName + ".pem")
 	if err != nil {
-		return fmt.Errorf("Error generating certificate file path: %s", err)
+		return diagFromErr(fmt.Errorf("Error generating certificate file path: %s", err))
 	}
 	content, err := base64.StdEncoding.DecodeString(connStr.CertBase64)
 	if err != nil {
-		return fmt.Errorf("Error decoding certificate content: %s", err)
+		return diagFromErr(fmt.Errorf("Error decoding certificate content: %s", err))
 	}
 	if err := ioutil.WriteFile(certFile, content, 0644); err != nil {
-		return fmt.Errorf("Error writing certificate to file: %s", err)
+		return diagFromErr(fmt.Errorf("Error writing certificate to file: %s", err))
 	}
 	d.Set("cert_file_path", certFile)
 
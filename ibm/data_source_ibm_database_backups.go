@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMDatabaseBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMDatabaseBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "The ID of the database deployment",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"type": {
+				Description:  "Only list the backups of this type, 'scheduled' or 'on_demand'",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{databaseBackupTypeScheduled, databaseBackupTypeOnDemand}),
+			},
+			"backups": {
+				Description: "The backups of the deployment",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Description: "The ID of the backup",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of the backup",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The status of the backup",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_downloadable": {
+							Description: "Whether the backup can be downloaded",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"is_restorable": {
+							Description: "Whether a new deployment can be restored from the backup",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the backup was taken",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMDatabaseBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	icdClient, err := meta.(ClientSession).ICDAPI()
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error getting database client settings: %s", err))
+	}
+	backupsAPI, err := icdBackupsAPI(icdClient)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	icdId := d.Get("deployment_id").(string)
+	backups, err := backupsAPI.ListBackups(icdId)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error listing the backups of the database %s: %s", icdId, err))
+	}
+
+	backupType := d.Get("type").(string)
+	d.SetId(icdId)
+	d.Set("backups", flattenDatabaseBackups(backups, backupType))
+	return nil
+}
+
+func flattenDatabaseBackups(backups []icdBackup, backupType string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(backups))
+	for _, backup := range backups {
+		if backupType != "" && backup.Type != backupType {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"backup_id":       backup.ID,
+			"type":            backup.Type,
+			"status":          backup.Status,
+			"is_downloadable": backup.IsDownloadable,
+			"is_restorable":   backup.IsRestorable,
+			"created_at":      backup.CreatedAt,
+		})
+	}
+	return result
+}
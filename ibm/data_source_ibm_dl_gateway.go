@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +21,7 @@ const (
 
 func dataSourceIBMDLGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDLGatewayRead,
+		ReadContext: dataSourceIBMDLGatewayRead,
 		Schema: map[string]*schema.Schema{
 			dlName: {
 				Type:         schema.TypeString,
@@ -262,18 +264,18 @@ func dataSourceIBMDLGateway() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDLGatewayVirtualConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDLGatewayVirtualConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := meta.(ClientSession).DirectlinkV1API()
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	listVcOptions := &directlinkv1.ListGatewayVirtualConnectionsOptions{}
 	dlGatewayId := d.Id()
 	listVcOptions.SetGatewayID(dlGatewayId)
 	listGatewayVirtualConnections, response, err := directLink.ListGatewayVirtualConnections(listVcOptions)
 	if err != nil {
-		return fmt.Errorf("Error while listing directlink gateway's virtual connections XXX %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error while listing directlink gateway's virtual connections XXX %s\n%s", err, response))
 	}
 	gatewayVCs := make([]map[string]interface{}, 0)
 	for _, instance := range listGatewayVirtualConnections.VirtualConnections {
@@ -309,18 +311,18 @@ func dataSourceIBMDLGatewayVirtualConnectionsRead(d *schema.ResourceData, meta i
 	d.Set(dlGatewaysVirtualConnections, gatewayVCs)
 	return nil
 }
-func dataSourceIBMDLGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDLGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	dlGatewayName := d.Get(dlName).(string)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	listGatewaysOptionsModel := &directlinkv1.ListGatewaysOptions{}
 	listGateways, response, err := directLink.ListGateways(listGatewaysOptionsModel)
 	if err != nil {
 		log.Println("[WARN] Error listing dl Gateway", response, err)
-		return err
+		return diagFromErr(err)
 	}
 	var found bool
 
@@ -460,8 +462,8 @@ func dataSourceIBMDLGatewayRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if !found {
-		return fmt.Errorf(
-			"Error Gateway with name  (%s) not found ", dlGatewayName)
+		return diagFromErr(fmt.Errorf(
+			"Error Gateway with name  (%s) not found ", dlGatewayName))
 	}
-	return dataSourceIBMDLGatewayVirtualConnectionsRead(d, meta)
+	return dataSourceIBMDLGatewayVirtualConnectionsRead(ctx, d, meta)
 }
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,7 +20,7 @@ const (
 
 func dataSourceIBMDLGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDLGatewaysRead,
+		ReadContext: dataSourceIBMDLGatewaysRead,
 		Schema: map[string]*schema.Schema{
 			dlGateways: {
 				Type:        schema.TypeList,
@@ -227,16 +229,16 @@ func dataSourceIBMDLGateways() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDLGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDLGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	listGatewaysOptionsModel := &directlinkv1.ListGatewaysOptions{}
 	listGateways, response, err := directLink.ListGateways(listGatewaysOptionsModel)
 	if err != nil {
 		log.Println("[WARN] Error listing dl Gateway", response, err)
-		return err
+		return diagFromErr(err)
 	}
 	gateways := make([]map[string]interface{}, 0)
 	for _, instance := range listGateways.Gateways {
@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"time"
 )
@@ -27,7 +29,7 @@ const (
 
 func dataSourceIBMDLLocations() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDLOfferingLocationsRead,
+		ReadContext: dataSourceIBMDLOfferingLocationsRead,
 		Schema: map[string]*schema.Schema{
 			dlOfferingType: {
 				Type:         schema.TypeString,
@@ -107,16 +109,16 @@ func dataSourceIBMDLLocations() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDLOfferingLocationsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDLOfferingLocationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := meta.(ClientSession).DirectlinkV1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	listOfferingTypeLocationsOptions := &directlinkv1.ListOfferingTypeLocationsOptions{}
 	listOfferingTypeLocationsOptions.SetOfferingType(d.Get(dlOfferingType).(string))
 	listLocations, response, err := directLink.ListOfferingTypeLocations(listOfferingTypeLocationsOptions)
 	if err != nil {
-		return fmt.Errorf("Error while listing directlink gateway's locations %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error while listing directlink gateway's locations %s\n%s", err, response))
 	}
 
 	locations := make([]map[string]interface{}, 0)
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,7 +22,7 @@ const (
 
 func dataSourceIBMDLOfferingSpeeds() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDLOfferingSpeedsRead,
+		ReadContext: dataSourceIBMDLOfferingSpeedsRead,
 		Schema: map[string]*schema.Schema{
 			dlOfferingType: {
 				Type:         schema.TypeString,
@@ -51,10 +53,10 @@ func dataSourceIBMDLOfferingSpeeds() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDLOfferingSpeedsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDLOfferingSpeedsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	dlType := d.Get(dlOfferingType).(string)
 	listSpeedsOptionsModel := &directlinkv1.ListOfferingTypeSpeedsOptions{}
@@ -63,7 +65,7 @@ func dataSourceIBMDLOfferingSpeedsRead(d *schema.ResourceData, meta interface{})
 
 	if err != nil {
 		log.Printf("Error reading list of direct link offering speeds:%s\n%s", err, detail)
-		return err
+		return diagFromErr(err)
 	}
 	speeds := make([]map[string]interface{}, 0)
 	for _, instance := range listSpeeds.Speeds {
@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMDirectLinkPort() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDirectLinkPortRead,
+		ReadContext: dataSourceIBMDirectLinkPortRead,
 		Schema: map[string]*schema.Schema{
 			dlPortID: {
 				Type:        schema.TypeString,
@@ -55,18 +57,18 @@ func dataSourceIBMDirectLinkPort() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDirectLinkPortRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDirectLinkPortRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).DirectlinkV1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	getPortsOptions := sess.NewGetPortOptions(d.Get(dlPortID).(string))
 	response, resp, err := sess.GetPort(getPortsOptions)
 	if err != nil {
 		log.Println("[WARN] Error getting port", resp, err)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*response.ID)
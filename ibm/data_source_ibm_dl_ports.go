@@ -6,9 +6,11 @@ package ibm
 import (
 	dl "github.com/IBM/networking-go-sdk/directlinkv1"
 
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,7 +27,7 @@ const (
 
 func dataSourceIBMDirectLinkPorts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDirectLinkPortsRead,
+		ReadContext: dataSourceIBMDirectLinkPortsRead,
 
 		Schema: map[string]*schema.Schema{
 			dlLocationName: {
@@ -87,11 +89,11 @@ func dataSourceIBMDirectLinkPorts() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDirectLinkPortsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDirectLinkPortsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).DirectlinkV1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	start := ""
@@ -110,7 +112,7 @@ func dataSourceIBMDirectLinkPortsRead(d *schema.ResourceData, meta interface{})
 		response, resp, err := sess.ListPorts(listPortsOptions)
 		if err != nil {
 			log.Println("[WARN] Error listing dl ports", resp, err)
-			return err
+			return diagFromErr(err)
 		}
 		start = GetNext(response.Next)
 		allrecs = append(allrecs, response.Ports...)
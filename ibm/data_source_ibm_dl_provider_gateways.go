@@ -4,7 +4,9 @@
 package ibm
 
 import (
+	"context"
 	dlProviderV2 "github.com/IBM/networking-go-sdk/directlinkproviderv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"time"
@@ -17,7 +19,7 @@ const (
 
 func dataSourceIBMDirectLinkProviderGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDirectLinkProviderGatewaysRead,
+		ReadContext: dataSourceIBMDirectLinkProviderGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -128,10 +130,10 @@ func dataSourceIBMDirectLinkProviderGateways() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDirectLinkProviderGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDirectLinkProviderGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLinkProvider, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	start := ""
 	allrecs := []dlProviderV2.ProviderGateway{}
@@ -144,7 +146,7 @@ func dataSourceIBMDirectLinkProviderGatewaysRead(d *schema.ResourceData, meta in
 		providerGateways, resp, err := directLinkProvider.ListProviderGateways(listProviderGatewaysOptions)
 		if err != nil {
 			log.Println("[WARN] Error listing dl provider gateways", providerGateways, resp, err)
-			return err
+			return diagFromErr(err)
 		}
 		start = GetNext(providerGateways.Next)
 		allrecs = append(allrecs, providerGateways.Gateways...)
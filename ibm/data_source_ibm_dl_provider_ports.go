@@ -4,7 +4,9 @@
 package ibm
 
 import (
+	"context"
 	dlProviderV2 "github.com/IBM/networking-go-sdk/directlinkproviderv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"time"
@@ -12,7 +14,7 @@ import (
 
 func dataSourceIBMDirectLinkProviderPorts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDirectLinkProviderPortsRead,
+		ReadContext: dataSourceIBMDirectLinkProviderPortsRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -70,10 +72,10 @@ func directlinkProviderClient(meta interface{}) (*dlProviderV2.DirectLinkProvide
 	sess, err := meta.(ClientSession).DirectlinkProviderV2API()
 	return sess, err
 }
-func dataSourceIBMDirectLinkProviderPortsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDirectLinkProviderPortsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLinkProvider, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	start := ""
 	allrecs := []dlProviderV2.ProviderPort{}
@@ -86,7 +88,7 @@ func dataSourceIBMDirectLinkProviderPortsRead(d *schema.ResourceData, meta inter
 		ports, resp, err := directLinkProvider.ListProviderPorts(listPortsProviderOptions)
 		if err != nil {
 			log.Println("[WARN] Error listing dl provider ports", ports, resp, err)
-			return err
+			return diagFromErr(err)
 		}
 		start = GetNext(ports.Next)
 		allrecs = append(allrecs, ports.Ports...)
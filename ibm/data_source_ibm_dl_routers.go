@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,7 +22,7 @@ const (
 
 func dataSourceIBMDLRouters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDLRoutersRead,
+		ReadContext: dataSourceIBMDLRoutersRead,
 		Schema: map[string]*schema.Schema{
 			dlOfferingType: {
 				Type:         schema.TypeString,
@@ -56,10 +58,10 @@ func dataSourceIBMDLRouters() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDLRoutersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDLRoutersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	dlType := d.Get(dlOfferingType).(string)
 	dlLocName := d.Get(dlLocation).(string)
@@ -70,7 +72,7 @@ func dataSourceIBMDLRoutersRead(d *schema.ResourceData, meta interface{}) error
 	listRouters, detail, err := directLink.ListOfferingTypeLocationCrossConnectRouters(listRoutersOptionsModel)
 
 	if err != nil {
-		return fmt.Errorf("Error Getting Direct Link Location Cross Connect Routers: %s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error Getting Direct Link Location Cross Connect Routers: %s\n%s", err, detail))
 	}
 
 	routers := make([]map[string]interface{}, 0)
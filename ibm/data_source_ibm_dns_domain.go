@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/filter"
 	"github.com/softlayer/softlayer-go/services"
@@ -13,7 +15,7 @@ import (
 
 func dataSourceIBMDNSDomain() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDNSDomainRead,
+		ReadContext: dataSourceIBMDNSDomainRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": &schema.Schema{
@@ -31,7 +33,7 @@ func dataSourceIBMDNSDomain() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDNSDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -43,11 +45,11 @@ func dataSourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error
 		GetDomains()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving domain: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving domain: %s", err))
 	}
 
 	if len(names) == 0 {
-		return fmt.Errorf("No domain found with name [%s]", name)
+		return diagFromErr(fmt.Errorf("No domain found with name [%s]", name))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *names[0].Id))
@@ -4,7 +4,9 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/filter"
 	"github.com/softlayer/softlayer-go/services"
@@ -13,7 +15,7 @@ import (
 
 func dataSourceIBMDNSDomainRegistration() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDNSDomainRegistrationRead,
+		ReadContext: dataSourceIBMDNSDomainRegistrationRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": &schema.Schema{
@@ -37,7 +39,7 @@ func dataSourceIBMDNSDomainRegistration() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDNSDomainRegistrationRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDNSDomainRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -48,11 +50,11 @@ func dataSourceIBMDNSDomainRegistrationRead(d *schema.ResourceData, meta interfa
 		GetDomainRegistrations()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving domain registration: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving domain registration: %s", err))
 	}
 
 	if len(names) == 0 {
-		return fmt.Errorf("No domain registration found with name [%s]", name)
+		return diagFromErr(fmt.Errorf("No domain registration found with name [%s]", name))
 	}
 
 	log.Printf("names %v\n", names)
@@ -77,7 +79,7 @@ func dataSourceIBMDNSDomainRegistrationRead(d *schema.ResourceData, meta interfa
 	log.Printf("names %v\n", ns)
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving domain registration nameservers: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving domain registration nameservers: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", dnsId))
@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
 )
 
 func dataSourceIBMDNSSecondary() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMDNSSecondaryRead,
+		ReadContext: dataSourceIBMDNSSecondaryRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -45,7 +47,7 @@ func dataSourceIBMDNSSecondary() *schema.Resource {
 	}
 }
 
-func dataSourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMDNSSecondaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -56,11 +58,11 @@ func dataSourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) err
 		GetSecondaryDomains()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving secondary zone: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving secondary zone: %s", err))
 	}
 
 	if len(names) == 0 {
-		return fmt.Errorf("No secondary zone found with name: %s", name)
+		return diagFromErr(fmt.Errorf("No secondary zone found with name: %s", name))
 	}
 
 	for _, zone := range names {
@@ -75,6 +77,6 @@ func dataSourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) err
 
 		}
 	}
-	return fmt.Errorf("No secondary zone found with name: %s", name)
+	return diagFromErr(fmt.Errorf("No secondary zone found with name: %s", name))
 
 }
@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMEventStreamsTopic() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMEventStreamsTopicRead,
+		ReadContext: dataSourceIBMEventStreamsTopicRead,
 		Schema: map[string]*schema.Schema{
 			"resource_instance_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -49,16 +51,16 @@ func dataSourceIBMEventStreamsTopic() *schema.Resource {
 	}
 }
 
-func dataSourceIBMEventStreamsTopicRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMEventStreamsTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
 	if err != nil {
 		log.Printf("[DEBUG]dataSourceIBMEventStreamsTopicRead createSaramaAdminClient err %s", err)
-		return err
+		return diagFromErr(err)
 	}
 	topics, err := adminClient.ListTopics()
 	if err != nil {
 		log.Printf("[DEBUG]dataSourceIBMEventStreamsTopicRead ListTopics err %s", err)
-		return err
+		return diagFromErr(err)
 	}
 	topicName := d.Get("name").(string)
 	for name := range topics {
@@ -71,5 +73,5 @@ func dataSourceIBMEventStreamsTopicRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 	log.Printf("[DEBUG]dataSourceIBMEventStreamsTopicRead topic %s does not exist", topicName)
-	return fmt.Errorf("topic %s does not exist", topicName)
+	return diagFromErr(fmt.Errorf("topic %s does not exist", topicName))
 }
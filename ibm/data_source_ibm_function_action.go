@@ -4,17 +4,19 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMFunctionAction() *schema.Resource {
 	return &schema.Resource{
 
-		Read: dataSourceIBMFunctionActionRead,
+		ReadContext: dataSourceIBMFunctionActionRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -122,20 +124,20 @@ func dataSourceIBMFunctionAction() *schema.Resource {
 	}
 }
 
-func dataSourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMFunctionActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -144,7 +146,7 @@ func dataSourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) e
 
 	action, _, err := actionService.Get(name, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function Action %s : %s", name, err)
+		return diagFromErr(fmt.Errorf("Error retrieving IBM Cloud Function Action %s : %s", name, err))
 	}
 
 	temp := strings.Split(action.Namespace, "/")
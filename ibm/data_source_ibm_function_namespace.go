@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/functions"
@@ -13,7 +15,7 @@ import (
 
 func dataSourceIBMFunctionNamespace() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMFunctionNamespaceRead,
+		ReadContext: dataSourceIBMFunctionNamespaceRead,
 		Schema: map[string]*schema.Schema{
 			funcNamespaceName: {
 				Type:         schema.TypeString,
@@ -40,16 +42,16 @@ func dataSourceIBMFunctionNamespace() *schema.Resource {
 	}
 }
 
-func dataSourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMFunctionNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get("name").(string)
 	nsList, err := functionNamespaceAPI.Namespaces().GetNamespaces()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	for _, n := range nsList.Namespaces {
 		if n.Name != nil && *n.Name == name {
@@ -87,5 +89,5 @@ func dataSourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	return fmt.Errorf("No cloud function namespace found with name [%s]", name)
+	return diagFromErr(fmt.Errorf("No cloud function namespace found with name [%s]", name))
 }
@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMFunctionPackage() *schema.Resource {
 	return &schema.Resource{
 
-		Read: dataSourceIBMFunctionPackageRead,
+		ReadContext: dataSourceIBMFunctionPackageRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -63,20 +65,20 @@ func dataSourceIBMFunctionPackage() *schema.Resource {
 	}
 }
 
-func dataSourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMFunctionPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -84,7 +86,7 @@ func dataSourceIBMFunctionPackageRead(d *schema.ResourceData, meta interface{})
 	name := d.Get("name").(string)
 	pkg, _, err := packageService.Get(name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function package %s : %s", name, err)
+		return diagFromErr(fmt.Errorf("Error retrieving IBM Cloud Function package %s : %s", name, err))
 	}
 
 	d.SetId(pkg.Name)
@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMFunctionRule() *schema.Resource {
 	return &schema.Resource{
 
-		Read: dataSourceIBMFunctionRuleRead,
+		ReadContext: dataSourceIBMFunctionRuleRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -58,20 +60,20 @@ func dataSourceIBMFunctionRule() *schema.Resource {
 	}
 }
 
-func dataSourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMFunctionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -80,7 +82,7 @@ func dataSourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) err
 
 	rule, _, err := ruleService.Get(name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function Rule %s : %s", name, err)
+		return diagFromErr(fmt.Errorf("Error retrieving IBM Cloud Function Rule %s : %s", name, err))
 	}
 
 	d.SetId(rule.Name)
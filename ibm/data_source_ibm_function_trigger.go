@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMFunctionTrigger() *schema.Resource {
 	return &schema.Resource{
 
-		Read: dataSourceIBMFunctionTriggerRead,
+		ReadContext: dataSourceIBMFunctionTriggerRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -55,20 +57,20 @@ func dataSourceIBMFunctionTrigger() *schema.Resource {
 	}
 }
 
-func dataSourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -77,7 +79,7 @@ func dataSourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{})
 
 	trigger, _, err := triggerService.Get(name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function Trigger %s : %s", name, err)
+		return diagFromErr(fmt.Errorf("Error retrieving IBM Cloud Function Trigger %s : %s", name, err))
 	}
 
 	d.SetId(trigger.Name)
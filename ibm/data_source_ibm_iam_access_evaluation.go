@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
+	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/iamaccess"
+)
+
+// Data source to evaluate whether a principal is allowed an action on a resource
+func dataSourceIBMIAMAccessEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIAMAccessEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"iam_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IAM ID of the user, service ID or trusted profile",
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The action to evaluate, eg cloud-object-storage.object.get",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Service name of the resource",
+			},
+			"service_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "service",
+				Description: "Service type of the resource, service or platform_service for the account management services",
+			},
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the resource instance",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Region of the resource",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Resource type of the resource",
+			},
+			"resource": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The resource",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the resource group of the resource",
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Other attributes of the resource, as a map of attribute name to value",
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the action is allowed",
+			},
+			"decision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The decision, allow or deny",
+			},
+			"policy_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the policies allowing the action",
+			},
+			"access_group_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the access groups of the principal whose policies were evaluated",
+			},
+		},
+	}
+}
+
+// expandIAMAccessEvaluationRequest builds the request of the configuration,
+// the service type only applies to the resources of a service
+func expandIAMAccessEvaluationRequest(d *schema.ResourceData, accountID string) iamaccess.Request {
+	resource := iamaccess.Attributes{iamaccess.AccountIDAttribute: accountID}
+	if service, ok := d.GetOk("service"); ok {
+		resource[iamaccess.ServiceNameAttribute] = service.(string)
+		resource[iamaccess.ServiceTypeAttribute] = d.Get("service_type").(string)
+	}
+	for name, key := range map[string]string{
+		iamaccess.ServiceInstanceAttribute: "resource_instance_id",
+		iamaccess.RegionAttribute:          "region",
+		iamaccess.ResourceTypeAttribute:    "resource_type",
+		iamaccess.ResourceAttribute:        "resource",
+		iamaccess.ResourceGroupIDAttribute: "resource_group_id",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			resource[name] = v.(string)
+		}
+	}
+	for name, value := range d.Get("attributes").(map[string]interface{}) {
+		resource[name] = value.(string)
+	}
+	return iamaccess.Request{Action: d.Get("action").(string), Resource: resource}
+}
+
+func expandIAMAccessPolicies(policies []iampapv1.Policy) []iamaccess.Policy {
+	result := make([]iamaccess.Policy, 0, len(policies))
+	for _, policy := range policies {
+		p := iamaccess.Policy{ID: policy.ID}
+		for _, role := range policy.Roles {
+			p.Roles = append(p.Roles, role.RoleID)
+		}
+		for _, resource := range policy.Resources {
+			attributes := iamaccess.Attributes{}
+			for _, a := range resource.Attributes {
+				attributes[a.Name] = a.Value
+			}
+			p.Resources = append(p.Resources, attributes)
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+func expandIAMAccessRoleActions(roles []iampapv2.Role) map[string][]string {
+	result := make(map[string][]string, len(roles))
+	for _, role := range roles {
+		result[role.Crn] = role.Actions
+	}
+	return result
+}
+
+func dataSourceIBMIAMAccessEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	iampapv2Client, err := meta.(ClientSession).IAMPAPAPIV2()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	accountID := userDetails.userAccount
+	iamID := d.Get("iam_id").(string)
+	request := expandIAMAccessEvaluationRequest(d, accountID)
+
+	// The policies of the principal and of the access groups it is a member of
+	policies, err := iampapClient.V1Policy().List(iampapv1.SearchParams{
+		AccountID: accountID,
+		IAMID:     iamID,
+		Type:      iampapv1.AccessPolicyType,
+	})
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error retrieving policies of %s: %s", iamID, err))
+	}
+	groups, err := iamuumClient.AccessGroup().List(accountID, iamID)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error retrieving access groups of %s: %s", iamID, err))
+	}
+	groupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupPolicies, err := iampapClient.V1Policy().List(iampapv1.SearchParams{
+			AccountID:     accountID,
+			AccessGroupID: group.ID,
+			Type:          iampapv1.AccessPolicyType,
+		})
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error retrieving policies of access group %s: %s", group.ID, err))
+		}
+		policies = append(policies, groupPolicies...)
+		groupIDs = append(groupIDs, group.ID)
+	}
+
+	// The actions of the system, service and custom roles of the service,
+	// named by the prefix of the action when the service isn't set
+	serviceName := request.Resource[iamaccess.ServiceNameAttribute]
+	if serviceName == "" {
+		serviceName = strings.SplitN(request.Action, ".", 2)[0]
+	}
+	roles, err := iampapv2Client.IAMRoles().ListAll(iampapv2.RoleQuery{
+		AccountID:   accountID,
+		ServiceName: serviceName,
+	})
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error retrieving roles of service %s: %s", serviceName, err))
+	}
+
+	decision := iamaccess.NewEvaluator(expandIAMAccessPolicies(policies), expandIAMAccessRoleActions(roles)).Evaluate(request)
+
+	d.SetId(fmt.Sprintf("%s/%s", iamID, request.Action))
+	d.Set("allowed", decision.Allowed)
+	if decision.Allowed {
+		d.Set("decision", "allow")
+	} else {
+		d.Set("decision", "deny")
+	}
+	d.Set("policy_ids", decision.PolicyIDs)
+	d.Set("access_group_ids", groupIDs)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/iamaccess"
+)
+
+func TestAccIBMIAMAccessEvaluationDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIAMAccessEvaluationDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_access_evaluation.get", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_evaluation.get", "decision", "allow"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_evaluation.get", "policy_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_evaluation.get", "access_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_evaluation.put", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_evaluation.put", "decision", "deny"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_evaluation.put", "policy_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessEvaluationDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "service_id" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_access_group" "group" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_access_group_members" "members" {
+			access_group_id = ibm_iam_access_group.group.id
+			iam_service_ids = [ibm_iam_service_id.service_id.id]
+		}
+
+		resource "ibm_iam_access_group_policy" "policy" {
+			access_group_id = ibm_iam_access_group.group.id
+			roles           = ["Reader"]
+
+			resources {
+				service = "cloud-object-storage"
+			}
+		}
+
+		data "ibm_iam_access_evaluation" "get" {
+			iam_id  = ibm_iam_service_id.service_id.iam_id
+			action  = "cloud-object-storage.object.get"
+			service = "cloud-object-storage"
+
+			depends_on = [ibm_iam_access_group_members.members, ibm_iam_access_group_policy.policy]
+		}
+
+		data "ibm_iam_access_evaluation" "put" {
+			iam_id  = ibm_iam_service_id.service_id.iam_id
+			action  = "cloud-object-storage.object.put"
+			service = "cloud-object-storage"
+
+			depends_on = [ibm_iam_access_group_members.members, ibm_iam_access_group_policy.policy]
+		}
+	`, name)
+}
+
+func TestExpandIAMAccessEvaluationRequest(t *testing.T) {
+	r := dataSourceIBMIAMAccessEvaluation()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"iam_id":               "iam-ServiceId-1",
+		"action":               "cloud-object-storage.object.get",
+		"service":              "cloud-object-storage",
+		"resource_instance_id": "cos-1",
+		"resource_type":        "bucket",
+		"resource":             "logs",
+		"attributes":           map[string]interface{}{"prefix": "reports/"},
+	})
+	assert.DeepEqual(t, iamaccess.Request{
+		Action: "cloud-object-storage.object.get",
+		Resource: iamaccess.Attributes{
+			iamaccess.AccountIDAttribute:       "account",
+			iamaccess.ServiceNameAttribute:     "cloud-object-storage",
+			iamaccess.ServiceTypeAttribute:     "service",
+			iamaccess.ServiceInstanceAttribute: "cos-1",
+			iamaccess.ResourceTypeAttribute:    "bucket",
+			iamaccess.ResourceAttribute:        "logs",
+			"prefix":                           "reports/",
+		},
+	}, expandIAMAccessEvaluationRequest(d, "account"))
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"iam_id":        "iam-ServiceId-1",
+		"action":        "resource-controller.group.retrieve",
+		"resource_type": "resource-group",
+		"resource":      "rg-1",
+	})
+	assert.DeepEqual(t, iamaccess.Attributes{
+		iamaccess.AccountIDAttribute:    "account",
+		iamaccess.ResourceTypeAttribute: "resource-group",
+		iamaccess.ResourceAttribute:     "rg-1",
+	}, expandIAMAccessEvaluationRequest(d, "account").Resource)
+}
+
+func TestExpandIAMAccessPolicies(t *testing.T) {
+	policies := expandIAMAccessPolicies([]iampapv1.Policy{{
+		ID:    "p1",
+		Roles: []iampapv1.Role{{RoleID: "crn:v1:bluemix:public:iam::::serviceRole:Reader"}},
+		Resources: []iampapv1.Resource{{Attributes: []iampapv1.Attribute{
+			{Name: "accountId", Value: "account"},
+			{Name: "serviceName", Value: "cloud-object-storage"},
+		}}},
+	}})
+	assert.DeepEqual(t, []iamaccess.Policy{{
+		ID:        "p1",
+		Roles:     []string{"crn:v1:bluemix:public:iam::::serviceRole:Reader"},
+		Resources: []iamaccess.Attributes{{"accountId": "account", "serviceName": "cloud-object-storage"}},
+	}}, policies)
+}
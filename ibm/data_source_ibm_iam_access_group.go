@@ -4,20 +4,21 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"log"
 
 	"github.com/IBM-Cloud/bluemix-go/crn"
 	"github.com/IBM-Cloud/bluemix-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMIAMAccessGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataIBMIAMAccessGroupRead,
-		Exists:   resourceIBMIAMAccessGroupExists,
-		Importer: &schema.ResourceImporter{},
+		ReadContext: dataIBMIAMAccessGroupRead,
+		Importer:    &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_name": {
@@ -108,31 +109,31 @@ func dataSourceIBMIAMAccessGroup() *schema.Resource {
 	}
 }
 
-func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataIBMIAMAccessGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	accountID := userDetails.userAccount
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	client := userManagement.UserInvite()
 	res, err := client.ListUsers(accountID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	boundTo := crn.New(userDetails.cloudName, userDetails.cloudType)
@@ -141,16 +142,16 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	serviceIDs, err := iamClient.ServiceIds().List(boundTo.String())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	retreivedGroups, err := iamuumClient.AccessGroup().List(accountID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access groups: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving access groups: %s", err))
 	}
 
 	if len(retreivedGroups) == 0 {
-		return fmt.Errorf("No access group in account")
+		return diagFromErr(fmt.Errorf("No access group in account"))
 	}
 	var agName string
 	var matchGroups []models.AccessGroupV2
@@ -165,7 +166,7 @@ func dataIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
 		matchGroups = retreivedGroups
 	}
 	if len(matchGroups) == 0 {
-		return fmt.Errorf("No Access Groups with name %s in Account", agName)
+		return diagFromErr(fmt.Errorf("No Access Groups with name %s in Account", agName))
 	}
 
 	grpMap := make([]map[string]interface{}, 0, len(matchGroups))
@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMIAMAuthToken() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMIAMAuthTokenRead,
+		ReadContext: dataSourceIBMIAMAuthTokenRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -37,10 +39,10 @@ func dataSourceIBMIAMAuthToken() *schema.Resource {
 	}
 }
 
-func dataSourceIBMIAMAuthTokenRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMIAMAuthTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	bmxSess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(dataSourceIBMIAMAuthTokenID(d))
 
@@ -4,12 +4,15 @@
 package ibm
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func datasourceIBMIAMRoleAction() *schema.Resource {
 	return &schema.Resource{
-		Read: datasourceIBMIAMRoleActionRead,
+		ReadContext: datasourceIBMIAMRoleActionRead,
 
 		Schema: map[string]*schema.Schema{
 			"service": {
@@ -47,17 +50,17 @@ func datasourceIBMIAMRoleAction() *schema.Resource {
 
 }
 
-func datasourceIBMIAMRoleActionRead(d *schema.ResourceData, meta interface{}) error {
+func datasourceIBMIAMRoleActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapv2Client, err := meta.(ClientSession).IAMPAPAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceName := d.Get("service").(string)
 	d.SetId(serviceName)
 	serviceRoles, err := iampapv2Client.IAMRoles().ListServiceRoles(serviceName)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set("reader", flattenActionbyDisplayName("Reader", serviceRoles))
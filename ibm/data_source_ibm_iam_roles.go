@@ -4,13 +4,16 @@
 package ibm
 
 import (
+	"context"
+
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func datasourceIBMIAMRole() *schema.Resource {
 	return &schema.Resource{
-		Read: datasourceIBMIAMRoleRead,
+		ReadContext: datasourceIBMIAMRoleRead,
 
 		Schema: map[string]*schema.Schema{
 			"service": {
@@ -44,15 +47,15 @@ func datasourceIBMIAMRole() *schema.Resource {
 
 }
 
-func datasourceIBMIAMRoleRead(d *schema.ResourceData, meta interface{}) error {
+func datasourceIBMIAMRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapv2Client, err := meta.(ClientSession).IAMPAPAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var serviceName string
@@ -62,12 +65,12 @@ func datasourceIBMIAMRoleRead(d *schema.ResourceData, meta interface{}) error {
 
 		customRoles, err = iampapv2Client.IAMRoles().ListCustomRoles(userDetails.userAccount, serviceName)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		serviceRoles, err = iampapv2Client.IAMRoles().ListServiceRoles(serviceName)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -75,7 +78,7 @@ func datasourceIBMIAMRoleRead(d *schema.ResourceData, meta interface{}) error {
 
 	systemRoles, err = iampapv2Client.IAMRoles().ListSystemDefinedRoles()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var roles []map[string]string
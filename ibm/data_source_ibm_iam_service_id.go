@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/crn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMIAMServiceID() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMIAMServiceIDRead,
+		ReadContext: dataSourceIBMIAMServiceIDRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -73,16 +75,16 @@ func dataSourceIBMIAMServiceID() *schema.Resource {
 	}
 }
 
-func dataSourceIBMIAMServiceIDRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMIAMServiceIDRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get("name").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	boundTo := crn.New(userDetails.cloudName, userDetails.cloudType)
@@ -91,11 +93,11 @@ func dataSourceIBMIAMServiceIDRead(d *schema.ResourceData, meta interface{}) err
 
 	serviceIDS, err := iamClient.ServiceIds().FindByName(boundTo.String(), name)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if len(serviceIDS) == 0 {
-		return fmt.Errorf("No serviceID found with name [%s]", name)
+		return diagFromErr(fmt.Errorf("No serviceID found with name [%s]", name))
 
 	}
 
@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // Data source to find all the policies for a serviceID
 func dataSourceIBMIAMServicePolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMIAMServicePolicyRead,
+		ReadContext: dataSourceIBMIAMServicePolicyRead,
 
 		Schema: map[string]*schema.Schema{
 			"iam_service_id": {
@@ -87,27 +89,27 @@ func dataSourceIBMIAMServicePolicy() *schema.Resource {
 	}
 }
 
-func dataSourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMIAMServicePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceIDUUID := d.Get("iam_service_id").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceID, err := iamClient.ServiceIds().Get(serviceIDUUID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	query := iampapv1.SearchParams{
@@ -122,7 +124,7 @@ func dataSourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{})
 
 	policies, err := iampapClient.V1Policy().List(query)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	servicePolicies := make([]map[string]interface{}, 0, len(policies))
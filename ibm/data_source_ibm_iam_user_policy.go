@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // Data source to find all the policies for a user in a particular account
 func dataSourceIBMIAMUserPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMIAMUserPolicyRead,
+		ReadContext: dataSourceIBMIAMUserPolicyRead,
 
 		Schema: map[string]*schema.Schema{
 			"ibm_id": {
@@ -87,24 +89,24 @@ func dataSourceIBMIAMUserPolicy() *schema.Resource {
 	}
 }
 
-func dataSourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMIAMUserPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userEmail := d.Get("ibm_id").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	accountID := userDetails.userAccount
 
 	ibmUniqueID, err := getIBMUniqueId(accountID, userEmail, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	query := iampapv1.SearchParams{
@@ -119,11 +121,11 @@ func dataSourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) er
 
 	policies, err := iampapClient.V1Policy().List(query)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userPolicies := make([]map[string]interface{}, 0, len(policies))
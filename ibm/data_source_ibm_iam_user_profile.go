@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMIAMUserProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMIAMUserProfileRead,
+		ReadContext: dataSourceIBMIAMUserProfileRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -79,10 +81,10 @@ func dataSourceIBMIAMUserProfile() *schema.Resource {
 	}
 }
 
-func dataSourceIBMIAMUserProfileRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMIAMUserProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	client := userManagement.UserInvite()
 
@@ -90,17 +92,17 @@ func dataSourceIBMIAMUserProfileRead(d *schema.ResourceData, meta interface{}) e
 
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	iamID, err := getIBMUniqueId(accountID, userEmail, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userInfo, error := client.GetUserProfile(accountID, iamID)
 	if error != nil {
-		return error
+		return diagFromErr(error)
 	}
 
 	d.Set("user_id", userInfo.UserID)
@@ -114,7 +116,7 @@ func dataSourceIBMIAMUserProfileRead(d *schema.ResourceData, meta interface{}) e
 
 	UserSettings, UserSettingError := client.GetUserSettings(accountID, iamID)
 	if UserSettingError != nil {
-		return UserSettingError
+		return diagFromErr(UserSettingError)
 	}
 
 	iplist := strings.Split(UserSettings.AllowedIPAddresses, ",")
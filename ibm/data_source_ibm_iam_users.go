@@ -4,12 +4,15 @@
 package ibm
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMIAMUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMIAMUsersRead,
+		ReadContext: dataSourceIBMIAMUsersRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -86,27 +89,27 @@ func dataSourceIBMIAMUsers() *schema.Resource {
 	}
 }
 
-func dataSourceIBMIAMUsersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMIAMUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	client := userManagement.UserInvite()
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	accountID := userDetails.userAccount
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	res, err := client.ListUsers(accountID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	profileList := make([]interface{}, 0)
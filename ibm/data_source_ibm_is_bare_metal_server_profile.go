@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBareMetalServerProfileName            = "name"
+	isBareMetalServerProfileFamily          = "family"
+	isBareMetalServerProfileHref            = "href"
+	isBareMetalServerProfileResourceType    = "resource_type"
+	isBareMetalServerProfileBandwidth       = "bandwidth"
+	isBareMetalServerProfileCPUArchitecture = "cpu_architecture"
+	isBareMetalServerProfileCPUCoreCount    = "cpu_core_count"
+	isBareMetalServerProfileCPUSocketCount  = "cpu_socket_count"
+	isBareMetalServerProfileMemory          = "memory"
+	isBareMetalServerProfileOSArchitecture  = "os_architecture"
+	isBareMetalServerProfileDisks           = "disks"
+	isBareMetalServerProfileDiskQuantity    = "quantity"
+	isBareMetalServerProfileDiskSize        = "size"
+	isBareMetalServerProfileDiskInterfaces  = "supported_interface_types"
+)
+
+func dataSourceIBMISBareMetalServerProfile() *schema.Resource {
+	profileSchema := bareMetalServerProfileSchema()
+	profileSchema[isBareMetalServerProfileName] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: InvokeDataSourceValidator("ibm_is_bare_metal_server_profile", isBareMetalServerProfileName),
+		Description:  "The name of the bare metal server profile",
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISBareMetalServerProfileRead,
+		Schema:      profileSchema,
+	}
+}
+
+// bareMetalServerProfileSchema returns the computed attributes of a bare metal server profile,
+// shared by ibm_is_bare_metal_server_profile and ibm_is_bare_metal_server_profiles
+func bareMetalServerProfileSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		isBareMetalServerProfileFamily: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The product family of the bare metal server profile",
+		},
+
+		isBareMetalServerProfileHref: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The URL of the bare metal server profile",
+		},
+
+		isBareMetalServerProfileResourceType: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The resource type of the bare metal server profile",
+		},
+
+		isBareMetalServerProfileBandwidth: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total bandwidth of the bare metal servers of the profile, in megabits per second",
+		},
+
+		isBareMetalServerProfileCPUArchitecture: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The CPU architecture of the bare metal servers of the profile",
+		},
+
+		isBareMetalServerProfileCPUCoreCount: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of CPU cores of the bare metal servers of the profile",
+		},
+
+		isBareMetalServerProfileCPUSocketCount: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of CPU sockets of the bare metal servers of the profile",
+		},
+
+		isBareMetalServerProfileMemory: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The amount of memory of the bare metal servers of the profile, in gibibytes",
+		},
+
+		isBareMetalServerProfileOSArchitecture: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The supported OS architectures of the bare metal servers of the profile",
+		},
+
+		isBareMetalServerProfileDisks: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The local disks of the bare metal servers of the profile",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isBareMetalServerProfileDiskQuantity: {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The number of disks of this configuration",
+					},
+					isBareMetalServerProfileDiskSize: {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The size of the disks, in gigabytes",
+					},
+					isBareMetalServerProfileDiskInterfaces: {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The supported disk interfaces of the disks",
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISBareMetalServerProfileValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBareMetalServerProfileName,
+			ValidateFunctionIdentifier: ValidateNoZeroValues,
+			Type:                       TypeString})
+
+	ibmISBareMetalServerProfileDataSourceValidator := ResourceValidator{ResourceName: "ibm_is_bare_metal_server_profile", Schema: validateSchema}
+	return &ibmISBareMetalServerProfileDataSourceValidator
+}
+
+func dataSourceIBMISBareMetalServerProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := bareMetalServersClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	name := d.Get(isBareMetalServerProfileName).(string)
+	profile, response, err := client.GetBareMetalServerProfile(name)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error Getting Bare Metal Server Profile (%s): %s\n%s", name, err, response))
+	}
+	d.SetId(profile.Name)
+	for key, value := range flattenBareMetalServerProfile(profile) {
+		d.Set(key, value)
+	}
+	return nil
+}
+
+// flattenBareMetalServerProfile returns the attributes of a bare metal server profile
+func flattenBareMetalServerProfile(profile *vpcBareMetalServerProfile) map[string]interface{} {
+	disks := make([]map[string]interface{}, 0, len(profile.Disks))
+	for _, disk := range profile.Disks {
+		interfaces := disk.SupportedInterfaceTypes.stringValues()
+		if len(interfaces) == 0 && disk.SupportedInterfaceTypes.stringValue() != "" {
+			interfaces = []string{disk.SupportedInterfaceTypes.stringValue()}
+		}
+		disks = append(disks, map[string]interface{}{
+			isBareMetalServerProfileDiskQuantity:   disk.Quantity.intValue(),
+			isBareMetalServerProfileDiskSize:       disk.Size.intValue(),
+			isBareMetalServerProfileDiskInterfaces: interfaces,
+		})
+	}
+	osArchitectures := profile.OSArchitecture.stringValues()
+	if len(osArchitectures) == 0 && profile.OSArchitecture.stringValue() != "" {
+		osArchitectures = []string{profile.OSArchitecture.stringValue()}
+	}
+	return map[string]interface{}{
+		isBareMetalServerProfileName:            profile.Name,
+		isBareMetalServerProfileFamily:          profile.Family,
+		isBareMetalServerProfileHref:            profile.Href,
+		isBareMetalServerProfileResourceType:    profile.ResourceType,
+		isBareMetalServerProfileBandwidth:       profile.Bandwidth.intValue(),
+		isBareMetalServerProfileCPUArchitecture: profile.CPUArchitecture.stringValue(),
+		isBareMetalServerProfileCPUCoreCount:    profile.CPUCoreCount.intValue(),
+		isBareMetalServerProfileCPUSocketCount:  profile.CPUSocketCount.intValue(),
+		isBareMetalServerProfileMemory:          profile.Memory.intValue(),
+		isBareMetalServerProfileOSArchitecture:  osArchitectures,
+		isBareMetalServerProfileDisks:           disks,
+	}
+}
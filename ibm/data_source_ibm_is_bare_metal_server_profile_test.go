@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISBareMetalServerProfileDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	data "ibm_is_bare_metal_server_profile" "testacc_profile" {
+		name = "%s"
+	}`, bareMetalServerProfileName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_bare_metal_server_profile.testacc_profile", "name", bareMetalServerProfileName),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_is_bare_metal_server_profile.testacc_profile", "cpu_core_count"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_is_bare_metal_server_profile.testacc_profile", "disks.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISBareMetalServerProfilesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+	data "ibm_is_bare_metal_server_profiles" "testacc_profiles" {
+	}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_is_bare_metal_server_profiles.testacc_profiles", "profiles.0.name"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBareMetalServerProfiles = "profiles"
+)
+
+func dataSourceIBMISBareMetalServerProfiles() *schema.Resource {
+	profileSchema := bareMetalServerProfileSchema()
+	profileSchema[isBareMetalServerProfileName] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the bare metal server profile",
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISBareMetalServerProfilesRead,
+
+		Schema: map[string]*schema.Schema{
+			isBareMetalServerProfiles: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The bare metal server profiles",
+				Elem:        &schema.Resource{Schema: profileSchema},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISBareMetalServerProfilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := bareMetalServersClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	profiles, err := client.ListBareMetalServerProfiles()
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error Fetching Bare Metal Server Profiles: %s", err))
+	}
+	profilesInfo := make([]map[string]interface{}, 0, len(profiles))
+	for i := range profiles {
+		profilesInfo = append(profilesInfo, flattenBareMetalServerProfile(&profiles[i]))
+	}
+	d.SetId(dataSourceIBMISBareMetalServerProfilesID(d))
+	d.Set(isBareMetalServerProfiles, profilesInfo)
+	return nil
+}
+
+// dataSourceIBMISBareMetalServerProfilesID returns a reasonable ID for a bare metal server profile list.
+func dataSourceIBMISBareMetalServerProfilesID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISDedicatedHost() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISDedicatedHostRead,
+
+		Schema: map[string]*schema.Schema{
+			isDedicatedHostName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeDataSourceValidator("ibm_is_dedicated_host", isDedicatedHostName),
+				Description:  "The name of the dedicated host",
+			},
+
+			isDedicatedHostGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the dedicated host group of the dedicated host",
+			},
+
+			isDedicatedHostProfile: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the profile of the dedicated host",
+			},
+
+			isDedicatedHostInstancePlacementEnabled: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether instances can be placed on the dedicated host",
+			},
+
+			isDedicatedHostResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource group ID of the dedicated host",
+			},
+
+			isDedicatedHostZone: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone of the dedicated host",
+			},
+
+			isDedicatedHostCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the dedicated host",
+			},
+
+			isDedicatedHostHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the dedicated host",
+			},
+
+			isDedicatedHostCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the dedicated host was created",
+			},
+
+			isDedicatedHostLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the dedicated host",
+			},
+
+			isDedicatedHostState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The administrative state of the dedicated host",
+			},
+
+			isDedicatedHostProvisionable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the dedicated host is available for instance creation",
+			},
+
+			isDedicatedHostMemory: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total amount of memory of the dedicated host, in gibibytes",
+			},
+
+			isDedicatedHostAvailableMemory: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of memory of the dedicated host available for instances, in gibibytes",
+			},
+
+			isDedicatedHostSocketCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of sockets of the dedicated host",
+			},
+
+			isDedicatedHostVcpuArchitecture: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The VCPU architecture of the dedicated host",
+			},
+
+			isDedicatedHostVcpuCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of VCPUs of the dedicated host",
+			},
+
+			isDedicatedHostAvailableVcpuCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of VCPUs of the dedicated host available for instances",
+			},
+
+			isDedicatedHostInstances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the instances placed on the dedicated host",
+			},
+
+			isDedicatedHostSupportedInstanceProfiles: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the instance profiles usable by the instances placed on the dedicated host",
+			},
+
+			isDedicatedHostResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the dedicated host",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISDedicatedHostValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isDedicatedHostName,
+			ValidateFunctionIdentifier: ValidateNoZeroValues,
+			Type:                       TypeString})
+
+	ibmISDedicatedHostDataSourceValidator := ResourceValidator{ResourceName: "ibm_is_dedicated_host", Schema: validateSchema}
+	return &ibmISDedicatedHostDataSourceValidator
+}
+
+func dataSourceIBMISDedicatedHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	name := d.Get(isDedicatedHostName).(string)
+	options := &vpcv1.ListDedicatedHostsOptions{}
+	if group, ok := d.GetOk(isDedicatedHostGroup); ok {
+		groupstr := group.(string)
+		options.DedicatedHostGroupID = &groupstr
+	}
+	start := ""
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		hosts, response, err := sess.ListDedicatedHosts(options)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error Fetching Dedicated Hosts: %s\n%s", err, response))
+		}
+		for _, host := range hosts.DedicatedHosts {
+			if *host.Name == name {
+				d.SetId(*host.ID)
+				setDedicatedHostAttributes(d, &host)
+				return nil
+			}
+		}
+		start = GetNext(hosts.Next)
+		if start == "" {
+			break
+		}
+	}
+	return diagFromErr(fmt.Errorf("No dedicated host found with name %s", name))
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISDedicatedHostGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISDedicatedHostGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			isDedicatedHostGroupName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeDataSourceValidator("ibm_is_dedicated_host_group", isDedicatedHostGroupName),
+				Description:  "The name of the dedicated host group",
+			},
+
+			isDedicatedHostGroupZone: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The zone of the dedicated host group",
+			},
+
+			isDedicatedHostGroupClass: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The dedicated host profile class of the hosts of the group",
+			},
+
+			isDedicatedHostGroupFamily: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The dedicated host profile family of the hosts of the group",
+			},
+
+			isDedicatedHostGroupResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource group ID of the dedicated host group",
+			},
+
+			isDedicatedHostGroupCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the dedicated host group",
+			},
+
+			isDedicatedHostGroupHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the dedicated host group",
+			},
+
+			isDedicatedHostGroupCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the dedicated host group was created",
+			},
+
+			isDedicatedHostGroupResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the dedicated host group",
+			},
+
+			isDedicatedHostGroupDedicatedHosts: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the dedicated hosts of the group",
+			},
+
+			isDedicatedHostGroupSupportedInstanceProfiles: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the instance profiles usable by the instances placed on the group",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISDedicatedHostGroupValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isDedicatedHostGroupName,
+			ValidateFunctionIdentifier: ValidateNoZeroValues,
+			Type:                       TypeString})
+
+	ibmISDedicatedHostGroupDataSourceValidator := ResourceValidator{ResourceName: "ibm_is_dedicated_host_group", Schema: validateSchema}
+	return &ibmISDedicatedHostGroupDataSourceValidator
+}
+
+func dataSourceIBMISDedicatedHostGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	name := d.Get(isDedicatedHostGroupName).(string)
+	options := &vpcv1.ListDedicatedHostGroupsOptions{}
+	if zone, ok := d.GetOk(isDedicatedHostGroupZone); ok {
+		zonestr := zone.(string)
+		options.ZoneName = &zonestr
+	}
+	start := ""
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		groups, response, err := sess.ListDedicatedHostGroups(options)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error Fetching Dedicated Host Groups: %s\n%s", err, response))
+		}
+		for _, group := range groups.Groups {
+			if *group.Name == name {
+				d.SetId(*group.ID)
+				setDedicatedHostGroupAttributes(d, &group)
+				return nil
+			}
+		}
+		start = GetNext(groups.Next)
+		if start == "" {
+			break
+		}
+	}
+	return diagFromErr(fmt.Errorf("No dedicated host group found with name %s", name))
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISDedicatedHostGroupDataSource_basic(t *testing.T) {
+	groupname := fmt.Sprintf("tf-dhgroup-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISDedicatedHostGroupConfig(groupname) + `
+	data "ibm_is_dedicated_host_group" "testacc_dhgroup" {
+		name = ibm_is_dedicated_host_group.testacc_dhgroup.name
+		zone = ibm_is_dedicated_host_group.testacc_dhgroup.zone
+	}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_dedicated_host_group.testacc_dhgroup", "id", "ibm_is_dedicated_host_group.testacc_dhgroup", "id"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_dedicated_host_group.testacc_dhgroup", "family", "balanced"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISDedicatedHostDataSource_basic(t *testing.T) {
+	groupname := fmt.Sprintf("tf-dhgroup-%d", acctest.RandIntRange(10, 100))
+	hostname := fmt.Sprintf("tf-dhost-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISDedicatedHostConfig(groupname, hostname, true) + `
+	data "ibm_is_dedicated_host" "testacc_dhost" {
+		name       = ibm_is_dedicated_host.testacc_dhost.name
+		host_group = ibm_is_dedicated_host.testacc_dhost.host_group
+	}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_dedicated_host.testacc_dhost", "id", "ibm_is_dedicated_host.testacc_dhost", "id"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_dedicated_host.testacc_dhost", "profile", dedicatedHostProfileName),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_dedicated_host.testacc_dhost", "instance_placement_enabled", "true"),
+				),
+			},
+		},
+	})
+}
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,7 +24,7 @@ const (
 
 func dataSourceIBMISFloatingIP() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISFloatingIPRead,
+		ReadContext: dataSourceIBMISFloatingIPRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -67,21 +69,21 @@ func dataSourceIBMISFloatingIP() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISFloatingIPRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISFloatingIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	floatingIPName := d.Get(isFloatingIPName).(string)
 	if userDetails.generation == 1 {
 		err := classicFloatingIPGet(d, meta, floatingIPName)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := floatingIPGet(d, meta, floatingIPName)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
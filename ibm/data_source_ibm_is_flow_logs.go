@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,7 +19,7 @@ const (
 
 func dataSourceIBMISFlowLogs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISFlowLogsRead,
+		ReadContext: dataSourceIBMISFlowLogsRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -89,10 +91,10 @@ func dataSourceIBMISFlowLogs() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISFlowLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	start := ""
@@ -104,7 +106,7 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 		}
 		flowlogCollectors, response, err := sess.ListFlowLogCollectors(listOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Flow Logs for VPC %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error Fetching Flow Logs for VPC %s\n%s", err, response))
 		}
 		start = GetNext(flowlogCollectors.Next)
 		allrecs = append(allrecs, flowlogCollectors.FlowLogCollectors...)
@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISImage() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISImageRead,
+		ReadContext: dataSourceIBMISImageRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -65,10 +67,10 @@ func dataSourceIBMISImage() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISImageRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get("name").(string)
 	var visibility string
@@ -78,12 +80,12 @@ func dataSourceIBMISImageRead(d *schema.ResourceData, meta interface{}) error {
 	if userDetails.generation == 1 {
 		err := classicImageGet(d, meta, name, visibility)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := imageGet(d, meta, name, visibility)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
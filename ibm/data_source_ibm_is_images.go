@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,7 +20,7 @@ const (
 
 func dataSourceIBMISImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISImagesRead,
+		ReadContext: dataSourceIBMISImagesRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -80,20 +82,20 @@ func dataSourceIBMISImages() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classicImageList(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := imageList(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
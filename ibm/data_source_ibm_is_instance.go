@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
@@ -19,6 +20,7 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/ScaleFT/sshkeys"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/crypto/ssh"
 )
@@ -32,7 +34,7 @@ const (
 
 func dataSourceIBMISInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISInstanceRead,
+		ReadContext: dataSourceIBMISInstanceRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -382,21 +384,21 @@ func dataSourceIBMISInstance() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get(isInstanceName).(string)
 	if userDetails.generation == 1 {
 		err := classicInstanceGetByName(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := instanceGetByName(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
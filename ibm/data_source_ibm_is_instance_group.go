@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISInstanceGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISInstanceGroupRead,
+		ReadContext: dataSourceIBMISInstanceGroupRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -81,10 +83,10 @@ func dataSourceIBMISInstanceGroup() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISInstanceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get("name")
@@ -99,7 +101,7 @@ func dataSourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{})
 		}
 		instanceGroupsCollection, response, err := sess.ListInstanceGroups(&listInstanceGroupOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching InstanceGroups %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error Fetching InstanceGroups %s\n%s", err, response))
 		}
 		start = GetNext(instanceGroupsCollection.Next)
 		allrecs = append(allrecs, instanceGroupsCollection.InstanceGroups...)
@@ -138,5 +140,5 @@ func dataSourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 	}
-	return fmt.Errorf("Instance group %s not found", name)
+	return diagFromErr(fmt.Errorf("Instance group %s not found", name))
 }
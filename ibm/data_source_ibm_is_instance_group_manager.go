@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISInstanceGroupManager() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISInstanceGroupManagerRead,
+		ReadContext: dataSourceIBMISInstanceGroupManagerRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -74,10 +76,10 @@ func dataSourceIBMISInstanceGroupManager() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISInstanceGroupManagerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceGroupID := d.Get("instance_group").(string)
@@ -92,10 +94,12 @@ func dataSourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interf
 		}
 		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagers(&listInstanceGroupManagerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting InstanceGroup Managers %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error Getting InstanceGroup Managers %s\n%s", err, response))
 		}
 		start = GetNext(instanceGroupManagerCollections.Next)
-		allrecs = append(allrecs, instanceGroupManagerCollections.Managers...)
+		for _, manager := range instanceGroupManagerCollections.Managers {
+			allrecs = append(allrecs, *manager.(*vpcv1.InstanceGroupManager))
+		}
 
 		if start == "" {
 			break
@@ -124,5 +128,5 @@ func dataSourceIBMISInstanceGroupManagerRead(d *schema.ResourceData, meta interf
 			return nil
 		}
 	}
-	return fmt.Errorf("Instance group manager %s not found", instanceGroupManagerName)
+	return diagFromErr(fmt.Errorf("Instance group manager %s not found", instanceGroupManagerName))
 }
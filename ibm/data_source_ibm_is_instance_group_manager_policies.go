@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISInstanceGroupManagerPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISInstanceGroupManagerPoliciesRead,
+		ReadContext: dataSourceIBMISInstanceGroupManagerPoliciesRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -77,10 +79,10 @@ func dataSourceIBMISInstanceGroupManagerPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISInstanceGroupManagerPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISInstanceGroupManagerPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceGroupManagerID := d.Get("instance_group_manager").(string)
@@ -98,7 +100,7 @@ func dataSourceIBMISInstanceGroupManagerPoliciesRead(d *schema.ResourceData, met
 
 		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPolicies(&listInstanceGroupManagerPoliciesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting InstanceGroup Manager Policies %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error Getting InstanceGroup Manager Policies %s\n%s", err, response))
 		}
 		start = GetNext(instanceGroupManagerPolicyCollection.Next)
 		allrecs = append(allrecs, instanceGroupManagerPolicyCollection.Policies...)
@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISInstanceGroupManagerPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISInstanceGroupManagerPolicyRead,
+		ReadContext: dataSourceIBMISInstanceGroupManagerPolicyRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -60,10 +62,10 @@ func dataSourceIBMISInstanceGroupManagerPolicy() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISInstanceGroupManagerPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceGroupManagerID := d.Get("instance_group_manager").(string)
@@ -82,7 +84,7 @@ func dataSourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta
 
 		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPolicies(&listInstanceGroupManagerPoliciesOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting InstanceGroup Manager Policies %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error Getting InstanceGroup Manager Policies %s\n%s", err, response))
 		}
 		start = GetNext(instanceGroupManagerPolicyCollection.Next)
 		allrecs = append(allrecs, instanceGroupManagerPolicyCollection.Policies...)
@@ -102,5 +104,5 @@ func dataSourceIBMISInstanceGroupManagerPolicyRead(d *schema.ResourceData, meta
 			return nil
 		}
 	}
-	return fmt.Errorf("Instance group manager policy %s not found", policyName)
+	return diagFromErr(fmt.Errorf("Instance group manager policy %s not found", policyName))
 }
@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISInstanceGroupManagers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISInstanceGroupManagersRead,
+		ReadContext: dataSourceIBMISInstanceGroupManagersRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -91,10 +93,10 @@ func dataSourceIBMISInstanceGroupManagers() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISInstanceGroupManagersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISInstanceGroupManagersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceGroupID := d.Get("instance_group").(string)
@@ -109,11 +111,13 @@ func dataSourceIBMISInstanceGroupManagersRead(d *schema.ResourceData, meta inter
 		}
 		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagers(&listInstanceGroupManagerOptions)
 		if err != nil {
-			return fmt.Errorf("Error Getting InstanceGroup Managers %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error Getting InstanceGroup Managers %s\n%s", err, response))
 		}
 
 		start = GetNext(instanceGroupManagerCollections.Next)
-		allrecs = append(allrecs, instanceGroupManagerCollections.Managers...)
+		for _, manager := range instanceGroupManagerCollections.Managers {
+			allrecs = append(allrecs, *manager.(*vpcv1.InstanceGroupManager))
+		}
 
 		if start == "" {
 			break
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,7 +20,7 @@ const (
 
 func dataSourceIBMISInstanceProfiles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISInstanceProfilesRead,
+		ReadContext: dataSourceIBMISInstanceProfilesRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -47,20 +49,20 @@ func dataSourceIBMISInstanceProfiles() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISInstanceProfilesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISInstanceProfilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classicInstanceProfilesList(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := instanceProfilesList(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
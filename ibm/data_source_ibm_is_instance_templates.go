@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -84,7 +86,7 @@ const (
 
 func dataSourceIBMISInstanceTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISInstanceTemplatesRead,
+		ReadContext: dataSourceIBMISInstanceTemplatesRead,
 		Schema: map[string]*schema.Schema{
 			isInstanceTemplates: {
 				Type:        schema.TypeList,
@@ -245,15 +247,15 @@ func dataSourceIBMISInstanceTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISInstanceTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISInstanceTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	listInstanceTemplatesOptions := &vpcv1.ListInstanceTemplatesOptions{}
 	availableTemplates, _, err := instanceC.ListInstanceTemplates(listInstanceTemplatesOptions)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	templates := make([]map[string]interface{}, 0)
 	for _, instTempl := range availableTemplates.Templates {
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,7 +20,7 @@ const (
 
 func dataSourceIBMISInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISInstancesRead,
+		ReadContext: dataSourceIBMISInstancesRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_name": {
@@ -255,20 +257,20 @@ func dataSourceIBMISInstances() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classicInstancesList(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := instancesList(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
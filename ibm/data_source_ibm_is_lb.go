@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -36,7 +38,7 @@ const (
 
 func dataSourceIBMISLB() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISLBRead,
+		ReadContext: dataSourceIBMISLBRead,
 
 		Schema: map[string]*schema.Schema{
 			isLBName: {
@@ -219,21 +221,21 @@ func dataSourceIBMISLB() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISLBRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISLBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get(isLBName).(string)
 	if userDetails.generation == 1 {
 		err := classiclbGetbyName(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := lbGetByName(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
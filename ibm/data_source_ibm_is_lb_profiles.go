@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,7 +19,7 @@ const (
 
 func dataSourceIBMISLbProfiles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISLbProfilesRead,
+		ReadContext: dataSourceIBMISLbProfilesRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -49,10 +51,10 @@ func dataSourceIBMISLbProfiles() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISLbProfilesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISLbProfilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	start := ""
@@ -64,7 +66,7 @@ func dataSourceIBMISLbProfilesRead(d *schema.ResourceData, meta interface{}) err
 		}
 		profileCollectors, response, err := sess.ListLoadBalancerProfiles(listOptions)
 		if err != nil {
-			return fmt.Errorf("Error Fetching Load Balancer Profiles for VPC %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error Fetching Load Balancer Profiles for VPC %s\n%s", err, response))
 		}
 		start = GetNext(profileCollectors.Next)
 		allrecs = append(allrecs, profileCollectors.Profiles...)
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,7 +25,7 @@ const (
 
 func dataSourceIBMISLBS() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISLBSRead,
+		ReadContext: dataSourceIBMISLBSRead,
 		Schema: map[string]*schema.Schema{
 			loadBalancers: {
 				Type:        schema.TypeList,
@@ -212,21 +214,21 @@ func dataSourceIBMISLBS() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISLBSRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISLBSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classiclbs(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		fmt.Println("classics")
 	} else {
 		err := getLbs(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
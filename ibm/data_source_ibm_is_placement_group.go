@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISPlacementGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			isPlacementGroupName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeDataSourceValidator("ibm_is_placement_group", isPlacementGroupName),
+				Description:  "The name of the placement group",
+			},
+
+			isPlacementGroupStrategy: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The strategy of the placement group, host_spread or power_spread",
+			},
+
+			isPlacementGroupResourceGroup: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource group ID of the placement group",
+			},
+
+			isPlacementGroupCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the placement group",
+			},
+
+			isPlacementGroupHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the placement group",
+			},
+
+			isPlacementGroupCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the placement group was created",
+			},
+
+			isPlacementGroupLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the placement group",
+			},
+
+			isPlacementGroupResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the placement group",
+			},
+		},
+	}
+}
+
+func dataSourceIBMISPlacementGroupValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isPlacementGroupName,
+			ValidateFunctionIdentifier: ValidateNoZeroValues,
+			Type:                       TypeString})
+
+	ibmISPlacementGroupDataSourceValidator := ResourceValidator{ResourceName: "ibm_is_placement_group", Schema: validateSchema}
+	return &ibmISPlacementGroupDataSourceValidator
+}
+
+func dataSourceIBMISPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := placementGroupsClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	name := d.Get(isPlacementGroupName).(string)
+	placementGroups, err := client.ListPlacementGroups()
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error Fetching Placement Groups: %s", err))
+	}
+	for _, placementGroup := range placementGroups {
+		if placementGroup.Name == name {
+			d.SetId(placementGroup.ID)
+			setPlacementGroupAttributes(d, &placementGroup)
+			return nil
+		}
+	}
+	return diagFromErr(fmt.Errorf("No placement group found with name %s", name))
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISPlacementGroupDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-pgroup-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISPlacementGroupConfig(name) + `
+	data "ibm_is_placement_group" "testacc_pgroup" {
+		name = ibm_is_placement_group.testacc_pgroup.name
+	}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_placement_group.testacc_pgroup", "id", "ibm_is_placement_group.testacc_pgroup", "id"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_placement_group.testacc_pgroup", "strategy", "host_spread"),
+				),
+			},
+		},
+	})
+}
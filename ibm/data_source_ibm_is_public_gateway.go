@@ -4,17 +4,19 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISPublicGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISPublicGatewayRead,
+		ReadContext: dataSourceIBMISPublicGatewayRead,
 
 		Schema: map[string]*schema.Schema{
 			isPublicGatewayName: {
@@ -95,21 +97,21 @@ func dataSourceIBMISPublicGateway() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISPublicGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get(isPublicGatewayName).(string)
 	if userDetails.generation == 1 {
 		err := classicPublicGatewayGet(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := publicGatewayGet(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -4,8 +4,11 @@
 package ibm
 
 import (
+	"context"
+
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,7 +20,7 @@ const (
 
 func dataSourceIBMISRegion() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISRegionRead,
+		ReadContext: dataSourceIBMISRegionRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -39,21 +42,21 @@ func dataSourceIBMISRegion() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISRegionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get("name").(string)
 	if userDetails.generation == 1 {
 		err := classicRegionGet(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := regionGet(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"reflect"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,7 +31,7 @@ const (
 func dataSourceIBMISSecurityGroup() *schema.Resource {
 	return &schema.Resource{
 
-		Read: dataSourceIBMISSecurityGroupRuleRead,
+		ReadContext: dataSourceIBMISSecurityGroupRuleRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -132,21 +134,21 @@ func dataSourceIBMISSecurityGroup() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	sgName := d.Get(isSgName).(string)
 	if userDetails.generation == 1 {
 		err := classicSecurityGroupGet(d, meta, sgName)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := securityGroupGet(d, meta, sgName)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
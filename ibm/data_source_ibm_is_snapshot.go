@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMISSnapshot() *schema.Resource {
+	snapshotSchema := dataSourceIBMISSnapshotAttributes()
+	snapshotSchema[isSnapshotName] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{isSnapshotName, isSnapshotIdentifier},
+		ValidateFunc: InvokeDataSourceValidator("ibm_is_snapshot", isSnapshotName),
+		Description:  "Snapshot name",
+	}
+	snapshotSchema[isSnapshotIdentifier] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{isSnapshotName, isSnapshotIdentifier},
+		Description:  "Snapshot ID",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISSnapshotRead,
+		Schema:      snapshotSchema,
+	}
+}
+
+// dataSourceIBMISSnapshotAttributes returns the computed attributes of a snapshot of the
+// ibm_is_snapshot and ibm_is_snapshots data sources
+func dataSourceIBMISSnapshotAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		isSnapshotSourceVolume: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the source volume of the snapshot",
+		},
+		isSnapshotSourceImage: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the image the source volume was provisioned from, for a bootable snapshot",
+		},
+		isSnapshotResourceGroup: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The resource group ID of the snapshot",
+		},
+		isSnapshotBootable: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates if a boot volume attachment can be created with a volume created from this snapshot",
+		},
+		isSnapshotCRN: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The CRN of the snapshot",
+		},
+		isSnapshotDeletable: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether this snapshot can be deleted",
+		},
+		isSnapshotEncryption: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of encryption used on the source volume, provider_managed or user_managed",
+		},
+		isSnapshotEncryptionKey: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The CRN of the root key used to wrap the data encryption key for the source volume",
+		},
+		isSnapshotHref: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The URL for the snapshot",
+		},
+		isSnapshotLifecycleState: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The lifecycle state of the snapshot",
+		},
+		isSnapshotMinCapacity: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The minimum capacity of a volume created from this snapshot, in gigabytes",
+		},
+		isSnapshotOperatingSystem: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the operating system of the bootable snapshot",
+		},
+		isSnapshotResourceType: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The resource type of the snapshot",
+		},
+		isSnapshotSize: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The size of the snapshot, in gigabytes",
+		},
+	}
+}
+
+func dataSourceIBMISSnapshotValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isSnapshotName,
+			ValidateFunctionIdentifier: ValidateNoZeroValues,
+			Type:                       TypeString})
+
+	ibmISSnapshotDataSourceValidator := ResourceValidator{ResourceName: "ibm_is_snapshot", Schema: validateSchema}
+	return &ibmISSnapshotDataSourceValidator
+}
+
+func dataSourceIBMISSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var snapshot *vpcv1.Snapshot
+	if id, ok := d.GetOk(isSnapshotIdentifier); ok {
+		idstr := id.(string)
+		options := &vpcv1.GetSnapshotOptions{
+			ID: &idstr,
+		}
+		found, response, err := sess.GetSnapshot(options)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error Getting Snapshot (%s): %s\n%s", idstr, err, response))
+		}
+		snapshot = found
+	} else {
+		name := d.Get(isSnapshotName).(string)
+		snapshots, err := listSnapshots(sess, &vpcv1.ListSnapshotsOptions{Name: &name})
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if len(snapshots) == 0 {
+			return diagFromErr(fmt.Errorf("No snapshot found with name %s", name))
+		}
+		snapshot = &snapshots[0]
+	}
+
+	d.SetId(*snapshot.ID)
+	d.Set(isSnapshotIdentifier, *snapshot.ID)
+	setSnapshotAttributes(d, snapshot)
+	return nil
+}
+
+// listSnapshots lists all the pages of the snapshots matching the filters of the options
+func listSnapshots(sess *vpcv1.VpcV1, options *vpcv1.ListSnapshotsOptions) ([]vpcv1.Snapshot, error) {
+	start := ""
+	allrecs := []vpcv1.Snapshot{}
+	for {
+		if start != "" {
+			options.Start = &start
+		}
+		snapshots, response, err := sess.ListSnapshots(options)
+		if err != nil {
+			return nil, fmt.Errorf("Error Fetching Snapshots: %s\n%s", err, response)
+		}
+		start = GetNext(snapshots.Next)
+		allrecs = append(allrecs, snapshots.Snapshots...)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
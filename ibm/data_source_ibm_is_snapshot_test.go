@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISSnapshotDataSource_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	snapshotname := fmt.Sprintf("tf-snapshot-%d", acctest.RandIntRange(10, 100))
+	volname := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSnapshotDataSourceConfig(vpcname, subnetname, sshname, publicKey, name, snapshotname, volname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_snapshot.by_name", "id", "ibm_is_snapshot.testacc_snapshot", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.ibm_is_snapshot.by_id", "name", "ibm_is_snapshot.testacc_snapshot", "name"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_snapshots.by_volume", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_snapshots.by_volume", "snapshots.0.name", snapshotname),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSnapshotDataSourceConfig(vpcname, subnetname, sshname, publicKey, name, snapshotname, volname string) string {
+	return testAccCheckIBMISSnapshotConfig(vpcname, subnetname, sshname, publicKey, name, snapshotname, volname) + `
+	data "ibm_is_snapshot" "by_name" {
+		name = ibm_is_snapshot.testacc_snapshot.name
+	}
+
+	data "ibm_is_snapshot" "by_id" {
+		identifier = ibm_is_snapshot.testacc_snapshot.id
+	}
+
+	data "ibm_is_snapshots" "by_volume" {
+		source_volume = ibm_is_snapshot.testacc_snapshot.source_volume
+	}`
+}
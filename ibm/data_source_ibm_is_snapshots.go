@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSnapshots = "snapshots"
+)
+
+func dataSourceIBMISSnapshots() *schema.Resource {
+	snapshotSchema := dataSourceIBMISSnapshotAttributes()
+	snapshotSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Snapshot ID",
+	}
+	snapshotSchema[isSnapshotName] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Snapshot name",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISSnapshotsRead,
+
+		Schema: map[string]*schema.Schema{
+			isSnapshotResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the snapshots by resource group ID",
+			},
+			isSnapshotName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the snapshots by name",
+			},
+			isSnapshotSourceVolume: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the snapshots by source volume ID",
+			},
+			isSnapshotSourceImage: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the snapshots by source image ID",
+			},
+			isSnapshots: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of snapshots",
+				Elem: &schema.Resource{
+					Schema: snapshotSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	options := &vpcv1.ListSnapshotsOptions{}
+	if rg, ok := d.GetOk(isSnapshotResourceGroup); ok {
+		rgstr := rg.(string)
+		options.ResourceGroupID = &rgstr
+	}
+	if name, ok := d.GetOk(isSnapshotName); ok {
+		namestr := name.(string)
+		options.Name = &namestr
+	}
+	if volume, ok := d.GetOk(isSnapshotSourceVolume); ok {
+		volumestr := volume.(string)
+		options.SourceVolumeID = &volumestr
+	}
+	if image, ok := d.GetOk(isSnapshotSourceImage); ok {
+		imagestr := image.(string)
+		options.SourceImageID = &imagestr
+	}
+	snapshots, err := listSnapshots(sess, options)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	snapshotsInfo := make([]map[string]interface{}, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		l := map[string]interface{}{
+			"id":                     *snapshot.ID,
+			isSnapshotName:           *snapshot.Name,
+			isSnapshotBootable:       *snapshot.Bootable,
+			isSnapshotCRN:            *snapshot.CRN,
+			isSnapshotDeletable:      *snapshot.Deletable,
+			isSnapshotEncryption:     *snapshot.Encryption,
+			isSnapshotHref:           *snapshot.Href,
+			isSnapshotLifecycleState: *snapshot.LifecycleState,
+			isSnapshotMinCapacity:    *snapshot.MinimumCapacity,
+			isSnapshotResourceType:   *snapshot.ResourceType,
+			isSnapshotSize:           *snapshot.Size,
+		}
+		if snapshot.EncryptionKey != nil {
+			l[isSnapshotEncryptionKey] = *snapshot.EncryptionKey.CRN
+		}
+		if snapshot.OperatingSystem != nil {
+			l[isSnapshotOperatingSystem] = *snapshot.OperatingSystem.Name
+		}
+		if snapshot.ResourceGroup != nil {
+			l[isSnapshotResourceGroup] = *snapshot.ResourceGroup.ID
+		}
+		if snapshot.SourceImage != nil {
+			l[isSnapshotSourceImage] = *snapshot.SourceImage.ID
+		}
+		if snapshot.SourceVolume != nil {
+			l[isSnapshotSourceVolume] = *snapshot.SourceVolume.ID
+		}
+		snapshotsInfo = append(snapshotsInfo, l)
+	}
+	d.SetId(dataSourceIBMISSnapshotsID(d))
+	d.Set(isSnapshots, snapshotsInfo)
+	return nil
+}
+
+// dataSourceIBMISSnapshotsID returns a reasonable ID for a snapshot list.
+func dataSourceIBMISSnapshotsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
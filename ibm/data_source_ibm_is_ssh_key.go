@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISSSHKey() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISSSHKeyRead,
+		ReadContext: dataSourceIBMISSSHKeyRead,
 
 		Schema: map[string]*schema.Schema{
 			isKeyName: {
@@ -67,21 +69,21 @@ func dataSourceIBMISSSHKey() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get(isKeyName).(string)
 	if userDetails.generation == 1 {
 		err := classicKeyGetByName(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := keyGetByName(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
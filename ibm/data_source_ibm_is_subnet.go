@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISSubnet() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISSubnetRead,
+		ReadContext: dataSourceIBMISSubnetRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -132,20 +134,20 @@ func dataSourceIBMISSubnetValidator() *ResourceValidator {
 	return &ibmISSubnetDataSourceValidator
 }
 
-func dataSourceIBMISSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classicSubnetGetByNameOrID(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := subnetGetByNameOrID(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +21,7 @@ const (
 
 func dataSourceIBMISSubnets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISSubnetsRead,
+		ReadContext: dataSourceIBMISSubnetsRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -88,20 +90,20 @@ func dataSourceIBMISSubnets() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISSubnetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISSubnetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classicSubnetList(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := subnetList(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
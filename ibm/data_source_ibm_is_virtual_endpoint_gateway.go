@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISEndpointGateway() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataSourceIBMISEndpointGatewayRead,
-		Importer: &schema.ResourceImporter{},
+		ReadContext: dataSourceIBMISEndpointGatewayRead,
+		Importer:    &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
 			isVirtualEndpointGatewayName: {
@@ -98,12 +100,12 @@ func dataSourceIBMISEndpointGateway() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISEndpointGatewayRead(
-	d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISEndpointGatewayRead(ctx context.Context,
+	d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var found bool
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get(isVirtualEndpointGatewayName).(string)
@@ -117,7 +119,7 @@ func dataSourceIBMISEndpointGatewayRead(
 		}
 		result, response, err := sess.ListEndpointGateways(options)
 		if err != nil {
-			return fmt.Errorf("Error fetching endpoint gateways %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error fetching endpoint gateways %s\n%s", err, response))
 		}
 		start = GetNext(result.Next)
 		allrecs = append(allrecs, result.EndpointGateways...)
@@ -143,7 +145,7 @@ func dataSourceIBMISEndpointGatewayRead(
 		}
 	}
 	if !found {
-		return fmt.Errorf("No Virtual Endpoints Gateway found with given name %s", name)
+		return diagFromErr(fmt.Errorf("No Virtual Endpoints Gateway found with given name %s", name))
 	}
 	return nil
 }
@@ -4,17 +4,19 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISEndpointGatewayIPs() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataSourceIBMISEndpointGatewayIPsRead,
-		Importer: &schema.ResourceImporter{},
+		ReadContext: dataSourceIBMISEndpointGatewayIPsRead,
+		Importer:    &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			isVirtualEndpointGatewayID: {
 				Type:     schema.TypeString,
@@ -86,10 +88,10 @@ func dataSourceIBMISEndpointGatewayIPs() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISEndpointGatewayIPsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISEndpointGatewayIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	gatewayID := d.Get(isVirtualEndpointGatewayID).(string)
 
@@ -102,7 +104,7 @@ func dataSourceIBMISEndpointGatewayIPsRead(d *schema.ResourceData, meta interfac
 		}
 		result, response, err := sess.ListEndpointGatewayIps(options)
 		if err != nil {
-			return fmt.Errorf("Error fetching endpoint gateway ips %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error fetching endpoint gateway ips %s\n%s", err, response))
 		}
 		start = GetNext(result.Next)
 		allrecs = append(allrecs, result.Ips...)
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,8 +20,8 @@ const (
 
 func dataSourceIBMISEndpointGateways() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataSourceIBMISEndpointGatewaysRead,
-		Importer: &schema.ResourceImporter{},
+		ReadContext: dataSourceIBMISEndpointGatewaysRead,
+		Importer:    &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			isVirtualEndpointGateways: {
 				Type:     schema.TypeList,
@@ -116,10 +118,10 @@ func dataSourceIBMISEndpointGateways() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISEndpointGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	start := ""
@@ -131,7 +133,7 @@ func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{
 		}
 		result, response, err := sess.ListEndpointGateways(options)
 		if err != nil {
-			return fmt.Errorf("Error fetching endpoint gateways %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error fetching endpoint gateways %s\n%s", err, response))
 		}
 		start = GetNext(result.Next)
 		allrecs = append(allrecs, result.EndpointGateways...)
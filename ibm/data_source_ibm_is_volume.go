@@ -4,17 +4,19 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISVolume() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISVolumeRead,
+		ReadContext: dataSourceIBMISVolumeRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -127,21 +129,21 @@ func dataSourceIBMISVolumeValidator() *ResourceValidator {
 	return &ibmISVoulmeDataSourceValidator
 }
 
-func dataSourceIBMISVolumeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get(isVolumeName).(string)
 	if userDetails.generation == 1 {
 		err := classicVolumeGet(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := volumeGet(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -4,8 +4,11 @@
 package ibm
 
 import (
+	"context"
+
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,7 +19,7 @@ const (
 
 func dataSourceIBMISVolumeProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISVolumeProfileRead,
+		ReadContext: dataSourceIBMISVolumeProfileRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -35,21 +38,21 @@ func dataSourceIBMISVolumeProfile() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISVolumeProfileRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISVolumeProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get(isVolumeProfile).(string)
 	if userDetails.generation == 1 {
 		err := classicVolumeProfileGet(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := volumeProfileGet(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,7 +20,7 @@ const (
 
 func dataSourceIBMISVolumeProfiles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISVolumeProfilesRead,
+		ReadContext: dataSourceIBMISVolumeProfilesRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -43,20 +45,20 @@ func dataSourceIBMISVolumeProfiles() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISVolumeProfilesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISVolumeProfilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classicVolumeProfilesList(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := volumeProfilesList(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
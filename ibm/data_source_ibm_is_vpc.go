@@ -4,18 +4,20 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMISVPC() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISVPCRead,
+		ReadContext: dataSourceIBMISVPCRead,
 
 		Schema: map[string]*schema.Schema{
 			isVPCDefaultNetworkACL: {
@@ -257,22 +259,22 @@ func dataSourceIBMISVpcValidator() *ResourceValidator {
 	return &ibmISVpcDataSourceValidator
 }
 
-func dataSourceIBMISVPCRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get(isVPCName).(string)
 	if userDetails.generation == 1 {
 		err := classicVpcGetByName(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpcGetByName(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -28,7 +30,7 @@ const (
 
 func dataSourceIBMISVPCDefaultRoutingTable() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISVPCDefaultRoutingTableGet,
+		ReadContext: dataSourceIBMISVPCDefaultRoutingTableGet,
 		Schema: map[string]*schema.Schema{
 			isDefaultRTVpcID: {
 				Type:        schema.TypeString,
@@ -127,11 +129,11 @@ func dataSourceIBMISVPCDefaultRoutingTable() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISVPCDefaultRoutingTableGet(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISVPCDefaultRoutingTableGet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	vpcID := d.Get(isDefaultRTVpcID).(string)
@@ -140,7 +142,7 @@ func dataSourceIBMISVPCDefaultRoutingTableGet(d *schema.ResourceData, meta inter
 	result, detail, err := sess.GetVPCDefaultRoutingTable(getVpcDefaultRoutingTableOptions)
 	if err != nil {
 		log.Printf("Error reading details of VPC Default Routing Table:%s", detail)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(isDefaultRoutingTableID, *result.ID)
 	d.Set(isDefaultRoutingTableHref, *result.Href)
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -28,7 +30,7 @@ const (
 
 func dataSourceIBMISVPCRoutingTableRoutes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISVPCRoutingTableRoutesList,
+		ReadContext: dataSourceIBMISVPCRoutingTableRoutesList,
 		Schema: map[string]*schema.Schema{
 			isRoutingTableRouteVpcID: {
 				Type:        schema.TypeString,
@@ -98,10 +100,10 @@ func dataSourceIBMISVPCRoutingTableRoutes() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISVPCRoutingTableRoutesList(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISVPCRoutingTableRoutesList(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	vpcID := d.Get(isRoutingTableRouteVpcID).(string)
@@ -116,7 +118,7 @@ func dataSourceIBMISVPCRoutingTableRoutesList(d *schema.ResourceData, meta inter
 		result, detail, err := sess.ListVPCRoutingTableRoutes(listVpcRoutingTablesRoutesOptions)
 		if err != nil {
 			log.Printf("Error reading list of VPC Routing Table Routes:%s\n%s", err, detail)
-			return err
+			return diagFromErr(err)
 		}
 		start = GetNext(result.Next)
 		allrecs = append(allrecs, result.Routes...)
@@ -5,10 +5,12 @@ package ibm
 
 import (
 	//"encoding/json"
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -31,7 +33,7 @@ const (
 
 func dataSourceIBMISVPCRoutingTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISVPCRoutingTablesList,
+		ReadContext: dataSourceIBMISVPCRoutingTablesList,
 		Schema: map[string]*schema.Schema{
 			isVpcID: {
 				Type:        schema.TypeString,
@@ -139,10 +141,10 @@ func dataSourceIBMISVPCRoutingTables() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISVPCRoutingTablesList(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISVPCRoutingTablesList(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	vpcID := d.Get(isVpcID).(string)
@@ -157,7 +159,7 @@ func dataSourceIBMISVPCRoutingTablesList(d *schema.ResourceData, meta interface{
 		result, detail, err := sess.ListVPCRoutingTables(listOptions)
 		if err != nil {
 			log.Printf("Error reading list of VPC Routing Tables:%s\n%s", err, detail)
-			return err
+			return diagFromErr(err)
 		}
 		start = GetNext(result.Next)
 		allrecs = append(allrecs, result.RoutingTables...)
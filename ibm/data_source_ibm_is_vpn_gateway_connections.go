@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +21,7 @@ const (
 
 func dataSourceIBMISVPNGatewayConnections() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMVPNGatewayConnectionsRead,
+		ReadContext: dataSourceIBMVPNGatewayConnectionsRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -152,18 +154,18 @@ func dataSourceIBMISVPNGatewayConnections() *schema.Resource {
 	}
 }
 
-func dataSourceIBMVPNGatewayConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMVPNGatewayConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	vpngatewayID := d.Get(isVPNGatewayID).(string)
 	listvpnGWConnectionOptions := sess.NewListVPNGatewayConnectionsOptions(vpngatewayID)
 
 	availableVPNGatewayConnections, detail, err := sess.ListVPNGatewayConnections(listvpnGWConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("Error reading list of VPN Gateway Connections:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error reading list of VPN Gateway Connections:%s\n%s", err, detail))
 	}
 	vpngatewayconnections := make([]map[string]interface{}, 0)
 	for _, instance := range availableVPNGatewayConnections.Connections {
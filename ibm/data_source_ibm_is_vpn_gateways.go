@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +21,7 @@ const (
 
 func dataSourceIBMISVPNGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMVPNGatewaysRead,
+		ReadContext: dataSourceIBMVPNGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -105,11 +107,11 @@ func dataSourceIBMISVPNGateways() *schema.Resource {
 	}
 }
 
-func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMVPNGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	listvpnGWOptions := sess.NewListVPNGatewaysOptions()
@@ -122,7 +124,7 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 		}
 		availableVPNGateways, detail, err := sess.ListVPNGateways(listvpnGWOptions)
 		if err != nil {
-			return fmt.Errorf("Error reading list of VPN Gateways:%s\n%s", err, detail)
+			return diagFromErr(fmt.Errorf("Error reading list of VPN Gateways:%s\n%s", err, detail))
 		}
 		start = GetNext(availableVPNGateways.Next)
 		allrecs = append(allrecs, availableVPNGateways.VPNGateways...)
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +21,7 @@ const (
 
 func dataSourceIBMISZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISZoneRead,
+		ReadContext: dataSourceIBMISZoneRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -41,22 +43,22 @@ func dataSourceIBMISZone() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISZoneRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	regionName := d.Get(isZoneRegion).(string)
 	zoneName := d.Get(isZoneName).(string)
 	if userDetails.generation == 1 {
 		err := classicZoneGet(d, meta, regionName, zoneName)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := zoneGet(d, meta, regionName, zoneName)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
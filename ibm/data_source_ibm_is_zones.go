@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,7 +19,7 @@ const (
 
 func dataSourceIBMISZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMISZonesRead,
+		ReadContext: dataSourceIBMISZonesRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -40,21 +42,21 @@ func dataSourceIBMISZones() *schema.Resource {
 	}
 }
 
-func dataSourceIBMISZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMISZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	regionName := d.Get(isZoneRegion).(string)
 	if userDetails.generation == 1 {
 		err := classicZonesList(d, meta, regionName)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := zonesList(d, meta, regionName)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
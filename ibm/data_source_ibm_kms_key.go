@@ -11,12 +11,13 @@ import (
 	"strings"
 
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMKMSkey() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMKMSKeyRead,
+		ReadContext: dataSourceIBMKMSKeyRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -57,6 +58,43 @@ func dataSourceIBMKMSkey() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"registrations": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The cloud resources registered with the key",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_crn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"prevent_key_deletion": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"created_by": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"creation_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"updated_by": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_update_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"policies": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -137,15 +175,15 @@ func dataSourceIBMKMSkey() *schema.Resource {
 
 }
 
-func dataSourceIBMKMSKeyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMKMSKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
@@ -155,7 +193,7 @@ func dataSourceIBMKMSKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	instanceData, err := rContollerApi.GetInstance(instanceID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceCRN := instanceData.Crn.String()
 
@@ -166,11 +204,11 @@ func dataSourceIBMKMSKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 		hpcsEndpointApi, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		resp, err := hpcsEndpointApi.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -181,7 +219,7 @@ func dataSourceIBMKMSKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diagFromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 		}
 		api.URL = u
 	} else if crnData[4] == "kms" {
@@ -191,18 +229,18 @@ func dataSourceIBMKMSKeyRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diagFromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 
 	api.Config.InstanceID = instanceID
-	keys, err := api.GetKeys(context.Background(), 100, 0)
+	keys, err := api.GetKeys(ctx, 100, 0)
 	if err != nil {
-		return fmt.Errorf(
-			"Get Keys failed with error: %s", err)
+		return diagFromErr(fmt.Errorf(
+			"Get Keys failed with error: %s", err))
 	}
 	retreivedKeys := keys.Keys
 	if len(retreivedKeys) == 0 {
-		return fmt.Errorf("No keys in instance  %s", instanceID)
+		return diagFromErr(fmt.Errorf("No keys in instance  %s", instanceID))
 	}
 	var keyName string
 	var matchKeys []kp.Key
@@ -218,7 +256,7 @@ func dataSourceIBMKMSKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(matchKeys) == 0 {
-		return fmt.Errorf("No keys with name %s in instance  %s", keyName, instanceID)
+		return diagFromErr(fmt.Errorf("No keys with name %s in instance  %s", keyName, instanceID))
 	}
 
 	keyMap := make([]map[string]interface{}, 0, len(matchKeys))
@@ -229,15 +267,23 @@ func dataSourceIBMKMSKeyRead(d *schema.ResourceData, meta interface{}) error {
 		keyInstance["name"] = key.Name
 		keyInstance["crn"] = key.CRN
 		keyInstance["standard_key"] = key.Extractable
-		policies, err := api.GetPolicies(context.Background(), key.ID)
+		policies, err := api.GetPolicies(ctx, key.ID)
 		if err != nil {
-			return fmt.Errorf("Failed to read policies: %s", err)
+			return diagFromErr(fmt.Errorf("Failed to read policies: %s", err))
 		}
 		if len(policies) == 0 {
 			log.Printf("No Policy Configurations read\n")
 		} else {
 			keyInstance["policies"] = flattenKeyPolicies(policies)
 		}
+		// The registrations need their own permission, the key is read without them
+		registrations, err := api.ListRegistrations(ctx, key.ID, "")
+		if err != nil {
+			log.Printf("[WARN] Failed to read registrations of key %s: %s", key.ID, err)
+			keyInstance["registrations"] = []map[string]interface{}{}
+		} else {
+			keyInstance["registrations"] = flattenKeyRegistrations(registrations.Registrations)
+		}
 		keyMap = append(keyMap, keyInstance)
 
 	}
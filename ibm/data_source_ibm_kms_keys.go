@@ -10,12 +10,13 @@ import (
 	"strings"
 
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMKMSkeys() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMKMSKeysRead,
+		ReadContext: dataSourceIBMKMSKeysRead,
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -137,15 +138,15 @@ func dataSourceIBMKMSkeys() *schema.Resource {
 
 }
 
-func dataSourceIBMKMSKeysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMKMSKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
@@ -155,7 +156,7 @@ func dataSourceIBMKMSKeysRead(d *schema.ResourceData, meta interface{}) error {
 
 	instanceData, err := rContollerApi.GetInstance(instanceID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceCRN := instanceData.Crn.String()
 
@@ -165,11 +166,11 @@ func dataSourceIBMKMSKeysRead(d *schema.ResourceData, meta interface{}) error {
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointApi, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		resp, err := hpcsEndpointApi.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -180,7 +181,7 @@ func dataSourceIBMKMSKeysRead(d *schema.ResourceData, meta interface{}) error {
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diagFromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 		}
 		api.URL = u
 	} else if crnData[4] == "kms" {
@@ -190,18 +191,18 @@ func dataSourceIBMKMSKeysRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diagFromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 
 	api.Config.InstanceID = instanceID
-	keys, err := api.GetKeys(context.Background(), 100, 0)
+	keys, err := api.GetKeys(ctx, 100, 0)
 	if err != nil {
-		return fmt.Errorf(
-			"Get Keys failed with error: %s", err)
+		return diagFromErr(fmt.Errorf(
+			"Get Keys failed with error: %s", err))
 	}
 	retreivedKeys := keys.Keys
 	if len(retreivedKeys) == 0 {
-		return fmt.Errorf("No keys in instance  %s", instanceID)
+		return diagFromErr(fmt.Errorf("No keys in instance  %s", instanceID))
 	}
 	var keyName string
 	var matchKeys []kp.Key
@@ -217,7 +218,7 @@ func dataSourceIBMKMSKeysRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(matchKeys) == 0 {
-		return fmt.Errorf("No keys with name %s in instance  %s", keyName, instanceID)
+		return diagFromErr(fmt.Errorf("No keys with name %s in instance  %s", keyName, instanceID))
 	}
 
 	keyMap := make([]map[string]interface{}, 0, len(matchKeys))
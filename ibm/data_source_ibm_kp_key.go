@@ -8,12 +8,13 @@ import (
 	"fmt"
 
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMkey() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMKeyRead,
+		ReadContext: dataSourceIBMKeyRead,
 
 		Schema: map[string]*schema.Schema{
 			"key_protect_id": {
@@ -53,22 +54,22 @@ func dataSourceIBMkey() *schema.Resource {
 
 }
 
-func dataSourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(ClientSession).keyProtectAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Get("key_protect_id").(string)
 	api.Config.InstanceID = instanceID
-	keys, err := api.GetKeys(context.Background(), 100, 0)
+	keys, err := api.GetKeys(ctx, 100, 0)
 	if err != nil {
-		return fmt.Errorf(
-			"Get Keys failed with error: %s", err)
+		return diagFromErr(fmt.Errorf(
+			"Get Keys failed with error: %s", err))
 	}
 	retreivedKeys := keys.Keys
 	if len(retreivedKeys) == 0 {
-		return fmt.Errorf("No keys in instance  %s", instanceID)
+		return diagFromErr(fmt.Errorf("No keys in instance  %s", instanceID))
 	}
 	var keyName string
 	var matchKeys []kp.Key
@@ -84,7 +85,7 @@ func dataSourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(matchKeys) == 0 {
-		return fmt.Errorf("No keys with name %s in instance  %s", keyName, instanceID)
+		return diagFromErr(fmt.Errorf("No keys with name %s in instance  %s", keyName, instanceID))
 	}
 
 	keyMap := make([]map[string]interface{}, 0, len(matchKeys))
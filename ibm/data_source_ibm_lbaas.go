@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/filter"
 	"github.com/softlayer/softlayer-go/services"
@@ -13,7 +15,7 @@ import (
 
 func dataSourceIBMLbaas() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMLbaasRead,
+		ReadContext: dataSourceIBMLbaasRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -170,29 +172,29 @@ func dataSourceIBMLbaas() *schema.Resource {
 	}
 }
 
-func dataSourceIBMLbaasRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMLbaasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 	lbs, err := service.Mask("datacenter,members,listeners.defaultPool,listeners.defaultPool.sessionAffinity,listeners.defaultPool.healthMonitor,healthMonitors,sslCiphers[name],useSystemPublicIpPool,isPublic,name,description,operatingStatus,address,uuid").Filter(filter.Build(
 		filter.Path("name").Eq(name))).GetAllObjects()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if len(lbs) != 1 {
-		return fmt.Errorf("No load balancer with name: %s", name)
+		return diagFromErr(fmt.Errorf("No load balancer with name: %s", name))
 	}
 	result := lbs[0]
 
 	//Get statistics
 	lbStat, err := service.GetLoadBalancerStatistics(result.Uuid)
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer statistics: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving load balancer statistics: %s", err))
 	}
 	//Get members health
 	lbMembersHealth, err := service.GetLoadBalancerMemberHealth(result.Uuid)
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer members: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving load balancer members: %s", err))
 	}
 	members := flattenServerInstances(result.Members)
 
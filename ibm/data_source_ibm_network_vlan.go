@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -16,7 +18,7 @@ import (
 
 func dataSourceIBMNetworkVlan() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMNetworkVlanRead,
+		ReadContext: dataSourceIBMNetworkVlanRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -97,7 +99,7 @@ func dataSourceIBMNetworkVlan() *schema.Resource {
 	}
 }
 
-func dataSourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMNetworkVlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetAccountService(sess)
 
@@ -111,7 +113,7 @@ func dataSourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) erro
 		// Got vlan number and router, get vlan, and compute name
 		vlan, err = getVlan(number, routerHostname, name, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		d.SetId(fmt.Sprintf("%d", *vlan.Id))
@@ -133,9 +135,9 @@ func dataSourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) erro
 			).
 			GetNetworkVlans()
 		if err != nil {
-			return fmt.Errorf("Error obtaining VLAN id: %s", err)
+			return diagFromErr(fmt.Errorf("Error obtaining VLAN id: %s", err))
 		} else if len(networkVlans) == 0 {
-			return fmt.Errorf("No VLAN was found with the name '%s'", name)
+			return diagFromErr(fmt.Errorf("No VLAN was found with the name '%s'", name))
 		}
 
 		vlan = &networkVlans[0]
@@ -146,7 +148,7 @@ func dataSourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) erro
 			d.Set("router_hostname", *vlan.PrimaryRouter.Hostname)
 		}
 	} else {
-		return errors.New("missing required properties. Need a VLAN name, or the VLAN's number and router hostname")
+		return diagFromErr(errors.New("missing required properties. Need a VLAN name, or the VLAN's number and router hostname"))
 	}
 
 	// Get subnets in cidr format for display
@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMOrg() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMOrgRead,
+		ReadContext: dataSourceIBMOrgRead,
 
 		Schema: map[string]*schema.Schema{
 			"org": {
@@ -31,10 +33,10 @@ func dataSourceIBMOrg() *schema.Resource {
 	}
 }
 
-func dataSourceIBMOrgRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMOrgRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	var org string
@@ -47,7 +49,7 @@ func dataSourceIBMOrgRead(d *schema.ResourceData, meta interface{}) error {
 
 	orgFields, err := orgAPI.FindByName(org, BluemixRegion)
 	if err != nil {
-		return fmt.Errorf("Error retrieving organisation: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving organisation: %s", err))
 	}
 	d.SetId(orgFields.GUID)
 
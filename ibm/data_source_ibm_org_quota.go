@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMOrgQuota() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMOrgQuotaRead,
+		ReadContext: dataSourceIBMOrgQuotaRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -78,16 +80,16 @@ func dataSourceIBMOrgQuota() *schema.Resource {
 	}
 }
 
-func dataSourceIBMOrgQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMOrgQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	orgQuotaAPI := cfClient.OrgQuotas()
 	orgQuotaName := d.Get("name").(string)
 	orgQuotaFields, err := orgQuotaAPI.FindByName(orgQuotaName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving org quota: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving org quota: %s", err))
 	}
 	d.SetId(orgQuotaFields.GUID)
 	d.Set("app_instance_limit", orgQuotaFields.AppInstanceLimit)
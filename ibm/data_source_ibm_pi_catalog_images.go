@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -20,7 +22,7 @@ Datasource to get the list of images that are available when a power instance is
 func dataSourceIBMPICatalogImages() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPICatalogImagesRead,
+		ReadContext: dataSourceIBMPICatalogImagesRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PICloudInstanceId: {
@@ -104,12 +106,12 @@ func dataSourceIBMPICatalogImages() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPICatalogImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPICatalogImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	sap := false
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -120,7 +122,7 @@ func dataSourceIBMPICatalogImagesRead(d *schema.ResourceData, meta interface{})
 	imageC := instance.NewIBMPIImageClient(sess, powerinstanceid)
 	result, err := imageC.GetSAPImages(powerinstanceid, sap)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	imageData := result.Images
 	images := make([]map[string]interface{}, 0)
@@ -4,6 +4,9 @@
 package ibm
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -15,7 +18,7 @@ import (
 func dataSourceIBMPICloudInstance() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPICloudInstanceRead,
+		ReadContext: dataSourceIBMPICloudInstanceRead,
 		Schema: map[string]*schema.Schema{
 			helpers.PICloudInstanceId: {
 				Type:         schema.TypeString,
@@ -103,12 +106,12 @@ func dataSourceIBMPICloudInstance() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPICloudInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPICloudInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -116,7 +119,7 @@ func dataSourceIBMPICloudInstanceRead(d *schema.ResourceData, meta interface{})
 	cloud_instance_data, err := cloud_instance.Get(powerinstanceid)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*cloud_instance_data.CloudInstanceID)
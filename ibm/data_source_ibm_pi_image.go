@@ -4,7 +4,10 @@
 package ibm
 
 import (
+	"context"
+
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	//"fmt"
@@ -15,7 +18,7 @@ import (
 func dataSourceIBMPIImage() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPIImagesRead,
+		ReadContext: dataSourceIBMPIImagesRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PIImageName: {
@@ -58,12 +61,12 @@ func dataSourceIBMPIImage() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPIImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPIImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -72,7 +75,7 @@ func dataSourceIBMPIImagesRead(d *schema.ResourceData, meta interface{}) error {
 	imagedata, err := imageC.Get(d.Get(helpers.PIImageName).(string), powerinstanceid)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*imagedata.ImageID)
@@ -4,9 +4,12 @@
 package ibm
 
 import (
+	"context"
+
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	//"fmt"
@@ -21,7 +24,7 @@ Datasource to get the list of images that are available when a power instance is
 func dataSourceIBMPIImages() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPIImagesAllRead,
+		ReadContext: dataSourceIBMPIImagesAllRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PIImageName: {
@@ -71,12 +74,12 @@ func dataSourceIBMPIImages() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPIImagesAllRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPIImagesAllRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -86,7 +89,7 @@ func dataSourceIBMPIImagesAllRead(d *schema.ResourceData, meta interface{}) erro
 	imagedata, err := imageC.GetAll(powerinstanceid)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var clientgenU, _ = uuid.GenerateUUID()
@@ -4,6 +4,9 @@
 package ibm
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -14,7 +17,7 @@ import (
 func dataSourceIBMPIInstance() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPIInstancesRead,
+		ReadContext: dataSourceIBMPIInstancesRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PIInstanceName: {
@@ -134,12 +137,12 @@ func dataSourceIBMPIInstance() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPIInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPIInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -148,7 +151,7 @@ func dataSourceIBMPIInstancesRead(d *schema.ResourceData, meta interface{}) erro
 	powervmdata, err := powerC.Get(d.Get(helpers.PIInstanceName).(string), powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	pvminstanceid := *powervmdata.PvmInstanceID
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"net"
 	"strconv"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,7 +19,7 @@ import (
 func dataSourceIBMPIInstanceIP() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPIInstancesIPRead,
+		ReadContext: dataSourceIBMPIInstancesIPRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PIInstanceName: {
@@ -69,12 +71,12 @@ func dataSourceIBMPIInstanceIP() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPIInstancesIPRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPIInstancesIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	checkValidSubnet(d, meta)
@@ -85,7 +87,7 @@ func dataSourceIBMPIInstancesIPRead(d *schema.ResourceData, meta interface{}) er
 	powervmdata, err := powerC.Get(d.Get(helpers.PIInstanceName).(string), powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	for i, _ := range powervmdata.Addresses {
@@ -4,9 +4,12 @@
 package ibm
 
 import (
+	"context"
+
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -16,7 +19,7 @@ import (
 func dataSourceIBMPIInstanceVolumes() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPIInstanceVolumesRead,
+		ReadContext: dataSourceIBMPIInstanceVolumesRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PIInstanceName: {
@@ -83,11 +86,11 @@ func dataSourceIBMPIInstanceVolumes() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPIInstanceVolumesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPIInstanceVolumesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -95,7 +98,7 @@ func dataSourceIBMPIInstanceVolumesRead(d *schema.ResourceData, meta interface{}
 	volumedata, err := volumeC.GetAll(d.Get(helpers.PIInstanceName).(string), powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var clientgenU, _ = uuid.GenerateUUID()
@@ -4,6 +4,9 @@
 package ibm
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -14,7 +17,7 @@ import (
 func dataSourceIBMPIKey() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPIKeysRead,
+		ReadContext: dataSourceIBMPIKeysRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PIKeyName: {
@@ -42,12 +45,12 @@ func dataSourceIBMPIKey() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPIKeysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPIKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -55,7 +58,7 @@ func dataSourceIBMPIKeysRead(d *schema.ResourceData, meta interface{}) error {
 	sshkeydata, err := sshkeyC.Get(d.Get(helpers.PIKeyName).(string), powerinstanceid)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*sshkeydata.Name)
@@ -6,6 +6,9 @@ package ibm
 import (
 	//"fmt"
 
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,7 +19,7 @@ import (
 func dataSourceIBMPINetwork() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPINetworksRead,
+		ReadContext: dataSourceIBMPINetworksRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PINetworkName: {
@@ -72,11 +75,11 @@ func dataSourceIBMPINetwork() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPINetworksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPINetworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -84,7 +87,7 @@ func dataSourceIBMPINetworksRead(d *schema.ResourceData, meta interface{}) error
 	networkdata, err := networkC.Get(d.Get(helpers.PINetworkName).(string), powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*networkdata.NetworkID)
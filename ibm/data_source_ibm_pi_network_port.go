@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 
@@ -18,7 +20,7 @@ import (
 func dataSourceIBMPINetworkPort() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPINetworkPortsRead,
+		ReadContext: dataSourceIBMPINetworkPortsRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PINetworkName: {
@@ -77,11 +79,11 @@ func dataSourceIBMPINetworkPort() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPINetworkPortsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPINetworkPortsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -89,7 +91,7 @@ func dataSourceIBMPINetworkPortsRead(d *schema.ResourceData, meta interface{}) e
 	networkportdata, err := networkportC.GetAllPort(d.Get(helpers.PINetworkName).(string), powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var clientgenU, _ = uuid.GenerateUUID()
 	d.SetId(clientgenU)
@@ -5,8 +5,10 @@ package ibm
 
 import (
 	//"fmt"
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -17,7 +19,7 @@ import (
 func dataSourceIBMPIPublicNetwork() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPIPublicNetworksRead,
+		ReadContext: dataSourceIBMPIPublicNetworksRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PINetworkName: {
@@ -59,11 +61,11 @@ func dataSourceIBMPIPublicNetwork() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPIPublicNetworksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPIPublicNetworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -71,10 +73,10 @@ func dataSourceIBMPIPublicNetworksRead(d *schema.ResourceData, meta interface{})
 	networkdata, err := networkC.GetPublic(powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if len(networkdata.Networks) < 1 {
-		return fmt.Errorf("No Public Network Found in %s", powerinstanceid)
+		return diagFromErr(fmt.Errorf("No Public Network Found in %s", powerinstanceid))
 	}
 	d.SetId(*networkdata.Networks[0].NetworkID)
 	if networkdata.Networks[0].Type != nil {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -16,7 +18,7 @@ import (
 func dataSourceIBMPISnapshot() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPISnapshotRead,
+		ReadContext: dataSourceIBMPISnapshotRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PICloudInstanceId: {
@@ -81,11 +83,11 @@ func dataSourceIBMPISnapshot() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPISnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPISnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -94,7 +96,7 @@ func dataSourceIBMPISnapshotRead(d *schema.ResourceData, meta interface{}) error
 	snapshotData, err := snapshot.GetSnapShotVM(powerinstanceid, powerinstancename, getTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var clientgenU, _ = uuid.GenerateUUID()
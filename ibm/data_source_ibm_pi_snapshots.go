@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -16,7 +18,7 @@ import (
 func dataSourceIBMPISnapshots() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPISnapshotsRead,
+		ReadContext: dataSourceIBMPISnapshotsRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PICloudInstanceId: {
@@ -72,11 +74,11 @@ func dataSourceIBMPISnapshots() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPISnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPISnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -84,7 +86,7 @@ func dataSourceIBMPISnapshotsRead(d *schema.ResourceData, meta interface{}) erro
 	snapshotData, err := snapshot.GetAll("", powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var clientgenU, _ = uuid.GenerateUUID()
@@ -4,6 +4,9 @@
 package ibm
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	//"fmt"
@@ -15,7 +18,7 @@ import (
 func dataSourceIBMPITenant() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPITenantRead,
+		ReadContext: dataSourceIBMPITenantRead,
 		Schema: map[string]*schema.Schema{
 			helpers.PICloudInstanceId: {
 				Type:         schema.TypeString,
@@ -59,12 +62,12 @@ func dataSourceIBMPITenant() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPITenantRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPITenantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -74,7 +77,7 @@ func dataSourceIBMPITenantRead(d *schema.ResourceData, meta interface{}) error {
 	tenantData, err := tenantC.Get(powerinstanceid)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*tenantData.TenantID)
@@ -6,6 +6,9 @@ package ibm
 import (
 	//"fmt"
 
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,7 +19,7 @@ import (
 func dataSourceIBMPIVolume() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMPIVolumeRead,
+		ReadContext: dataSourceIBMPIVolumeRead,
 		Schema: map[string]*schema.Schema{
 
 			helpers.PIVolumeName: {
@@ -71,18 +74,18 @@ func dataSourceIBMPIVolume() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPIVolumeRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	volumeC := instance.NewIBMPIVolumeClient(sess, powerinstanceid)
 	volumedata, err := volumeC.Get(d.Get(helpers.PIVolumeName).(string), powerinstanceid, getTimeOut)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*volumedata.VolumeID)
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,7 +18,7 @@ const (
 
 func dataSourceIBMPrivateDNSGLBMonitors() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMPrivateDNSGLBMonitorsRead,
+		ReadContext: dataSourceIBMPrivateDNSGLBMonitorsRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -128,17 +130,17 @@ func dataSourceIBMPrivateDNSGLBMonitors() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPrivateDNSGLBMonitorsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPrivateDNSGLBMonitorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	listDNSGLBMonitorions := sess.NewListMonitorsOptions(instanceID)
 	availableGLBMonitors, detail, err := sess.ListMonitors(listDNSGLBMonitorions)
 	if err != nil {
-		return fmt.Errorf("Error reading list of pdns GLB monitors:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error reading list of pdns GLB monitors:%s\n%s", err, detail))
 	}
 
 	dnsMonitors := make([]map[string]interface{}, 0)
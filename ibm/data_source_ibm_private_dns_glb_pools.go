@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,7 +18,7 @@ const (
 
 func dataSourceIBMPrivateDNSGLBPools() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMPrivateDNSGLBPoolsRead,
+		ReadContext: dataSourceIBMPrivateDNSGLBPoolsRead,
 		Schema: map[string]*schema.Schema{
 			pdnsInstanceID: {
 				Type:        schema.TypeString,
@@ -138,17 +140,17 @@ func dataSourceIBMPrivateDNSGLBPools() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPrivateDNSGLBPoolsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPrivateDNSGLBPoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	listDNSGLBPooloptions := sess.NewListPoolsOptions(instanceID)
 	availableGLBPools, detail, err := sess.ListPools(listDNSGLBPooloptions)
 	if err != nil {
-		return fmt.Errorf("Error reading list of pdns GLB pools:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error reading list of pdns GLB pools:%s\n%s", err, detail))
 	}
 	d.Set(pdnsInstanceID, instanceID)
 	dnsPools := make([]map[string]interface{}, 0)
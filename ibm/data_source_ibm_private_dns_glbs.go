@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,7 +18,7 @@ const (
 
 func dataSourceIBMPrivateDNSGLBs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMPrivateDNSGLBsRead,
+		ReadContext: dataSourceIBMPrivateDNSGLBsRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -119,18 +121,18 @@ func dataSourceIBMPrivateDNSGLBs() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPrivateDNSGLBsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPrivateDNSGLBsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	zoneID := d.Get(pdnsZoneID).(string)
 	listDNSGLBs := sess.NewListLoadBalancersOptions(instanceID, zoneID)
 	availableGLBs, detail, err := sess.ListLoadBalancers(listDNSGLBs)
 	if err != nil {
-		return fmt.Errorf("Error reading list of pdns GLB load balancers:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error reading list of pdns GLB load balancers:%s\n%s", err, detail))
 	}
 
 	dnslbs := make([]interface{}, 0)
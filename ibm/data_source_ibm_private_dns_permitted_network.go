@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,7 +18,7 @@ const (
 
 func dataSourceIBMPrivateDNSPermittedNetworks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMPrivateDNSPermittedNetworksRead,
+		ReadContext: dataSourceIBMPrivateDNSPermittedNetworksRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -95,10 +97,10 @@ func dataSourceIBMPrivateDNSPermittedNetworks() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPrivateDNSPermittedNetworksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPrivateDNSPermittedNetworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Get(pdnsInstanceID).(string)
@@ -106,7 +108,7 @@ func dataSourceIBMPrivateDNSPermittedNetworksRead(d *schema.ResourceData, meta i
 	listPermittedNetworkOptions := sess.NewListPermittedNetworksOptions(instanceID, dnsZoneID)
 	availablePermittedNetworks, detail, err := sess.ListPermittedNetworks(listPermittedNetworkOptions)
 	if err != nil {
-		return fmt.Errorf("Error reading list of pdns permitted networks:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error reading list of pdns permitted networks:%s\n%s", err, detail))
 	}
 
 	permittedNetworks := make([]map[string]interface{}, 0)
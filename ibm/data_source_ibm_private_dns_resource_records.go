@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,7 +19,7 @@ const (
 
 func dataSourceIBMPrivateDNSResourceRecords() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMPrivateDNSResourceRecordsRead,
+		ReadContext: dataSourceIBMPrivateDNSResourceRecordsRead,
 		Schema: map[string]*schema.Schema{
 			pdnsInstanceID: {
 				Type:        schema.TypeString,
@@ -67,18 +69,18 @@ func dataSourceIBMPrivateDNSResourceRecords() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPrivateDNSResourceRecordsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPrivateDNSResourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	DnszoneID := d.Get(pdnsZoneID).(string)
 	listDNSResRecOptions := sess.NewListResourceRecordsOptions(instanceID, DnszoneID)
 	availableDNSResRecs, detail, err := sess.ListResourceRecords(listDNSResRecOptions)
 	if err != nil {
-		return fmt.Errorf("Error reading list of pdns resource records:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error reading list of pdns resource records:%s\n%s", err, detail))
 	}
 	dnsResRecs := make([]map[string]interface{}, 0)
 	for _, instance := range availableDNSResRecs.ResourceRecords {
@@ -89,7 +91,7 @@ func dataSourceIBMPrivateDNSResourceRecordsRead(d *schema.ResourceData, meta int
 		// Marshal the rdata map into a JSON string
 		rData, err := json.Marshal(instance.Rdata)
 		if err != nil {
-			return fmt.Errorf("Error reading rdata map of dns resource records:%s", err)
+			return diagFromErr(fmt.Errorf("Error reading rdata map of dns resource records:%s", err))
 		}
 		jsonStr := string(rData)
 		dnsRecord[pdnsRdata] = jsonStr
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,7 +18,7 @@ const (
 
 func dataSourceIBMPrivateDNSZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMPrivateDNSZonesRead,
+		ReadContext: dataSourceIBMPrivateDNSZonesRead,
 		Schema: map[string]*schema.Schema{
 			pdnsInstanceID: {
 				Type:        schema.TypeString,
@@ -76,17 +78,17 @@ func dataSourceIBMPrivateDNSZones() *schema.Resource {
 	}
 }
 
-func dataSourceIBMPrivateDNSZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMPrivateDNSZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	listDNSZonesOptions := sess.NewListDnszonesOptions(instanceID)
 	availableDNSZones, detail, err := sess.ListDnszones(listDNSZonesOptions)
 	if err != nil {
-		return fmt.Errorf("Error reading list of dns zones:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error reading list of dns zones:%s\n%s", err, detail))
 	}
 	dnsZones := make([]map[string]interface{}, 0)
 	for _, instance := range availableDNSZones.Dnszones {
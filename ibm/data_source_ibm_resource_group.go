@@ -4,16 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev2/managementv2"
 	"github.com/IBM-Cloud/bluemix-go/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMResourceGroupRead,
+		ReadContext: dataSourceIBMResourceGroupRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -32,10 +34,10 @@ func dataSourceIBMResourceGroup() *schema.Resource {
 	}
 }
 
-func dataSourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMResourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsManagementAPI, err := meta.(ClientSession).ResourceManagementAPIv2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsGroup := rsManagementAPI.ResourceGroup()
 
@@ -49,7 +51,7 @@ func dataSourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) er
 	}
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	accountID := userDetails.userAccount
 	var grp []models.ResourceGroupv2
@@ -62,7 +64,7 @@ func dataSourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) er
 		grp, err = rsGroup.List(&resourceGroupQuery)
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving default resource group: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving default resource group: %s", err))
 		}
 		d.SetId(grp[0].ID)
 
@@ -72,12 +74,12 @@ func dataSourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) er
 		}
 		grp, err := rsGroup.FindByName(resourceGroupQuery, name)
 		if err != nil {
-			return fmt.Errorf("Error retrieving resource group %s: %s", name, err)
+			return diagFromErr(fmt.Errorf("Error retrieving resource group %s: %s", name, err))
 		}
 		d.SetId(grp[0].ID)
 
 	} else {
-		return fmt.Errorf("Missing required properties. Need a resource group name, or the is_default true")
+		return diagFromErr(fmt.Errorf("Missing required properties. Need a resource group name, or the is_default true"))
 	}
 
 	return nil
@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/resource/resourcev1/controller"
@@ -14,7 +16,7 @@ import (
 
 func dataSourceIBMResourceInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMResourceInstanceRead,
+		ReadContext: dataSourceIBMResourceInstanceRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -106,10 +108,10 @@ func dataSourceIBMResourceInstance() *schema.Resource {
 	}
 }
 
-func dataSourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMResourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsAPI := rsConClient.ResourceServiceInstance()
 	name := d.Get("name").(string)
@@ -123,14 +125,14 @@ func dataSourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{})
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		rsInstQuery.ResourceGroupID = defaultRg
 	}
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
@@ -138,7 +140,7 @@ func dataSourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{})
 
 		serviceOff, err := rsCatRepo.FindByName(service.(string), true)
 		if err != nil {
-			return fmt.Errorf("Error retrieving service offering: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 		}
 
 		rsInstQuery.ServiceID = serviceOff[0].ID
@@ -148,7 +150,7 @@ func dataSourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{})
 
 	instances, err = rsAPI.ListInstances(rsInstQuery)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var filteredInstances []models.ServiceInstance
 	var location string
@@ -165,14 +167,14 @@ func dataSourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if len(filteredInstances) == 0 {
-		return fmt.Errorf("No resource instance found with name [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or service", name)
+		return diagFromErr(fmt.Errorf("No resource instance found with name [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or service", name))
 	}
 
 	var instance models.ServiceInstance
 
 	if len(filteredInstances) > 1 {
-		return fmt.Errorf(
-			"More than one resource instance found with name matching [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or service", name)
+		return diagFromErr(fmt.Errorf(
+			"More than one resource instance found with name matching [%s]\nIf not specified please specify more filters like resource_group_id if instance doesn't exists in default group, location or service", name))
 	}
 	instance = filteredInstances[0]
 
@@ -182,7 +184,7 @@ func dataSourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set("location", instance.RegionID)
 	serviceOff, err := rsCatRepo.GetServiceName(instance.ServiceID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	d.Set("service", serviceOff)
@@ -200,13 +202,13 @@ func dataSourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{})
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, rcontroller+"/services/")
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(instance.ServicePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 	d.Set("crn", instance.Crn.String())
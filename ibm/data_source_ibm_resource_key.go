@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -11,12 +12,13 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/crn"
 	"github.com/IBM-Cloud/bluemix-go/models"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMResourceKey() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMResourceKeyRead,
+		ReadContext: dataSourceIBMResourceKeyRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -75,10 +77,10 @@ func dataSourceIBMResourceKey() *schema.Resource {
 	}
 }
 
-func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMResourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsContClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rkAPI := rsContClient.ResourceServiceKey()
 	name := d.Get("name").(string)
@@ -86,7 +88,7 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 
 	keys, err := rkAPI.GetKeys(name)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var filteredKeys []models.ServiceKey
 
@@ -95,7 +97,7 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		crn, err := getCRN(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		for _, key := range keys {
 			if key.SourceCrn == *crn {
@@ -106,7 +108,7 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if len(filteredKeys) == 0 {
-		return fmt.Errorf("No resource keys found with name [%s]", name)
+		return diagFromErr(fmt.Errorf("No resource keys found with name [%s]", name))
 	}
 
 	var key models.ServiceKey
@@ -115,10 +117,10 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 		if mostRecent {
 			key = mostRecentResourceKey(filteredKeys)
 		} else {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"More than one resource key found with name matching [%s]. "+
 					"Set 'most_recent' to true in your configuration to force the most recent resource key "+
-					"to be used", name)
+					"to be used", name))
 		}
 	} else {
 		key = filteredKeys[0]
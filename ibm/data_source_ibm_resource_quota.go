@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMResourceQuota() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMResourceQuotaRead,
+		ReadContext: dataSourceIBMResourceQuotaRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -58,20 +60,20 @@ func dataSourceIBMResourceQuota() *schema.Resource {
 	}
 }
 
-func dataSourceIBMResourceQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMResourceQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsManagementAPI, err := meta.(ClientSession).ResourceManagementAPIv2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsQuota := rsManagementAPI.ResourceQuota()
 	rsQuotaName := d.Get("name").(string)
 	rsQuotas, err := rsQuota.FindByName(rsQuotaName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving resource quota: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving resource quota: %s", err))
 	}
 
 	if len(rsQuotas) == 0 {
-		return fmt.Errorf("Error retrieving resource quota: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving resource quota: %s", err))
 	}
 
 	rsQuotaFields := rsQuotas[0]
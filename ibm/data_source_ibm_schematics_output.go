@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceSchematicsOut() *schema.Resource {
 	return &schema.Resource{
-		Read: resourceIBMSchematicsOutRead,
+		ReadContext: resourceIBMSchematicsOutRead,
 
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
@@ -48,10 +50,10 @@ func dataSourceSchematicsOut() *schema.Resource {
 
 }
 
-func resourceIBMSchematicsOutRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSchematicsOutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	scClient, err := meta.(ClientSession).SchematicsAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	wrkAPI := scClient.Workspaces()
@@ -60,7 +62,7 @@ func resourceIBMSchematicsOutRead(d *schema.ResourceData, meta interface{}) erro
 
 	out, err := wrkAPI.GetOutputValues(workspaceID)
 	if err != nil {
-		return fmt.Errorf("Error while retreiving outputs of workspace: %s", err)
+		return diagFromErr(fmt.Errorf("Error while retreiving outputs of workspace: %s", err))
 	}
 
 	var outputJSON string
@@ -72,7 +74,7 @@ func resourceIBMSchematicsOutRead(d *schema.ResourceData, meta interface{}) erro
 			found = true
 			outputByte, err := json.MarshalIndent(output, "", "")
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 
 			outputJSON = string(outputByte[:])
@@ -88,7 +90,7 @@ func resourceIBMSchematicsOutRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 	if !(found) {
-		return fmt.Errorf("Error while fetching template id in workspace: %s", workspaceID)
+		return diagFromErr(fmt.Errorf("Error while fetching template id in workspace: %s", workspaceID))
 	}
 
 	d.Set("output_json", outputJSON)
@@ -97,7 +99,7 @@ func resourceIBMSchematicsOutRead(d *schema.ResourceData, meta interface{}) erro
 
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/schematics")
 
@@ -4,15 +4,17 @@
 package ibm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceSchematicsState() *schema.Resource {
 	return &schema.Resource{
-		Read: resourceIBMSchematicsStateRead,
+		ReadContext: resourceIBMSchematicsStateRead,
 
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
@@ -43,10 +45,10 @@ func dataSourceSchematicsState() *schema.Resource {
 
 }
 
-func resourceIBMSchematicsStateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSchematicsStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	scClient, err := meta.(ClientSession).SchematicsAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	wrkAPI := scClient.Workspaces()
@@ -55,7 +57,7 @@ func resourceIBMSchematicsStateRead(d *schema.ResourceData, meta interface{}) er
 
 	stateStore, err := wrkAPI.GetStateStore(workspaceID, templateID)
 	if err != nil {
-		return fmt.Errorf("Error retreiving statestore: %s", err)
+		return diagFromErr(fmt.Errorf("Error retreiving statestore: %s", err))
 	}
 	statestr := fmt.Sprintf("%v", stateStore)
 	d.SetId(fmt.Sprintf("%s/%s", workspaceID, templateID))
@@ -63,7 +65,7 @@ func resourceIBMSchematicsStateRead(d *schema.ResourceData, meta interface{}) er
 
 	stateByte, err := json.MarshalIndent(stateStore, "", "")
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	stateStoreJson := string(stateByte[:])
@@ -71,7 +73,7 @@ func resourceIBMSchematicsStateRead(d *schema.ResourceData, meta interface{}) er
 
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/schematics")
 
@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceSchematicsWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Read: resourceIBMSchematicsWorkspaceRead,
+		ReadContext: dataSourceIBMSchematicsWorkspaceRead,
 
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
@@ -88,10 +90,10 @@ func dataSourceSchematicsWorkspace() *schema.Resource {
 
 }
 
-func resourceIBMSchematicsWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMSchematicsWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	scClient, err := meta.(ClientSession).SchematicsAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	wrkAPI := scClient.Workspaces()
@@ -99,7 +101,7 @@ func resourceIBMSchematicsWorkspaceRead(d *schema.ResourceData, meta interface{}
 	// templateID := d.Get("template_id").(string)
 	WorkspaceInfo, err := wrkAPI.GetWorkspaceByID(workspaceID)
 	if err != nil {
-		return fmt.Errorf("Error retreiving Workspace details: %s", err)
+		return diagFromErr(fmt.Errorf("Error retreiving Workspace details: %s", err))
 	}
 
 	templateID := []string{}
@@ -129,7 +131,7 @@ func resourceIBMSchematicsWorkspaceRead(d *schema.ResourceData, meta interface{}
 
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/schematics")
 
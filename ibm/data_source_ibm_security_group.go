@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -15,7 +17,7 @@ import (
 
 func dataSourceIBMSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMSecurityGroupRead,
+		ReadContext: dataSourceIBMSecurityGroupRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -40,7 +42,7 @@ func dataSourceIBMSecurityGroup() *schema.Resource {
 	}
 }
 
-func dataSourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	name := d.Get("name").(string)
 	mostRecent := d.Get("most_recent").(bool)
@@ -57,10 +59,10 @@ func dataSourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 		GetSecurityGroups()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving Security group: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Security group: %s", err))
 	}
 	if len(groups) == 0 {
-		return fmt.Errorf("No security group found with name [%s]", name)
+		return diagFromErr(fmt.Errorf("No security group found with name [%s]", name))
 	}
 
 	var sg datatypes.Network_SecurityGroup
@@ -68,11 +70,11 @@ func dataSourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 		if mostRecent {
 			sg = mostRecentSecurityGroup(groups)
 		} else {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"More than one security group found with name matching [%s]. "+
 					"Either set 'most_recent' to true in your "+
 					"configuration to force the most security group "+
-					"to be used, or ensure that the name and/or description is unique", name)
+					"to be used, or ensure that the name and/or description is unique", name))
 		}
 	} else {
 		sg = groups[0]
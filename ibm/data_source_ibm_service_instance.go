@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMServiceInstance() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMServiceInstanceRead,
+		ReadContext: dataSourceIBMServiceInstanceRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -63,22 +65,22 @@ func dataSourceIBMServiceInstance() *schema.Resource {
 	}
 }
 
-func dataSourceIBMServiceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMServiceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	siAPI := cfClient.ServiceInstances()
 	name := d.Get("name").(string)
 	spaceGUID := d.Get("space_guid").(string)
 	inst, err := siAPI.FindByNameInSpace(spaceGUID, name)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceInstance, err := siAPI.Get(inst.GUID, 1)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service: %s", err))
 	}
 
 	d.SetId(serviceInstance.Metadata.GUID)
@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMServiceKey() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMServiceKeyRead,
+		ReadContext: dataSourceIBMServiceKeyRead,
 
 		Schema: map[string]*schema.Schema{
 			"credentials": {
@@ -39,10 +41,10 @@ func dataSourceIBMServiceKey() *schema.Resource {
 	}
 }
 
-func dataSourceIBMServiceKeyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMServiceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	siAPI := cfClient.ServiceInstances()
 	skAPI := cfClient.ServiceKeys()
@@ -51,15 +53,15 @@ func dataSourceIBMServiceKeyRead(d *schema.ResourceData, meta interface{}) error
 	name := d.Get("name").(string)
 	inst, err := siAPI.FindByNameInSpace(spaceGUID, serviceInstanceName)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	serviceInstance, err := siAPI.Get(inst.GUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service: %s", err))
 	}
 	serviceKey, err := skAPI.FindByName(serviceInstance.Metadata.GUID, name)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service key: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service key: %s", err))
 	}
 	d.SetId(serviceKey.GUID)
 	d.Set("credentials", Flatten(serviceKey.Credentials))
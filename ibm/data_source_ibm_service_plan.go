@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMServicePlan() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMServicePlanRead,
+		ReadContext: dataSourceIBMServicePlanRead,
 
 		Schema: map[string]*schema.Schema{
 			"service": {
@@ -29,10 +31,10 @@ func dataSourceIBMServicePlan() *schema.Resource {
 	}
 }
 
-func dataSourceIBMServicePlanRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMServicePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	soffAPI := cfClient.ServiceOfferings()
 	spAPI := cfClient.ServicePlans()
@@ -41,11 +43,11 @@ func dataSourceIBMServicePlanRead(d *schema.ResourceData, meta interface{}) erro
 	plan := d.Get("plan").(string)
 	serviceOff, err := soffAPI.FindByLabel(service)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 	servicePlan, err := spAPI.FindPlanInServiceOffering(serviceOff.GUID, plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 
 	d.SetId(servicePlan.GUID)
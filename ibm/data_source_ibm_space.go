@@ -4,14 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMSpace() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMSpaceRead,
+		ReadContext: dataSourceIBMSpaceRead,
 
 		Schema: map[string]*schema.Schema{
 			"space": {
@@ -54,10 +56,10 @@ func dataSourceIBMSpace() *schema.Resource {
 	}
 }
 
-func dataSourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMSpaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	orgAPI := cfClient.Organizations()
 	spaceAPI := cfClient.Spaces()
@@ -73,11 +75,11 @@ func dataSourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
 
 	orgFields, err := orgAPI.FindByName(org, BluemixRegion)
 	if err != nil {
-		return fmt.Errorf("Error retrieving org: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving org: %s", err))
 	}
 	spaceFields, err := spaceAPI.FindByNameInOrg(orgFields.GUID, space, BluemixRegion)
 	if err != nil {
-		return fmt.Errorf("Error retrieving space: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving space: %s", err))
 	}
 
 	spaceGUID := spaceFields.GUID
@@ -85,17 +87,17 @@ func dataSourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
 
 	auditors, err := spaceAPI.ListAuditors(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving auditors in the space: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving auditors in the space: %s", err))
 	}
 
 	managers, err := spaceAPI.ListManagers(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving managers in the space: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving managers in the space: %s", err))
 	}
 
 	developers, err := spaceAPI.ListDevelopers(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving developers in space: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving developers in space: %s", err))
 	}
 
 	d.Set("auditors", flattenSpaceRoleUsers(auditors))
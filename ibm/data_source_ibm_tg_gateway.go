@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,7 +20,7 @@ const (
 
 func dataSourceIBMTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMTransitGatewayRead,
+		ReadContext: dataSourceIBMTransitGatewayRead,
 
 		Schema: map[string]*schema.Schema{
 			tgName: {
@@ -101,17 +103,17 @@ func dataSourceIBMTransitGateway() *schema.Resource {
 	}
 }
 
-func dataSourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMTransitGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	listTransitGatewaysOptionsModel := &transitgatewayapisv1.ListTransitGatewaysOptions{}
 	listTransitGateways, response, err := client.ListTransitGateways(listTransitGatewaysOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Error while listing transit gateways %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error while listing transit gateways %s\n%s", err, response))
 	}
 
 	gwName := d.Get(tgName).(string)
@@ -140,18 +142,18 @@ func dataSourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if !foundGateway {
-		return fmt.Errorf(
-			"Couldn't find any gateway with the specified name: (%s)", gwName)
+		return diagFromErr(fmt.Errorf(
+			"Couldn't find any gateway with the specified name: (%s)", gwName))
 	}
 
-	return dataSourceIBMTransitGatewayConnectionsRead(d, meta)
+	return dataSourceIBMTransitGatewayConnectionsRead(ctx, d, meta)
 
 }
-func dataSourceIBMTransitGatewayConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMTransitGatewayConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	listTransitGatewayConnectionsOptions := &transitgatewayapisv1.ListTransitGatewayConnectionsOptions{}
 	tgGatewayId := d.Id()
@@ -160,7 +162,7 @@ func dataSourceIBMTransitGatewayConnectionsRead(d *schema.ResourceData, meta int
 	listTransitGatewayConnectionsOptions.SetTransitGatewayID(tgGatewayId)
 	listTGConnections, response, err := client.ListTransitGatewayConnections(listTransitGatewayConnectionsOptions)
 	if err != nil {
-		return fmt.Errorf("Error while listing transit gateway connections %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error while listing transit gateway connections %s\n%s", err, response))
 	}
 	connections := make([]map[string]interface{}, 0)
 
@@ -4,17 +4,19 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceIBMTransitGateways() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMTransitGatewaysRead,
+		ReadContext: dataSourceIBMTransitGatewaysRead,
 		Schema: map[string]*schema.Schema{
 			tgGateways: {
 				Type:        schema.TypeList,
@@ -65,17 +67,17 @@ func dataSourceIBMTransitGateways() *schema.Resource {
 	}
 }
 
-func dataSourceIBMTransitGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMTransitGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	listTransitGatewaysOptionsModel := &transitgatewayapisv1.ListTransitGatewaysOptions{}
 	listTransitGateways, response, err := client.ListTransitGateways(listTransitGatewaysOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Error while listing transit gateways %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error while listing transit gateways %s\n%s", err, response))
 	}
 
 	tgws := make([]map[string]interface{}, 0)
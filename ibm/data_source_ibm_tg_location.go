@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,7 +20,7 @@ const (
 
 func dataSourceIBMTransitGatewaysLocation() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMTransitGatewaysLocationRead,
+		ReadContext: dataSourceIBMTransitGatewaysLocationRead,
 		Schema: map[string]*schema.Schema{
 			tgLocationsName: {
 				Type:        schema.TypeString,
@@ -64,11 +66,11 @@ func dataSourceIBMTransitGatewaysLocation() *schema.Resource {
 	}
 }
 
-func dataSourceIBMTransitGatewaysLocationRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMTransitGatewaysLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	detailGatewayLocationOptionsModel := &transitgatewayapisv1.GetGatewayLocationOptions{}
@@ -76,7 +78,7 @@ func dataSourceIBMTransitGatewaysLocationRead(d *schema.ResourceData, meta inter
 	detailGatewayLocationOptionsModel.Name = &locName
 	detailTransitGatewayLocation, response, err := client.GetGatewayLocation(detailGatewayLocationOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Error while fetching transit gateway detailed location: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error while fetching transit gateway detailed location: %s\n%s", err, response))
 	}
 
 	if detailTransitGatewayLocation != nil {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,7 +23,7 @@ const (
 func dataSourceIBMTransitGatewaysLocations() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceIBMTransitGatewaysLocationsRead,
+		ReadContext: dataSourceIBMTransitGatewaysLocationsRead,
 		Schema: map[string]*schema.Schema{
 			tgLocations: {
 				Type:        schema.TypeList,
@@ -51,17 +53,17 @@ func dataSourceIBMTransitGatewaysLocations() *schema.Resource {
 	}
 }
 
-func dataSourceIBMTransitGatewaysLocationsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMTransitGatewaysLocationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	listTransitGatewayLocationsOptionsModel := &transitgatewayapisv1.ListGatewayLocationsOptions{}
 	listTransitGatewayLocations, response, err := client.ListGatewayLocations(listTransitGatewayLocationsOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Error while fetching transit gateways locations: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error while fetching transit gateways locations: %s\n%s", err, response))
 	}
 
 	tgLocationsCol := make([]map[string]interface{}, 0)
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// attributeError is an error about the value of a resource attribute. The summary
+// describes the failed operation and err holds the details returned by the API.
+type attributeError struct {
+	attribute string
+	summary   string
+	err       error
+}
+
+func (e *attributeError) Error() string {
+	return fmt.Sprintf("%s: %s", e.summary, e.err)
+}
+
+func (e *attributeError) Unwrap() error {
+	return e.err
+}
+
+// newAttributeError returns an error that is reported against the given top level attribute
+func newAttributeError(attribute, summary string, err error) error {
+	return &attributeError{
+		attribute: attribute,
+		summary:   summary,
+		err:       err,
+	}
+}
+
+// diagFromErr converts an error into diagnostics. An attributeError keeps its summary,
+// detail and attribute path. Any other error of the form "<summary>: <detail>", such as
+// fmt.Errorf("Error getting instance: %s", err), is split into a summary and a detail.
+func diagFromErr(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	var attrErr *attributeError
+	if errors.As(err, &attrErr) {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       attrErr.summary,
+				Detail:        attrErr.err.Error(),
+				AttributePath: cty.GetAttrPath(attrErr.attribute),
+			},
+		}
+	}
+	if summary, detail, ok := splitErrorMessage(err.Error()); ok {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail:   detail,
+			},
+		}
+	}
+	return diag.FromErr(err)
+}
+
+// splitErrorMessage splits an error message at its first ": "
+func splitErrorMessage(message string) (string, string, bool) {
+	i := strings.Index(message, ": ")
+	if i < 0 {
+		return "", "", false
+	}
+	summary, detail := strings.TrimSpace(message[:i]), strings.TrimSpace(message[i+2:])
+	if summary == "" || detail == "" {
+		return "", "", false
+	}
+	return summary, detail, true
+}
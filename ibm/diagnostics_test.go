@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+func TestDiagFromErr(t *testing.T) {
+	assert.Assert(t, is.Nil(diagFromErr(nil)))
+
+	diags := diagFromErr(errors.New("Error Getting Instance"))
+	assert.Assert(t, is.Len(diags, 1))
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, "Error Getting Instance", diags[0].Summary)
+	assert.Equal(t, "", diags[0].Detail)
+	assert.Assert(t, is.Nil(diags[0].AttributePath))
+
+	diags = diagFromErr(fmt.Errorf("Error getting instance (%s): %s", "0717-1234", errors.New("Instance not found: 404")))
+	assert.Assert(t, is.Len(diags, 1))
+	assert.Equal(t, "Error getting instance (0717-1234)", diags[0].Summary)
+	assert.Equal(t, "Instance not found: 404", diags[0].Detail)
+	assert.Assert(t, is.Nil(diags[0].AttributePath))
+
+	diags = diagFromErr(errors.New("Error getting instance: "))
+	assert.Equal(t, "Error getting instance: ", diags[0].Summary)
+	assert.Equal(t, "", diags[0].Detail)
+
+	err := newAttributeError(isInstanceVolumes, "Error while attaching volume \"vol\"", errors.New("Volume not found"))
+	assert.Equal(t, "Error while attaching volume \"vol\": Volume not found", err.Error())
+
+	diags = diagFromErr(fmt.Errorf("Error updating instance: %w", err))
+	assert.Assert(t, is.Len(diags, 1))
+	assert.Equal(t, "Error while attaching volume \"vol\"", diags[0].Summary)
+	assert.Equal(t, "Volume not found", diags[0].Detail)
+	assert.Assert(t, cty.GetAttrPath("volumes").Equals(diags[0].AttributePath))
+}
@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/Shopify/sarama"
+)
+
+// eventStreamsInstance holds the endpoints of an Event Streams instance and
+// the API key used to authenticate with them
+type eventStreamsInstance struct {
+	CRN          string
+	KafkaHTTPURL string
+	Brokers      []string
+	APIKey       string
+}
+
+// tenantID returns the tenant of the instance, it is the first label of the
+// kafka_http_url host
+func (i eventStreamsInstance) tenantID() string {
+	return strings.TrimPrefix(strings.Split(i.KafkaHTTPURL, ".")[0], "https://")
+}
+
+// eventStreamsAdminPool caches a Kafka admin client for each instance, it is
+// safe for concurrent use. The key is the instance's CRN.
+type eventStreamsAdminPool struct {
+	mu      sync.Mutex
+	closed  bool
+	clients map[string]*eventStreamsAdminEntry
+}
+
+// eventStreamsAdminEntry is a client of the pool, ready is closed once the
+// client is created or failed to be created
+type eventStreamsAdminEntry struct {
+	ready chan struct{}
+	admin sarama.ClusterAdmin
+	err   error
+}
+
+func newEventStreamsAdminPool() *eventStreamsAdminPool {
+	return &eventStreamsAdminPool{clients: map[string]*eventStreamsAdminEntry{}}
+}
+
+// clientPool is shared by all the Event Streams resources and data sources
+var clientPool = newEventStreamsAdminPool()
+
+// CloseEventStreamsAdminClients closes the Kafka admin clients opened by the
+// provider, it is called when the provider stops.
+func CloseEventStreamsAdminClients() error {
+	return clientPool.Close()
+}
+
+// Get returns the client of the key, the client is created with newAdmin
+// when the pool doesn't have it. A client that fails to be created isn't
+// cached.
+func (p *eventStreamsAdminPool) Get(key string, newAdmin func() (sarama.ClusterAdmin, error)) (sarama.ClusterAdmin, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, fmt.Errorf("The Event Streams admin clients are closed")
+	}
+	entry, ok := p.clients[key]
+	if ok {
+		p.mu.Unlock()
+		<-entry.ready
+		return entry.admin, entry.err
+	}
+	entry = &eventStreamsAdminEntry{ready: make(chan struct{})}
+	p.clients[key] = entry
+	p.mu.Unlock()
+
+	// The client is created without holding the lock, so that the clients
+	// of the other instances are not blocked
+	entry.admin, entry.err = newAdmin()
+	if entry.err != nil {
+		p.mu.Lock()
+		if p.clients[key] == entry {
+			delete(p.clients, key)
+		}
+		p.mu.Unlock()
+	}
+	close(entry.ready)
+	return entry.admin, entry.err
+}
+
+// Evict closes and removes the client of the key, the next Get creates a new
+// client.
+func (p *eventStreamsAdminPool) Evict(key string) error {
+	p.mu.Lock()
+	entry, ok := p.clients[key]
+	if ok {
+		delete(p.clients, key)
+	}
+	p.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	<-entry.ready
+	if entry.admin == nil {
+		return nil
+	}
+	return entry.admin.Close()
+}
+
+// EvictOnError evicts the client of the key when the error of one of its
+// operations shows that its connection or credentials went bad, so that the
+// next Get creates a new client.
+func (p *eventStreamsAdminPool) EvictOnError(key string, err error) {
+	if !isEventStreamsConnectionError(err) {
+		return
+	}
+	log.Printf("[INFO] Evicting the Event Streams admin client of %s: %s", key, err)
+	if evictErr := p.Evict(key); evictErr != nil {
+		log.Printf("[WARN] Error closing the Event Streams admin client of %s: %s", key, evictErr)
+	}
+}
+
+// isEventStreamsConnectionError returns whether the error comes from the
+// connection to the brokers or the authentication with them
+func isEventStreamsConnectionError(err error) bool {
+	switch err {
+	case nil:
+		return false
+	case sarama.ErrOutOfBrokers, sarama.ErrClosedClient, sarama.ErrNotConnected, sarama.ErrBrokerNotAvailable, sarama.ErrSASLAuthenticationFailed, io.EOF:
+		return true
+	}
+	_, ok := err.(net.Error)
+	return ok
+}
+
+// Close closes all the clients, waiting for the clients being created. The
+// pool can't be used after it is closed.
+func (p *eventStreamsAdminPool) Close() error {
+	p.mu.Lock()
+	p.closed = true
+	entries := p.clients
+	p.clients = map[string]*eventStreamsAdminEntry{}
+	p.mu.Unlock()
+
+	var errs []string
+	for key, entry := range entries {
+		<-entry.ready
+		if entry.admin == nil {
+			continue
+		}
+		if err := entry.admin.Close(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", key, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("Error closing the Event Streams admin clients: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// getEventStreamsInstance looks up the endpoints of the Event Streams instance
+func getEventStreamsInstance(meta interface{}, instanceCRN string) (eventStreamsInstance, error) {
+	bxSession, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		log.Printf("[DEBUG] getEventStreamsInstance BluemixSession err %s", err)
+		return eventStreamsInstance{}, err
+	}
+	apiKey := bxSession.Config.BluemixAPIKey
+	if len(apiKey) == 0 {
+		log.Printf("[DEBUG] getEventStreamsInstance BluemixAPIKey is empty")
+		return eventStreamsInstance{}, fmt.Errorf("failed to get IBM cloud API key")
+	}
+	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
+	if err != nil {
+		log.Printf("[DEBUG] getEventStreamsInstance ResourceControllerAPI err %s", err)
+		return eventStreamsInstance{}, err
+	}
+	instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceCRN)
+	if err != nil {
+		log.Printf("[DEBUG] getEventStreamsInstance GetInstance err %s", err)
+		return eventStreamsInstance{}, err
+	}
+	if instance.Extensions == nil {
+		log.Printf("[DEBUG] getEventStreamsInstance instance %s extension is nil", instance.ID)
+		return eventStreamsInstance{}, fmt.Errorf("instance %s extension is nil", instance.ID)
+	}
+	adminURL, _ := instance.Extensions["kafka_http_url"].(string)
+	brokers, _ := instance.Extensions["kafka_brokers_sasl"].([]interface{})
+	return eventStreamsInstance{
+		CRN:          instanceCRN,
+		KafkaHTTPURL: adminURL,
+		Brokers:      expandStringList(brokers),
+		APIKey:       apiKey,
+	}, nil
+}
+
+// eventStreamsAdminClient returns the pooled Kafka admin client of the
+// Event Streams instance
+func eventStreamsAdminClient(instance eventStreamsInstance) (sarama.ClusterAdmin, error) {
+	return clientPool.Get(instance.CRN, func() (sarama.ClusterAdmin, error) {
+		adminClient, err := sarama.NewClusterAdmin(instance.Brokers, eventStreamsSaramaConfig(instance))
+		if err != nil {
+			log.Printf("[DEBUG] eventStreamsAdminClient NewClusterAdmin err %s", err)
+			return nil, err
+		}
+		log.Printf("[INFO] eventStreamsAdminClient instance %s 's client is initialized", instance.CRN)
+		return adminClient, nil
+	})
+}
+
+func eventStreamsSaramaConfig(instance eventStreamsInstance) *sarama.Config {
+	config := sarama.NewConfig()
+	config.ClientID, _ = os.Hostname()
+	config.Net.SASL.Enable = true
+	if tenantID := instance.tenantID(); tenantID != "" && tenantID != "admin" {
+		config.Net.SASL.AuthIdentity = tenantID
+	}
+	config.Net.SASL.User = "token"
+	config.Net.SASL.Password = instance.APIKey
+	config.Net.TLS.Enable = true
+	config.Version = brokerVersion
+	return config
+}
+
+// eventStreamsResourceID returns the CRN of a resource of an Event Streams
+// instance, the name is escaped so that it doesn't break the CRN segments.
+func eventStreamsResourceID(instanceCRN, resourceType, name string) (string, error) {
+	crnSegments := strings.Split(instanceCRN, ":")
+	if len(crnSegments) != 10 {
+		return "", fmt.Errorf("Incorrect resource_instance_id %s: it should be the CRN of an Event Streams instance", instanceCRN)
+	}
+	crnSegments[8] = resourceType
+	crnSegments[9] = url.QueryEscape(name)
+	return strings.Join(crnSegments, ":"), nil
+}
+
+// parseEventStreamsResourceID returns the instance CRN and the name of the
+// resource from the CRN of a resource of an Event Streams instance
+func parseEventStreamsResourceID(id, resourceType string) (string, string, error) {
+	crnSegments := strings.Split(id, ":")
+	if len(crnSegments) != 10 || crnSegments[8] != resourceType {
+		return "", "", fmt.Errorf("Incorrect ID %s: ID should be a CRN with the resource type %s", id, resourceType)
+	}
+	name, err := url.QueryUnescape(crnSegments[9])
+	if err != nil {
+		return "", "", fmt.Errorf("Incorrect ID %s: %s", id, err)
+	}
+	return getInstanceCRN(id), name, nil
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+// fakeClusterAdmin counts the calls to Close
+type fakeClusterAdmin struct {
+	sarama.ClusterAdmin
+	closed int32
+}
+
+func (f *fakeClusterAdmin) Close() error {
+	atomic.AddInt32(&f.closed, 1)
+	return nil
+}
+
+func TestEventStreamsAdminPoolGet(t *testing.T) {
+	pool := newEventStreamsAdminPool()
+	var created int32
+	newAdmin := func() (sarama.ClusterAdmin, error) {
+		atomic.AddInt32(&created, 1)
+		return &fakeClusterAdmin{}, nil
+	}
+
+	// Concurrent lookups of an instance share one client
+	var wg sync.WaitGroup
+	admins := make([]sarama.ClusterAdmin, 20)
+	for i := range admins {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			admin, err := pool.Get("crn1", newAdmin)
+			assert.NilError(t, err)
+			admins[i] = admin
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&created))
+	for _, admin := range admins {
+		assert.Assert(t, admin == admins[0])
+	}
+
+	other, err := pool.Get("crn2", newAdmin)
+	assert.NilError(t, err)
+	assert.Assert(t, other != admins[0])
+	assert.Equal(t, int32(2), atomic.LoadInt32(&created))
+}
+
+func TestEventStreamsAdminPoolGetError(t *testing.T) {
+	pool := newEventStreamsAdminPool()
+	_, err := pool.Get("crn1", func() (sarama.ClusterAdmin, error) {
+		return nil, fmt.Errorf("kafka: client has run out of available brokers")
+	})
+	assert.Assert(t, err != nil)
+
+	// The failed client isn't cached
+	admin, err := pool.Get("crn1", func() (sarama.ClusterAdmin, error) {
+		return &fakeClusterAdmin{}, nil
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, admin != nil)
+}
+
+func TestEventStreamsAdminPoolEvictAndClose(t *testing.T) {
+	pool := newEventStreamsAdminPool()
+	first := &fakeClusterAdmin{}
+	second := &fakeClusterAdmin{}
+	pool.Get("crn1", func() (sarama.ClusterAdmin, error) { return first, nil })
+	pool.Get("crn2", func() (sarama.ClusterAdmin, error) { return second, nil })
+
+	assert.Assert(t, is.Nil(pool.Evict("crn1")))
+	assert.Equal(t, int32(1), first.closed)
+	assert.Assert(t, is.Nil(pool.Evict("crn1")))
+	assert.Equal(t, int32(1), first.closed)
+
+	assert.Assert(t, is.Nil(pool.Close()))
+	assert.Equal(t, int32(1), first.closed)
+	assert.Equal(t, int32(1), second.closed)
+
+	_, err := pool.Get("crn1", func() (sarama.ClusterAdmin, error) { return &fakeClusterAdmin{}, nil })
+	assert.Assert(t, err != nil)
+}
+
+func TestEventStreamsAdminPoolEvictOnError(t *testing.T) {
+	pool := newEventStreamsAdminPool()
+	admin := &fakeClusterAdmin{}
+	pool.Get("crn1", func() (sarama.ClusterAdmin, error) { return admin, nil })
+
+	// The errors of the request keep the client
+	pool.EvictOnError("crn1", nil)
+	pool.EvictOnError("crn1", sarama.ErrTopicAuthorizationFailed)
+	pool.EvictOnError("crn1", fmt.Errorf("topic already exists"))
+	assert.Equal(t, int32(0), admin.closed)
+
+	pool.EvictOnError("crn1", sarama.ErrOutOfBrokers)
+	assert.Equal(t, int32(1), admin.closed)
+	second, err := pool.Get("crn1", func() (sarama.ClusterAdmin, error) { return &fakeClusterAdmin{}, nil })
+	assert.NilError(t, err)
+	assert.Assert(t, second != admin)
+
+	pool.EvictOnError("crn1", sarama.ErrSASLAuthenticationFailed)
+	assert.Equal(t, int32(1), second.(*fakeClusterAdmin).closed)
+}
+
+func TestEventStreamsResourceID(t *testing.T) {
+	instanceCRN := "crn:v1:bluemix:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:cb5a0252-8b8d-4390-b017-80b743d32839::"
+	acl := eventStreamsACL{
+		ResourceType:   "topic",
+		ResourceName:   "orders",
+		PatternType:    "prefixed",
+		Principal:      "User:iam-ServiceId-1234",
+		Host:           "*",
+		Operation:      "read",
+		PermissionType: "allow",
+	}
+	id, err := eventStreamsResourceID(instanceCRN, "acl", acl.name())
+	assert.NilError(t, err)
+	assert.Equal(t, "crn:v1:bluemix:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:cb5a0252-8b8d-4390-b017-80b743d32839:acl:topic%2Corders%2Cprefixed%2CUser%3Aiam-ServiceId-1234%2C%2A%2Cread%2Callow", id)
+
+	crn, name, err := parseEventStreamsResourceID(id, "acl")
+	assert.NilError(t, err)
+	assert.Equal(t, instanceCRN, crn)
+	parsed, err := parseEventStreamsACLName(name)
+	assert.NilError(t, err)
+	assert.Equal(t, acl, parsed)
+
+	_, _, err = parseEventStreamsResourceID(id, "quota")
+	assert.Assert(t, err != nil)
+	_, _, err = parseEventStreamsResourceID("crn:v1:bluemix", "acl")
+	assert.Assert(t, err != nil)
+	_, err = eventStreamsResourceID("crn:v1:bluemix", "acl", acl.name())
+	assert.ErrorContains(t, err, "Incorrect resource_instance_id crn:v1:bluemix")
+}
+
+func TestEventStreamsACL(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetController(broker.BrokerID()).
+			SetBroker(broker.Addr(), broker.BrokerID()),
+		"CreateAclsRequest":   sarama.NewMockCreateAclsResponse(t),
+		"DescribeAclsRequest": sarama.NewMockListAclsResponse(t),
+		"DeleteAclsRequest":   sarama.NewMockDeleteAclsResponse(t),
+	})
+	config := sarama.NewConfig()
+	config.Version = brokerVersion
+	adminClient, err := sarama.NewClusterAdmin([]string{broker.Addr()}, config)
+	assert.NilError(t, err)
+	defer adminClient.Close()
+
+	acl := eventStreamsACL{
+		ResourceType:   "group",
+		ResourceName:   "consumers",
+		PatternType:    "literal",
+		Principal:      "User:iam-ServiceId-1234",
+		Host:           "*",
+		Operation:      "read",
+		PermissionType: "deny",
+	}
+	assert.Assert(t, is.Nil(createEventStreamsACL(adminClient, acl)))
+	found, err := findEventStreamsACL(adminClient, acl)
+	assert.NilError(t, err)
+	assert.Assert(t, found)
+	assert.Assert(t, is.Nil(deleteEventStreamsACL(adminClient, acl)))
+
+	acl.Operation = "publish"
+	assert.Assert(t, createEventStreamsACL(adminClient, acl) != nil)
+	_, err = findEventStreamsACL(adminClient, acl)
+	assert.Assert(t, err != nil)
+}
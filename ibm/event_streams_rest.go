@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	eventStreamsAdminContentType          = "application/json"
+	eventStreamsSchemaRegistryContentType = "application/vnd.schemaregistry.v1+json"
+)
+
+// eventStreamsRESTError is the error returned by the Event Streams REST APIs
+type eventStreamsRESTError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *eventStreamsRESTError) Error() string {
+	return fmt.Sprintf("Request failed with status code: %d, %s", e.StatusCode, e.Message)
+}
+
+func isEventStreamsNotFound(err error) bool {
+	apiErr, ok := err.(*eventStreamsRESTError)
+	return ok && apiErr.StatusCode == gohttp.StatusNotFound
+}
+
+// eventStreamsREST calls the admin and schema registry REST APIs of an Event
+// Streams instance, authenticating with the API key like the Kafka clients
+type eventStreamsREST struct {
+	client      *gohttp.Client
+	baseURL     string
+	apiKey      string
+	contentType string
+}
+
+func newEventStreamsAdminREST(client *gohttp.Client, instance eventStreamsInstance) *eventStreamsREST {
+	return &eventStreamsREST{
+		client:      client,
+		baseURL:     strings.TrimSuffix(instance.KafkaHTTPURL, "/"),
+		apiKey:      instance.APIKey,
+		contentType: eventStreamsAdminContentType,
+	}
+}
+
+// newEventStreamsSchemaRegistry returns a client of the schema registry,
+// which is served under /confluent by the enterprise instances
+func newEventStreamsSchemaRegistry(client *gohttp.Client, instance eventStreamsInstance) *eventStreamsREST {
+	return &eventStreamsREST{
+		client:      client,
+		baseURL:     strings.TrimSuffix(instance.KafkaHTTPURL, "/") + "/confluent",
+		apiKey:      instance.APIKey,
+		contentType: eventStreamsSchemaRegistryContentType,
+	}
+}
+
+func (r *eventStreamsREST) do(method, path string, body, respV interface{}) error {
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return err
+		}
+	}
+	req, err := gohttp.NewRequest(method, r.baseURL+path, &reqBody)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth("token", r.apiKey)
+	req.Header.Set("Accept", r.contentType)
+	if body != nil {
+		req.Header.Set("Content-Type", r.contentType)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &eventStreamsRESTError{StatusCode: resp.StatusCode}
+		var errBody struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &errBody) == nil && errBody.Message != "" {
+			apiErr.Message = errBody.Message
+		} else {
+			apiErr.Message = strings.TrimSpace(string(data))
+		}
+		return apiErr
+	}
+	if respV == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, respV)
+}
+
+// eventStreamsQuota is the throughput quota of a user, the rates are in
+// bytes per second
+type eventStreamsQuota struct {
+	ProducerByteRate *int `json:"producer_byte_rate,omitempty"`
+	ConsumerByteRate *int `json:"consumer_byte_rate,omitempty"`
+}
+
+func eventStreamsQuotaPath(entity string) string {
+	return "/admin/quotas/" + url.PathEscape(entity)
+}
+
+func (r *eventStreamsREST) GetQuota(entity string) (eventStreamsQuota, error) {
+	var quota eventStreamsQuota
+	err := r.do(gohttp.MethodGet, eventStreamsQuotaPath(entity), nil, &quota)
+	return quota, err
+}
+
+func (r *eventStreamsREST) CreateQuota(entity string, quota eventStreamsQuota) error {
+	return r.do(gohttp.MethodPost, eventStreamsQuotaPath(entity), quota, nil)
+}
+
+func (r *eventStreamsREST) UpdateQuota(entity string, quota eventStreamsQuota) error {
+	return r.do(gohttp.MethodPatch, eventStreamsQuotaPath(entity), quota, nil)
+}
+
+func (r *eventStreamsREST) DeleteQuota(entity string) error {
+	return r.do(gohttp.MethodDelete, eventStreamsQuotaPath(entity), nil, nil)
+}
+
+// eventStreamsSchema is a version of the schema of a subject
+type eventStreamsSchema struct {
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+	ID      int    `json:"id"`
+	Schema  string `json:"schema"`
+}
+
+func eventStreamsSubjectPath(subject string) string {
+	return "/subjects/" + url.PathEscape(subject)
+}
+
+// RegisterSchema adds the schema as the latest version of the subject and
+// returns its ID, the schema registry returns the ID of the existing version
+// when the schema is already registered
+func (r *eventStreamsREST) RegisterSchema(subject, schema string) (int, error) {
+	var registered struct {
+		ID int `json:"id"`
+	}
+	body := map[string]string{"schema": schema}
+	err := r.do(gohttp.MethodPost, eventStreamsSubjectPath(subject)+"/versions", body, &registered)
+	return registered.ID, err
+}
+
+// GetSchemaVersion returns a version of the schema of the subject, the
+// version is a number or latest
+func (r *eventStreamsREST) GetSchemaVersion(subject, version string) (eventStreamsSchema, error) {
+	var schema eventStreamsSchema
+	err := r.do(gohttp.MethodGet, eventStreamsSubjectPath(subject)+"/versions/"+url.PathEscape(version), nil, &schema)
+	return schema, err
+}
+
+func (r *eventStreamsREST) ListSchemaVersions(subject string) ([]int, error) {
+	var versions []int
+	err := r.do(gohttp.MethodGet, eventStreamsSubjectPath(subject)+"/versions", nil, &versions)
+	return versions, err
+}
+
+// DeleteSubject deletes the subject and all the versions of its schema
+func (r *eventStreamsREST) DeleteSubject(subject string) error {
+	return r.do(gohttp.MethodDelete, eventStreamsSubjectPath(subject), nil, nil)
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+// fakeSchemaRegistry is an in-memory schema registry
+type fakeSchemaRegistry struct {
+	mu       sync.Mutex
+	nextID   int
+	subjects map[string][]eventStreamsSchema
+}
+
+func (f *fakeSchemaRegistry) ServeHTTP(w gohttp.ResponseWriter, r *gohttp.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if user, password, ok := r.BasicAuth(); !ok || user != "token" || password != "apikey" {
+		w.WriteHeader(gohttp.StatusUnauthorized)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/confluent/subjects/"), "/")
+	subject := parts[0]
+	versions := f.subjects[subject]
+	notFound := func() {
+		w.WriteHeader(gohttp.StatusNotFound)
+		fmt.Fprint(w, `{"error_code":40401,"message":"Subject not found."}`)
+	}
+	switch {
+	case r.Method == gohttp.MethodPost && len(parts) == 2:
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		for _, v := range versions {
+			if v.Schema == body["schema"] {
+				fmt.Fprintf(w, `{"id":%d}`, v.ID)
+				return
+			}
+		}
+		f.nextID++
+		f.subjects[subject] = append(versions, eventStreamsSchema{Subject: subject, Version: len(versions) + 1, ID: f.nextID, Schema: body["schema"]})
+		fmt.Fprintf(w, `{"id":%d}`, f.nextID)
+	case r.Method == gohttp.MethodGet && len(parts) == 2:
+		if len(versions) == 0 {
+			notFound()
+			return
+		}
+		numbers := []int{}
+		for _, v := range versions {
+			numbers = append(numbers, v.Version)
+		}
+		json.NewEncoder(w).Encode(numbers)
+	case r.Method == gohttp.MethodGet && len(parts) == 3 && parts[2] == "latest":
+		if len(versions) == 0 {
+			notFound()
+			return
+		}
+		json.NewEncoder(w).Encode(versions[len(versions)-1])
+	case r.Method == gohttp.MethodDelete && len(parts) == 1:
+		if len(versions) == 0 {
+			notFound()
+			return
+		}
+		delete(f.subjects, subject)
+		fmt.Fprint(w, "[1]")
+	default:
+		w.WriteHeader(gohttp.StatusMethodNotAllowed)
+	}
+}
+
+func TestEventStreamsSchemaRegistry(t *testing.T) {
+	server := httptest.NewServer(&fakeSchemaRegistry{subjects: map[string][]eventStreamsSchema{}})
+	defer server.Close()
+	client := newEventStreamsSchemaRegistry(server.Client(), eventStreamsInstance{KafkaHTTPURL: server.URL, APIKey: "apikey"})
+
+	_, err := client.GetSchemaVersion("orders-value", "latest")
+	assert.Assert(t, isEventStreamsNotFound(err))
+
+	first := `{"type":"record","name":"order","fields":[{"name":"id","type":"string"}]}`
+	id, err := client.RegisterSchema("orders-value", first)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, id)
+
+	// Registering the same schema again doesn't add a version
+	id, err = client.RegisterSchema("orders-value", first)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, id)
+
+	second := `{"type":"record","name":"order","fields":[{"name":"id","type":"string"},{"name":"total","type":"int","default":0}]}`
+	id, err = client.RegisterSchema("orders-value", second)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, id)
+
+	latest, err := client.GetSchemaVersion("orders-value", "latest")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, eventStreamsSchema{Subject: "orders-value", Version: 2, ID: 2, Schema: second}, latest)
+	versions, err := client.ListSchemaVersions("orders-value")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []int{1, 2}, versions)
+
+	assert.Assert(t, is.Nil(client.DeleteSubject("orders-value")))
+	err = client.DeleteSubject("orders-value")
+	assert.Assert(t, isEventStreamsNotFound(err))
+	assert.Equal(t, "Request failed with status code: 404, Subject not found.", err.Error())
+
+	unauthorized := newEventStreamsSchemaRegistry(server.Client(), eventStreamsInstance{KafkaHTTPURL: server.URL, APIKey: "other"})
+	_, err = unauthorized.ListSchemaVersions("orders-value")
+	assert.Assert(t, err != nil)
+	assert.Equal(t, gohttp.StatusUnauthorized, err.(*eventStreamsRESTError).StatusCode)
+}
+
+func TestEventStreamsQuota(t *testing.T) {
+	quotas := map[string]map[string]interface{}{}
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		entity := strings.TrimPrefix(r.URL.Path, "/admin/quotas/")
+		quota, ok := quotas[entity]
+		switch r.Method {
+		case gohttp.MethodPost:
+			if ok {
+				w.WriteHeader(gohttp.StatusConflict)
+				return
+			}
+			quota = map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&quota)
+			quotas[entity] = quota
+			w.WriteHeader(gohttp.StatusCreated)
+			return
+		case gohttp.MethodPatch:
+			if ok {
+				json.NewDecoder(r.Body).Decode(&quota)
+				w.WriteHeader(gohttp.StatusAccepted)
+				return
+			}
+		case gohttp.MethodGet:
+			if ok {
+				json.NewEncoder(w).Encode(quota)
+				return
+			}
+		case gohttp.MethodDelete:
+			if ok {
+				delete(quotas, entity)
+				w.WriteHeader(gohttp.StatusAccepted)
+				return
+			}
+		}
+		w.WriteHeader(gohttp.StatusNotFound)
+		fmt.Fprint(w, `{"error_code":404,"message":"quota not found"}`)
+	}))
+	defer server.Close()
+	client := newEventStreamsAdminREST(server.Client(), eventStreamsInstance{KafkaHTTPURL: server.URL + "/", APIKey: "apikey"})
+
+	entity := "iam-ServiceId-1234"
+	assert.Assert(t, is.Nil(client.CreateQuota(entity, eventStreamsQuota{ProducerByteRate: ptrToInt(1024)})))
+	quota, err := client.GetQuota(entity)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, eventStreamsQuota{ProducerByteRate: ptrToInt(1024)}, quota)
+
+	assert.Assert(t, is.Nil(client.UpdateQuota(entity, eventStreamsQuota{ConsumerByteRate: ptrToInt(2048)})))
+	quota, err = client.GetQuota(entity)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, eventStreamsQuota{ProducerByteRate: ptrToInt(1024), ConsumerByteRate: ptrToInt(2048)}, quota)
+
+	assert.Assert(t, is.Nil(client.DeleteQuota(entity)))
+	_, err = client.GetQuota(entity)
+	assert.Assert(t, isEventStreamsNotFound(err))
+}
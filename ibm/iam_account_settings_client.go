@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	iamidentity "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+// iamAccountSettings are the IAM settings of an account, the session values
+// are either a number of seconds or NOT_SET. The allowed IP addresses are only
+// sent when set, an empty value removes the restriction.
+type iamAccountSettings struct {
+	AccountID                    string  `json:"account_id,omitempty"`
+	EntityTag                    string  `json:"entity_tag,omitempty"`
+	RestrictCreateServiceID      string  `json:"restrict_create_service_id,omitempty"`
+	RestrictCreatePlatformAPIKey string  `json:"restrict_create_platform_apikey,omitempty"`
+	AllowedIPAddresses           *string `json:"allowed_ip_addresses,omitempty"`
+	MFA                          string  `json:"mfa,omitempty"`
+	SessionExpirationInSeconds   string  `json:"session_expiration_in_seconds,omitempty"`
+	SessionInvalidationInSeconds string  `json:"session_invalidation_in_seconds,omitempty"`
+}
+
+func getIAMAccountSettings(ctx context.Context, client *iamidentity.IamIdentityV1, accountID string) (*iamAccountSettings, *core.DetailedResponse, error) {
+	result := &iamAccountSettings{}
+	response, err := iamIdentityRequest(ctx, client, core.GET, "/v1/accounts/{account_id}/settings/identity", map[string]string{"account_id": accountID}, "", nil, result)
+	return result, response, err
+}
+
+// updateIAMAccountSettings updates the settings that are set, the entity tag
+// must be the one of the last read so that concurrent changes are not lost
+func updateIAMAccountSettings(ctx context.Context, client *iamidentity.IamIdentityV1, accountID, entityTag string, settings iamAccountSettings) (*iamAccountSettings, *core.DetailedResponse, error) {
+	result := &iamAccountSettings{}
+	settings.AccountID = ""
+	settings.EntityTag = ""
+	response, err := iamIdentityRequest(ctx, client, core.PUT, "/v1/accounts/{account_id}/settings/identity", map[string]string{"account_id": accountID}, entityTag, settings, result)
+	return result, response, err
+}
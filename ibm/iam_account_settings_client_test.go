@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func TestIAMAccountSettings(t *testing.T) {
+	allowedIPAddresses := "10.0.0.0/8"
+	stored := &iamAccountSettings{
+		AccountID:                    "account",
+		EntityTag:                    "1-a",
+		RestrictCreateServiceID:      "NOT_SET",
+		RestrictCreatePlatformAPIKey: "NOT_SET",
+		AllowedIPAddresses:           &allowedIPAddresses,
+		MFA:                          "NONE",
+		SessionExpirationInSeconds:   "NOT_SET",
+		SessionInvalidationInSeconds: "NOT_SET",
+	}
+	var sent map[string]interface{}
+	client, closeServer := newTestIAMIdentityClient(t, func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		if r.URL.Path != "/v1/accounts/account/settings/identity" {
+			w.WriteHeader(gohttp.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[{"code":"not_found","message":"Account not found"}]}`)
+			return
+		}
+		switch r.Method {
+		case gohttp.MethodGet:
+			json.NewEncoder(w).Encode(stored)
+		case gohttp.MethodPut:
+			if r.Header.Get("If-Match") != stored.EntityTag {
+				w.WriteHeader(gohttp.StatusConflict)
+				fmt.Fprint(w, `{"errors":[{"code":"conflict","message":"The entity tag does not match"}]}`)
+				return
+			}
+			// The settings that are not sent keep their value
+			sent = map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&sent)
+			body, _ := json.Marshal(sent)
+			json.Unmarshal(body, stored)
+			stored.EntityTag = "2-b"
+			json.NewEncoder(w).Encode(stored)
+		}
+	})
+	defer closeServer()
+	ctx := context.Background()
+
+	settings, _, err := getIAMAccountSettings(ctx, client, "account")
+	assert.NilError(t, err)
+	assert.Equal(t, "1-a", settings.EntityTag)
+
+	_, response, err := updateIAMAccountSettings(ctx, client, "account", "0-stale", iamAccountSettings{MFA: "TOTP"})
+	assert.Assert(t, err != nil)
+	assert.Equal(t, gohttp.StatusConflict, response.StatusCode)
+
+	updated, _, err := updateIAMAccountSettings(ctx, client, "account", settings.EntityTag, iamAccountSettings{AccountID: "account", MFA: "TOTP", SessionExpirationInSeconds: "3600"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, map[string]interface{}{"mfa": "TOTP", "session_expiration_in_seconds": "3600"}, sent)
+	assert.Equal(t, "2-b", updated.EntityTag)
+	assert.Equal(t, "TOTP", updated.MFA)
+	assert.Equal(t, "3600", updated.SessionExpirationInSeconds)
+	assert.Equal(t, "NOT_SET", updated.RestrictCreateServiceID)
+	assert.Equal(t, "10.0.0.0/8", *updated.AllowedIPAddresses)
+
+	noIPAddresses := ""
+	updated, _, err = updateIAMAccountSettings(ctx, client, "account", updated.EntityTag, iamAccountSettings{AllowedIPAddresses: &noIPAddresses})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, map[string]interface{}{"allowed_ip_addresses": ""}, sent)
+	assert.Equal(t, "", *updated.AllowedIPAddresses)
+
+	_, response, err = getIAMAccountSettings(ctx, client, "other")
+	assert.Assert(t, err != nil)
+	assert.Equal(t, gohttp.StatusNotFound, response.StatusCode)
+}
+
+func TestExpandIAMAccountSettings(t *testing.T) {
+	r := resourceIBMIAMAccountSettings()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"mfa": "LEVEL2",
+	})
+	assert.DeepEqual(t, iamAccountSettings{MFA: "LEVEL2"}, expandIAMAccountSettings(d))
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"mfa":                        "LEVEL2",
+		"restrict_create_service_id": "RESTRICTED",
+		"allowed_ip_addresses":       []interface{}{"10.0.0.0/8", "192.168.1.1"},
+	})
+	allowedIPAddresses := "10.0.0.0/8,192.168.1.1"
+	assert.DeepEqual(t, iamAccountSettings{
+		MFA:                     "LEVEL2",
+		RestrictCreateServiceID: "RESTRICTED",
+		AllowedIPAddresses:      &allowedIPAddresses,
+	}, expandIAMAccountSettings(d))
+
+	assert.DeepEqual(t, []string{"10.0.0.0/8", "192.168.1.1"}, flattenIAMAccountSettingsAllowedIPAddresses("10.0.0.0/8, 192.168.1.1"))
+	assert.DeepEqual(t, []string{}, flattenIAMAccountSettingsAllowedIPAddresses(""))
+}
+
+func TestIAMAccountSettingsSessionValidation(t *testing.T) {
+	r := resourceIBMIAMAccountSettings()
+	testCases := []struct {
+		key   string
+		value string
+		valid bool
+	}{
+		{key: "session_expiration_in_seconds", value: "NOT_SET", valid: true},
+		{key: "session_expiration_in_seconds", value: "900", valid: true},
+		{key: "session_expiration_in_seconds", value: "86400", valid: true},
+		{key: "session_expiration_in_seconds", value: "899", valid: false},
+		{key: "session_expiration_in_seconds", value: "86401", valid: false},
+		{key: "session_expiration_in_seconds", value: "1h", valid: false},
+		{key: "session_invalidation_in_seconds", value: "7200", valid: true},
+		{key: "session_invalidation_in_seconds", value: "7201", valid: false},
+	}
+	for _, tc := range testCases {
+		_, errs := r.Schema[tc.key].ValidateFunc(tc.value, tc.key)
+		assert.Equal(t, tc.valid, len(errs) == 0, "%s = %s", tc.key, tc.value)
+	}
+}
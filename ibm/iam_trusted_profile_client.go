@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	iamidentity "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+// The IAM Identity SDK doesn't cover the trusted profiles yet, the requests
+// below go through the service of the SDK client so that they share its
+// authenticator, endpoint and retries.
+
+// iamTrustedProfile is a trusted profile, workloads and federated users
+// assume it to get its access
+type iamTrustedProfile struct {
+	ID           string `json:"id,omitempty"`
+	EntityTag    string `json:"entity_tag,omitempty"`
+	CRN          string `json:"crn,omitempty"`
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	IamID        string `json:"iam_id,omitempty"`
+	AccountID    string `json:"account_id"`
+	CreatedAt    string `json:"created_at,omitempty"`
+	ModifiedAt   string `json:"modified_at,omitempty"`
+	IMSAccountID int    `json:"ims_account_id,omitempty"`
+	IMSUserID    int    `json:"ims_user_id,omitempty"`
+}
+
+// iamTrustedProfileClaimRuleCondition matches a claim of the token of the
+// user or the compute resource assuming the profile
+type iamTrustedProfileClaimRuleCondition struct {
+	Claim    string `json:"claim"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// iamTrustedProfileClaimRule lets the federated users or compute resources
+// whose claims match its conditions assume the profile
+type iamTrustedProfileClaimRule struct {
+	ID         string                                `json:"id,omitempty"`
+	EntityTag  string                                `json:"entity_tag,omitempty"`
+	Name       string                                `json:"name,omitempty"`
+	Type       string                                `json:"type"`
+	RealmName  string                                `json:"realm_name,omitempty"`
+	CRType     string                                `json:"cr_type,omitempty"`
+	Expiration int                                   `json:"expiration,omitempty"`
+	Conditions []iamTrustedProfileClaimRuleCondition `json:"conditions"`
+	CreatedAt  string                                `json:"created_at,omitempty"`
+	ModifiedAt string                                `json:"modified_at,omitempty"`
+}
+
+// iamTrustedProfileLinkTarget is the compute resource of a link
+type iamTrustedProfileLinkTarget struct {
+	CRN       string `json:"crn"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// iamTrustedProfileLink lets a single compute resource assume the profile
+type iamTrustedProfileLink struct {
+	ID         string                      `json:"id,omitempty"`
+	EntityTag  string                      `json:"entity_tag,omitempty"`
+	Name       string                      `json:"name,omitempty"`
+	CRType     string                      `json:"cr_type"`
+	Link       iamTrustedProfileLinkTarget `json:"link"`
+	CreatedAt  string                      `json:"created_at,omitempty"`
+	ModifiedAt string                      `json:"modified_at,omitempty"`
+}
+
+// iamIdentityRequest sends a request to the IAM Identity service of the client
+func iamIdentityRequest(ctx context.Context, client *iamidentity.IamIdentityV1, method, path string, pathParams map[string]string, ifMatch string, body, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if ifMatch != "" {
+		builder.AddHeader("If-Match", ifMatch)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
+
+func createIAMTrustedProfile(ctx context.Context, client *iamidentity.IamIdentityV1, profile iamTrustedProfile) (*iamTrustedProfile, *core.DetailedResponse, error) {
+	result := &iamTrustedProfile{}
+	response, err := iamIdentityRequest(ctx, client, core.POST, "/v1/profiles", nil, "", profile, result)
+	return result, response, err
+}
+
+func getIAMTrustedProfile(ctx context.Context, client *iamidentity.IamIdentityV1, profileID string) (*iamTrustedProfile, *core.DetailedResponse, error) {
+	result := &iamTrustedProfile{}
+	response, err := iamIdentityRequest(ctx, client, core.GET, "/v1/profiles/{profile-id}", map[string]string{"profile-id": profileID}, "", nil, result)
+	return result, response, err
+}
+
+// updateIAMTrustedProfile updates the name and description of the profile,
+// the entity tag must be the one of the last read
+func updateIAMTrustedProfile(ctx context.Context, client *iamidentity.IamIdentityV1, profileID, entityTag string, profile iamTrustedProfile) (*iamTrustedProfile, *core.DetailedResponse, error) {
+	result := &iamTrustedProfile{}
+	body := map[string]string{"name": profile.Name, "description": profile.Description}
+	response, err := iamIdentityRequest(ctx, client, core.PUT, "/v1/profiles/{profile-id}", map[string]string{"profile-id": profileID}, entityTag, body, result)
+	return result, response, err
+}
+
+func deleteIAMTrustedProfile(ctx context.Context, client *iamidentity.IamIdentityV1, profileID string) (*core.DetailedResponse, error) {
+	return iamIdentityRequest(ctx, client, core.DELETE, "/v1/profiles/{profile-id}", map[string]string{"profile-id": profileID}, "", nil, nil)
+}
+
+func createIAMTrustedProfileClaimRule(ctx context.Context, client *iamidentity.IamIdentityV1, profileID string, rule iamTrustedProfileClaimRule) (*iamTrustedProfileClaimRule, *core.DetailedResponse, error) {
+	result := &iamTrustedProfileClaimRule{}
+	response, err := iamIdentityRequest(ctx, client, core.POST, "/v1/profiles/{profile-id}/rules", map[string]string{"profile-id": profileID}, "", rule, result)
+	return result, response, err
+}
+
+func getIAMTrustedProfileClaimRule(ctx context.Context, client *iamidentity.IamIdentityV1, profileID, ruleID string) (*iamTrustedProfileClaimRule, *core.DetailedResponse, error) {
+	result := &iamTrustedProfileClaimRule{}
+	response, err := iamIdentityRequest(ctx, client, core.GET, "/v1/profiles/{profile-id}/rules/{rule-id}", map[string]string{"profile-id": profileID, "rule-id": ruleID}, "", nil, result)
+	return result, response, err
+}
+
+func updateIAMTrustedProfileClaimRule(ctx context.Context, client *iamidentity.IamIdentityV1, profileID, ruleID, entityTag string, rule iamTrustedProfileClaimRule) (*iamTrustedProfileClaimRule, *core.DetailedResponse, error) {
+	result := &iamTrustedProfileClaimRule{}
+	response, err := iamIdentityRequest(ctx, client, core.PUT, "/v1/profiles/{profile-id}/rules/{rule-id}", map[string]string{"profile-id": profileID, "rule-id": ruleID}, entityTag, rule, result)
+	return result, response, err
+}
+
+func deleteIAMTrustedProfileClaimRule(ctx context.Context, client *iamidentity.IamIdentityV1, profileID, ruleID string) (*core.DetailedResponse, error) {
+	return iamIdentityRequest(ctx, client, core.DELETE, "/v1/profiles/{profile-id}/rules/{rule-id}", map[string]string{"profile-id": profileID, "rule-id": ruleID}, "", nil, nil)
+}
+
+func createIAMTrustedProfileLink(ctx context.Context, client *iamidentity.IamIdentityV1, profileID string, link iamTrustedProfileLink) (*iamTrustedProfileLink, *core.DetailedResponse, error) {
+	result := &iamTrustedProfileLink{}
+	response, err := iamIdentityRequest(ctx, client, core.POST, "/v1/profiles/{profile-id}/links", map[string]string{"profile-id": profileID}, "", link, result)
+	return result, response, err
+}
+
+func getIAMTrustedProfileLink(ctx context.Context, client *iamidentity.IamIdentityV1, profileID, linkID string) (*iamTrustedProfileLink, *core.DetailedResponse, error) {
+	result := &iamTrustedProfileLink{}
+	response, err := iamIdentityRequest(ctx, client, core.GET, "/v1/profiles/{profile-id}/links/{link-id}", map[string]string{"profile-id": profileID, "link-id": linkID}, "", nil, result)
+	return result, response, err
+}
+
+func deleteIAMTrustedProfileLink(ctx context.Context, client *iamidentity.IamIdentityV1, profileID, linkID string) (*core.DetailedResponse, error) {
+	return iamIdentityRequest(ctx, client, core.DELETE, "/v1/profiles/{profile-id}/links/{link-id}", map[string]string{"profile-id": profileID, "link-id": linkID}, "", nil, nil)
+}
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	iamidentity "github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+)
+
+func newTestIAMIdentityClient(t *testing.T, handler gohttp.HandlerFunc) (*iamidentity.IamIdentityV1, func()) {
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		// The SDK only decodes the JSON responses
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r)
+	}))
+	client, err := iamidentity.NewIamIdentityV1(&iamidentity.IamIdentityV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, server.Close
+}
+
+func TestIAMTrustedProfile(t *testing.T) {
+	profiles := map[string]*iamTrustedProfile{}
+	client, closeServer := newTestIAMIdentityClient(t, func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/v1/profiles/")
+		profile, ok := profiles[id]
+		switch {
+		case r.Method == gohttp.MethodPost && r.URL.Path == "/v1/profiles":
+			profile = &iamTrustedProfile{}
+			json.NewDecoder(r.Body).Decode(profile)
+			profile.ID = fmt.Sprintf("Profile-%d", len(profiles)+1)
+			profile.IamID = "iam-" + profile.ID
+			profile.EntityTag = "1-a"
+			profiles[profile.ID] = profile
+			w.WriteHeader(gohttp.StatusCreated)
+			json.NewEncoder(w).Encode(profile)
+		case r.Method == gohttp.MethodGet && ok:
+			json.NewEncoder(w).Encode(profile)
+		case r.Method == gohttp.MethodPut && ok:
+			if r.Header.Get("If-Match") != profile.EntityTag {
+				w.WriteHeader(gohttp.StatusConflict)
+				fmt.Fprint(w, `{"errors":[{"code":"conflict","message":"The entity tag does not match"}]}`)
+				return
+			}
+			json.NewDecoder(r.Body).Decode(profile)
+			profile.EntityTag = "2-b"
+			json.NewEncoder(w).Encode(profile)
+		case r.Method == gohttp.MethodDelete && ok:
+			delete(profiles, id)
+			w.WriteHeader(gohttp.StatusNoContent)
+		default:
+			w.WriteHeader(gohttp.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[{"code":"not_found","message":"Profile not found"}]}`)
+		}
+	})
+	defer closeServer()
+	ctx := context.Background()
+
+	created, _, err := createIAMTrustedProfile(ctx, client, iamTrustedProfile{Name: "workers", AccountID: "account"})
+	assert.NilError(t, err)
+	assert.Equal(t, "Profile-1", created.ID)
+	assert.Equal(t, "iam-Profile-1", created.IamID)
+
+	_, response, err := updateIAMTrustedProfile(ctx, client, created.ID, "0-stale", iamTrustedProfile{Name: "batch"})
+	assert.Assert(t, err != nil)
+	assert.Equal(t, gohttp.StatusConflict, response.StatusCode)
+
+	updated, _, err := updateIAMTrustedProfile(ctx, client, created.ID, created.EntityTag, iamTrustedProfile{Name: "batch", Description: "Batch jobs"})
+	assert.NilError(t, err)
+	assert.Equal(t, "2-b", updated.EntityTag)
+	profile, _, err := getIAMTrustedProfile(ctx, client, created.ID)
+	assert.NilError(t, err)
+	assert.Equal(t, "batch", profile.Name)
+	assert.Equal(t, "Batch jobs", profile.Description)
+	assert.Equal(t, "account", profile.AccountID)
+
+	_, err = deleteIAMTrustedProfile(ctx, client, created.ID)
+	assert.NilError(t, err)
+	_, response, err = getIAMTrustedProfile(ctx, client, created.ID)
+	assert.Assert(t, err != nil)
+	assert.Equal(t, gohttp.StatusNotFound, response.StatusCode)
+	assert.Equal(t, "Profile not found", err.Error())
+}
+
+func TestIAMTrustedProfileLink(t *testing.T) {
+	var stored *iamTrustedProfileLink
+	client, closeServer := newTestIAMIdentityClient(t, func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		switch {
+		case r.Method == gohttp.MethodPost && r.URL.Path == "/v1/profiles/Profile-1/links":
+			stored = &iamTrustedProfileLink{}
+			json.NewDecoder(r.Body).Decode(stored)
+			stored.ID = "link-1"
+			w.WriteHeader(gohttp.StatusCreated)
+			json.NewEncoder(w).Encode(stored)
+		case r.Method == gohttp.MethodGet && r.URL.Path == "/v1/profiles/Profile-1/links/link-1" && stored != nil:
+			json.NewEncoder(w).Encode(stored)
+		default:
+			w.WriteHeader(gohttp.StatusNotFound)
+		}
+	})
+	defer closeServer()
+	ctx := context.Background()
+
+	link := iamTrustedProfileLink{
+		CRType: "IKS_SA",
+		Link:   iamTrustedProfileLinkTarget{CRN: "crn:v1:bluemix:public:containers-kubernetes:us-south:a/account:cluster::", Namespace: "default"},
+	}
+	created, _, err := createIAMTrustedProfileLink(ctx, client, "Profile-1", link)
+	assert.NilError(t, err)
+	assert.Equal(t, "link-1", created.ID)
+	read, _, err := getIAMTrustedProfileLink(ctx, client, "Profile-1", "link-1")
+	assert.NilError(t, err)
+	assert.Equal(t, link.Link, read.Link)
+	_, response, err := getIAMTrustedProfileLink(ctx, client, "Profile-1", "link-2")
+	assert.Assert(t, err != nil)
+	assert.Equal(t, gohttp.StatusNotFound, response.StatusCode)
+}
+
+func TestExpandIAMTrustedProfileClaimRule(t *testing.T) {
+	r := resourceIBMIAMTrustedProfileClaimRule()
+	conditions := []interface{}{map[string]interface{}{"claim": "namespace", "operator": "EQUALS", "value": "\"batch\""}}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"profile_id": "Profile-1",
+		"type":       "Profile-CR",
+		"cr_type":    "IKS_SA",
+		"conditions": conditions,
+	})
+	rule, err := expandIAMTrustedProfileClaimRule(d)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, iamTrustedProfileClaimRule{
+		Type:       "Profile-CR",
+		CRType:     "IKS_SA",
+		Conditions: []iamTrustedProfileClaimRuleCondition{{Claim: "namespace", Operator: "EQUALS", Value: "\"batch\""}},
+	}, rule)
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"profile_id": "Profile-1",
+		"type":       "Profile-SAML",
+		"realm_name": "https://idp.example.com",
+		"expiration": 3600,
+		"conditions": conditions,
+	})
+	rule, err = expandIAMTrustedProfileClaimRule(d)
+	assert.NilError(t, err)
+	assert.Equal(t, 3600, rule.Expiration)
+
+	for _, config := range []map[string]interface{}{
+		{"type": "Profile-CR", "conditions": conditions},
+		{"type": "Profile-CR", "cr_type": "VSI", "realm_name": "https://idp.example.com", "conditions": conditions},
+		{"type": "Profile-SAML", "conditions": conditions},
+		{"type": "Profile-SAML", "realm_name": "https://idp.example.com", "cr_type": "VSI", "conditions": conditions},
+	} {
+		config["profile_id"] = "Profile-1"
+		_, err = expandIAMTrustedProfileClaimRule(schema.TestResourceDataRaw(t, r.Schema, config))
+		assert.Assert(t, err != nil, "%v", config)
+	}
+}
+
+func TestExpandIAMTrustedProfileLink(t *testing.T) {
+	r := resourceIBMIAMTrustedProfileLink()
+	clusterCRN := "crn:v1:bluemix:public:containers-kubernetes:us-south:a/account:cluster::"
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"profile_id": "Profile-1",
+		"cr_type":    "ROKS_SA",
+		"link":       []interface{}{map[string]interface{}{"crn": clusterCRN, "namespace": "batch", "name": "runner"}},
+	})
+	link, err := expandIAMTrustedProfileLink(d)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, iamTrustedProfileLink{CRType: "ROKS_SA", Link: iamTrustedProfileLinkTarget{CRN: clusterCRN, Namespace: "batch", Name: "runner"}}, link)
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"profile_id": "Profile-1",
+		"cr_type":    "IKS_SA",
+		"link":       []interface{}{map[string]interface{}{"crn": clusterCRN}},
+	})
+	_, err = expandIAMTrustedProfileLink(d)
+	assert.Assert(t, err != nil)
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"profile_id": "Profile-1",
+		"cr_type":    "VSI",
+		"link":       []interface{}{map[string]interface{}{"crn": "crn:v1:bluemix:public:is:us-south-1:a/account::instance:0717-1234", "namespace": "default"}},
+	})
+	_, err = expandIAMTrustedProfileLink(d)
+	assert.Assert(t, err != nil)
+}
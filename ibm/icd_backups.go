@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	gohttp "net/http"
+
+	"github.com/IBM-Cloud/bluemix-go/api/icd/icdv4"
+)
+
+const (
+	databaseBackupTypeScheduled = "scheduled"
+	databaseBackupTypeOnDemand  = "on_demand"
+)
+
+// icdBackup is a backup of an IBM Cloud Databases deployment
+type icdBackup struct {
+	ID             string `json:"id"`
+	DeploymentID   string `json:"deployment_id"`
+	Type           string `json:"type"`
+	Status         string `json:"status"`
+	IsDownloadable bool   `json:"is_downloadable"`
+	IsRestorable   bool   `json:"is_restorable"`
+	CreatedAt      string `json:"created_at"`
+}
+
+// icdBackups is the backups API of IBM Cloud Databases, the icdv4 client of bluemix-go does not
+// provide it
+type icdBackups interface {
+	ListBackups(icdId string) ([]icdBackup, error)
+	GetBackup(backupId string) (icdBackup, error)
+	StartBackup(icdId string) (icdv4.Task, error)
+}
+
+// icdRESTClient is the REST client embedded in the icdv4 client, it sends the requests with the
+// endpoint and the IAM token of the ICD service
+type icdRESTClient interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+}
+
+type backups struct {
+	client icdRESTClient
+}
+
+// icdBackupsAPI returns the backups API of the ICD service configured in icdClient
+func icdBackupsAPI(icdClient icdv4.ICDServiceAPI) (icdBackups, error) {
+	client, ok := icdClient.(icdRESTClient)
+	if !ok {
+		return nil, fmt.Errorf("The IBM Cloud Databases client does not support the backups API")
+	}
+	return &backups{client: client}, nil
+}
+
+func (r *backups) ListBackups(icdId string) ([]icdBackup, error) {
+	backupsResult := struct {
+		Backups []icdBackup `json:"backups"`
+	}{}
+	rawURL := fmt.Sprintf("/v4/ibm/deployments/%s/backups", EscapeUrlParm(icdId))
+	_, err := r.client.Get(rawURL, &backupsResult)
+	if err != nil {
+		return nil, err
+	}
+	return backupsResult.Backups, nil
+}
+
+func (r *backups) GetBackup(backupId string) (icdBackup, error) {
+	backupResult := struct {
+		Backup icdBackup `json:"backup"`
+	}{}
+	rawURL := fmt.Sprintf("/v4/ibm/backups/%s", EscapeUrlParm(backupId))
+	_, err := r.client.Get(rawURL, &backupResult)
+	if err != nil {
+		return backupResult.Backup, err
+	}
+	return backupResult.Backup, nil
+}
+
+func (r *backups) StartBackup(icdId string) (icdv4.Task, error) {
+	taskResult := icdv4.TaskResult{}
+	rawURL := fmt.Sprintf("/v4/ibm/deployments/%s/backups", EscapeUrlParm(icdId))
+	_, err := r.client.Post(rawURL, nil, &taskResult)
+	if err != nil {
+		return taskResult.Task, err
+	}
+	return taskResult.Task, nil
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/api/icd/icdv4"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+const testICDBackupID = "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/4ea1882a2d3401ed1e459979941966ea:31fa970d-51d0-4b05-893e-251cba75a7b3:backup:8e1bd78c-3c18-4e1a-ae2a-0a1f6a4e8b2e"
+
+// fakeICDClient answers the GET and POST requests "<method> <path>" of the ICD API with canned
+// JSON responses
+type fakeICDClient struct {
+	icdv4.ICDServiceAPI
+	responses map[string]string
+}
+
+func (f *fakeICDClient) respond(request string, respV interface{}) (*gohttp.Response, error) {
+	response, ok := f.responses[request]
+	if !ok {
+		return nil, bmxerror.NewRequestFailure("Not Found", fmt.Sprintf("Unexpected request %s", request), 404)
+	}
+	return &gohttp.Response{StatusCode: 200}, json.Unmarshal([]byte(response), respV)
+}
+
+func (f *fakeICDClient) Get(path string, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error) {
+	return f.respond("GET "+path, respV)
+}
+
+func (f *fakeICDClient) Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error) {
+	return f.respond("POST "+path, respV)
+}
+
+func fakeICDClientSession(responses map[string]string) ClientSession {
+	sess := &clientSession{
+		icdServiceAPI: &fakeICDClient{responses: responses},
+	}
+	sess.icdConfigOnce.Do(func() {})
+	return sess
+}
+
+func TestICDBackupsAPI(t *testing.T) {
+	icdClient := &fakeICDClient{responses: map[string]string{
+		"GET /v4/ibm/deployments/crn:v1:deployment::/backups": `{"backups": [
+			{"id": "backup1", "type": "scheduled", "status": "completed", "is_restorable": true, "created_at": "2021-03-01T02:00:00Z"},
+			{"id": "backup2", "type": "on_demand", "status": "completed", "is_restorable": true, "created_at": "2021-03-02T10:00:00Z"}
+		]}`,
+		"POST /v4/ibm/deployments/crn:v1:deployment::/backups": `{"task": {"id": "task1", "status": "running"}}`,
+	}}
+	backupsAPI, err := icdBackupsAPI(icdClient)
+	assert.NilError(t, err)
+
+	backups, err := backupsAPI.ListBackups("crn:v1:deployment::")
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(backups, 2))
+	assert.Equal(t, databaseBackupTypeOnDemand, backups[1].Type)
+
+	task, err := backupsAPI.StartBackup("crn:v1:deployment::")
+	assert.NilError(t, err)
+	assert.Equal(t, "task1", task.Id)
+
+	_, err = backupsAPI.GetBackup("backup1")
+	assert.Assert(t, err != nil)
+
+	assert.Assert(t, is.Len(flattenDatabaseBackups(backups, ""), 2))
+	assert.Equal(t, "backup1", flattenDatabaseBackups(backups, databaseBackupTypeScheduled)[0]["backup_id"])
+
+	_, err = icdBackupsAPI(nil)
+	assert.Assert(t, err != nil)
+}
+
+func TestNewOnDemandBackup(t *testing.T) {
+	previous := []icdBackup{
+		{ID: "scheduled1", Type: databaseBackupTypeScheduled, CreatedAt: "2021-03-01T02:00:00Z"},
+		{ID: "ondemand1", Type: databaseBackupTypeOnDemand, CreatedAt: "2021-03-01T10:00:00Z"},
+	}
+	current := append(previous,
+		icdBackup{ID: "scheduled2", Type: databaseBackupTypeScheduled, CreatedAt: "2021-03-02T02:00:00Z"},
+		icdBackup{ID: "ondemand3", Type: databaseBackupTypeOnDemand, CreatedAt: "2021-03-02T10:05:00Z"},
+		icdBackup{ID: "ondemand2", Type: databaseBackupTypeOnDemand, CreatedAt: "2021-03-02T10:00:00Z"},
+	)
+
+	backup, ok := newOnDemandBackup(previous, current)
+	assert.Assert(t, ok)
+	assert.Equal(t, "ondemand3", backup.ID)
+
+	_, ok = newOnDemandBackup(previous, previous)
+	assert.Assert(t, !ok)
+}
+
+func TestDatabaseBackupRead(t *testing.T) {
+	meta := fakeICDClientSession(map[string]string{
+		"GET /v4/ibm/backups/" + EscapeUrlParm(testICDBackupID): `{"backup": {
+			"id": "` + testICDBackupID + `", "deployment_id": "crn:v1:deployment::", "type": "on_demand",
+			"status": "completed", "is_downloadable": true, "is_restorable": true, "created_at": "2021-03-02T10:00:00Z"
+		}}`,
+	})
+
+	r := resourceIBMDatabaseBackup()
+	d := r.Data(nil)
+	d.SetId(testICDBackupID)
+	diags := r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.Equal(t, "crn:v1:deployment::", d.Get("deployment_id"))
+	assert.Equal(t, true, d.Get("is_restorable"))
+
+	params := databaseInstanceParams(map[string]interface{}{"backup-id": testICDBackupID, "service-endpoints": "public"})
+	restoredFrom := flattenDatabaseRestoredFrom(params, meta)
+	assert.Equal(t, "crn:v1:deployment::", restoredFrom[0]["deployment_id"])
+	assert.Assert(t, is.Nil(flattenDatabaseRestoredFrom(Params{}, meta)))
+
+	d.SetId("unknown")
+	diags = r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.Equal(t, "", d.Id())
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// setImportDefaults sets the arguments of the imported resource to their default value. The read
+// that follows the import only populates the arguments returned by the API, the other ones would
+// show a diff in the next plan otherwise. It must be called before the importer sets any argument.
+func setImportDefaults(d *schema.ResourceData, r *schema.Resource) error {
+	for k, s := range r.Schema {
+		if s.Computed || (s.Default == nil && s.DefaultFunc == nil) {
+			continue
+		}
+		v, err := s.DefaultValue()
+		if err != nil {
+			return fmt.Errorf("Error getting the default value of %s: %s", k, err)
+		}
+		if v == nil {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("Error setting the default value of %s: %s", k, err)
+		}
+	}
+	return nil
+}
+
+// importIDArguments returns the arguments of a resource that are encoded in its composite ID
+type importIDArguments func(id string) (map[string]interface{}, error)
+
+// importStateWithRead returns the import function of a resource. It sets the default values and
+// the arguments of the composite ID, then reads the resource so that the import fails when the
+// resource does not exist. idArguments is nil when the read sets all the arguments of the ID.
+func importStateWithRead(resource func() *schema.Resource, idArguments importIDArguments) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		r := resource()
+		if err := setImportDefaults(d, r); err != nil {
+			return nil, err
+		}
+		id := d.Id()
+		if idArguments != nil {
+			args, err := idArguments(id)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range args {
+				if err := d.Set(k, v); err != nil {
+					return nil, fmt.Errorf("Error setting %s from the ID %s: %s", k, id, err)
+				}
+			}
+		}
+		if err := diagsError(r.ReadContext(ctx, d, meta)); err != nil {
+			return nil, err
+		}
+		if d.Id() == "" {
+			return nil, fmt.Errorf("Cannot import the resource with ID %s: it does not exist", id)
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// importIDParts returns the importIDArguments of an ID made of parts separated by /, the part i
+// is the value of arguments[i]. An empty argument skips the part, it is not an argument.
+func importIDParts(arguments ...string) importIDArguments {
+	return func(id string) (map[string]interface{}, error) {
+		parts := strings.Split(id, "/")
+		if len(parts) != len(arguments) {
+			return nil, fmt.Errorf("The ID %s must be made of %d parts separated by /", id, len(arguments))
+		}
+		args := make(map[string]interface{}, len(arguments))
+		for i, argument := range arguments {
+			if parts[i] == "" {
+				return nil, fmt.Errorf("The part %d of the ID %s is empty", i+1, id)
+			}
+			if argument != "" {
+				args[argument] = parts[i]
+			}
+		}
+		return args, nil
+	}
+}
+
+// diagsError returns the first error of the diagnostics with its summary and detail
+func diagsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail == "" {
+			return errors.New(d.Summary)
+		}
+		return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	return nil
+}
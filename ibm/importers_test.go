@@ -0,0 +1,350 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	slsession "github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+// fakeSoftLayerTransport answers the SoftLayer API calls "<service>::<method>" with canned JSON responses
+type fakeSoftLayerTransport map[string]string
+
+func (f fakeSoftLayerTransport) DoRequest(sess *slsession.Session, service string, method string, args []interface{}, options *sl.Options, pResult interface{}) error {
+	response, ok := f[service+"::"+method]
+	if !ok {
+		return sl.Error{StatusCode: 404, Exception: "SoftLayer_Exception_ObjectNotFound", Message: fmt.Sprintf("Unexpected call to %s::%s", service, method)}
+	}
+	return json.Unmarshal([]byte(response), pResult)
+}
+
+func fakeSoftLayerClientSession(responses fakeSoftLayerTransport) ClientSession {
+	return &clientSession{
+		session: &Session{
+			SoftLayerSession: &slsession.Session{TransportHandler: responses},
+		},
+	}
+}
+
+// fakeVPCClientSession returns a session whose VPC client is answered with the canned JSON
+// responses of the given paths
+func fakeVPCClientSession(t *testing.T, responses map[string]string) ClientSession {
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		response, ok := responses[r.URL.Path]
+		if !ok || r.Method != gohttp.MethodGet {
+			gohttp.Error(w, `{"errors": [{"code": "not_found", "message": "Not found"}]}`, gohttp.StatusNotFound)
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	client, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.NilError(t, err)
+	sess := &clientSession{vpcAPI: client}
+	sess.vpcOnce.Do(func() {})
+	return sess
+}
+
+// testImportStateEmptyPlan imports the resource, refreshes it and checks that planning the
+// given configuration after the import does not change anything. stateID is the ID of the
+// imported resource, it is the import ID when it is empty.
+func testImportStateEmptyPlan(t *testing.T, r *schema.Resource, id, stateID string, meta interface{}, config map[string]interface{}) {
+	ctx := context.Background()
+	if stateID == "" {
+		stateID = id
+	}
+	d := r.Data(nil)
+	d.SetId(id)
+	imported, err := r.Importer.StateContext(ctx, d, meta)
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(imported, 1))
+
+	state, diags := r.RefreshWithoutUpgrade(ctx, imported[0].State(), meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.Assert(t, state != nil)
+	assert.Equal(t, stateID, state.ID)
+
+	diff, err := r.SimpleDiff(ctx, state, terraform.NewResourceConfigRaw(config), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff == nil || diff.Empty(), "the plan after the import is not empty: %v", diff)
+}
+
+func TestImportStateEmptyPlan(t *testing.T) {
+	softlayer := fakeSoftLayerClientSession(fakeSoftLayerTransport{
+		"SoftLayer_Network_CdnMarketplace_Configuration_Mapping::listDomainMappingByUniqueId": `[{
+			"uniqueId": "354034879028850", "domain": "www.example.com", "vendorName": "akamai",
+			"originHost": "1.1.1.1", "originType": "HOST_SERVER", "protocol": "HTTP", "httpPort": 80,
+			"cname": "example.cdn.appdomain.cloud", "header": "www.example.com", "path": "/*",
+			"respectHeaders": true, "cacheKeyQueryRule": "include-all",
+			"performanceConfiguration": "General web delivery", "status": "RUNNING"
+		}]`,
+		"SoftLayer_Network_SecurityGroup::getNetworkComponentBindings": `[{"networkComponentId": 4567}]`,
+		"SoftLayer_Dns_Domain_Registration::getDomainNameservers":      `[{"nameservers": [{"name": "ns1.example.com"}, {"name": "ns2.example.com"}]}]`,
+		"SoftLayer_Dns_Domain_ResourceRecord::getObject":               `{"data": "www.example.com", "host": "4", "ttl": 900, "domain": {"name": "3.2.1.in-addr.arpa"}}`,
+		"SoftLayer_Network_SecurityGroup::getRules":                    `[{"id": 5678, "direction": "ingress", "ethertype": "IPv4", "portRangeMin": 8080, "portRangeMax": 8080, "protocol": "tcp"}]`,
+		"SoftLayer_Network_LBaaS_LoadBalancer::getLoadBalancer": `{"listeners": [{
+			"protocol": "HTTP",
+			"defaultPool": {"protocolPort": 80, "healthMonitor": {"uuid": "d343f-f44r-wer3-fe3", "interval": 10, "maxRetries": 2, "timeout": 2, "urlPath": "/health"}}
+		}]}`,
+		"SoftLayer_Network_LBaaS_Member::getObject": `{"id": 123456, "address": "10.10.10.10", "weight": 40, "uuid": "4a1b2c3d-0f3e"}`,
+	})
+	vpc := fakeVPCClientSession(t, map[string]string{
+		"/vpcs/r006-vpc/routing_tables/r006-rt": `{
+			"id": "r006-rt", "name": "routing-table", "href": "https://vpc/vpcs/r006-vpc/routing_tables/r006-rt",
+			"lifecycle_state": "stable", "created_at": "2021-06-01T10:00:00Z", "resource_type": "routing_table",
+			"route_direct_link_ingress": false, "route_transit_gateway_ingress": false, "route_vpc_zone_ingress": false,
+			"is_default": false, "subnets": [], "routes": []
+		}`,
+		"/vpcs/r006-vpc/routing_tables/r006-rt/routes/r006-route": `{
+			"id": "r006-route", "name": "route", "href": "https://vpc/vpcs/r006-vpc/routing_tables/r006-rt/routes/r006-route",
+			"lifecycle_state": "stable", "created_at": "2021-06-01T10:00:00Z", "action": "deliver",
+			"destination": "192.168.4.0/24", "next_hop": {"address": "10.0.0.4"}, "zone": {"name": "us-south-1"}
+		}`,
+	})
+
+	testCases := []struct {
+		name     string
+		resource *schema.Resource
+		id       string
+		stateID  string
+		meta     interface{}
+		config   map[string]interface{}
+	}{
+		{
+			name:     "ibm_cdn",
+			resource: resourceIBMCDN(),
+			id:       "354034879028850",
+			meta:     softlayer,
+			config: map[string]interface{}{
+				"host_name":      "www.example.com",
+				"origin_address": "1.1.1.1",
+				"cname":          "example",
+				"header":         "www.example.com",
+			},
+		},
+		{
+			name:     "ibm_network_interface_sg_attachment",
+			resource: resourceIBMNetworkInterfaceSGAttachment(),
+			id:       "1234_4567",
+			meta:     softlayer,
+			config: map[string]interface{}{
+				"security_group_id":    1234,
+				"network_interface_id": 4567,
+			},
+		},
+		{
+			name:     "ibm_dns_domain_registration_nameservers",
+			resource: resourceIBMDNSDomainRegistrationNameservers(),
+			id:       "123456",
+			meta:     softlayer,
+			config: map[string]interface{}{
+				"dns_registration_id": "123456",
+				"name_servers":        []interface{}{"ns1.example.com", "ns2.example.com"},
+			},
+		},
+		{
+			name:     "ibm_container_api_key_reset",
+			resource: resourceIBMContainerAPIKeyReset(),
+			id:       "us-south/4ea1882a2d3401ed1e459979941966ea",
+			config: map[string]interface{}{
+				"region": "us-south",
+			},
+		},
+		{
+			name:     "ibm_iam_authorization_policy_detach",
+			resource: resourceIBMIAMAuthorizationPolicyDetach(),
+			id:       "a1b2c3d4-e5f6-4a1b-8c2d-3e4f5a6b7c8d",
+			config: map[string]interface{}{
+				"authorization_policy_id": "a1b2c3d4-e5f6-4a1b-8c2d-3e4f5a6b7c8d",
+			},
+		},
+		{
+			name:     "ibm_dns_reverse_record",
+			resource: resourceIBMDNSReverseRecord(),
+			id:       "98765",
+			meta:     softlayer,
+			config: map[string]interface{}{
+				"ipaddress": "1.2.3.4",
+				"hostname":  "www.example.com",
+				"ttl":       900,
+			},
+		},
+		{
+			name:     "ibm_security_group_rule",
+			resource: resourceIBMSecurityGroupRule(),
+			id:       "1234/5678",
+			stateID:  "5678",
+			meta:     softlayer,
+			config: map[string]interface{}{
+				"security_group_id": 1234,
+				"direction":         "ingress",
+				"port_range_min":    8080,
+				"port_range_max":    8080,
+				"protocol":          "tcp",
+			},
+		},
+		{
+			name:     "ibm_lbaas_health_monitor",
+			resource: resourceIBMLbaasHealthMonitor(),
+			id:       "988-454f-45vf-454542/d343f-f44r-wer3-fe3",
+			meta:     softlayer,
+			config: map[string]interface{}{
+				"lbaas_id":   "988-454f-45vf-454542",
+				"monitor_id": "d343f-f44r-wer3-fe3",
+				"protocol":   "HTTP",
+				"port":       80,
+				"interval":   10,
+				"url_path":   "/health",
+			},
+		},
+		{
+			name:     "ibm_lbaas_server_instance_attachment",
+			resource: resourceIBMLbaasServerInstanceAttachment(),
+			id:       "988-454f-45vf-454542/123456",
+			stateID:  "123456",
+			meta:     softlayer,
+			config: map[string]interface{}{
+				"lbaas_id":           "988-454f-45vf-454542",
+				"private_ip_address": "10.10.10.10",
+				"weight":             40,
+			},
+		},
+		{
+			name:     "ibm_is_vpc_routing_table",
+			resource: resourceIBMISVPCRoutingTable(),
+			id:       "r006-vpc/r006-rt",
+			meta:     vpc,
+			config: map[string]interface{}{
+				"vpc":  "r006-vpc",
+				"name": "routing-table",
+			},
+		},
+		{
+			name:     "ibm_is_vpc_routing_table_route",
+			resource: resourceIBMISVPCRoutingTableRoute(),
+			id:       "r006-vpc/r006-rt/r006-route",
+			meta:     vpc,
+			config: map[string]interface{}{
+				"vpc":           "r006-vpc",
+				"routing_table": "r006-rt",
+				"zone":          "us-south-1",
+				"destination":   "192.168.4.0/24",
+				"next_hop":      "10.0.0.4",
+				"name":          "route",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testImportStateEmptyPlan(t, tc.resource, tc.id, tc.stateID, tc.meta, tc.config)
+		})
+	}
+}
+
+func TestImportStateWithRead(t *testing.T) {
+	var resource func() *schema.Resource
+	resource = func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"parent":  {Type: schema.TypeString, Required: true, ForceNew: true},
+				"name":    {Type: schema.TypeString, Required: true},
+				"timeout": {Type: schema.TypeInt, Optional: true, Default: 10},
+				"force":   {Type: schema.TypeBool, Optional: true, Default: true},
+				"status":  {Type: schema.TypeString, Computed: true},
+			},
+			ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				switch d.Id() {
+				case "p1/missing":
+					d.SetId("")
+				case "p1/error":
+					return diag.Diagnostics{{Severity: diag.Error, Summary: "Error getting the child", Detail: "internal error"}}
+				default:
+					// The read needs the argument from the ID
+					if d.Get("parent").(string) != "p1" {
+						return diag.Errorf("Unknown parent %q", d.Get("parent"))
+					}
+					d.Set("name", "child")
+					d.Set("status", "active")
+				}
+				return nil
+			},
+			Importer: &schema.ResourceImporter{
+				StateContext: importStateWithRead(resource, importIDParts("parent", "")),
+			},
+		}
+	}
+	r := resource()
+	importState := func(id string) ([]*schema.ResourceData, error) {
+		d := r.Data(nil)
+		d.SetId(id)
+		return r.Importer.StateContext(context.Background(), d, nil)
+	}
+
+	imported, err := importState("p1/c1")
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(imported, 1))
+	assert.Equal(t, "p1/c1", imported[0].Id())
+	assert.Equal(t, "p1", imported[0].Get("parent"))
+	assert.Equal(t, "child", imported[0].Get("name"))
+	assert.Equal(t, "active", imported[0].Get("status"))
+	assert.Equal(t, 10, imported[0].Get("timeout"))
+	assert.Equal(t, true, imported[0].Get("force"))
+
+	_, err = importState("p1/missing")
+	assert.Error(t, err, "Cannot import the resource with ID p1/missing: it does not exist")
+	_, err = importState("p1/error")
+	assert.Error(t, err, "Error getting the child: internal error")
+	_, err = importState("c1")
+	assert.Error(t, err, "The ID c1 must be made of 2 parts separated by /")
+	_, err = importState("p1/")
+	assert.Error(t, err, "The part 2 of the ID p1/ is empty")
+}
+
+func TestImportStateNotFound(t *testing.T) {
+	softlayer := fakeSoftLayerClientSession(fakeSoftLayerTransport{})
+	vpc := fakeVPCClientSession(t, map[string]string{})
+	testCases := []struct {
+		resource *schema.Resource
+		id       string
+		meta     interface{}
+	}{
+		{resource: resourceIBMCDN(), id: "354034879028850", meta: softlayer},
+		{resource: resourceIBMDNSReverseRecord(), id: "98765", meta: softlayer},
+		{resource: resourceIBMISVPCRoutingTable(), id: "r006-vpc/r006-rt", meta: vpc},
+	}
+	for _, tc := range testCases {
+		d := tc.resource.Data(nil)
+		d.SetId(tc.id)
+		_, err := tc.resource.Importer.StateContext(context.Background(), d, tc.meta)
+		assert.Error(t, err, "Cannot import the resource with ID "+tc.id+": it does not exist")
+	}
+}
+
+func TestPTRRecordIPAddress(t *testing.T) {
+	ipAddress, ok := ptrRecordIPAddress("4", "3.2.1.in-addr.arpa")
+	assert.Assert(t, ok)
+	assert.Equal(t, "1.2.3.4", ipAddress)
+	_, ok = ptrRecordIPAddress("4", "2.1.in-addr.arpa")
+	assert.Assert(t, !ok)
+	_, ok = ptrRecordIPAddress("www", "example.com")
+	assert.Assert(t, !ok)
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package iamaccess evaluates IAM access policies offline, it answers whether
+// the policies of a principal grant an action on a resource without calling
+// the IAM services.
+package iamaccess
+
+import (
+	"sort"
+)
+
+// Resource attributes of the policies and requests
+const (
+	AccountIDAttribute       = "accountId"
+	ServiceTypeAttribute     = "serviceType"
+	ServiceNameAttribute     = "serviceName"
+	ServiceInstanceAttribute = "serviceInstance"
+	RegionAttribute          = "region"
+	ResourceTypeAttribute    = "resourceType"
+	ResourceAttribute        = "resource"
+	ResourceGroupIDAttribute = "resourceGroupId"
+)
+
+// Attributes are the attributes of a resource by name
+type Attributes map[string]string
+
+// Policy is an access policy of the principal or of one of its access groups
+type Policy struct {
+	ID string
+	// Roles are the CRNs of the roles granted by the policy
+	Roles []string
+	// Resources are the resources the roles are granted on, the policy applies
+	// to a request matching any of them
+	Resources []Attributes
+}
+
+// Request is the action to perform on a resource
+type Request struct {
+	Action   string
+	Resource Attributes
+}
+
+// Decision is the result of the evaluation of a request
+type Decision struct {
+	Allowed bool
+	// PolicyIDs are the IDs of the policies granting the action, sorted
+	PolicyIDs []string
+}
+
+// Evaluator evaluates requests against a fixed set of policies
+type Evaluator struct {
+	policies    []Policy
+	roleActions map[string]map[string]bool
+}
+
+// NewEvaluator returns an evaluator of the policies, roleActions maps the role
+// CRNs to the actions they allow
+func NewEvaluator(policies []Policy, roleActions map[string][]string) *Evaluator {
+	e := &Evaluator{
+		policies:    policies,
+		roleActions: make(map[string]map[string]bool, len(roleActions)),
+	}
+	for role, actions := range roleActions {
+		e.roleActions[role] = make(map[string]bool, len(actions))
+		for _, action := range actions {
+			e.roleActions[role][action] = true
+		}
+	}
+	return e
+}
+
+// Evaluate returns whether the action of the request is granted, access is
+// denied unless a policy matching the resource grants a role allowing the action
+func (e *Evaluator) Evaluate(request Request) Decision {
+	decision := Decision{PolicyIDs: []string{}}
+	for _, policy := range e.policies {
+		if e.allows(policy, request.Action) && matchesAny(policy.Resources, request.Resource) {
+			decision.PolicyIDs = append(decision.PolicyIDs, policy.ID)
+		}
+	}
+	sort.Strings(decision.PolicyIDs)
+	decision.Allowed = len(decision.PolicyIDs) > 0
+	return decision
+}
+
+func (e *Evaluator) allows(policy Policy, action string) bool {
+	for _, role := range policy.Roles {
+		if e.roleActions[role][action] {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAny(resources []Attributes, resource Attributes) bool {
+	for _, r := range resources {
+		if Matches(r, resource) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns whether the resource of a request is one of the resources
+// of a policy, every attribute of the policy must match the attribute of the
+// same name of the request. The policy values may contain the * and ?
+// wildcards, an empty policy resource matches nothing.
+func Matches(policy, resource Attributes) bool {
+	if len(policy) == 0 {
+		return false
+	}
+	for name, value := range policy {
+		actual, ok := resource[name]
+		if !ok || !matchValue(value, actual) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchValue matches the value against the pattern where * matches any
+// sequence of characters and ? a single character
+func matchValue(pattern, value string) bool {
+	p, v := []rune(pattern), []rune(value)
+	// Position after the last * of the pattern and the value it was tried at
+	star, retry := -1, 0
+	i, j := 0, 0
+	for j < len(v) {
+		switch {
+		case i < len(p) && (p[i] == '?' || p[i] == v[j]):
+			i++
+			j++
+		case i < len(p) && p[i] == '*':
+			star, retry = i+1, j
+			i++
+		case star >= 0:
+			retry++
+			i, j = star, retry
+		default:
+			return false
+		}
+	}
+	for i < len(p) && p[i] == '*' {
+		i++
+	}
+	return i == len(p)
+}
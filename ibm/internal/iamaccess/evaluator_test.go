@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccess
+
+import (
+	"reflect"
+	"testing"
+)
+
+const (
+	readerRole  = "crn:v1:bluemix:public:iam::::serviceRole:Reader"
+	writerRole  = "crn:v1:bluemix:public:iam::::serviceRole:Writer"
+	viewerRole  = "crn:v1:bluemix:public:iam::::role:Viewer"
+	customRole  = "crn:v1:bluemix:public:iam::a/account::customRole:ObjectPurger"
+	objectGet   = "cloud-object-storage.object.get"
+	objectPut   = "cloud-object-storage.object.put"
+	objectPurge = "cloud-object-storage.object.delete"
+	groupView   = "resource-controller.group.retrieve"
+)
+
+var testRoleActions = map[string][]string{
+	readerRole: {objectGet},
+	writerRole: {objectGet, objectPut},
+	viewerRole: {groupView},
+	customRole: {objectPurge},
+}
+
+func TestEvaluate(t *testing.T) {
+	cos := Attributes{
+		AccountIDAttribute:       "account",
+		ServiceTypeAttribute:     "service",
+		ServiceNameAttribute:     "cloud-object-storage",
+		ServiceInstanceAttribute: "cos-1",
+		RegionAttribute:          "us-south",
+		ResourceGroupIDAttribute: "rg-1",
+		ResourceTypeAttribute:    "bucket",
+		ResourceAttribute:        "logs-2021",
+	}
+	with := func(attributes Attributes, name, value string) Attributes {
+		result := Attributes{}
+		for k, v := range attributes {
+			result[k] = v
+		}
+		if value == "" {
+			delete(result, name)
+		} else {
+			result[name] = value
+		}
+		return result
+	}
+
+	cases := []struct {
+		name     string
+		policies []Policy
+		request  Request
+		expected Decision
+	}{
+		{
+			name:     "no policies",
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "service policy",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{AccountIDAttribute: "account", ServiceNameAttribute: "cloud-object-storage"}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p1"}},
+		},
+		{
+			name: "role without the action",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{ServiceNameAttribute: "cloud-object-storage"}}},
+			},
+			request:  Request{Action: objectPut, Resource: cos},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "unknown role",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{"crn:v1:bluemix:public:iam::::serviceRole:Manager"}, Resources: []Attributes{{ServiceNameAttribute: "cloud-object-storage"}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "any role of the policy",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole, customRole}, Resources: []Attributes{{ServiceNameAttribute: "cloud-object-storage"}}},
+			},
+			request:  Request{Action: objectPurge, Resource: cos},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p1"}},
+		},
+		{
+			name: "other service",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{ServiceNameAttribute: "kms"}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "other account",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{AccountIDAttribute: "other", ServiceNameAttribute: "cloud-object-storage"}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "all identity and access enabled services",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{writerRole}, Resources: []Attributes{{AccountIDAttribute: "account", ServiceTypeAttribute: "service"}}},
+			},
+			request:  Request{Action: objectPut, Resource: cos},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p1"}},
+		},
+		{
+			name: "all account management services",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{writerRole}, Resources: []Attributes{{AccountIDAttribute: "account", ServiceTypeAttribute: "platform_service"}}},
+			},
+			request:  Request{Action: objectPut, Resource: cos},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "resource group scope",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{ResourceGroupIDAttribute: "rg-1"}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p1"}},
+		},
+		{
+			name: "other resource group",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{ResourceGroupIDAttribute: "rg-2"}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "resource not in a resource group",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{ResourceGroupIDAttribute: "rg-1"}}},
+			},
+			request:  Request{Action: objectGet, Resource: with(cos, ResourceGroupIDAttribute, "")},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "resource group itself",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{viewerRole}, Resources: []Attributes{{ResourceTypeAttribute: "resource-group", ResourceAttribute: "rg-1"}}},
+			},
+			request:  Request{Action: groupView, Resource: Attributes{AccountIDAttribute: "account", ResourceTypeAttribute: "resource-group", ResourceAttribute: "rg-1"}},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p1"}},
+		},
+		{
+			name: "region",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{ServiceNameAttribute: "cloud-object-storage", RegionAttribute: "eu-de"}}},
+				{ID: "p2", Roles: []string{readerRole}, Resources: []Attributes{{ServiceNameAttribute: "cloud-object-storage", RegionAttribute: "us-south"}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p2"}},
+		},
+		{
+			name: "service instance",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{writerRole}, Resources: []Attributes{{ServiceNameAttribute: "cloud-object-storage", ServiceInstanceAttribute: "cos-2"}}},
+			},
+			request:  Request{Action: objectPut, Resource: cos},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "resource",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{writerRole}, Resources: []Attributes{{ServiceInstanceAttribute: "cos-1", ResourceTypeAttribute: "bucket", ResourceAttribute: "logs-2021"}}},
+			},
+			request:  Request{Action: objectPut, Resource: cos},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p1"}},
+		},
+		{
+			name: "attribute missing from the request",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{writerRole}, Resources: []Attributes{{ServiceInstanceAttribute: "cos-1", ResourceTypeAttribute: "bucket", ResourceAttribute: "logs-2021"}}},
+			},
+			request:  Request{Action: objectPut, Resource: with(cos, ResourceAttribute, "")},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "custom attribute",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{ServiceInstanceAttribute: "cos-1", "prefix": "reports/"}}},
+			},
+			request:  Request{Action: objectGet, Resource: with(cos, "prefix", "reports/")},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p1"}},
+		},
+		{
+			name: "wildcard",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{ServiceInstanceAttribute: "cos-1", ResourceTypeAttribute: "bucket", ResourceAttribute: "logs-*"}}},
+				{ID: "p2", Roles: []string{readerRole}, Resources: []Attributes{{ServiceInstanceAttribute: "cos-1", ResourceTypeAttribute: "bucket", ResourceAttribute: "logs-202?"}}},
+				{ID: "p3", Roles: []string{readerRole}, Resources: []Attributes{{ServiceInstanceAttribute: "cos-1", ResourceTypeAttribute: "bucket", ResourceAttribute: "audit-*"}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p1", "p2"}},
+		},
+		{
+			name: "any resource of the policy",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{ServiceNameAttribute: "kms"}, {ServiceNameAttribute: "cloud-object-storage"}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p1"}},
+		},
+		{
+			name: "empty policy resource",
+			policies: []Policy{
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: false, PolicyIDs: []string{}},
+		},
+		{
+			name: "every granting policy",
+			policies: []Policy{
+				{ID: "p3", Roles: []string{writerRole}, Resources: []Attributes{{ServiceNameAttribute: "cloud-object-storage"}}},
+				{ID: "p2", Roles: []string{viewerRole}, Resources: []Attributes{{ServiceNameAttribute: "cloud-object-storage"}}},
+				{ID: "p1", Roles: []string{readerRole}, Resources: []Attributes{{ResourceGroupIDAttribute: "rg-1"}}},
+			},
+			request:  Request{Action: objectGet, Resource: cos},
+			expected: Decision{Allowed: true, PolicyIDs: []string{"p1", "p3"}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			decision := NewEvaluator(c.policies, testRoleActions).Evaluate(c.request)
+			if !reflect.DeepEqual(c.expected, decision) {
+				t.Errorf("expected %+v, got %+v", c.expected, decision)
+			}
+		})
+	}
+}
+
+func TestMatchValue(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"abc", "abc", true},
+		{"abc", "abd", false},
+		{"abc", "ab", false},
+		{"*", "", true},
+		{"*", "anything", true},
+		{"logs-*", "logs-", true},
+		{"logs-*", "logs-2021", true},
+		{"logs-*", "audit-2021", false},
+		{"*-2021", "logs-2021", true},
+		{"*-2021", "logs-2020", false},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxbyy", false},
+		{"a*bc", "abcbc", true},
+		{"logs-202?", "logs-2021", true},
+		{"logs-202?", "logs-20211", false},
+		{"??", "é1", true},
+		{"**", "x", true},
+	}
+
+	for _, c := range cases {
+		if actual := matchValue(c.pattern, c.value); actual != c.expected {
+			t.Errorf("matchValue(%q, %q): expected %t, got %t", c.pattern, c.value, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	kp "github.com/IBM/keyprotect-go-client"
+)
+
+const (
+	kmsPolicyCollectionType = "application/vnd.ibm.kms.policy+json"
+
+	// kmsKeyCreateImportAccessPolicyType is the instance policy restricting how the keys are created
+	kmsKeyCreateImportAccessPolicyType = "keyCreateImportAccess"
+)
+
+// kmsKeyRing is a key ring of a Key Protect or HPCS instance
+type kmsKeyRing struct {
+	ID           string     `json:"id"`
+	CreationDate *time.Time `json:"creationDate,omitempty"`
+	CreatedBy    string     `json:"createdBy,omitempty"`
+}
+
+// kmsKeyAlias is an alias of a key, the alias can be used instead of the key ID
+type kmsKeyAlias struct {
+	KeyID        string     `json:"keyId"`
+	Alias        string     `json:"alias"`
+	CreationDate *time.Time `json:"creationDate,omitempty"`
+	CreatedBy    string     `json:"createdBy,omitempty"`
+}
+
+// kmsKeyCreateImportAccessAttributes are the ways of creating a key allowed
+// by the keyCreateImportAccess policy
+type kmsKeyCreateImportAccessAttributes struct {
+	CreateRootKey     bool `json:"create_root_key"`
+	CreateStandardKey bool `json:"create_standard_key"`
+	ImportRootKey     bool `json:"import_root_key"`
+	ImportStandardKey bool `json:"import_standard_key"`
+	EnforceToken      bool `json:"enforce_token"`
+}
+
+// kmsKeyCreateImportAccessPolicy is the keyCreateImportAccess instance
+// policy, kp.InstancePolicy doesn't have its attributes
+type kmsKeyCreateImportAccessPolicy struct {
+	PolicyType string `json:"policy_type"`
+	PolicyData struct {
+		Enabled    bool                                `json:"enabled"`
+		Attributes *kmsKeyCreateImportAccessAttributes `json:"attributes,omitempty"`
+	} `json:"policy_data"`
+}
+
+// kmsInstanceAPI returns a copy of the key management client targeting the
+// Key Protect or HPCS instance, and the type of the instance. The client is
+// copied so that the shared client isn't changed.
+func kmsInstanceAPI(meta interface{}, instanceID, endpointType string) (*kp.Client, string, error) {
+	kpAPI, err := meta.(ClientSession).keyManagementAPI()
+	if err != nil {
+		return nil, "", err
+	}
+	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return nil, "", err
+	}
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return nil, "", err
+	}
+	crnData := strings.Split(instanceData.Crn.String(), ":")
+	if len(crnData) < 5 {
+		return nil, "", fmt.Errorf("Invalid or unsupported service Instance")
+	}
+	instanceType := crnData[4]
+
+	client := *kpAPI
+	u := *kpAPI.URL
+	switch instanceType {
+	case "hs-crypto":
+		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
+		if err != nil {
+			return nil, "", err
+		}
+		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
+		if err != nil {
+			return nil, "", err
+		}
+		host := resp.Kms.Public
+		if endpointType == "private" {
+			host = resp.Kms.Private
+		}
+		hpcsURL, err := url.Parse("https://" + host + "/api/v2/")
+		if err != nil {
+			return nil, "", fmt.Errorf("Error Parsing hpcs EndpointURL")
+		}
+		u = *hpcsURL
+	case "kms":
+		if endpointType == "private" && !strings.HasPrefix(u.Host, "private.") {
+			u.Host = "private." + u.Host
+		}
+	default:
+		return nil, "", fmt.Errorf("Invalid or unsupported service Instance")
+	}
+	client.URL = &u
+	client.Config.InstanceID = instanceID
+	return &client, instanceType, nil
+}
+
+// kmsDo calls a Key Protect API that the kp client doesn't cover, the errors
+// are *kp.Error like the errors of the kp client
+func kmsDo(ctx context.Context, client *kp.Client, method, path string, body, res interface{}) error {
+	u, err := client.URL.Parse(path)
+	if err != nil {
+		return err
+	}
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := gohttp.NewRequest(method, u.String(), reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("accept", "application/json")
+	if body != nil {
+		req.Header.Set("content-type", "application/json")
+	}
+	req.Header.Set("authorization", client.Config.Authorization)
+	req.Header.Set("bluemix-instance", client.Config.InstanceID)
+
+	resp, err := client.HttpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message := string(data)
+		var kpErr struct {
+			Resources []struct {
+				ErrorMsg string `json:"errorMsg"`
+			} `json:"resources"`
+		}
+		if json.Unmarshal(data, &kpErr) == nil && len(kpErr.Resources) > 0 && kpErr.Resources[0].ErrorMsg != "" {
+			message = kpErr.Resources[0].ErrorMsg
+		}
+		return &kp.Error{
+			URL:         u.String(),
+			StatusCode:  resp.StatusCode,
+			Message:     message,
+			BodyContent: data,
+		}
+	}
+	if res == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, res)
+}
+
+func isKMSNotFound(err error) bool {
+	kpErr, ok := err.(*kp.Error)
+	return ok && kpErr.StatusCode == gohttp.StatusNotFound
+}
+
+func listKMSKeyRings(ctx context.Context, client *kp.Client) ([]kmsKeyRing, error) {
+	var keyRings struct {
+		Resources []kmsKeyRing `json:"resources"`
+	}
+	err := kmsDo(ctx, client, gohttp.MethodGet, "key_rings", nil, &keyRings)
+	return keyRings.Resources, err
+}
+
+func createKMSKeyRing(ctx context.Context, client *kp.Client, id string) error {
+	return kmsDo(ctx, client, gohttp.MethodPost, "key_rings/"+url.PathEscape(id), nil, nil)
+}
+
+func deleteKMSKeyRing(ctx context.Context, client *kp.Client, id string) error {
+	return kmsDo(ctx, client, gohttp.MethodDelete, "key_rings/"+url.PathEscape(id), nil, nil)
+}
+
+func kmsKeyAliasPath(keyID, alias string) string {
+	return fmt.Sprintf("keys/%s/aliases/%s", url.PathEscape(keyID), url.PathEscape(alias))
+}
+
+func createKMSKeyAlias(ctx context.Context, client *kp.Client, keyID, alias string) (kmsKeyAlias, error) {
+	var aliases struct {
+		Resources []kmsKeyAlias `json:"resources"`
+	}
+	err := kmsDo(ctx, client, gohttp.MethodPost, kmsKeyAliasPath(keyID, alias), nil, &aliases)
+	if err != nil {
+		return kmsKeyAlias{}, err
+	}
+	if len(aliases.Resources) == 0 {
+		return kmsKeyAlias{KeyID: keyID, Alias: alias}, nil
+	}
+	return aliases.Resources[0], nil
+}
+
+func deleteKMSKeyAlias(ctx context.Context, client *kp.Client, keyID, alias string) error {
+	return kmsDo(ctx, client, gohttp.MethodDelete, kmsKeyAliasPath(keyID, alias), nil, nil)
+}
+
+// getKMSKeyAliases returns the aliases of the key, kp.Key doesn't have them
+func getKMSKeyAliases(ctx context.Context, client *kp.Client, keyID string) ([]string, error) {
+	var keys struct {
+		Resources []struct {
+			Aliases []string `json:"aliases"`
+		} `json:"resources"`
+	}
+	err := kmsDo(ctx, client, gohttp.MethodGet, fmt.Sprintf("keys/%s/metadata", url.PathEscape(keyID)), nil, &keys)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys.Resources) == 0 {
+		return []string{}, nil
+	}
+	return keys.Resources[0].Aliases, nil
+}
+
+// getKMSKeyCreateImportAccessPolicy returns the keyCreateImportAccess policy
+// of the instance, nil when it was never set
+func getKMSKeyCreateImportAccessPolicy(ctx context.Context, client *kp.Client) (*kmsKeyCreateImportAccessPolicy, error) {
+	var policies struct {
+		Resources []kmsKeyCreateImportAccessPolicy `json:"resources"`
+	}
+	err := kmsDo(ctx, client, gohttp.MethodGet, "instance/policies?policy="+kmsKeyCreateImportAccessPolicyType, nil, &policies)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies.Resources) == 0 {
+		return nil, nil
+	}
+	return &policies.Resources[0], nil
+}
+
+func setKMSKeyCreateImportAccessPolicy(ctx context.Context, client *kp.Client, enabled bool, attributes *kmsKeyCreateImportAccessAttributes) error {
+	policy := kmsKeyCreateImportAccessPolicy{PolicyType: kmsKeyCreateImportAccessPolicyType}
+	policy.PolicyData.Enabled = enabled
+	policy.PolicyData.Attributes = attributes
+	body := map[string]interface{}{
+		"metadata": kp.PoliciesMetadata{
+			CollectionType:   kmsPolicyCollectionType,
+			NumberOfPolicies: 1,
+		},
+		"resources": []kmsKeyCreateImportAccessPolicy{policy},
+	}
+	return kmsDo(ctx, client, gohttp.MethodPut, "instance/policies?policy="+kmsKeyCreateImportAccessPolicyType, body, nil)
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+func newTestKMSClient(t *testing.T, handler gohttp.HandlerFunc) (*kp.Client, func()) {
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		if r.Header.Get("authorization") != "Bearer token" || r.Header.Get("bluemix-instance") != "instance" {
+			w.WriteHeader(gohttp.StatusUnauthorized)
+			fmt.Fprint(w, `{"resources":[{"errorMsg":"Unauthorized: The user does not have access to the specified resource"}]}`)
+			return
+		}
+		handler(w, r)
+	}))
+	client, err := kp.New(kp.ClientConfig{BaseURL: server.URL, Authorization: "Bearer token", InstanceID: "instance"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, server.Close
+}
+
+func TestKMSKeyRings(t *testing.T) {
+	keyRings := map[string]bool{"default": true}
+	client, closeServer := newTestKMSClient(t, func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/key_rings/")
+		switch {
+		case r.Method == gohttp.MethodGet && r.URL.Path == "/api/v2/key_rings":
+			resources := []kmsKeyRing{}
+			for id := range keyRings {
+				resources = append(resources, kmsKeyRing{ID: id, CreatedBy: "IBMid-1234"})
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"resources": resources})
+		case r.Method == gohttp.MethodPost && !keyRings[id]:
+			keyRings[id] = true
+			w.WriteHeader(gohttp.StatusCreated)
+		case r.Method == gohttp.MethodPost:
+			w.WriteHeader(gohttp.StatusConflict)
+			fmt.Fprint(w, `{"resources":[{"errorMsg":"Conflict: Key ring already exists"}]}`)
+		case r.Method == gohttp.MethodDelete && keyRings[id]:
+			delete(keyRings, id)
+			w.WriteHeader(gohttp.StatusNoContent)
+		default:
+			w.WriteHeader(gohttp.StatusNotFound)
+			fmt.Fprint(w, `{"resources":[{"errorMsg":"Not Found: Key ring does not exist"}]}`)
+		}
+	})
+	defer closeServer()
+	ctx := context.Background()
+
+	assert.Assert(t, is.Nil(createKMSKeyRing(ctx, client, "team-a")))
+	err := createKMSKeyRing(ctx, client, "team-a")
+	assert.Assert(t, err != nil)
+	assert.Equal(t, gohttp.StatusConflict, err.(*kp.Error).StatusCode)
+	assert.Equal(t, "Conflict: Key ring already exists", err.(*kp.Error).Message)
+	list, err := listKMSKeyRings(ctx, client)
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(list, 2))
+
+	assert.Assert(t, is.Nil(deleteKMSKeyRing(ctx, client, "team-a")))
+	err = deleteKMSKeyRing(ctx, client, "team-a")
+	assert.Assert(t, isKMSNotFound(err))
+}
+
+func TestKMSKeyAliases(t *testing.T) {
+	aliases := []string{}
+	client, closeServer := newTestKMSClient(t, func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		switch {
+		case r.Method == gohttp.MethodGet && r.URL.Path == "/api/v2/keys/key1/metadata":
+			json.NewEncoder(w).Encode(map[string]interface{}{"resources": []map[string]interface{}{{"id": "key1", "aliases": aliases}}})
+		case r.Method == gohttp.MethodPost && strings.HasPrefix(r.URL.Path, "/api/v2/keys/key1/aliases/"):
+			alias := strings.TrimPrefix(r.URL.Path, "/api/v2/keys/key1/aliases/")
+			aliases = append(aliases, alias)
+			w.WriteHeader(gohttp.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{"resources": []kmsKeyAlias{{KeyID: "key1", Alias: alias, CreatedBy: "IBMid-1234"}}})
+		case r.Method == gohttp.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v2/keys/key1/aliases/"):
+			aliases = []string{}
+			w.WriteHeader(gohttp.StatusNoContent)
+		default:
+			w.WriteHeader(gohttp.StatusNotFound)
+			fmt.Fprint(w, `{"resources":[{"errorMsg":"Not Found: Key does not exist"}]}`)
+		}
+	})
+	defer closeServer()
+	ctx := context.Background()
+
+	alias, err := createKMSKeyAlias(ctx, client, "key1", "payments")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, kmsKeyAlias{KeyID: "key1", Alias: "payments", CreatedBy: "IBMid-1234"}, alias)
+	list, err := getKMSKeyAliases(ctx, client, "key1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"payments"}, list)
+
+	assert.Assert(t, is.Nil(deleteKMSKeyAlias(ctx, client, "key1", "payments")))
+	_, err = getKMSKeyAliases(ctx, client, "key2")
+	assert.Assert(t, isKMSNotFound(err))
+}
+
+func TestKMSKeyCreateImportAccessPolicy(t *testing.T) {
+	var stored *kmsKeyCreateImportAccessPolicy
+	client, closeServer := newTestKMSClient(t, func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		if r.URL.Path != "/api/v2/instance/policies" || r.URL.Query().Get("policy") != "keyCreateImportAccess" {
+			w.WriteHeader(gohttp.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case gohttp.MethodGet:
+			resources := []kmsKeyCreateImportAccessPolicy{}
+			if stored != nil {
+				resources = append(resources, *stored)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"resources": resources})
+		case gohttp.MethodPut:
+			var body struct {
+				Metadata  kp.PoliciesMetadata              `json:"metadata"`
+				Resources []kmsKeyCreateImportAccessPolicy `json:"resources"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.Metadata.CollectionType != "application/vnd.ibm.kms.policy+json" || len(body.Resources) != 1 {
+				w.WriteHeader(gohttp.StatusBadRequest)
+				return
+			}
+			stored = &body.Resources[0]
+			w.WriteHeader(gohttp.StatusNoContent)
+		}
+	})
+	defer closeServer()
+	ctx := context.Background()
+
+	policy, err := getKMSKeyCreateImportAccessPolicy(ctx, client)
+	assert.NilError(t, err)
+	assert.Assert(t, is.Nil(policy))
+
+	attributes := &kmsKeyCreateImportAccessAttributes{ImportRootKey: true, ImportStandardKey: true, EnforceToken: true}
+	assert.Assert(t, is.Nil(setKMSKeyCreateImportAccessPolicy(ctx, client, true, attributes)))
+	policy, err = getKMSKeyCreateImportAccessPolicy(ctx, client)
+	assert.NilError(t, err)
+	assert.Assert(t, policy != nil)
+	assert.Equal(t, "keyCreateImportAccess", policy.PolicyType)
+	assert.Assert(t, policy.PolicyData.Enabled)
+	assert.DeepEqual(t, attributes, policy.PolicyData.Attributes)
+	assert.DeepEqual(t, []map[string]interface{}{{
+		"enabled":             true,
+		"create_root_key":     false,
+		"create_standard_key": false,
+		"import_root_key":     true,
+		"import_standard_key": true,
+		"enforce_token":       true,
+	}}, flattenKMSKeyCreateImportAccess(policy))
+
+	assert.Assert(t, is.Nil(setKMSKeyCreateImportAccessPolicy(ctx, client, false, nil)))
+	policy, err = getKMSKeyCreateImportAccessPolicy(ctx, client)
+	assert.NilError(t, err)
+	assert.Assert(t, !policy.PolicyData.Enabled)
+	assert.Equal(t, true, flattenKMSKeyCreateImportAccess(policy)[0]["create_root_key"])
+}
+
+func TestKMSDoUnauthorized(t *testing.T) {
+	client, closeServer := newTestKMSClient(t, func(w gohttp.ResponseWriter, r *gohttp.Request) {})
+	defer closeServer()
+	client.Config.InstanceID = "other"
+
+	_, err := listKMSKeyRings(context.Background(), client)
+	assert.Assert(t, err != nil)
+	kpErr := err.(*kp.Error)
+	assert.Equal(t, gohttp.StatusUnauthorized, kpErr.StatusCode)
+	assert.Equal(t, "Unauthorized: The user does not have access to the specified resource", kpErr.Message)
+	assert.Assert(t, !isKMSNotFound(err))
+}
+
+func TestFlattenKeyRegistrations(t *testing.T) {
+	registrations := []kp.Registration{{
+		KeyID:              "key1",
+		ResourceCrn:        "crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:logs",
+		CreatedBy:          "IBMid-1234",
+		PreventKeyDeletion: true,
+	}}
+	assert.DeepEqual(t, []map[string]interface{}{{
+		"resource_crn":         "crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:logs",
+		"description":          "",
+		"prevent_key_deletion": true,
+		"created_by":           "IBMid-1234",
+		"updated_by":           "",
+	}}, flattenKeyRegistrations(registrations))
+	assert.Assert(t, is.Len(flattenKeyRegistrations(nil), 0))
+}
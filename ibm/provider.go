@@ -4,10 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/mutexkv"
@@ -18,7 +21,7 @@ var ibmMutexKV = mutexkv.NewMutexKV()
 
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -154,6 +157,66 @@ func Provider() *schema.Provider {
 				Description: "IAM Authentication refresh token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN"}, nil),
 			},
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Custom service endpoints that override the default IBM Cloud endpoints",
+				Elem:        endpointsSchema(),
+			},
+			"endpoints_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a JSON file with custom service endpoints keyed by region",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{visibilityPublic, visibilityPrivate, visibilityPublicAndPrivate}),
+				Description:  "Visibility of the IBM Cloud service endpoints. Allowable values are public, private, public-and-private. Default is public",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, visibilityPublic),
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags added to all the taggable resources of the provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tags added to all the taggable resources",
+						},
+					},
+				},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags of the taggable resources that are ignored by the provider",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tags starting with one of these prefixes are neither read nor removed",
+						},
+					},
+				},
+			},
+			"delete_detached_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the tags from the account once they are detached from a resource. A tag still attached to other resources is not deleted",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -190,6 +253,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_page_rules":                     dataSourceIBMCISPageRules(),
 			"ibm_cis_waf_rules":                      dataSourceIBMCISWAFRules(),
 			"ibm_database":                           dataSourceIBMDatabaseInstance(),
+			"ibm_database_backups":                   dataSourceIBMDatabaseBackups(),
 			"ibm_compute_bare_metal":                 dataSourceIBMComputeBareMetal(),
 			"ibm_compute_image_template":             dataSourceIBMComputeImageTemplate(),
 			"ibm_compute_placement_group":            dataSourceIBMComputePlacementGroup(),
@@ -217,6 +281,7 @@ func Provider() *schema.Provider {
 			"ibm_dns_secondary":                      dataSourceIBMDNSSecondary(),
 			"ibm_event_streams_topic":                dataSourceIBMEventStreamsTopic(),
 			"ibm_iam_access_group":                   dataSourceIBMIAMAccessGroup(),
+			"ibm_iam_access_evaluation":              dataSourceIBMIAMAccessEvaluation(),
 			"ibm_iam_auth_token":                     dataSourceIBMIAMAuthToken(),
 			"ibm_iam_role_actions":                   datasourceIBMIAMRoleAction(),
 			"ibm_iam_users":                          dataSourceIBMIAMUsers(),
@@ -252,6 +317,13 @@ func Provider() *schema.Provider {
 			"ibm_is_subnets":                         dataSourceIBMISSubnets(),
 			"ibm_is_security_group":                  dataSourceIBMISSecurityGroup(),
 			"ibm_is_volume":                          dataSourceIBMISVolume(),
+			"ibm_is_snapshot":                        dataSourceIBMISSnapshot(),
+			"ibm_is_snapshots":                       dataSourceIBMISSnapshots(),
+			"ibm_is_dedicated_host":                  dataSourceIBMISDedicatedHost(),
+			"ibm_is_dedicated_host_group":            dataSourceIBMISDedicatedHostGroup(),
+			"ibm_is_placement_group":                 dataSourceIBMISPlacementGroup(),
+			"ibm_is_bare_metal_server_profile":       dataSourceIBMISBareMetalServerProfile(),
+			"ibm_is_bare_metal_server_profiles":      dataSourceIBMISBareMetalServerProfiles(),
 			"ibm_is_volume_profile":                  dataSourceIBMISVolumeProfile(),
 			"ibm_is_volume_profiles":                 dataSourceIBMISVolumeProfiles(),
 			"ibm_is_vpc":                             dataSourceIBMISVPC(),
@@ -341,6 +413,7 @@ func Provider() *schema.Provider {
 			"ibm_function_namespace":                             resourceIBMFunctionNamespace(),
 			"ibm_cis":                                            resourceIBMCISInstance(),
 			"ibm_database":                                       resourceIBMDatabaseInstance(),
+			"ibm_database_backup":                                resourceIBMDatabaseBackup(),
 			"ibm_certificate_manager_import":                     resourceIBMCertificateManagerImport(),
 			"ibm_certificate_manager_order":                      resourceIBMCertificateManagerOrder(),
 			"ibm_cis_domain":                                     resourceIBMCISDomain(),
@@ -410,6 +483,11 @@ func Provider() *schema.Provider {
 			"ibm_iam_service_api_key":                            resourceIBMIAMServiceAPIKey(),
 			"ibm_iam_service_policy":                             resourceIBMIAMServicePolicy(),
 			"ibm_iam_user_invite":                                resourceIBMUserInvite(),
+			"ibm_iam_account_settings":                           resourceIBMIAMAccountSettings(),
+			"ibm_iam_trusted_profile":                            resourceIBMIAMTrustedProfile(),
+			"ibm_iam_trusted_profile_claim_rule":                 resourceIBMIAMTrustedProfileClaimRule(),
+			"ibm_iam_trusted_profile_link":                       resourceIBMIAMTrustedProfileLink(),
+			"ibm_iam_trusted_profile_policy":                     resourceIBMIAMTrustedProfilePolicy(),
 			"ibm_ipsec_vpn":                                      resourceIBMIPSecVPN(),
 			"ibm_is_floating_ip":                                 resourceIBMISFloatingIP(),
 			"ibm_is_flow_log":                                    resourceIBMISFlowLog(),
@@ -420,6 +498,7 @@ func Provider() *schema.Provider {
 			"ibm_is_virtual_endpoint_gateway":                    resourceIBMISEndpointGateway(),
 			"ibm_is_virtual_endpoint_gateway_ip":                 resourceIBMISEndpointGatewayIP(),
 			"ibm_is_instance_template":                           resourceIBMISInstanceTemplate(),
+			"ibm_is_instance_volume_attachment":                  resourceIBMISInstanceVolumeAttachment(),
 			"ibm_is_ike_policy":                                  resourceIBMISIKEPolicy(),
 			"ibm_is_ipsec_policy":                                resourceIBMISIPSecPolicy(),
 			"ibm_is_lb":                                          resourceIBMISLB(),
@@ -437,6 +516,12 @@ func Provider() *schema.Provider {
 			"ibm_is_subnet_network_acl_attachment":               resourceIBMISSubnetNetworkACLAttachment(),
 			"ibm_is_ssh_key":                                     resourceIBMISSSHKey(),
 			"ibm_is_volume":                                      resourceIBMISVolume(),
+			"ibm_is_snapshot":                                    resourceIBMISSnapshot(),
+			"ibm_is_dedicated_host":                              resourceIBMISDedicatedHost(),
+			"ibm_is_dedicated_host_group":                        resourceIBMISDedicatedHostGroup(),
+			"ibm_is_placement_group":                             resourceIBMISPlacementGroup(),
+			"ibm_is_bare_metal_server":                           resourceIBMISBareMetalServer(),
+			"ibm_is_bare_metal_server_network_interface":         resourceIBMISBareMetalServerNetworkInterface(),
 			"ibm_is_vpn_gateway":                                 resourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateway_connection":                      resourceIBMISVPNGatewayConnection(),
 			"ibm_is_vpc":                                         resourceIBMISVPC(),
@@ -469,6 +554,7 @@ func Provider() *schema.Provider {
 			"ibm_resource_group":                                 resourceIBMResourceGroup(),
 			"ibm_resource_instance":                              resourceIBMResourceInstance(),
 			"ibm_resource_key":                                   resourceIBMResourceKey(),
+			"ibm_resource_tag":                                   resourceIBMResourceTag(),
 			"ibm_security_group":                                 resourceIBMSecurityGroup(),
 			"ibm_security_group_rule":                            resourceIBMSecurityGroupRule(),
 			"ibm_service_instance":                               resourceIBMServiceInstance(),
@@ -513,10 +599,31 @@ func Provider() *schema.Provider {
 			"ibm_tg_gateway":           resourceIBMTransitGateway(),
 			"ibm_tg_connection":        resourceIBMTransitGatewayConnection(),
 			"ibm_cm_offering_instance": resourceIBMCmOfferingInstance(),
+
+			//Added for Schematics
+			"ibm_schematics_workspace": resourceIBMSchematicsWorkspace(),
+			"ibm_schematics_action":    resourceIBMSchematicsAction(),
+
+			//Added for Event Streams
+			"ibm_event_streams_acl":    resourceIBMEventStreamsACL(),
+			"ibm_event_streams_quota":  resourceIBMEventStreamsQuota(),
+			"ibm_event_streams_schema": resourceIBMEventStreamsSchema(),
+
+			//Added for Key Protect and HPCS
+			"ibm_kms_key_rings":         resourceIBMKmsKeyRings(),
+			"ibm_kms_key_alias":         resourceIBMKmsKeyAlias(),
+			"ibm_kms_key_rotation":      resourceIBMKmsKeyRotation(),
+			"ibm_kms_instance_policies": resourceIBMKmsInstancePolicies(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
+
+	// A validator registered for an unknown resource or parameter is a programming error
+	if err := validatorDict.validateIdentifiers(provider.ResourcesMap, provider.DataSourcesMap); err != nil {
+		panic(err)
+	}
+	return provider
 }
 
 var globalValidatorDict ValidatorDict
@@ -544,6 +651,7 @@ func Validator() ValidatorDict {
 				"ibm_cis_waf_rule":           resourceIBMCISWAFRuleValidator(),
 				"ibm_cis_certificate_order":  resourceIBMCISCertificateOrderValidator(),
 				"ibm_cr_namespace":           resourceIBMCrNamespaceValidator(),
+				"ibm_cos_bucket":             resourceIBMCOSBucketValidator(),
 				"ibm_tg_gateway":             resourceIBMTGValidator(),
 				"ibm_tg_connection":          resourceIBMTransitGatewayConnectionValidator(),
 				"ibm_dl_virtual_connection":  resourceIBMdlGatewayVCValidator(),
@@ -555,10 +663,12 @@ func Validator() ValidatorDict {
 				"ibm_function_rule":                    resourceIBMFuncRuleValidator(),
 				"ibm_function_trigger":                 resourceIBMFuncTriggerValidator(),
 				"ibm_function_namespace":               resourceIBMFuncNamespaceValidator(),
+				"ibm_hardware_firewall_shared":         resourceIBMFirewallSharedValidator(),
 				"ibm_is_flow_log":                      resourceIBMISFlowLogValidator(),
 				"ibm_is_instance_group":                resourceIBMISInstanceGroupValidator(),
 				"ibm_is_instance_group_manager":        resourceIBMISInstanceGroupManagerValidator(),
 				"ibm_is_instance_group_manager_policy": resourceIBMISInstanceGroupManagerPolicyValidator(),
+				"ibm_is_instance_volume_attachment":    resourceIBMISInstanceVolumeAttachmentValidator(),
 				"ibm_is_floating_ip":                   resourceIBMISFloatingIPValidator(),
 				"ibm_is_ike_policy":                    resourceIBMISIKEValidator(),
 				"ibm_is_image":                         resourceIBMISImageValidator(),
@@ -576,8 +686,12 @@ func Validator() ValidatorDict {
 				"ibm_is_ssh_key":                       resourceIBMISSHKeyValidator(),
 				"ibm_is_subnet":                        resourceIBMISSubnetValidator(),
 				"ibm_is_volume":                        resourceIBMISVolumeValidator(),
-				"ibm_is_address_prefix":                resourceIBMISAddressPrefixValidator(),
-				"ibm_is_route":                         resourceIBMISRouteValidator(),
+				"ibm_is_snapshot":                      resourceIBMISSnapshotValidator(),
+				"ibm_is_dedicated_host":                resourceIBMISDedicatedHostValidator(),
+				"ibm_is_dedicated_host_group":          resourceIBMISDedicatedHostGroupValidator(),
+				"ibm_is_placement_group":               resourceIBMISPlacementGroupValidator(),
+				"ibm_is_vpc_address_prefix":            resourceIBMISAddressPrefixValidator(),
+				"ibm_is_vpc_route":                     resourceIBMISRouteValidator(),
 				"ibm_is_vpc":                           resourceIBMISVPCValidator(),
 				"ibm_is_vpc_routing_table":             resourceIBMISVPCRoutingTableValidator(),
 				"ibm_is_vpc_routing_table_route":       resourceIBMISVPCRoutingTableRouteValidator(),
@@ -585,20 +699,50 @@ func Validator() ValidatorDict {
 				"ibm_is_vpn_gateway":                   resourceIBMISVPNGatewayValidator(),
 				"ibm_dns_glb_monitor":                  resourceIBMPrivateDNSGLBMonitorValidator(),
 				"ibm_dns_glb_pool":                     resourceIBMPrivateDNSGLBPoolValidator(),
+
+				"ibm_is_bare_metal_server":                   resourceIBMISBareMetalServerValidator(),
+				"ibm_is_bare_metal_server_network_interface": resourceIBMISBareMetalServerNetworkInterfaceValidator(),
+				"ibm_schematics_workspace":                   resourceIBMSchematicsWorkspaceValidator(),
+				"ibm_schematics_action":                      resourceIBMSchematicsActionValidator(),
+				"ibm_event_streams_acl":                      resourceIBMEventStreamsACLValidator(),
+				"ibm_kms_key_rings":                          resourceIBMKmsKeyRingsValidator(),
+				"ibm_kms_key_alias":                          resourceIBMKmsKeyAliasValidator(),
+				"ibm_kms_instance_policies":                  resourceIBMKmsInstancePoliciesValidator(),
+				"ibm_iam_trusted_profile":                    resourceIBMIAMTrustedProfileValidator(),
+				"ibm_iam_trusted_profile_claim_rule":         resourceIBMIAMTrustedProfileClaimRuleValidator(),
+				"ibm_iam_trusted_profile_link":               resourceIBMIAMTrustedProfileLinkValidator(),
+				"ibm_iam_account_settings":                   resourceIBMIAMAccountSettingsValidator(),
 			},
 			DataSourceValidatorDictionary: map[string]*ResourceValidator{
-				"ibm_is_subnet":          dataSourceIBMISSubnetValidator(),
-				"ibm_dl_offering_speeds": datasourceIBMDLOfferingSpeedsValidator(),
-				"ibm_dl_routers":         datasourceIBMDLRoutersValidator(),
-				"ibm_is_vpc":             dataSourceIBMISVpcValidator(),
-				"ibm_is_volume":          dataSourceIBMISVolumeValidator(),
+				"ibm_is_subnet":                    dataSourceIBMISSubnetValidator(),
+				"ibm_dl_offering_speeds":           datasourceIBMDLOfferingSpeedsValidator(),
+				"ibm_dl_routers":                   datasourceIBMDLRoutersValidator(),
+				"ibm_is_vpc":                       dataSourceIBMISVpcValidator(),
+				"ibm_is_volume":                    dataSourceIBMISVolumeValidator(),
+				"ibm_is_snapshot":                  dataSourceIBMISSnapshotValidator(),
+				"ibm_is_dedicated_host":            dataSourceIBMISDedicatedHostValidator(),
+				"ibm_is_dedicated_host_group":      dataSourceIBMISDedicatedHostGroupValidator(),
+				"ibm_is_placement_group":           dataSourceIBMISPlacementGroupValidator(),
+				"ibm_is_bare_metal_server_profile": dataSourceIBMISBareMetalServerProfileValidator(),
 			},
 		}
 	})
 	return globalValidatorDict
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func endpointsSchema() *schema.Resource {
+	endpoints := map[string]*schema.Schema{}
+	for service, description := range serviceEndpointDescriptions {
+		endpoints[service] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: description,
+		}
+	}
+	return &schema.Resource{Schema: endpoints}
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
 	var iamToken, iamRefreshToken string
@@ -655,10 +799,33 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 	generation := d.Get("generation").(int)
+	endpointsFile := d.Get("endpoints_file_path").(string)
+	visibility := d.Get("visibility").(string)
+	endpoints := map[string]string{}
+	if v, ok := d.GetOk("endpoints"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		for service, endpoint := range v.([]interface{})[0].(map[string]interface{}) {
+			if endpoint.(string) != "" {
+				endpoints[service] = endpoint.(string)
+			}
+		}
+	}
+
+	tagsConfig := TagsConfig{}
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagsConfig.DefaultTags = expandStringList(v.([]interface{})[0].(map[string]interface{})["tags"].(*schema.Set).List())
+	}
+	// IC_ENV_TAGS is set by Schematics, its tags are added to the default tags
+	if v := os.Getenv("IC_ENV_TAGS"); v != "" {
+		tagsConfig.DefaultTags = append(tagsConfig.DefaultTags, strings.Split(v, ",")...)
+	}
+	tagsConfig.DeleteDetachedTags = d.Get("delete_detached_tags").(bool)
+	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagsConfig.IgnoreTagPrefixes = expandStringList(v.([]interface{})[0].(map[string]interface{})["key_prefixes"].(*schema.Set).List())
+	}
 
 	wskEnvVal, err := schema.EnvDefaultFunc("FUNCTION_NAMESPACE", "")()
 	if err != nil {
-		return nil, err
+		return nil, diagFromErr(err)
 	}
 	//Set environment variable to be used in DiffSupressFunction
 	if wskEnvVal.(string) == "" {
@@ -682,8 +849,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		IAMToken:             iamToken,
 		IAMRefreshToken:      iamRefreshToken,
 		Zone:                 zone,
+		Endpoints:            endpoints,
+		EndpointsFile:        endpointsFile,
+		Visibility:           visibility,
+		Tags:                 tagsConfig,
 		//PowerServiceInstance: powerServiceInstance,
 	}
 
-	return config.ClientSession()
+	session, err := config.ClientSession()
+	if err != nil {
+		return nil, diagFromErr(err)
+	}
+	return session, nil
 }
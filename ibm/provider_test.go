@@ -51,6 +51,9 @@ var certCRN string
 var updatedCertCRN string
 var regionName string
 var ISZoneName string
+var dedicatedHostProfileName string
+var dedicatedHostClass string
+var bareMetalServerProfileName string
 var ISCIDR string
 var ISAddressPrefixCIDR string
 var instanceProfileName string
@@ -59,9 +62,11 @@ var ISRouteDestination string
 var ISRouteNextHop string
 var workspaceID string
 var templateID string
+var schematicsTemplateRepoURL string
 var imageName string
 var functionNamespace string
 var hpcsInstanceID string
+var trustedProfileClusterCRN string
 
 // For Power Colo
 
@@ -310,6 +315,24 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable SL_ZONE for testing ibm_is_zone datasource else it is set to default value 'us-south-1'")
 	}
 
+	dedicatedHostProfileName = os.Getenv("IS_DEDICATED_HOST_PROFILE")
+	if dedicatedHostProfileName == "" {
+		dedicatedHostProfileName = "bx2d-host-152x608"
+		fmt.Println("[INFO] Set the environment variable IS_DEDICATED_HOST_PROFILE for testing ibm_is_dedicated_host resource else it is set to default value 'bx2d-host-152x608'")
+	}
+
+	dedicatedHostClass = os.Getenv("IS_DEDICATED_HOST_CLASS")
+	if dedicatedHostClass == "" {
+		dedicatedHostClass = "bx2d"
+		fmt.Println("[INFO] Set the environment variable IS_DEDICATED_HOST_CLASS for testing ibm_is_dedicated_host_group resource else it is set to default value 'bx2d'")
+	}
+
+	bareMetalServerProfileName = os.Getenv("IS_BARE_METAL_SERVER_PROFILE")
+	if bareMetalServerProfileName == "" {
+		bareMetalServerProfileName = "bx2-metal-192x768"
+		fmt.Println("[INFO] Set the environment variable IS_BARE_METAL_SERVER_PROFILE for testing ibm_is_bare_metal_server resource else it is set to default value 'bx2-metal-192x768'")
+	}
+
 	ISCIDR = os.Getenv("SL_CIDR")
 	if ISCIDR == "" {
 		ISCIDR = "10.240.0.0/24"
@@ -407,6 +430,11 @@ func init() {
 		templateID = "653f60a4-f64f-41"
 		fmt.Println("[INFO] Set the environment variable TEMPLATE_ID for testing data_source_ibm_schematics_state_test else it is set to default value")
 	}
+	schematicsTemplateRepoURL = os.Getenv("SCHEMATICS_TEMPLATE_REPO_URL")
+	if schematicsTemplateRepoURL == "" {
+		schematicsTemplateRepoURL = "https://github.com/IBM-Cloud/terraform-provider-ibm/tree/master/examples/ibm-resource-instance"
+		fmt.Println("[INFO] Set the environment variable SCHEMATICS_TEMPLATE_REPO_URL for testing ibm_schematics_workspace resource else it is set to default value")
+	}
 
 	// Added for resource image testing
 	image_cos_url = os.Getenv("IMAGE_COS_URL")
@@ -454,6 +482,10 @@ func init() {
 		hpcsInstanceID = "5af62d5d-5d90-4b84-bbcd-90d2123ae6c8"
 		fmt.Println("[INFO] Set the environment variable HPCS_INSTANCE_ID for testing data_source_ibm_kms_key_test else it is set to default value")
 	}
+	trustedProfileClusterCRN = os.Getenv("IBM_TRUSTED_PROFILE_CLUSTER_CRN")
+	if trustedProfileClusterCRN == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_TRUSTED_PROFILE_CLUSTER_CRN for testing ibm_iam_trusted_profile_link resource else tests will fail if this is not set correctly")
+	}
 	tg_cross_network_account_id = os.Getenv("IBM_TG_CROSS_ACCOUNT_ID")
 	if tg_cross_network_account_id == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_TG_CROSS_ACCOUNT_ID for testing ibm_tg_connection resource else  tests will fail if this is not set correctly")
@@ -4,22 +4,25 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	apigatewaysdk "github.com/IBM/apigateway-go-sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMApiGatewayEndpointSubscription() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMApiGatewayEndpointSubscriptionCreate,
-		Read:     resourceIBMApiGatewayEndpointSubscriptionGet,
-		Update:   resourceIBMApiGatewayEndpointSubscriptionUpdate,
-		Delete:   resourceIBMApiGatewayEndpointSubscriptionDelete,
-		Importer: &schema.ResourceImporter{},
-		Exists:   resourceIBMApiGatewayEndpointSubscriptionExists,
+		CreateContext: resourceIBMApiGatewayEndpointSubscriptionCreate,
+		ReadContext:   resourceIBMApiGatewayEndpointSubscriptionGet,
+		UpdateContext: resourceIBMApiGatewayEndpointSubscriptionUpdate,
+		DeleteContext: resourceIBMApiGatewayEndpointSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMApiGatewayEndpointSubscription, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			"artifact_id": {
 				Type:        schema.TypeString,
@@ -65,14 +68,14 @@ func resourceIBMApiGatewayEndpointSubscription() *schema.Resource {
 		},
 	}
 }
-func resourceIBMApiGatewayEndpointSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	payload := &apigatewaysdk.CreateSubscriptionOptions{}
 
@@ -114,21 +117,21 @@ func resourceIBMApiGatewayEndpointSubscriptionCreate(d *schema.ResourceData, met
 
 	result, response, err := endpointservice.CreateSubscription(payload)
 	if err != nil {
-		return fmt.Errorf("Error creating Subscription: %s %s", err, response)
+		return diagFromErr(fmt.Errorf("Error creating Subscription: %s %s", err, response))
 	}
 	d.SetId(fmt.Sprintf("%s//%s", *result.ArtifactID, *result.ClientID))
 
-	return resourceIBMApiGatewayEndpointSubscriptionGet(d, meta)
+	return resourceIBMApiGatewayEndpointSubscriptionGet(ctx, d, meta)
 }
 
-func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionGet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts := d.Id()
@@ -150,7 +153,7 @@ func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subscription: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error Getting Subscription: %s\n%s", err, response))
 	}
 	d.Set("artifact_id", result.ArtifactID)
 	d.Set("client_id", result.ClientID)
@@ -165,14 +168,14 @@ func resourceIBMApiGatewayEndpointSubscriptionGet(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceIBMApiGatewayEndpointSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	payload := &apigatewaysdk.UpdateSubscriptionOptions{}
 
@@ -211,26 +214,26 @@ func resourceIBMApiGatewayEndpointSubscriptionUpdate(d *schema.ResourceData, met
 		}
 		_, SecretResponse, err := endpointservice.AddSubscriptionSecret(secretpayload)
 		if err != nil {
-			return fmt.Errorf("Error Adding Secret to Subscription: %s,%s", err, SecretResponse)
+			return diagFromErr(fmt.Errorf("Error Adding Secret to Subscription: %s,%s", err, SecretResponse))
 		}
 	}
 	if update {
 		_, response, err := endpointservice.UpdateSubscription(payload)
 		if err != nil {
-			return fmt.Errorf("Error updating Subscription: %s,%s", err, response)
+			return diagFromErr(fmt.Errorf("Error updating Subscription: %s,%s", err, response))
 		}
 	}
-	return resourceIBMApiGatewayEndpointSubscriptionGet(d, meta)
+	return resourceIBMApiGatewayEndpointSubscriptionGet(ctx, d, meta)
 }
 
-func resourceIBMApiGatewayEndpointSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMApiGatewayEndpointSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	endpointservice, err := meta.(ClientSession).APIGateway()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts := d.Id()
 	partslist := strings.Split(parts, "//")
@@ -250,41 +253,9 @@ func resourceIBMApiGatewayEndpointSubscriptionDelete(d *schema.ResourceData, met
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Subscription: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error deleting Subscription: %s\n%s", err, response))
 	}
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMApiGatewayEndpointSubscriptionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := meta.(ClientSession).BluemixSession()
-	if err != nil {
-		return false, err
-	}
-	endpointservice, err := meta.(ClientSession).APIGateway()
-	if err != nil {
-		return false, err
-	}
-	parts := d.Id()
-	partslist := strings.Split(parts, "//")
-	artifactID := partslist[0]
-	clientID := partslist[1]
-
-	oauthtoken := sess.Config.IAMAccessToken
-	oauthtoken = strings.Replace(oauthtoken, "Bearer ", "", -1)
-
-	payload := apigatewaysdk.GetSubscriptionOptions{
-		ArtifactID:    &artifactID,
-		ID:            &clientID,
-		Authorization: &oauthtoken,
-	}
-	_, response, err := endpointservice.GetSubscription(&payload)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
-}
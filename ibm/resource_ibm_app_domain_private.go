@@ -4,22 +4,25 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMAppDomainPrivate() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMAppDomainPrivateCreate,
-		Read:     resourceIBMAppDomainPrivateRead,
-		Update:   resourceIBMAppDomainPrivateUpdate,
-		Delete:   resourceIBMAppDomainPrivateDelete,
-		Exists:   resourceIBMAppDomainPrivateExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMAppDomainPrivateCreate,
+		ReadContext:   resourceIBMAppDomainPrivateRead,
+		UpdateContext: resourceIBMAppDomainPrivateUpdate,
+		DeleteContext: resourceIBMAppDomainPrivateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMAppDomainPrivate, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -46,10 +49,10 @@ func resourceIBMAppDomainPrivate() *schema.Resource {
 	}
 }
 
-func resourceIBMAppDomainPrivateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainPrivateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get("name").(string)
 	orgGUID := d.Get("org_guid").(string)
@@ -61,29 +64,33 @@ func resourceIBMAppDomainPrivateCreate(d *schema.ResourceData, meta interface{})
 
 	prdomain, err := cfClient.PrivateDomains().Create(params)
 	if err != nil {
-		return fmt.Errorf("Error creating private domain: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating private domain: %s", err))
 	}
 
 	d.SetId(prdomain.Metadata.GUID)
 
-	return resourceIBMAppDomainPrivateRead(d, meta)
+	return resourceIBMAppDomainPrivateRead(ctx, d, meta)
 }
 
-func resourceIBMAppDomainPrivateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainPrivateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMAppDomainPrivateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainPrivateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	prdomainGUID := d.Id()
 
 	prdomain, err := cfClient.PrivateDomains().Get(prdomainGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving private domain: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving private domain: %s", err))
 	}
 	d.Set("name", prdomain.Entity.Name)
 	d.Set("org_guid", prdomain.Entity.OwningOrganizationGUID)
@@ -91,40 +98,20 @@ func resourceIBMAppDomainPrivateRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMAppDomainPrivateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainPrivateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	prdomainGUID := d.Id()
 
 	err = cfClient.PrivateDomains().Delete(prdomainGUID, false)
 	if err != nil {
-		return fmt.Errorf("Error deleting private domain: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting private domain: %s", err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMAppDomainPrivateExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cfClient, err := meta.(ClientSession).MccpAPI()
-	if err != nil {
-		return false, err
-	}
-	prdomainGUID := d.Id()
-
-	prdomain, err := cfClient.PrivateDomains().Get(prdomainGUID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return prdomain.Metadata.GUID == prdomainGUID, nil
-}
@@ -4,22 +4,25 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMAppDomainShared() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMAppDomainSharedCreate,
-		Read:     resourceIBMAppDomainSharedRead,
-		Update:   resourceIBMAppDomainSharedUpdate,
-		Delete:   resourceIBMAppDomainSharedDelete,
-		Exists:   resourceIBMAppDomainSharedExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMAppDomainSharedCreate,
+		ReadContext:   resourceIBMAppDomainSharedRead,
+		UpdateContext: resourceIBMAppDomainSharedUpdate,
+		DeleteContext: resourceIBMAppDomainSharedDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMAppDomainShared, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -47,10 +50,10 @@ func resourceIBMAppDomainShared() *schema.Resource {
 	}
 }
 
-func resourceIBMAppDomainSharedCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainSharedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get("name").(string)
 	routerGroupGUID := d.Get("router_group_guid").(string)
@@ -62,29 +65,33 @@ func resourceIBMAppDomainSharedCreate(d *schema.ResourceData, meta interface{})
 
 	shdomain, err := cfClient.SharedDomains().Create(params)
 	if err != nil {
-		return fmt.Errorf("Error creating shared domain: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating shared domain: %s", err))
 	}
 
 	d.SetId(shdomain.Metadata.GUID)
 
-	return resourceIBMAppDomainSharedRead(d, meta)
+	return resourceIBMAppDomainSharedRead(ctx, d, meta)
 }
 
-func resourceIBMAppDomainSharedUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainSharedUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMAppDomainSharedRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainSharedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	shdomainGUID := d.Id()
 
 	shdomain, err := cfClient.SharedDomains().Get(shdomainGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving shared domain: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving shared domain: %s", err))
 	}
 	d.Set("name", shdomain.Entity.Name)
 	d.Set("router_group_guid", shdomain.Entity.RouterGroupGUID)
@@ -92,40 +99,20 @@ func resourceIBMAppDomainSharedRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMAppDomainSharedDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppDomainSharedDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	shdomainGUID := d.Id()
 
 	err = cfClient.SharedDomains().Delete(shdomainGUID, false)
 	if err != nil {
-		return fmt.Errorf("Error deleting shared domain: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting shared domain: %s", err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMAppDomainSharedExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cfClient, err := meta.(ClientSession).MccpAPI()
-	if err != nil {
-		return false, err
-	}
-	shdomainGUID := d.Id()
-
-	shdomain, err := cfClient.SharedDomains().Get(shdomainGUID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return shdomain.Metadata.GUID == shdomainGUID, nil
-}
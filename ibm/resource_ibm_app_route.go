@@ -4,23 +4,26 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMAppRoute() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMAppRouteCreate,
-		Read:     resourceIBMAppRouteRead,
-		Update:   resourceIBMAppRouteUpdate,
-		Delete:   resourceIBMAppRouteDelete,
-		Exists:   resourceIBMAppRouteExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMAppRouteCreate,
+		ReadContext:   resourceIBMAppRouteRead,
+		UpdateContext: resourceIBMAppRouteUpdate,
+		DeleteContext: resourceIBMAppRouteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMAppRoute, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"host": {
@@ -67,10 +70,10 @@ func resourceIBMAppRoute() *schema.Resource {
 	}
 }
 
-func resourceIBMAppRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	spaceGUID := d.Get("space_guid").(string)
@@ -95,24 +98,28 @@ func resourceIBMAppRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	route, err := cfClient.Routes().Create(params)
 	if err != nil {
-		return fmt.Errorf("Error creating route: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating route: %s", err))
 	}
 
 	d.SetId(route.Metadata.GUID)
 
-	return resourceIBMAppRouteRead(d, meta)
+	return resourceIBMAppRouteRead(ctx, d, meta)
 }
 
-func resourceIBMAppRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	routeGUID := d.Id()
 
 	route, err := cfClient.Routes().Get(routeGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving route: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving route: %s", err))
 	}
 
 	d.Set("host", route.Entity.Host)
@@ -126,10 +133,10 @@ func resourceIBMAppRouteRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMAppRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	routeGUID := d.Id()
@@ -149,43 +156,24 @@ func resourceIBMAppRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	_, err = cfClient.Routes().Update(routeGUID, params)
 	if err != nil {
-		return fmt.Errorf("Error updating route: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating route: %s", err))
 	}
-	return resourceIBMAppRouteRead(d, meta)
+	return resourceIBMAppRouteRead(ctx, d, meta)
 }
 
-func resourceIBMAppRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMAppRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	routeGUID := d.Id()
 
 	err = cfClient.Routes().Delete(routeGUID, false)
 	if err != nil {
-		return fmt.Errorf("Error deleting route: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting route: %s", err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-func resourceIBMAppRouteExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cfClient, err := meta.(ClientSession).MccpAPI()
-	if err != nil {
-		return false, err
-	}
-	routeGUID := d.Id()
-
-	route, err := cfClient.Routes().Get(routeGUID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return route.Metadata.GUID == routeGUID, nil
-}
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -19,11 +21,13 @@ const str string = ".cdn.appdomain.cloud"
 
 func resourceIBMCDN() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMCDNCreate,
-		Read:   resourceIBMCDNRead,
-		Update: resourceIBMCDNUpdate,
-		Delete: resourceIBMCDNDelete,
-		Exists: resourceIBMCDNExists,
+		CreateContext: resourceIBMCDNCreate,
+		ReadContext:   resourceIBMCDNRead,
+		UpdateContext: resourceIBMCDNUpdate,
+		DeleteContext: resourceIBMCDNDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCDN, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"host_name": &schema.Schema{
@@ -149,7 +153,7 @@ func resourceIBMCDN() *schema.Resource {
 	}
 }
 
-func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	///create  session
 	sess := meta.(ClientSession).SoftLayerSession()
 	///get the value of all the parameters
@@ -196,14 +200,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diagFromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt1[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result1, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result1)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 
 	}
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTPS" {
@@ -224,14 +228,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diagFromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt2[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result2, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result2)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTP_AND_HTTPS" {
 		receipt3, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -252,14 +256,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diagFromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt3[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result3, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result3)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 	if origintype == "HOST_SERVER" && protocol == "HTTP" {
 		receipt4, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -277,14 +281,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diagFromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt4[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result4, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result4)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 	if origintype == "HOST_SERVER" && protocol == "HTTPS" {
 		receipt5, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -303,14 +307,14 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diagFromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt5[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result5, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result5)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 	if origintype == "HOST_SERVER" && protocol == "HTTP_AND_HTTPS" {
 		receipt6, err := service.CreateDomainMapping(&datatypes.Container_Network_CdnMarketplace_Configuration_Input{
@@ -330,55 +334,75 @@ func resourceIBMCDNCreate(d *schema.ResourceData, meta interface{}) error {
 			PerformanceConfiguration: sl.String(performanceconfiguration),
 		})
 		if err != nil {
-			return fmt.Errorf("Error creating CDN: %s", err)
+			return diagFromErr(fmt.Errorf("Error creating CDN: %s", err))
 		}
 
 		d.SetId(*receipt6[0].UniqueId)
 		id, err := strconv.Atoi((d.Id()))
 		result6, err := service.VerifyDomainMapping(&id)
 		log.Print("The status of domain mapping ", result6)
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceIBMCDNRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkCdnMarketplaceConfigurationMappingService(sess)
 	cdnId := sl.String(d.Id())
 	///read the changes in the remote resource and update in the local resource.
 	read, err := service.ListDomainMappingByUniqueId(cdnId)
-	///Print the response of the requested the service.
-	d.Set("originaddress", *read[0].OriginHost)
-	d.Set("vendorname", *read[0].VendorName)
-	d.Set("domain", *read[0].Domain)
-	d.Set("header", *read[0].Header)
-	d.Set("cname", *read[0].Cname)
-	d.Set("origin_type", *read[0].OriginType)
-	d.Set("status", *read[0].Status)
-	if *read[0].OriginType == "OBJECT_STORAGE" {
-		d.Set("bucketname", *read[0].BucketName)
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving CDN mapping info: %s", err))
 	}
-	if *read[0].Protocol == "HTTP" || *read[0].Protocol == "HTTP_AND_HTTPS" {
-		d.Set("httpport", *read[0].HttpPort)
+	if len(read) == 0 {
+		d.SetId("")
+		return nil
 	}
-	if *read[0].Protocol == "HTTPS" || *read[0].Protocol == "HTTP_AND_HTTPS" {
-		d.Set("httpsport", *read[0].HttpsPort)
+	mapping := read[0]
+	d.Set("host_name", sl.Get(mapping.Domain, nil))
+	d.Set("vendor_name", sl.Get(mapping.VendorName, nil))
+	d.Set("origin_address", sl.Get(mapping.OriginHost, nil))
+	d.Set("origin_type", sl.Get(mapping.OriginType, nil))
+	d.Set("header", sl.Get(mapping.Header, nil))
+	d.Set("cname", sl.Get(mapping.Cname, nil))
+	d.Set("status", sl.Get(mapping.Status, nil))
+	if mapping.OriginType != nil && *mapping.OriginType == "OBJECT_STORAGE" {
+		d.Set("bucket_name", sl.Get(mapping.BucketName, nil))
+		if mapping.FileExtension != nil {
+			d.Set("file_extension", *mapping.FileExtension)
+		}
 	}
-	d.Set("protocol", *read[0].Protocol)
-	d.Set("respectheaders", *read[0].RespectHeaders)
-	d.Set("certificationtype", *read[0].CertificateType)
-	d.Set("cachekeyqueryrule", *read[0].CacheKeyQueryRule)
-	d.Set("path", *read[0].Path)
-	d.Set("performanceconfiguration", *read[0].PerformanceConfiguration)
-	if err != nil {
-		log.Println(err)
+	protocol := sl.Get(mapping.Protocol, "").(string)
+	if (protocol == "HTTP" || protocol == "HTTP_AND_HTTPS") && mapping.HttpPort != nil {
+		d.Set("http_port", *mapping.HttpPort)
+	}
+	if (protocol == "HTTPS" || protocol == "HTTP_AND_HTTPS") && mapping.HttpsPort != nil {
+		d.Set("https_port", *mapping.HttpsPort)
+	}
+	if (protocol == "HTTPS" || protocol == "HTTP_AND_HTTPS") && mapping.CertificateType != nil {
+		d.Set("certificate_type", *mapping.CertificateType)
+	}
+	d.Set("protocol", protocol)
+	if mapping.RespectHeaders != nil {
+		d.Set("respect_headers", *mapping.RespectHeaders)
+	}
+	if mapping.CacheKeyQueryRule != nil {
+		d.Set("cache_key_query_rule", *mapping.CacheKeyQueryRule)
+	}
+	d.Set("path", sl.Get(mapping.Path, nil))
+	if mapping.PerformanceConfiguration != nil {
+		d.Set("performance_configuration", *mapping.PerformanceConfiguration)
 	}
 	return nil
 }
 
-func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	/// Nothing to update for now. Not supported.
 	sess := meta.(ClientSession).SoftLayerSession()
 	domain := d.Get("host_name").(string)
@@ -434,7 +458,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 
 	if origintype == "HOST_SERVER" && protocol == "HTTPS" {
@@ -459,7 +483,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 
 	}
 
@@ -484,7 +508,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 
 	}
 
@@ -513,7 +537,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTPS" {
@@ -540,7 +564,7 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 
 	if origintype == "OBJECT_STORAGE" && protocol == "HTTP" {
@@ -566,13 +590,13 @@ func resourceIBMCDNUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Println(err)
 		}
-		return resourceIBMCDNRead(d, meta)
+		return resourceIBMCDNRead(ctx, d, meta)
 	}
 
 	return nil
 }
 
-func resourceIBMCDNDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCDNDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkCdnMarketplaceConfigurationMappingService(sess)
 
@@ -581,29 +605,10 @@ func resourceIBMCDNDelete(d *schema.ResourceData, meta interface{}) error {
 	delete, err := service.DeleteDomainMapping(cdnId)
 	if err != nil {
 		log.Println(err)
-		return err
+		return diagFromErr(err)
 	}
 	///print the delete response
 	log.Print("Delete response is : ", delete)
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMCDNExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetNetworkCdnMarketplaceConfigurationMappingService(sess)
-	cdnId := sl.String(d.Id())
-	///check if the resource exists with the given id.
-	exists, err := service.ListDomainMappingByUniqueId(cdnId)
-	///Print the response for exist request.
-	log.Print("Exists response is : ", exists)
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 || len(exists) == 0 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error retrieving CDN mapping info: %s", err)
-	}
-	return true, nil
-}
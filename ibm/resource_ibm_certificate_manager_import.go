@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -15,12 +17,13 @@ import (
 
 func resourceIBMCertificateManagerImport() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCertificateManagerImportCertificate,
-		Read:     resourceIBMCertificateManagerGet,
-		Update:   resourceIBMCertificateManagerUpdate,
-		Importer: &schema.ResourceImporter{},
-		Delete:   resourceIBMCertificateManagerDelete,
-		Exists:   resourceIBMCertificateManagerExists,
+		CreateContext: resourceIBMCertificateManagerImportCertificate,
+		ReadContext:   resourceIBMCertificateManagerGet,
+		UpdateContext: resourceIBMCertificateManagerUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCertificateManagerImport, nil),
+		},
+		DeleteContext: resourceIBMCertificateManagerDelete,
 		Schema: map[string]*schema.Schema{
 			"certificate_manager_instance_id": {
 				Type:        schema.TypeString,
@@ -81,11 +84,11 @@ func resourceIBMCertificateManagerImport() *schema.Resource {
 	}
 }
 
-func resourceIBMCertificateManagerImportCertificate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerImportCertificate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Get("certificate_manager_instance_id").(string)
@@ -111,18 +114,25 @@ func resourceIBMCertificateManagerImportCertificate(d *schema.ResourceData, meta
 
 	result, importCertError := client.ImportCertificate(instanceID, payload)
 	if importCertError != nil {
-		return importCertError
+		return diagFromErr(importCertError)
 	}
 	d.SetId(result.ID)
-	return resourceIBMCertificateManagerUpdate(d, meta)
+	return resourceIBMCertificateManagerUpdate(ctx, d, meta)
 }
-func resourceIBMCertificateManagerGet(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerGet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	certID := d.Id()
 	certificatedata, err := cmService.Certificate().GetCertData(certID)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
 
 	cminstanceid := strings.Split(certID, ":certificate:")
 	d.Set("certificate_manager_instance_id", cminstanceid[0]+"::")
@@ -152,10 +162,10 @@ func resourceIBMCertificateManagerGet(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMCertificateManagerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	certID := d.Id()
 	client := cmService.Certificate()
@@ -166,7 +176,7 @@ func resourceIBMCertificateManagerUpdate(d *schema.ResourceData, meta interface{
 
 		importCertError := client.UpdateCertificateMetaData(certID, payload)
 		if importCertError != nil {
-			return importCertError
+			return diagFromErr(importCertError)
 		}
 	}
 	if d.HasChange("data") {
@@ -186,43 +196,22 @@ func resourceIBMCertificateManagerUpdate(d *schema.ResourceData, meta interface{
 		payload := models.CertificateReimportData{Content: importData.Content, Privatekey: importData.Privatekey, IntermediateCertificate: importData.IntermediateCertificate}
 		_, reImportCertError := client.ReimportCertificate(certID, payload)
 		if reImportCertError != nil {
-			return reImportCertError
+			return diagFromErr(reImportCertError)
 		}
 	}
-	return resourceIBMCertificateManagerGet(d, meta)
+	return resourceIBMCertificateManagerGet(ctx, d, meta)
 }
-func resourceIBMCertificateManagerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCertificateManagerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	certID := d.Id()
 	err = cmService.Certificate().DeleteCertificate(certID)
 	if err != nil {
-		return fmt.Errorf("Error deleting Certificate: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Certificate: %s", err))
 	}
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMCertificateManagerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cmService, err := meta.(ClientSession).CertificateManagerAPI()
-	if err != nil {
-		return false, err
-	}
-	client := cmService.Certificate()
-	certID := d.Id()
-
-	_, err = client.GetCertData(certID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return true, nil
-}
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,12 +32,13 @@ const (
 
 func resourceIBMCISInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCISInstanceCreate,
-		Read:     resourceIBMCISInstanceRead,
-		Update:   resourceIBMCISInstanceUpdate,
-		Delete:   resourceIBMCISInstanceDelete,
-		Exists:   resourceIBMCISInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCISInstanceCreate,
+		ReadContext:   resourceIBMCISInstanceRead,
+		UpdateContext: resourceIBMCISInstanceUpdate,
+		DeleteContext: resourceIBMCISInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISInstance, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -135,10 +138,10 @@ func resourceIBMCISInstance() *schema.Resource {
 }
 
 // Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "internet-svcs"
-func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	serviceName := "internet-svcs"
 	plan := d.Get("plan").(string)
@@ -151,27 +154,27 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	rsInst.ServicePlanID = servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err)
+		return diagFromErr(fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("No deployment found for service plan : %s", plan)
+		return diagFromErr(fmt.Errorf("No deployment found for service plan : %s", plan))
 	}
 	deployments, supportedLocations := filterCISDeployments(deployments, location)
 
@@ -180,7 +183,7 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.", plan, location, locationList)
+		return diagFromErr(fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.", plan, location, locationList))
 	}
 
 	rsInst.TargetCrn = deployments[0].CatalogCRN
@@ -190,7 +193,7 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		rsInst.ResourceGroupID = defaultRg
 	}
@@ -205,27 +208,27 @@ func resourceIBMCISInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	instance, err := rsConClient.ResourceServiceInstance().CreateInstance(rsInst)
 	if err != nil {
-		return fmt.Errorf("Error creating resource instance: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating resource instance: %s", err))
 	}
 
 	// Moved d.SetId(instance.ID) to after waiting for resource to finish creation. Otherwise Terraform initates depedent tasks too early.
 	// Original flow had SetId here as its required as input to waitForCISInstanceCreate
 
-	_, err = waitForCISInstanceCreate(d, meta, instance.ID)
+	_, err = waitForCISInstanceCreate(ctx, d, meta, instance.ID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
 	d.SetId(instance.ID)
 
-	return resourceIBMCISInstanceRead(d, meta)
+	return resourceIBMCISInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -237,7 +240,7 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving resource instance: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving resource instance: %s", err))
 	}
 	if strings.Contains(instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -255,7 +258,7 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
@@ -263,7 +266,7 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(instance.ServicePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 
@@ -274,17 +277,17 @@ func resourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, rcontroller+"/internet-svcs/"+url.QueryEscape(instance.Crn.String()))
 
 	return nil
 }
 
-func resourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -299,18 +302,18 @@ func resourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		service := d.Get("service").(string)
 		rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		rsCatRepo := rsCatClient.ResourceCatalog()
 
 		serviceOff, err := rsCatRepo.FindByName(service, true)
 		if err != nil {
-			return fmt.Errorf("Error retrieving service offering: %s", err)
+			return diagFromErr(newAttributeError("plan", "Error retrieving service offering", err))
 		}
 
 		servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 		if err != nil {
-			return fmt.Errorf("Error retrieving plan: %s", err)
+			return diagFromErr(newAttributeError("plan", "Error retrieving plan", err))
 		}
 
 		updateReq.ServicePlanID = servicePlan
@@ -324,22 +327,22 @@ func resourceIBMCISInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	_, err = rsConClient.ResourceServiceInstance().UpdateInstance(instanceID, updateReq)
 	if err != nil {
-		return fmt.Errorf("Error updating resource instance: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating resource instance: %s", err))
 	}
 
-	_, err = waitForCISInstanceUpdate(d, meta)
+	_, err = waitForCISInstanceUpdate(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMCISInstanceRead(d, meta)
+	return resourceIBMCISInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 
@@ -352,45 +355,21 @@ func resourceIBMCISInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[WARN] Resource instance already deleted %s\n ", err)
 			err = nil
 		} else {
-			return fmt.Errorf("Error deleting resource instance: %s", err)
+			return diagFromErr(fmt.Errorf("Error deleting resource instance: %s", err))
 		}
 	}
 
-	_, err = waitForCISInstanceDelete(d, meta)
+	_, err = waitForCISInstanceDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-func resourceIBMCISInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
-	if err != nil {
-		return false, err
-	}
-	instanceID := d.Id()
-	instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	if strings.Contains(instance.State, "removed") {
-		log.Printf("[WARN] Removing instance from state because it's in removed state")
-		d.SetId("")
-		return false, nil
-	}
-
-	return instance.ID == instanceID, nil
-}
-
-func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+func waitForCISInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
 		return false, err
@@ -418,10 +397,10 @@ func waitForCISInstanceCreate(d *schema.ResourceData, meta interface{}, instance
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
 		return false, err
@@ -449,10 +428,10 @@ func waitForCISInstanceUpdate(d *schema.ResourceData, meta interface{}) (interfa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
 		return false, err
@@ -479,7 +458,7 @@ func waitForCISInstanceDelete(d *schema.ResourceData, meta interface{}) (interfa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func filterCISDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,7 +26,7 @@ const (
 )
 
 func resourceIBMCISCacheSettings() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -116,12 +118,14 @@ func resourceIBMCISCacheSettings() *schema.Resource {
 				},
 			},
 		},
-		Create:   resourceCISCacheSettingsUpdate,
-		Read:     resourceCISCacheSettingsRead,
-		Update:   resourceCISCacheSettingsUpdate,
-		Delete:   resourceCISCacheSettingsDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		CreateContext: resourceCISCacheSettingsUpdate,
+		ReadContext:   resourceCISCacheSettingsRead,
+		UpdateContext: resourceCISCacheSettingsUpdate,
+		DeleteContext: resourceCISCacheSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISCacheSettings, nil),
+		},
+	}, upgradeStateID(upgradeCISID(1)))
 }
 
 func resourceIBMCISCacheSettingsValidator() *ResourceValidator {
@@ -156,10 +160,10 @@ func resourceIBMCISCacheSettingsValidator() *ResourceValidator {
 	return &ibmCISCacheSettingsResourceValidator
 }
 
-func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCacheSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisCacheClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -182,7 +186,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateCacheLevel(opt)
 			if err != nil {
 				log.Printf("Update caching level failed : %v\n", resp)
-				return err
+				return diagFromErr(err)
 			}
 		}
 
@@ -193,7 +197,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateBrowserCacheTTL(opt)
 			if err != nil {
 				log.Printf("Update browser expiration setting failed : %v\n", resp)
-				return err
+				return diagFromErr(err)
 			}
 		}
 
@@ -204,7 +208,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateDevelopmentMode(opt)
 			if err != nil {
 				log.Printf("Update development mode setting failed : %v\n", resp)
-				return err
+				return diagFromErr(err)
 			}
 		}
 		// Query string sort setting
@@ -214,7 +218,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, resp, err := cisClient.UpdateQueryStringSort(opt)
 			if err != nil {
 				log.Printf("Update query string sort setting failed : %v\n", resp)
-				return err
+				return diagFromErr(err)
 			}
 		}
 
@@ -224,7 +228,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 				result, response, err := cisClient.PurgeAll(opt)
 				if err != nil {
 					log.Printf("Purge all failed : %v", response)
-					return err
+					return diagFromErr(err)
 				}
 				log.Printf("Purge all successful : %s", *result.Result.ID)
 			}
@@ -236,7 +240,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			_, response, err := cisClient.PurgeByUrls(opt)
 			if err != nil {
 				log.Printf("Purge by urls failed : %v", response)
-				return err
+				return diagFromErr(err)
 			}
 		}
 		if value, ok := d.GetOk(cisCachePurgeByCacheTags); ok {
@@ -246,7 +250,7 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			result, response, err := cisClient.PurgeByCacheTags(opt)
 			if err != nil {
 				log.Printf("Purge by cache tags failed : %v", response)
-				return err
+				return diagFromErr(err)
 			}
 			log.Printf("Purge by tags successful : %s", *result.Result.ID)
 
@@ -258,19 +262,19 @@ func resourceCISCacheSettingsUpdate(d *schema.ResourceData, meta interface{}) er
 			result, response, err := cisClient.PurgeByHosts(opt)
 			if err != nil {
 				log.Printf("Purge by hosts failed : %v", response)
-				return err
+				return diagFromErr(err)
 			}
 			log.Printf("Purge by hosts successful : %s", *result.Result.ID)
 		}
 	}
 	d.SetId(convertCisToTfTwoVar(zoneID, crn))
-	return resourceCISCacheSettingsRead(d, meta)
+	return resourceCISCacheSettingsRead(ctx, d, meta)
 }
 
-func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCacheSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisCacheClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	zoneID, crn, _ := convertTftoCisTwoVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -280,7 +284,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 	cacheLevel, resp, err := cisClient.GetCacheLevel(cisClient.NewGetCacheLevelOptions())
 	if err != nil {
 		log.Printf("Get caching leve setting failed : %v\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	// Browser Expiration setting
@@ -288,7 +292,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 		cisClient.NewGetBrowserCacheTtlOptions())
 	if err != nil {
 		log.Printf("Get browser expiration setting failed : %v\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	// development mode setting
@@ -296,7 +300,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 		cisClient.NewGetDevelopmentModeOptions())
 	if err != nil {
 		log.Printf("Get development mode setting failed : %v", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	// Query string sort setting
@@ -304,7 +308,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 		cisClient.NewGetQueryStringSortOptions())
 	if err != nil {
 		log.Printf("Get query string sort setting failed : %v", resp)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -315,7 +319,7 @@ func resourceCISCacheSettingsRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceCISCacheSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCacheSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
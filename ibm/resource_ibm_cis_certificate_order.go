@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,13 +26,14 @@ const (
 )
 
 func resourceIBMCISCertificateOrder() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISCertificateOrderCreate,
-		Update:   resourceIBMCISCertificateOrderRead,
-		Read:     resourceIBMCISCertificateOrderRead,
-		Delete:   resourceIBMCISCertificateOrderDelete,
-		Exists:   resourceIBMCISCertificateOrderExist,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISCertificateOrderCreate,
+		UpdateContext: resourceIBMCISCertificateOrderRead,
+		ReadContext:   resourceIBMCISCertificateOrderRead,
+		DeleteContext: resourceIBMCISCertificateOrderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISCertificateOrder, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -66,7 +69,7 @@ func resourceIBMCISCertificateOrder() *schema.Resource {
 				Computed:    true,
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(2)))
 }
 
 func resourceIBMCISCertificateOrderValidator() *ResourceValidator {
@@ -85,10 +88,10 @@ func resourceIBMCISCertificateOrderValidator() *ResourceValidator {
 	return &cisCertificateOrderValidator
 }
 
-func resourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISCertificateOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -105,30 +108,34 @@ func resourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface
 	result, resp, err := cisClient.OrderCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate order failed: %v", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceIBMCISCertificateOrderRead(d, meta)
+	return resourceIBMCISCertificateOrderRead(ctx, d, meta)
 }
 
-func resourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISCertificateOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	certificateID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading certificate id")
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetCustomCertificateOptions(certificateID)
 	result, resp, err := cisClient.GetCustomCertificate(opt)
 	if err != nil {
+		if resp != nil && resp.StatusCode == 400 {
+			d.SetId("")
+			return nil
+		}
 		log.Printf("Certificate read failed: %v", resp)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -139,15 +146,15 @@ func resourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIBMCISCertificateOrderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISCertificateOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	certificateID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading certificate id")
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -155,43 +162,18 @@ func resourceIBMCISCertificateOrderDelete(d *schema.ResourceData, meta interface
 	resp, err := cisClient.DeleteCertificate(opt)
 	if err != nil {
 		log.Printf("Certificate delete failed: %v", resp)
-		return err
+		return diagFromErr(err)
 	}
 
-	_, err = waitForCISCertificateOrderDelete(d, meta)
+	_, err = waitForCISCertificateOrderDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func resourceIBMCISCertificateOrderExist(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cisClient, err := meta.(ClientSession).CisSSLClientSession()
-	if err != nil {
-		return false, err
-	}
-	certificateID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
-	if err != nil {
-		log.Println("Error in reading certificate id")
-		return false, err
-	}
-	cisClient.Crn = core.StringPtr(crn)
-	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-	opt := cisClient.NewGetCustomCertificateOptions(certificateID)
-	_, response, err := cisClient.GetCustomCertificate(opt)
-	if err != nil {
-		if response != nil && response.StatusCode == 400 {
-			log.Printf("Certificate is not found")
-			return false, nil
-		}
-		log.Printf("Get Certificate failed: %v", response)
-		return false, err
-	}
-	return true, nil
-}
-
-func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISCertificateOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
 		return nil, err
@@ -223,5 +205,5 @@ func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{})
 		PollInterval: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
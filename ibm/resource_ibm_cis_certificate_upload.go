@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	cissslv1 "github.com/IBM/networking-go-sdk/sslcertificateapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -35,13 +37,14 @@ const (
 )
 
 func resourceIBMCISCertificateUpload() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceCISCertificateUploadCreate,
-		Read:     resourceCISCertificateUploadRead,
-		Update:   resourceCISCertificateUploadUpdate,
-		Delete:   resourceCISCertificateUploadDelete,
-		Exists:   resourceCISCertificateUploadExists,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceCISCertificateUploadCreate,
+		ReadContext:   resourceCISCertificateUploadRead,
+		UpdateContext: resourceCISCertificateUploadUpdate,
+		DeleteContext: resourceCISCertificateUploadDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISCertificateUpload, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -124,7 +127,7 @@ func resourceIBMCISCertificateUpload() *schema.Resource {
 				Computed:    true,
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(2)))
 }
 
 func resourceCISCertificateUploadValidator() *ResourceValidator {
@@ -142,10 +145,10 @@ func resourceCISCertificateUploadValidator() *ResourceValidator {
 	return &cisCertificateUploadValidator
 }
 
-func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -164,7 +167,7 @@ func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}
 	result, response, err := cisClient.UploadCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Upload custom certificate failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	certID := *result.Result.ID
 	d.SetId(convertCisToTfThreeVar(certID, zoneID, crn))
@@ -182,21 +185,21 @@ func resourceCISCertificateUploadCreate(d *schema.ResourceData, meta interface{}
 		priorityResponse, err := cisClient.ChangeCertificatePriority(priorityOpt)
 		if err != nil {
 			log.Printf("Change certificate priority failed: %v", priorityResponse)
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceCISCertificateUploadRead(d, meta)
+	return resourceCISCertificateUploadRead(ctx, d, meta)
 }
-func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	certID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -204,8 +207,12 @@ func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{})
 	opt := cisClient.NewGetCustomCertificateOptions(certID)
 	result, response, err := cisClient.GetCustomCertificate(opt)
 	if err != nil {
+		if response != nil && strings.Contains(err.Error(), "Invalid certificate") {
+			d.SetId("")
+			return nil
+		}
 		log.Printf("Get custom certificate failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -221,15 +228,15 @@ func resourceCISCertificateUploadRead(d *schema.ResourceData, meta interface{})
 	d.Set(cisCertificateUploadExpiresOn, result.Result.ExpiresOn)
 	return nil
 }
-func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	certID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -245,7 +252,7 @@ func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.UpdateCustomCertificate(opt)
 		if err != nil {
 			log.Printf("Update custom certificate failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -263,22 +270,22 @@ func resourceCISCertificateUploadUpdate(d *schema.ResourceData, meta interface{}
 			_, err := cisClient.ChangeCertificatePriority(priorityOpt)
 			if err != nil {
 				log.Printf("Change certificate priority failed: %v", err)
-				return err
+				return diagFromErr(err)
 			}
 		}
 	}
-	return resourceCISCertificateUploadRead(d, meta)
+	return resourceCISCertificateUploadRead(ctx, d, meta)
 }
 
-func resourceCISCertificateUploadDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCertificateUploadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	certID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -286,42 +293,17 @@ func resourceCISCertificateUploadDelete(d *schema.ResourceData, meta interface{}
 	_, err = cisClient.DeleteCustomCertificate(opt)
 	if err != nil {
 		log.Printf("Delete custom certificate failed: %v", err)
-		return err
+		return diagFromErr(err)
 	}
-	_, err = waitForCISCertificateUploadDelete(d, meta)
+	_, err = waitForCISCertificateUploadDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceCISCertificateUploadExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cisClient, err := meta.(ClientSession).CisSSLClientSession()
-	if err != nil {
-		return false, err
-	}
-
-	certID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
-	if err != nil {
-		return false, err
-	}
-	cisClient.Crn = core.StringPtr(crn)
-	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-
-	opt := cisClient.NewGetCustomCertificateOptions(certID)
-	_, detail, err := cisClient.GetCustomCertificate(opt)
-	if err != nil {
-		if detail != nil && strings.Contains(err.Error(), "Invalid certificate") {
-			return false, nil
-		}
-		log.Printf("Get custom certificate failed: %v", err)
-		return false, err
-	}
-	return true, nil
-}
-
-func waitForCISCertificateUploadDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForCISCertificateUploadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
 		return nil, err
@@ -354,5 +336,5 @@ func waitForCISCertificateUploadDelete(d *schema.ResourceData, meta interface{})
 		PollInterval: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,7 +27,7 @@ const (
 )
 
 func resourceIBMCISCustomPage() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -85,12 +87,14 @@ func resourceIBMCISCustomPage() *schema.Resource {
 				Computed:    true,
 			},
 		},
-		Create:   resourceCISCustomPageUpdate,
-		Read:     resourceCISCustomPageRead,
-		Update:   resourceCISCustomPageUpdate,
-		Delete:   resourceCISCustomPageDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		CreateContext: resourceCISCustomPageUpdate,
+		ReadContext:   resourceCISCustomPageRead,
+		UpdateContext: resourceCISCustomPageUpdate,
+		DeleteContext: resourceCISCustomPageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISCustomPage, nil),
+		},
+	}, upgradeStateID(upgradeCISID(2)))
 }
 
 func resourceIBMCISCustomPageValidator() *ResourceValidator {
@@ -111,10 +115,10 @@ func resourceIBMCISCustomPageValidator() *ResourceValidator {
 	return &ibmCISCustomPageResourceValidator
 }
 
-func resourceCISCustomPageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCustomPageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisCustomPageClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -136,17 +140,17 @@ func resourceCISCustomPageUpdate(d *schema.ResourceData, meta interface{}) error
 		result, response, err := cisClient.UpdateZoneCustomPage(opt)
 		if err != nil {
 			log.Printf("Update custom page failed : %v", response)
-			return err
+			return diagFromErr(err)
 		}
 		d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	}
-	return resourceCISCustomPageRead(d, meta)
+	return resourceCISCustomPageRead(ctx, d, meta)
 }
 
-func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCustomPageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisCustomPageClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	pageID, zoneID, crn, _ := convertTfToCisThreeVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -162,7 +166,7 @@ func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		log.Printf("Get custom page failed : %v", response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set(cisID, crn)
@@ -178,7 +182,7 @@ func resourceCISCustomPageRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISCustomPageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISCustomPageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
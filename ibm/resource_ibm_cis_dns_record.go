@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,13 +48,14 @@ const (
 )
 
 func resourceIBMCISDnsRecord() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISDnsRecordCreate,
-		Read:     resourceIBMCISDnsRecordRead,
-		Update:   resourceIBMCISDnsRecordUpdate,
-		Delete:   resourceIBMCISDnsRecordDelete,
-		Exists:   resourceIBMCISDnsRecordExist,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISDnsRecordCreate,
+		ReadContext:   resourceIBMCISDnsRecordRead,
+		UpdateContext: resourceIBMCISDnsRecordUpdate,
+		DeleteContext: resourceIBMCISDnsRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISDnsRecord, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -133,15 +136,15 @@ func resourceIBMCISDnsRecord() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(2)))
 }
 
-func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISDnsRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
 	if err != nil {
 		log.Printf("Error: %s", err)
-		return err
+		return diagFromErr(err)
 	}
 	var (
 		crn            string
@@ -206,7 +209,7 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		data, ok = d.GetOk(cisDNSRecordData)
 		if ok == false {
 			log.Printf("Error in getting data")
-			return err
+			return diagFromErr(err)
 		}
 		recordData = make(map[string]interface{}, 0)
 		var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -214,14 +217,14 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// altitude
 		v, ok = strconv.ParseFloat(dataMap["altitude"].(string), 64)
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diagFromErr(fmt.Errorf("data input error"))
 		}
 		recordData["altitude"] = v
 
 		// lat_degrees
 		v, ok = strconv.Atoi(dataMap["lat_degrees"].(string))
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diagFromErr(fmt.Errorf("data input error"))
 		}
 		recordData["lat_degrees"] = v
 
@@ -234,14 +237,14 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// lat_minutes
 		v, ok = strconv.Atoi(dataMap["lat_minutes"].(string))
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diagFromErr(fmt.Errorf("data input error"))
 		}
 		recordData["lat_minutes"] = v
 
 		// lat_seconds
 		v, ok = strconv.ParseFloat(dataMap["lat_seconds"].(string), 64)
 		if ok != nil {
-			return fmt.Errorf("data input error")
+			return diagFromErr(fmt.Errorf("data input error"))
 
 		}
 		recordData["lat_seconds"] = v
@@ -249,42 +252,42 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// long_degrees
 		v, ok := strconv.Atoi(dataMap["long_degrees"].(string))
 		if ok != nil {
-			return ok
+			return diagFromErr(ok)
 		}
 		recordData["long_degrees"] = v
 
 		// long_minutes
 		v, ok = strconv.Atoi(dataMap["long_minutes"].(string))
 		if ok != nil {
-			return ok
+			return diagFromErr(ok)
 		}
 		recordData["long_minutes"] = v
 
 		// long_seconds
 		i, ok := strconv.ParseFloat(dataMap["long_seconds"].(string), 64)
 		if ok != nil {
-			return ok
+			return diagFromErr(ok)
 		}
 		recordData["long_seconds"] = i
 
 		// percision_horz
 		i, ok = strconv.ParseFloat(dataMap["precision_horz"].(string), 64)
 		if ok != nil {
-			return ok
+			return diagFromErr(ok)
 		}
 		recordData["precision_horz"] = v
 
 		// precision_vert
 		i, ok = strconv.ParseFloat(dataMap["precision_vert"].(string), 64)
 		if ok != nil {
-			return ok
+			return diagFromErr(ok)
 		}
 		recordData["precision_vert"] = i
 
 		// size
 		i, ok = strconv.ParseFloat(dataMap["size"].(string), 64)
 		if ok != nil {
-			return ok
+			return diagFromErr(ok)
 		}
 		recordData["size"] = i
 
@@ -299,7 +302,7 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		data, ok = d.GetOk(cisDNSRecordData)
 		if ok == false {
 			log.Printf("Error in getting data")
-			return err
+			return diagFromErr(err)
 		}
 		recordData = make(map[string]interface{}, 0)
 		var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -319,7 +322,7 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		data, ok = d.GetOk(cisDNSRecordData)
 		if ok == false {
 			log.Printf("Error in getting data")
-			return err
+			return diagFromErr(err)
 		}
 		recordData = make(map[string]interface{}, 0)
 		var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -344,21 +347,21 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		// port
 		s, ok := strconv.Atoi(dataMap["port"].(string))
 		if ok != nil {
-			return ok
+			return diagFromErr(ok)
 		}
 		recordData["port"] = s
 
 		// priority
 		s, ok = strconv.Atoi(dataMap["priority"].(string))
 		if ok != nil {
-			return ok
+			return diagFromErr(ok)
 		}
 		recordData["priority"] = s
 
 		// weight
 		s, ok = strconv.Atoi(dataMap["weight"].(string))
 		if ok != nil {
-			return ok
+			return diagFromErr(ok)
 		}
 		recordData["weight"] = s
 		opt.SetData(recordData)
@@ -381,7 +384,7 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 			for id, content := range data.(map[string]interface{}) {
 				newData, err := transformToIBMCISDnsData(recordType, id, content)
 				if err != nil {
-					return err
+					return diagFromErr(err)
 				} else if newData == nil {
 					continue
 				}
@@ -392,9 +395,9 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		if contentOk == dataOk {
-			return fmt.Errorf(
+			return diagFromErr(fmt.Errorf(
 				"either 'content' (present: %t) or 'data' (present: %t) must be provided",
-				contentOk, dataOk)
+				contentOk, dataOk))
 		}
 
 		if priority, ok := d.GetOk("priority"); ok {
@@ -409,15 +412,15 @@ func resourceIBMCISDnsRecordCreate(d *schema.ResourceData, meta interface{}) err
 	result, response, err := sess.CreateDnsRecord(opt)
 	if err != nil {
 		log.Printf("Error creating dns record: %s, error %s", response, err)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceIBMCISDnsRecordUpdate(d, meta)
+	return resourceIBMCISDnsRecordUpdate(ctx, d, meta)
 
 }
 
-func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISDnsRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		crn      string
 		zoneID   string
@@ -425,12 +428,12 @@ func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 	)
 	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	recordID, zoneID, crn, _ = convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
@@ -443,7 +446,7 @@ func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 		log.Printf("Error reading dns record: %s", response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set(cisID, crn)
@@ -469,11 +472,11 @@ func resourceIBMCISDnsRecordRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
 	if err != nil {
 		log.Printf("Error: %s", err)
-		return err
+		return diagFromErr(err)
 	}
 	var (
 		recordID       string
@@ -493,7 +496,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 	recordID, zoneID, crn, err = convertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading record id")
-		return err
+		return diagFromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
@@ -560,7 +563,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			data, ok = d.GetOk(cisDNSRecordData)
 			if ok == false {
 				log.Printf("Error in getting data")
-				return err
+				return diagFromErr(err)
 			}
 			recordData = make(map[string]interface{}, 0)
 			var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -568,14 +571,14 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			// altitude
 			v, ok := strconv.ParseFloat(dataMap["altitude"].(string), 64)
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["altitude"] = v
 
 			// lat_degrees
 			i, ok := strconv.Atoi(dataMap["lat_degrees"].(string))
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["lat_degrees"] = i
 
@@ -588,56 +591,56 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			// lat_minutes
 			i, ok = strconv.Atoi(dataMap["lat_minutes"].(string))
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["lat_minutes"] = i
 
 			// lat_seconds
 			v, ok = strconv.ParseFloat(dataMap["lat_seconds"].(string), 64)
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["lat_seconds"] = v
 
 			// long_degrees
 			i, ok = strconv.Atoi(dataMap["long_degrees"].(string))
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["long_degrees"] = i
 
 			// long_minutes
 			i, ok = strconv.Atoi(dataMap["long_minutes"].(string))
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["long_minutes"] = i
 
 			// long_seconds
 			v, ok = strconv.ParseFloat(dataMap["long_seconds"].(string), 64)
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["long_seconds"] = v
 
 			// percision_horz
 			v, ok = strconv.ParseFloat(dataMap["precision_horz"].(string), 64)
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["precision_horz"] = v
 
 			// precision_vert
 			v, ok = strconv.ParseFloat(dataMap["precision_vert"].(string), 64)
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["precision_vert"] = v
 
 			// size
 			v, ok = strconv.ParseFloat(dataMap["size"].(string), 64)
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["size"] = v
 
@@ -652,7 +655,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			data, ok = d.GetOk(cisDNSRecordData)
 			if ok == false {
 				log.Printf("Error in getting data")
-				return err
+				return diagFromErr(err)
 			}
 			recordData = make(map[string]interface{}, 0)
 			var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -672,7 +675,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			data, ok = d.GetOk(cisDNSRecordData)
 			if ok == false {
 				log.Printf("Error in getting data")
-				return err
+				return diagFromErr(err)
 			}
 			recordData = make(map[string]interface{}, 0)
 			var dataMap map[string]interface{} = data.(map[string]interface{})
@@ -697,21 +700,21 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 			// port
 			s, ok := strconv.Atoi(dataMap["port"].(string))
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["port"] = s
 
 			// priority
 			s, ok = strconv.Atoi(dataMap["priority"].(string))
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["priority"] = s
 
 			// weight
 			s, ok = strconv.Atoi(dataMap["weight"].(string))
 			if ok != nil {
-				return ok
+				return diagFromErr(ok)
 			}
 			recordData["weight"] = s
 			opt.SetData(recordData)
@@ -739,8 +742,8 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 					opt.SetTTL(ttl.(int64))
 				}
 				if ttl != 1 && proxied == true {
-					return fmt.Errorf("To enable proxy TTL should be Automatic %s",
-						"i.e it should be set to 1. For the the values other than Automatic, proxy should be disabled.")
+					return diagFromErr(fmt.Errorf("To enable proxy TTL should be Automatic %s",
+						"i.e it should be set to 1. For the the values other than Automatic, proxy should be disabled."))
 				}
 				priority, priorityOk := d.GetOk(cisDNSRecordPriority)
 				if priorityOk {
@@ -753,7 +756,7 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 					for id, content := range data.(map[string]interface{}) {
 						newData, err := transformToIBMCISDnsData(recordType, id, content)
 						if err != nil {
-							return err
+							return diagFromErr(err)
 						} else if newData == nil {
 							continue
 						}
@@ -763,9 +766,9 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 					opt.SetData(newDataMap)
 				}
 				if contentOk == dataOk {
-					return fmt.Errorf(
+					return diagFromErr(fmt.Errorf(
 						"either 'content' (present: %t) or 'data' (present: %t) must be provided",
-						contentOk, dataOk)
+						contentOk, dataOk))
 				}
 			}
 		}
@@ -773,14 +776,14 @@ func resourceIBMCISDnsRecordUpdate(d *schema.ResourceData, meta interface{}) err
 		result, response, err := sess.UpdateDnsRecord(opt)
 		if err != nil {
 			log.Printf("Error updating dns record: %s, error %s", response, err)
-			return err
+			return diagFromErr(err)
 		}
 		log.Printf("record id: %s", *result.Result.ID)
 	}
-	return resourceIBMCISDnsRecordRead(d, meta)
+	return resourceIBMCISDnsRecordRead(ctx, d, meta)
 }
 
-func resourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISDnsRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var (
 		crn      string
 		zoneID   string
@@ -789,13 +792,13 @@ func resourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) err
 	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
 	if err != nil {
 		log.Printf("Error: %s", err)
-		return err
+		return diagFromErr(err)
 	}
 	// session options
 	recordID, zoneID, crn, _ = convertTfToCisThreeVar(d.Id())
 	if err != nil {
 		log.Println("Error in reading input")
-		return err
+		return diagFromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 	sess.ZoneIdentifier = core.StringPtr(zoneID)
@@ -805,42 +808,12 @@ func resourceIBMCISDnsRecordDelete(d *schema.ResourceData, meta interface{}) err
 
 	if err != nil && !strings.Contains(err.Error(), "Request failed with status code: 404") {
 		log.Printf("Error deleting dns record %s: %s", *result.Result.ID, response)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMCISDnsRecordExist(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := meta.(ClientSession).CisDNSRecordClientSession()
-	if err != nil {
-		log.Printf("session creation failed: %s", err)
-		return false, err
-	}
-
-	// session options
-	recordID, zoneID, crn, _ := convertTfToCisThreeVar(d.Id())
-	if err != nil {
-		log.Println("Error in reading input")
-		return false, err
-	}
-
-	sess.Crn = core.StringPtr(crn)
-	sess.ZoneIdentifier = core.StringPtr(zoneID)
-
-	opt := sess.NewGetDnsRecordOptions(recordID)
-	_, response, err := sess.GetDnsRecord(opt)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			log.Printf("DNS record is not found")
-			return false, nil
-		}
-		log.Printf("get DNS record failed")
-		return false, err
-	}
-	return true, nil
-}
-
 var dnsTypeIntFields = []string{
 	"algorithm",
 	"key_tag",
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -52,17 +54,19 @@ func resourceIBMCISDNSRecordsImport() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISDNSRecordsImportUpdate,
-		Read:     resourceCISDNSRecordsImportRead,
-		Update:   resourceCISDNSRecordsImportRead,
-		Delete:   resourceCISDNSRecordsImportDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceCISDNSRecordsImportUpdate,
+		ReadContext:   resourceCISDNSRecordsImportRead,
+		UpdateContext: resourceCISDNSRecordsImportRead,
+		DeleteContext: resourceCISDNSRecordsImportDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISDNSRecordsImport, nil),
+		},
 	}
 }
-func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISDNSRecordsImportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisDNSRecordBulkClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -73,14 +77,14 @@ func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{})
 
 	f, err := os.Open(file)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	opt := cisClient.NewPostDnsRecordsBulkOptions()
 	opt.SetFile(f)
 	result, response, err := cisClient.PostDnsRecordsBulk(opt)
 	if err != nil {
 		log.Printf("Error importing dns records: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	id := fmt.Sprintf("%v:%v:%s:%s:%s", *result.Result.TotalRecordsParsed,
 		*result.Result.RecsAdded, file, zoneID, crn)
@@ -90,7 +94,7 @@ func resourceCISDNSRecordsImportUpdate(d *schema.ResourceData, meta interface{})
 
 }
 
-func resourceCISDNSRecordsImportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISDNSRecordsImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSplitStr := strings.SplitN(d.Id(), ":", 5)
 	parsed, _ := strconv.Atoi(idSplitStr[0])
 	added, _ := strconv.Atoi(idSplitStr[1])
@@ -105,7 +109,7 @@ func resourceCISDNSRecordsImportRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceCISDNSRecordsImportDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISDNSRecordsImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS DNS Record import resource
 	d.SetId("")
 	return nil
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +21,7 @@ const (
 )
 
 func resourceIBMCISDomain() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -54,19 +56,20 @@ func resourceIBMCISDomain() *schema.Resource {
 				Computed: true,
 			},
 		},
-		Create:   resourceCISdomainCreate,
-		Read:     resourceCISdomainRead,
-		Exists:   resourceCISdomainExists,
-		Update:   resourceCISdomainUpdate,
-		Delete:   resourceCISdomainDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		CreateContext: resourceCISdomainCreate,
+		ReadContext:   resourceCISdomainRead,
+		UpdateContext: resourceCISdomainUpdate,
+		DeleteContext: resourceCISdomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISDomain, nil),
+		},
+	}, upgradeStateID(upgradeCISID(1)))
 }
 
-func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISdomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisZonesV1ClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -78,28 +81,32 @@ func resourceCISdomainCreate(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.CreateZone(opt)
 	if err != nil {
 		log.Printf("CreateZones Failed %s", resp)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(convertCisToTfTwoVar(*result.Result.ID, crn))
-	return resourceCISdomainRead(d, meta)
+	return resourceCISdomainRead(ctx, d, meta)
 }
 
-func resourceCISdomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISdomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisZonesV1ClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetZoneOptions(zoneID)
 	result, resp, err := cisClient.GetZone(opt)
 	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		log.Printf("[WARN] Error getting zone %v\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, result.Result.ID)
@@ -111,60 +118,34 @@ func resourceCISdomainRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
-func resourceCISdomainExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cisClient, err := meta.(ClientSession).CisZonesV1ClientSession()
-	if err != nil {
-		return false, err
-	}
-
-	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
-	log.Println("resource exist :", d.Id())
-	if err != nil {
-		return false, err
-	}
-	log.Println("resource exist :", d.Id())
-	cisClient.Crn = core.StringPtr(crn)
-	opt := cisClient.NewGetZoneOptions(zoneID)
-	_, resp, err := cisClient.GetZone(opt)
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Printf("[WARN] zone is not found")
-			return false, nil
-		}
-		log.Printf("[WARN] Error getting zone %v\n", resp)
-		return false, err
-	}
-	return true, nil
-}
-
-func resourceCISdomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceCISdomainRead(d, meta)
+func resourceCISdomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceCISdomainRead(ctx, d, meta)
 }
 
-func resourceCISdomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISdomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisZonesV1ClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
 	log.Println("resource delete :", d.Id())
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetZoneOptions(zoneID)
 	_, resp, err := cisClient.GetZone(opt)
 	if err != nil {
 		log.Printf("[WARN] Error getting zone %v\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 	delOpt := cisClient.NewDeleteZoneOptions(zoneID)
 	_, resp, err = cisClient.DeleteZone(delOpt)
 	if err != nil {
 		log.Printf("[ERR] Error deleting zone %v\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
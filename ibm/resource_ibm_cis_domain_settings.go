@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -67,7 +69,7 @@ const (
 )
 
 func resourceIBMCISSettings() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -443,12 +445,14 @@ func resourceIBMCISSettings() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISSettingsUpdate,
-		Read:     resourceCISSettingsRead,
-		Update:   resourceCISSettingsUpdate,
-		Delete:   resourceCISSettingsDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		CreateContext: resourceCISSettingsUpdate,
+		ReadContext:   resourceCISSettingsRead,
+		UpdateContext: resourceCISSettingsUpdate,
+		DeleteContext: resourceCISSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISSettings, nil),
+		},
+	}, upgradeStateID(upgradeCISID(1)))
 }
 
 func resourceIBMCISDomainSettingValidator() *ResourceValidator {
@@ -582,10 +586,10 @@ var settingsList = []string{
 	cisDomainSettingsCipher,
 }
 
-func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisDomainSettingsClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	cisID := d.Get(cisID).(string)
@@ -619,7 +623,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				if v, ok := d.GetOk(item); ok {
 					cisClient, err := meta.(ClientSession).CisSSLClientSession()
 					if err != nil {
-						return err
+						return diagFromErr(err)
 					}
 					cisClient.Crn = core.StringPtr(cisID)
 					cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -832,7 +836,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					minifyVal, err := cisClient.NewMinifySettingValue(css, html, js)
 					if err != nil {
 						log.Println("Invalid minfiy setting values")
-						return err
+						return diagFromErr(err)
 					}
 					opt := cisClient.NewUpdateMinifyOptions()
 					opt.SetValue(minifyVal)
@@ -851,12 +855,12 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 						enabled, maxAge, includeSubdomain, nosniff)
 					if err != nil {
 						log.Println("Invalid security header setting values")
-						return err
+						return diagFromErr(err)
 					}
 					securityOpt, err := cisClient.NewSecurityHeaderSettingValue(securityVal)
 					if err != nil {
 						log.Println("Invalid security header setting options")
-						return err
+						return diagFromErr(err)
 					}
 					opt := cisClient.NewUpdateSecurityHeaderOptions()
 					opt.SetValue(securityOpt)
@@ -873,7 +877,7 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 					mobileOpt, err := cisClient.NewMobileRedirecSettingValue(status, mobileSubdomain, stripURI)
 					if err != nil {
 						log.Println("Invalid mobile redirect options")
-						return err
+						return diagFromErr(err)
 					}
 					opt := cisClient.NewUpdateMobileRedirectOptions()
 					opt.SetValue(mobileOpt)
@@ -887,17 +891,17 @@ func resourceCISSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
 				continue
 			}
 			log.Printf("Update settings Failed on %s, %v\n", item, resp)
-			return err
+			return diagFromErr(err)
 		}
 	}
 	d.SetId(convertCisToTfTwoVar(zoneID, cisID))
-	return resourceCISSettingsRead(d, meta)
+	return resourceCISSettingsRead(ctx, d, meta)
 }
 
-func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisDomainSettingsClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	zoneID, crn, _ := convertTftoCisTwoVar(d.Id())
@@ -929,7 +933,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 		case cisDomainSettingsSSL:
 			cisClient, err := meta.(ClientSession).CisSSLClientSession()
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -944,7 +948,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 		case cisDomainSettingsBrotli:
 			cisClient, err := meta.(ClientSession).CisAPI()
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			settingsResult, err := cisClient.Settings().GetSetting(crn, zoneID, item)
 			if err == nil {
@@ -1242,7 +1246,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 				continue
 			}
 			log.Printf("Get settings failed on %s, %v\n", item, settingErr)
-			return settingErr
+			return diagFromErr(settingErr)
 		}
 	}
 	d.Set(cisID, crn)
@@ -1250,7 +1254,7 @@ func resourceCISSettingsRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
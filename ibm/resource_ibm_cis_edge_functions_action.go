@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,13 +22,14 @@ const (
 )
 
 func resourceIBMCISEdgeFunctionsAction() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISEdgeFunctionsActionCreate,
-		Read:     resourceIBMCISEdgeFunctionsActionRead,
-		Update:   resourceIBMCISEdgeFunctionsActionUpdate,
-		Delete:   resourceIBMCISEdgeFunctionsActionDelete,
-		Exists:   resourceIBMCISEdgeFunctionsActionExists,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISEdgeFunctionsActionCreate,
+		ReadContext:   resourceIBMCISEdgeFunctionsActionRead,
+		UpdateContext: resourceIBMCISEdgeFunctionsActionUpdate,
+		DeleteContext: resourceIBMCISEdgeFunctionsActionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISEdgeFunctionsAction, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -51,13 +54,13 @@ func resourceIBMCISEdgeFunctionsAction() *schema.Resource {
 				Description: "Edge function action script",
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(2)))
 }
 
-func resourceIBMCISEdgeFunctionsActionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -73,24 +76,24 @@ func resourceIBMCISEdgeFunctionsActionCreate(d *schema.ResourceData, meta interf
 
 	_, _, err = cisClient.UpdateEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("Error: %v", err)
+		return diagFromErr(fmt.Errorf("Error: %v", err))
 	}
 	d.SetId(convertCisToTfThreeVar(scriptName, zoneID, crn))
-	return resourceIBMCISEdgeFunctionsActionRead(d, meta)
+	return resourceIBMCISEdgeFunctionsActionRead(ctx, d, meta)
 }
 
-func resourceIBMCISEdgeFunctionsActionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange(cisEdgeFunctionsActionScript) {
-		return resourceIBMCISEdgeFunctionsActionCreate(d, meta)
+		return resourceIBMCISEdgeFunctionsActionCreate(ctx, d, meta)
 	}
 
-	return resourceIBMCISEdgeFunctionsActionRead(d, meta)
+	return resourceIBMCISEdgeFunctionsActionRead(ctx, d, meta)
 }
 
-func resourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	scriptName, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -100,7 +103,12 @@ func resourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interfac
 	opt := cisClient.NewGetEdgeFunctionsActionOptions(scriptName)
 	result, resp, err := cisClient.GetEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("Error: %v", resp)
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("Edge functions action script is not found")
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error: %v", resp))
 	}
 
 	// read script content
@@ -115,7 +123,7 @@ func resourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interfac
 	}
 	err = result.Close()
 	if err != nil {
-		return fmt.Errorf("Error in closing reader")
+		return diagFromErr(fmt.Errorf("Error in closing reader"))
 	}
 
 	d.Set(cisID, crn)
@@ -125,32 +133,10 @@ func resourceIBMCISEdgeFunctionsActionRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceIBMCISEdgeFunctionsActionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
-	if err != nil {
-		return false, fmt.Errorf("Error in creating CIS object")
-	}
-
-	scriptName, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
-	cisClient.Crn = core.StringPtr(crn)
-	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-
-	opt := cisClient.NewGetEdgeFunctionsActionOptions(scriptName)
-	_, response, err := cisClient.GetEdgeFunctionsAction(opt)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			log.Printf("Edge functions action script is not found")
-			return false, nil
-		}
-		return false, fmt.Errorf("Error: %v", response)
-	}
-	return true, nil
-}
-
-func resourceIBMCISEdgeFunctionsActionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return fmt.Errorf("Error in creating CIS object")
+		return diagFromErr(fmt.Errorf("Error in creating CIS object"))
 	}
 
 	scriptName, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -160,7 +146,7 @@ func resourceIBMCISEdgeFunctionsActionDelete(d *schema.ResourceData, meta interf
 	opt := cisClient.NewDeleteEdgeFunctionsActionOptions(scriptName)
 	_, response, err := cisClient.DeleteEdgeFunctionsAction(opt)
 	if err != nil {
-		return fmt.Errorf("Error in edge function action script deletion: %v", response)
+		return diagFromErr(fmt.Errorf("Error in edge function action script deletion: %v", response))
 	}
 	return nil
 }
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,13 +21,14 @@ const (
 )
 
 func resourceIBMCISEdgeFunctionsTrigger() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISEdgeFunctionsTriggerCreate,
-		Read:     resourceIBMCISEdgeFunctionsTriggerRead,
-		Update:   resourceIBMCISEdgeFunctionsTriggerUpdate,
-		Delete:   resourceIBMCISEdgeFunctionsTriggerDelete,
-		Exists:   resourceIBMCISEdgeFunctionsTriggerExists,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISEdgeFunctionsTriggerCreate,
+		ReadContext:   resourceIBMCISEdgeFunctionsTriggerRead,
+		UpdateContext: resourceIBMCISEdgeFunctionsTriggerUpdate,
+		DeleteContext: resourceIBMCISEdgeFunctionsTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISEdgeFunctionsTrigger, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -59,13 +62,13 @@ func resourceIBMCISEdgeFunctionsTrigger() *schema.Resource {
 				Description: "Edge function trigger request limit fail open",
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(2)))
 }
 
-func resourceIBMCISEdgeFunctionsTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -81,16 +84,16 @@ func resourceIBMCISEdgeFunctionsTriggerCreate(d *schema.ResourceData, meta inter
 
 	result, _, err := cisClient.CreateEdgeFunctionsTrigger(opt)
 	if err != nil {
-		return fmt.Errorf("Error creating edge function trigger route : %v", err)
+		return diagFromErr(fmt.Errorf("Error creating edge function trigger route : %v", err))
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceIBMCISEdgeFunctionsTriggerRead(d, meta)
+	return resourceIBMCISEdgeFunctionsTriggerRead(ctx, d, meta)
 }
 
-func resourceIBMCISEdgeFunctionsTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	routeID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -109,16 +112,16 @@ func resourceIBMCISEdgeFunctionsTriggerUpdate(d *schema.ResourceData, meta inter
 
 		_, _, err := cisClient.UpdateEdgeFunctionsTrigger(opt)
 		if err != nil {
-			return fmt.Errorf("Error updating edge function trigger route : %v", err)
+			return diagFromErr(fmt.Errorf("Error updating edge function trigger route : %v", err))
 		}
 	}
-	return resourceIBMCISEdgeFunctionsTriggerRead(d, meta)
+	return resourceIBMCISEdgeFunctionsTriggerRead(ctx, d, meta)
 }
 
-func resourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	routeID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -128,7 +131,12 @@ func resourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta interfa
 	opt := cisClient.NewGetEdgeFunctionsTriggerOptions(routeID)
 	result, resp, err := cisClient.GetEdgeFunctionsTrigger(opt)
 	if err != nil {
-		return fmt.Errorf("Error: %v", resp)
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("Edge functions trigger route is not found")
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error: %v", resp))
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -139,32 +147,10 @@ func resourceIBMCISEdgeFunctionsTriggerRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceIBMCISEdgeFunctionsTriggerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
-	if err != nil {
-		return false, err
-	}
-
-	routeID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
-	cisClient.Crn = core.StringPtr(crn)
-	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-
-	opt := cisClient.NewGetEdgeFunctionsTriggerOptions(routeID)
-	_, response, err := cisClient.GetEdgeFunctionsTrigger(opt)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			log.Printf("Edge functions trigger route is not found")
-			return false, nil
-		}
-		return false, fmt.Errorf("Error: %v", response)
-	}
-	return true, nil
-}
-
-func resourceIBMCISEdgeFunctionsTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISEdgeFunctionsTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisEdgeFunctionClientSession()
 	if err != nil {
-		return fmt.Errorf("Error in creating CIS object")
+		return diagFromErr(fmt.Errorf("Error in creating CIS object"))
 	}
 
 	routeID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
@@ -174,7 +160,7 @@ func resourceIBMCISEdgeFunctionsTriggerDelete(d *schema.ResourceData, meta inter
 	opt := cisClient.NewDeleteEdgeFunctionsTriggerOptions(routeID)
 	_, response, err := cisClient.DeleteEdgeFunctionsTrigger(opt)
 	if err != nil {
-		return fmt.Errorf("Error in edge function trigger route deletion: %v", response)
+		return diagFromErr(fmt.Errorf("Error in edge function trigger route deletion: %v", response))
 	}
 	return nil
 }
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	cislockdownv1 "github.com/IBM/networking-go-sdk/zonelockdownv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -56,13 +58,14 @@ const (
 )
 
 func resourceIBMCISFirewallRecord() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISFirewallRecordCreate,
-		Read:     resourceIBMCISFirewallRecordRead,
-		Update:   resourceIBMCISFirewallRecordUpdate,
-		Delete:   resourceIBMCISFirewallRecordDelete,
-		Exists:   resourceIBMCISFirewallRecordExists,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISFirewallRecordCreate,
+		ReadContext:   resourceIBMCISFirewallRecordRead,
+		UpdateContext: resourceIBMCISFirewallRecordUpdate,
+		DeleteContext: resourceIBMCISFirewallRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISFirewallRecord, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -260,7 +263,7 @@ func resourceIBMCISFirewallRecord() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(3)))
 }
 
 func resourceIBMCISFirewallValidator() *ResourceValidator {
@@ -308,11 +311,11 @@ func resourceIBMCISFirewallValidator() *ResourceValidator {
 			Type:                       TypeString,
 			Required:                   true,
 			AllowedValues:              "block, challenge, js_challenge"})
-	cisFirewallValidator := ResourceValidator{ResourceName: ibmCISHealthCheck, Schema: validateSchema}
+	cisFirewallValidator := ResourceValidator{ResourceName: ibmCISFirewall, Schema: validateSchema}
 	return &cisFirewallValidator
 }
 
-func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISFirewallRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	crn := d.Get(cisID).(string)
 	zoneID, _, _ := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
 	firewallType := d.Get(cisFirewallType).(string)
@@ -322,7 +325,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 
 		cisClient, err := meta.(ClientSession).CisLockdownClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		lockdown := d.Get(cisFirewallLockdown).([]interface{})[0].(map[string]interface{})
 
@@ -340,7 +343,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		configurations, err := expandLockdownsTypeConfiguration(
 			lockdown[cisFirewallLockdownConfigurations].([]interface{}))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		opt.SetUrls(urls)
 		opt.SetConfigurations(configurations)
@@ -350,7 +353,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		result, response, err := cisClient.CreateZoneLockdownRule(opt)
 		if err != nil {
 			log.Printf("Create zone firewall lockdown failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 		d.SetId(convertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 
@@ -358,7 +361,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 
 		cisClient, err := meta.(ClientSession).CisAccessRuleClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -375,7 +378,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		configOpt, err := cisClient.NewZoneAccessRuleInputConfiguration(target, value)
 		if err != nil {
 			log.Printf("Error in firewall type %s input: %s", firewallType, err)
-			return err
+			return diagFromErr(err)
 		}
 
 		opt := cisClient.NewCreateZoneAccessRuleOptions()
@@ -388,14 +391,14 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		result, response, err := cisClient.CreateZoneAccessRule(opt)
 		if err != nil {
 			log.Printf("Create zone firewall access rule failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 		d.SetId(convertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 
 	} else if firewallType == cisFirewallTypeUARules {
 		cisClient, err := meta.(ClientSession).CisUARuleClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -404,7 +407,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		mode := uaRule[cisFirewallUARuleMode].(string)
 		configList := uaRule[cisFirewallUARuleConfiguration].([]interface{})
 		if len(configList) > 1 {
-			return fmt.Errorf("Only one configuration is allowed for %s type", firewallType)
+			return diagFromErr(fmt.Errorf("Only one configuration is allowed for %s type", firewallType))
 		}
 		config := configList[0].(map[string]interface{})
 		target := config[cisFirewallLockdownConfigurationsTarget].(string)
@@ -413,7 +416,7 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		configOpt, err := cisClient.NewUseragentRuleInputConfiguration(target, value)
 		if err != nil {
 			log.Printf("Error in firewall type %s input: %s", firewallType, err)
-			return err
+			return diagFromErr(err)
 		}
 
 		opt := cisClient.NewCreateZoneUserAgentRuleOptions()
@@ -430,22 +433,22 @@ func resourceIBMCISFirewallRecordCreate(d *schema.ResourceData, meta interface{}
 		result, response, err := cisClient.CreateZoneUserAgentRule(opt)
 		if err != nil {
 			log.Printf("Create zone user agent rule failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 		d.SetId(convertCisToTfFourVar(firewallType, *result.Result.ID, zoneID, crn))
 	}
 
-	return resourceIBMCISFirewallRecordRead(d, meta)
+	return resourceIBMCISFirewallRecordRead(ctx, d, meta)
 }
 
-func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISFirewallRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	firewallType, lockdownID, zoneID, crn, _ := convertTfToCisFourVar(d.Id())
 
 	if firewallType == cisFirewallTypeLockdowns {
 		// Firewall Type : Lockdowns
 		cisClient, err := meta.(ClientSession).CisLockdownClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		cisClient.Crn = core.StringPtr(crn)
@@ -455,8 +458,13 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 
 		result, response, err := cisClient.GetLockdown(opt)
 		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				log.Printf("Zone Firewall Lockdown is not found")
+				d.SetId("")
+				return nil
+			}
 			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 		lockdownList := []interface{}{}
 		lockdown := map[string]interface{}{}
@@ -479,7 +487,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		// Firewall Type : Zone Access firewall rules
 		cisClient, err := meta.(ClientSession).CisAccessRuleClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -488,8 +496,13 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 
 		result, response, err := cisClient.GetZoneAccessRule(opt)
 		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				log.Printf("Zone Firewall Access Rule is not found")
+				d.SetId("")
+				return nil
+			}
 			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 
 		config := map[string]interface{}{}
@@ -511,7 +524,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		// Firewall Type: User Agent access rules
 		cisClient, err := meta.(ClientSession).CisUARuleClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -519,8 +532,13 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 		opt := cisClient.NewGetUserAgentRuleOptions(lockdownID)
 		result, response, err := cisClient.GetUserAgentRule(opt)
 		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				log.Printf("Zone Firewall User Agent Rule is not found")
+				d.SetId("")
+				return nil
+			}
 			log.Printf("Get zone user agent rule failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 
 		config := map[string]interface{}{}
@@ -547,7 +565,7 @@ func resourceIBMCISFirewallRecordRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISFirewallRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	firewallType, lockdownID, zoneID, crn, _ := convertTfToCisFourVar(d.Id())
 
@@ -561,7 +579,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 
 			cisClient, err := meta.(ClientSession).CisLockdownClientSession()
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 
 			opt := cisClient.NewUpdateLockdownRuleOptions(lockdownID)
@@ -577,7 +595,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			urls := expandStringList(lockdown[cisFirewallLockdownURLs].([]interface{}))
 			configurations, err := expandLockdownsTypeConfiguration(lockdown[cisFirewallLockdownConfigurations].([]interface{}))
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			opt.SetUrls(urls)
 			opt.SetConfigurations(configurations)
@@ -587,7 +605,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			_, response, err := cisClient.UpdateLockdownRule(opt)
 			if err != nil {
 				log.Printf("Update zone firewall lockdown failed: %v", response)
-				return err
+				return diagFromErr(err)
 			}
 
 		} else if firewallType == cisFirewallTypeAccessRules {
@@ -597,7 +615,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			// Firewall Type : Zone Access firewall rules
 			cisClient, err := meta.(ClientSession).CisAccessRuleClientSession()
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -612,7 +630,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			_, response, err := cisClient.UpdateZoneAccessRule(opt)
 			if err != nil {
 				log.Printf("Update zone firewall access rule failed: %v", response)
-				return err
+				return diagFromErr(err)
 			}
 
 		} else if firewallType == cisFirewallTypeUARules {
@@ -620,7 +638,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			uaRule := d.Get(cisFirewallUARule).([]interface{})[0].(map[string]interface{})
 			cisClient, err := meta.(ClientSession).CisUARuleClientSession()
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -633,7 +651,7 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			configOpt, err := cisClient.NewUseragentRuleInputConfiguration(target, value)
 			if err != nil {
 				log.Printf("Error in firewall type %s input: %s", firewallType, err)
-				return err
+				return diagFromErr(err)
 			}
 
 			opt := cisClient.NewUpdateUserAgentRuleOptions(lockdownID)
@@ -650,22 +668,22 @@ func resourceIBMCISFirewallRecordUpdate(d *schema.ResourceData, meta interface{}
 			_, response, err := cisClient.UpdateUserAgentRule(opt)
 			if err != nil {
 				log.Printf("Update zone user agent rule failed: %v", response)
-				return err
+				return diagFromErr(err)
 			}
 		}
 
 	}
-	return resourceIBMCISFirewallRecordRead(d, meta)
+	return resourceIBMCISFirewallRecordRead(ctx, d, meta)
 }
 
-func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISFirewallRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	firewallType, lockdownID, zoneID, crn, _ := convertTfToCisFourVar(d.Id())
 
 	if firewallType == cisFirewallTypeLockdowns {
 		// Firewall Type : Lockdowns
 		cisClient, err := meta.(ClientSession).CisLockdownClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		cisClient.Crn = core.StringPtr(crn)
@@ -676,7 +694,7 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.DeleteZoneLockdownRule(opt)
 		if err != nil {
 			log.Printf("Delete zone firewall lockdown failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 
 	} else if firewallType == cisFirewallTypeAccessRules {
@@ -684,7 +702,7 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		// Firewall Type : Zone Access firewall rules
 		cisClient, err := meta.(ClientSession).CisAccessRuleClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -694,14 +712,14 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.DeleteZoneAccessRule(opt)
 		if err != nil {
 			log.Printf("Delete zone firewall access rule failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 
 	} else if firewallType == cisFirewallTypeUARules {
 		// Firewall Type: User Agent access rules
 		cisClient, err := meta.(ClientSession).CisUARuleClientSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		cisClient.Crn = core.StringPtr(crn)
 		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -710,84 +728,12 @@ func resourceIBMCISFirewallRecordDelete(d *schema.ResourceData, meta interface{}
 		_, response, err := cisClient.DeleteZoneUserAgentRule(opt)
 		if err != nil {
 			log.Printf("Delete zone user agent rule failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	return nil
 }
-func resourceIBMCISFirewallRecordExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	firewallType, lockdownID, zoneID, crn, _ := convertTfToCisFourVar(d.Id())
-
-	if firewallType == cisFirewallTypeLockdowns {
-		// Firewall Type : Lockdowns
-		cisClient, err := meta.(ClientSession).CisLockdownClientSession()
-		if err != nil {
-			return false, err
-		}
-
-		cisClient.Crn = core.StringPtr(crn)
-		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-
-		opt := cisClient.NewGetLockdownOptions(lockdownID)
-
-		_, response, err := cisClient.GetLockdown(opt)
-		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				log.Printf("Zone Firewall Lockdown is not found")
-				return false, nil
-			}
-			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return false, err
-		}
-
-	} else if firewallType == cisFirewallTypeAccessRules {
-
-		// Firewall Type : Zone Access firewall rules
-		cisClient, err := meta.(ClientSession).CisAccessRuleClientSession()
-		if err != nil {
-			return false, err
-		}
-		cisClient.Crn = core.StringPtr(crn)
-		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-
-		opt := cisClient.NewGetZoneAccessRuleOptions(lockdownID)
-
-		_, response, err := cisClient.GetZoneAccessRule(opt)
-		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				log.Printf("Zone Firewall Access Rule is not found")
-				return false, nil
-			}
-			log.Printf("Get zone firewall lockdown failed: %v", response)
-			return false, err
-		}
-
-	} else if firewallType == cisFirewallTypeUARules {
-		// Firewall Type: User Agent access rules
-		cisClient, err := meta.(ClientSession).CisUARuleClientSession()
-		if err != nil {
-			return false, err
-		}
-		cisClient.Crn = core.StringPtr(crn)
-		cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-
-		opt := cisClient.NewGetUserAgentRuleOptions(lockdownID)
-		_, response, err := cisClient.GetUserAgentRule(opt)
-		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				log.Printf("Zone Firewall User Agent Rule does not found")
-				return false, nil
-			}
-			log.Printf("Get zone user agent rule failed: %v", response)
-			return false, err
-		}
-
-	}
-
-	return true, nil
-}
-
 func expandLockdownsTypeConfiguration(lockdownConfigs []interface{}) ([]cislockdownv1.LockdownInputConfigurationsItem, error) {
 	var configListOutput = make([]cislockdownv1.LockdownInputConfigurationsItem, 0)
 
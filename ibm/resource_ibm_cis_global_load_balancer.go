@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -32,7 +34,7 @@ const (
 )
 
 func resourceIBMCISGlb() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -156,18 +158,20 @@ func resourceIBMCISGlb() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISGlbCreate,
-		Read:     resourceCISGlbRead,
-		Update:   resourceCISGlbUpdate,
-		Delete:   resourceCISGlbDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		CreateContext: resourceCISGlbCreate,
+		ReadContext:   resourceCISGlbRead,
+		UpdateContext: resourceCISGlbUpdate,
+		DeleteContext: resourceCISGlbDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISGlb, nil),
+		},
+	}, upgradeStateID(upgradeCISID(2)))
 }
 
-func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -196,14 +200,14 @@ func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
 	if regionPools, ok := d.GetOk(cisGLBRegionPools); ok {
 		expandedRegionPools, err := expandGeoPools(regionPools, cisGLBRegionPoolsRegion)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		opt.SetRegionPools(expandedRegionPools)
 	}
 	if popPools, ok := d.GetOk(cisGLBPopPools); ok {
 		expandedPopPools, err := expandGeoPools(popPools, cisGLBPopPoolsPop)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		opt.SetPopPools(expandedPopPools)
 	}
@@ -211,22 +215,22 @@ func resourceCISGlbCreate(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.CreateLoadBalancer(opt)
 	if err != nil {
 		log.Printf("Create GLB failed %s\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceCISGlbUpdate(d, meta)
+	return resourceCISGlbUpdate(ctx, d, meta)
 }
 
-func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Extract CIS Ids from TF Id
 	glbID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	cisClient.Crn = core.StringPtr(crn)
@@ -237,7 +241,7 @@ func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.GetLoadBalancerSettings(opt)
 	if err != nil {
 		log.Printf("[WARN] GLB Read failed: %v\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 	glbObj := result.Result
 	d.Set(cisID, crn)
@@ -261,15 +265,15 @@ func resourceCISGlbRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISGlbUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	// Extract CIS Ids from TF Id
 	glbID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -303,14 +307,14 @@ func resourceCISGlbUpdate(d *schema.ResourceData, meta interface{}) error {
 		if regionPools, ok := d.GetOk(cisGLBRegionPools); ok {
 			expandedRegionPools, err := expandGeoPools(regionPools, cisGLBRegionPoolsRegion)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			opt.SetRegionPools(expandedRegionPools)
 		}
 		if popPools, ok := d.GetOk(cisGLBPopPools); ok {
 			expandedPopPools, err := expandGeoPools(popPools, cisGLBPopPoolsPop)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			opt.SetPopPools(expandedPopPools)
 		}
@@ -318,22 +322,22 @@ func resourceCISGlbUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, resp, err := cisClient.EditLoadBalancer(opt)
 		if err != nil {
 			log.Printf("[WARN] Error updating GLB %v\n", resp)
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceCISGlbRead(d, meta)
+	return resourceCISGlbRead(ctx, d, meta)
 }
 
-func resourceCISGlbDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISGlbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	// Extract CIS Ids from TF Id
 	glbID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -342,7 +346,7 @@ func resourceCISGlbDelete(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.DeleteLoadBalancer(opt)
 	if err != nil {
 		log.Printf("[WARN] Error deleting GLB %v\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 	log.Printf("Deletion successful : %s", *result.Result.ID)
 	return nil
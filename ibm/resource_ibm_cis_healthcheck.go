@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -33,14 +35,15 @@ const (
 )
 
 func resourceIBMCISHealthCheck() *schema.Resource {
-	return &schema.Resource{
-
-		Create:   resourceCISHealthCheckCreate,
-		Read:     resourceCISHealthCheckRead,
-		Update:   resourceCISHealthCheckUpdate,
-		Delete:   resourceCISHealthCheckDelete,
-		Exists:   resourceCISHealthCheckExists,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+
+		CreateContext: resourceCISHealthCheckCreate,
+		ReadContext:   resourceCISHealthCheckRead,
+		UpdateContext: resourceCISHealthCheckUpdate,
+		DeleteContext: resourceCISHealthCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISHealthCheck, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			cisID: {
@@ -160,7 +163,7 @@ func resourceIBMCISHealthCheck() *schema.Resource {
 				Set: hashByMapKey(cisGLBHealthCheckHeadersHeader),
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(1)))
 }
 
 func resourceIBMCISHealthCheckValidator() *ResourceValidator {
@@ -218,10 +221,10 @@ func resourceIBMCISHealthCheckValidator() *ResourceValidator {
 	return &cisHealthCheckValidator
 }
 
-func resourceCISHealthCheckCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -272,22 +275,22 @@ func resourceCISHealthCheckCreate(d *schema.ResourceData, meta interface{}) erro
 	result, resp, err := sess.CreateLoadBalancerMonitor(opt)
 	if err != nil {
 		log.Printf("create global load balancer health check failed %s", resp)
-		return err
+		return diagFromErr(err)
 	}
 	log.Printf("global load balancer created successfully : %s", *result.Result.ID)
 	d.SetId(convertCisToTfTwoVar(*result.Result.ID, crn))
-	return resourceCISHealthCheckRead(d, meta)
+	return resourceCISHealthCheckRead(ctx, d, meta)
 }
 
-func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	monitorID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 
@@ -295,8 +298,13 @@ func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error
 
 	result, resp, err := sess.GetLoadBalancerMonitor(opt)
 	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("global load balancer health check does not exist.")
+			d.SetId("")
+			return nil
+		}
 		log.Printf("Error reading global load balancer health check detail: %s", resp)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(cisGLBHealthCheckID, result.Result.ID)
 	d.Set(cisID, crn)
@@ -321,15 +329,15 @@ func resourceCISHealthCheckRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceCISHealthCheckUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	monitorID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 
@@ -389,23 +397,23 @@ func resourceCISHealthCheckUpdate(d *schema.ResourceData, meta interface{}) erro
 		result, resp, err := sess.EditLoadBalancerMonitor(opt)
 		if err != nil {
 			log.Printf("Error updating global load balancer health check detail: %s", resp)
-			return err
+			return diagFromErr(err)
 		}
 		log.Printf("Monitor update succesful : %s", *result.Result.ID)
 	}
 
-	return resourceCISHealthCheckRead(d, meta)
+	return resourceCISHealthCheckRead(ctx, d, meta)
 }
 
-func resourceCISHealthCheckDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISHealthCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).CisGLBHealthCheckClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	monitorID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	sess.Crn = core.StringPtr(crn)
 
@@ -414,39 +422,12 @@ func resourceCISHealthCheckDelete(d *schema.ResourceData, meta interface{}) erro
 	result, resp, err := sess.DeleteLoadBalancerMonitor(opt)
 	if err != nil {
 		log.Printf("Error deleting global load balancer health check detail: %s", resp)
-		return err
+		return diagFromErr(err)
 	}
 	log.Printf("Monitor ID: %s", *result.Result.ID)
 	return nil
 }
 
-func resourceCISHealthCheckExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := meta.(ClientSession).CisGLBHealthCheckClientSession()
-	if err != nil {
-		return false, err
-	}
-
-	monitorID, crn, err := convertTftoCisTwoVar(d.Id())
-	if err != nil {
-		return false, err
-	}
-	sess.Crn = core.StringPtr(crn)
-
-	opt := sess.NewGetLoadBalancerMonitorOptions(monitorID)
-
-	result, response, err := sess.GetLoadBalancerMonitor(opt)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			log.Printf("global load balancer health check does not exist.")
-			return false, nil
-		}
-		log.Printf("Error : %s", response)
-		return false, err
-	}
-	log.Printf("global load balancer health check exists: %s", *result.Result.ID)
-	return true, nil
-}
-
 func hashByMapKey(key string) func(v interface{}) int {
 	return func(v interface{}) int {
 		m := v.(map[string]interface{})
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/networking-go-sdk/globalloadbalancerpoolsv0"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -35,7 +37,7 @@ const (
 )
 
 func resourceIBMCISPool() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -146,20 +148,21 @@ func resourceIBMCISPool() *schema.Resource {
 			},
 		},
 
-		Create:   resourceCISPoolCreate,
-		Read:     resourceCISPoolRead,
-		Update:   resourceCISPoolUpdate,
-		Delete:   resourceCISPoolDelete,
-		Exists:   resourceCISPoolExists,
-		Importer: &schema.ResourceImporter{},
-	}
+		CreateContext: resourceCISPoolCreate,
+		ReadContext:   resourceCISPoolRead,
+		UpdateContext: resourceCISPoolUpdate,
+		DeleteContext: resourceCISPoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISPool, nil),
+		},
+	}, upgradeStateID(upgradeCISID(1)))
 }
 
-func resourceCISPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var regions []string
 	cisClient, err := meta.(ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -208,28 +211,33 @@ func resourceCISPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	result, resp, err := cisClient.CreateLoadBalancerPool(opt)
 	if err != nil {
 		log.Printf("[WARN] Create GLB Pools failed %s\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 	//Set unique TF Id from concatenated CIS Ids
 	d.SetId(convertCisToTfTwoVar(*result.Result.ID, crn))
-	return resourceCISPoolRead(d, meta)
+	return resourceCISPoolRead(ctx, d, meta)
 }
 
-func resourceCISPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	poolID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewGetLoadBalancerPoolOptions(poolID)
 	result, resp, err := cisClient.GetLoadBalancerPool(opt)
 	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("global load balancer pool does not exist.")
+			d.SetId("")
+			return nil
+		}
 		log.Printf("[WARN] Create GLB Pools failed %s\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	poolObj := *result.Result
@@ -251,14 +259,14 @@ func resourceCISPoolRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceCISPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	poolID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	if d.HasChange(cisGLBPoolName) ||
@@ -318,56 +326,32 @@ func resourceCISPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, resp, err := cisClient.EditLoadBalancerPool(opt)
 		if err != nil {
 			log.Printf("[WARN] Error getting zone during PoolUpdate %v\n", resp)
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceCISPoolRead(d, meta)
+	return resourceCISPoolRead(ctx, d, meta)
 }
 
-func resourceCISPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisGLBPoolClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	poolID, crn, err := convertTftoCisTwoVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	opt := cisClient.NewDeleteLoadBalancerPoolOptions(poolID)
 	result, resp, err := cisClient.DeleteLoadBalancerPool(opt)
 	if err != nil {
 		log.Printf("[WARN] Delete GLB Pools failed %s\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 	log.Printf("Pool %s deleted successfully.", *result.Result.ID)
 	return nil
 }
 
-func resourceCISPoolExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cisClient, err := meta.(ClientSession).CisGLBPoolClientSession()
-	if err != nil {
-		return false, err
-	}
-	poolID, cisID, err := convertTftoCisTwoVar(d.Id())
-	if err != nil {
-		return false, err
-	}
-	cisClient.Crn = core.StringPtr(cisID)
-	opt := cisClient.NewGetLoadBalancerPoolOptions(poolID)
-	result, response, err := cisClient.GetLoadBalancerPool(opt)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			log.Printf("global load balancer pool does not exist.")
-			return false, nil
-		}
-		log.Printf("Error : %s", response)
-		return false, err
-	}
-	log.Printf("global load balancer pool exist : %s", *result.Result.ID)
-	return true, nil
-}
-
 // Cloud Internet Services
 func flattenOrigins(list []globalloadbalancerpoolsv0.LoadBalancerPoolPackOriginsItem) []map[string]interface{} {
 	origins := []map[string]interface{}{}
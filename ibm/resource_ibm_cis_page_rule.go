@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"log"
 	"strconv"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	cispagerulev1 "github.com/IBM/networking-go-sdk/pageruleapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -35,13 +37,14 @@ const (
 )
 
 func resourceIBMCISPageRule() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceCISPageRuleCreate,
-		Read:     resourceCISPageRuleRead,
-		Update:   resourceCISPageRuleUpdate,
-		Delete:   resourceCISPageRuleDelete,
-		Exists:   resourceCISPageRuleExists,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceCISPageRuleCreate,
+		ReadContext:   resourceCISPageRuleRead,
+		UpdateContext: resourceCISPageRuleUpdate,
+		DeleteContext: resourceCISPageRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISPageRule, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -138,7 +141,7 @@ func resourceIBMCISPageRule() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(2)))
 }
 
 func resourceCISPageRuleValidator() *ResourceValidator {
@@ -172,10 +175,10 @@ func resourceCISPageRuleValidator() *ResourceValidator {
 	return &cisPageRuleValidator
 }
 
-func resourceCISPageRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -199,20 +202,20 @@ func resourceCISPageRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	result, response, err := cisClient.CreatePageRule(opt)
 	if err != nil {
 		log.Printf("Create page rule failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceCISPageRuleRead(d, meta)
+	return resourceCISPageRuleRead(ctx, d, meta)
 }
-func resourceCISPageRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ruleID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneID = core.StringPtr(zoneID)
@@ -220,8 +223,13 @@ func resourceCISPageRuleRead(d *schema.ResourceData, meta interface{}) error {
 	opt := cisClient.NewGetPageRuleOptions(ruleID)
 	result, response, err := cisClient.GetPageRule(opt)
 	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("Page rule does not exist.")
+			d.SetId("")
+			return nil
+		}
 		log.Printf("Get page rule failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -232,10 +240,10 @@ func resourceCISPageRuleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(cisPageRuleActions, flattenCISPageRuleActions(result.Result.Actions))
 	return nil
 }
-func resourceCISPageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ruleID, zoneID, crn, _ := convertTfToCisThreeVar(d.Id())
@@ -263,16 +271,16 @@ func resourceCISPageRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, response, err := cisClient.UpdatePageRule(opt)
 		if err != nil {
 			log.Printf("Update page rule failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceCISPageRuleRead(d, meta)
+	return resourceCISPageRuleRead(ctx, d, meta)
 }
 
-func resourceCISPageRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISPageRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisPageRuleClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ruleID, zoneID, crn, _ := convertTfToCisThreeVar(d.Id())
@@ -282,34 +290,11 @@ func resourceCISPageRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	_, response, err := cisClient.DeletePageRule(opt)
 	if err != nil {
 		log.Printf("Delete page rule failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func resourceCISPageRuleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cisClient, err := meta.(ClientSession).CisPageRuleClientSession()
-	if err != nil {
-		return false, err
-	}
-
-	ruleID, zoneID, crn, _ := convertTfToCisThreeVar(d.Id())
-	cisClient.Crn = core.StringPtr(crn)
-	cisClient.ZoneID = core.StringPtr(zoneID)
-
-	opt := cisClient.NewGetPageRuleOptions(ruleID)
-	_, response, err := cisClient.GetPageRule(opt)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			log.Printf("Page rule does not exist.")
-			return false, nil
-		}
-		log.Printf("Get page rule failed: %v", response)
-		return false, err
-	}
-	return true, nil
-}
-
 func expandCISPageRuleTargets(targets interface{}) []cispagerulev1.TargetsItem {
 	targetsInput := targets.(*schema.Set).List()
 	targetsOuptut := make([]cispagerulev1.TargetsItem, 0)
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	cisrangeappv1 "github.com/IBM/networking-go-sdk/rangeapplicationsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -47,13 +49,14 @@ const (
 )
 
 func resourceIBMCISRangeApp() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISRangeAppCreate,
-		Read:     resourceIBMCISRangeAppRead,
-		Update:   resourceIBMCISRangeAppUpdate,
-		Delete:   resourceIBMCISRangeAppDelete,
-		Exists:   resourceIBMCISRangeAppExists,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISRangeAppCreate,
+		ReadContext:   resourceIBMCISRangeAppRead,
+		UpdateContext: resourceIBMCISRangeAppUpdate,
+		DeleteContext: resourceIBMCISRangeAppDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISRangeApp, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -116,7 +119,7 @@ func resourceIBMCISRangeApp() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Description:  "Allows for the true client IP to be passed to the service.",
-				ValidateFunc: InvokeValidator(ibmCISRangeApp, cisRangeAppProtocol),
+				ValidateFunc: InvokeValidator(ibmCISRangeApp, cisRangeAppProxyProtocol),
 			},
 			cisRangeAppEdgeIPsType: {
 				Type:         schema.TypeString,
@@ -157,7 +160,7 @@ func resourceIBMCISRangeApp() *schema.Resource {
 				Description: "modified on date",
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(2)))
 }
 func resourceIBMCISRangeAppValidator() *ResourceValidator {
 
@@ -205,10 +208,10 @@ func resourceIBMCISRangeAppValidator() *ResourceValidator {
 	ibmCISRangeAppResourceValidator := ResourceValidator{ResourceName: ibmCISRangeApp, Schema: validateSchema}
 	return &ibmCISRangeAppResourceValidator
 }
-func resourceIBMCISRangeAppCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRangeAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -264,16 +267,16 @@ func resourceIBMCISRangeAppCreate(d *schema.ResourceData, meta interface{}) erro
 
 	result, resp, err := cisClient.CreateRangeApp(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to create range application: %v", resp)
+		return diagFromErr(fmt.Errorf("Failed to create range application: %v", resp))
 	}
 	d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
-	return resourceIBMCISRangeAppRead(d, meta)
+	return resourceIBMCISRangeAppRead(ctx, d, meta)
 }
 
-func resourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRangeAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rangeAppID, zoneID, crn, _ := convertTfToCisThreeVar(d.Id())
@@ -283,7 +286,12 @@ func resourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error
 	opt := cisClient.NewGetRangeAppOptions(rangeAppID)
 	result, resp, err := cisClient.GetRangeApp(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to read range application: %v", resp)
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("range application is not found")
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Failed to read range application: %v", resp))
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -303,10 +311,10 @@ func resourceIBMCISRangeAppRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMCISRangeAppUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRangeAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if d.HasChange(cisRangeAppOriginDirect) ||
@@ -369,16 +377,16 @@ func resourceIBMCISRangeAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 		_, resp, err := cisClient.UpdateRangeApp(opt)
 		if err != nil {
-			return fmt.Errorf("Failed to update range application: %v", resp)
+			return diagFromErr(fmt.Errorf("Failed to update range application: %v", resp))
 		}
 	}
-	return resourceIBMCISRangeAppRead(d, meta)
+	return resourceIBMCISRangeAppRead(ctx, d, meta)
 }
 
-func resourceIBMCISRangeAppDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRangeAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRangeAppClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rangeAppID, zoneID, cisID, _ := convertTfToCisThreeVar(d.Id())
@@ -387,27 +395,7 @@ func resourceIBMCISRangeAppDelete(d *schema.ResourceData, meta interface{}) erro
 	opt := cisClient.NewDeleteRangeAppOptions(rangeAppID)
 	_, resp, err := cisClient.DeleteRangeApp(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to delete range application: %v", resp)
+		return diagFromErr(fmt.Errorf("Failed to delete range application: %v", resp))
 	}
 	return nil
 }
-
-func resourceIBMCISRangeAppExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cisClient, err := meta.(ClientSession).CisRangeAppClientSession()
-	if err != nil {
-		return false, err
-	}
-	rangeAppID, zoneID, cisID, _ := convertTfToCisThreeVar(d.Id())
-	cisClient.Crn = core.StringPtr(cisID)
-	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-	opt := cisClient.NewGetRangeAppOptions(rangeAppID)
-	_, resp, err := cisClient.GetRangeApp(opt)
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Println("range application is not found")
-			return false, nil
-		}
-		return false, fmt.Errorf("Failed to getting existing range application: %v", err)
-	}
-	return true, nil
-}
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
 	"github.com/IBM/networking-go-sdk/zoneratelimitsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,13 +24,14 @@ const (
 )
 
 func resourceIBMCISRateLimit() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISRateLimitCreate,
-		Read:     resourceIBMCISRateLimitRead,
-		Update:   resourceIBMCISRateLimitUpdate,
-		Delete:   resourceIBMCISRateLimitDelete,
-		Exists:   resourceIBMCISRateLimitExists,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISRateLimitCreate,
+		ReadContext:   resourceIBMCISRateLimitRead,
+		UpdateContext: resourceIBMCISRateLimitUpdate,
+		DeleteContext: resourceIBMCISRateLimitDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISRateLimit, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			"cis_id": {
 				Type:        schema.TypeString,
@@ -248,7 +251,7 @@ func resourceIBMCISRateLimit() *schema.Resource {
 				Description: "Rate Limit rule Id",
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(2)))
 }
 func resourceIBMCISRateLimitValidator() *ResourceValidator {
 
@@ -346,16 +349,16 @@ func resourceIBMCISRateLimitValidator() *ResourceValidator {
 	ibmCISRateLimitResourceValidator := ResourceValidator{ResourceName: "ibm_cis_rate_limit", Schema: validateSchema}
 	return &ibmCISRateLimitResourceValidator
 }
-func resourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRateLimitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	cisID := d.Get("cis_id").(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get("domain_id").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -375,13 +378,13 @@ func resourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) err
 
 	action, err := expandRateLimitAction(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting action from expandRateLimitAction %s", err)
+		return diagFromErr(fmt.Errorf("Error in getting action from expandRateLimitAction %s", err))
 	}
 	opt.SetAction(action)
 
 	match, err := expandRateLimitMatch(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting match from expandRateLimitMatch %s", err)
+		return diagFromErr(fmt.Errorf("Error in getting match from expandRateLimitMatch %s", err))
 	}
 	opt.SetMatch(match)
 
@@ -392,35 +395,40 @@ func resourceIBMCISRateLimitCreate(d *schema.ResourceData, meta interface{}) err
 
 	byPass, err := expandRateLimitBypass(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting bypass from expandRateLimitBypass %s", err)
+		return diagFromErr(fmt.Errorf("Error in getting bypass from expandRateLimitBypass %s", err))
 	}
 	opt.SetBypass(byPass)
 
 	//creating rate limit rule
 	result, resp, err := cisClient.CreateZoneRateLimits(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to create RateLimit: %v", resp)
+		return diagFromErr(fmt.Errorf("Failed to create RateLimit: %v", resp))
 	}
 	record := result.Result
 	d.SetId(convertCisToTfThreeVar(*record.ID, zoneID, cisID))
-	return resourceIBMCISRateLimitRead(d, meta)
+	return resourceIBMCISRateLimitRead(ctx, d, meta)
 }
 
-func resourceIBMCISRateLimitRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRateLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	recordID, zoneID, cisID, _ := convertTfToCisThreeVar(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cisClient.Crn = core.StringPtr(cisID)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetRateLimitOptions(recordID)
 	result, resp, err := cisClient.GetRateLimit(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to read RateLimit: %v", resp)
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("ratelimit is not found")
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Failed to read RateLimit: %v", resp))
 	}
 
 	rule := result.Result
@@ -439,10 +447,10 @@ func resourceIBMCISRateLimitRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRateLimitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	recordID, zoneID, cisID, _ := convertTfToCisThreeVar(d.Id())
@@ -472,40 +480,40 @@ func resourceIBMCISRateLimitUpdate(d *schema.ResourceData, meta interface{}) err
 
 		action, err := expandRateLimitAction(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting action from expandRateLimitAction %s", err)
+			return diagFromErr(fmt.Errorf("Error in getting action from expandRateLimitAction %s", err))
 		}
 		opt.SetAction(action)
 
 		match, err := expandRateLimitMatch(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting match from expandRateLimitMatch %s", err)
+			return diagFromErr(fmt.Errorf("Error in getting match from expandRateLimitMatch %s", err))
 		}
 		opt.SetMatch(match)
 
 		correlate, err := expandRateLimitCorrelate(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting correlate from expandRateLimitCorrelate %s", err)
+			return diagFromErr(fmt.Errorf("Error in getting correlate from expandRateLimitCorrelate %s", err))
 		}
 		opt.SetCorrelate(correlate)
 
 		byPass, err := expandRateLimitBypass(d)
 		if err != nil {
-			return fmt.Errorf("Error in getting bypass from expandRateLimitBypass %s", err)
+			return diagFromErr(fmt.Errorf("Error in getting bypass from expandRateLimitBypass %s", err))
 		}
 		opt.SetBypass(byPass)
 		_, resp, err := cisClient.UpdateRateLimit(opt)
 		if err != nil {
-			return fmt.Errorf("Failed to update RateLimit: %v", resp)
+			return diagFromErr(fmt.Errorf("Failed to update RateLimit: %v", resp))
 		}
 	}
 	d.SetId(convertCisToTfThreeVar(recordID, zoneID, cisID))
-	return resourceIBMCISRateLimitRead(d, meta)
+	return resourceIBMCISRateLimitRead(ctx, d, meta)
 }
 
-func resourceIBMCISRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRateLimitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	recordID, zoneID, cisID, _ := convertTfToCisThreeVar(d.Id())
@@ -514,31 +522,11 @@ func resourceIBMCISRateLimitDelete(d *schema.ResourceData, meta interface{}) err
 	opt := cisClient.NewDeleteZoneRateLimitOptions(recordID)
 	_, resp, err := cisClient.DeleteZoneRateLimit(opt)
 	if err != nil {
-		return fmt.Errorf("Failed to delete RateLimit: %v", resp)
+		return diagFromErr(fmt.Errorf("Failed to delete RateLimit: %v", resp))
 	}
 	return nil
 }
 
-func resourceIBMCISRateLimitExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cisClient, err := meta.(ClientSession).CisRLClientSession()
-	if err != nil {
-		return false, err
-	}
-	recordID, zoneID, cisID, _ := convertTfToCisThreeVar(d.Id())
-	cisClient.Crn = core.StringPtr(cisID)
-	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-	opt := cisClient.NewGetRateLimitOptions(recordID)
-	_, resp, err := cisClient.GetRateLimit(opt)
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			log.Println("ratelimit is not found")
-			return false, nil
-		}
-		return false, fmt.Errorf("Failed to getting existing RateLimit: %v", err)
-	}
-	return true, nil
-}
-
 func expandRateLimitAction(d *schema.ResourceData) (
 	action *zoneratelimitsv1.RatelimitInputAction, err error) {
 	action = &zoneratelimitsv1.RatelimitInputAction{}
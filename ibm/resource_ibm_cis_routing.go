@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,12 +18,14 @@ const (
 )
 
 func resourceIBMCISRouting() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISRoutingUpdate,
-		Read:     resourceIBMCISRoutingRead,
-		Update:   resourceIBMCISRoutingUpdate,
-		Delete:   resourceIBMCISRoutingDelete,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISRoutingUpdate,
+		ReadContext:   resourceIBMCISRoutingRead,
+		UpdateContext: resourceIBMCISRoutingUpdate,
+		DeleteContext: resourceIBMCISRoutingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISRouting, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -42,7 +46,7 @@ func resourceIBMCISRouting() *schema.Resource {
 				ValidateFunc: InvokeValidator(ibmCISRouting, cisRoutingSmartRouting),
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(1)))
 }
 
 func resourceIBMCISRoutingValidator() *ResourceValidator {
@@ -61,10 +65,10 @@ func resourceIBMCISRoutingValidator() *ResourceValidator {
 	return &ibmCISRoutingValidator
 }
 
-func resourceIBMCISRoutingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRoutingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRoutingClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -79,18 +83,18 @@ func resourceIBMCISRoutingUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, err := cisClient.UpdateSmartRouting(opt)
 		if err != nil {
 			log.Printf("Update smart route setting failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	d.SetId(convertCisToTfTwoVar(zoneID, crn))
-	return resourceIBMCISRoutingRead(d, meta)
+	return resourceIBMCISRoutingRead(ctx, d, meta)
 }
 
-func resourceIBMCISRoutingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRoutingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisRoutingClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	zoneID, crn, err := convertTftoCisTwoVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -99,7 +103,7 @@ func resourceIBMCISRoutingRead(d *schema.ResourceData, meta interface{}) error {
 	result, response, err := cisClient.GetSmartRouting(opt)
 	if err != nil {
 		log.Printf("Get smart route setting failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -107,7 +111,7 @@ func resourceIBMCISRoutingRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMCISRoutingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISRoutingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +21,7 @@ const (
 )
 
 func resourceIBMCISTLSSettings() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -54,12 +56,14 @@ func resourceIBMCISTLSSettings() *schema.Resource {
 				Default:      "1.1",
 			},
 		},
-		Create:   resourceCISTLSSettingsUpdate,
-		Read:     resourceCISTLSSettingsRead,
-		Update:   resourceCISTLSSettingsUpdate,
-		Delete:   resourceCISTLSSettingsDelete,
-		Importer: &schema.ResourceImporter{},
-	}
+		CreateContext: resourceCISTLSSettingsUpdate,
+		ReadContext:   resourceCISTLSSettingsRead,
+		UpdateContext: resourceCISTLSSettingsUpdate,
+		DeleteContext: resourceCISTLSSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISTLSSettings, nil),
+		},
+	}, upgradeStateID(upgradeCISID(1)))
 }
 
 func resourceIBMCISTLSSettingsValidator() *ResourceValidator {
@@ -85,10 +89,10 @@ func resourceIBMCISTLSSettingsValidator() *ResourceValidator {
 	return &ibmCISTLSSettingsResourceValidator
 }
 
-func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceCISTLSSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Get(cisID).(string)
 	zoneID, _, err := convertTftoCisTwoVar(d.Get(cisDomainID).(string))
@@ -107,7 +111,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			_, resp, err := cisClient.ChangeTls13Setting(opt)
 			if err != nil {
 				log.Printf("Update TLS 1.3 setting Failed : %v\n", resp)
-				return err
+				return diagFromErr(err)
 			}
 		}
 
@@ -118,7 +122,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			resp, err := cisClient.ChangeUniversalCertificateSetting(opt)
 			if err != nil {
 				log.Printf("Update universal ssl setting Failed : %v\n", resp)
-				return err
+				return diagFromErr(err)
 			}
 		}
 
@@ -126,7 +130,7 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 		if minTLSVer, ok := d.GetOk(cisTLSSettingsMinTLSVersion); ok {
 			cisClient, err := meta.(ClientSession).CisDomainSettingsClientSession()
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			cisClient.Crn = core.StringPtr(crn)
 			cisClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -135,18 +139,18 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			_, resp, err := cisClient.UpdateMinTlsVersion(opt)
 			if err != nil {
 				log.Printf("Update minimum TLS version setting Failed : %v\n", resp)
-				return err
+				return diagFromErr(err)
 			}
 		}
 	}
 	d.SetId(convertCisToTfTwoVar(zoneID, crn))
-	return resourceCISTLSSettingsRead(d, meta)
+	return resourceCISTLSSettingsRead(ctx, d, meta)
 }
 
-func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceCISTLSSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisSSLClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	zoneID, crn, _ := convertTftoCisTwoVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -156,7 +160,7 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 	tls13Result, resp, err := cisClient.GetTls13Setting(cisClient.NewGetTls13SettingOptions())
 	if err != nil {
 		log.Printf("Get TLS 1.3 setting failed : %v\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	// Universal SSL setting
@@ -164,13 +168,13 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 		cisClient.NewGetUniversalCertificateSettingOptions())
 	if err != nil {
 		log.Printf("Update TLS 1.3 setting failed : %v\n", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	// Minumum TLS version setting
 	minTLSClient, err := meta.(ClientSession).CisDomainSettingsClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	minTLSClient.Crn = core.StringPtr(crn)
 	minTLSClient.ZoneIdentifier = core.StringPtr(zoneID)
@@ -178,7 +182,7 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 		minTLSClient.NewGetMinTlsVersionOptions())
 	if err != nil {
 		log.Printf("Min TLS Version setting get request failed : %v", resp)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -188,7 +192,7 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceCISTLSSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceCISTLSSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS resource
 	d.SetId("")
 	return nil
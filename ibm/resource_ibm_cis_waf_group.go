@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,12 +24,14 @@ const (
 )
 
 func resourceIBMCISWAFGroup() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISWAFGroupUpdate,
-		Read:     resourceIBMCISWAFGroupRead,
-		Update:   resourceIBMCISWAFGroupUpdate,
-		Delete:   resourceIBMCISWAFGroupDelete,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISWAFGroupUpdate,
+		ReadContext:   resourceIBMCISWAFGroupRead,
+		UpdateContext: resourceIBMCISWAFGroupUpdate,
+		DeleteContext: resourceIBMCISWAFGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISWAFGroup, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -80,7 +84,7 @@ func resourceIBMCISWAFGroup() *schema.Resource {
 				Description: "WAF Rule group modified rules count",
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(3)))
 }
 
 func resourceIBMCISWAFGroupValidator() *ResourceValidator {
@@ -99,10 +103,10 @@ func resourceIBMCISWAFGroupValidator() *ResourceValidator {
 	return &ibmCISWAFGroupValidator
 }
 
-func resourceIBMCISWAFGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFGroupClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -119,17 +123,17 @@ func resourceIBMCISWAFGroupUpdate(d *schema.ResourceData, meta interface{}) erro
 		_, response, err := cisClient.UpdateWafRuleGroup(opt)
 		if err != nil {
 			log.Printf("Update waf rule group mode failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 	}
 	d.SetId(convertCisToTfFourVar(groupID, packageID, zoneID, crn))
-	return resourceIBMCISWAFGroupRead(d, meta)
+	return resourceIBMCISWAFGroupRead(ctx, d, meta)
 }
 
-func resourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFGroupClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	groupID, packageID, zoneID, crn, err := convertTfToCisFourVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -143,7 +147,7 @@ func resourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error
 			return nil
 		}
 		log.Printf("Get waf rule group setting failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -157,7 +161,7 @@ func resourceIBMCISWAFGroupRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMCISWAFGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS WAF Group resource
 	d.SetId("")
 	return nil
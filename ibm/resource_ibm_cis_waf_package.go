@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,12 +23,14 @@ const (
 )
 
 func resourceIBMCISWAFPackage() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISWAFPackageUpdate,
-		Read:     resourceIBMCISWAFPackageRead,
-		Update:   resourceIBMCISWAFPackageUpdate,
-		Delete:   resourceIBMCISWAFPackageDelete,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISWAFPackageUpdate,
+		ReadContext:   resourceIBMCISWAFPackageRead,
+		UpdateContext: resourceIBMCISWAFPackageUpdate,
+		DeleteContext: resourceIBMCISWAFPackageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISWAFPackage, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -76,7 +80,7 @@ func resourceIBMCISWAFPackage() *schema.Resource {
 				Description: "WAF package description",
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(2)))
 }
 
 func resourceIBMCISWAFPackageValidator() *ResourceValidator {
@@ -103,10 +107,10 @@ func resourceIBMCISWAFPackageValidator() *ResourceValidator {
 	return &ibmCISWAFPackageValidator
 }
 
-func resourceIBMCISWAFPackageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFPackageClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -127,18 +131,18 @@ func resourceIBMCISWAFPackageUpdate(d *schema.ResourceData, meta interface{}) er
 		result, response, err := cisClient.UpdateWafPackage(opt)
 		if err != nil {
 			log.Printf("Update waf package setting failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 		d.SetId(convertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	}
 
-	return resourceIBMCISWAFPackageRead(d, meta)
+	return resourceIBMCISWAFPackageRead(ctx, d, meta)
 }
 
-func resourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFPackageClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	packageID, zoneID, crn, err := convertTfToCisThreeVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -152,7 +156,7 @@ func resourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) erro
 			return nil
 		}
 		log.Printf("Get waf package setting failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
@@ -165,7 +169,7 @@ func resourceIBMCISWAFPackageRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMCISWAFPackageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS WAF Package resource
 	d.SetId("")
 	return nil
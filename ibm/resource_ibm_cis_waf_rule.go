@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"log"
 
 	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,12 +28,14 @@ const (
 )
 
 func resourceIBMCISWAFRule() *schema.Resource {
-	return &schema.Resource{
-		Create:   resourceIBMCISWAFRuleUpdate,
-		Read:     resourceIBMCISWAFRuleRead,
-		Update:   resourceIBMCISWAFRuleUpdate,
-		Delete:   resourceIBMCISWAFRuleDelete,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		CreateContext: resourceIBMCISWAFRuleUpdate,
+		ReadContext:   resourceIBMCISWAFRuleRead,
+		UpdateContext: resourceIBMCISWAFRuleUpdate,
+		DeleteContext: resourceIBMCISWAFRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCISWAFRule, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -100,7 +104,7 @@ func resourceIBMCISWAFRule() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, upgradeStateID(upgradeCISID(3)))
 }
 
 func resourceIBMCISWAFRuleValidator() *ResourceValidator {
@@ -119,10 +123,10 @@ func resourceIBMCISWAFRuleValidator() *ResourceValidator {
 	return &ibmCISWAFRuleValidator
 }
 
-func resourceIBMCISWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFRuleClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	crn := d.Get(cisID).(string)
@@ -139,7 +143,7 @@ func resourceIBMCISWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error
 		getResult, getResponse, err := cisClient.GetWafRule(getOpt)
 		if err != nil {
 			log.Printf("Get WAF rule setting failed: %v", getResponse)
-			return err
+			return diagFromErr(err)
 		}
 		getMode := *getResult.Result.Mode
 		updateOpt := cisClient.NewUpdateWafRuleOptions(packageID, ruleID)
@@ -159,18 +163,18 @@ func resourceIBMCISWAFRuleUpdate(d *schema.ResourceData, meta interface{}) error
 		_, response, err := cisClient.UpdateWafRule(updateOpt)
 		if err != nil {
 			log.Printf("Update WAF rule setting failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	d.SetId(convertCisToTfFourVar(ruleID, packageID, zoneID, crn))
-	return resourceIBMCISWAFRuleRead(d, meta)
+	return resourceIBMCISWAFRuleRead(ctx, d, meta)
 }
 
-func resourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cisClient, err := meta.(ClientSession).CisWAFRuleClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	ruleID, packageID, zoneID, crn, err := convertTfToCisFourVar(d.Id())
 	cisClient.Crn = core.StringPtr(crn)
@@ -184,7 +188,7 @@ func resourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 		log.Printf("Get waf rule setting failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	groups := []interface{}{}
 	group := map[string]interface{}{}
@@ -204,7 +208,7 @@ func resourceIBMCISWAFRuleRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMCISWAFRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCISWAFRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Nothing to delete on CIS WAF rule resource
 	d.SetId("")
 	return nil
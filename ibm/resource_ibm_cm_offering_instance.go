@@ -4,21 +4,24 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMCmOfferingInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMCmOfferingInstanceCreate,
-		Read:     resourceIBMCmOfferingInstanceRead,
-		Update:   resourceIBMCmOfferingInstanceUpdate,
-		Delete:   resourceIBMCmOfferingInstanceDelete,
-		Exists:   resourceIBMCmOfferingInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMCmOfferingInstanceCreate,
+		ReadContext:   resourceIBMCmOfferingInstanceRead,
+		UpdateContext: resourceIBMCmOfferingInstanceUpdate,
+		DeleteContext: resourceIBMCmOfferingInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMCmOfferingInstance, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"url": &schema.Schema{
@@ -81,15 +84,15 @@ func resourceIBMCmOfferingInstance() *schema.Resource {
 	}
 }
 
-func resourceIBMCmOfferingInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	createOfferingInstanceOptions := &catalogmanagementv1.CreateOfferingInstanceOptions{}
@@ -127,20 +130,20 @@ func resourceIBMCmOfferingInstanceCreate(d *schema.ResourceData, meta interface{
 	offeringInstance, response, err := catalogManagementClient.CreateOfferingInstance(createOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*offeringInstance.ID)
 
 	log.Printf("LOG2 Service version instance of type %q was created on cluster %q", *createOfferingInstanceOptions.KindFormat, *createOfferingInstanceOptions.ClusterID)
 
-	return resourceIBMCmOfferingInstanceRead(d, meta)
+	return resourceIBMCmOfferingInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMCmOfferingInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	getOfferingInstanceOptions := &catalogmanagementv1.GetOfferingInstanceOptions{}
@@ -154,57 +157,57 @@ func resourceIBMCmOfferingInstanceRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 		log.Printf("[DEBUG] GetOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("url", offeringInstance.URL); err != nil {
-		return fmt.Errorf("Error setting url: %s", err)
+		return diagFromErr(fmt.Errorf("Error setting url: %s", err))
 	}
 	if err = d.Set("crn", offeringInstance.CRN); err != nil {
-		return fmt.Errorf("Error setting crn: %s", err)
+		return diagFromErr(fmt.Errorf("Error setting crn: %s", err))
 	}
 	if err = d.Set("label", offeringInstance.Label); err != nil {
-		return fmt.Errorf("Error setting label: %s", err)
+		return diagFromErr(fmt.Errorf("Error setting label: %s", err))
 	}
 	if err = d.Set("catalog_id", offeringInstance.CatalogID); err != nil {
-		return fmt.Errorf("Error setting catalog_id: %s", err)
+		return diagFromErr(fmt.Errorf("Error setting catalog_id: %s", err))
 	}
 	if err = d.Set("offering_id", offeringInstance.OfferingID); err != nil {
-		return fmt.Errorf("Error setting offering_id: %s", err)
+		return diagFromErr(fmt.Errorf("Error setting offering_id: %s", err))
 	}
 	if err = d.Set("kind_format", offeringInstance.KindFormat); err != nil {
-		return fmt.Errorf("Error setting kind_format: %s", err)
+		return diagFromErr(fmt.Errorf("Error setting kind_format: %s", err))
 	}
 	if err = d.Set("version", offeringInstance.Version); err != nil {
-		return fmt.Errorf("Error setting version: %s", err)
+		return diagFromErr(fmt.Errorf("Error setting version: %s", err))
 	}
 	if err = d.Set("cluster_id", offeringInstance.ClusterID); err != nil {
-		return fmt.Errorf("Error setting cluster_id: %s", err)
+		return diagFromErr(fmt.Errorf("Error setting cluster_id: %s", err))
 	}
 	if err = d.Set("cluster_region", offeringInstance.ClusterRegion); err != nil {
-		return fmt.Errorf("Error setting cluster_region: %s", err)
+		return diagFromErr(fmt.Errorf("Error setting cluster_region: %s", err))
 	}
 	if offeringInstance.ClusterNamespaces != nil {
 		if err = d.Set("cluster_namespaces", offeringInstance.ClusterNamespaces); err != nil {
-			return fmt.Errorf("Error setting cluster_namespaces: %s", err)
+			return diagFromErr(fmt.Errorf("Error setting cluster_namespaces: %s", err))
 		}
 	}
 	if err = d.Set("cluster_all_namespaces", offeringInstance.ClusterAllNamespaces); err != nil {
-		return fmt.Errorf("Error setting cluster_all_namespaces: %s", err)
+		return diagFromErr(fmt.Errorf("Error setting cluster_all_namespaces: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMCmOfferingInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	putOfferingInstanceOptions := &catalogmanagementv1.PutOfferingInstanceOptions{}
@@ -243,20 +246,20 @@ func resourceIBMCmOfferingInstanceUpdate(d *schema.ResourceData, meta interface{
 	_, response, err := catalogManagementClient.PutOfferingInstance(putOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] PutOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMCmOfferingInstanceRead(d, meta)
+	return resourceIBMCmOfferingInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMCmOfferingInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMCmOfferingInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	deleteOfferingInstanceOptions := &catalogmanagementv1.DeleteOfferingInstanceOptions{}
@@ -267,29 +270,10 @@ func resourceIBMCmOfferingInstanceDelete(d *schema.ResourceData, meta interface{
 	response, err := catalogManagementClient.DeleteOfferingInstance(deleteOfferingInstanceOptions)
 	if err != nil {
 		log.Printf("[DEBUG] DeleteOfferingInstance failed %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMCmOfferingInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	catalogManagementClient, err := meta.(ClientSession).CatalogManagementV1()
-	if err != nil {
-		return false, err
-	}
-
-	getOfferingInstanceOptions := &catalogmanagementv1.GetOfferingInstanceOptions{}
-
-	getOfferingInstanceOptions.SetInstanceIdentifier(d.Id())
-
-	offeringInstance, response, err := catalogManagementClient.GetOfferingInstance(getOfferingInstanceOptions)
-	if err != nil {
-		log.Printf("[DEBUG] GetOfferingInstance failed %s\n%s", err, response)
-		return false, err
-	}
-
-	return *offeringInstance.ID == d.Id(), nil
-}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -38,12 +40,13 @@ var IBMComputeAutoScaleGroupObjectMask = []string{
 
 func resourceIBMComputeAutoScaleGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeAutoScaleGroupCreate,
-		Read:     resourceIBMComputeAutoScaleGroupRead,
-		Update:   resourceIBMComputeAutoScaleGroupUpdate,
-		Delete:   resourceIBMComputeAutoScaleGroupDelete,
-		Exists:   resourceIBMComputeAutoScaleGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeAutoScaleGroupCreate,
+		ReadContext:   resourceIBMComputeAutoScaleGroupRead,
+		UpdateContext: resourceIBMComputeAutoScaleGroupUpdate,
+		DeleteContext: resourceIBMComputeAutoScaleGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMComputeAutoScaleGroup, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -245,23 +248,23 @@ func getVirtualGuestTemplate(vGuestTemplateList []interface{}, meta interface{})
 	return vgs[0], err
 }
 
-func resourceIBMComputeAutoScaleGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	accountServiceNoRetry := services.GetScaleGroupService(sess.SetRetries(0))
 
 	virtualGuestTemplateOpts, err := getVirtualGuestTemplate(d.Get("virtual_guest_member_template").([]interface{}), meta)
 	if err != nil {
-		return fmt.Errorf("Error while parsing virtual_guest_member_template values: %s", err)
+		return diagFromErr(fmt.Errorf("Error while parsing virtual_guest_member_template values: %s", err))
 	}
 
 	scaleNetworkVlans, err := buildScaleVlansFromResourceData(d.Get("network_vlan_ids").(*schema.Set).List(), meta)
 	if err != nil {
-		return fmt.Errorf("Error while parsing network vlan values: %s", err)
+		return diagFromErr(fmt.Errorf("Error while parsing network vlan values: %s", err))
 	}
 
 	locationGroupRegionalId, err := getLocationGroupRegionalId(sess, d.Get("regional_group").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Build up our creation options
@@ -282,12 +285,12 @@ func resourceIBMComputeAutoScaleGroupCreate(d *schema.ResourceData, meta interfa
 
 	opts.LoadBalancers, err = buildLoadBalancers(d)
 	if err != nil {
-		return fmt.Errorf("Error creating Scale Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Scale Group: %s", err))
 	}
 
 	res, err := accountServiceNoRetry.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Scale Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Scale Group: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
@@ -296,13 +299,13 @@ func resourceIBMComputeAutoScaleGroupCreate(d *schema.ResourceData, meta interfa
 	time.Sleep(60)
 
 	// wait for scale group to become active
-	_, err = waitForActiveStatus(d, meta)
+	_, err = waitForActiveStatus(ctx, d, meta)
 
 	if err != nil {
-		return fmt.Errorf("Error waiting for scale group (%s) to become active: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf("Error waiting for scale group (%s) to become active: %s", d.Id(), err))
 	}
 
-	return resourceIBMComputeAutoScaleGroupRead(d, meta)
+	return resourceIBMComputeAutoScaleGroupRead(ctx, d, meta)
 }
 
 func buildLoadBalancers(d *schema.ResourceData, ids ...int) ([]datatypes.Scale_LoadBalancer, error) {
@@ -338,7 +341,7 @@ func buildLoadBalancers(d *schema.ResourceData, ids ...int) ([]datatypes.Scale_L
 	}
 }
 
-func resourceIBMComputeAutoScaleGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetScaleGroupService(sess)
 
@@ -352,7 +355,7 @@ func resourceIBMComputeAutoScaleGroupRead(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving autoscale Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving autoscale Group: %s", err))
 	}
 
 	d.Set("name", slGroupObj.Name)
@@ -452,7 +455,7 @@ func populateMemberTemplateResourceData(template datatypes.Virtual_Guest) []map[
 	return []map[string]interface{}{d}
 }
 
-func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	scaleGroupService := services.GetScaleGroupService(sess)
@@ -462,14 +465,14 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 
 	groupId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID. Must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID. Must be an integer: %s", err))
 	}
 
 	// Fetch the complete object from SoftLayer, update with current values from the configuration, and send the
 	// whole thing back to SoftLayer (effectively, a PUT)
 	groupObj, err := scaleGroupService.Id(groupId).Mask(strings.Join(IBMComputeAutoScaleGroupObjectMask, ",")).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving autoscale_group resource: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving autoscale_group resource: %s", err))
 	}
 
 	groupObj.Name = sl.String(d.Get("name").(string))
@@ -485,7 +488,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 		groupObj.LoadBalancers, err = buildLoadBalancers(d)
 	}
 	if err != nil {
-		return fmt.Errorf("Error creating Scale Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Scale Group: %s", err))
 	}
 
 	if d.HasChange("network_vlan_ids") {
@@ -503,13 +506,13 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 			Id(groupId).
 			GetNetworkVlans()
 		if err != nil {
-			return fmt.Errorf("Could not retrieve current vlans for scale group (%d): %s", groupId, err)
+			return diagFromErr(newAttributeError("network_vlan_ids", fmt.Sprintf("Could not retrieve current vlans for scale group (%d)", groupId), err))
 		}
 
 		for _, oldScaleVlan := range oldScaleVlans {
 			_, err := scaleNetworkVlanService.Id(*oldScaleVlan.Id).DeleteObject()
 			if err != nil {
-				return fmt.Errorf("Error deleting scale network vlan %d: %s", *oldScaleVlan.Id, err)
+				return diagFromErr(newAttributeError("network_vlan_ids", fmt.Sprintf("Error deleting scale network vlan %d", *oldScaleVlan.Id), err))
 			}
 		}
 
@@ -517,7 +520,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 		scaleVlans, err := buildScaleVlansFromResourceData(newIds, meta)
 
 		if err != nil {
-			return fmt.Errorf("Unable to parse network vlan options: %s", err)
+			return diagFromErr(newAttributeError("network_vlan_ids", "Unable to parse network vlan options", err))
 		}
 
 		groupObj.NetworkVlans = scaleVlans
@@ -526,7 +529,7 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 	if d.HasChange("virtual_guest_member_template") {
 		virtualGuestTemplateOpts, err := getVirtualGuestTemplate(d.Get("virtual_guest_member_template").([]interface{}), meta)
 		if err != nil {
-			return fmt.Errorf("Unable to parse virtual guest member template options: %s", err)
+			return diagFromErr(newAttributeError("virtual_guest_member_template", "Unable to parse virtual guest member template options", err))
 		}
 
 		groupObj.VirtualGuestMemberTemplate = &virtualGuestTemplateOpts
@@ -534,14 +537,14 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 	}
 	_, err = scaleGroupServiceNoRetry.Id(groupId).EditObject(&groupObj)
 	if err != nil {
-		return fmt.Errorf("Error received while editing autoscale_group: %s", err)
+		return diagFromErr(fmt.Errorf("Error received while editing autoscale_group: %s", err))
 	}
 
 	// wait for scale group to become active
-	_, err = waitForActiveStatus(d, meta)
+	_, err = waitForActiveStatus(ctx, d, meta)
 
 	if err != nil {
-		return fmt.Errorf("Error waiting for scale group (%s) to become active: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf("Error waiting for scale group (%s) to become active: %s", d.Id(), err))
 	}
 
 	// Delete a load balancer if there is the load balancer in a scale group
@@ -549,26 +552,26 @@ func resourceIBMComputeAutoScaleGroupUpdate(d *schema.ResourceData, meta interfa
 	if len(currentLoadBalancers) > 0 && len(groupObj.LoadBalancers) <= 0 {
 		_, err = scaleLoadBalancerService.Id(*currentLoadBalancers[0].Id).DeleteObject()
 		if err != nil {
-			return fmt.Errorf("Error received while deleting loadbalancers: %s", err)
+			return diagFromErr(fmt.Errorf("Error received while deleting loadbalancers: %s", err))
 		}
 	}
 
 	return nil
 }
 
-func resourceIBMComputeAutoScaleGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScaleGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	scaleGroupService := services.GetScaleGroupService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting scale group: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting scale group: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting scale group: %d", id)
 	_, err = scaleGroupService.Id(id).ForceDeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting scale group: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting scale group: %s", err))
 	}
 
 	d.SetId("")
@@ -576,7 +579,7 @@ func resourceIBMComputeAutoScaleGroupDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func waitForActiveStatus(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForActiveStatus(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(ClientSession).SoftLayerSession()
 	scaleGroupService := services.GetScaleGroupService(sess)
 
@@ -635,29 +638,7 @@ func waitForActiveStatus(d *schema.ResourceData, meta interface{}) (interface{},
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
-}
-
-func resourceIBMComputeAutoScaleGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	sess := meta.(ClientSession).SoftLayerSession()
-	scaleGroupService := services.GetScaleGroupService(sess)
-
-	groupId, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := scaleGroupService.Id(groupId).Mask("id").GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return result.Id != nil && *result.Id == groupId, nil
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func getLocationGroupRegionalId(sess *session.Session, locationGroupRegionalName string) (int, error) {
@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -41,12 +43,13 @@ var IBMComputeAutoScalePolicyObjectMask = []string{
 
 func resourceIBMComputeAutoScalePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeAutoScalePolicyCreate,
-		Read:     resourceIBMComputeAutoScalePolicyRead,
-		Update:   resourceIBMComputeAutoScalePolicyUpdate,
-		Delete:   resourceIBMComputeAutoScalePolicyDelete,
-		Exists:   resourceIBMComputeAutoScalePolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeAutoScalePolicyCreate,
+		ReadContext:   resourceIBMComputeAutoScalePolicyRead,
+		UpdateContext: resourceIBMComputeAutoScalePolicyUpdate,
+		DeleteContext: resourceIBMComputeAutoScalePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMComputeAutoScalePolicy, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -146,7 +149,7 @@ func resourceIBMComputeAutoScalePolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeAutoScalePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScalePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetScalePolicyService(sess.SetRetries(0))
 
@@ -160,7 +163,7 @@ func resourceIBMComputeAutoScalePolicyCreate(d *schema.ResourceData, meta interf
 	}
 
 	if *opts.Cooldown < 0 || *opts.Cooldown > 864000 {
-		return fmt.Errorf("Error retrieving scalePolicy: %s", "cooldown must be between 0 seconds and 10 days.")
+		return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", "cooldown must be between 0 seconds and 10 days."))
 	}
 
 	opts.ScaleActions = []datatypes.Scale_Policy_Action_Scale{{
@@ -171,58 +174,62 @@ func resourceIBMComputeAutoScalePolicyCreate(d *schema.ResourceData, meta interf
 	opts.ScaleActions[0].TypeId = sl.Int(1)
 
 	if *opts.ScaleActions[0].Amount <= 0 {
-		return fmt.Errorf("Error retrieving scalePolicy: %s", "scale_amount should be greater than 0.")
+		return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", "scale_amount should be greater than 0."))
 	}
 	if *opts.ScaleActions[0].ScaleType != "ABSOLUTE" && *opts.ScaleActions[0].ScaleType != "RELATIVE" && *opts.ScaleActions[0].ScaleType != "PERCENT" {
-		return fmt.Errorf("Error retrieving scalePolicy: %s", "scale_type should be ABSOLUTE, RELATIVE, or PERCENT.")
+		return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", "scale_type should be ABSOLUTE, RELATIVE, or PERCENT."))
 	}
 
 	if _, ok := d.GetOk("triggers"); ok {
 		err = validateTriggerTypes(d)
 		if err != nil {
-			return fmt.Errorf("Error retrieving scalePolicy: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", err))
 		}
 
 		opts.OneTimeTriggers, err = prepareOneTimeTriggers(d)
 		if err != nil {
-			return fmt.Errorf("Error retrieving scalePolicy: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", err))
 		}
 
 		opts.RepeatingTriggers, err = prepareRepeatingTriggers(d)
 		if err != nil {
-			return fmt.Errorf("Error retrieving scalePolicy: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", err))
 		}
 
 		opts.ResourceUseTriggers, err = prepareResourceUseTriggers(d)
 		if err != nil {
-			return fmt.Errorf("Error retrieving scalePolicy: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", err))
 		}
 	}
 
 	res, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Scale Policy: %s $s", err)
+		return diagFromErr(fmt.Errorf("Error creating Scale Policy: %s $s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
 	log.Printf("[INFO] Scale Polocy: %d", res.Id)
 
-	return resourceIBMComputeAutoScalePolicyRead(d, meta)
+	return resourceIBMComputeAutoScalePolicyRead(ctx, d, meta)
 }
 
-func resourceIBMComputeAutoScalePolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScalePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetScalePolicyService(sess)
 
 	scalePolicyId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid scale policy ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid scale policy ID, must be an integer: %s", err))
 	}
 
 	log.Printf("[INFO] Reading Scale Polocy: %d", scalePolicyId)
 	scalePolicy, err := service.Id(scalePolicyId).Mask(strings.Join(IBMComputeAutoScalePolicyObjectMask, ";")).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Scale Policy: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving Scale Policy: %s", err))
 	}
 
 	d.Set("name", scalePolicy.Name)
@@ -240,7 +247,7 @@ func resourceIBMComputeAutoScalePolicyRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceIBMComputeAutoScalePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScalePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	scalePolicyService := services.GetScalePolicyService(sess)
@@ -249,12 +256,12 @@ func resourceIBMComputeAutoScalePolicyUpdate(d *schema.ResourceData, meta interf
 
 	scalePolicyId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid scale policy ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid scale policy ID, must be an integer: %s", err))
 	}
 
 	scalePolicy, err := scalePolicyService.Id(scalePolicyId).Mask(strings.Join(IBMComputeAutoScalePolicyObjectMask, ";")).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving scalePolicy: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", err))
 	}
 
 	var template datatypes.Scale_Policy
@@ -274,36 +281,36 @@ func resourceIBMComputeAutoScalePolicyUpdate(d *schema.ResourceData, meta interf
 	if d.HasChange("scale_type") {
 		template.ScaleActions[0].ScaleType = sl.String(d.Get("scale_type").(string))
 		if *template.ScaleActions[0].ScaleType != "ABSOLUTE" && *template.ScaleActions[0].ScaleType != "RELATIVE" && *template.ScaleActions[0].ScaleType != "PERCENT" {
-			return fmt.Errorf("Error retrieving scalePolicy: %s", "scale_type should be ABSOLUTE, RELATIVE, or PERCENT.")
+			return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", "scale_type should be ABSOLUTE, RELATIVE, or PERCENT."))
 		}
 	}
 
 	if d.HasChange("scale_amount") {
 		template.ScaleActions[0].Amount = sl.Int(d.Get("scale_amount").(int))
 		if *template.ScaleActions[0].Amount <= 0 {
-			return fmt.Errorf("Error retrieving scalePolicy: %s", "scale_amount should be greater than 0.")
+			return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", "scale_amount should be greater than 0."))
 		}
 	}
 
 	if d.HasChange("cooldown") {
 		template.Cooldown = sl.Int(d.Get("cooldown").(int))
 		if *template.Cooldown <= 0 || *template.Cooldown > 864000 {
-			return fmt.Errorf("Error retrieving scalePolicy: %s", "cooldown must be between 0 seconds and 10 days.")
+			return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", "cooldown must be between 0 seconds and 10 days."))
 		}
 	}
 
 	if _, ok := d.GetOk("triggers"); ok {
 		template.OneTimeTriggers, err = prepareOneTimeTriggers(d)
 		if err != nil {
-			return fmt.Errorf("Error retrieving scalePolicy: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", err))
 		}
 		template.RepeatingTriggers, err = prepareRepeatingTriggers(d)
 		if err != nil {
-			return fmt.Errorf("Error retrieving scalePolicy: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", err))
 		}
 		template.ResourceUseTriggers, err = prepareResourceUseTriggers(d)
 		if err != nil {
-			return fmt.Errorf("Error retrieving scalePolicy: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving scalePolicy: %s", err))
 		}
 	}
 
@@ -317,25 +324,25 @@ func resourceIBMComputeAutoScalePolicyUpdate(d *schema.ResourceData, meta interf
 	_, err = scalePolicyServiceNoRetry.Id(scalePolicyId).EditObject(&template)
 
 	if err != nil {
-		return fmt.Errorf("Error updating scalie policy: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating scalie policy: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMComputeAutoScalePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeAutoScalePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetScalePolicyService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting scale policy: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting scale policy: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting scale policy: %d", id)
 	_, err = service.Id(id).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting scale policy: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting scale policy: %s", err))
 	}
 
 	d.SetId("")
@@ -343,27 +350,6 @@ func resourceIBMComputeAutoScalePolicyDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceIBMComputeAutoScalePolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetScalePolicyService(sess)
-
-	policyId, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-	result, err := service.Id(policyId).Mask("id").GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return result.Id != nil && *result.Id == policyId, nil
-
-}
-
 func validateTriggerTypes(d *schema.ResourceData) error {
 	triggerLists := d.Get("triggers").(*schema.Set).List()
 	for _, triggerList := range triggerLists {
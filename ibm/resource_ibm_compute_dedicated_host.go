@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -24,12 +26,13 @@ var dedicatedHostPackageType = "DEDICATED_HOST"
 
 func resourceIBMComputeDedicatedHost() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeDedicatedHostCreate,
-		Read:     resourceIBMComputeDedicatedHostRead,
-		Delete:   resourceIBMComputeDedicatedHostDelete,
-		Exists:   resourceIBMComputeDedicatedHostExists,
-		Update:   resourceIBMComputeDedicatedHostUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeDedicatedHostCreate,
+		ReadContext:   resourceIBMComputeDedicatedHostRead,
+		DeleteContext: resourceIBMComputeDedicatedHostDelete,
+		UpdateContext: resourceIBMComputeDedicatedHostUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMComputeDedicatedHost, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"hostname": {
@@ -99,12 +102,12 @@ func resourceIBMComputeDedicatedHost() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	pkg, err := product.GetPackageByType(sess, dedicatedHostPackageType)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	datacenter := d.Get("datacenter").(string)
@@ -114,12 +117,12 @@ func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interfac
 	// Lookup the data center ID
 	dc, err := location.GetDatacenterByName(sess, datacenter)
 	if err != nil {
-		return fmt.Errorf("No data centers matching %s could be found", datacenter)
+		return diagFromErr(fmt.Errorf("No data centers matching %s could be found", datacenter))
 	}
 
 	rt, err := hardware.GetRouterByName(sess, router, "id")
 	if err != nil {
-		return fmt.Errorf("Error creating dedicated host: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating dedicated host: %s", err))
 	}
 
 	primaryBackendNetworkComponent := datatypes.Network_Component{
@@ -138,7 +141,7 @@ func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interfac
 	// 2. Get all prices for the package
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id, productItemMaskWithPriceLocationGroupID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	priceItems := []datatypes.Product_Item_Price{}
@@ -180,33 +183,33 @@ func resourceIBMComputeDedicatedHostCreate(d *schema.ResourceData, meta interfac
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		VerifyOrder(&productOrderContainer)
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated host: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of dedicated host: %s", err))
 	}
 	//place order
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated host: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of dedicated host: %s", err))
 	}
 
 	// wait for machine availability
-	dedicated, err := findDedicatedHostByOrderID(&hardware, d, meta)
+	dedicated, err := findDedicatedHostByOrderID(ctx, &hardware, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for dedicated host (%s) to become ready: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for dedicated host (%s) to become ready: %s", d.Id(), err))
 	}
 
 	id := *dedicated.(datatypes.Virtual_DedicatedHost).Id
 	d.SetId(fmt.Sprintf("%d", id))
-	return resourceIBMComputeDedicatedHostRead(d, meta)
+	return resourceIBMComputeDedicatedHostRead(ctx, d, meta)
 }
 
-func resourceIBMComputeDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetVirtualDedicatedHostService(meta.(ClientSession).SoftLayerSession())
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).Mask(
@@ -214,7 +217,11 @@ func resourceIBMComputeDedicatedHostRead(d *schema.ResourceData, meta interface{
 	).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving dedicated host: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving dedicated host: %s", err))
 	}
 
 	d.Set("hostname", result.Name)
@@ -226,76 +233,56 @@ func resourceIBMComputeDedicatedHostRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIBMComputeDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualDedicatedHostService(sess.SetRetries(0))
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving dedicated host: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving dedicated host: %s", err))
 	}
 
 	if d.HasChange("hostname") {
 		result.Name = sl.String(d.Get("hostname").(string))
 		_, err = service.Id(id).EditObject(&result)
 		if err != nil {
-			return fmt.Errorf("Couldn't update dedicated host: %s", err)
+			return diagFromErr(newAttributeError("hostname", "Couldn't update dedicated host", err))
 		}
 
 	}
-	return resourceIBMComputeDedicatedHostRead(d, meta)
+	return resourceIBMComputeDedicatedHostRead(ctx, d, meta)
 }
 
-func resourceIBMComputeDedicatedHostDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeDedicatedHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetVirtualDedicatedHostService(meta.(ClientSession).SoftLayerSession())
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	ok, err := service.Id(id).DeleteObject()
 
 	if err != nil {
-		return fmt.Errorf("Error deleting dedicated host: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting dedicated host: %s", err))
 	}
 
 	if !ok {
-		return fmt.Errorf(
-			"API reported it was unsuccessful in removing the dedicated host '%d'", id)
+		return diagFromErr(fmt.Errorf(
+			"API reported it was unsuccessful in removing the dedicated host '%d'", id))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMComputeDedicatedHostExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	service := services.GetVirtualDedicatedHostService(meta.(ClientSession).SoftLayerSession())
-	dedicatedID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(dedicatedID).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return result.Id != nil && *result.Id == dedicatedID, nil
-}
-
-func findDedicatedHostByOrderID(d *datatypes.Hardware, r *schema.ResourceData, meta interface{}) (interface{}, error) {
+func findDedicatedHostByOrderID(ctx context.Context, d *datatypes.Hardware, r *schema.ResourceData, meta interface{}) (interface{}, error) {
 	hostname := *d.Hostname
 
 	log.Printf("Waiting for dedicated host (%s) to have to be provisioned", hostname)
@@ -325,5 +312,5 @@ func findDedicatedHostByOrderID(d *datatypes.Hardware, r *schema.ResourceData, m
 		MinTimeout: 1 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
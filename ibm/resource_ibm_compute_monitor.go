@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -17,12 +19,13 @@ import (
 
 func resourceIBMComputeMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeMonitorCreate,
-		Read:     resourceIBMComputeMonitorRead,
-		Update:   resourceIBMComputeMonitorUpdate,
-		Delete:   resourceIBMComputeMonitorDelete,
-		Exists:   resourceIBMComputeMonitorExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeMonitorCreate,
+		ReadContext:   resourceIBMComputeMonitorRead,
+		UpdateContext: resourceIBMComputeMonitorUpdate,
+		DeleteContext: resourceIBMComputeMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMComputeMonitor, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -76,7 +79,7 @@ func resourceIBMComputeMonitor() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	virtualGuestService := services.GetVirtualGuestService(sess)
 	monitorService := services.GetNetworkMonitorVersion1QueryHostService(sess.SetRetries(0))
@@ -86,12 +89,12 @@ func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) e
 	if ipAddress == "" {
 		virtualGuest, err := virtualGuestService.Id(guestId).GetObject()
 		if err != nil {
-			return fmt.Errorf("Error looking up virtual guest %d: %s", guestId, err)
+			return diagFromErr(fmt.Errorf("Error looking up virtual guest %d: %s", guestId, err))
 		}
 
 		if virtualGuest.PrimaryIpAddress == nil {
-			return fmt.Errorf(
-				"No primary ip address found for virtual guest %d. Please specify it.", guestId)
+			return diagFromErr(fmt.Errorf(
+				"No primary ip address found for virtual guest %d. Please specify it.", guestId))
 		}
 
 		ipAddress = *virtualGuest.PrimaryIpAddress
@@ -111,7 +114,7 @@ func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) e
 	// Create a monitor
 	res, err := monitorService.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Basic Monitor : %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Basic Monitor : %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
@@ -119,10 +122,10 @@ func resourceIBMComputeMonitorCreate(d *schema.ResourceData, meta interface{}) e
 
 	err = createNotifications(d, meta, guestId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMComputeMonitorRead(d, meta)
+	return resourceIBMComputeMonitorRead(ctx, d, meta)
 }
 
 func createNotifications(d *schema.ResourceData, meta interface{}, guestId int) error {
@@ -165,8 +168,7 @@ func notificationExists(notificationLinks []datatypes.User_Customer_Notification
 	return false
 }
 
-func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMComputeMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkMonitorVersion1QueryHostService(sess)
 	virtualGuestService := services.GetVirtualGuestService(sess)
@@ -182,7 +184,7 @@ func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) err
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Basic Monitor : %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Basic Monitor : %s", err))
 	}
 
 	guestId := *basicMonitor.GuestId
@@ -195,7 +197,7 @@ func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) err
 
 	notificationLinks, err := virtualGuestService.Id(guestId).GetMonitoringUserNotification()
 	if err != nil {
-		return fmt.Errorf("Error looking up user notifications for virtual guest %d", guestId)
+		return diagFromErr(fmt.Errorf("Error looking up user notifications for virtual guest %d", guestId))
 	}
 
 	notificationUserIds := schema.NewSet(func(v interface{}) int { return v.(int) }, make([]interface{}, 0, len(notificationLinks)))
@@ -226,7 +228,7 @@ func resourceIBMComputeMonitorRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMComputeMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	serviceNoRetry := services.GetNetworkMonitorVersion1QueryHostService(sess.SetRetries(0))
@@ -237,7 +239,7 @@ func resourceIBMComputeMonitorUpdate(d *schema.ResourceData, meta interface{}) e
 
 	basicMonitor, err := service.Id(basicMonitorId).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Basic Monitor : %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Basic Monitor : %s", err))
 	}
 	if d.HasChange("query_type_id") {
 		basicMonitor.QueryTypeId = sl.Int(d.Get("query_type_id").(int))
@@ -251,20 +253,20 @@ func resourceIBMComputeMonitorUpdate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = serviceNoRetry.Id(basicMonitorId).EditObject(&basicMonitor)
 	if err != nil {
-		return fmt.Errorf("Error editing Basic Monitor : %s", err)
+		return diagFromErr(fmt.Errorf("Error editing Basic Monitor : %s", err))
 	}
 
 	// Will only create notification objects for user/vm relationships that
 	// don't exist yet.
 	err = createNotifications(d, meta, guestId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMComputeMonitorRead(d, meta)
+	return resourceIBMComputeMonitorRead(ctx, d, meta)
 }
 
-func resourceIBMComputeMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkMonitorVersion1QueryHostService(sess)
 
@@ -274,30 +276,9 @@ func resourceIBMComputeMonitorDelete(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Deleting Basic Monitor : %d", id)
 	_, err = service.Id(id).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Basic Monitor : %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Basic Monitor : %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMComputeMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetNetworkMonitorVersion1QueryHostService(sess)
-
-	basicMonitorId, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(basicMonitorId).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error retrieving basic monitor info: %s", err)
-	}
-	return *result.Id == basicMonitorId, nil
-}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/filter"
@@ -23,12 +25,13 @@ import (
 
 func resourceIBMComputePlacementGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputePlacementGroupCreate,
-		Read:     resourceIBMComputePlacementGroupRead,
-		Update:   resourceIBMComputePlacementGroupUpdate,
-		Delete:   resourceIBMComputePlacementGroupDelete,
-		Exists:   resourceIBMComputePlacementGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputePlacementGroupCreate,
+		ReadContext:   resourceIBMComputePlacementGroupRead,
+		UpdateContext: resourceIBMComputePlacementGroupUpdate,
+		DeleteContext: resourceIBMComputePlacementGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMComputePlacementGroup, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -78,7 +81,7 @@ func resourceIBMComputePlacementGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	name := d.Get("name").(string)
 	datacenter := d.Get("datacenter").(string)
@@ -91,7 +94,7 @@ func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interfa
 	// 1.Getting the router ID
 	routerids, err := PodService.Filter(filter.Path("datacenterName").Eq(datacenter).Build()).Mask(podMask).GetAllObjects()
 	if err != nil {
-		return fmt.Errorf("Encountered problem trying to get the router ID: %s", err)
+		return diagFromErr(fmt.Errorf("Encountered problem trying to get the router ID: %s", err))
 	}
 	var routerid int
 	for _, iterate := range routerids {
@@ -105,7 +108,7 @@ func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interfa
 		Mask("id,name").
 		Filter(filter.Path("name").Eq(rule).Build()).GetObject()
 	if err != nil {
-		return fmt.Errorf("Encountered problem trying to get the placement group rule ID: %s", err)
+		return diagFromErr(fmt.Errorf("Encountered problem trying to get the placement group rule ID: %s", err))
 	}
 
 	opts := datatypes.Virtual_PlacementGroup{
@@ -118,16 +121,16 @@ func resourceIBMComputePlacementGroupCreate(d *schema.ResourceData, meta interfa
 
 	pgrp, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Placement Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Placement Group: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*pgrp.Id))
 	log.Printf("[INFO] Placement Group ID: %d", *pgrp.Id)
 
-	return resourceIBMComputePlacementGroupRead(d, meta)
+	return resourceIBMComputePlacementGroupRead(ctx, d, meta)
 }
 
-func resourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualPlacementGroupService(sess)
 
@@ -141,7 +144,7 @@ func resourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interface
 				return nil
 			}
 		}
-		return fmt.Errorf("Error retrieving Placement Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Placement Group: %s", err))
 	}
 
 	d.Set("name", pgrp.Name)
@@ -155,7 +158,7 @@ func resourceIBMComputePlacementGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceIBMComputePlacementGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualPlacementGroupService(sess.SetRetries(0))
 
@@ -168,36 +171,14 @@ func resourceIBMComputePlacementGroupUpdate(d *schema.ResourceData, meta interfa
 		_, err := service.Id(pgrpID).EditObject(&opts)
 
 		if err != nil {
-			return fmt.Errorf("Error editing Placement Group: %s", err)
+			return diagFromErr(newAttributeError("name", "Error editing Placement Group", err))
 		}
 	}
 
 	return nil
 }
 
-func resourceIBMComputePlacementGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetVirtualPlacementGroupService(sess)
-
-	pgrpID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(pgrpID).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return result.Id != nil && *result.Id == pgrpID, nil
-}
-
-func resourceIBMComputePlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputePlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualPlacementGroupService(sess)
 
@@ -228,14 +209,14 @@ func resourceIBMComputePlacementGroupDelete(d *schema.ResourceData, meta interfa
 			return vms, noVms, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	_, err = service.Id(pgrpID).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Placement Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Placement Group: %s", err))
 	}
 
 	return nil
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -17,12 +19,13 @@ import (
 
 func resourceIBMComputeProvisioningHook() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeProvisioningHookCreate,
-		Read:     resourceIBMComputeProvisioningHookRead,
-		Update:   resourceIBMComputeProvisioningHookUpdate,
-		Delete:   resourceIBMComputeProvisioningHookDelete,
-		Exists:   resourceIBMComputeProvisioningHookExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeProvisioningHookCreate,
+		ReadContext:   resourceIBMComputeProvisioningHookRead,
+		UpdateContext: resourceIBMComputeProvisioningHookUpdate,
+		DeleteContext: resourceIBMComputeProvisioningHookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMComputeProvisioningHook, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -48,7 +51,7 @@ func resourceIBMComputeProvisioningHook() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeProvisioningHookCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess.SetRetries(0))
 
@@ -59,16 +62,16 @@ func resourceIBMComputeProvisioningHookCreate(d *schema.ResourceData, meta inter
 
 	hook, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Provisioning Hook: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Provisioning Hook: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*hook.Id))
 	log.Printf("[INFO] Provisioning Hook ID: %d", *hook.Id)
 
-	return resourceIBMComputeProvisioningHookRead(d, meta)
+	return resourceIBMComputeProvisioningHookRead(ctx, d, meta)
 }
 
-func resourceIBMComputeProvisioningHookRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess)
 
@@ -82,7 +85,7 @@ func resourceIBMComputeProvisioningHookRead(d *schema.ResourceData, meta interfa
 				return nil
 			}
 		}
-		return fmt.Errorf("Error retrieving Provisioning Hook: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Provisioning Hook: %s", err))
 	}
 
 	d.Set("name", hook.Name)
@@ -91,7 +94,7 @@ func resourceIBMComputeProvisioningHookRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceIBMComputeProvisioningHookUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess.SetRetries(0))
 
@@ -111,12 +114,12 @@ func resourceIBMComputeProvisioningHookUpdate(d *schema.ResourceData, meta inter
 	_, err := service.Id(hookId).EditObject(&opts)
 
 	if err != nil {
-		return fmt.Errorf("Error editing Provisioning Hook: %s", err)
+		return diagFromErr(fmt.Errorf("Error editing Provisioning Hook: %s", err))
 	}
 	return nil
 }
 
-func resourceIBMComputeProvisioningHookDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeProvisioningHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetProvisioningHookService(sess)
 
@@ -124,29 +127,8 @@ func resourceIBMComputeProvisioningHookDelete(d *schema.ResourceData, meta inter
 	log.Printf("[INFO] Deleting Provisioning Hook: %d", hookId)
 	_, err = service.Id(hookId).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Provisioning Hook: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Provisioning Hook: %s", err))
 	}
 
 	return nil
 }
-
-func resourceIBMComputeProvisioningHookExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetProvisioningHookService(sess)
-
-	hookId, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(hookId).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return result.Id != nil && *result.Id == hookId, nil
-}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -20,12 +22,13 @@ import (
 
 func resourceIBMComputeSSHKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeSSHKeyCreate,
-		Read:     resourceIBMComputeSSHKeyRead,
-		Update:   resourceIBMComputeSSHKeyUpdate,
-		Delete:   resourceIBMComputeSSHKeyDelete,
-		Exists:   resourceIBMComputeSSHKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeSSHKeyCreate,
+		ReadContext:   resourceIBMComputeSSHKeyRead,
+		UpdateContext: resourceIBMComputeSSHKeyUpdate,
+		DeleteContext: resourceIBMComputeSSHKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMComputeSSHKey, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"label": {
@@ -68,7 +71,7 @@ func resourceIBMComputeSSHKey() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
@@ -79,7 +82,7 @@ func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 	fingerprint, err := computeSSHKeyFingerprint(key)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	keys, err := services.GetAccountService(sess).
@@ -108,7 +111,7 @@ func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 		if editKey {
 			_, err = service.Id(id).EditObject(&slKey)
-			return err
+			return diagFromErr(err)
 		}
 
 		return nil
@@ -126,16 +129,16 @@ func resourceIBMComputeSSHKeyCreate(d *schema.ResourceData, meta interface{}) er
 
 	res, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating SSH Key: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating SSH Key: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
 	log.Printf("[INFO] SSH Key: %d", *res.Id)
 
-	return resourceIBMComputeSSHKeyRead(d, meta)
+	return resourceIBMComputeSSHKeyRead(ctx, d, meta)
 }
 
-func resourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
@@ -148,7 +151,7 @@ func resourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving SSH key: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving SSH key: %s", err))
 	}
 
 	d.Set("label", key.Label)
@@ -158,7 +161,7 @@ func resourceIBMComputeSSHKeyRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMComputeSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
@@ -166,7 +169,7 @@ func resourceIBMComputeSSHKeyUpdate(d *schema.ResourceData, meta interface{}) er
 
 	key, err := service.Id(keyID).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving SSH key: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving SSH key: %s", err))
 	}
 
 	if d.HasChange("label") {
@@ -179,51 +182,30 @@ func resourceIBMComputeSSHKeyUpdate(d *schema.ResourceData, meta interface{}) er
 
 	_, err = service.Id(keyID).EditObject(&key)
 	if err != nil {
-		return fmt.Errorf("Error editing SSH key: %s", err)
+		return diagFromErr(fmt.Errorf("Error editing SSH key: %s", err))
 	}
-	return resourceIBMComputeSSHKeyRead(d, meta)
+	return resourceIBMComputeSSHKeyRead(ctx, d, meta)
 }
 
-func resourceIBMComputeSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecuritySshKeyService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting SSH Key: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting SSH Key: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting SSH key: %d", id)
 	_, err = service.Id(id).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting SSH key: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting SSH key: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMComputeSSHKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetSecuritySshKeyService(sess)
-
-	keyID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(keyID).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return result.Id != nil && *result.Id == keyID, nil
-}
-
 func computeSSHKeyFingerprint(key string) (fingerprint string, err error) {
 	parts := strings.Fields(key)
 	if len(parts) < 2 {
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -17,12 +19,13 @@ import (
 
 func resourceIBMComputeSSLCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeSSLCertificateCreate,
-		Read:     resourceIBMComputeSSLCertificateRead,
-		Update:   resourceIBMComputeSSLCertificateUpdate,
-		Delete:   resourceIBMComputeSSLCertificateDelete,
-		Exists:   resourceIBMComputeSSLCertificateExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeSSLCertificateCreate,
+		ReadContext:   resourceIBMComputeSSLCertificateRead,
+		UpdateContext: resourceIBMComputeSSLCertificateUpdate,
+		DeleteContext: resourceIBMComputeSSLCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMComputeSSLCertificate, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -110,7 +113,7 @@ func resourceIBMComputeSSLCertificate() *schema.Resource {
 	}
 }
 
-func resourceIBMComputeSSLCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSLCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateService(sess.SetRetries(0))
 
@@ -125,27 +128,31 @@ func resourceIBMComputeSSLCertificateCreate(d *schema.ResourceData, meta interfa
 	cert, err := service.CreateObject(&template)
 
 	if err != nil {
-		return fmt.Errorf("Error creating Security Certificate: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Security Certificate: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *cert.Id))
 
-	return resourceIBMComputeSSLCertificateRead(d, meta)
+	return resourceIBMComputeSSLCertificateRead(ctx, d, meta)
 }
 
-func resourceIBMComputeSSLCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSLCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	cert, err := service.Id(id).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Unable to get Security Certificate: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Unable to get Security Certificate: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *cert.Id))
@@ -172,44 +179,23 @@ func resourceIBMComputeSSLCertificateRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceIBMComputeSSLCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSLCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMComputeSSLCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeSSLCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetSecurityCertificateService(sess)
 	id, err := strconv.Atoi(d.Id())
 	_, err = service.Id(id).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Security Certificate %d: %s", id, err)
+		return diagFromErr(fmt.Errorf("Error deleting Security Certificate %d: %s", id, err))
 	}
 
 	return nil
 }
 
-func resourceIBMComputeSSLCertificateExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetSecurityCertificateService(sess)
-
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	cert, err := service.Id(id).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return cert.Id != nil && *cert.Id == id, nil
-}
-
 func normalizeCert(cert interface{}) string {
 	if cert == nil || cert == (*string)(nil) {
 		return ""
@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
@@ -16,6 +17,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -62,12 +64,13 @@ const (
 
 func resourceIBMComputeVmInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMComputeVmInstanceCreate,
-		Read:     resourceIBMComputeVmInstanceRead,
-		Update:   resourceIBMComputeVmInstanceUpdate,
-		Delete:   resourceIBMComputeVmInstanceDelete,
-		Exists:   resourceIBMComputeVmInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMComputeVmInstanceCreate,
+		ReadContext:   resourceIBMComputeVmInstanceRead,
+		UpdateContext: resourceIBMComputeVmInstanceUpdate,
+		DeleteContext: resourceIBMComputeVmInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMComputeVmInstance, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"hostname": {
@@ -903,7 +906,7 @@ func getVirtualGuestTemplateFromResourceData(d *schema.ResourceData, meta interf
 	return vms, nil
 }
 
-func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualGuestService(sess)
@@ -925,11 +928,11 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if dcName == "" && len(retryOptions) == 0 {
-		return fmt.Errorf("Provide either `datacenter` or `datacenter_choice`")
+		return diagFromErr(fmt.Errorf("Provide either `datacenter` or `datacenter_choice`"))
 	}
 
 	if (d.Get("hostname").(string) == "" || d.Get("domain").(string) == "") && len(d.Get("bulk_vms").(*schema.Set).List()) == 0 {
-		return fmt.Errorf("Provide either `hostname` and `domain` or `bulk_vms`")
+		return diagFromErr(fmt.Errorf("Provide either `hostname` and `domain` or `bulk_vms`"))
 	}
 
 	if dcName != "" {
@@ -948,11 +951,11 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 
 		err := validateDatacenterOption(retryOptions, []string{"datacenter", "public_vlan_id", "private_vlan_id"})
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		for _, option := range retryOptions {
 			if option == nil {
-				return fmt.Errorf("Provide a valid `datacenter_choice`")
+				return diagFromErr(fmt.Errorf("Provide a valid `datacenter_choice`"))
 			}
 			center := option.(map[string]interface{})
 			var publicVlan, privateVlan int
@@ -961,7 +964,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 			if v, ok := center["datacenter"]; ok {
 				name = v.(string)
 			} else {
-				return fmt.Errorf("Missing datacenter in `datacenter_choice`")
+				return diagFromErr(fmt.Errorf("Missing datacenter in `datacenter_choice`"))
 			}
 
 			if v, ok := center["public_vlan_id"]; ok {
@@ -980,7 +983,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if err1 != nil {
-		return fmt.Errorf("Error ordering virtual guest: %s", err1)
+		return diagFromErr(fmt.Errorf("Error ordering virtual guest: %s", err1))
 	}
 
 	var idStrings []string
@@ -998,7 +1001,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 	for _, str := range idStrings {
 		id, err = strconv.Atoi(str)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		// Set tags
 		tags := getTags(d)
@@ -1006,7 +1009,7 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 			//Try setting only when it is non empty as we are creating virtual guest
 			err = setGuestTags(id, tags, meta)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		}
 
@@ -1021,38 +1024,38 @@ func resourceIBMComputeVmInstanceCreate(d *schema.ResourceData, meta interface{}
 		if len(storageIds) > 0 {
 			err := addAccessToStorageList(service.Id(id), id, storageIds, meta)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		}
 
 		// Set notes
 		err = setNotes(id, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		// wait for machine availability
 
-		_, err = WaitForVirtualGuestAvailable(id, d, meta)
+		_, err = WaitForVirtualGuestAvailable(ctx, id, d, meta)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for virtual machine (%s) to become ready: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for virtual machine (%s) to become ready: %s", d.Id(), err))
 		}
 	}
 
-	return resourceIBMComputeVmInstanceRead(d, meta)
+	return resourceIBMComputeVmInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service := services.GetVirtualGuestService(meta.(ClientSession).SoftLayerSession())
 	parts, err := vmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).Mask(
@@ -1074,7 +1077,11 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving virtual guest: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving virtual guest: %s", err))
 	}
 
 	if len(parts) == 1 {
@@ -1085,7 +1092,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 		for _, part := range parts {
 			vmId, err := strconv.Atoi(part)
 			if err != nil {
-				return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+				return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 			}
 			vmResult, err := service.Id(vmId).Mask(
 				"hostname,domain",
@@ -1272,7 +1279,7 @@ func resourceIBMComputeVmInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set(ResourceName, *result.Hostname)
 	d.Set(ResourceStatus, *result.Status.Name)
 	err = readSecondaryIPAddresses(d, meta, result.PrimaryIpAddress)
-	return err
+	return diagFromErr(err)
 }
 
 func readSecondaryIPAddresses(d *schema.ResourceData, meta interface{}, primaryIPAddress *string) error {
@@ -1302,23 +1309,23 @@ func readSecondaryIPAddresses(d *schema.ResourceData, meta interface{}, primaryI
 	}
 	return nil
 }
-func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualGuestService(sess)
 
 	parts, err := vmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving virtual guest: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving virtual guest: %s", err))
 	}
 
 	isChanged := false
@@ -1339,7 +1346,7 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 	if isChanged {
 		_, err = service.Id(id).EditObject(&result)
 		if err != nil {
-			return fmt.Errorf("Couldn't update virtual guest: %s", err)
+			return diagFromErr(fmt.Errorf("Couldn't update virtual guest: %s", err))
 		}
 	}
 
@@ -1348,13 +1355,13 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 		tags := getTags(d)
 		err := setGuestTags(id, tags, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	err = modifyStorageAccess(service.Id(id), id, meta, d)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Upgrade "cores", "memory" and "network_speed" if provided and changed
@@ -1382,7 +1389,7 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 
 		//Remove is not supported for now.
 		if len(oldDisk) > len(newDisk) {
-			return fmt.Errorf("Removing drives is not supported.")
+			return diagFromErr(fmt.Errorf("Removing drives is not supported."))
 		}
 
 		var diskName string
@@ -1418,30 +1425,30 @@ func resourceIBMComputeVmInstanceUpdate(d *schema.ResourceData, meta interface{}
 			presetKeyName := d.Get("flavor_key_name").(string)
 			_, err = virtual.UpgradeVirtualGuestWithPreset(sess.SetRetries(0), &result, presetKeyName, upgradeOptions)
 			if err != nil {
-				return fmt.Errorf("Couldn't upgrade virtual guest: %s", err)
+				return diagFromErr(fmt.Errorf("Couldn't upgrade virtual guest: %s", err))
 			}
 
 		} else {
 			_, err = virtual.UpgradeVirtualGuest(sess.SetRetries(0), &result, upgradeOptions)
 			if err != nil {
-				return fmt.Errorf("Couldn't upgrade virtual guest: %s", err)
+				return diagFromErr(fmt.Errorf("Couldn't upgrade virtual guest: %s", err))
 			}
 		}
 
 		// Wait for softlayer to start upgrading...
-		_, err = WaitForUpgradeTransactionsToAppear(d, meta)
+		_, err = WaitForUpgradeTransactionsToAppear(ctx, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		// Wait for upgrade transactions to finish
-		_, err = WaitForNoActiveTransactions(id, d, meta)
+		_, err = WaitForNoActiveTransactions(ctx, id, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 	}
 
-	return resourceIBMComputeVmInstanceRead(d, meta)
+	return resourceIBMComputeVmInstanceRead(ctx, d, meta)
 }
 
 func modifyStorageAccess(sam storageAccessModifier, deviceID int, meta interface{}, d *schema.ResourceData) error {
@@ -1478,36 +1485,36 @@ func modifyStorageAccess(sam storageAccessModifier, deviceID int, meta interface
 	return nil
 }
 
-func resourceIBMComputeVmInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMComputeVmInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetVirtualGuestService(sess)
 	parts, err := vmIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	for _, part := range parts {
 		id, err := strconv.Atoi(part)
 		if err != nil {
-			return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+			return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 		}
 
-		_, err = WaitForNoActiveTransactions(id, d, meta)
+		_, err = WaitForNoActiveTransactions(ctx, id, d, meta)
 
 		if err != nil {
-			return fmt.Errorf("Error deleting virtual guest, couldn't wait for zero active transactions: %s", err)
+			return diagFromErr(fmt.Errorf("Error deleting virtual guest, couldn't wait for zero active transactions: %s", err))
 		}
 		err = detachSecurityGroupNetworkComponentBindings(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		ok, err := service.Id(id).DeleteObject()
 		if err != nil {
-			return fmt.Errorf("Error deleting virtual guest: %s", err)
+			return diagFromErr(fmt.Errorf("Error deleting virtual guest: %s", err))
 		}
 
 		if !ok {
-			return fmt.Errorf(
-				"API reported it was unsuccessful in removing the virtual guest '%d'", id)
+			return diagFromErr(fmt.Errorf(
+				"API reported it was unsuccessful in removing the virtual guest '%d'", id))
 		}
 	}
 
@@ -1580,7 +1587,7 @@ func genID() (interface{}, error) {
 }
 
 // WaitForUpgradeTransactionsToAppear Wait for upgrade transactions
-func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForUpgradeTransactionsToAppear(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to have upgrade transactions", d.Id())
 
 	parts, err := vmIdParts(d.Id())
@@ -1616,11 +1623,11 @@ func WaitForUpgradeTransactionsToAppear(d *schema.ResourceData, meta interface{}
 		MinTimeout: 5 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForNoActiveTransactions Wait for no active transactions
-func WaitForNoActiveTransactions(id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForNoActiveTransactions(ctx context.Context, id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to have zero active transactions", d.Id())
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"retry", activeTransaction},
@@ -1644,11 +1651,11 @@ func WaitForNoActiveTransactions(id int, d *schema.ResourceData, meta interface{
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForVirtualGuestAvailable Waits for virtual guest creation
-func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForVirtualGuestAvailable(ctx context.Context, id int, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for server (%s) to be available.", d.Id())
 	sess := meta.(ClientSession).SoftLayerSession()
 	stateConf := &resource.StateChangeConf{
@@ -1660,7 +1667,7 @@ func WaitForVirtualGuestAvailable(id int, d *schema.ResourceData, meta interface
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func virtualGuestStateRefreshFunc(sess *session.Session, instanceID int, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -1711,30 +1718,6 @@ func virtualGuestStateRefreshFunc(sess *session.Session, instanceID int, d *sche
 	}
 }
 
-func resourceIBMComputeVmInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	service := services.GetVirtualGuestService(meta.(ClientSession).SoftLayerSession())
-	parts, err := vmIdParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	guestID, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(guestID).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return result.Id != nil && *result.Id == guestID, nil
-}
-
 func getTags(d dataRetriever) string {
 	tagSet := d.Get("tags").(*schema.Set)
 
@@ -5,10 +5,12 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -19,12 +21,13 @@ import (
 
 func resourceIBMContainerAddOns() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerAddOnsCreate,
-		Read:     resourceIBMContainerAddOnsRead,
-		Update:   resourceIBMContainerAddOnsUpdate,
-		Delete:   resourceIBMContainerAddOnsDelete,
-		Exists:   resourceIBMContainerAddOnsExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerAddOnsCreate,
+		ReadContext:   resourceIBMContainerAddOnsRead,
+		UpdateContext: resourceIBMContainerAddOnsUpdate,
+		DeleteContext: resourceIBMContainerAddOnsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerAddOns, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -113,36 +116,36 @@ func resourceIBMContainerAddOns() *schema.Resource {
 		},
 	}
 }
-func resourceIBMContainerAddOnsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cluster := d.Get("cluster").(string)
 
 	payload, err := expandAddOns(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting addons from expandAddOns %s", err)
+		return diagFromErr(fmt.Errorf("Error in getting addons from expandAddOns %s", err))
 	}
 	payload.Enable = true
 	_, err = addOnAPI.ConfigureAddons(cluster, &payload, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "Request failed with status code: 409") {
-		return err
+		return diagFromErr(err)
 	}
-	_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutCreate)
+	_, err = waitForContainerAddOns(ctx, d, meta, cluster, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for Enabling Addon (%s) : %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for Enabling Addon (%s) : %s", d.Id(), err))
 	}
 	d.SetId(cluster)
 
-	return resourceIBMContainerAddOnsRead(d, meta)
+	return resourceIBMContainerAddOnsRead(ctx, d, meta)
 }
 func expandAddOns(d *schema.ResourceData) (addOns v1.ConfigureAddOns, err error) {
 	addOnSet := d.Get("addons").(*schema.Set).List()
@@ -158,22 +161,26 @@ func expandAddOns(d *schema.ResourceData) (addOns v1.ConfigureAddOns, err error)
 	}
 	return addOns, nil
 }
-func resourceIBMContainerAddOnsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cluster := d.Id()
 
 	result, err := addOnAPI.GetAddons(cluster, targetEnv)
 	if err != nil {
-		return err
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
 	}
 	d.Set("cluster", cluster)
 	addOns, err := flattenAddOns(result)
@@ -222,16 +229,16 @@ func flattenAddOns(result []v1.AddOn) (resp *schema.Set, err error) {
 
 	return schema.NewSet(resourceIBMContainerAddonsHash, addOns), nil
 }
-func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cluster := d.Id()
 
@@ -261,12 +268,12 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 					updateList.Update = true
 					_, err = addOnAPI.ConfigureAddons(cluster, &updateList, targetEnv)
 					if err != nil && !strings.Contains(err.Error(), "Request failed with status code: 409") {
-						return err
+						return diagFromErr(err)
 					}
-					_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutUpdate)
+					_, err = waitForContainerAddOns(ctx, d, meta, cluster, schema.TimeoutUpdate)
 					if err != nil {
-						return fmt.Errorf(
-							"Error waiting for Updating Addon (%s) : %s", d.Id(), err)
+						return diagFromErr(fmt.Errorf(
+							"Error waiting for Updating Addon (%s) : %s", d.Id(), err))
 					}
 					ns.Remove(nA)
 					os.Remove(oA)
@@ -292,12 +299,12 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 			addOnParams.Enable = true
 			_, err = addOnAPI.ConfigureAddons(cluster, &addOnParams, targetEnv)
 			if err != nil && !strings.Contains(err.Error(), "Request failed with status code: 409") {
-				return err
+				return diagFromErr(err)
 			}
-			_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutCreate)
+			_, err = waitForContainerAddOns(ctx, d, meta, cluster, schema.TimeoutCreate)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for Enabling Addon (%s) : %s", d.Id(), err)
+				return diagFromErr(fmt.Errorf(
+					"Error waiting for Enabling Addon (%s) : %s", d.Id(), err))
 			}
 		}
 		if len(remove) > 0 {
@@ -315,39 +322,39 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 			addOnParams.Enable = false
 			_, err = addOnAPI.ConfigureAddons(cluster, &addOnParams, targetEnv)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		}
 	}
 
-	return resourceIBMContainerAddOnsRead(d, meta)
+	return resourceIBMContainerAddOnsRead(ctx, d, meta)
 }
-func resourceIBMContainerAddOnsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAddOnsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	addOnAPI := csClient.AddOns()
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cluster := d.Id()
 	payload, err := expandAddOns(d)
 	if err != nil {
-		return fmt.Errorf("Error in getting addons from expandAddOns %s", err)
+		return diagFromErr(fmt.Errorf("Error in getting addons from expandAddOns %s", err))
 	}
 
 	payload.Enable = false
 	_, err = addOnAPI.ConfigureAddons(cluster, &payload, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
-func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, timeout string) (interface{}, error) {
+func waitForContainerAddOns(ctx context.Context, d *schema.ResourceData, meta interface{}, cluster, timeout string) (interface{}, error) {
 	addOnClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return false, err
@@ -380,35 +387,8 @@ func waitForContainerAddOns(d *schema.ResourceData, meta interface{}, cluster, t
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
-}
-func resourceIBMContainerAddOnsExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	csClient, err := meta.(ClientSession).ContainerAPI()
-	if err != nil {
-		return false, err
-	}
-	addOnAPI := csClient.AddOns()
-
-	targetEnv, err := getClusterTargetHeader(d, meta)
-	if err != nil {
-		return false, err
-	}
-	cluster := d.Id()
-
-	_, err = addOnAPI.GetAddons(cluster, targetEnv)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return true, nil
+	return stateConf.WaitForStateContext(ctx)
 }
-
 func resourceIBMContainerAddonsHash(v interface{}) int {
 	var buf bytes.Buffer
 	a := v.(map[string]interface{})
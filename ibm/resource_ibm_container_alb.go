@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -17,11 +19,13 @@ import (
 
 func resourceIBMContainerALB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerALBCreate,
-		Read:     resourceIBMContainerALBRead,
-		Update:   resourceIBMContainerALBUpdate,
-		Delete:   resourceIBMContainerALBDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerALBCreate,
+		ReadContext:   resourceIBMContainerALBRead,
+		UpdateContext: resourceIBMContainerALBUpdate,
+		DeleteContext: resourceIBMContainerALBDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerALB, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -85,10 +89,10 @@ func resourceIBMContainerALB() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var userIP string
 	var enable, disableDeployment bool
@@ -98,7 +102,7 @@ func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) err
 	} else if v, ok := d.GetOkExists("disable_deployment"); ok {
 		disableDeployment = v.(bool)
 	} else {
-		return fmt.Errorf("Provide either `enable` or `disable_deployment`")
+		return diagFromErr(fmt.Errorf("Provide either `enable` or `disable_deployment`"))
 	}
 
 	numOfInstances := "2"
@@ -114,35 +118,35 @@ func resourceIBMContainerALBCreate(d *schema.ResourceData, meta interface{}) err
 		params.ALBIP = userIP
 	}
 
-	_, err = waitForClusterAvailable(d, meta, albID)
+	_, err = waitForClusterAvailable(ctx, d, meta, albID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err))
 	}
 
 	albAPI := albClient.Albs()
 	targetEnv, err := getAlbTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	err = albAPI.ConfigureALB(albID, params, disableDeployment, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(albID)
-	_, err = waitForContainerALB(d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
+	_, err = waitForContainerALB(ctx, d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create resource alb (%s) : %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for create resource alb (%s) : %s", d.Id(), err))
 	}
 
-	return resourceIBMContainerALBRead(d, meta)
+	return resourceIBMContainerALBRead(ctx, d, meta)
 }
 
-func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	albID := d.Id()
@@ -150,13 +154,14 @@ func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error
 	albAPI := albClient.Albs()
 	targetEnv, err := getAlbTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	albConfig, err := albAPI.GetALB(albID, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
+	d.Set("alb_id", albID)
 	d.Set("alb_type", albConfig.ALBType)
 	d.Set("cluster", albConfig.ClusterID)
 	d.Set("name", albConfig.Name)
@@ -169,10 +174,10 @@ func resourceIBMContainerALBRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMContainerALBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	albAPI := albClient.Albs()
 
@@ -187,30 +192,30 @@ func resourceIBMContainerALBUpdate(d *schema.ResourceData, meta interface{}) err
 
 		targetEnv, err := getAlbTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
-		_, err = waitForClusterAvailable(d, meta, albID)
+		_, err = waitForClusterAvailable(ctx, d, meta, albID)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for cluster resources availabilty (%s) : %s", d.Id(), err))
 		}
 
 		err = albAPI.ConfigureALB(albID, params, disableDeployment, targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		_, err = waitForContainerALB(d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
+		_, err = waitForContainerALB(ctx, d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for updating resource alb (%s) : %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for updating resource alb (%s) : %s", d.Id(), err))
 		}
 
 	}
-	return resourceIBMContainerALBRead(d, meta)
+	return resourceIBMContainerALBRead(ctx, d, meta)
 }
 
-func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
+func waitForContainerALB(ctx context.Context, d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
 	albClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return false, err
@@ -247,17 +252,17 @@ func waitForContainerALB(d *schema.ResourceData, meta interface{}, albID, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func resourceIBMContainerALBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 
 	return nil
 }
 
 // WaitForWorkerAvailable Waits for worker creation
-func waitForClusterAvailable(d *schema.ResourceData, meta interface{}, albID string) (interface{}, error) {
+func waitForClusterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, albID string) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -286,7 +291,7 @@ func waitForClusterAvailable(d *schema.ResourceData, meta interface{}, albID str
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 func getAlbTargetHeader(d *schema.ResourceData, meta interface{}) (v1.ClusterTargetHeader, error) {
 	var region string
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -17,12 +19,13 @@ import (
 
 func resourceIBMContainerALBCert() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerALBCertCreate,
-		Read:     resourceIBMContainerALBCertRead,
-		Update:   resourceIBMContainerALBCertUpdate,
-		Delete:   resourceIBMContainerALBCertDelete,
-		Exists:   resourceIBMContainerALBCertExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerALBCertCreate,
+		ReadContext:   resourceIBMContainerALBCertRead,
+		UpdateContext: resourceIBMContainerALBCertUpdate,
+		DeleteContext: resourceIBMContainerALBCertDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerALBCert, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -97,10 +100,10 @@ func resourceIBMContainerALBCert() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerALBCertCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	certCRN := d.Get("cert_crn").(string)
@@ -123,26 +126,26 @@ func resourceIBMContainerALBCertCreate(d *schema.ResourceData, meta interface{})
 	response, err := ingressAPI.CreateIngressSecret(params)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, secretName, response.Namespace))
-	_, err = waitForContainerALBCert(d, meta, schema.TimeoutCreate)
+	_, err = waitForContainerALBCert(ctx, d, meta, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create resource alb cert (%s) : %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for create resource alb cert (%s) : %s", d.Id(), err))
 	}
 
-	return resourceIBMContainerALBCertRead(d, meta)
+	return resourceIBMContainerALBCertRead(ctx, d, meta)
 }
 
-func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	clusterID := parts[0]
 	secretName := parts[1]
@@ -154,7 +157,11 @@ func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) e
 	ingressAPI := ingressClient.Ingresses()
 	ingressSecretConfig, err := ingressAPI.GetIngressSecret(clusterID, secretName, namespace)
 	if err != nil {
-		return err
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", clusterID, secretName, namespace))
 	d.Set("cluster_id", ingressSecretConfig.Cluster)
@@ -171,17 +178,17 @@ func resourceIBMContainerALBCertRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMContainerALBCertDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ingressAPI := ingressClient.Ingresses()
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	clusterID := parts[0]
 	secretName := parts[1]
@@ -197,17 +204,17 @@ func resourceIBMContainerALBCertDelete(d *schema.ResourceData, meta interface{})
 
 	err = ingressAPI.DeleteIngressSecret(params)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	_, albCertDeletionError := waitForALBCertDelete(d, meta, schema.TimeoutDelete)
+	_, albCertDeletionError := waitForALBCertDelete(ctx, d, meta, schema.TimeoutDelete)
 	if albCertDeletionError != nil {
-		return albCertDeletionError
+		return diagFromErr(albCertDeletionError)
 	}
 	d.SetId("")
 	return nil
 }
 
-func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
+func waitForALBCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
@@ -245,17 +252,17 @@ func waitForALBCertDelete(d *schema.ResourceData, meta interface{}, timeout stri
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerALBCertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cluster := parts[0]
 	secretName := parts[1]
@@ -277,51 +284,19 @@ func resourceIBMContainerALBCertUpdate(d *schema.ResourceData, meta interface{})
 		ingressAPI := ingressClient.Ingresses()
 		_, err = ingressAPI.UpdateIngressSecret(params)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
-		_, err = waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
+		_, err = waitForContainerALBCert(ctx, d, meta, schema.TimeoutUpdate)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for updating resource alb cert (%s) : %s", d.Id(), err)
-		}
-	}
-	return resourceIBMContainerALBCertRead(d, meta)
-}
-
-func resourceIBMContainerALBCertExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
-	if err != nil {
-		return false, err
-	}
-
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	clusterID := parts[0]
-	secretName := parts[1]
-	namespace := "ibm-cert-store"
-	if len(parts) > 2 && len(parts[2]) > 0 {
-		namespace = parts[2]
-	}
-
-	ingressAPI := ingressClient.Ingresses()
-	ingressSecretConfig, err := ingressAPI.GetIngressSecret(clusterID, secretName, namespace)
-
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for updating resource alb cert (%s) : %s", d.Id(), err))
 		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
 	}
-
-	return ingressSecretConfig.Cluster == clusterID && ingressSecretConfig.Name == secretName, nil
+	return resourceIBMContainerALBCertRead(ctx, d, meta)
 }
 
-func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
+func waitForContainerALBCert(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
 	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
@@ -366,5 +341,5 @@ func waitForContainerALBCert(d *schema.ResourceData, meta interface{}, timeout s
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
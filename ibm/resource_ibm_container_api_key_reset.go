@@ -4,17 +4,22 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMContainerAPIKeyReset() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMContainerAPIKeyResetUpdate,
-		Read:   resourceIBMContainerAPIKeyResetRead,
-		Update: resourceIBMContainerAPIKeyResetUpdate,
-		Delete: resourceIBMContainerAPIKeyResetdelete,
+		CreateContext: resourceIBMContainerAPIKeyResetUpdate,
+		ReadContext:   resourceIBMContainerAPIKeyResetRead,
+		UpdateContext: resourceIBMContainerAPIKeyResetUpdate,
+		DeleteContext: resourceIBMContainerAPIKeyResetdelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerAPIKeyReset, importIDParts("region", "resource_group_id")),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -27,6 +32,7 @@ func resourceIBMContainerAPIKeyReset() *schema.Resource {
 				Type:        schema.TypeString,
 				ForceNew:    true,
 				Optional:    true,
+				Computed:    true,
 				Description: "ID of Resource Group",
 			},
 			"reset_api_key": {
@@ -39,41 +45,42 @@ func resourceIBMContainerAPIKeyReset() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerAPIKeyResetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAPIKeyResetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("reset_api_key") {
 		apikeyClient, err := meta.(ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		apikeyAPI := apikeyClient.Apikeys()
 		region := d.Get("region").(string)
 		targetEnv, err := getClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		targetEnv.Region = region
 		err = apikeyAPI.ResetApiKey(targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		if targetEnv.ResourceGroup == "" {
 			defaultRg, err := defaultResourceGroup(meta)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			targetEnv.ResourceGroup = defaultRg
 		}
 
 		d.SetId(fmt.Sprintf("%s/%s", region, targetEnv.ResourceGroup))
+		d.Set("resource_group_id", targetEnv.ResourceGroup)
 	}
 
 	return nil
 }
-func resourceIBMContainerAPIKeyResetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAPIKeyResetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
-func resourceIBMContainerAPIKeyResetdelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerAPIKeyResetdelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 	return nil
 }
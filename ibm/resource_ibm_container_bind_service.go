@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
@@ -13,11 +15,13 @@ import (
 
 func resourceIBMContainerBindService() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerBindServiceCreate,
-		Read:     resourceIBMContainerBindServiceRead,
-		Update:   resourceIBMContainerBindServiceUpdate,
-		Delete:   resourceIBMContainerBindServiceDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerBindServiceCreate,
+		ReadContext:   resourceIBMContainerBindServiceRead,
+		UpdateContext: resourceIBMContainerBindServiceUpdate,
+		DeleteContext: resourceIBMContainerBindServiceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerBindService, importIDParts("cluster_name_id", "", "namespace_id")),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cluster_name_id": {
@@ -127,10 +131,10 @@ func getClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v1.Cluste
 	return targetEnv, nil
 }
 
-func resourceIBMContainerBindServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerBindServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	clusterNameID := d.Get("cluster_name_id").(string)
 	namespaceID := d.Get("namespace_id").(string)
@@ -140,7 +144,7 @@ func resourceIBMContainerBindServiceCreate(d *schema.ResourceData, meta interfac
 	} else if serviceInstanceID, ok := d.GetOk("service_instance_id"); ok {
 		serviceInstanceNameID = serviceInstanceID.(string)
 	} else {
-		return fmt.Errorf("Please set either service_instance_name or service_instance_id")
+		return diagFromErr(fmt.Errorf("Please set either service_instance_name or service_instance_id"))
 	}
 
 	bindService := v1.ServiceBindRequest{
@@ -159,29 +163,29 @@ func resourceIBMContainerBindServiceCreate(d *schema.ResourceData, meta interfac
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	_, err = csClient.Clusters().BindService(bindService, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", clusterNameID, serviceInstanceNameID, namespaceID))
 
-	return resourceIBMContainerBindServiceRead(d, meta)
+	return resourceIBMContainerBindServiceRead(ctx, d, meta)
 }
 
-func resourceIBMContainerBindServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerBindServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMContainerBindServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerBindServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	clusterNameID := parts[0]
 	serviceInstanceNameID := parts[1]
@@ -189,12 +193,12 @@ func resourceIBMContainerBindServiceRead(d *schema.ResourceData, meta interface{
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	boundService, err := csClient.Clusters().FindServiceBoundToCluster(clusterNameID, serviceInstanceNameID, namespaceID, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set("namespace_id", boundService.Namespace)
 
@@ -205,26 +209,26 @@ func resourceIBMContainerBindServiceRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIBMContainerBindServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerBindServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	clusterNameID := parts[0]
 	serviceInstanceNameID := parts[1]
 	namespace := parts[2]
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	err = csClient.Clusters().UnBindService(clusterNameID, namespace, serviceInstanceNameID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error unbinding service: %s", err)
+		return diagFromErr(fmt.Errorf("Error unbinding service: %s", err))
 	}
 	return nil
 }
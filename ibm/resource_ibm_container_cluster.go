@@ -7,10 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -49,12 +49,13 @@ const PUBLIC_SUBNET_TYPE = "public"
 
 func resourceIBMContainerCluster() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerClusterCreate,
-		Read:     resourceIBMContainerClusterRead,
-		Update:   resourceIBMContainerClusterUpdate,
-		Delete:   resourceIBMContainerClusterDelete,
-		Exists:   resourceIBMContainerClusterExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerClusterCreate,
+		ReadContext:   resourceIBMContainerClusterRead,
+		UpdateContext: resourceIBMContainerClusterUpdate,
+		DeleteContext: resourceIBMContainerClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerCluster, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
@@ -64,7 +65,10 @@ func resourceIBMContainerCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceContainerWorkerUpdateCustomizeDiff(diff)
 			},
 		),
 
@@ -206,6 +210,10 @@ func resourceIBMContainerCluster() *schema.Resource {
 				Description: "Updates all the woker nodes if sets to true",
 			},
 
+			workerUpdateStrategy: workerUpdateStrategySchema(),
+
+			workerUpdateProgress: workerUpdateProgressSchema(),
+
 			"machine_type": {
 				Type:        schema.TypeString,
 				ForceNew:    true,
@@ -400,6 +408,7 @@ func resourceIBMContainerCluster() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "Tags for the resource",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			"worker_pools": {
 				Type:     schema.TypeList,
@@ -573,11 +582,11 @@ func resourceIBMContainerCluster() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get("name").(string)
@@ -626,10 +635,10 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 				params.PrivateEndpointEnabled = v.(bool)
 				params.GatewayEnabled = gatewayEnabled
 			} else {
-				return fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters")
+				return diagFromErr(fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters"))
 			}
 		} else {
-			return fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters")
+			return diagFromErr(fmt.Errorf("set private_service_endpoint to true for gateway_enabled clusters"))
 		}
 	}
 	if v, ok := d.GetOk("kube_version"); ok {
@@ -648,37 +657,37 @@ func resourceIBMContainerClusterCreate(d *schema.ResourceData, meta interface{})
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	cls, err := csClient.Clusters().Create(params, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(cls.ID)
 	switch strings.ToLower(timeoutStage) {
 
 	case strings.ToLower(masterNodeReady):
-		_, err = waitForClusterMasterAvailable(d, meta)
+		_, err = waitForClusterMasterAvailable(ctx, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 	case strings.ToLower(oneWorkerNodeReady):
-		_, err = waitForClusterOneWorkerAvailable(d, meta)
+		_, err = waitForClusterOneWorkerAvailable(ctx, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	d.Set("force_delete_storage", d.Get("force_delete_storage").(bool))
 
-	return resourceIBMContainerClusterUpdate(d, meta)
+	return resourceIBMContainerClusterUpdate(ctx, d, meta)
 }
 
-func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	wrkAPI := csClient.Workers()
 	workerPoolsAPI := csClient.WorkerPools()
@@ -686,18 +695,22 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().Find(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving armada cluster: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving armada cluster: %s", err))
 	}
 
 	workerFields, err := wrkAPI.List(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving workers for cluster: %s", err))
 	}
 	workerCount := 0
 	workers := []map[string]string{}
@@ -717,7 +730,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 	workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var poolName string
 	var poolContains bool
@@ -732,14 +745,14 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	if poolContains {
 		workersByPool, err := wrkAPI.ListByWorkerPool(clusterID, poolName, false, targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err))
 		}
 
 		// to get the private and public vlan IDs of the gateway enabled cluster.
 		if poolName == computeWorkerPool {
 			gatewayWorkersByPool, err := wrkAPI.ListByWorkerPool(clusterID, gatewayWorkerpool, false, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err)
+				return diagFromErr(fmt.Errorf("Error retrieving workers of default worker pool for cluster: %s", err))
 			}
 			d.Set("public_vlan_id", gatewayWorkersByPool[0].PublicVlan)
 			d.Set("private_vlan_id", gatewayWorkersByPool[0].PrivateVlan)
@@ -772,7 +785,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 
 		defaultWorkerPool, err := workerPoolsAPI.GetWorkerPool(clusterID, poolName, targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		d.Set("labels", IgnoreSystemLabels(defaultWorkerPool.Labels))
 		zones := defaultWorkerPool.Zones
@@ -788,7 +801,7 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	albs, err := albsAPI.ListClusterALBs(clusterID, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "The specified cluster is a lite cluster.") && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") && !strings.Contains(err.Error(), "The specified cluster is a free cluster.") {
 
-		return fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err)
+		return diagFromErr(fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err))
 	}
 
 	d.Set("name", cls.Name)
@@ -817,10 +830,10 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf(
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 	d.Set(ResourceName, cls.Name)
@@ -830,16 +843,16 @@ func resourceIBMContainerClusterRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	subnetAPI := csClient.Subnets()
@@ -850,7 +863,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 
 	clusterID := d.Id()
 
-	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version")) && !d.IsNewResource() {
+	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || workerUpdateResumable(d)) && !d.IsNewResource() {
 		if d.HasChange("kube_version") {
 			var masterVersion string
 			if v, ok := d.GetOk("kube_version"); ok {
@@ -863,49 +876,46 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err := clusterAPI.Update(clusterID, params, targetEnv)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
-			_, err = WaitForClusterVersionUpdate(d, meta, targetEnv)
+			_, err = WaitForClusterVersionUpdate(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diagFromErr(fmt.Errorf(
+					"Error waiting for cluster (%s) version to be updated: %s", d.Id(), err))
 			}
 		}
 		// "update_all_workers" deafult is false, enable to true when all worker nodes to be updated
 		// with major and minor updates.
 		updateAllWorkers := d.Get("update_all_workers").(bool)
-		if updateAllWorkers || d.HasChange("patch_version") {
-			patchVersion := d.Get("patch_version").(string)
-			workerFields, err := wrkAPI.List(clusterID, targetEnv)
-			if err != nil {
-				return fmt.Errorf("Error retrieving workers for cluster: %s", err)
-			}
+		if updateAllWorkers || d.HasChange("patch_version") || workerUpdateResumable(d) {
 			cluster, err := clusterAPI.Find(clusterID, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
+				return diagFromErr(fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err))
 			}
-
-			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
-
-			for _, w := range workerFields {
-				/*kubeversion update done if
-				1. There is a change in Major.Minor version
-				2. Therese is a change in patch_version & Traget kube patch version and patch_version are same
-				*/
-				if strings.Split(w.KubeVersion, "_")[0] != strings.Split(cluster.MasterKubeVersion, "_")[0] || (strings.Split(w.KubeVersion, ".")[2] != patchVersion && strings.Split(w.TargetVersion, ".")[2] == patchVersion) {
+			updates := &classicWorkerUpdates{
+				client:        csClient,
+				clusterID:     clusterID,
+				masterVersion: cluster.MasterKubeVersion,
+				patchVersion:  d.Get("patch_version").(string),
+				target:        targetEnv,
+			}
+			if d.Get("wait_for_worker_update").(bool) {
+				err = updateContainerWorkers(ctx, d, updates, expandWorkerUpdateStrategy(d), d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return diagFromErr(err)
+				}
+			} else {
+				workers, err := updates.OutdatedWorkers()
+				if err != nil {
+					return diagFromErr(fmt.Errorf("Error retrieving workers for cluster: %s", err))
+				}
+				for _, w := range workers {
 					params := v1.WorkerUpdateParam{
 						Action: "update",
 					}
 					err = wrkAPI.Update(clusterID, w.ID, params, targetEnv)
 					if err != nil {
-						return fmt.Errorf("Error updating worker %s: %s", w.ID, err)
-					}
-					if waitForWorkerUpdate {
-						_, err = WaitForWorkerAvailable(d, meta, targetEnv)
-						if err != nil {
-							return fmt.Errorf(
-								"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
-						}
+						return diagFromErr(fmt.Errorf("Error updating worker %s: %s", w.ID, err))
 					}
 				}
 			}
@@ -943,7 +953,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			log.Printf(
 				"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -959,7 +969,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		workerPoolsAPI := csClient.WorkerPools()
 		workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		var poolName string
 		var poolContains bool
@@ -976,18 +986,18 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			poolSize := d.Get("default_pool_size").(int)
 			err = workerPoolsAPI.ResizeWorkerPool(clusterID, poolName, poolSize, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error updating the default_pool_size %d: %s", poolSize, err)
+				return diagFromErr(fmt.Errorf(
+					"Error updating the default_pool_size %d: %s", poolSize, err))
 			}
 
-			_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+			_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+				return diagFromErr(fmt.Errorf(
+					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 			}
 		} else {
-			return fmt.Errorf(
-				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes..")
+			return diagFromErr(fmt.Errorf(
+				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes.."))
 		}
 	}
 
@@ -995,7 +1005,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		workerPoolsAPI := csClient.WorkerPools()
 		workerPools, err := workerPoolsAPI.ListWorkerPools(clusterID, targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		var poolName string
 		var poolContains bool
@@ -1016,18 +1026,18 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			}
 			err = workerPoolsAPI.UpdateLabelsWorkerPool(clusterID, poolName, labels, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error updating the labels %s", err)
+				return diagFromErr(fmt.Errorf(
+					"Error updating the labels %s", err))
 			}
 
-			_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+			_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+				return diagFromErr(fmt.Errorf(
+					"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 			}
 		} else {
-			return fmt.Errorf(
-				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes..")
+			return diagFromErr(fmt.Errorf(
+				"The default worker pool does not exist. Use ibm_container_worker_pool and ibm_container_worker_pool_zone attachment resources to make changes to your cluster, such as adding zones, adding worker nodes, or updating worker nodes.."))
 		}
 	}
 
@@ -1059,21 +1069,21 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			count := oldCount - newCount
 			workerFields, err := wrkAPI.List(clusterID, targetEnv)
 			if err != nil {
-				return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+				return diagFromErr(newAttributeError("worker_num", "Error retrieving workers for cluster", err))
 			}
 			for i := 0; i < count; i++ {
 				err := wrkAPI.Delete(clusterID, workerFields[i].ID, targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error deleting workers of cluster (%s): %s", d.Id(), err)
+					return diagFromErr(fmt.Errorf(
+						"Error deleting workers of cluster (%s): %s", d.Id(), err))
 				}
 			}
 		}
 
-		_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+		_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 		}
 	}
 
@@ -1088,23 +1098,23 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 				if strings.Compare(newPack["version"].(string), oldPack["version"].(string)) != 0 {
 					cluster, err := clusterAPI.Find(clusterID, targetEnv)
 					if err != nil {
-						return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
+						return diagFromErr(fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err))
 					}
 					if newPack["version"].(string) != strings.Split(cluster.MasterKubeVersion, "_")[0] {
-						return fmt.Errorf("Worker version %s should match the master kube version %s", newPack["version"].(string), strings.Split(cluster.MasterKubeVersion, "_")[0])
+						return diagFromErr(fmt.Errorf("Worker version %s should match the master kube version %s", newPack["version"].(string), strings.Split(cluster.MasterKubeVersion, "_")[0]))
 					}
 					params := v1.WorkerUpdateParam{
 						Action: "update",
 					}
 					err = wrkAPI.Update(clusterID, oldPack["id"].(string), params, targetEnv)
 					if err != nil {
-						return fmt.Errorf("Error updating worker %s: %s", oldPack["id"].(string), err)
+						return diagFromErr(fmt.Errorf("Error updating worker %s: %s", oldPack["id"].(string), err))
 					}
 
-					_, err = WaitForWorkerAvailable(d, meta, targetEnv)
+					_, err = WaitForWorkerAvailable(ctx, d, meta, targetEnv)
 					if err != nil {
-						return fmt.Errorf(
-							"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err)
+						return diagFromErr(fmt.Errorf(
+							"Error waiting for workers of cluster (%s) to become ready: %s", d.Id(), err))
 					}
 				}
 			}
@@ -1150,12 +1160,12 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		newSubnet := newSubnets.(*schema.Set)
 		rem := oldSubnet.Difference(newSubnet).List()
 		if len(rem) > 0 {
-			return fmt.Errorf("Subnet(s) %v cannot be deleted", rem)
+			return diagFromErr(fmt.Errorf("Subnet(s) %v cannot be deleted", rem))
 		}
 
 		subnets, err := subnetAPI.List(targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		for _, nS := range newSubnet.List() {
 			exists := false
@@ -1167,7 +1177,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 			if !exists {
 				err := subnetAPI.AddSubnet(clusterID, nS.(string), targetEnv)
 				if err != nil {
-					return err
+					return diagFromErr(err)
 				}
 				subnet := getSubnet(subnets, nS.(string))
 				if subnet.Type == PUBLIC_SUBNET_TYPE {
@@ -1177,19 +1187,18 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 	if publicSubnetAdded && d.Get("wait_till").(string) == ingressReady {
-		_, err = WaitForSubnetAvailable(d, meta, targetEnv)
+		_, err = WaitForSubnetAvailable(ctx, d, meta, targetEnv)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for initializing ingress hostname and secret: %s", err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for initializing ingress hostname and secret: %s", err))
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
-		oldList, newList := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldList, newList := d.GetChange("tags_all")
 		cluster, err := clusterAPI.Find(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
+			return diagFromErr(fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err))
 		}
 		err = UpdateTagsUsingCRN(oldList, newList, meta, cluster.CRN)
 		if err != nil {
@@ -1199,7 +1208,7 @@ func resourceIBMContainerClusterUpdate(d *schema.ResourceData, meta interface{})
 
 	}
 
-	return resourceIBMContainerClusterRead(d, meta)
+	return resourceIBMContainerClusterRead(ctx, d, meta)
 }
 
 func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorkers []interface{}, workerInfo []map[string]string) (string, error) {
@@ -1237,30 +1246,30 @@ func getID(d *schema.ResourceData, meta interface{}, clusterID string, oldWorker
 	return "", fmt.Errorf("Unable to get ID of worker")
 }
 
-func resourceIBMContainerClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	clusterID := d.Id()
 	forceDeleteStorage := d.Get("force_delete_storage").(bool)
 	err = csClient.Clusters().Delete(clusterID, targetEnv, forceDeleteStorage)
 	if err != nil {
-		return fmt.Errorf("Error deleting cluster: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting cluster: %s", err))
 	}
-	_, err = waitForClusterDelete(d, meta)
+	_, err = waitForClusterDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1289,11 +1298,11 @@ func waitForClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForClusterAvailable Waits for cluster creation
-func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1310,7 +1319,7 @@ func WaitForClusterAvailable(d *schema.ResourceData, meta interface{}, target v1
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1331,7 +1340,7 @@ func clusterStateRefreshFunc(client v1.Clusters, instanceID string, target v1.Cl
 }
 
 // waitForClusterMasterAvailable Waits for cluster creation
-func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterMasterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1361,11 +1370,11 @@ func waitForClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // waitForClusterOneWorkerAvailable Waits for cluster creation
-func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForClusterOneWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1420,11 +1429,11 @@ func waitForClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{})
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 // WaitForWorkerAvailable Waits for worker creation
-func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1441,7 +1450,7 @@ func WaitForWorkerAvailable(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1463,7 +1472,7 @@ func workerStateRefreshFunc(client v1.Workers, instanceID string, target v1.Clus
 	}
 }
 
-func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterCreation(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1495,10 +1504,10 @@ func WaitForClusterCreation(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForSubnetAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1515,7 +1524,7 @@ func WaitForSubnetAvailable(d *schema.ResourceData, meta interface{}, target v1.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1532,7 +1541,7 @@ func subnetStateRefreshFunc(client v1.Clusters, instanceID string, d *schema.Res
 }
 
 // WaitForClusterVersionUpdate Waits for cluster creation
-func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForClusterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1550,7 +1559,7 @@ func WaitForClusterVersionUpdate(d *schema.ResourceData, meta interface{}, targe
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func clusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.ResourceData, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1571,29 +1580,6 @@ func clusterVersionRefreshFunc(client v1.Clusters, instanceID string, d *schema.
 	}
 }
 
-func resourceIBMContainerClusterExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	csClient, err := meta.(ClientSession).ContainerAPI()
-	if err != nil {
-		return false, err
-	}
-	targetEnv, err := getClusterTargetHeader(d, meta)
-	if err != nil {
-		return false, err
-	}
-	clusterID := d.Id()
-	cls, err := csClient.Clusters().FindWithOutShowResourcesCompatible(clusterID, targetEnv)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return cls.ID == clusterID, nil
-}
-
 func getSubnet(subnets []v1.Subnet, subnetId string) v1.Subnet {
 	for _, subnet := range subnets {
 		if subnet.ID == subnetId {
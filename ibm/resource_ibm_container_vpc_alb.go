@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -11,17 +12,20 @@ import (
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMContainerVpcALB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcALBCreate,
-		Read:     resourceIBMContainerVpcALBRead,
-		Update:   resourceIBMContainerVpcALBUpdate,
-		Delete:   resourceIBMContainerVpcALBDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcALBCreate,
+		ReadContext:   resourceIBMContainerVpcALBRead,
+		UpdateContext: resourceIBMContainerVpcALBUpdate,
+		DeleteContext: resourceIBMContainerVpcALBDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerVpcALB, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -92,10 +96,10 @@ func resourceIBMContainerVpcALB() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerVpcALBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcALBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var enable, disableDeployment bool
 	albID := d.Get("alb_id").(string)
@@ -104,13 +108,13 @@ func resourceIBMContainerVpcALBCreate(d *schema.ResourceData, meta interface{})
 	} else if v, ok := d.GetOkExists("disable_deployment"); ok {
 		disableDeployment = v.(bool)
 	} else {
-		return fmt.Errorf("Provide either `enable` or `disable_deployment`")
+		return diagFromErr(fmt.Errorf("Provide either `enable` or `disable_deployment`"))
 	}
 
-	_, err = waitForVpcClusterAvailable(d, meta, albID, schema.TimeoutCreate)
+	_, err = waitForVpcClusterAvailable(ctx, d, meta, albID, schema.TimeoutCreate)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for cluster resource availabilty (%s) : %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for cluster resource availabilty (%s) : %s", d.Id(), err))
 	}
 
 	params := v2.AlbConfig{
@@ -121,35 +125,35 @@ func resourceIBMContainerVpcALBCreate(d *schema.ResourceData, meta interface{})
 	albAPI := albClient.Albs()
 	targetEnv := v2.ClusterTargetHeader{}
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if enable {
 		err = albAPI.EnableAlb(params, targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err = albAPI.DisableAlb(params, targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	d.SetId(albID)
-	_, err = waitForVpcContainerALB(d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
+	_, err = waitForVpcContainerALB(ctx, d, meta, albID, schema.TimeoutCreate, enable, disableDeployment)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create resource alb (%s) : %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for create resource alb (%s) : %s", d.Id(), err))
 	}
 
-	return resourceIBMContainerVpcALBRead(d, meta)
+	return resourceIBMContainerVpcALBRead(ctx, d, meta)
 }
 
-func resourceIBMContainerVpcALBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcALBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	albID := d.Id()
@@ -159,7 +163,7 @@ func resourceIBMContainerVpcALBRead(d *schema.ResourceData, meta interface{}) er
 
 	albConfig, err := albAPI.GetAlb(albID, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set("alb_type", albConfig.AlbType)
@@ -177,10 +181,10 @@ func resourceIBMContainerVpcALBRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMContainerVpcALBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcALBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	albClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	albAPI := albClient.Albs()
 
@@ -189,10 +193,10 @@ func resourceIBMContainerVpcALBUpdate(d *schema.ResourceData, meta interface{})
 		disableDeployment := d.Get("disable_deployment").(bool)
 		albID := d.Id()
 
-		_, err = waitForVpcClusterAvailable(d, meta, albID, schema.TimeoutCreate)
+		_, err = waitForVpcClusterAvailable(ctx, d, meta, albID, schema.TimeoutCreate)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for cluster resource availabilty (%s) : %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for cluster resource availabilty (%s) : %s", d.Id(), err))
 		}
 
 		params := v2.AlbConfig{
@@ -205,26 +209,26 @@ func resourceIBMContainerVpcALBUpdate(d *schema.ResourceData, meta interface{})
 		if enable {
 			err = albAPI.EnableAlb(params, targetEnv)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		} else {
 			err = albAPI.DisableAlb(params, targetEnv)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		}
 
-		_, err = waitForVpcContainerALB(d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
+		_, err = waitForVpcContainerALB(ctx, d, meta, albID, schema.TimeoutUpdate, enable, disableDeployment)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for updating resource alb (%s) : %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for updating resource alb (%s) : %s", d.Id(), err))
 		}
 
 	}
-	return resourceIBMContainerVpcALBRead(d, meta)
+	return resourceIBMContainerVpcALBRead(ctx, d, meta)
 }
 
-func waitForVpcContainerALB(d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
+func waitForVpcContainerALB(ctx context.Context, d *schema.ResourceData, meta interface{}, albID, timeout string, enable, disableDeployment bool) (interface{}, error) {
 	albClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
@@ -257,16 +261,16 @@ func waitForVpcContainerALB(d *schema.ResourceData, meta interface{}, albID, tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func resourceIBMContainerVpcALBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcALBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	d.SetId("")
 
 	return nil
 }
 
-func waitForVpcClusterAvailable(d *schema.ResourceData, meta interface{}, albID, timeout string) (interface{}, error) {
+func waitForVpcClusterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, albID, timeout string) (interface{}, error) {
 	albClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return false, err
@@ -313,5 +317,5 @@ func waitForVpcClusterAvailable(d *schema.ResourceData, meta interface{}, albID,
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.WaitForStateContext(ctx)
 }
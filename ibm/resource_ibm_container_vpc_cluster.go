@@ -7,13 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v3/core"
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,16 +36,20 @@ const (
 
 func resourceIBMContainerVpcCluster() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcClusterCreate,
-		Read:     resourceIBMContainerVpcClusterRead,
-		Update:   resourceIBMContainerVpcClusterUpdate,
-		Delete:   resourceIBMContainerVpcClusterDelete,
-		Exists:   resourceIBMContainerVpcClusterExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcClusterCreate,
+		ReadContext:   resourceIBMContainerVpcClusterRead,
+		UpdateContext: resourceIBMContainerVpcClusterUpdate,
+		DeleteContext: resourceIBMContainerVpcClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerVpcCluster, nil),
+		},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceContainerWorkerUpdateCustomizeDiff(diff)
 			},
 		),
 
@@ -147,6 +151,10 @@ func resourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Updates all the woker nodes if sets to true",
 			},
 
+			workerUpdateStrategy: workerUpdateStrategySchema(),
+
+			workerUpdateProgress: workerUpdateProgressSchema(),
+
 			"patch_version": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -206,6 +214,7 @@ func resourceIBMContainerVpcCluster() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "List of tags for the resources",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			"wait_till": {
 				Type:             schema.TypeString,
@@ -365,12 +374,12 @@ func resourceIBMContainerVpcCluster() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	var vpcProvider string
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
 		vpcProvider = "vpc-classic"
@@ -380,7 +389,7 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	disablePublicServiceEndpoint := d.Get("disable_public_service_endpoint").(bool)
@@ -453,60 +462,59 @@ func resourceIBMContainerVpcClusterCreate(d *schema.ResourceData, meta interface
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	cls, err := csClient.Clusters().Create(params, targetEnv)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(cls.ID)
 	switch strings.ToLower(timeoutStage) {
 
 	case strings.ToLower(masterNodeReady):
-		_, err = waitForVpcClusterMasterAvailable(d, meta)
+		_, err = waitForVpcClusterMasterAvailable(ctx, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 	case strings.ToLower(oneWorkerNodeReady):
-		_, err = waitForVpcClusterOneWorkerAvailable(d, meta)
+		_, err = waitForVpcClusterOneWorkerAvailable(ctx, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 	case strings.ToLower(ingressReady):
-		_, err = waitForVpcClusterIngressAvailable(d, meta)
+		_, err = waitForVpcClusterIngressAvailable(ctx, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 	}
-	return resourceIBMContainerVpcClusterUpdate(d, meta)
+	return resourceIBMContainerVpcClusterUpdate(ctx, d, meta)
 
 }
 
-func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	clusterID := d.Id()
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if d.HasChange("tags") || v != "" {
-		oldList, newList := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oldList, newList := d.GetChange("tags_all")
 		cluster, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err)
+			return diagFromErr(fmt.Errorf("Error retrieving cluster %s: %s", clusterID, err))
 		}
 		err = UpdateTagsUsingCRN(oldList, newList, meta, cluster.CRN)
 		if err != nil {
@@ -546,17 +554,17 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		if err != nil {
 			log.Printf(
 				"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
-			return err
+			return diagFromErr(err)
 		}
 
 	}
 
-	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version")) && !d.IsNewResource() {
+	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || workerUpdateResumable(d)) && !d.IsNewResource() {
 
 		if d.HasChange("kube_version") {
 			ClusterClient, err := meta.(ClientSession).ContainerAPI()
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			var masterVersion string
 			if v, ok := d.GetOk("kube_version"); ok {
@@ -571,91 +579,54 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			Env, err := getClusterTargetHeader(d, meta)
 
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			Error := ClusterClient.Clusters().Update(clusterID, params, Env)
 			if Error != nil {
-				return Error
+				return diagFromErr(Error)
 			}
-			_, err = WaitForVpcClusterVersionUpdate(d, meta, targetEnv)
+			_, err = WaitForVpcClusterVersionUpdate(ctx, d, meta, targetEnv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for cluster (%s) version to be updated: %s", d.Id(), err)
+				return diagFromErr(fmt.Errorf(
+					"Error waiting for cluster (%s) version to be updated: %s", d.Id(), err))
 			}
 		}
 
-		csClient, err := meta.(ClientSession).VpcContainerAPI()
-		if err != nil {
-			return err
-		}
-		targetEnv, err := getVpcClusterTargetHeader(d, meta)
-		if err != nil {
-			return err
-		}
-
-		clusterID := d.Id()
 		cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 		if err != nil {
-			return fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err))
 		}
 
 		// Update the worker nodes after master node kube-version is updated.
-		// workers will store the existing workers info to identify the replaced node
-		workersInfo := make(map[string]int, 0)
-
 		updateAllWorkers := d.Get("update_all_workers").(bool)
-		if updateAllWorkers || d.HasChange("patch_version") {
-
-			patchVersion := d.Get("patch_version").(string)
-			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+		if updateAllWorkers || d.HasChange("patch_version") || workerUpdateResumable(d) {
+			ClusterClient, err := meta.(ClientSession).ContainerAPI()
 			if err != nil {
-				return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+				return diagFromErr(err)
 			}
-
-			for index, worker := range workers {
-				workersInfo[worker.ID] = index
+			updates := &vpcWorkerUpdates{
+				client:        csClient,
+				poolClient:    ClusterClient,
+				clusterID:     clusterID,
+				masterVersion: cls.MasterKubeVersion,
+				patchVersion:  d.Get("patch_version").(string),
+				target:        targetEnv,
 			}
-			workersCount := len(workers)
-
-			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
-
-			for _, worker := range workers {
-				// check if change is present in MAJOR.MINOR version or in PATCH version
-				if strings.Split(worker.KubeVersion.Actual, "_")[0] != strings.Split(cls.MasterKubeVersion, "_")[0] || (strings.Split(worker.KubeVersion.Actual, ".")[2] != patchVersion && patchVersion == strings.Split(worker.KubeVersion.Target, ".")[2]) {
+			if d.Get("wait_for_worker_update").(bool) {
+				err = updateContainerWorkers(ctx, d, updates, expandWorkerUpdateStrategy(d), d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return diagFromErr(err)
+				}
+			} else {
+				workers, err := updates.OutdatedWorkers()
+				if err != nil {
+					return diagFromErr(fmt.Errorf("Error retrieving workers for cluster: %s", err))
+				}
+				for _, worker := range workers {
 					_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
 					// As API returns http response 204 NO CONTENT, error raised will be exempted.
 					if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
-						return fmt.Errorf("Error replacing the worker node from the cluster: %s", err)
-					}
-
-					if waitForWorkerUpdate {
-						//1. wait for worker node to delete
-						_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
-						if deleteError != nil {
-							return fmt.Errorf("Worker node - %s is failed to replace", worker.ID)
-						}
-
-						//2. wait for new workerNode
-						_, newWorkerError := waitForNewWorker(d, meta, targetEnv, workersCount)
-						if newWorkerError != nil {
-							return fmt.Errorf("Failed to spawn new worker node")
-						}
-
-						//3. Get new worker node ID and update the map
-						newWorkerID, index, newNodeError := getNewWorkerID(d, meta, targetEnv, workersInfo)
-						if newNodeError != nil {
-							return fmt.Errorf("Unable to find the new worker node info")
-						}
-
-						delete(workersInfo, worker.ID)
-						workersInfo[newWorkerID] = index
-
-						//4. wait for the worker's version update and normal state
-						_, Err := WaitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, cls.MasterKubeVersion, newWorkerID)
-						if Err != nil {
-							return fmt.Errorf(
-								"Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), Err)
-						}
+						return diagFromErr(fmt.Errorf("Error replacing the worker node from the cluster: %s", err))
 					}
 				}
 			}
@@ -672,14 +643,14 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 		ClusterClient, err := meta.(ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(clusterID, "default", labels, Env)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating the labels: %s", err)
+			return diagFromErr(fmt.Errorf(
+				"Error updating the labels: %s", err))
 		}
 	}
 
@@ -687,14 +658,14 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		count := d.Get("worker_count").(int)
 		ClusterClient, err := meta.(ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterID, "default", count, Env)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating the worker_count %d: %s", count, err)
+			return diagFromErr(fmt.Errorf(
+				"Error updating the worker_count %d: %s", count, err))
 		}
 	}
 	if d.HasChange("zones") && !d.IsNewResource() {
@@ -720,12 +691,12 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 				}
 				err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
 				if err != nil {
-					return fmt.Errorf("Error adding zone to conatiner vpc cluster: %s", err)
+					return diagFromErr(newAttributeError("zones", "Error adding zone to conatiner vpc cluster", err))
 				}
-				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, "default", d.Timeout(schema.TimeoutCreate), targetEnv)
+				_, err = WaitForWorkerPoolAvailable(ctx, d, meta, clusterID, "default", d.Timeout(schema.TimeoutCreate), targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+					return diagFromErr(fmt.Errorf(
+						"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 				}
 
 			}
@@ -735,17 +706,17 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 				oldZone := zone.(map[string]interface{})
 				ClusterClient, err := meta.(ClientSession).ContainerAPI()
 				if err != nil {
-					return err
+					return diagFromErr(err)
 				}
 				Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), "default", Env)
 				if err != nil {
-					return fmt.Errorf("Error deleting zone to conatiner vpc cluster: %s", err)
+					return diagFromErr(newAttributeError("zones", "Error deleting zone to conatiner vpc cluster", err))
 				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, "default", oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+				_, err = WaitForV2WorkerZoneDeleted(ctx, clusterID, "default", oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", "default", clusterID, err)
+					return diagFromErr(fmt.Errorf(
+						"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", "default", clusterID, err))
 				}
 			}
 		}
@@ -759,9 +730,9 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		d.Set("force_delete_storage", forceDeleteStorage)
 	}
 
-	return resourceIBMContainerVpcClusterRead(d, meta)
+	return resourceIBMContainerVpcClusterRead(ctx, d, meta)
 }
-func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForV2WorkerZoneDeleted(ctx context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -775,7 +746,7 @@ func WaitForV2WorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 func workerPoolV2ZoneDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID, zone string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -794,23 +765,26 @@ func workerPoolV2ZoneDeleteStateRefreshFunc(client v2.Workers, instanceID, worke
 		return workerFields, workerDeleteState, nil
 	}
 }
-func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMContainerVpcClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	albsAPI := csClient.Albs()
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	clusterID := d.Id()
 	cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err))
 	}
 
 	workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterID, "default", targetEnv)
@@ -830,7 +804,7 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 
 	albs, err := albsAPI.ListClusterAlbs(clusterID, targetEnv)
 	if err != nil && !strings.Contains(err.Error(), "This operation is not supported for your cluster's version.") {
-		return fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err)
+		return diagFromErr(fmt.Errorf("Error retrieving alb's of the cluster %s: %s", clusterID, err))
 	}
 
 	d.Set("name", cls.Name)
@@ -869,10 +843,10 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 		log.Printf(
 			"An error occured during reading of instance (%s) tags : %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 	d.Set(ResourceName, cls.Name)
@@ -883,15 +857,15 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	clusterID := d.Id()
 
@@ -917,7 +891,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 
 	bxsession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var authenticator *core.BearerTokenAuthenticator
@@ -934,17 +908,17 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 	forceDeleteStorage := d.Get("force_delete_storage").(bool)
 	err = csClient.Clusters().Delete(clusterID, targetEnv, forceDeleteStorage)
 	if err != nil {
-		return fmt.Errorf("Error deleting cluster: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting cluster: %s", err))
 	}
-	_, err = waitForVpcClusterDelete(d, meta)
+	_, err = waitForVpcClusterDelete(ctx, d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if region != "" {
 		userDetails, err := meta.(ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		if userDetails.generation == 1 {
 			vpcclassicurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", region)
@@ -966,7 +940,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 					if strings.Contains(*lb.Name, clusterID) {
 						log.Println("Deleting Load Balancer", *lb.Name)
 						id := *lb.ID
-						_, err = isWaitForClassicLBDeleted(sess1, id, d.Timeout(schema.TimeoutDelete))
+						_, err = isWaitForClassicLBDeleted(ctx, sess1, id, d.Timeout(schema.TimeoutDelete))
 						if err != nil {
 							log.Printf("Error waiting for vpc load balancer to be deleted: %s\n", err)
 
@@ -994,7 +968,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 					if strings.Contains(*lb.Name, clusterID) {
 						log.Println("Deleting Load Balancer", *lb.Name)
 						id := *lb.ID
-						_, err = isWaitForLBDeleted(sess1, id, d.Timeout(schema.TimeoutDelete))
+						_, err = isWaitForLBDeleted(ctx, sess1, id, d.Timeout(schema.TimeoutDelete))
 						if err != nil {
 							log.Printf("Error waiting for vpc load balancer to be deleted: %s\n", err)
 
@@ -1007,7 +981,7 @@ func resourceIBMContainerVpcClusterDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1036,10 +1010,10 @@ func waitForVpcClusterDelete(d *schema.ResourceData, meta interface{}) (interfac
 		PollInterval: 5 * time.Second,
 	}
 
-	return deleteStateConf.WaitForState()
+	return deleteStateConf.WaitForStateContext(ctx)
 }
 
-func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterOneWorkerAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1077,10 +1051,10 @@ func waitForVpcClusterOneWorkerAvailable(d *schema.ResourceData, meta interface{
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.WaitForStateContext(ctx)
 }
 
-func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterMasterAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1111,10 +1085,10 @@ func waitForVpcClusterMasterAvailable(d *schema.ResourceData, meta interface{})
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.WaitForStateContext(ctx)
 }
 
-func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForVpcClusterIngressAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return nil, err
@@ -1145,7 +1119,7 @@ func waitForVpcClusterIngressAvailable(d *schema.ResourceData, meta interface{})
 		MinTimeout:                5 * time.Second,
 		ContinuousTargetOccurence: 5,
 	}
-	return createStateConf.WaitForState()
+	return createStateConf.WaitForStateContext(ctx)
 }
 
 func getVpcClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v2.ClusterTargetHeader, error) {
@@ -1159,31 +1133,8 @@ func getVpcClusterTargetHeader(d *schema.ResourceData, meta interface{}) (v2.Clu
 	return targetEnv, nil
 }
 
-func resourceIBMContainerVpcClusterExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	csClient, err := meta.(ClientSession).VpcContainerAPI()
-	if err != nil {
-		return false, err
-	}
-	targetEnv, err := getVpcClusterTargetHeader(d, meta)
-	if err != nil {
-		return false, err
-	}
-	clusterID := d.Id()
-	cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return cls.ID == clusterID, nil
-}
-
 // WaitForVpcClusterVersionUpdate Waits for cluster creation
-func WaitForVpcClusterVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForVpcClusterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1201,7 +1152,7 @@ func WaitForVpcClusterVersionUpdate(d *schema.ResourceData, meta interface{}, ta
 		ContinuousTargetOccurence: 5,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *schema.ResourceData, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -1219,118 +1170,3 @@ func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *sche
 		return cls, clusterNormal, nil
 	}
 }
-
-// WaitForVpcClusterWokersVersionUpdate Waits for Cluster version Update
-func WaitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, masterVersion, workerID string) (interface{}, error) {
-	csClient, err := meta.(ClientSession).VpcContainerAPI()
-	if err != nil {
-		return nil, err
-	}
-
-	log.Printf("Waiting for worker (%s) version to be updated.", workerID)
-	clusterID := d.Id()
-	stateConf := &resource.StateChangeConf{
-		Pending:                   []string{"retry", versionUpdating},
-		Target:                    []string{workerNormal},
-		Refresh:                   vpcClusterWorkersVersionRefreshFunc(csClient.Workers(), workerID, clusterID, d, target, masterVersion),
-		Timeout:                   d.Timeout(schema.TimeoutUpdate),
-		Delay:                     10 * time.Second,
-		MinTimeout:                10 * time.Second,
-		ContinuousTargetOccurence: 5,
-	}
-
-	return stateConf.WaitForState()
-}
-
-func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID string, d *schema.ResourceData, target v2.ClusterTargetHeader, masterVersion string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		worker, err := client.Get(clusterID, workerID, target)
-		if err != nil {
-			return nil, "retry", fmt.Errorf("Error retrieving worker of container vpc cluster: %s", err)
-		}
-		// Check active updates
-		if worker.Health.State == "normal" && strings.Split(worker.KubeVersion.Actual, "_")[0] == strings.Split(masterVersion, "_")[0] {
-			return worker, workerNormal, nil
-		}
-		return worker, versionUpdating, nil
-	}
-}
-
-func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
-
-	csClient, err := meta.(ClientSession).VpcContainerAPI()
-	if err != nil {
-		return nil, err
-	}
-
-	clusterID := d.Id()
-	deleteStateConf := &resource.StateChangeConf{
-		Pending: []string{workerDeletePending},
-		Target:  []string{workerDeleteState},
-		Refresh: func() (interface{}, string, error) {
-			worker, err := csClient.Workers().Get(clusterID, workerID, targetEnv)
-			if err != nil {
-				return worker, workerDeletePending, nil
-			}
-			if worker.LifeCycle.ActualState == "deleted" {
-				return worker, workerDeleteState, nil
-			}
-			return worker, workerDeletePending, nil
-		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        10 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 5 * time.Second,
-	}
-	return deleteStateConf.WaitForState()
-}
-
-func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
-	csClient, err := meta.(ClientSession).VpcContainerAPI()
-	if err != nil {
-		return nil, err
-	}
-
-	clusterID := d.Id()
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"creating"},
-		Target:  []string{"created"},
-		Refresh: func() (interface{}, string, error) {
-			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
-			if err != nil {
-				return workers, "", fmt.Errorf("Error in retriving the list of worker nodes")
-			}
-			if len(workers) == workersCount {
-				return workers, "created", nil
-			}
-			return workers, "creating", nil
-		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
-		Delay:        10 * time.Second,
-		MinTimeout:   5 * time.Second,
-		PollInterval: 5 * time.Second,
-	}
-	return stateConf.WaitForState()
-}
-
-func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersInfo map[string]int) (string, int, error) {
-	csClient, err := meta.(ClientSession).VpcContainerAPI()
-	if err != nil {
-		return "", -1, err
-	}
-
-	clusterID := d.Id()
-
-	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
-	if err != nil {
-		return "", -1, fmt.Errorf("Error in retriving the list of worker nodes")
-	}
-
-	for index, worker := range workers {
-		if _, ok := workersInfo[worker.ID]; !ok {
-			log.Println("found new replaced node: ", worker.ID)
-			return worker.ID, index, nil
-		}
-	}
-	return "", -1, fmt.Errorf("no new node found")
-}
@@ -4,11 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,17 +27,28 @@ const (
 func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerVpcWorkerPoolCreate,
-		Update:   resourceIBMContainerVpcWorkerPoolUpdate,
-		Read:     resourceIBMContainerVpcWorkerPoolRead,
-		Delete:   resourceIBMContainerVpcWorkerPoolDelete,
-		Exists:   resourceIBMContainerVpcWorkerPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerVpcWorkerPoolCreate,
+		UpdateContext: resourceIBMContainerVpcWorkerPoolUpdate,
+		ReadContext:   resourceIBMContainerVpcWorkerPoolRead,
+		DeleteContext: resourceIBMContainerVpcWorkerPoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerVpcWorkerPool, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMContainerVpcWorkerPoolOutdatedWorkersCustomizeDiff(diff)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceContainerWorkerUpdateCustomizeDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"cluster": {
 				Type:        schema.TypeString,
@@ -110,15 +124,44 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 				DiffSuppressFunc: applyOnce,
 				Description:      "Entitlement option reduces additional OCP Licence cost in Openshift Clusters",
 			},
+
+			"update_all_workers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Updates the worker nodes of the worker pool to the Kubernetes version of the master if sets to true",
+			},
+
+			"outdated_workers": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of worker nodes of the worker pool that are not at the Kubernetes version of the master",
+			},
+
+			workerUpdateStrategy: workerUpdateStrategySchema(),
+
+			workerUpdateProgress: workerUpdateProgressSchema(),
 		},
 	}
 }
 
-func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+// resourceIBMContainerVpcWorkerPoolOutdatedWorkersCustomizeDiff plans the update of the outdated
+// workers of the worker pool when update_all_workers is set
+func resourceIBMContainerVpcWorkerPoolOutdatedWorkersCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.Get("update_all_workers").(bool) {
+		return nil
+	}
+	if diff.Get("outdated_workers").(int) > 0 {
+		return diff.SetNew("outdated_workers", 0)
+	}
+	return nil
+}
+
+func resourceIBMContainerVpcWorkerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	clusterNameorID := d.Get("cluster").(string)
@@ -174,27 +217,27 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	res, err := workerPoolsAPI.CreateWorkerPool(params, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
 
 	//wait for workerpool availability
-	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
+	_, err = WaitForWorkerPoolAvailable(ctx, d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 	}
 
-	return resourceIBMContainerVpcWorkerPoolUpdate(d, meta)
+	return resourceIBMContainerVpcWorkerPoolUpdate(ctx, d, meta)
 }
 
-func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("labels") && !d.IsNewResource() {
 		clusterNameOrID := d.Get("cluster").(string)
@@ -209,18 +252,18 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		ClusterClient, err := meta.(ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(clusterNameOrID, workerPoolName, labels, Env)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating the labels: %s", err)
+			return diagFromErr(fmt.Errorf(
+				"Error updating the labels: %s", err))
 		}
 	}
 
@@ -230,18 +273,18 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		count := d.Get("worker_count").(int)
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		ClusterClient, err := meta.(ClientSession).ContainerAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
 		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPoolName, count, Env)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating the worker_count %d: %s", count, err)
+			return diagFromErr(fmt.Errorf(
+				"Error updating the worker_count %d: %s", count, err))
 		}
 	}
 
@@ -250,7 +293,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		workerPoolName := d.Get("worker_pool_name").(string)
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		oldList, newList := d.GetChange("zones")
 		if oldList == nil {
@@ -266,7 +309,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		if len(add) > 0 {
 			csClient, err := meta.(ClientSession).VpcContainerAPI()
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			for _, zone := range add {
 				newZone := zone.(map[string]interface{})
@@ -278,12 +321,12 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 				}
 				err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
 				if err != nil {
-					return fmt.Errorf("Error adding zone to conatiner vpc cluster: %s", err)
+					return diagFromErr(newAttributeError("zones", "Error adding zone to conatiner vpc cluster", err))
 				}
-				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
+				_, err = WaitForWorkerPoolAvailable(ctx, d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+					return diagFromErr(fmt.Errorf(
+						"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err))
 				}
 
 			}
@@ -293,32 +336,64 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 				oldZone := zone.(map[string]interface{})
 				ClusterClient, err := meta.(ClientSession).ContainerAPI()
 				if err != nil {
-					return err
+					return diagFromErr(err)
 				}
 				Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), workerPoolName, Env)
 				if err != nil {
-					return fmt.Errorf("Error deleting zone to conatiner vpc cluster: %s", err)
+					return diagFromErr(newAttributeError("zones", "Error deleting zone to conatiner vpc cluster", err))
 				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+				_, err = WaitForV2WorkerZoneDeleted(ctx, clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err)
+					return diagFromErr(fmt.Errorf(
+						"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err))
 				}
 			}
 		}
 	}
-	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
+
+	if d.Get("update_all_workers").(bool) && (d.HasChange("outdated_workers") || d.HasChange("update_all_workers") || workerUpdateResumable(d)) && !d.IsNewResource() {
+		clusterID := d.Get("cluster").(string)
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		csClient, err := meta.(ClientSession).VpcContainerAPI()
+		if err != nil {
+			return diagFromErr(err)
+		}
+		ClusterClient, err := meta.(ClientSession).ContainerAPI()
+		if err != nil {
+			return diagFromErr(err)
+		}
+		cls, err := csClient.Clusters().GetCluster(clusterID, targetEnv)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err))
+		}
+		updates := &vpcWorkerUpdates{
+			client:        csClient,
+			poolClient:    ClusterClient,
+			clusterID:     clusterID,
+			pool:          d.Get("worker_pool_name").(string),
+			masterVersion: cls.MasterKubeVersion,
+			target:        targetEnv,
+		}
+		err = updateContainerWorkers(ctx, d, updates, expandWorkerUpdateStrategy(d), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+	return resourceIBMContainerVpcWorkerPoolRead(ctx, d, meta)
 }
 
-func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cluster := parts[0]
 	workerPoolID := parts[1]
@@ -326,12 +401,16 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
 	if err != nil {
-		return err
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
 	}
 
 	var zones = make([]map[string]interface{}, 0)
@@ -347,7 +426,7 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 
 	cls, err := wpClient.Clusters().GetCluster(cluster, targetEnv)
 	if err != nil {
-		return fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err))
 	}
 
 	d.Set("worker_pool_name", workerPool.PoolName)
@@ -360,22 +439,35 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 	d.Set("cluster", cluster)
 	d.Set("vpc_id", workerPool.VpcID)
 
+	updates := &vpcWorkerUpdates{
+		client:        wpClient,
+		clusterID:     cluster,
+		pool:          workerPool.PoolName,
+		masterVersion: cls.MasterKubeVersion,
+		target:        targetEnv,
+	}
+	outdated, err := updates.OutdatedWorkers()
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error retrieving the workers of worker pool (%s): %s", workerPool.PoolName, err))
+	}
+	d.Set("outdated_workers", len(outdated))
+
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/kubernetes/clusters")
 	return nil
 }
 
-func resourceIBMContainerVpcWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerVpcWorkerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	clusterNameorID := parts[0]
 	workerPoolNameorID := parts[1]
@@ -383,55 +475,24 @@ func resourceIBMContainerVpcWorkerPoolDelete(d *schema.ResourceData, meta interf
 	workerPoolsAPI := wpClient.WorkerPools()
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	err = workerPoolsAPI.DeleteWorkerPool(clusterNameorID, workerPoolNameorID, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	_, err = WaitForVpcWorkerDelete(clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	_, err = WaitForVpcWorkerDelete(ctx, clusterNameorID, workerPoolNameorID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolNameorID, clusterNameorID, err))
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMContainerVpcWorkerPoolExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	wpClient, err := meta.(ClientSession).VpcContainerAPI()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	cluster := parts[0]
-	workerPoolID := parts[1]
-
-	workerPoolsAPI := wpClient.WorkerPools()
-	targetEnv, err := getVpcClusterTargetHeader(d, meta)
-	if err != nil {
-		return false, err
-	}
-
-	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return workerPool.ID == workerPoolID, nil
-}
-
 // WaitForWorkerPoolAvailable Waits for worker creation
-func WaitForWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerPoolAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -448,7 +509,7 @@ func WaitForWorkerPoolAvailable(d *schema.ResourceData, meta interface{}, cluste
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -472,7 +533,7 @@ func vpcWorkerPoolStateRefreshFunc(client v2.Workers, instanceID string, workerP
 	}
 }
 
-func WaitForVpcWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+func WaitForVpcWorkerDelete(ctx context.Context, clusterNameOrID, workerPoolNameOrID string, meta interface{}, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
 	wpClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -487,7 +548,7 @@ func WaitForVpcWorkerDelete(clusterNameOrID, workerPoolNameOrID string, meta int
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPoolNameOrID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
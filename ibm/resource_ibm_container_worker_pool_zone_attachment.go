@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,12 +19,13 @@ import (
 func resourceIBMContainerWorkerPoolZoneAttachment() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMContainerWorkerPoolZoneAttachmentCreate,
-		Read:     resourceIBMContainerWorkerPoolZoneAttachmentRead,
-		Update:   resourceIBMContainerWorkerPoolZoneAttachmentUpdate,
-		Delete:   resourceIBMContainerWorkerPoolZoneAttachmentDelete,
-		Exists:   resourceIBMContainerWorkerPoolZoneAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerWorkerPoolZoneAttachmentCreate,
+		ReadContext:   resourceIBMContainerWorkerPoolZoneAttachmentRead,
+		UpdateContext: resourceIBMContainerWorkerPoolZoneAttachmentUpdate,
+		DeleteContext: resourceIBMContainerWorkerPoolZoneAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerWorkerPoolZoneAttachment, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Update: schema.DefaultTimeout(90 * time.Minute),
@@ -94,10 +97,10 @@ func resourceIBMContainerWorkerPoolZoneAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	zone := d.Get("zone").(string)
@@ -111,8 +114,8 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	}
 
 	if publicVLAN != "" && privateVLAN == "" {
-		return fmt.Errorf(
-			"A private_vlan_id must be specified if a public_vlan_id is specified.")
+		return diagFromErr(fmt.Errorf(
+			"A private_vlan_id must be specified if a public_vlan_id is specified."))
 	}
 
 	workerPoolZoneNetwork := v1.WorkerPoolZoneNetwork{
@@ -131,19 +134,19 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	err = workerPoolsAPI.AddZone(cluster, workerPool, workerPoolZone, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, workerPool, zone))
 
-	_, err = WaitForWorkerZoneNormal(cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+	_, err = WaitForWorkerZoneNormal(ctx, cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPool, cluster, err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for workers of worker pool (%s) of cluster (%s) to become ready: %s", workerPool, cluster, err))
 	}
 
 	var waitTillALBs bool
@@ -152,25 +155,25 @@ func resourceIBMContainerWorkerPoolZoneAttachmentCreate(d *schema.ResourceData,
 	}
 
 	if waitTillALBs {
-		_, err = waitForWorkerZoneALB(cluster, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		_, err = waitForWorkerZoneALB(ctx, cluster, zone, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for ALBs in zone (%s) of cluster (%s) to become ready: %s", zone, cluster, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for ALBs in zone (%s) of cluster (%s) to become ready: %s", zone, cluster, err))
 		}
 	}
 
-	return resourceIBMContainerWorkerPoolZoneAttachmentRead(d, meta)
+	return resourceIBMContainerWorkerPoolZoneAttachmentRead(ctx, d, meta)
 
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cluster := parts[0]
 	workerPool := parts[1]
@@ -179,12 +182,16 @@ func resourceIBMContainerWorkerPoolZoneAttachmentRead(d *schema.ResourceData, me
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	workerPoolRes, err := workerPoolsAPI.GetWorkerPool(cluster, workerPool, targetEnv)
 	if err != nil {
-		return err
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
 	}
 	zones := workerPoolRes.Zones
 
@@ -197,17 +204,18 @@ func resourceIBMContainerWorkerPoolZoneAttachmentRead(d *schema.ResourceData, me
 			d.Set("cluster", cluster)
 			d.Set("worker_pool", workerPool)
 
-			break
+			return nil
 		}
 	}
 
+	d.SetId("")
 	return nil
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	workerPoolsAPI := csClient.WorkerPools()
@@ -216,38 +224,38 @@ func resourceIBMContainerWorkerPoolZoneAttachmentUpdate(d *schema.ResourceData,
 		privateVLAN := d.Get("private_vlan_id").(string)
 		publicVLAN := d.Get("public_vlan_id").(string)
 		if publicVLAN != "" && privateVLAN == "" {
-			return fmt.Errorf(
-				"A private VLAN must be specified if a public VLAN is specified.")
+			return diagFromErr(fmt.Errorf(
+				"A private VLAN must be specified if a public VLAN is specified."))
 		}
 		targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		cluster := parts[0]
 		workerPool := parts[1]
 		zone := parts[2]
 		err = workerPoolsAPI.UpdateZoneNetwork(cluster, zone, workerPool, privateVLAN, publicVLAN, targetEnv)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMContainerWorkerPoolZoneAttachmentRead(d, meta)
+	return resourceIBMContainerWorkerPoolZoneAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerWorkerPoolZoneAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	cluster := parts[0]
 	workerPool := parts[1]
@@ -256,60 +264,22 @@ func resourceIBMContainerWorkerPoolZoneAttachmentDelete(d *schema.ResourceData,
 	workerPoolsAPI := csClient.WorkerPools()
 	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	err = workerPoolsAPI.RemoveZone(cluster, zone, workerPool, targetEnv)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	_, err = WaitForWorkerZoneDeleted(cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	_, err = WaitForWorkerZoneDeleted(ctx, cluster, workerPool, zone, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPool, cluster, err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPool, cluster, err))
 	}
 
 	return nil
 }
 
-func resourceIBMContainerWorkerPoolZoneAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	csClient, err := meta.(ClientSession).ContainerAPI()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	cluster := parts[0]
-	workerPoolID := parts[1]
-	zoneID := parts[2]
-
-	workerPoolsAPI := csClient.WorkerPools()
-	targetEnv, err := getWorkerPoolTargetHeader(d, meta)
-	if err != nil {
-		return false, err
-	}
-
-	workerPool, err := workerPoolsAPI.GetWorkerPool(cluster, workerPoolID, targetEnv)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	zones := workerPool.Zones
-	var zone v1.WorkerPoolZoneResponse
-	for _, z := range zones {
-		if z.ID == zoneID {
-			zone = z
-		}
-	}
-	return zone.ID == zoneID, nil
-}
-
-func WaitForWorkerZoneNormal(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerZoneNormal(ctx context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -324,7 +294,7 @@ func WaitForWorkerZoneNormal(clusterNameOrID, workerPoolNameOrID, zone string, m
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -347,7 +317,7 @@ func workerPoolZoneStateRefreshFunc(client v1.Workers, instanceID, workerPoolNam
 	}
 }
 
-func WaitForWorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func WaitForWorkerZoneDeleted(ctx context.Context, clusterNameOrID, workerPoolNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -362,7 +332,7 @@ func WaitForWorkerZoneDeleted(clusterNameOrID, workerPoolNameOrID, zone string,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func workerPoolZoneDeleteStateRefreshFunc(client v1.Workers, instanceID, workerPoolNameOrID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
@@ -383,7 +353,7 @@ func workerPoolZoneDeleteStateRefreshFunc(client v1.Workers, instanceID, workerP
 	}
 }
 
-func waitForWorkerZoneALB(clusterNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+func waitForWorkerZoneALB(ctx context.Context, clusterNameOrID, zone string, meta interface{}, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
 	csClient, err := meta.(ClientSession).ContainerAPI()
 	if err != nil {
 		return nil, err
@@ -398,7 +368,7 @@ func waitForWorkerZoneALB(clusterNameOrID, zone string, meta interface{}, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func workerZoneALBStateRefreshFunc(client v1.Albs, instanceID, zone string, target v1.ClusterTargetHeader) resource.StateRefreshFunc {
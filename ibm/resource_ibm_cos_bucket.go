@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -11,12 +12,14 @@ import (
 
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -43,13 +46,15 @@ const (
 )
 
 func resourceIBMCOS() *schema.Resource {
-	return &schema.Resource{
-		Read:     resourceIBMCOSRead,
-		Create:   resourceIBMCOSCreate,
-		Update:   resourceIBMCOSUpdate,
-		Delete:   resourceIBMCOSDelete,
-		Exists:   resourceIBMCOSExists,
-		Importer: &schema.ResourceImporter{},
+	return withStateUpgrades(&schema.Resource{
+		ReadContext:   resourceIBMCOSRead,
+		CreateContext: resourceIBMCOSCreate,
+		UpdateContext: resourceIBMCOSUpdate,
+		DeleteContext: resourceIBMCOSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMCOSImport,
+		},
+		CustomizeDiff: InvokeCustomizeDiff("ibm_cos_bucket"),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -252,6 +257,195 @@ func resourceIBMCOS() *schema.Resource {
 					},
 				},
 			},
+			"noncurrent_version_expiration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1000,
+				Description: "Enable configuration noncurrent_version_expiration to COS Bucket to delete the noncurrent versions of the objects after a defined period of time",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Unique identifier for the rule. Set Rule ID for cos bucket",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Enable or disable a noncurrent version expiration rule for a bucket",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The rule applies to any objects with keys that match this prefix",
+						},
+						"noncurrent_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateAllowedRangeInt(1, 3650),
+							Description:  "Specifies the number of days an object is noncurrent before it is deleted.",
+						},
+					},
+				},
+			},
+			"abort_incomplete_multipart_upload": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1000,
+				Description: "Enable configuration abort_incomplete_multipart_upload to COS Bucket to stop the multipart uploads that are not completed after a defined period of time",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Unique identifier for the rule. Set Rule ID for cos bucket",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Enable or disable an abort incomplete multipart upload rule for a bucket",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The rule applies to any objects with keys that match this prefix",
+						},
+						"days_after_initiation": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateAllowedRangeInt(1, 3650),
+							Description:  "Specifies the number of days after the start of an upload when it is stopped and its parts are deleted.",
+						},
+					},
+				},
+			},
+			"object_versioning": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Protect objects from accidental deletion or overwrites. Versioning allows you to keep multiple versions of an object protecting from unintentional data loss.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Enable or suspend the versioning for a bucket",
+						},
+					},
+				},
+			},
+			"replication_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1000,
+				Description: "Replicate the objects of the bucket to a destination bucket. Object versioning must be enabled on both buckets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Unique identifier for the rule. Set Rule ID for cos bucket",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Enable or disable a replication rule for a bucket",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The rule applies to any objects with keys that match this prefix",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "A priority is associated with each rule. The rule with the highest priority wins when an object matches several rules.",
+						},
+						"deletemarker_replication_status": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the delete markers are replicated",
+						},
+						"destination_bucket_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the destination bucket",
+						},
+					},
+				},
+			},
+			"retention_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A retention policy is enabled at the IBM Cloud Object Storage bucket level. Minimum, maximum and default retention period are defined by this policy and apply to all objects in the bucket. A retention policy cannot be removed once it is set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateAllowedRangeInt(0, 365243),
+							Description:  "If an object is stored in the bucket without specifying a custom retention period.",
+						},
+						"maximum": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateAllowedRangeInt(0, 365243),
+							Description:  "Maximum duration of time an object can be kept unmodified in the bucket.",
+						},
+						"minimum": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateAllowedRangeInt(0, 365243),
+							Description:  "Minimum duration of time an object must be kept unmodified in the bucket",
+						},
+						"permanent": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Enable or disable the permanent retention of the objects",
+						},
+					},
+				},
+			},
+			"object_lock": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Enable Object Lock to store the objects of the bucket in a WORM model. Object versioning must be enabled and Object Lock cannot be disabled once it is enabled.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_retention_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "COMPLIANCE",
+							ValidateFunc: validateAllowedStringValue([]string{"COMPLIANCE"}),
+							Description:  "The retention mode applied to the new objects",
+						},
+						"default_retention_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateAllowedRangeInt(1, 36500),
+							Description:  "The number of days the new objects are retained",
+						},
+						"default_retention_years": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateAllowedRangeInt(1, 100),
+							Description:  "The number of years the new objects are retained",
+						},
+					},
+				},
+			},
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -259,7 +453,31 @@ func resourceIBMCOS() *schema.Resource {
 				Description: "COS buckets need to be empty before they can be deleted. force_delete option empty the bucket and delete it.",
 			},
 		},
+	}, upgradeStateID(upgradeCOSBucketID))
+}
+
+func resourceIBMCOSBucketValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 0)
+	rules := []ValidateRule{
+		{
+			Type:        ExactlyOneOf,
+			Identifiers: []string{"single_site_location", "region_location", "cross_region_location"},
+		},
+		{
+			Type:          AllowedValuesWith,
+			Identifier:    "object_versioning.0.enable",
+			Identifiers:   []string{"replication_rule", "object_lock"},
+			AllowedValues: "true",
+		},
+		{
+			Type:        ConflictsWith,
+			Identifier:  "object_lock.0.default_retention_days",
+			Identifiers: []string{"object_lock.0.default_retention_years"},
+		},
 	}
+
+	ibmCOSBucketResourceValidator := ResourceValidator{ResourceName: "ibm_cos_bucket", Schema: validateSchema, Rules: rules}
+	return &ibmCOSBucketResourceValidator
 }
 
 func archiveRuleList(archiveList []interface{}) []*s3.LifecycleRule {
@@ -360,25 +578,137 @@ func expireRuleList(expireList []interface{}) []*s3.LifecycleRule {
 	return rules
 }
 
-func resourceIBMCOSUpdate(d *schema.ResourceData, meta interface{}) error {
+// cosRuleStatus returns the status of a COS bucket rule from its enable argument
+func cosRuleStatus(enable bool) string {
+	if enable {
+		return "Enabled"
+	}
+	return "Disabled"
+}
+
+func noncurrentVersionExpirationRuleList(ruleList []interface{}) []*s3.LifecycleRule {
+	var rules []*s3.LifecycleRule
+	for _, l := range ruleList {
+		ruleMap, _ := l.(map[string]interface{})
+		rules = append(rules, &s3.LifecycleRule{
+			ID:     aws.String(ruleMap["rule_id"].(string)),
+			Status: aws.String(cosRuleStatus(ruleMap["enable"].(bool))),
+			Filter: &s3.LifecycleRuleFilter{
+				Prefix: aws.String(ruleMap["prefix"].(string)),
+			},
+			NoncurrentVersionExpiration: &s3.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(int64(ruleMap["noncurrent_days"].(int))),
+			},
+		})
+	}
+	return rules
+}
+
+func abortIncompleteMultipartUploadRuleList(ruleList []interface{}) []*s3.LifecycleRule {
+	var rules []*s3.LifecycleRule
+	for _, l := range ruleList {
+		ruleMap, _ := l.(map[string]interface{})
+		rules = append(rules, &s3.LifecycleRule{
+			ID:     aws.String(ruleMap["rule_id"].(string)),
+			Status: aws.String(cosRuleStatus(ruleMap["enable"].(bool))),
+			Filter: &s3.LifecycleRuleFilter{
+				Prefix: aws.String(ruleMap["prefix"].(string)),
+			},
+			AbortIncompleteMultipartUpload: &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(ruleMap["days_after_initiation"].(int))),
+			},
+		})
+	}
+	return rules
+}
+
+func replicationRuleList(ruleList []interface{}) []*s3.ReplicationRule {
+	var rules []*s3.ReplicationRule
+	for _, l := range ruleList {
+		ruleMap, _ := l.(map[string]interface{})
+		rule := &s3.ReplicationRule{
+			Status: aws.String(cosRuleStatus(ruleMap["enable"].(bool))),
+			Filter: &s3.ReplicationRuleFilter{
+				Prefix: aws.String(ruleMap["prefix"].(string)),
+			},
+			Priority: aws.Int64(int64(ruleMap["priority"].(int))),
+			DeleteMarkerReplication: &s3.DeleteMarkerReplication{
+				Status: aws.String(cosRuleStatus(ruleMap["deletemarker_replication_status"].(bool))),
+			},
+			Destination: &s3.Destination{
+				Bucket: aws.String(ruleMap["destination_bucket_crn"].(string)),
+			},
+		}
+		if ruleID := ruleMap["rule_id"].(string); ruleID != "" {
+			rule.ID = aws.String(ruleID)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func retentionRuleProtection(retentionList []interface{}) *s3.ProtectionConfiguration {
+	retentionMap, _ := retentionList[0].(map[string]interface{})
+	return &s3.ProtectionConfiguration{
+		Status: aws.String("COMPLIANCE"),
+		DefaultRetention: &s3.BucketProtectionDefaultRetention{
+			Days: aws.Int64(int64(retentionMap["default"].(int))),
+		},
+		MaximumRetention: &s3.BucketProtectionMaximumRetention{
+			Days: aws.Int64(int64(retentionMap["maximum"].(int))),
+		},
+		MinimumRetention: &s3.BucketProtectionMinimumRetention{
+			Days: aws.Int64(int64(retentionMap["minimum"].(int))),
+		},
+		EnablePermanentRetention: aws.Bool(retentionMap["permanent"].(bool)),
+	}
+}
 
-	//// Update  the lifecycle (Archive or Expire)
-	if d.HasChange("archive_rule") || d.HasChange("expire_rule") {
+func objectLockConfiguration(objectLockList []interface{}) *s3.ObjectLockConfiguration {
+	config := &s3.ObjectLockConfiguration{
+		ObjectLockEnabled: aws.String("Enabled"),
+	}
+	objectLockMap, _ := objectLockList[0].(map[string]interface{})
+	if objectLockMap == nil {
+		return config
+	}
+	retention := &s3.DefaultRetention{
+		Mode: aws.String(objectLockMap["default_retention_mode"].(string)),
+	}
+	if days := objectLockMap["default_retention_days"].(int); days > 0 {
+		retention.Days = aws.Int64(int64(days))
+	}
+	if years := objectLockMap["default_retention_years"].(int); years > 0 {
+		retention.Years = aws.Int64(int64(years))
+	}
+	if retention.Days != nil || retention.Years != nil {
+		config.Rule = &s3.ObjectLockRule{DefaultRetention: retention}
+	}
+	return config
+}
+
+func resourceIBMCOSUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	if d.HasChanges("archive_rule", "expire_rule", "noncurrent_version_expiration", "abort_incomplete_multipart_upload", "object_versioning", "replication_rule", "retention_rule", "object_lock") {
 		var s3Conf *aws.Config
 		rsConClient, err := meta.(ClientSession).BluemixSession()
 		if err != nil {
-			return err
+			return diagFromErr(err)
+		}
+		bucketID, err := parseCOSBucketID(d.Id())
+		if err != nil {
+			return diagFromErr(err)
 		}
-		bucketName := parseBucketId(d.Id(), "bucketName")
-		serviceID := parseBucketId(d.Id(), "serviceID")
-		endpointType := parseBucketId(d.Id(), "endpointType")
-		apiEndpoint, apiEndpointPrivate := selectCosApi(parseBucketId(d.Id(), "apiType"), parseBucketId(d.Id(), "bLocation"))
+		bucketName := bucketID.bucketName
+		serviceID := bucketID.serviceID
+		endpointType := bucketID.endpointType
+		apiEndpoint, apiEndpointPrivate := selectCosApi(bucketID.bucketType, bucketID.location)
 		if endpointType == "private" {
 			apiEndpoint = apiEndpointPrivate
 		}
 		authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 		apiKey := rsConClient.Config.BluemixAPIKey
@@ -401,47 +731,124 @@ func resourceIBMCOSUpdate(d *schema.ResourceData, meta interface{}) error {
 		s3Sess := session.Must(session.NewSession())
 		s3Client := s3.New(s3Sess, s3Conf)
 
-		var archive, archive_ok = d.GetOk("archive_rule")
-		var expire, expire_ok = d.GetOk("expire_rule")
-		var rules []*s3.LifecycleRule
-		if archive_ok || expire_ok {
-			if expire_ok {
+		// The replication rules and the object lock need the versioning, it is enabled
+		// before them and suspended after the replication rules are removed
+		versioningEnabled := cosObjectVersioningEnabled(d.Get("object_versioning").([]interface{}))
+		if d.HasChange("object_versioning") && versioningEnabled {
+			if err := updateCOSObjectVersioning(s3Client, bucketName, versioningEnabled); err != nil {
+				return diagFromErr(err)
+			}
+		}
+
+		//// Update  the lifecycle (Archive, Expire, Noncurrent version expiration or Abort incomplete multipart upload)
+		if d.HasChanges("archive_rule", "expire_rule", "noncurrent_version_expiration", "abort_incomplete_multipart_upload") {
+			var rules []*s3.LifecycleRule
+			if expire, ok := d.GetOk("expire_rule"); ok {
 				rules = append(rules, expireRuleList(expire.([]interface{}))...)
 			}
-			if archive_ok {
+			if archive, ok := d.GetOk("archive_rule"); ok {
 				rules = append(rules, archiveRuleList(archive.([]interface{}))...)
 			}
+			if noncurrent, ok := d.GetOk("noncurrent_version_expiration"); ok {
+				rules = append(rules, noncurrentVersionExpirationRuleList(noncurrent.([]interface{}))...)
+			}
+			if abort, ok := d.GetOk("abort_incomplete_multipart_upload"); ok {
+				rules = append(rules, abortIncompleteMultipartUploadRuleList(abort.([]interface{}))...)
+			}
 
-			lInput := &s3.PutBucketLifecycleConfigurationInput{
-				Bucket: aws.String(bucketName),
-				LifecycleConfiguration: &s3.LifecycleConfiguration{
-					Rules: rules,
-				},
+			if len(rules) > 0 {
+				lInput := &s3.PutBucketLifecycleConfigurationInput{
+					Bucket: aws.String(bucketName),
+					LifecycleConfiguration: &s3.LifecycleConfiguration{
+						Rules: rules,
+					},
+				}
+				_, err := s3Client.PutBucketLifecycleConfiguration(lInput)
+				if err != nil {
+					return diagFromErr(fmt.Errorf("failed to update the lifecycle rules on COS bucket %s, %v", bucketName, err))
+				}
+
+			} else {
+				DelInput := &s3.DeleteBucketLifecycleInput{
+					Bucket: aws.String(bucketName),
+				}
+
+				delarchive, _ := s3Client.DeleteBucketLifecycleRequest(DelInput)
+				err := delarchive.Send()
+				if err != nil {
+					return diagFromErr(err)
+				}
+			}
+		}
+
+		if d.HasChange("retention_rule") {
+			retention, ok := d.GetOk("retention_rule")
+			if !ok {
+				return diagFromErr(fmt.Errorf("The retention policy of the COS bucket %s cannot be removed once it is set", bucketName))
+			}
+			pInput := &s3.PutBucketProtectionConfigurationInput{
+				Bucket:                  aws.String(bucketName),
+				ProtectionConfiguration: retentionRuleProtection(retention.([]interface{})),
 			}
-			_, err := s3Client.PutBucketLifecycleConfiguration(lInput)
+			_, err := s3Client.PutBucketProtectionConfiguration(pInput)
 			if err != nil {
-				return fmt.Errorf("failed to update the archive rule on COS bucket %s, %v", bucketName, err)
+				return diagFromErr(newAttributeError("retention_rule", fmt.Sprintf("failed to update the retention rule on COS bucket %s", bucketName), err))
 			}
+		}
 
-		} else {
-			DelInput := &s3.DeleteBucketLifecycleInput{
-				Bucket: aws.String(bucketName),
+		if d.HasChange("object_lock") {
+			objectLock, ok := d.GetOk("object_lock")
+			if !ok {
+				return diagFromErr(fmt.Errorf("The object lock of the COS bucket %s cannot be disabled once it is enabled", bucketName))
 			}
-
-			delarchive, _ := s3Client.DeleteBucketLifecycleRequest(DelInput)
-			err := delarchive.Send()
+			oInput := &s3.PutObjectLockConfigurationInput{
+				Bucket:                  aws.String(bucketName),
+				ObjectLockConfiguration: objectLockConfiguration(objectLock.([]interface{})),
+			}
+			_, err := s3Client.PutObjectLockConfiguration(oInput)
 			if err != nil {
-				return err
+				return diagFromErr(newAttributeError("object_lock", fmt.Sprintf("failed to update the object lock on COS bucket %s", bucketName), err))
+			}
+		}
+
+		if d.HasChange("replication_rule") {
+			if replication, ok := d.GetOk("replication_rule"); ok {
+				rInput := &s3.PutBucketReplicationInput{
+					Bucket: aws.String(bucketName),
+					ReplicationConfiguration: &s3.ReplicationConfiguration{
+						Rules: replicationRuleList(replication.([]interface{})),
+					},
+				}
+				_, err := s3Client.PutBucketReplication(rInput)
+				if err != nil {
+					return diagFromErr(newAttributeError("replication_rule", fmt.Sprintf("failed to update the replication rules on COS bucket %s", bucketName), err))
+				}
+			} else {
+				_, err := s3Client.DeleteBucketReplication(&s3.DeleteBucketReplicationInput{
+					Bucket: aws.String(bucketName),
+				})
+				if err != nil {
+					return diagFromErr(newAttributeError("replication_rule", fmt.Sprintf("failed to delete the replication rules on COS bucket %s", bucketName), err))
+				}
+			}
+		}
+
+		if d.HasChange("object_versioning") && !versioningEnabled {
+			if err := updateCOSObjectVersioning(s3Client, bucketName, versioningEnabled); err != nil {
+				return diagFromErr(err)
 			}
 		}
 	}
 
 	sess, err := meta.(ClientSession).CosConfigV1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	endpointType := parseBucketId(d.Id(), "endpointType")
-	if endpointType == "private" {
+	bucketID, err := parseCOSBucketID(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if bucketID.endpointType == "private" {
 		sess.SetServiceURL("https://config.private.cloud-object-storage.cloud.ibm.com/v1")
 	}
 
@@ -520,30 +927,61 @@ func resourceIBMCOSUpdate(d *schema.ResourceData, meta interface{}) error {
 	if hasChanged {
 		response, err := sess.UpdateBucketConfig(updateBucketConfigOptions)
 		if err != nil {
-			return fmt.Errorf("Error Update COS Bucket: %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error Update COS Bucket: %s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMCOSRead(d, meta)
+	return resourceIBMCOSRead(ctx, d, meta)
 }
 
-func resourceIBMCOSRead(d *schema.ResourceData, meta interface{}) error {
+func cosObjectVersioningEnabled(versioningList []interface{}) bool {
+	if len(versioningList) == 0 || versioningList[0] == nil {
+		return false
+	}
+	return versioningList[0].(map[string]interface{})["enable"].(bool)
+}
+
+// updateCOSObjectVersioning enables or suspends the versioning of the bucket, a bucket cannot
+// go back to the unversioned state once its versioning has been enabled
+func updateCOSObjectVersioning(s3Client *s3.S3, bucketName string, enable bool) error {
+	status := s3.BucketVersioningStatusSuspended
+	if enable {
+		status = s3.BucketVersioningStatusEnabled
+	}
+	vInput := &s3.PutBucketVersioningInput{
+		Bucket: aws.String(bucketName),
+		VersioningConfiguration: &s3.VersioningConfiguration{
+			Status: aws.String(status),
+		},
+	}
+	_, err := s3Client.PutBucketVersioning(vInput)
+	if err != nil {
+		return fmt.Errorf("failed to update the versioning on COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
+
+func resourceIBMCOSRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
+	}
+	bucketID, err := parseCOSBucketID(d.Id())
+	if err != nil {
+		return diagFromErr(err)
 	}
-	bucketName := parseBucketId(d.Id(), "bucketName")
-	serviceID := parseBucketId(d.Id(), "serviceID")
-	endpointType := parseBucketId(d.Id(), "endpointType")
-	apiEndpoint, apiEndpointPrivate := selectCosApi(parseBucketId(d.Id(), "apiType"), parseBucketId(d.Id(), "bLocation"))
+	bucketName := bucketID.bucketName
+	serviceID := bucketID.serviceID
+	endpointType := bucketID.endpointType
+	apiEndpoint, apiEndpointPrivate := selectCosApi(bucketID.bucketType, bucketID.location)
 	if endpointType == "private" {
 		apiEndpoint = apiEndpointPrivate
 	}
 	apiEndpoint = envFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
@@ -569,35 +1007,42 @@ func resourceIBMCOSRead(d *schema.ResourceData, meta interface{}) error {
 	headInput := &s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
 	}
-	err = s3Client.WaitUntilBucketExists(headInput)
-	if err != nil {
-		return fmt.Errorf("failed waiting for bucket %s to be created, %v",
-			bucketName, err)
-	}
-
 	bucketOutput, err := s3Client.ListBucketsExtended(&s3.ListBucketsExtendedInput{})
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var bLocationConstraint string
+	bucketFound := false
 	for _, b := range bucketOutput.Buckets {
 		if *b.Name == bucketName {
 			bLocationConstraint = *b.LocationConstraint
+			bucketFound = true
 		}
 	}
+	// A bucket that was just created may not be listed yet
+	if !bucketFound && !d.IsNewResource() {
+		d.SetId("")
+		return nil
+	}
+
+	err = s3Client.WaitUntilBucketExists(headInput)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed waiting for bucket %s to be created, %v",
+			bucketName, err))
+	}
 
 	singleSiteLocationRegex, err := regexp.Compile("^[a-z]{3}[0-9][0-9]-[a-z]{4,8}$")
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	regionLocationRegex, err := regexp.Compile("^[a-z]{2}-[a-z]{2,5}-[a-z]{4,8}$")
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crossRegionLocationRegex, err := regexp.Compile("^[a-z]{2}-[a-z]{4,8}$")
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if singleSiteLocationRegex.MatchString(bLocationConstraint) {
@@ -629,7 +1074,7 @@ func resourceIBMCOSRead(d *schema.ResourceData, meta interface{}) error {
 
 	sess, err := meta.(ClientSession).CosConfigV1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if endpointType == "private" {
 		sess.SetServiceURL("https://config.private.cloud-object-storage.cloud.ibm.com/v1")
@@ -637,7 +1082,7 @@ func resourceIBMCOSRead(d *schema.ResourceData, meta interface{}) error {
 
 	bucketPtr, response, err := sess.GetBucketConfig(getBucketConfigOptions)
 	if err != nil {
-		return fmt.Errorf("Error in getting bucket info rule: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error in getting bucket info rule: %s\n%s", err, response))
 	}
 
 	if bucketPtr != nil {
@@ -661,7 +1106,7 @@ func resourceIBMCOSRead(d *schema.ResourceData, meta interface{}) error {
 	lifecycleptr, err := s3Client.GetBucketLifecycleConfiguration(gInput)
 
 	if (err != nil && !strings.Contains(err.Error(), "NoSuchLifecycleConfiguration: The lifecycle configuration does not exist")) && (err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
-		return err
+		return diagFromErr(err)
 	}
 
 	if lifecycleptr != nil {
@@ -673,16 +1118,84 @@ func resourceIBMCOSRead(d *schema.ResourceData, meta interface{}) error {
 		if len(expireRules) > 0 {
 			d.Set("expire_rule", expireRules)
 		}
+		d.Set("noncurrent_version_expiration", noncurrentVersionExpirationRuleGet(lifecycleptr.Rules))
+		d.Set("abort_incomplete_multipart_upload", abortIncompleteMultipartUploadRuleGet(lifecycleptr.Rules))
+	}
+
+	firewall := bucketPtr != nil && bucketPtr.Firewall != nil
+	versioning, err := s3Client.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err = ignoreCOSBucketConfigError(err, firewall); err != nil {
+		return diagFromErr(fmt.Errorf("Error getting the versioning of the COS bucket %s: %s", bucketName, err))
+	}
+	// The versioning has no status until it is enabled for the first time
+	if versioning != nil && versioning.Status != nil {
+		d.Set("object_versioning", []interface{}{
+			map[string]interface{}{"enable": *versioning.Status == s3.BucketVersioningStatusEnabled},
+		})
+	}
+
+	replication, err := s3Client.GetBucketReplication(&s3.GetBucketReplicationInput{
+		Bucket: aws.String(bucketName),
+	})
+	if isCOSBucketConfigNotFound(err, "ReplicationConfigurationNotFoundError") {
+		d.Set("replication_rule", []interface{}{})
+	} else if err = ignoreCOSBucketConfigError(err, firewall); err != nil {
+		return diagFromErr(fmt.Errorf("Error getting the replication rules of the COS bucket %s: %s", bucketName, err))
+	} else if replication != nil {
+		d.Set("replication_rule", replicationRuleGet(replication.ReplicationConfiguration))
+	}
+
+	protection, err := s3Client.GetBucketProtectionConfiguration(&s3.GetBucketProtectionConfigurationInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err = ignoreCOSBucketConfigError(err, firewall); err != nil {
+		return diagFromErr(fmt.Errorf("Error getting the retention rule of the COS bucket %s: %s", bucketName, err))
+	}
+	if protection != nil {
+		d.Set("retention_rule", retentionRuleGet(protection.ProtectionConfiguration))
+	}
+
+	objectLock, err := s3Client.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(bucketName),
+	})
+	if isCOSBucketConfigNotFound(err, "ObjectLockConfigurationNotFoundError") {
+		d.Set("object_lock", []interface{}{})
+	} else if err = ignoreCOSBucketConfigError(err, firewall); err != nil {
+		return diagFromErr(fmt.Errorf("Error getting the object lock of the COS bucket %s: %s", bucketName, err))
+	} else if objectLock != nil {
+		d.Set("object_lock", objectLockGet(objectLock.ObjectLockConfiguration))
 	}
 
 	return nil
 }
 
-func resourceIBMCOSCreate(d *schema.ResourceData, meta interface{}) error {
+// ignoreCOSBucketConfigError returns nil when the S3 request reading a configuration of the bucket
+// failed because the firewall of the bucket denies the access, the configuration in the state is
+// kept then
+func ignoreCOSBucketConfigError(err error, firewall bool) error {
+	if err == nil {
+		return nil
+	}
+	if awsErr, ok := err.(awserr.Error); ok && firewall && awsErr.Code() == "AccessDenied" {
+		return nil
+	}
+	return err
+}
+
+// isCOSBucketConfigNotFound reports whether the S3 request reading a configuration of the bucket
+// failed with notFoundCode because the configuration is not set
+func isCOSBucketConfigNotFound(err error, notFoundCode string) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == notFoundCode
+}
+
+func resourceIBMCOSCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	bucketName := d.Get("bucket_name").(string)
 	storageClass := d.Get("storage_class").(string)
@@ -703,7 +1216,7 @@ func resourceIBMCOSCreate(d *schema.ResourceData, meta interface{}) error {
 		apiType = "ssl"
 	}
 	if bLocation == "" {
-		return fmt.Errorf("Provide either `cross_region_location` or `region_location` or `single_site_location`")
+		return diagFromErr(fmt.Errorf("Provide either `cross_region_location` or `region_location` or `single_site_location`"))
 	}
 	lConstraint := fmt.Sprintf("%s-%s", bLocation, storageClass)
 	var endpointType = d.Get("endpoint_type").(string)
@@ -713,7 +1226,7 @@ func resourceIBMCOSCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	apiEndpoint = envFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 	if apiEndpoint == "" {
-		return fmt.Errorf("The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType)
+		return diagFromErr(fmt.Errorf("The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType))
 	}
 	create := &s3.CreateBucketInput{
 		Bucket: aws.String(bucketName),
@@ -729,7 +1242,7 @@ func resourceIBMCOSCreate(d *schema.ResourceData, meta interface{}) error {
 
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 	apiKey := rsConClient.Config.BluemixAPIKey
@@ -755,20 +1268,47 @@ func resourceIBMCOSCreate(d *schema.ResourceData, meta interface{}) error {
 
 	_, err = s3Client.CreateBucket(create)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	// Generating a fake id which contains every information about to get the bucket via s3 api
-	bucketID := fmt.Sprintf("%s:%s:%s:meta:%s:%s:%s", strings.Replace(serviceID, "::", "", -1), "bucket", bucketName, apiType, bLocation, endpointType)
-	d.SetId(bucketID)
+	bucketID := cosBucketID{
+		bucketName:   bucketName,
+		bucketType:   apiType,
+		location:     bLocation,
+		endpointType: endpointType,
+		serviceID:    serviceID,
+	}
+	d.SetId(bucketID.String())
 
-	return resourceIBMCOSUpdate(d, meta)
+	return resourceIBMCOSUpdate(ctx, d, meta)
 
 }
 
-func resourceIBMCOSDelete(d *schema.ResourceData, meta interface{}) error {
+// resourceIBMCOSImport accepts the composite ID of the bucket or its legacy ID
+// "<bucket_crn>:meta:<bucket_type>:<location>[:<endpoint_type>]"
+func resourceIBMCOSImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := parseCOSBucketID(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+	bucketID, ok := parseLegacyCOSBucketID(d.Id())
+	if !ok || bucketID.bucketType == "" || bucketID.location == "" {
+		return nil, fmt.Errorf("Invalid COS bucket ID %q, expected <bucket_name>:<bucket_type>:<location>:<endpoint_type>:<resource_instance_id>", d.Id())
+	}
+	if bucketID.endpointType == "" {
+		bucketID.endpointType = "public"
+	}
+	d.SetId(bucketID.String())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceIBMCOSDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var s3Conf *aws.Config
 	rsConClient, _ := meta.(ClientSession).BluemixSession()
-	bucketName := parseBucketId(d.Id(), "bucketName")
+	bucketID, err := parseCOSBucketID(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	bucketName := bucketID.bucketName
 	serviceID := d.Get("resource_instance_id").(string)
 	var bLocation string
 	var apiType string
@@ -784,18 +1324,18 @@ func resourceIBMCOSDelete(d *schema.ResourceData, meta interface{}) error {
 		bLocation = bucketLocation.(string)
 		apiType = "ssl"
 	}
-	endpointType := parseBucketId(d.Id(), "endpointType")
+	endpointType := bucketID.endpointType
 	apiEndpoint, apiEndpointPrivate := selectCosApi(apiType, bLocation)
 	if endpointType == "private" {
 		apiEndpoint = apiEndpointPrivate
 	}
 	apiEndpoint = envFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
 	if apiEndpoint == "" {
-		return fmt.Errorf("The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType)
+		return diagFromErr(fmt.Errorf("The endpoint doesn't exists for given location %s and endpoint type %s", bLocation, endpointType))
 	}
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
 
@@ -826,14 +1366,14 @@ func resourceIBMCOSDelete(d *schema.ResourceData, meta interface{}) error {
 			// List objects within a bucket
 			resp, err := s3Client.ListObjects(&s3.ListObjectsInput{Bucket: aws.String(bucketName)})
 			if err != nil {
-				return fmt.Errorf("Unable to list items in bucket %s, %v", bucketName, err)
+				return diagFromErr(fmt.Errorf("Unable to list items in bucket %s, %v", bucketName, err))
 			}
 			for _, item := range resp.Contents {
 				// Delete object within the bucket
 				_, err = s3Client.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String(bucketName), Key: aws.String(*item.Key)})
 
 				if err != nil {
-					return fmt.Errorf("Unable to delete object %s from bucket %s, %v", *item.Key, bucketName, err)
+					return diagFromErr(fmt.Errorf("Unable to delete object %s from bucket %s, %v", *item.Key, bucketName, err))
 				}
 
 				err = s3Client.WaitUntilObjectNotExists(&s3.HeadObjectInput{
@@ -841,7 +1381,7 @@ func resourceIBMCOSDelete(d *schema.ResourceData, meta interface{}) error {
 					Key:    aws.String(*item.Key),
 				})
 				if err != nil {
-					return fmt.Errorf("Error occurred while waiting for object %s to be deleted %v", *item.Key, err)
+					return diagFromErr(fmt.Errorf("Error occurred while waiting for object %s to be deleted %v", *item.Key, err))
 				}
 			}
 		}
@@ -852,69 +1392,12 @@ func resourceIBMCOSDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	_, err = s3Client.DeleteBucket(delete)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMCOSExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	var s3Conf *aws.Config
-	rsConClient, err := meta.(ClientSession).BluemixSession()
-	if err != nil {
-		return false, err
-	}
-
-	bucketName := parseBucketId(d.Id(), "bucketName")
-	serviceID := parseBucketId(d.Id(), "serviceID")
-	endpointType := parseBucketId(d.Id(), "endpointType")
-	apiEndpoint, apiEndpointPrivate := selectCosApi(parseBucketId(d.Id(), "apiType"), parseBucketId(d.Id(), "bLocation"))
-	if endpointType == "private" {
-		apiEndpoint = apiEndpointPrivate
-	}
-	apiEndpoint = envFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
-	if apiEndpoint == "" {
-		return false, fmt.Errorf("The endpoint doesn't exists for given endpoint type %s", endpointType)
-	}
-	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
-	if err != nil {
-		return false, err
-	}
-	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
-
-	apiKey := rsConClient.Config.BluemixAPIKey
-	if apiKey != "" {
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, serviceID)).WithS3ForcePathStyle(true)
-	}
-	iamAccessToken := rsConClient.Config.IAMAccessToken
-	if iamAccessToken != "" {
-		initFunc := func() (*token.Token, error) {
-			return &token.Token{
-				AccessToken:  rsConClient.Config.IAMAccessToken,
-				RefreshToken: rsConClient.Config.IAMRefreshToken,
-				TokenType:    "Bearer",
-				ExpiresIn:    int64((time.Hour * 248).Seconds()) * -1,
-				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
-			}, nil
-		}
-		s3Conf = aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, serviceID)).WithS3ForcePathStyle(true)
-	}
-
-	s3Sess := session.Must(session.NewSession())
-	s3Client := s3.New(s3Sess, s3Conf)
-
-	bucketList, err := s3Client.ListBuckets(&s3.ListBucketsInput{})
-	if err != nil {
-		return false, err
-	}
-	for _, bucket := range bucketList.Buckets {
-		if *bucket.Name == bucketName {
-			return true, nil
-		}
-	}
-	return false, nil
-}
-
 func selectCosApi(apiType string, bLocation string) (string, string) {
 	if apiType == "crl" {
 		switch bLocation {
@@ -978,30 +1461,3 @@ func selectCosApi(apiType string, bLocation string) (string, string) {
 	}
 	return "", ""
 }
-
-func parseBucketId(id string, info string) string {
-	crn := strings.Split(id, ":meta:")[0]
-	meta := strings.Split(id, ":meta:")[1]
-
-	if info == "bucketName" {
-		return strings.Split(crn, ":bucket:")[1]
-	}
-	if info == "serviceID" {
-		return fmt.Sprintf("%s::", strings.Split(crn, ":bucket:")[0])
-	}
-	if info == "apiType" {
-		return strings.Split(meta, ":")[0]
-	}
-	if info == "bLocation" {
-		return strings.Split(meta, ":")[1]
-	}
-	if info == "endpointType" {
-		s := strings.Split(meta, ":")
-		if len(s) > 2 {
-			return strings.Split(meta, ":")[2]
-		}
-		return ""
-
-	}
-	return ""
-}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
 )
 
 func TestAccIBMCosBucket_Basic(t *testing.T) {
@@ -258,6 +261,93 @@ func TestAccIBMCosBucket_Expire(t *testing.T) {
 	})
 }
 
+func TestAccIBMCosBucket_Versioning_Replication(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("tf-bucket%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	noncurrentDays := 30
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_versioning_replication(cosServiceName, bucketName, bucketRegion, bucketClass, noncurrentDays),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", "region_location", bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_versioning.0.enable", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "replication_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "noncurrent_version_expiration.0.noncurrent_days", fmt.Sprintf("%d", noncurrentDays)),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "abort_incomplete_multipart_upload.0.days_after_initiation", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_versioning_replication(cosServiceName, bucketName, bucketRegion, bucketClass, noncurrentDays*2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", "region_location", bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "noncurrent_version_expiration.0.noncurrent_days", fmt.Sprintf("%d", noncurrentDays*2)),
+				),
+			},
+		},
+	})
+}
+
+func TestCOSBucketRules(t *testing.T) {
+	lifecycle := append(archiveRuleList([]interface{}{
+		map[string]interface{}{"rule_id": "archive", "enable": true, "days": 10, "type": "GLACIER"},
+	}), noncurrentVersionExpirationRuleList([]interface{}{
+		map[string]interface{}{"rule_id": "noncurrent", "enable": true, "prefix": "logs/", "noncurrent_days": 30},
+	})...)
+	lifecycle = append(lifecycle, abortIncompleteMultipartUploadRuleList([]interface{}{
+		map[string]interface{}{"rule_id": "abort", "enable": false, "prefix": "", "days_after_initiation": 2},
+	})...)
+
+	assert.DeepEqual(t, []interface{}{
+		map[string]interface{}{"rule_id": "archive", "enable": true, "days": 10, "type": "GLACIER"},
+	}, archiveRuleGet(lifecycle))
+	assert.DeepEqual(t, []interface{}{
+		map[string]interface{}{"rule_id": "noncurrent", "enable": true, "prefix": "logs/", "noncurrent_days": 30},
+	}, noncurrentVersionExpirationRuleGet(lifecycle))
+	assert.DeepEqual(t, []interface{}{
+		map[string]interface{}{"rule_id": "abort", "enable": false, "prefix": "", "days_after_initiation": 2},
+	}, abortIncompleteMultipartUploadRuleGet(lifecycle))
+	assert.Assert(t, is.Len(expireRuleGet(lifecycle), 0))
+
+	replication := []interface{}{
+		map[string]interface{}{"rule_id": "replicate", "enable": true, "prefix": "", "priority": 1, "deletemarker_replication_status": true, "destination_bucket_crn": testCOSCRN + "bucket:destination"},
+	}
+	assert.DeepEqual(t, replication, replicationRuleGet(&s3.ReplicationConfiguration{Rules: replicationRuleList(replication)}))
+
+	retention := []interface{}{
+		map[string]interface{}{"default": 1, "maximum": 365, "minimum": 1, "permanent": false},
+	}
+	assert.DeepEqual(t, retention, retentionRuleGet(retentionRuleProtection(retention)))
+
+	assert.DeepEqual(t, []interface{}{
+		map[string]interface{}{"default_retention_mode": "COMPLIANCE", "default_retention_days": 0, "default_retention_years": 3},
+	}, objectLockGet(objectLockConfiguration([]interface{}{
+		map[string]interface{}{"default_retention_mode": "COMPLIANCE", "default_retention_days": 0, "default_retention_years": 3},
+	})))
+	assert.DeepEqual(t, []interface{}{
+		map[string]interface{}{"default_retention_mode": "COMPLIANCE"},
+	}, objectLockGet(objectLockConfiguration([]interface{}{nil})))
+
+	assert.Assert(t, !cosObjectVersioningEnabled([]interface{}{nil}))
+	assert.Assert(t, cosObjectVersioningEnabled([]interface{}{map[string]interface{}{"enable": true}}))
+
+	notFound := awserr.New("ReplicationConfigurationNotFoundError", "The replication configuration was not found", nil)
+	accessDenied := awserr.New("AccessDenied", "Access Denied", nil)
+	assert.Assert(t, isCOSBucketConfigNotFound(notFound, "ReplicationConfigurationNotFoundError"))
+	assert.Assert(t, !isCOSBucketConfigNotFound(notFound, "ObjectLockConfigurationNotFoundError"))
+	assert.Assert(t, !isCOSBucketConfigNotFound(nil, "ReplicationConfigurationNotFoundError"))
+	assert.Assert(t, ignoreCOSBucketConfigError(notFound, true) != nil)
+	assert.Assert(t, is.Nil(ignoreCOSBucketConfigError(accessDenied, true)))
+	assert.Assert(t, ignoreCOSBucketConfigError(accessDenied, false) != nil)
+}
+
 func TestAccIBMCosBucket_Smart_Type(t *testing.T) {
 	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
 	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
@@ -840,3 +930,67 @@ func testAccCheckIBMCosBucket_update_archive_expire(cosServiceName string, bucke
 	}
 	`, cosServiceName, bucketName, region, storageClass)
 }
+
+func testAccCheckIBMCosBucket_versioning_replication(cosServiceName string, bucketName string, region string, storageClass string, noncurrentDays int) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%[1]s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+
+	resource "ibm_cos_bucket" "destination" {
+		bucket_name          = "%[2]s-destination"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%[3]s"
+		storage_class        = "%[4]s"
+		object_versioning {
+			enable = true
+		}
+	}
+
+	resource "ibm_iam_authorization_policy" "policy" {
+		roles                  = ["Writer"]
+		source_service_name    = "cloud-object-storage"
+		source_resource_instance_id = ibm_resource_instance.instance.guid
+		source_resource_type   = "bucket"
+		target_service_name    = "cloud-object-storage"
+		target_resource_instance_id = ibm_resource_instance.instance.guid
+		target_resource_type   = "bucket"
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%[2]s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%[3]s"
+		storage_class        = "%[4]s"
+		object_versioning {
+			enable = true
+		}
+		noncurrent_version_expiration {
+			rule_id         = "noncurrent"
+			enable          = true
+			noncurrent_days = %[5]d
+		}
+		abort_incomplete_multipart_upload {
+			rule_id               = "abort"
+			enable                = true
+			days_after_initiation = 1
+		}
+		replication_rule {
+			rule_id                = "replicate"
+			enable                 = true
+			priority               = 1
+			destination_bucket_crn = ibm_cos_bucket.destination.crn
+		}
+		depends_on = [ibm_iam_authorization_policy.policy]
+	}
+	`, cosServiceName, bucketName, region, storageClass, noncurrentDays)
+}
@@ -4,10 +4,11 @@
 package ibm
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	registryv1 "github.com/IBM-Cloud/bluemix-go/api/container/registryv1"
@@ -15,11 +16,12 @@ import (
 
 func resourceIBMContainerRegistryNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerRegistryNamespaceCreate,
-		Read:     resourceIBMContainerRegistryNamespaceRead,
-		Delete:   resourceIBMContainerRegistryNamespaceDelete,
-		Exists:   resourceIBMContainerRegistryNamespaceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerRegistryNamespaceCreate,
+		ReadContext:   resourceIBMContainerRegistryNamespaceRead,
+		DeleteContext: resourceIBMContainerRegistryNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMContainerRegistryNamespace, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -81,17 +83,17 @@ func resourceIBMCrNamespaceValidator() *ResourceValidator {
 	ibmCrNamespaceResourceValidator := ResourceValidator{ResourceName: "ibm_cr_namespace", Schema: validateSchema}
 	return &ibmCrNamespaceResourceValidator
 }
-func resourceIBMContainerRegistryNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerRegistryNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	accountID := userDetails.userAccount
 	log.Print("accountID", accountID)
 
 	crClient, err := meta.(ClientSession).ContainerRegistryAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crAPI := crClient.Namespaces()
 	namespace := d.Get("name").(string)
@@ -103,28 +105,28 @@ func resourceIBMContainerRegistryNamespaceCreate(d *schema.ResourceData, meta in
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		target.ResourceGroup = defaultRg
 	}
 
 	response, err := crAPI.AddNamespace(namespace, target)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(response.Namespace)
-	return resourceIBMContainerRegistryNamespaceRead(d, meta)
+	return resourceIBMContainerRegistryNamespaceRead(ctx, d, meta)
 }
-func resourceIBMContainerRegistryNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerRegistryNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	accountID := userDetails.userAccount
 
 	crClient, err := meta.(ClientSession).ContainerRegistryAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crAPI := crClient.Namespaces()
 	namespace := d.Id()
@@ -134,21 +136,21 @@ func resourceIBMContainerRegistryNamespaceDelete(d *schema.ResourceData, meta in
 
 	err = crAPI.DeleteNamespace(namespace, target)
 	if err != nil && !strings.Contains(err.Error(), "404") {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func resourceIBMContainerRegistryNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMContainerRegistryNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	accountID := userDetails.userAccount
 
 	crClient, err := meta.(ClientSession).ContainerRegistryAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	namespace := d.Id()
 	target := registryv1.NamespaceTargetHeader{
@@ -159,7 +161,7 @@ func resourceIBMContainerRegistryNamespaceRead(d *schema.ResourceData, meta inte
 
 	response, err := crAPI.GetDetailedNamespaces(target)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	found := false
 	for _, ns := range response {
@@ -181,34 +183,3 @@ func resourceIBMContainerRegistryNamespaceRead(d *schema.ResourceData, meta inte
 
 	return nil
 }
-
-func resourceIBMContainerRegistryNamespaceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	accountID := userDetails.userAccount
-
-	crClient, err := meta.(ClientSession).ContainerRegistryAPI()
-	if err != nil {
-		return false, err
-	}
-	namespace := d.Id()
-	target := registryv1.NamespaceTargetHeader{
-		AccountID: accountID,
-	}
-
-	crAPI := crClient.Namespaces()
-
-	response, err := crAPI.GetDetailedNamespaces(target)
-	if err != nil {
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	found := false
-	for _, ns := range response {
-		if ns.Name == namespace {
-			found = true
-		}
-	}
-	return found, nil
-}
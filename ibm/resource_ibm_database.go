@@ -9,10 +9,10 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"os"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -60,12 +60,13 @@ type CsEntry struct {
 
 func resourceIBMDatabaseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMDatabaseInstanceCreate,
-		Read:     resourceIBMDatabaseInstanceRead,
-		Update:   resourceIBMDatabaseInstanceUpdate,
-		Delete:   resourceIBMDatabaseInstanceDelete,
-		Exists:   resourceIBMDatabaseInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMDatabaseInstanceCreate,
+		ReadContext:   resourceIBMDatabaseInstanceRead,
+		UpdateContext: resourceIBMDatabaseInstanceUpdate,
+		DeleteContext: resourceIBMDatabaseInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMDatabaseInstance, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -74,7 +75,7 @@ func resourceIBMDatabaseInstance() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -174,9 +175,34 @@ func resourceIBMDatabaseInstance() *schema.Resource {
 				ValidateFunc: validateAllowedStringValue([]string{"public", "private", "public-and-private"}),
 			},
 			"backup_id": {
-				Description: "The CRN of backup source database",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The CRN of backup source database. The backup is only restored when the deployment is created, changing it afterwards is ignored and shows no difference in the plan",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: applyOnce,
+			},
+			"restored_from": {
+				Description: "The source of the data of the deployment when it was restored from a backup or a point in time",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"deployment_id": {
+							Description: "The ID of the source deployment",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"backup_id": {
+							Description: "The CRN of the restored backup",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"point_in_time_recovery_time": {
+							Description: "The restored point in time",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
 			},
 			"remote_leader_id": {
 				Description:      "The CRN of leader database",
@@ -209,6 +235,7 @@ func resourceIBMDatabaseInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      resourceIBMVPCHash,
 			},
+			"tags_all": resourceTagsAllSchema(),
 			"point_in_time_recovery_deployment_id": {
 				Description:      "The CRN of source instance",
 				Type:             schema.TypeString,
@@ -679,10 +706,10 @@ type Params struct {
 }
 
 // Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "database......."
-func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceName := d.Get("service").(string)
@@ -696,27 +723,27 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving database service offering: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving database service offering: %s", err))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	rsInst.ServicePlanID = servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err)
+		return diagFromErr(fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("No deployment found for service plan : %s", plan)
+		return diagFromErr(fmt.Errorf("No deployment found for service plan : %s", plan))
 	}
 	deployments, supportedLocations := filterDatabaseDeployments(deployments, location)
 
@@ -725,7 +752,7 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.", plan, location, locationList)
+		return diagFromErr(fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.", plan, location, locationList))
 	}
 
 	rsInst.TargetCrn = deployments[0].CatalogCRN
@@ -735,7 +762,7 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		rsInst.ResourceGroupID = defaultRg
 	}
@@ -784,23 +811,22 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	instance, err := rsConClient.ResourceServiceInstance().CreateInstance(rsInst)
 	if err != nil {
-		return fmt.Errorf("Error creating database instance: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating database instance: %s", err))
 	}
 
 	// Moved d.SetId(instance.ID) to after waiting for resource to finish creation. Otherwise Terraform initates depedent tasks too early.
 	// Original flow had SetId here as its required as input to waitForDatabaseInstanceCreate
 
-	_, err = waitForDatabaseInstanceCreate(d, meta, instance.ID)
+	_, err = waitForDatabaseInstanceCreate(ctx, d, meta, instance.ID)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create database instance (%s) to complete: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for create database instance (%s) to complete: %s", d.Id(), err))
 	}
 
 	d.SetId(instance.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
-		oldList, newList := d.GetChange("tags")
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, instance.Crn.String())
 		if err != nil {
 			log.Printf(
@@ -811,7 +837,7 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 	icdId := EscapeUrlParm(instance.ID)
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
-		return fmt.Errorf("Error getting database client settings: %s", err)
+		return diagFromErr(fmt.Errorf("Error getting database client settings: %s", err))
 	}
 
 	if pw, ok := d.GetOk("adminpassword"); ok {
@@ -819,9 +845,9 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		cdb, err := icdClient.Cdbs().GetCdb(icdId)
 		if err != nil {
 			if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-				return fmt.Errorf("The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", err)
+				return diagFromErr(fmt.Errorf("The database instance was not found in the region set for the Provider, or the default of us-south. Specify the correct region in the provider definition, or create a provider alias for the correct region. %v", err))
 			}
-			return fmt.Errorf("Error getting database config for: %s with error %s\n", icdId, err)
+			return diagFromErr(fmt.Errorf("Error getting database config for: %s with error %s\n", icdId, err))
 		}
 
 		userParams := icdv4.UserReq{
@@ -831,12 +857,12 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 		task, err := icdClient.Users().UpdateUser(icdId, cdb.AdminUser, userParams)
 		if err != nil {
-			return fmt.Errorf("Error updating database admin password: %s", err)
+			return diagFromErr(newAttributeError("adminpassword", "Error updating database admin password", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+		_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for update of database (%s) admin password task to complete: %s", icdId, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for update of database (%s) admin password task to complete: %s", icdId, err))
 		}
 	}
 
@@ -851,12 +877,12 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 			}
 			task, err := icdClient.Whitelists().CreateWhitelist(icdId, whitelistReq)
 			if err != nil {
-				return fmt.Errorf("Error updating database whitelist entry: %s", err)
+				return diagFromErr(newAttributeError("whitelist", "Error updating database whitelist entry", err))
 			}
-			_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+			_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for update of database (%s) whitelist task to complete: %s", icdId, err)
+				return diagFromErr(fmt.Errorf(
+					"Error waiting for update of database (%s) whitelist task to complete: %s", icdId, err))
 			}
 		}
 	}
@@ -864,17 +890,17 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		cpuBody, err := expandICDAutoScalingGroup(d, cpuRecord, "cpu")
 		if err != nil {
-			return fmt.Errorf("Error in getting cpuBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error in getting cpuBody from expandICDAutoScalingGroup", err))
 		}
 		params.Autoscaling.CPU = &cpuBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database scaling group: %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error updating database scaling group", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+		_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -882,17 +908,17 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		diskBody, err := expandICDAutoScalingGroup(d, diskRecord, "disk")
 		if err != nil {
-			return fmt.Errorf("Error in getting diskBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error in getting diskBody from expandICDAutoScalingGroup", err))
 		}
 		params.Autoscaling.Disk = &diskBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database scaling group: %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error updating database scaling group", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+		_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -900,17 +926,17 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		memoryBody, err := expandICDAutoScalingGroup(d, memoryRecord, "memory")
 		if err != nil {
-			return fmt.Errorf("Error in getting memoryBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error in getting memoryBody from expandICDAutoScalingGroup", err))
 		}
 		params.Autoscaling.Memory = &memoryBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database scaling group: %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error updating database scaling group", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+		_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -926,23 +952,23 @@ func resourceIBMDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 			}
 			task, err := icdClient.Users().CreateUser(icdId, userReq)
 			if err != nil {
-				return fmt.Errorf("Error updating database user (%s) entry: %s", user.UserName, err)
+				return diagFromErr(newAttributeError("users", fmt.Sprintf("Error updating database user (%s) entry", user.UserName), err))
 			}
-			_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+			_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for update of database (%s) user (%s) create task to complete: %s", icdId, user.UserName, err)
+				return diagFromErr(fmt.Errorf(
+					"Error waiting for update of database (%s) user (%s) create task to complete: %s", icdId, user.UserName, err))
 			}
 		}
 	}
 
-	return resourceIBMDatabaseInstanceRead(d, meta)
+	return resourceIBMDatabaseInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -955,7 +981,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving resource instance: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving resource instance: %s", err))
 	}
 	if strings.Contains(instance.State, "removed") {
 		log.Printf("[WARN] Removing instance from TF state because it's now in removed state")
@@ -968,7 +994,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf(
 			"Error on get of ibm database tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	d.Set("name", instance.Name)
 	d.Set("status", instance.State)
 	d.Set("resource_group_id", instance.ResourceGroupID)
@@ -984,6 +1010,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 		}
 
 	}
+	d.Set("restored_from", flattenDatabaseRestoredFrom(databaseInstanceParams(instance.Parameters), meta))
 
 	d.Set(ResourceName, instance.Name)
 	d.Set(ResourceCRN, instance.Crn.String())
@@ -992,48 +1019,48 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, rcontroller+"/services/"+url.QueryEscape(instance.Crn.String()))
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.GetServiceName(instance.ServiceID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	d.Set("service", serviceOff)
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(instance.ServicePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
-		return fmt.Errorf("Error getting database client settings: %s", err)
+		return diagFromErr(fmt.Errorf("Error getting database client settings: %s", err))
 	}
 
 	icdId := EscapeUrlParm(instanceID)
 	cdb, err := icdClient.Cdbs().GetCdb(icdId)
 	if err != nil {
 		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
-			return fmt.Errorf("The database instance was not found in the region set for the Provider. Specify the correct region in the provider definition. %v", err)
+			return diagFromErr(fmt.Errorf("The database instance was not found in the region set for the Provider. Specify the correct region in the provider definition. %v", err))
 		}
-		return fmt.Errorf("Error getting database config for: %s with error %s\n", icdId, err)
+		return diagFromErr(fmt.Errorf("Error getting database config for: %s with error %s\n", icdId, err))
 	}
 	d.Set("adminuser", cdb.AdminUser)
 	d.Set("version", cdb.Version)
 
 	groupList, err := icdClient.Groups().GetGroups(icdId)
 	if err != nil {
-		return fmt.Errorf("Error getting database groups: %s", err)
+		return diagFromErr(fmt.Errorf("Error getting database groups: %s", err))
 	}
 	d.Set("groups", flattenIcdGroups(groupList))
 	d.Set("members_memory_allocation_mb", groupList.Groups[0].Memory.AllocationMb)
@@ -1042,13 +1069,13 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	autoSclaingGroup, err := icdClient.AutoScaling().GetAutoScaling(icdId, "member")
 	if err != nil {
-		return fmt.Errorf("Error getting database groups: %s", err)
+		return diagFromErr(fmt.Errorf("Error getting database groups: %s", err))
 	}
 	d.Set("auto_scaling", flattenICDAutoScalingGroup(autoSclaingGroup))
 
 	whitelist, err := icdClient.Whitelists().GetWhitelist(icdId)
 	if err != nil {
-		return fmt.Errorf("Error getting database whitelist: %s", err)
+		return diagFromErr(fmt.Errorf("Error getting database whitelist: %s", err))
 	}
 	d.Set("whitelist", flattenWhitelist(whitelist))
 
@@ -1064,7 +1091,7 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 		userName := user.UserName
 		csEntry, err := getConnectionString(d, userName, connectionEndpoint, meta)
 		if err != nil {
-			return fmt.Errorf("Error getting user connection string for user (%s): %s", userName, err)
+			return diagFromErr(fmt.Errorf("Error getting user connection string for user (%s): %s", userName, err))
 		}
 		connectionStrings = append(connectionStrings, csEntry)
 	}
@@ -1073,10 +1100,10 @@ func resourceIBMDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -1099,20 +1126,20 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 	if update {
 		_, err = rsConClient.ResourceServiceInstance().UpdateInstance(instanceID, updateReq)
 		if err != nil {
-			return fmt.Errorf("Error updating resource instance: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating resource instance: %s", err))
 		}
 
-		_, err = waitForDatabaseInstanceUpdate(d, meta)
+		_, err = waitForDatabaseInstanceUpdate(ctx, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for update of resource instance (%s) to complete: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for update of resource instance (%s) to complete: %s", d.Id(), err))
 		}
 
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 
-		oldList, newList := d.GetChange("tags")
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
 		if err != nil {
 			log.Printf(
@@ -1122,7 +1149,7 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
-		return fmt.Errorf("Error getting database client settings: %s", err)
+		return diagFromErr(fmt.Errorf("Error getting database client settings: %s", err))
 	}
 	icdId := EscapeUrlParm(instanceID)
 
@@ -1145,12 +1172,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 		task, err := icdClient.Groups().UpdateGroup(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database scaling group: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating database scaling group: %s", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+		_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1159,17 +1186,17 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		cpuBody, err := expandICDAutoScalingGroup(d, cpuRecord, "cpu")
 		if err != nil {
-			return fmt.Errorf("Error in getting cpuBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error in getting cpuBody from expandICDAutoScalingGroup", err))
 		}
 		params.Autoscaling.CPU = &cpuBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database scaling group: %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error updating database scaling group", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+		_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1178,17 +1205,17 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		diskBody, err := expandICDAutoScalingGroup(d, diskRecord, "disk")
 		if err != nil {
-			return fmt.Errorf("Error in getting diskBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error in getting diskBody from expandICDAutoScalingGroup", err))
 		}
 		params.Autoscaling.Disk = &diskBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database scaling group: %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error updating database scaling group", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+		_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1197,17 +1224,17 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		params := icdv4.AutoscalingSetGroup{}
 		memoryBody, err := expandICDAutoScalingGroup(d, memoryRecord, "memory")
 		if err != nil {
-			return fmt.Errorf("Error in getting memoryBody from expandICDAutoScalingGroup %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error in getting memoryBody from expandICDAutoScalingGroup", err))
 		}
 		params.Autoscaling.Memory = &memoryBody
 		task, err := icdClient.AutoScaling().SetAutoScaling(icdId, "member", params)
 		if err != nil {
-			return fmt.Errorf("Error updating database scaling group: %s", err)
+			return diagFromErr(newAttributeError("auto_scaling", "Error updating database scaling group", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+		_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for database (%s) scaling group update task to complete: %s", icdId, err))
 		}
 
 	}
@@ -1222,12 +1249,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 		task, err := icdClient.Users().UpdateUser(icdId, adminUser, userParams)
 		if err != nil {
-			return fmt.Errorf("Error updating database admin password: %s", err)
+			return diagFromErr(newAttributeError("adminpassword", "Error updating database admin password", err))
 		}
-		_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+		_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for database (%s) admin password update task to complete: %s", icdId, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for database (%s) admin password update task to complete: %s", icdId, err))
 		}
 	}
 
@@ -1256,12 +1283,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 				}
 				task, err := icdClient.Whitelists().CreateWhitelist(icdId, whitelistReq)
 				if err != nil {
-					return fmt.Errorf("Error updating database whitelist entry %v : %s", wlEntry.Address, err)
+					return diagFromErr(newAttributeError("whitelist", fmt.Sprintf("Error updating database whitelist entry %v", wlEntry.Address), err))
 				}
-				_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+				_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for database (%s) whitelist create task to complete for entry %s : %s", icdId, wlEntry.Address, err)
+					return diagFromErr(fmt.Errorf(
+						"Error waiting for database (%s) whitelist create task to complete for entry %s : %s", icdId, wlEntry.Address, err))
 				}
 
 			}
@@ -1278,12 +1305,12 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 				ipAddress := wlEntry.Address
 				task, err := icdClient.Whitelists().DeleteWhitelist(icdId, ipAddress)
 				if err != nil {
-					return fmt.Errorf("Error deleting database whitelist entry: %s", err)
+					return diagFromErr(newAttributeError("whitelist", "Error deleting database whitelist entry", err))
 				}
-				_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+				_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for database (%s) whitelist delete task to complete for ipAddress %s : %s", icdId, ipAddress, err)
+					return diagFromErr(fmt.Errorf(
+						"Error waiting for database (%s) whitelist delete task to complete for ipAddress %s : %s", icdId, ipAddress, err))
 				}
 
 			}
@@ -1324,18 +1351,18 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 					}
 					task, err := icdClient.Users().UpdateUser(icdId, newEntry["name"].(string), userParams)
 					if err != nil {
-						return fmt.Errorf("Error updating database user (%s) password: %s", newEntry["name"].(string), err)
+						return diagFromErr(fmt.Errorf("Error updating database user (%s) password: %s", newEntry["name"].(string), err))
 					}
-					_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+					_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
-						return fmt.Errorf(
-							"Error waiting for database (%s) user (%s) password update task to complete: %s", icdId, newEntry["name"].(string), err)
+						return diagFromErr(fmt.Errorf(
+							"Error waiting for database (%s) user (%s) password update task to complete: %s", icdId, newEntry["name"].(string), err))
 					}
 				} else {
-					_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+					_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
-						return fmt.Errorf(
-							"Error waiting for database (%s) user (%s) create task to complete: %s", icdId, newEntry["name"].(string), err)
+						return diagFromErr(fmt.Errorf(
+							"Error waiting for database (%s) user (%s) create task to complete: %s", icdId, newEntry["name"].(string), err))
 					}
 				}
 			}
@@ -1352,18 +1379,18 @@ func resourceIBMDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 				user := userEntry.UserName
 				task, err := icdClient.Users().DeleteUser(icdId, user)
 				if err != nil {
-					return fmt.Errorf("Error deleting database user (%s) entry: %s", user, err)
+					return diagFromErr(newAttributeError("users", fmt.Sprintf("Error deleting database user (%s) entry", user), err))
 				}
-				_, err = waitForDatabaseTaskComplete(task.Id, d, meta)
+				_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for database (%s) user (%s) delete task to complete: %s", icdId, user, err)
+					return diagFromErr(fmt.Errorf(
+						"Error waiting for database (%s) user (%s) delete task to complete: %s", icdId, user, err))
 				}
 			}
 		}
 	}
 
-	return resourceIBMDatabaseInstanceRead(d, meta)
+	return resourceIBMDatabaseInstanceRead(ctx, d, meta)
 }
 
 func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint string, meta interface{}) (CsEntry, error) {
@@ -1428,10 +1455,10 @@ func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint st
 	return csEntry, nil
 }
 
-func resourceIBMDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDatabaseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 
@@ -1444,45 +1471,21 @@ func resourceIBMDatabaseInstanceDelete(d *schema.ResourceData, meta interface{})
 			log.Printf("[WARN] Resource instance already deleted %s\n ", err)
 			err = nil
 		} else {
-			return fmt.Errorf("Error deleting resource instance: %s", err)
+			return diagFromErr(fmt.Errorf("Error deleting resource instance: %s", err))
 		}
 	}
 
-	_, err = waitForDatabaseInstanceDelete(d, meta)
+	_, err = waitForDatabaseInstanceDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
-	if err != nil {
-		return false, err
-	}
-	instanceID := d.Id()
-	instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	if strings.Contains(instance.State, "removed") {
-		log.Printf("[WARN] Removing instance from state because it's in removed state")
-		d.SetId("")
-		return false, nil
-	}
-
-	return instance.ID == instanceID, nil
-}
-
-func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+func waitForDatabaseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
 		return false, err
@@ -1509,10 +1512,10 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
 		return false, err
@@ -1540,43 +1543,84 @@ func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
+}
+
+// databaseInstanceParams returns the parameters the deployment was provisioned with
+func databaseInstanceParams(parameters map[string]interface{}) Params {
+	var params Params
+	raw, _ := json.Marshal(parameters)
+	json.Unmarshal(raw, &params)
+	return params
+}
+
+// flattenDatabaseRestoredFrom returns the restored_from attribute of a deployment created with params
+func flattenDatabaseRestoredFrom(params Params, meta interface{}) []map[string]interface{} {
+	if params.BackupID == "" && params.PITRDeploymentID == "" {
+		return nil
+	}
+	restoredFrom := map[string]interface{}{
+		"deployment_id":               params.PITRDeploymentID,
+		"backup_id":                   params.BackupID,
+		"point_in_time_recovery_time": params.PITRTimeStamp,
+	}
+	if params.BackupID != "" {
+		backup, err := getDatabaseBackup(params.BackupID, meta)
+		if err != nil {
+			log.Printf("[WARN] Error getting the source deployment of the backup %s: %s", params.BackupID, err)
+		} else {
+			restoredFrom["deployment_id"] = backup.DeploymentID
+		}
+	}
+	return []map[string]interface{}{restoredFrom}
+}
+
+func getDatabaseBackup(backupID string, meta interface{}) (icdBackup, error) {
+	icdClient, err := meta.(ClientSession).ICDAPI()
+	if err != nil {
+		return icdBackup{}, fmt.Errorf("Error getting database client settings: %s", err)
+	}
+	backupsAPI, err := icdBackupsAPI(icdClient)
+	if err != nil {
+		return icdBackup{}, err
+	}
+	return backupsAPI.GetBackup(backupID)
 }
 
-func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta interface{}) (bool, error) {
+func waitForDatabaseTaskComplete(ctx context.Context, taskId string, meta interface{}, timeout time.Duration) (bool, error) {
 	icdClient, err := meta.(ClientSession).ICDAPI()
 	if err != nil {
 		return false, fmt.Errorf("Error getting database client settings: %s", err)
 	}
-	delayDuration := 5 * time.Second
-
-	timeout := time.After(15 * time.Minute)
-	delay := time.Tick(delayDuration)
-	innerTask := icdv4.Task{}
-
-	for {
-		select {
-		case <-timeout:
-			return false, fmt.Errorf("[Error] Time out waiting for database task to complete")
-		case <-delay:
-			innerTask, err = icdClient.Tasks().GetTask(EscapeUrlParm(taskId))
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"queued", "running"},
+		Target:  []string{"completed"},
+		Refresh: func() (interface{}, string, error) {
+			innerTask, err := icdClient.Tasks().GetTask(EscapeUrlParm(taskId))
 			if err != nil {
-				return false, fmt.Errorf("The ICD Get task on database update errored: %v", err)
+				return nil, "", fmt.Errorf("The ICD Get task on database update errored: %v", err)
 			}
 			if innerTask.Status == "failed" {
-				return false, fmt.Errorf("[Error] Database task failed")
+				return innerTask, innerTask.Status, fmt.Errorf("[Error] Database task failed")
 			}
 			// Completed status could be returned as "" due to interaction between bluemix-go and icd task response
-			// Otherwise Running an queued
-			if innerTask.Status == "completed" || innerTask.Status == "" {
-				return true, nil
+			if innerTask.Status == "" {
+				return innerTask, "completed", nil
 			}
+			return innerTask, innerTask.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
 
-		}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return false, err
 	}
+	return true, nil
 }
 
-func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForDatabaseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
 		return false, err
@@ -1603,7 +1647,7 @@ func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func filterDatabaseDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMDatabaseBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseBackupCreate,
+		ReadContext:   resourceIBMDatabaseBackupRead,
+		DeleteContext: resourceIBMDatabaseBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMDatabaseBackup, nil),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "The ID of the database deployment to back up",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description: "The type of the backup, 'scheduled' or 'on_demand'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The status of the backup",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_downloadable": {
+				Description: "Whether the backup can be downloaded",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"is_restorable": {
+				Description: "Whether a new deployment can be restored from the backup",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The time the backup was taken",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	icdClient, err := meta.(ClientSession).ICDAPI()
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error getting database client settings: %s", err))
+	}
+	backupsAPI, err := icdBackupsAPI(icdClient)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	icdId := d.Get("deployment_id").(string)
+	previous, err := backupsAPI.ListBackups(icdId)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error listing the backups of the database %s: %s", icdId, err))
+	}
+
+	task, err := backupsAPI.StartBackup(icdId)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error starting a backup of the database %s: %s", icdId, err))
+	}
+	_, err = waitForDatabaseTaskComplete(ctx, task.Id, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error waiting for the backup of the database %s to complete: %s", icdId, err))
+	}
+
+	current, err := backupsAPI.ListBackups(icdId)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error listing the backups of the database %s: %s", icdId, err))
+	}
+	backup, ok := newOnDemandBackup(previous, current)
+	if !ok {
+		return diagFromErr(fmt.Errorf("The backup task %s of the database %s completed but no new backup was found", task.Id, icdId))
+	}
+	d.SetId(backup.ID)
+
+	return resourceIBMDatabaseBackupRead(ctx, d, meta)
+}
+
+func resourceIBMDatabaseBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	icdClient, err := meta.(ClientSession).ICDAPI()
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error getting database client settings: %s", err))
+	}
+	backupsAPI, err := icdBackupsAPI(icdClient)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	backup, err := backupsAPI.GetBackup(d.Id())
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] Removing the database backup %s from state because it's not found via the API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error getting the database backup %s: %s", d.Id(), err))
+	}
+
+	d.Set("deployment_id", backup.DeploymentID)
+	d.Set("type", backup.Type)
+	d.Set("status", backup.Status)
+	d.Set("is_downloadable", backup.IsDownloadable)
+	d.Set("is_restorable", backup.IsRestorable)
+	d.Set("created_at", backup.CreatedAt)
+
+	return nil
+}
+
+func resourceIBMDatabaseBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// ICD does not implement a DeleteBackup API, the on-demand backups expire after 30 days
+	log.Printf("[WARN] The database backup %s is only removed from the state, it expires after the retention period of the deployment", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// newOnDemandBackup returns the most recent on-demand backup of current that is not in previous
+func newOnDemandBackup(previous, current []icdBackup) (icdBackup, bool) {
+	known := make(map[string]bool, len(previous))
+	for _, backup := range previous {
+		known[backup.ID] = true
+	}
+	var newest icdBackup
+	found := false
+	for _, backup := range current {
+		if backup.Type != databaseBackupTypeOnDemand || known[backup.ID] {
+			continue
+		}
+		// The creation times are RFC 3339 timestamps in UTC, they sort as strings
+		if !found || backup.CreatedAt > newest.CreatedAt {
+			newest, found = backup, true
+		}
+	}
+	return newest, found
+}
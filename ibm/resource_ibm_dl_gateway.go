@@ -7,10 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -63,12 +63,13 @@ const (
 
 func resourceIBMDLGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayCreate,
-		Read:     resourceIBMdlGatewayRead,
-		Delete:   resourceIBMdlGatewayDelete,
-		Exists:   resourceIBMdlGatewayExists,
-		Update:   resourceIBMdlGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlGatewayCreate,
+		ReadContext:   resourceIBMdlGatewayRead,
+		DeleteContext: resourceIBMdlGatewayDelete,
+		UpdateContext: resourceIBMdlGatewayUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMDLGateway, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -78,7 +79,7 @@ func resourceIBMDLGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -336,6 +337,7 @@ func resourceIBMDLGateway() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "Tags for the direct link gateway",
 			},
+			"tags_all": resourceTagsAllSchema(),
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -400,10 +402,10 @@ func directlinkClient(meta interface{}) (*directlinkv1.DirectLinkV1, error) {
 	return sess, err
 }
 
-func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	dtype := d.Get(dlType).(string)
 	createGatewayOptionsModel := &directlinkv1.CreateGatewayOptions{}
@@ -421,7 +423,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("Error creating gateway, %s is a required field", dlCarrierName)
 			log.Printf("%s is a required field", dlCarrierName)
-			return err
+			return diagFromErr(err)
 		}
 		if _, ok := d.GetOk(dlCrossConnectRouter); ok {
 			crossConnectRouter = d.Get(dlCrossConnectRouter).(string)
@@ -429,7 +431,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("Error creating gateway, %s is a required field", dlCrossConnectRouter)
 			log.Printf("%s is a required field", dlCrossConnectRouter)
-			return err
+			return diagFromErr(err)
 		}
 		if _, ok := d.GetOk(dlLocationName); ok {
 			locationName = d.Get(dlLocationName).(string)
@@ -437,7 +439,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("Error creating gateway, %s is a required field", dlLocationName)
 			log.Printf("%s is a required field", dlLocationName)
-			return err
+			return diagFromErr(err)
 		}
 		if _, ok := d.GetOk(dlCustomerName); ok {
 			customerName = d.Get(dlCustomerName).(string)
@@ -445,7 +447,7 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("Error creating gateway, %s is a required field", dlCustomerName)
 			log.Printf("%s is a required field", dlCustomerName)
-			return err
+			return diagFromErr(err)
 		}
 		gatewayDedicatedTemplateModel, _ := directLink.NewGatewayTemplateGatewayTypeDedicatedTemplate(bgpAsn, global, metered, name, speed, dtype, carrierName, crossConnectRouter, customerName, locationName)
 
@@ -528,28 +530,27 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = fmt.Errorf("Error creating direct link connect gateway, %s is a required field", dlPort)
 			log.Printf("%s is a required field", dlPort)
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	gateway, response, err := directLink.CreateGateway(createGatewayOptionsModel)
 	if err != nil {
 		log.Printf("[DEBUG] Create Direct Link Gateway (%s) err %s\n%s", dtype, err, response)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(*gateway.ID)
 
 	log.Printf("[INFO] Created Direct Link Gateway (%s Template) : %s", dtype, *gateway.ID)
 	if dtype == "connect" {
-		_, err = isWaitForDirectLinkAvailable(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForDirectLinkAvailable(ctx, directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
-		oldList, newList := d.GetChange(dlTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
 			log.Printf(
@@ -557,16 +558,16 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceIBMdlGatewayRead(d, meta)
+	return resourceIBMdlGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dtype := d.Get(dlType).(string)
 	log.Printf("[INFO] Inside resourceIBMdlGatewayRead: %s", dtype)
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -582,7 +583,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return diagFromErr(fmt.Errorf("Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response))
 	}
 	if instance.Name != nil {
 		d.Set(dlName, *instance.Name)
@@ -710,10 +711,10 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf(
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(dlTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/interconnectivity/direct-link")
 	d.Set(ResourceName, *instance.Name)
@@ -727,7 +728,7 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
-func isWaitForDirectLinkAvailable(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForDirectLinkAvailable(ctx context.Context, client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for direct link (%s) to be provisioned.", id)
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", dlGatewayProvisioning},
@@ -737,7 +738,7 @@ func isWaitForDirectLinkAvailable(client *directlinkv1.DirectLinkV1, id string,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -755,11 +756,11 @@ func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resou
 	}
 }
 
-func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -770,15 +771,15 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil {
 		log.Printf("Error fetching Direct Link Gateway :%s", detail)
-		return err
+		return diagFromErr(err)
 	}
 
 	updateGatewayOptionsModel := &directlinkv1.UpdateGatewayOptions{}
 	updateGatewayOptionsModel.ID = &ID
 	dtype := *instance.Type
 
-	if d.HasChange(dlTags) {
-		oldList, newList := d.GetChange(dlTags)
+	if d.HasChange("tags_all") {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
 			log.Printf(
@@ -861,17 +862,17 @@ func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	_, response, err := directLink.UpdateGateway(updateGatewayOptionsModel)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Gateway err %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMdlGatewayRead(d, meta)
+	return resourceIBMdlGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMdlGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -882,31 +883,9 @@ func resourceIBMdlGatewayDelete(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting Direct Link Gateway : %s", response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMdlGatewayExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	directLink, err := directlinkClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	ID := d.Id()
-
-	getOptions := &directlinkv1.GetGatewayOptions{
-		ID: &ID,
-	}
-	_, response, err := directLink.GetGateway(getOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			d.SetId("")
-			return false, nil
-		}
-		return false, fmt.Errorf("Error Getting Direct Link Gateway : %s\n%s", err, response)
-	}
-	return true, nil
-}
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,12 +29,13 @@ const (
 
 func resourceIBMDLGatewayVC() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlGatewayVCCreate,
-		Read:     resourceIBMdlGatewayVCRead,
-		Delete:   resourceIBMdlGatewayVCDelete,
-		Exists:   resourceIBMdlGatewayVCExists,
-		Update:   resourceIBMdlGatewayVCUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlGatewayVCCreate,
+		ReadContext:   resourceIBMdlGatewayVCRead,
+		DeleteContext: resourceIBMdlGatewayVCDelete,
+		UpdateContext: resourceIBMdlGatewayVCUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMDLGatewayVC, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -123,10 +126,10 @@ func resourceIBMdlGatewayVCValidator() *ResourceValidator {
 
 	return &ibmDLGatewayVCResourceValidator
 }
-func resourceIBMdlGatewayVCCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	createGatewayVCOptions := &directlinkv1.CreateGatewayVirtualConnectionOptions{}
@@ -146,23 +149,22 @@ func resourceIBMdlGatewayVCCreate(d *schema.ResourceData, meta interface{}) erro
 	gatewayVC, response, err := directLink.CreateGatewayVirtualConnection(createGatewayVCOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create Direct Link Gateway (Dedicated) Virtual connection err %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *gatewayVC.ID))
 	d.Set(dlVirtualConnectionId, *gatewayVC.ID)
-	return resourceIBMdlGatewayVCRead(d, meta)
+	return resourceIBMdlGatewayVCRead(ctx, d, meta)
 }
 
-func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMdlGatewayVCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -177,7 +179,7 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Directlink Gateway Connection (%s): %s\n%s", ID, err, response)
+		return diagFromErr(fmt.Errorf("Error Getting Directlink Gateway Connection (%s): %s\n%s", ID, err, response))
 	}
 
 	if instance.Name != nil {
@@ -205,21 +207,21 @@ func resourceIBMdlGatewayVCRead(d *schema.ResourceData, meta interface{}) error
 	}
 	dlgw, response, err := directLink.GetGateway(getGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Direct Link Gateway (Dedicated Template): %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error Getting Direct Link Gateway (Dedicated Template): %s\n%s", err, response))
 	}
 	d.Set(RelatedCRN, *dlgw.Crn)
 	return nil
 }
 
-func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -233,7 +235,7 @@ func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if err != nil {
 		log.Printf("Error fetching Direct Link Gateway (Dedicated Template) Virtual Connection:%s", detail)
-		return err
+		return diagFromErr(err)
 	}
 
 	updateGatewayVCOptions := &directlinkv1.UpdateGatewayVirtualConnectionOptions{}
@@ -249,21 +251,21 @@ func resourceIBMdlGatewayVCUpdate(d *schema.ResourceData, meta interface{}) erro
 	_, response, err := directLink.UpdateGatewayVirtualConnection(updateGatewayVCOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Gateway (Dedicated) Virtual Connection err %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMdlGatewayVCRead(d, meta)
+	return resourceIBMdlGatewayVCRead(ctx, d, meta)
 }
 
-func resourceIBMdlGatewayVCDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlGatewayVCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -276,42 +278,9 @@ func resourceIBMdlGatewayVCDelete(d *schema.ResourceData, meta interface{}) erro
 
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting Direct Link Gateway (Dedicated Template) Virtual Connection: %s", response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMdlGatewayVCExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	directLink, err := directlinkClient(meta)
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	gatewayId := parts[0]
-	ID := parts[1]
-
-	getVCOptions := &directlinkv1.GetGatewayVirtualConnectionOptions{
-		ID: &ID,
-	}
-	getVCOptions.SetGatewayID(gatewayId)
-	_, response, err := directLink.GetGatewayVirtualConnection(getVCOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			d.SetId("")
-			return false, nil
-		}
-		return false, fmt.Errorf("Error Getting Direct Link Gateway (Dedicated Template) Virtual Connection: %s\n%s", err, response)
-	}
-
-	if response.StatusCode == 404 {
-		d.SetId("")
-		return false, nil
-	}
-	return true, nil
-}
@@ -7,8 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -19,15 +19,16 @@ const (
 
 func resourceIBMDLProviderGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMdlProviderGatewayCreate,
-		Read:     resourceIBMdlProviderGatewayRead,
-		Delete:   resourceIBMdlProviderGatewayDelete,
-		Exists:   resourceIBMdlProviderGatewayExists,
-		Update:   resourceIBMdlProviderGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMdlProviderGatewayCreate,
+		ReadContext:   resourceIBMdlProviderGatewayRead,
+		DeleteContext: resourceIBMdlProviderGatewayDelete,
+		UpdateContext: resourceIBMdlProviderGatewayUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMDLProviderGateway, nil),
+		},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -118,6 +119,7 @@ func resourceIBMDLProviderGateway() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "Tags for the direct link gateway",
 			},
+			"tags_all": resourceTagsAllSchema(),
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -178,10 +180,10 @@ func resourceIBMDLProviderGatewayValidator() *ResourceValidator {
 	return &ibmISDLGatewayResourceValidator
 }
 
-func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get(dlName).(string)
 	speed := int64(d.Get(dlSpeedMbps).(int))
@@ -205,15 +207,14 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 	gateway, response, err := directLink.CreateProviderGateway(gatewayOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create Direct Link Provider Gateway err %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(*gateway.ID)
 
 	log.Printf("[INFO] Created Direct Link Provider Gateway : %s", *gateway.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(dlTags); ok || v != "" {
-		oldList, newList := d.GetChange(dlTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *gateway.Crn)
 		if err != nil {
 			log.Printf(
@@ -221,16 +222,16 @@ func resourceIBMdlProviderGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	return resourceIBMdlProviderGatewayRead(d, meta)
+	return resourceIBMdlProviderGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dtype := d.Get(dlType).(string)
 	log.Printf("[INFO] Inside resourceIBMdlGatewayRead: %s", dtype)
 
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -245,7 +246,7 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response)
+		return diagFromErr(fmt.Errorf("Error Getting Direct Link Gateway (%s Template): %s\n%s", dtype, err, response))
 	}
 	if instance.ID != nil {
 		d.Set("id", *instance.ID)
@@ -303,10 +304,10 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 		log.Printf(
 			"Error on get of resource direct link gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(dlTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, controller+"/interconnectivity/direct-link")
 	d.Set(ResourceName, *instance.Name)
@@ -315,11 +316,11 @@ func resourceIBMdlProviderGatewayRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -331,8 +332,8 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 	updateGatewayOptionsModel := directLink.NewUpdateProviderGatewayOptions(ID)
 
-	if d.HasChange(dlTags) {
-		oldList, newList := d.GetChange(dlTags)
+	if d.HasChange("tags_all") {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *instance.Crn)
 		if err != nil {
 			log.Printf(
@@ -352,17 +353,17 @@ func resourceIBMdlProviderGatewayUpdate(d *schema.ResourceData, meta interface{}
 	_, response, err = directLink.UpdateProviderGateway(updateGatewayOptionsModel)
 	if err != nil {
 		log.Printf("[DEBUG] Update Direct Link Provider Gateway  err %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMdlProviderGatewayRead(d, meta)
+	return resourceIBMdlProviderGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMdlProviderGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMdlProviderGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	directLink, err := directlinkProviderClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -378,23 +379,3 @@ func resourceIBMdlProviderGatewayDelete(d *schema.ResourceData, meta interface{}
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMdlProviderGatewayExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	directLink, err := directlinkProviderClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	ID := d.Id()
-
-	getOptions := directLink.NewGetProviderGatewayOptions(ID)
-	_, response, err := directLink.GetProviderGateway(getOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			d.SetId("")
-			return false, nil
-		}
-		return false, fmt.Errorf("Error Getting Direct Link Provider Gateway : %s\n%s", err, response)
-	}
-	return true, nil
-}
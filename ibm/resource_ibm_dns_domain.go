@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -17,12 +19,13 @@ import (
 
 func resourceIBMDNSDomain() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSDomainExists,
-		Create:   resourceIBMDNSDomainCreate,
-		Read:     resourceIBMDNSDomainRead,
-		Update:   resourceIBMDNSDomainUpdate,
-		Delete:   resourceIBMDNSDomainDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMDNSDomainCreate,
+		ReadContext:   resourceIBMDNSDomainRead,
+		UpdateContext: resourceIBMDNSDomainUpdate,
+		DeleteContext: resourceIBMDNSDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMDNSDomain, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -60,7 +63,7 @@ func resourceIBMDNSDomain() *schema.Resource {
 	}
 }
 
-func resourceIBMDNSDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess.SetRetries(0))
 
@@ -85,7 +88,7 @@ func resourceIBMDNSDomainCreate(d *schema.ResourceData, meta interface{}) error
 	// create Dns_Domain object
 	response, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Dns Domain: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Dns Domain: %s", err))
 	}
 
 	// populate id
@@ -94,10 +97,10 @@ func resourceIBMDNSDomainCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Created Dns Domain: %d", id)
 
 	// read remote state
-	return resourceIBMDNSDomainRead(d, meta)
+	return resourceIBMDNSDomainRead(ctx, d, meta)
 }
 
-func resourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess)
 
@@ -108,7 +111,11 @@ func resourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
 		"id,name,updateDate,resourceRecords",
 	).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Dns Domain %d: %s", dnsId, err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving Dns Domain %d: %s", dnsId, err))
 	}
 
 	// populate fields
@@ -127,7 +134,7 @@ func resourceIBMDNSDomainRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// If the target has been updated, find the corresponding dns record and update its data
 
 	sess := meta.(ClientSession).SoftLayerSession()
@@ -147,7 +154,7 @@ func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error
 		"id,name,updateDate,resourceRecords",
 	).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving DNS resource %d: %s", domainId, err)
+		return diagFromErr(fmt.Errorf("Error retrieving DNS resource %d: %s", domainId, err))
 	}
 
 	// find a record with host @; that will have the current target.
@@ -159,8 +166,8 @@ func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if record.Id == nil {
-		return fmt.Errorf("Could not find DNS target record for domain %s (%d)",
-			sl.Get(domain.Name), sl.Get(domain.Id))
+		return diagFromErr(fmt.Errorf("Could not find DNS target record for domain %s (%d)",
+			sl.Get(domain.Name), sl.Get(domain.Id)))
 	}
 
 	record.Data = sl.String(newTarget)
@@ -168,53 +175,32 @@ func resourceIBMDNSDomainUpdate(d *schema.ResourceData, meta interface{}) error
 	_, err = service.Id(*record.Id).EditObject(&record)
 
 	if err != nil {
-		return fmt.Errorf("Error editing DNS target record for domain %s (%d): %s",
-			sl.Get(domain.Name), sl.Get(domain.Id), err)
+		return diagFromErr(fmt.Errorf("Error editing DNS target record for domain %s (%d): %s",
+			sl.Get(domain.Name), sl.Get(domain.Id), err))
 	}
 
 	return nil
 }
 
-func resourceIBMDNSDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess)
 
 	dnsId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting Dns Domain: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Dns Domain: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting Dns Domain: %d", dnsId)
 	result, err := service.Id(dnsId).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Dns Domain: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Dns Domain: %s", err))
 	}
 
 	if !result {
-		return errors.New("Error deleting Dns Domain")
+		return diagFromErr(errors.New("Error deleting Dns Domain"))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMDNSDomainExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetDnsDomainService(sess)
-
-	dnsId, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(dnsId).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error retrieving domain info: %s", err)
-	}
-	return result.Id != nil && *result.Id == dnsId, nil
-}
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -32,12 +34,13 @@ func init() {
 
 func resourceIBMDNSRecord() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSRecordExists,
-		Create:   resourceIBMDNSRecordCreate,
-		Read:     resourceIBMDNSRecordRead,
-		Update:   resourceIBMDNSRecordUpdate,
-		Delete:   resourceIBMDNSRecordDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMDNSRecordCreate,
+		ReadContext:   resourceIBMDNSRecordRead,
+		UpdateContext: resourceIBMDNSRecordUpdate,
+		DeleteContext: resourceIBMDNSRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMDNSRecord, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			"data": {
 				Type:     schema.TypeString,
@@ -190,7 +193,7 @@ func resourceIBMDNSRecord() *schema.Resource {
 
 //  Creates DNS Domain Resource Record
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/createObject
-func resourceIBMDNSRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess.SetRetries(0))
 
@@ -266,29 +269,33 @@ func resourceIBMDNSRecordCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error creating DNS Resource %s Record: %s", *opts.Type, err)
+		return diagFromErr(fmt.Errorf("Error creating DNS Resource %s Record: %s", *opts.Type, err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", id))
 
 	log.Printf("[INFO] Dns Resource %s Record ID: %s", *opts.Type, d.Id())
 
-	return resourceIBMDNSRecordRead(d, meta)
+	return resourceIBMDNSRecordRead(ctx, d, meta)
 }
 
 //  Reads DNS Domain Resource Record from SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/getObject
-func resourceIBMDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 	result, err := service.Id(id).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving DNS Resource Record: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving DNS Resource Record: %s", err))
 	}
 
 	// Required fields
@@ -319,7 +326,7 @@ func resourceIBMDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
 
 //  Updates DNS Domain Resource Record in SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/editObject
-func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 	serviceNoRetry := services.GetDnsDomainResourceRecordService(sess.SetRetries(0))
@@ -327,7 +334,7 @@ func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 	recordId, _ := strconv.Atoi(d.Id())
 	record, err := service.Id(recordId).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving DNS Resource Record: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving DNS Resource Record: %s", err))
 	}
 
 	recordType := d.Get("type").(string)
@@ -405,7 +412,7 @@ func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error editing DNS Resource %s Record %d: %s", recordType, recordId, err)
+		return diagFromErr(fmt.Errorf("Error editing DNS Resource %s Record %d: %s", recordType, recordId, err))
 	}
 
 	return nil
@@ -413,43 +420,21 @@ func resourceIBMDNSRecordUpdate(d *schema.ResourceData, meta interface{}) error
 
 //  Deletes DNS Domain Resource Record in SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/deleteObject
-func resourceIBMDNSRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	_, err = service.Id(id).DeleteObject()
 
 	if err != nil {
-		return fmt.Errorf("Error deleting DNS Resource Record: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting DNS Resource Record: %s", err))
 	}
 
 	return nil
 }
 
-// Exists function is called by refresh
-// if the entity is absent - it is deleted from the .tfstate file
-func resourceIBMDNSRecordExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetDnsDomainResourceRecordService(sess)
-
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	record, err := service.Id(id).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error retrieving domain record info: %s", err)
-	}
-	return record.Id != nil && *record.Id == id, nil
-}
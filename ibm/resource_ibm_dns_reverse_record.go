@@ -4,10 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
 	"github.com/softlayer/softlayer-go/sl"
@@ -15,12 +18,13 @@ import (
 
 func resourceIBMDNSReverseRecord() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSREVERSERecordExists,
-		Create:   resourceIBMDNSREVERSERecordCreate,
-		Read:     resourceIBMDNSREVERSERecordRead,
-		Update:   resourceIBMDNSREVERSERecordUpdate,
-		Delete:   resourceIBMDNSREVERSERecordDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMDNSREVERSERecordCreate,
+		ReadContext:   resourceIBMDNSREVERSERecordRead,
+		UpdateContext: resourceIBMDNSREVERSERecordUpdate,
+		DeleteContext: resourceIBMDNSREVERSERecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMDNSReverseRecord, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			"ipaddress": {
 				Type:        schema.TypeString,
@@ -47,7 +51,7 @@ func resourceIBMDNSReverseRecord() *schema.Resource {
 
 //  Creates DNS Domain Reverse Record
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain/CreatePtrRecord
-func resourceIBMDNSREVERSERecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSREVERSERecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainService(sess.SetRetries(0))
 	Data := sl.String(d.Get("hostname").(string))
@@ -60,40 +64,68 @@ func resourceIBMDNSREVERSERecordCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error creating DNS Reverse %s", err)
+		return diagFromErr(fmt.Errorf("Error creating DNS Reverse %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", id))
 	log.Printf("[INFO] Dns Reverse %s ", d.Id())
-	return resourceIBMDNSREVERSERecordRead(d, meta)
+	return resourceIBMDNSREVERSERecordRead(ctx, d, meta)
 }
 
 //  Reads DNS Domain Reverse Record from SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/getObject
-func resourceIBMDNSREVERSERecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSREVERSERecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
-	_, nexterr := service.Id(id).GetObject()
+	record, nexterr := service.Id(id).Mask("data;host;ttl;domain.name").GetObject()
 	if nexterr != nil {
-		return fmt.Errorf("Error retrieving DNS Reverse Record: %s", err)
+		if apiErr, ok := nexterr.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving DNS Reverse Record: %s", nexterr))
+	}
+	d.Set("hostname", sl.Get(record.Data, nil))
+	d.Set("ttl", sl.Get(record.Ttl, nil))
+	if record.Host != nil && record.Domain != nil && record.Domain.Name != nil {
+		if ipAddress, ok := ptrRecordIPAddress(*record.Host, *record.Domain.Name); ok {
+			d.Set("ipaddress", ipAddress)
+		}
 	}
 	return nil
 }
 
+// ptrRecordIPAddress returns the IPv4 address of a PTR record from its host and its in-addr.arpa domain,
+// e.g. 4 and 3.2.1.in-addr.arpa for 1.2.3.4
+func ptrRecordIPAddress(host, domain string) (string, bool) {
+	network := strings.TrimSuffix(domain, ".in-addr.arpa")
+	if network == domain {
+		return "", false
+	}
+	octets := append(strings.Split(network, "."), host)
+	if len(octets) != 4 {
+		return "", false
+	}
+	for i, j := 0, len(octets)-2; i < j; i, j = i+1, j-1 {
+		octets[i], octets[j] = octets[j], octets[i]
+	}
+	return strings.Join(octets, "."), true
+}
+
 //  Updates DNS Domain Reverse Record in SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/editObject
-func resourceIBMDNSREVERSERecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSREVERSERecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 	serviceNoRetry := services.GetDnsDomainResourceRecordService(sess.SetRetries(0))
 	recordId, _ := strconv.Atoi(d.Id())
 	record, err := service.Id(recordId).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving DNS Reverse Record: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving DNS Reverse Record: %s", err))
 	}
 	if data, ok := d.GetOk("hostname"); ok && d.HasChange("hostname") {
 		record.Data = sl.String(data.(string))
@@ -104,45 +136,25 @@ func resourceIBMDNSREVERSERecordUpdate(d *schema.ResourceData, meta interface{})
 	record.IsGatewayAddress = nil
 	_, err = serviceNoRetry.Id(recordId).EditObject(&record)
 	if err != nil {
-		return fmt.Errorf("Error editing DNS Reverse  Record %d: %s", recordId, err)
+		return diagFromErr(fmt.Errorf("Error editing DNS Reverse  Record %d: %s", recordId, err))
 	}
 	return nil
 }
 
 //  Deletes DNS Domain Reverse Record in SL system
 //  https://sldn.softlayer.com/reference/services/SoftLayer_Dns_Domain_ResourceRecord/deleteObject
-func resourceIBMDNSREVERSERecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSREVERSERecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsDomainResourceRecordService(sess)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 	_, err = service.Id(id).DeleteObject()
 
 	if err != nil {
-		return fmt.Errorf("Error deleting DNS Reverse Record: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting DNS Reverse Record: %s", err))
 	}
 	return nil
 }
 
-// Exists function is called by refresh
-// if the entity is absent - it is deleted from the .tfstate file
-func resourceIBMDNSREVERSERecordExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetDnsDomainResourceRecordService(sess)
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-	record, err := service.Id(id).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error retrieving domain reverse record info: %s", err)
-	}
-	return record.Id != nil && *record.Id == id, nil
-}
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -17,12 +19,13 @@ import (
 
 func resourceIBMDNSSecondary() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMDNSSecondaryExists,
-		Create:   resourceIBMDNSSecondaryCreate,
-		Read:     resourceIBMDNSSecondaryRead,
-		Update:   resourceIBMDNSSecondaryUpdate,
-		Delete:   resourceIBMDNSSecondaryDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMDNSSecondaryCreate,
+		ReadContext:   resourceIBMDNSSecondaryRead,
+		UpdateContext: resourceIBMDNSSecondaryUpdate,
+		DeleteContext: resourceIBMDNSSecondaryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMDNSSecondary, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			"master_ip_address": {
 				Type:        schema.TypeString,
@@ -66,7 +69,7 @@ func resourceIBMDNSSecondary() *schema.Resource {
 	}
 }
 
-func resourceIBMDNSSecondaryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsSecondaryService(sess)
 
@@ -80,7 +83,7 @@ func resourceIBMDNSSecondaryCreate(d *schema.ResourceData, meta interface{}) err
 	// create Dns_Secondary object
 	response, err := service.CreateObject(&opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Dns Secondary Zone: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Dns Secondary Zone: %s", err))
 	}
 
 	// populate id
@@ -89,10 +92,10 @@ func resourceIBMDNSSecondaryCreate(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[INFO] Created Dns Secondary Zone: %d", id)
 
 	// read remote state
-	return resourceIBMDNSSecondaryRead(d, meta)
+	return resourceIBMDNSSecondaryRead(ctx, d, meta)
 }
 
-func resourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsSecondaryService(sess)
 
@@ -101,7 +104,11 @@ func resourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) error
 	// retrieve remote object state
 	dns_domain_secondary, err := service.Id(dnsId).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Dns Secondary Zone %d: %s", dnsId, err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving Dns Secondary Zone %d: %s", dnsId, err))
 	}
 
 	// populate fields
@@ -114,7 +121,7 @@ func resourceIBMDNSSecondaryRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMDNSSecondaryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	domainId, _ := strconv.Atoi(d.Id())
 	hasChange := false
@@ -135,45 +142,32 @@ func resourceIBMDNSSecondaryUpdate(d *schema.ResourceData, meta interface{}) err
 		_, err := service.Id(domainId).EditObject(&opts)
 
 		if err != nil {
-			return fmt.Errorf("Error editing DNS secondary zone (%d): %s", domainId, err)
+			return diagFromErr(fmt.Errorf("Error editing DNS secondary zone (%d): %s", domainId, err))
 		}
 	}
 
 	return nil
 }
 
-func resourceIBMDNSSecondaryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMDNSSecondaryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetDnsSecondaryService(sess)
 
 	dnsId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting Dns Secondary Zone: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Dns Secondary Zone: %s", err))
 	}
 
 	log.Printf("[INFO] Deleting Dns Secondary Zone: %d", dnsId)
 	result, err := service.Id(dnsId).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Dns Secondary Zone: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Dns Secondary Zone: %s", err))
 	}
 
 	if !result {
-		return errors.New("Error deleting Dns Secondary Zone")
+		return diagFromErr(errors.New("Error deleting Dns Secondary Zone"))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMDNSSecondaryExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetDnsSecondaryService(sess)
-
-	dnsId, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(dnsId).GetObject()
-	return err == nil && result.Id != nil && *result.Id == dnsId, nil
-}
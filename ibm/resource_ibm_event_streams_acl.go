@@ -0,0 +1,398 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	eventStreamsACLResourceType   = "resource_type"
+	eventStreamsACLResourceName   = "resource_name"
+	eventStreamsACLPatternType    = "pattern_type"
+	eventStreamsACLPrincipal      = "principal"
+	eventStreamsACLHost           = "host"
+	eventStreamsACLOperation      = "operation"
+	eventStreamsACLPermissionType = "permission_type"
+)
+
+var (
+	eventStreamsACLResourceTypes = map[string]sarama.AclResourceType{
+		"topic":            sarama.AclResourceTopic,
+		"group":            sarama.AclResourceGroup,
+		"cluster":          sarama.AclResourceCluster,
+		"transactional_id": sarama.AclResourceTransactionalID,
+	}
+	eventStreamsACLPatternTypes = map[string]sarama.AclResourcePatternType{
+		"literal":  sarama.AclPatternLiteral,
+		"prefixed": sarama.AclPatternPrefixed,
+	}
+	eventStreamsACLOperations = map[string]sarama.AclOperation{
+		"all":              sarama.AclOperationAll,
+		"read":             sarama.AclOperationRead,
+		"write":            sarama.AclOperationWrite,
+		"create":           sarama.AclOperationCreate,
+		"delete":           sarama.AclOperationDelete,
+		"alter":            sarama.AclOperationAlter,
+		"describe":         sarama.AclOperationDescribe,
+		"cluster_action":   sarama.AclOperationClusterAction,
+		"describe_configs": sarama.AclOperationDescribeConfigs,
+		"alter_configs":    sarama.AclOperationAlterConfigs,
+		"idempotent_write": sarama.AclOperationIdempotentWrite,
+	}
+	eventStreamsACLPermissionTypes = map[string]sarama.AclPermissionType{
+		"allow": sarama.AclPermissionAllow,
+		"deny":  sarama.AclPermissionDeny,
+	}
+)
+
+func resourceIBMEventStreamsACL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsACLCreate,
+		ReadContext:   resourceIBMEventStreamsACLRead,
+		DeleteContext: resourceIBMEventStreamsACLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMEventStreamsACL, nil),
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			eventStreamsACLResourceType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_event_streams_acl", eventStreamsACLResourceType),
+				Description:  "The type of the resource the ACL applies to, topic, group, cluster or transactional_id",
+			},
+			eventStreamsACLResourceName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the resource the ACL applies to, kafka-cluster for the cluster",
+			},
+			eventStreamsACLPatternType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "literal",
+				ValidateFunc: InvokeValidator("ibm_event_streams_acl", eventStreamsACLPatternType),
+				Description:  "How the resource name is matched, literal or prefixed",
+			},
+			eventStreamsACLPrincipal: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The principal the ACL applies to, like User:iam-ServiceId-1234",
+			},
+			eventStreamsACLHost: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "*",
+				Description: "The host the ACL applies to",
+			},
+			eventStreamsACLOperation: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_event_streams_acl", eventStreamsACLOperation),
+				Description:  "The operation the ACL allows or denies",
+			},
+			eventStreamsACLPermissionType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "allow",
+				ValidateFunc: InvokeValidator("ibm_event_streams_acl", eventStreamsACLPermissionType),
+				Description:  "Whether the ACL allows or denies the operation",
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsACLValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 eventStreamsACLResourceType,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "topic, group, cluster, transactional_id"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 eventStreamsACLPatternType,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "literal, prefixed"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 eventStreamsACLOperation,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "all, read, write, create, delete, alter, describe, cluster_action, describe_configs, alter_configs, idempotent_write"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 eventStreamsACLPermissionType,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "allow, deny"})
+
+	ibmEventStreamsACLResourceValidator := ResourceValidator{ResourceName: "ibm_event_streams_acl", Schema: validateSchema}
+	return &ibmEventStreamsACLResourceValidator
+}
+
+// eventStreamsACL is a Kafka ACL binding, its fields hold the values of the
+// resource arguments
+type eventStreamsACL struct {
+	ResourceType   string
+	ResourceName   string
+	PatternType    string
+	Principal      string
+	Host           string
+	Operation      string
+	PermissionType string
+}
+
+func expandEventStreamsACL(d *schema.ResourceData) eventStreamsACL {
+	return eventStreamsACL{
+		ResourceType:   d.Get(eventStreamsACLResourceType).(string),
+		ResourceName:   d.Get(eventStreamsACLResourceName).(string),
+		PatternType:    d.Get(eventStreamsACLPatternType).(string),
+		Principal:      d.Get(eventStreamsACLPrincipal).(string),
+		Host:           d.Get(eventStreamsACLHost).(string),
+		Operation:      d.Get(eventStreamsACLOperation).(string),
+		PermissionType: d.Get(eventStreamsACLPermissionType).(string),
+	}
+}
+
+// name identifies the ACL in the resource ID, Kafka resource names and
+// principals don't contain commas
+func (a eventStreamsACL) name() string {
+	return strings.Join([]string{a.ResourceType, a.ResourceName, a.PatternType, a.Principal, a.Host, a.Operation, a.PermissionType}, ",")
+}
+
+func parseEventStreamsACLName(name string) (eventStreamsACL, error) {
+	parts := strings.Split(name, ",")
+	if len(parts) != 7 {
+		return eventStreamsACL{}, fmt.Errorf("Incorrect ACL %s: the ACL should be resource_type,resource_name,pattern_type,principal,host,operation,permission_type", name)
+	}
+	return eventStreamsACL{
+		ResourceType:   parts[0],
+		ResourceName:   parts[1],
+		PatternType:    parts[2],
+		Principal:      parts[3],
+		Host:           parts[4],
+		Operation:      parts[5],
+		PermissionType: parts[6],
+	}, nil
+}
+
+func (a eventStreamsACL) binding() (sarama.Resource, sarama.Acl, error) {
+	resourceType, ok := eventStreamsACLResourceTypes[a.ResourceType]
+	if !ok {
+		return sarama.Resource{}, sarama.Acl{}, fmt.Errorf("Unsupported ACL resource type %q", a.ResourceType)
+	}
+	patternType, ok := eventStreamsACLPatternTypes[a.PatternType]
+	if !ok {
+		return sarama.Resource{}, sarama.Acl{}, fmt.Errorf("Unsupported ACL pattern type %q", a.PatternType)
+	}
+	operation, ok := eventStreamsACLOperations[a.Operation]
+	if !ok {
+		return sarama.Resource{}, sarama.Acl{}, fmt.Errorf("Unsupported ACL operation %q", a.Operation)
+	}
+	permissionType, ok := eventStreamsACLPermissionTypes[a.PermissionType]
+	if !ok {
+		return sarama.Resource{}, sarama.Acl{}, fmt.Errorf("Unsupported ACL permission type %q", a.PermissionType)
+	}
+	resource := sarama.Resource{
+		ResourceType:        resourceType,
+		ResourceName:        a.ResourceName,
+		ResourcePatternType: patternType,
+	}
+	acl := sarama.Acl{
+		Principal:      a.Principal,
+		Host:           a.Host,
+		Operation:      operation,
+		PermissionType: permissionType,
+	}
+	return resource, acl, nil
+}
+
+// filter matches exactly the binding of the ACL
+func (a eventStreamsACL) filter() (sarama.AclFilter, error) {
+	resource, acl, err := a.binding()
+	if err != nil {
+		return sarama.AclFilter{}, err
+	}
+	return sarama.AclFilter{
+		ResourceType:              resource.ResourceType,
+		ResourceName:              &resource.ResourceName,
+		ResourcePatternTypeFilter: resource.ResourcePatternType,
+		Principal:                 &acl.Principal,
+		Host:                      &acl.Host,
+		Operation:                 acl.Operation,
+		PermissionType:            acl.PermissionType,
+	}, nil
+}
+
+func createEventStreamsACL(adminClient sarama.ClusterAdmin, a eventStreamsACL) error {
+	resource, acl, err := a.binding()
+	if err != nil {
+		return err
+	}
+	return adminClient.CreateACL(resource, acl)
+}
+
+func findEventStreamsACL(adminClient sarama.ClusterAdmin, a eventStreamsACL) (bool, error) {
+	filter, err := a.filter()
+	if err != nil {
+		return false, err
+	}
+	resourceACLs, err := adminClient.ListAcls(filter)
+	if err != nil {
+		return false, err
+	}
+	wantResource, wantACL, _ := a.binding()
+	for _, resourceACL := range resourceACLs {
+		if resourceACL.Resource != wantResource {
+			continue
+		}
+		for _, acl := range resourceACL.Acls {
+			if acl != nil && *acl == wantACL {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func deleteEventStreamsACL(adminClient sarama.ClusterAdmin, a eventStreamsACL) error {
+	filter, err := a.filter()
+	if err != nil {
+		return err
+	}
+	matchingACLs, err := adminClient.DeleteACL(filter, false)
+	if err != nil {
+		return err
+	}
+	for _, matchingACL := range matchingACLs {
+		if matchingACL.Err != sarama.ErrNoError {
+			return matchingACL.Err
+		}
+	}
+	return nil
+}
+
+func resourceIBMEventStreamsACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("resource_instance_id").(string)
+	acl := expandEventStreamsACL(d)
+	id, err := eventStreamsResourceID(instanceCRN, "acl", acl.name())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	instance, err := getEventStreamsInstance(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	adminClient, err := eventStreamsAdminClient(instance)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	err = createEventStreamsACL(adminClient, acl)
+	if err != nil {
+		clientPool.EvictOnError(instanceCRN, err)
+		return diagFromErr(fmt.Errorf("Error creating the ACL %s of Event Streams instance (%s): %s", acl.name(), instanceCRN, err))
+	}
+	// CreateACL doesn't report the errors of the broker, the ACL is looked up to make sure it exists
+	found, err := findEventStreamsACL(adminClient, acl)
+	if err != nil {
+		clientPool.EvictOnError(instanceCRN, err)
+		return diagFromErr(fmt.Errorf("Error retrieving the ACL %s of Event Streams instance (%s): %s", acl.name(), instanceCRN, err))
+	}
+	if !found {
+		return diagFromErr(fmt.Errorf("The ACL %s of Event Streams instance (%s) was not created, check the permissions of the API key", acl.name(), instanceCRN))
+	}
+	log.Printf("[INFO] resourceIBMEventStreamsACLCreate ACL %s created", acl.name())
+	d.SetId(id)
+	return resourceIBMEventStreamsACLRead(ctx, d, meta)
+}
+
+func resourceIBMEventStreamsACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, name, err := parseEventStreamsResourceID(d.Id(), "acl")
+	if err != nil {
+		return diagFromErr(err)
+	}
+	acl, err := parseEventStreamsACLName(name)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	instance, err := getEventStreamsInstance(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	adminClient, err := eventStreamsAdminClient(instance)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	found, err := findEventStreamsACL(adminClient, acl)
+	if err != nil {
+		clientPool.EvictOnError(instanceCRN, err)
+		return diagFromErr(fmt.Errorf("Error retrieving the ACL %s of Event Streams instance (%s): %s", name, instanceCRN, err))
+	}
+	if !found {
+		log.Printf("[INFO] resourceIBMEventStreamsACLRead ACL %s does not exist", name)
+		d.SetId("")
+		return nil
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set(eventStreamsACLResourceType, acl.ResourceType)
+	d.Set(eventStreamsACLResourceName, acl.ResourceName)
+	d.Set(eventStreamsACLPatternType, acl.PatternType)
+	d.Set(eventStreamsACLPrincipal, acl.Principal)
+	d.Set(eventStreamsACLHost, acl.Host)
+	d.Set(eventStreamsACLOperation, acl.Operation)
+	d.Set(eventStreamsACLPermissionType, acl.PermissionType)
+	return nil
+}
+
+func resourceIBMEventStreamsACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, name, err := parseEventStreamsResourceID(d.Id(), "acl")
+	if err != nil {
+		return diagFromErr(err)
+	}
+	acl, err := parseEventStreamsACLName(name)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	instance, err := getEventStreamsInstance(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	adminClient, err := eventStreamsAdminClient(instance)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	err = deleteEventStreamsACL(adminClient, acl)
+	if err != nil {
+		clientPool.EvictOnError(instanceCRN, err)
+		return diagFromErr(fmt.Errorf("Error deleting the ACL %s of Event Streams instance (%s): %s", name, instanceCRN, err))
+	}
+	d.SetId("")
+	return nil
+}
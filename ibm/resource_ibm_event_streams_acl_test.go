@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMEventStreamsACLResourceBasic(t *testing.T) {
+	topicName := fmt.Sprintf("es_topic_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMEventStreamsACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsACLConfig(existingInstanceName, topicName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEventStreamsACLExists("ibm_event_streams_acl.es_acl"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "resource_type", "topic"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "resource_name", topicName),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "pattern_type", "literal"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "host", "*"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "permission_type", "allow"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_acl.es_acl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsACLConfig(instanceName, topicName string) string {
+	return getPlatformResource(instanceName) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_topic" "es_topic" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		name                 = "%s"
+		partitions           = 1
+	}
+	resource "ibm_event_streams_acl" "es_acl" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		resource_type        = "topic"
+		resource_name        = ibm_event_streams_topic.es_topic.name
+		principal            = "User:iam-ServiceId-00000000-0000-0000-0000-000000000000"
+		operation            = "read"
+	}`, topicName)
+}
+
+func testAccCheckIBMEventStreamsACLFound(rs *terraform.ResourceState) (bool, error) {
+	instanceCRN, name, err := parseEventStreamsResourceID(rs.Primary.ID, "acl")
+	if err != nil {
+		return false, err
+	}
+	acl, err := parseEventStreamsACLName(name)
+	if err != nil {
+		return false, err
+	}
+	instance, err := getEventStreamsInstance(testAccProvider.Meta(), instanceCRN)
+	if err != nil {
+		return false, err
+	}
+	adminClient, err := eventStreamsAdminClient(instance)
+	if err != nil {
+		return false, err
+	}
+	return findEventStreamsACL(adminClient, acl)
+}
+
+func testAccCheckIBMEventStreamsACLExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		found, err := testAccCheckIBMEventStreamsACLFound(rs)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("ACL %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMEventStreamsACLDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_event_streams_acl" {
+			continue
+		}
+		found, err := testAccCheckIBMEventStreamsACLFound(rs)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("ACL still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
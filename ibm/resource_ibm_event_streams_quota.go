@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	eventStreamsQuotaEntity           = "entity"
+	eventStreamsQuotaProducerByteRate = "producer_byte_rate"
+	eventStreamsQuotaConsumerByteRate = "consumer_byte_rate"
+)
+
+func resourceIBMEventStreamsQuota() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsQuotaCreate,
+		ReadContext:   resourceIBMEventStreamsQuotaRead,
+		UpdateContext: resourceIBMEventStreamsQuotaUpdate,
+		DeleteContext: resourceIBMEventStreamsQuotaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMEventStreamsQuota, nil),
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			eventStreamsQuotaEntity: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The entity the quota applies to, default or the IAM ID of a user or service ID",
+			},
+			eventStreamsQuotaProducerByteRate: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{eventStreamsQuotaProducerByteRate, eventStreamsQuotaConsumerByteRate},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The producer throughput quota in bytes per second",
+			},
+			eventStreamsQuotaConsumerByteRate: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				AtLeastOneOf: []string{eventStreamsQuotaProducerByteRate, eventStreamsQuotaConsumerByteRate},
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The consumer throughput quota in bytes per second",
+			},
+		},
+	}
+}
+
+func expandEventStreamsQuota(d *schema.ResourceData) eventStreamsQuota {
+	quota := eventStreamsQuota{}
+	if rate, ok := d.GetOk(eventStreamsQuotaProducerByteRate); ok {
+		quota.ProducerByteRate = ptrToInt(rate.(int))
+	}
+	if rate, ok := d.GetOk(eventStreamsQuotaConsumerByteRate); ok {
+		quota.ConsumerByteRate = ptrToInt(rate.(int))
+	}
+	return quota
+}
+
+func eventStreamsQuotaAPI(meta interface{}, instanceCRN string) (*eventStreamsREST, error) {
+	instance, err := getEventStreamsInstance(meta, instanceCRN)
+	if err != nil {
+		return nil, err
+	}
+	return newEventStreamsAdminREST(meta.(ClientSession).HTTPClient(), instance), nil
+}
+
+func resourceIBMEventStreamsQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("resource_instance_id").(string)
+	entity := d.Get(eventStreamsQuotaEntity).(string)
+	id, err := eventStreamsResourceID(instanceCRN, "quota", entity)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	client, err := eventStreamsQuotaAPI(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	err = client.CreateQuota(entity, expandEventStreamsQuota(d))
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating the quota of %s on Event Streams instance (%s): %s", entity, instanceCRN, err))
+	}
+	log.Printf("[INFO] resourceIBMEventStreamsQuotaCreate quota of %s created", entity)
+	d.SetId(id)
+	return resourceIBMEventStreamsQuotaRead(ctx, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, entity, err := parseEventStreamsResourceID(d.Id(), "quota")
+	if err != nil {
+		return diagFromErr(err)
+	}
+	client, err := eventStreamsQuotaAPI(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	quota, err := client.GetQuota(entity)
+	if err != nil {
+		if isEventStreamsNotFound(err) {
+			log.Printf("[INFO] resourceIBMEventStreamsQuotaRead quota of %s does not exist", entity)
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving the quota of %s on Event Streams instance (%s): %s", entity, instanceCRN, err))
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set(eventStreamsQuotaEntity, entity)
+	d.Set(eventStreamsQuotaProducerByteRate, 0)
+	if quota.ProducerByteRate != nil {
+		d.Set(eventStreamsQuotaProducerByteRate, *quota.ProducerByteRate)
+	}
+	d.Set(eventStreamsQuotaConsumerByteRate, 0)
+	if quota.ConsumerByteRate != nil {
+		d.Set(eventStreamsQuotaConsumerByteRate, *quota.ConsumerByteRate)
+	}
+	return nil
+}
+
+func resourceIBMEventStreamsQuotaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, entity, err := parseEventStreamsResourceID(d.Id(), "quota")
+	if err != nil {
+		return diagFromErr(err)
+	}
+	client, err := eventStreamsQuotaAPI(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	quota := expandEventStreamsQuota(d)
+	// A patch can't remove a rate, the quota is created again without it
+	removed := (d.HasChange(eventStreamsQuotaProducerByteRate) && quota.ProducerByteRate == nil) ||
+		(d.HasChange(eventStreamsQuotaConsumerByteRate) && quota.ConsumerByteRate == nil)
+	if removed {
+		err = client.DeleteQuota(entity)
+		if err == nil || isEventStreamsNotFound(err) {
+			err = client.CreateQuota(entity, quota)
+		}
+	} else {
+		err = client.UpdateQuota(entity, quota)
+	}
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error updating the quota of %s on Event Streams instance (%s): %s", entity, instanceCRN, err))
+	}
+	return resourceIBMEventStreamsQuotaRead(ctx, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, entity, err := parseEventStreamsResourceID(d.Id(), "quota")
+	if err != nil {
+		return diagFromErr(err)
+	}
+	client, err := eventStreamsQuotaAPI(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	err = client.DeleteQuota(entity)
+	if err != nil && !isEventStreamsNotFound(err) {
+		return diagFromErr(fmt.Errorf("Error deleting the quota of %s on Event Streams instance (%s): %s", entity, instanceCRN, err))
+	}
+	d.SetId("")
+	return nil
+}
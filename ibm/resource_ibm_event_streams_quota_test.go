@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMEventStreamsQuotaResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMEventStreamsQuotaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsQuotaConfig(existingInstanceName, "producer_byte_rate = 1048576"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "entity", "default"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", "1048576"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsQuotaConfig(existingInstanceName, "consumer_byte_rate = 2097152"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", "0"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", "2097152"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_quota.es_quota",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsQuotaConfig(instanceName, rates string) string {
+	return getPlatformResource(instanceName) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_quota" "es_quota" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		entity               = "default"
+		%s
+	}`, rates)
+}
+
+func testAccCheckIBMEventStreamsQuotaDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_event_streams_quota" {
+			continue
+		}
+		instanceCRN, entity, err := parseEventStreamsResourceID(rs.Primary.ID, "quota")
+		if err != nil {
+			return err
+		}
+		client, err := eventStreamsQuotaAPI(testAccProvider.Meta(), instanceCRN)
+		if err != nil {
+			return err
+		}
+		_, err = client.GetQuota(entity)
+		if err == nil {
+			return fmt.Errorf("Quota still exists: %s", rs.Primary.ID)
+		}
+		if !isEventStreamsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
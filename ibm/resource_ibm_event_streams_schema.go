@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	eventStreamsSchemaSubject  = "subject"
+	eventStreamsSchemaSchema   = "schema"
+	eventStreamsSchemaVersion  = "version"
+	eventStreamsSchemaID       = "schema_id"
+	eventStreamsSchemaVersions = "versions"
+)
+
+func resourceIBMEventStreamsSchema() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsSchemaCreate,
+		ReadContext:   resourceIBMEventStreamsSchemaRead,
+		UpdateContext: resourceIBMEventStreamsSchemaUpdate,
+		DeleteContext: resourceIBMEventStreamsSchemaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMEventStreamsSchema, nil),
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			eventStreamsSchemaSubject: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The subject of the schema, like <topic>-value",
+			},
+			eventStreamsSchemaSchema: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+				Description:      "The Avro schema, a change registers a new version of the schema",
+			},
+			eventStreamsSchemaVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The latest version of the schema",
+			},
+			eventStreamsSchemaID: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the latest version of the schema",
+			},
+			eventStreamsSchemaVersions: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The versions of the schema of the subject",
+			},
+		},
+	}
+}
+
+func eventStreamsSchemaRegistryAPI(meta interface{}, instanceCRN string) (*eventStreamsREST, error) {
+	instance, err := getEventStreamsInstance(meta, instanceCRN)
+	if err != nil {
+		return nil, err
+	}
+	return newEventStreamsSchemaRegistry(meta.(ClientSession).HTTPClient(), instance), nil
+}
+
+func resourceIBMEventStreamsSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("resource_instance_id").(string)
+	subject := d.Get(eventStreamsSchemaSubject).(string)
+	resourceID, err := eventStreamsResourceID(instanceCRN, "schema", subject)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	client, err := eventStreamsSchemaRegistryAPI(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id, err := client.RegisterSchema(subject, d.Get(eventStreamsSchemaSchema).(string))
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error registering the schema of subject %s on Event Streams instance (%s): %s", subject, instanceCRN, err))
+	}
+	log.Printf("[INFO] resourceIBMEventStreamsSchemaCreate schema %d of subject %s registered", id, subject)
+	d.SetId(resourceID)
+	return resourceIBMEventStreamsSchemaRead(ctx, d, meta)
+}
+
+func resourceIBMEventStreamsSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, subject, err := parseEventStreamsResourceID(d.Id(), "schema")
+	if err != nil {
+		return diagFromErr(err)
+	}
+	client, err := eventStreamsSchemaRegistryAPI(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	latest, err := client.GetSchemaVersion(subject, "latest")
+	if err != nil {
+		if isEventStreamsNotFound(err) {
+			log.Printf("[INFO] resourceIBMEventStreamsSchemaRead subject %s does not exist", subject)
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving the schema of subject %s on Event Streams instance (%s): %s", subject, instanceCRN, err))
+	}
+	versions, err := client.ListSchemaVersions(subject)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error retrieving the schema versions of subject %s on Event Streams instance (%s): %s", subject, instanceCRN, err))
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set(eventStreamsSchemaSubject, subject)
+	d.Set(eventStreamsSchemaSchema, latest.Schema)
+	d.Set(eventStreamsSchemaVersion, latest.Version)
+	d.Set(eventStreamsSchemaID, latest.ID)
+	d.Set(eventStreamsSchemaVersions, versions)
+	return nil
+}
+
+func resourceIBMEventStreamsSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, subject, err := parseEventStreamsResourceID(d.Id(), "schema")
+	if err != nil {
+		return diagFromErr(err)
+	}
+	client, err := eventStreamsSchemaRegistryAPI(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if d.HasChange(eventStreamsSchemaSchema) {
+		// The registry rejects a schema that isn't compatible with the previous versions
+		id, err := client.RegisterSchema(subject, d.Get(eventStreamsSchemaSchema).(string))
+		if err != nil {
+			return diagFromErr(newAttributeError(eventStreamsSchemaSchema, fmt.Sprintf("Error registering a new version of the schema of subject %s on Event Streams instance (%s)", subject, instanceCRN), err))
+		}
+		log.Printf("[INFO] resourceIBMEventStreamsSchemaUpdate schema %d of subject %s registered", id, subject)
+	}
+	return resourceIBMEventStreamsSchemaRead(ctx, d, meta)
+}
+
+func resourceIBMEventStreamsSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, subject, err := parseEventStreamsResourceID(d.Id(), "schema")
+	if err != nil {
+		return diagFromErr(err)
+	}
+	client, err := eventStreamsSchemaRegistryAPI(meta, instanceCRN)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	err = client.DeleteSubject(subject)
+	if err != nil && !isEventStreamsNotFound(err) {
+		return diagFromErr(fmt.Errorf("Error deleting the subject %s on Event Streams instance (%s): %s", subject, instanceCRN, err))
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMEventStreamsSchemaResourceBasic(t *testing.T) {
+	subject := fmt.Sprintf("es_topic_%d-value", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMEventStreamsSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsSchemaConfig(existingInstanceName, subject, `[{"name": "id", "type": "string"}]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "subject", subject),
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "version", "1"),
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "versions.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_event_streams_schema.es_schema", "schema_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsSchemaConfig(existingInstanceName, subject, `[{"name": "id", "type": "string"}, {"name": "total", "type": "int", "default": 0}]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "version", "2"),
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "versions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_schema.es_schema",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsSchemaConfig(instanceName, subject, fields string) string {
+	return getPlatformResource(instanceName) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_schema" "es_schema" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		subject              = "%s"
+		schema = jsonencode({
+			type   = "record"
+			name   = "order"
+			fields = jsondecode(%q)
+		})
+	}`, subject, fields)
+}
+
+func testAccCheckIBMEventStreamsSchemaDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_event_streams_schema" {
+			continue
+		}
+		instanceCRN, subject, err := parseEventStreamsResourceID(rs.Primary.ID, "schema")
+		if err != nil {
+			return err
+		}
+		client, err := eventStreamsSchemaRegistryAPI(testAccProvider.Meta(), instanceCRN)
+		if err != nil {
+			return err
+		}
+		_, err = client.GetSchemaVersion(subject, "latest")
+		if err == nil {
+			return fmt.Errorf("Schema still exists: %s", rs.Primary.ID)
+		}
+		if !isEventStreamsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,12 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"os"
 	"strings"
 
 	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -41,12 +42,13 @@ var (
 
 func resourceIBMEventStreamsTopic() *schema.Resource {
 	return &schema.Resource{
-		Exists:   resourceIBMEventStreamsTopicExists,
-		Create:   resourceIBMEventStreamsTopicCreate,
-		Read:     resourceIBMEventStreamsTopicRead,
-		Update:   resourceIBMEventStreamsTopicUpdate,
-		Delete:   resourceIBMEventStreamsTopicDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMEventStreamsTopicCreate,
+		ReadContext:   resourceIBMEventStreamsTopicRead,
+		UpdateContext: resourceIBMEventStreamsTopicUpdate,
+		DeleteContext: resourceIBMEventStreamsTopicDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMEventStreamsTopic, nil),
+		},
 		Schema: map[string]*schema.Schema{
 			"resource_instance_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -84,12 +86,8 @@ func resourceIBMEventStreamsTopic() *schema.Resource {
 	}
 }
 
-// clientPool maintains Kafka admin client for each instance.
-// key is instance's CRN
-var clientPool = map[string]sarama.ClusterAdmin{}
-
 func resourceIBMEventStreamsTopicExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	adminClient, _, err := createSaramaAdminClient(d, meta)
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicExists createSaramaAdminClient err %s", err)
 		return false, err
@@ -98,17 +96,18 @@ func resourceIBMEventStreamsTopicExists(d *schema.ResourceData, meta interface{}
 	topics, err := adminClient.DescribeTopics([]string{topicName})
 	if err != nil || len(topics) != 1 {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicExists DescribeTopics err %s", err)
+		clientPool.EvictOnError(instanceCRN, err)
 		return false, err
 	}
 	log.Printf("[INFO] resourceIBMEventStreamsTopicExists topic %s exists", topicName)
 	return true, nil
 }
 
-func resourceIBMEventStreamsTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMEventStreamsTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicCreate createSaramaAdminClient err %s", err)
-		return err
+		return diagFromErr(err)
 	}
 	topicName := d.Get("name").(string)
 	partitions := d.Get("partitions").(int)
@@ -121,24 +120,26 @@ func resourceIBMEventStreamsTopicCreate(d *schema.ResourceData, meta interface{}
 	err = adminClient.CreateTopic(topicName, &topicDetail, false)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicCreate CreateTopic err %s", err)
+		clientPool.EvictOnError(instanceCRN, err)
 	}
 	log.Printf("[INFO] resourceIBMEventStreamsTopicCreate CreateTopic: topic is %s, detail is %v", topicName, topicDetail)
 	d.SetId(getTopicID(instanceCRN, topicName))
-	return resourceIBMEventStreamsTopicRead(d, meta)
+	return resourceIBMEventStreamsTopicRead(ctx, d, meta)
 }
 
-func resourceIBMEventStreamsTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMEventStreamsTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicRead createSaramaAdminClient err %s", err)
-		return err
+		return diagFromErr(err)
 	}
 	topicID := d.Id()
 	topicName := getTopicName(topicID)
 	topics, err := adminClient.ListTopics()
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicRead ListTopics err %s", err)
-		return err
+		clientPool.EvictOnError(instanceCRN, err)
+		return diagFromErr(err)
 	}
 	for name, detail := range topics {
 		if name == topicName {
@@ -162,11 +163,11 @@ func resourceIBMEventStreamsTopicRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMEventStreamsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
-	adminClient, _, err := createSaramaAdminClient(d, meta)
+func resourceIBMEventStreamsTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicUpdate createSaramaAdminClient err %s", err)
-		return err
+		return diagFromErr(err)
 	}
 	topicName := d.Get("name").(string)
 	if d.HasChange("partitions") {
@@ -177,7 +178,8 @@ func resourceIBMEventStreamsTopicUpdate(d *schema.ResourceData, meta interface{}
 		err = adminClient.CreatePartitions(topicName, int32(newPartitions), nil, false)
 		if err != nil {
 			log.Printf("[DEBUG]resourceIBMEventStreamsTopicUpdate CreatePartitions err %s", err)
-			return err
+			clientPool.EvictOnError(instanceCRN, err)
+			return diagFromErr(err)
 		}
 		d.Set("partitions", int32(newPartitions))
 		log.Printf("[INFO]resourceIBMEventStreamsTopicUpdate partitions is set to %d", newPartitions)
@@ -188,25 +190,27 @@ func resourceIBMEventStreamsTopicUpdate(d *schema.ResourceData, meta interface{}
 		err = adminClient.AlterConfig(sarama.TopicResource, topicName, configEntries, false)
 		if err != nil {
 			log.Printf("[DEBUG]resourceIBMEventStreamsTopicUpdate AlterConfig err %s", err)
-			return err
+			clientPool.EvictOnError(instanceCRN, err)
+			return diagFromErr(err)
 		}
 		d.Set("config", topicDetail2Config(configEntries))
 		log.Printf("[INFO]resourceIBMEventStreamsTopicUpdate config is set to %v", topicDetail2Config(configEntries))
 	}
-	return resourceIBMEventStreamsTopicRead(d, meta)
+	return resourceIBMEventStreamsTopicRead(ctx, d, meta)
 }
 
-func resourceIBMEventStreamsTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	adminClient, _, err := createSaramaAdminClient(d, meta)
+func resourceIBMEventStreamsTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicDelete createSaramaAdminClient err %s", err)
-		return err
+		return diagFromErr(err)
 	}
 	topicName := d.Get("name").(string)
 	err = adminClient.DeleteTopic(topicName)
 	if err != nil {
 		log.Printf("[DEBUG] resourceIBMEventStreamsTopicDelete DeleteTopic err %s", err)
-		return err
+		clientPool.EvictOnError(instanceCRN, err)
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	log.Printf("[INFO]resourceIBMEventStreamsTopicDelete topic %s deleted", topicName)
@@ -214,22 +218,6 @@ func resourceIBMEventStreamsTopicDelete(d *schema.ResourceData, meta interface{}
 }
 
 func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.ClusterAdmin, string, error) {
-	bxSession, err := meta.(ClientSession).BluemixSession()
-	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient BluemixSession err %s", err)
-		return nil, "", err
-	}
-	apiKey := bxSession.Config.BluemixAPIKey
-	if len(apiKey) == 0 {
-		log.Printf("[DEBUG] createSaramaAdminClient BluemixAPIKey is empty")
-		return nil, "", fmt.Errorf("failed to get IBM cloud API key")
-	}
-	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
-	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient ResourceControllerAPI err %s", err)
-		return nil, "", err
-	}
-	rcAPI := rsConClient.ResourceServiceInstance()
 	instanceCRN := d.Get("resource_instance_id").(string)
 	if len(instanceCRN) == 0 {
 		topicID := d.Id()
@@ -239,40 +227,19 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 		}
 		instanceCRN = getInstanceCRN(topicID)
 	}
-	instance, err := rcAPI.GetInstance(instanceCRN)
+	instance, err := getEventStreamsInstance(meta, instanceCRN)
 	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient GetInstance err %s", err)
 		return nil, "", err
 	}
-	if instance.Extensions == nil {
-		log.Printf("[DEBUG] createSaramaAdminClient instance %s extension is nil", instance.ID)
-		return nil, "", fmt.Errorf("instance %s extension is nil", instance.ID)
-	}
-	adminURL := instance.Extensions["kafka_http_url"].(string)
-	d.Set("kafka_http_url", adminURL)
-	log.Printf("[INFO] createSaramaAdminClient kafka_http_url is set to %s", adminURL)
-	brokerAddress := expandStringList(instance.Extensions["kafka_brokers_sasl"].([]interface{}))
-	d.Set("kafka_brokers_sasl", brokerAddress)
-	log.Printf("[INFO] createSaramaAdminClient kafka_brokers_sasl is set to %s", brokerAddress)
-	tenantID := strings.TrimPrefix(strings.Split(adminURL, ".")[0], "https://")
+	d.Set("kafka_http_url", instance.KafkaHTTPURL)
+	log.Printf("[INFO] createSaramaAdminClient kafka_http_url is set to %s", instance.KafkaHTTPURL)
+	d.Set("kafka_brokers_sasl", instance.Brokers)
+	log.Printf("[INFO] createSaramaAdminClient kafka_brokers_sasl is set to %s", instance.Brokers)
 
-	config := sarama.NewConfig()
-	config.ClientID, _ = os.Hostname()
-	config.Net.SASL.Enable = true
-	if tenantID != "" && tenantID != "admin" {
-		config.Net.SASL.AuthIdentity = tenantID
-	}
-	config.Net.SASL.User = "token"
-	config.Net.SASL.Password = apiKey
-	config.Net.TLS.Enable = true
-	config.Version = brokerVersion
-	adminClient, err := sarama.NewClusterAdmin(brokerAddress, config)
+	adminClient, err := eventStreamsAdminClient(instance)
 	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient NewClusterAdmin err %s", err)
 		return nil, "", err
 	}
-	clientPool[instanceCRN] = adminClient
-	log.Printf("[INFO] createSaramaAdminClient instance %s 's client is initialized", instanceCRN)
 	return adminClient, instanceCRN, nil
 }
 
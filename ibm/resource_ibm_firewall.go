@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -31,12 +33,13 @@ const (
 
 func resourceIBMFirewall() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFirewallCreate,
-		Read:     resourceIBMFirewallRead,
-		Update:   resourceIBMFirewallUpdate,
-		Delete:   resourceIBMFirewallDelete,
-		Exists:   resourceIBMFirewallExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFirewallCreate,
+		ReadContext:   resourceIBMFirewallRead,
+		UpdateContext: resourceIBMFirewallUpdate,
+		DeleteContext: resourceIBMFirewallDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMFirewall, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"firewall_type": {
@@ -95,7 +98,7 @@ func resourceIBMFirewall() *schema.Resource {
 	}
 }
 
-func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	keyName := "HARDWARE_FIREWALL_DEDICATED"
@@ -115,13 +118,13 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 
 	pkg, err := product.GetPackageByType(sess, FwHardwareDedicatedPackageType)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Get all prices for ADDITIONAL_SERVICES_FIREWALL with the given capacity
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Select only those product items with a matching keyname
@@ -133,7 +136,7 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(targetItems) == 0 {
-		return fmt.Errorf("No product items matching %s could be found", keyName)
+		return diagFromErr(fmt.Errorf("No product items matching %s could be found", keyName))
 	}
 
 	productOrderContainer := datatypes.Container_Product_Order_Network_Protection_Firewall_Dedicated{
@@ -154,11 +157,11 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(&productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err))
 	}
-	vlan, _, _, err := findDedicatedFirewallByOrderId(sess, *receipt.OrderId, d)
+	vlan, _, _, err := findDedicatedFirewallByOrderId(ctx, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of dedicated hardware firewall: %s", err))
 	}
 
 	id := *vlan.NetworkVlanFirewall.Id
@@ -174,14 +177,14 @@ func resourceIBMFirewallCreate(d *schema.ResourceData, meta interface{}) error {
 		//Try setting only when it is non empty as we are creating Firewall
 		err = setFirewallTags(id, tags, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMFirewallRead(d, meta)
+	return resourceIBMFirewallRead(ctx, d, meta)
 }
 
-func resourceIBMFirewallRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	fwID, _ := strconv.Atoi(d.Id())
@@ -192,7 +195,11 @@ func resourceIBMFirewallRead(d *schema.ResourceData, meta interface{}) error {
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving firewall information: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving firewall information: %s", err))
 	}
 
 	d.Set("public_vlan_id", *fw.NetworkVlan.Id)
@@ -217,11 +224,11 @@ func resourceIBMFirewallRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	fwID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err))
 	}
 
 	// Update tags
@@ -229,13 +236,13 @@ func resourceIBMFirewallUpdate(d *schema.ResourceData, meta interface{}) error {
 		tags := getTags(d)
 		err := setFirewallTags(fwID, tags, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMFirewallRead(d, meta)
+	return resourceIBMFirewallRead(ctx, d, meta)
 }
 
-func resourceIBMFirewallDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	fwService := services.GetNetworkVlanFirewallService(sess)
 
@@ -245,48 +252,26 @@ func resourceIBMFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 	billingItem, err := fwService.Id(fwID).GetBillingItem()
 
 	if err != nil {
-		return fmt.Errorf("Error while looking up billing item associated with the firewall: %s", err)
+		return diagFromErr(fmt.Errorf("Error while looking up billing item associated with the firewall: %s", err))
 	}
 
 	if billingItem.Id == nil {
-		return fmt.Errorf("Error while looking up billing item associated with the firewall: No billing item for ID:%d", fwID)
+		return diagFromErr(fmt.Errorf("Error while looking up billing item associated with the firewall: No billing item for ID:%d", fwID))
 	}
 
 	success, err := services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diagFromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 
 	return nil
 }
 
-func resourceIBMFirewallExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-
-	fwID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	_, err = services.GetNetworkVlanFirewallService(sess).
-		Id(fwID).
-		GetObject()
-
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error retrieving firewall information: %s", err)
-	}
-
-	return true, nil
-}
-
-func findDedicatedFirewallByOrderId(sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Vlan, datatypes.Network_Gateway, datatypes.Product_Upgrade_Request, error) {
+func findDedicatedFirewallByOrderId(ctx context.Context, sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Vlan, datatypes.Network_Gateway, datatypes.Product_Upgrade_Request, error) {
 	filterPath := "networkVlans.networkVlanFirewall.billingItem.orderItem.order.id"
 	multivlanfilterpath := "networkGateways.networkFirewall.billingItem.orderItem.order.id"
 	var vlans []datatypes.Network_Vlan
@@ -346,7 +331,7 @@ func findDedicatedFirewallByOrderId(sess *session.Session, orderId int, d *schem
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Vlan{}, datatypes.Network_Gateway{}, datatypes.Product_Upgrade_Request{}, err
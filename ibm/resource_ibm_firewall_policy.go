@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"strconv"
@@ -12,6 +13,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -25,12 +27,13 @@ const (
 
 func resourceIBMFirewallPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFirewallPolicyCreate,
-		Read:     resourceIBMFirewallPolicyRead,
-		Update:   resourceIBMFirewallPolicyUpdate,
-		Delete:   resourceIBMFirewallPolicyDelete,
-		Exists:   resourceIBMFirewallPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFirewallPolicyCreate,
+		ReadContext:   resourceIBMFirewallPolicyRead,
+		UpdateContext: resourceIBMFirewallPolicyUpdate,
+		DeleteContext: resourceIBMFirewallPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMFirewallPolicy, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"firewall_id": {
@@ -158,7 +161,7 @@ func getFirewallContextAccessControlListId(fwId int, sess *session.Session) (int
 	return 0, fmt.Errorf("No firewallContextAccessControlListId.")
 }
 
-func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	fwId := d.Get("firewall_id").(int)
@@ -166,7 +169,7 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated hardware firewall rules: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of dedicated hardware firewall rules: %s", err))
 	}
 
 	ruleTemplate := datatypes.Network_Firewall_Update_Request{
@@ -178,7 +181,7 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
-		return fmt.Errorf("Error during creation of dedicated hardware firewall rules: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of dedicated hardware firewall rules: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(fwId))
@@ -187,10 +190,10 @@ func resourceIBMFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Wait one minute for applying the rules.")
 	time.Sleep(time.Minute)
 
-	return resourceIBMFirewallPolicyRead(d, meta)
+	return resourceIBMFirewallPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	fwRulesID, _ := strconv.Atoi(d.Id())
@@ -201,7 +204,16 @@ func resourceIBMFirewallPolicyRead(d *schema.ResourceData, meta interface{}) err
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving firewall rules: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving firewall rules: %s", err))
+	}
+
+	if len(fw.Rules) == 0 {
+		d.SetId("")
+		return nil
 	}
 
 	rules := make([]map[string]interface{}, 0, len(fw.Rules))
@@ -258,18 +270,18 @@ func appendAnyOpenRule(rules []datatypes.Network_Firewall_Update_Request_Rule, p
 	return append(rules, ruleAnyOpen, ruleAnyOpenIpv6)
 }
 
-func resourceIBMFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	fwId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err))
 	}
 	rules := prepareRules(d)
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
-		return fmt.Errorf("Error during updating of dedicated hardware firewall rules: %s", err)
+		return diagFromErr(fmt.Errorf("Error during updating of dedicated hardware firewall rules: %s", err))
 	}
 
 	ruleTemplate := datatypes.Network_Firewall_Update_Request{
@@ -281,23 +293,23 @@ func resourceIBMFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
-		return fmt.Errorf("Error during updating of dedicated hardware firewall rules: %s", err)
+		return diagFromErr(fmt.Errorf("Error during updating of dedicated hardware firewall rules: %s", err))
 	}
 	time.Sleep(time.Minute)
 
-	return resourceIBMFirewallPolicyRead(d, meta)
+	return resourceIBMFirewallPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	fwId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid firewall ID, must be an integer: %s", err))
 	}
 
 	fwContextACLId, err := getFirewallContextAccessControlListId(fwId, sess)
 	if err != nil {
-		return fmt.Errorf("Error during deleting of dedicated hardware firewall rules: %s", err)
+		return diagFromErr(fmt.Errorf("Error during deleting of dedicated hardware firewall rules: %s", err))
 	}
 
 	ruleTemplate := datatypes.Network_Firewall_Update_Request{
@@ -316,38 +328,9 @@ func resourceIBMFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) e
 
 	_, err = services.GetNetworkFirewallUpdateRequestService(sess.SetRetries(0)).CreateObject(&ruleTemplate)
 	if err != nil {
-		return fmt.Errorf("Error during deleting of dedicated hardware firewall rules: %s", err)
+		return diagFromErr(fmt.Errorf("Error during deleting of dedicated hardware firewall rules: %s", err))
 	}
 	time.Sleep(time.Minute)
 
 	return nil
 }
-
-func resourceIBMFirewallPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-
-	fwRulesID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	fw, err := services.GetNetworkVlanFirewallService(sess).
-		Id(fwRulesID).
-		Mask("rules").
-		GetObject()
-
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error retrieving firewall rules: %s", err)
-	}
-
-	if len(fw.Rules) == 0 {
-		return false, nil
-	}
-
-	return true, nil
-}
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -23,11 +25,13 @@ const (
 
 func resourceIBMFirewallShared() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFirewallSharedCreate,
-		Read:     resourceIBMFirewallSharedRead,
-		Delete:   resourceIBMFirewallSharedDelete,
-		Exists:   resourceIBMFirewallSharedExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFirewallSharedCreate,
+		ReadContext:   resourceIBMFirewallSharedRead,
+		DeleteContext: resourceIBMFirewallSharedDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMFirewallShared, nil),
+		},
+		CustomizeDiff: InvokeCustomizeDiff("ibm_hardware_firewall_shared"),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -65,9 +69,22 @@ func resourceIBMFirewallShared() *schema.Resource {
 	}
 }
 
+func resourceIBMFirewallSharedValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 0)
+	rules := []ValidateRule{
+		{
+			Type:        ExactlyOneOf,
+			Identifiers: []string{"virtual_instance_id", "hardware_instance_id"},
+		},
+	}
+
+	ibmFirewallSharedResourceValidator := ResourceValidator{ResourceName: "ibm_hardware_firewall_shared", Schema: validateSchema, Rules: rules}
+	return &ibmFirewallSharedResourceValidator
+}
+
 // keyName is in between:[10MBPS_HARDWARE_FIREWALL, 20MBPS_HARDWARE_FIREWALL,
 //                         100MBPS_HARDWARE_FIREWALL, 1000MBPS_HARDWARE_FIREWALL]
-func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallSharedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	keyName := d.Get("firewall_type").(string)
@@ -82,19 +99,19 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if virtualId == 0 && hardwareId == 0 {
-		return fmt.Errorf("Provide either `virtual_instance_id` or `hardware_instance_id`")
+		return diagFromErr(fmt.Errorf("Provide either `virtual_instance_id` or `hardware_instance_id`"))
 	}
 
 	//var productOrderContainer *string
 	pkg, err := product.GetPackageByType(sess, FwHardwarePackageType)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Get all prices for ADDITIONAL_SERVICES_FIREWALL with the given capacity
 	productItems, err := product.GetPackageProducts(sess, *pkg.Id)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Select only those product items with a matching keyname
@@ -106,7 +123,7 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if len(targetItems) == 0 {
-		return fmt.Errorf("No product items matching %s could be found", keyName)
+		return diagFromErr(fmt.Errorf("No product items matching %s could be found", keyName))
 	}
 
 	masked := "id,firewallServiceComponent[id,status]"
@@ -154,9 +171,9 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 			NotFoundChecks: 24 * 60,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		result, err := service.Id(virtualId).Mask(masked).GetObject()
@@ -165,7 +182,7 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 		d.SetId(fmt.Sprintf("%d", idd))
 
 		if err != nil {
-			return fmt.Errorf("Error during creation of hardware firewall: %s", err)
+			return diagFromErr(fmt.Errorf("Error during creation of hardware firewall: %s", err))
 		}
 
 	}
@@ -214,9 +231,9 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 			NotFoundChecks: 24 * 60,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		resultNew, err := service.Id(hardwareId).Mask(masked).GetObject()
@@ -225,16 +242,16 @@ func resourceIBMFirewallSharedCreate(d *schema.ResourceData, meta interface{}) e
 		d.SetId(fmt.Sprintf("%d", idd2))
 		log.Print(idd2)
 		if err != nil {
-			return fmt.Errorf("Error during creation of hardware firewall: %s", err)
+			return diagFromErr(fmt.Errorf("Error during creation of hardware firewall: %s", err))
 		}
 
 	}
 	log.Println("[INFO] Creating hardware firewall shared")
 
-	return resourceIBMFirewallSharedRead(d, meta)
+	return resourceIBMFirewallSharedRead(ctx, d, meta)
 }
 
-func resourceIBMFirewallSharedRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallSharedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	firewall_type := (d.Get("firewall_type").(string))
@@ -247,40 +264,30 @@ func resourceIBMFirewallSharedRead(d *schema.ResourceData, meta interface{}) err
 	data, err := fservice.Id(fwID).Mask("billingItem.id").GetObject()
 	d.Set("billing_item_id", *data.BillingItem.Id)
 	if err != nil {
-		return fmt.Errorf("Error during creation of hardware firewall: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error during creation of hardware firewall: %s", err))
 	}
 
 	return nil
 }
 
 //detach hardware firewall from particular machine
-func resourceIBMFirewallSharedDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFirewallSharedDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	idd2 := (d.Get("billing_item_id")).(int)
 
 	success, err := services.GetBillingItemService(sess).Id(idd2).CancelService()
 	log.Print(success)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diagFromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 	return nil
 }
 
-//exists method
-func resourceIBMFirewallSharedExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	fservice := services.GetNetworkComponentFirewallService(sess)
-	id, err := strconv.Atoi(d.Id())
-	response, err := fservice.Id(id).GetObject()
-
-	if err != nil {
-		log.Printf("error fetching the firewall resource: %s", err)
-		return false, err
-	}
-	log.Print(response)
-	return true, nil
-}
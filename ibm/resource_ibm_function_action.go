@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,12 +25,13 @@ const (
 
 func resourceIBMFunctionAction() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionActionCreate,
-		Read:     resourceIBMFunctionActionRead,
-		Update:   resourceIBMFunctionActionUpdate,
-		Delete:   resourceIBMFunctionActionDelete,
-		Exists:   resourceIBMFunctionActionExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionActionCreate,
+		ReadContext:   resourceIBMFunctionActionRead,
+		UpdateContext: resourceIBMFunctionActionUpdate,
+		DeleteContext: resourceIBMFunctionActionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMFunctionAction, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			funcActionName: {
@@ -218,20 +221,20 @@ func resourceIBMFuncActionValidator() *ResourceValidator {
 	return &ibmFuncActionResourceValidator
 }
 
-func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -241,7 +244,7 @@ func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) e
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diagFromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Action{
@@ -255,13 +258,13 @@ func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) e
 	userDefinedAnnotations := d.Get("user_defined_annotations").(string)
 	payload.Annotations, err = expandAnnotations(userDefinedAnnotations)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userDefinedParameters := d.Get("user_defined_parameters").(string)
 	payload.Parameters, err = expandParameters(userDefinedParameters)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if v, ok := d.GetOk("limits"); ok {
@@ -277,18 +280,18 @@ func resourceIBMFunctionActionCreate(d *schema.ResourceData, meta interface{}) e
 	_, _, err = actionService.Insert(&payload, true)
 
 	if err != nil {
-		return fmt.Errorf("Error creating IBM Cloud Function Action: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating IBM Cloud Function Action: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, qualifiedName.GetEntityName()))
 
-	return resourceIBMFunctionActionRead(d, meta)
+	return resourceIBMFunctionActionRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	namespace := ""
@@ -304,23 +307,27 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
 	actionService := wskClient.Actions
-	action, _, err := actionService.Get(actionID, true)
+	action, resp, err := actionService.Get(actionID, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function Action %s : %s", actionID, err)
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving IBM Cloud Function Action %s : %s", actionID, err))
 	}
 	d.Set("namespace", namespace)
 	d.Set("limits", flattenLimits(action.Limits))
@@ -330,13 +337,13 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("action_id", action.Name)
 	annotations, err := flattenAnnotations(action.Annotations)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set("annotations", annotations)
 	parameters, err := flattenParameters(action.Parameters)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set("parameters", parameters)
 
@@ -354,31 +361,31 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 
 		pkg, _, err := c.Packages.Get(pkgName)
 		if err != nil {
-			return fmt.Errorf("Error retrieving package IBM Cloud Function package %s : %s", pkgName, err)
+			return diagFromErr(fmt.Errorf("Error retrieving package IBM Cloud Function package %s : %s", pkgName, err))
 		}
 
 		userAnnotations, err := flattenAnnotations(filterInheritedAnnotations(pkg.Annotations, action.Annotations))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		d.Set("user_defined_annotations", userAnnotations)
 		userParameters, err := flattenParameters(filterInheritedParameters(pkg.Parameters, action.Parameters))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		d.Set("user_defined_parameters", userParameters)
 	} else {
 		d.Set("name", action.Name)
 		userDefinedAnnotations, err := filterActionAnnotations(action.Annotations)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		d.Set("user_defined_annotations", userDefinedAnnotations)
 
 		userDefinedParameters, err := filterActionParameters(action.Parameters)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		d.Set("user_defined_parameters", userDefinedParameters)
 	}
@@ -394,10 +401,10 @@ func resourceIBMFunctionActionRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	namespace := parts[0]
@@ -405,17 +412,17 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -424,7 +431,7 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(actionID); err != nil {
-		return NewQualifiedNameError(actionID, err)
+		return diagFromErr(NewQualifiedNameError(actionID, err))
 	}
 
 	payload := whisk.Action{
@@ -443,7 +450,7 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 		var err error
 		payload.Parameters, err = expandParameters(d.Get("user_defined_parameters").(string))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		ischanged = true
 	}
@@ -452,7 +459,7 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 		var err error
 		payload.Annotations, err = expandAnnotations(d.Get("user_defined_annotations").(string))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		ischanged = true
 	}
@@ -473,17 +480,17 @@ func resourceIBMFunctionActionUpdate(d *schema.ResourceData, meta interface{}) e
 		log.Println("[INFO] Update IBM Cloud Function Action")
 		_, _, err = actionService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("Error updating IBM Cloud Function Action: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating IBM Cloud Function Action: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionActionRead(d, meta)
+	return resourceIBMFunctionActionRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionActionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	namespace := parts[0]
@@ -491,16 +498,16 @@ func resourceIBMFunctionActionDelete(d *schema.ResourceData, meta interface{}) e
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -508,64 +515,9 @@ func resourceIBMFunctionActionDelete(d *schema.ResourceData, meta interface{}) e
 
 	_, err = actionService.Delete(actionID)
 	if err != nil {
-		return fmt.Errorf("Error deleting IBM Cloud Function Action: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting IBM Cloud Function Action: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMFunctionActionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	parts, err := cfIdParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	namespace := ""
-	actionID := ""
-	if len(parts) >= 2 {
-		namespace = parts[0]
-		actionID = parts[1]
-	} else {
-		namespace = os.Getenv("FUNCTION_NAMESPACE")
-		actionID = parts[0]
-		d.SetId(fmt.Sprintf("%s:%s", namespace, actionID))
-	}
-
-	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
-	if err != nil {
-		return false, err
-	}
-
-	bxSession, err := meta.(ClientSession).BluemixSession()
-	if err != nil {
-		return false, err
-	}
-
-	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
-	if err != nil {
-		return false, err
-
-	}
-
-	actionService := wskClient.Actions
-
-	action, resp, err := actionService.Get(actionID, true)
-	if err != nil {
-		if resp.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error communicating with IBM Cloud Function Client : %s", err)
-	}
-
-	temp := strings.Split(action.Namespace, "/")
-	var name string
-
-	if len(temp) == 2 {
-		name = fmt.Sprintf("%s/%s", temp[1], action.Name)
-	} else {
-		name = action.Name
-	}
-
-	return name == actionID, nil
-}
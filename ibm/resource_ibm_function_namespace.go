@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM-Cloud/bluemix-go/api/functions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,12 +23,13 @@ const (
 
 func resourceIBMFunctionNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionNamespaceCreate,
-		Read:     resourceIBMFunctionNamespaceRead,
-		Update:   resourceIBMFunctionNamespaceUpdate,
-		Delete:   resourceIBMFunctionNamespaceDelete,
-		Exists:   resourceIBMFunctionNamespaceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionNamespaceCreate,
+		ReadContext:   resourceIBMFunctionNamespaceRead,
+		UpdateContext: resourceIBMFunctionNamespaceUpdate,
+		DeleteContext: resourceIBMFunctionNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMFunctionNamespace, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			funcNamespaceName: {
@@ -77,10 +80,10 @@ func resourceIBMFuncNamespaceValidator() *ResourceValidator {
 	return &ibmFuncNamespaceResourceValidator
 }
 
-func resourceIBMFunctionNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	createNamespaceOptions := functions.CreateNamespaceOptions{}
@@ -99,19 +102,19 @@ func resourceIBMFunctionNamespaceCreate(d *schema.ResourceData, meta interface{}
 
 	namespace, err := functionNamespaceAPI.Namespaces().CreateNamespace(createNamespaceOptions)
 	if err != nil {
-		return fmt.Errorf("Error Creating Namespace: %s", err)
+		return diagFromErr(fmt.Errorf("Error Creating Namespace: %s", err))
 	}
 
 	d.SetId(*namespace.ID)
 	log.Printf("[INFO] Created namespace (IAM) : %s", *namespace.Name)
 
-	return resourceIBMFunctionNamespaceRead(d, meta)
+	return resourceIBMFunctionNamespaceRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
@@ -146,10 +149,10 @@ func resourceIBMFunctionNamespaceRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMFunctionNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nsClient, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -167,47 +170,26 @@ func resourceIBMFunctionNamespaceUpdate(d *schema.ResourceData, meta interface{}
 	updateNamespaceOptions.ID = &ID
 	namespace, err := nsClient.Namespaces().UpdateNamespace(updateNamespaceOptions)
 	if err != nil {
-		return fmt.Errorf("Error Updating Namespace: %s", err)
+		return diagFromErr(fmt.Errorf("Error Updating Namespace: %s", err))
 	}
 
 	log.Printf("[INFO] Updated namespace (IAM) : %s", *namespace.Name)
 
-	return resourceIBMFunctionNamespaceRead(d, meta)
+	return resourceIBMFunctionNamespaceRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nsClient, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
 	_, err = nsClient.Namespaces().DeleteNamespace(ID)
 	if err != nil {
-		return fmt.Errorf("Error Deleting Namespace: %s", err)
+		return diagFromErr(fmt.Errorf("Error Deleting Namespace: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMFunctionNamespaceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	nsClient, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
-	if err != nil {
-		return false, err
-	}
-
-	ID := d.Id()
-
-	getOptions := functions.GetNamespaceOptions{
-		ID: &ID,
-	}
-	_, err = nsClient.Namespaces().GetNamespace(getOptions)
-	if err != nil {
-		d.SetId("")
-		return false, fmt.Errorf("Error Getting Namesapce (IAM): %s", err)
-	}
-
-	return true, nil
-
-}
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"strings"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,12 +22,13 @@ const (
 
 func resourceIBMFunctionRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionRuleCreate,
-		Read:     resourceIBMFunctionRuleRead,
-		Update:   resourceIBMFunctionRuleUpdate,
-		Delete:   resourceIBMFunctionRuleDelete,
-		Exists:   resourceIBMFunctionRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionRuleCreate,
+		ReadContext:   resourceIBMFunctionRuleRead,
+		UpdateContext: resourceIBMFunctionRuleUpdate,
+		DeleteContext: resourceIBMFunctionRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMFunctionRule, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			funcRuleNamespace: {
@@ -125,20 +128,20 @@ func resourceIBMFuncRuleValidator() *ResourceValidator {
 	return &ibmFuncRuleResourceValidator
 }
 
-func resourceIBMFunctionRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -148,7 +151,7 @@ func resourceIBMFunctionRuleCreate(d *schema.ResourceData, meta interface{}) err
 
 	var qualifiedName = new(QualifiedName)
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diagFromErr(NewQualifiedNameError(name, err))
 	}
 	trigger := d.Get("trigger_name").(string)
 	action := d.Get("action_name").(string)
@@ -164,18 +167,18 @@ func resourceIBMFunctionRuleCreate(d *schema.ResourceData, meta interface{}) err
 	log.Println("[INFO] Creating IBM Cloud Function rule")
 	result, _, err := ruleService.Insert(&payload, false)
 	if err != nil {
-		return fmt.Errorf("Error creating IBM Cloud Function rule: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating IBM Cloud Function rule: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
 
-	return resourceIBMFunctionRuleRead(d, meta)
+	return resourceIBMFunctionRuleRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	namespace := ""
@@ -191,24 +194,28 @@ func resourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
 	ruleService := wskClient.Rules
-	rule, _, err := ruleService.Get(ruleID)
+	rule, resp, err := ruleService.Get(ruleID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function rule %s : %s", ruleID, err)
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving IBM Cloud Function rule %s : %s", ruleID, err))
 	}
 
 	d.Set("rule_id", rule.Name)
@@ -226,26 +233,26 @@ func resourceIBMFunctionRuleRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMFunctionRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	namespace := parts[0]
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -254,7 +261,7 @@ func resourceIBMFunctionRuleUpdate(d *schema.ResourceData, meta interface{}) err
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(d.Get("name").(string)); err != nil {
-		return NewQualifiedNameError(d.Get("name").(string), err)
+		return diagFromErr(NewQualifiedNameError(d.Get("name").(string), err))
 	}
 
 	payload := whisk.Rule{
@@ -279,38 +286,38 @@ func resourceIBMFunctionRuleUpdate(d *schema.ResourceData, meta interface{}) err
 		log.Println("[INFO] Update IBM Cloud Function Rule")
 		result, _, err := ruleService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("Error updating IBM Cloud Function Rule: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating IBM Cloud Function Rule: %s", err))
 		}
 		_, _, err = ruleService.SetState(result.Name, "active")
 		if err != nil {
-			return fmt.Errorf("Error updating IBM Cloud Function Rule: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating IBM Cloud Function Rule: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionRuleRead(d, meta)
+	return resourceIBMFunctionRuleRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	namespace := parts[0]
 	ruleID := parts[1]
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -318,54 +325,9 @@ func resourceIBMFunctionRuleDelete(d *schema.ResourceData, meta interface{}) err
 
 	_, err = ruleService.Delete(ruleID)
 	if err != nil {
-		return fmt.Errorf("Error deleting IBM Cloud Function Rule: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting IBM Cloud Function Rule: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMFunctionRuleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	parts, err := cfIdParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	namespace := ""
-	ruleID := ""
-	if len(parts) == 2 {
-		namespace = parts[0]
-		ruleID = parts[1]
-	} else {
-		namespace = os.Getenv("FUNCTION_NAMESPACE")
-		ruleID = parts[0]
-		d.SetId(fmt.Sprintf("%s:%s", namespace, ruleID))
-	}
-
-	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
-	if err != nil {
-		return false, err
-	}
-
-	bxSession, err := meta.(ClientSession).BluemixSession()
-	if err != nil {
-		return false, err
-	}
-
-	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
-	if err != nil {
-		return false, err
-
-	}
-
-	ruleService := wskClient.Rules
-
-	rule, resp, err := ruleService.Get(ruleID)
-	if err != nil {
-		if resp.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error communicating with IBM Cloud Function Client : %s", err)
-	}
-	return rule.Name == ruleID, nil
-}
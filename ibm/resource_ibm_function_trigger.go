@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,12 +31,13 @@ const (
 
 func resourceIBMFunctionTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMFunctionTriggerCreate,
-		Read:     resourceIBMFunctionTriggerRead,
-		Update:   resourceIBMFunctionTriggerUpdate,
-		Delete:   resourceIBMFunctionTriggerDelete,
-		Exists:   resourceIBMFunctionTriggerExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMFunctionTriggerCreate,
+		ReadContext:   resourceIBMFunctionTriggerRead,
+		UpdateContext: resourceIBMFunctionTriggerUpdate,
+		DeleteContext: resourceIBMFunctionTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMFunctionTrigger, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			funcTriggerNamespace: {
@@ -181,20 +184,20 @@ func resourceIBMFuncTriggerValidator() *ResourceValidator {
 	return &ibmFuncTriggerResourceValidator
 }
 
-func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	namespace := d.Get("namespace").(string)
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -206,7 +209,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diagFromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Trigger{
@@ -217,13 +220,13 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 	userDefinedAnnotations := d.Get("user_defined_annotations").(string)
 	payload.Annotations, err = expandAnnotations(userDefinedAnnotations)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userDefinedParameters := d.Get("user_defined_parameters").(string)
 	payload.Parameters, err = expandParameters(userDefinedParameters)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if v, ok := d.GetOk("feed"); ok {
@@ -241,7 +244,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 	log.Println("[INFO] Creating IBM Cloud Function trigger")
 	result, _, err := triggerService.Insert(&payload, false)
 	if err != nil {
-		return fmt.Errorf("Error creating IBM Cloud Function trigger: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating IBM Cloud Function trigger: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
@@ -253,7 +256,7 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		var err error
 		feedParameters, err := expandParameters(parameters)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		for _, value := range feedParameters {
 			feedPayload[value.Key] = value.Value
@@ -263,9 +266,9 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		if feedQualifiedName, err = NewQualifiedName(actionName); err != nil {
 			_, _, delerr := triggerService.Delete(name)
 			if delerr != nil {
-				return fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err)
+				return diagFromErr(fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err))
 			}
-			return NewQualifiedNameError(actionName, err)
+			return diagFromErr(NewQualifiedNameError(actionName, err))
 		}
 
 		feedPayload[feedLifeCycleEvent] = feedCreate
@@ -286,22 +289,22 @@ func resourceIBMFunctionTriggerCreate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			_, _, delerr := triggerService.Delete(name)
 			if delerr != nil {
-				return fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err)
+				return diagFromErr(fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err))
 			}
 			d.SetId("")
-			return fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err)
+			return diagFromErr(fmt.Errorf("Error creating IBM Cloud Function trigger with feed: %s", err))
 		}
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", namespace, result.Name))
 
-	return resourceIBMFunctionTriggerRead(d, meta)
+	return resourceIBMFunctionTriggerRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	namespace := ""
@@ -317,25 +320,29 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
 	triggerService := wskClient.Triggers
 
-	trigger, _, err := triggerService.Get(triggerID)
+	trigger, resp, err := triggerService.Get(triggerID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err)
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err))
 	}
 	d.Set("trigger_id", trigger.Name)
 	d.Set("namespace", namespace)
@@ -344,19 +351,19 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("version", trigger.Version)
 	annotations, err := flattenAnnotations(trigger.Annotations)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set("annotations", annotations)
 	parameters, err := flattenParameters(trigger.Parameters)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set("parameters", parameters)
 	d.Set("user_defined_parameters", parameters)
 
 	userDefinedAnnotations, err := filterTriggerAnnotations(trigger.Annotations)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set("user_defined_annotations", userDefinedAnnotations)
 
@@ -369,25 +376,25 @@ func resourceIBMFunctionTriggerRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	namespace := parts[0]
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -398,7 +405,7 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 
 	if qualifiedName, err = NewQualifiedName(name); err != nil {
-		return NewQualifiedNameError(name, err)
+		return diagFromErr(NewQualifiedNameError(name, err))
 	}
 
 	payload := whisk.Trigger{
@@ -411,7 +418,7 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 		var err error
 		payload.Parameters, err = expandParameters(d.Get("user_defined_parameters").(string))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		ischanged = true
 	}
@@ -420,7 +427,7 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 		var err error
 		payload.Annotations, err = expandAnnotations(d.Get("user_defined_annotations").(string))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		ischanged = true
 	}
@@ -430,34 +437,34 @@ func resourceIBMFunctionTriggerUpdate(d *schema.ResourceData, meta interface{})
 
 		_, _, err = triggerService.Insert(&payload, true)
 		if err != nil {
-			return fmt.Errorf("Error updating IBM Cloud Function Trigger: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating IBM Cloud Function Trigger: %s", err))
 		}
 	}
 
-	return resourceIBMFunctionTriggerRead(d, meta)
+	return resourceIBMFunctionTriggerRead(ctx, d, meta)
 }
 
-func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMFunctionTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	bxSession, err := meta.(ClientSession).BluemixSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := cfIdParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	namespace := parts[0]
 	triggerID := parts[1]
 
 	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 
 	}
 
@@ -465,11 +472,11 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 	var qualifiedName = new(QualifiedName)
 	fmt.Println(qualifiedName)
 	if qualifiedName, err = NewQualifiedName(triggerID); err != nil {
-		return NewQualifiedNameError(triggerID, err)
+		return diagFromErr(NewQualifiedNameError(triggerID, err))
 	}
 	trigger, _, err := triggerService.Get(triggerID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err)
+		return diagFromErr(fmt.Errorf("Error retrieving IBM Cloud Function Trigger %s : %s", triggerID, err))
 	}
 	found := trigger.Annotations.FindKeyValue("feed")
 	if found >= 0 {
@@ -477,7 +484,7 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 		var feedQualifiedName = new(QualifiedName)
 
 		if feedQualifiedName, err = NewQualifiedName(actionName); err != nil {
-			return NewQualifiedNameError(actionName, err)
+			return diagFromErr(NewQualifiedNameError(actionName, err))
 		}
 
 		feedPayload := map[string]interface{}{
@@ -498,60 +505,16 @@ func resourceIBMFunctionTriggerDelete(d *schema.ResourceData, meta interface{})
 		actionService := c.Actions
 		_, _, err = actionService.Invoke(feedQualifiedName.GetEntityName(), feedPayload, true, true)
 		if err != nil {
-			return fmt.Errorf("Error deleting IBM Cloud Function trigger with feed: %s", err)
+			return diagFromErr(fmt.Errorf("Error deleting IBM Cloud Function trigger with feed: %s", err))
 
 		}
 	}
 
 	_, _, err = triggerService.Delete(triggerID)
 	if err != nil {
-		return fmt.Errorf("Error deleting IBM Cloud Function Trigger: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting IBM Cloud Function Trigger: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMFunctionTriggerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	parts, err := cfIdParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	namespace := ""
-	triggerID := ""
-	if len(parts) == 2 {
-		namespace = parts[0]
-		triggerID = parts[1]
-	} else {
-		namespace = os.Getenv("FUNCTION_NAMESPACE")
-		triggerID = parts[0]
-		d.SetId(fmt.Sprintf("%s:%s", namespace, triggerID))
-	}
-
-	functionNamespaceAPI, err := meta.(ClientSession).FunctionIAMNamespaceAPI()
-	if err != nil {
-		return false, err
-	}
-
-	bxSession, err := meta.(ClientSession).BluemixSession()
-	if err != nil {
-		return false, err
-	}
-
-	wskClient, err := setupOpenWhiskClientConfig(namespace, bxSession.Config, functionNamespaceAPI)
-	if err != nil {
-		return false, err
-
-	}
-
-	triggerService := wskClient.Triggers
-	trigger, resp, err := triggerService.Get(triggerID)
-	if err != nil {
-		if resp.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error communicating with IBM Cloud Function Client : %s", err)
-	}
-	return trigger.Name == triggerID, nil
-}
@@ -4,23 +4,26 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iamuum/iamuumv2"
 	"github.com/IBM-Cloud/bluemix-go/models"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMAccessGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMAccessGroupCreate,
-		Read:     resourceIBMIAMAccessGroupRead,
-		Update:   resourceIBMIAMAccessGroupUpdate,
-		Delete:   resourceIBMIAMAccessGroupDelete,
-		Exists:   resourceIBMIAMAccessGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMAccessGroupCreate,
+		ReadContext:   resourceIBMIAMAccessGroupRead,
+		UpdateContext: resourceIBMIAMAccessGroupUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMAccessGroup, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -50,10 +53,10 @@ func resourceIBMIAMAccessGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAccessGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	request := models.AccessGroupV2{
@@ -68,29 +71,33 @@ func resourceIBMIAMAccessGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	agrp, err := iamuumClient.AccessGroup().Create(request, userDetails.userAccount)
 	if err != nil {
-		return fmt.Errorf("Error creating access group: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating access group: %s", err))
 	}
 
 	d.SetId(agrp.ID)
 
-	return resourceIBMIAMAccessGroupRead(d, meta)
+	return resourceIBMIAMAccessGroupRead(ctx, d, meta)
 }
 
-func resourceIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	agrpID := d.Id()
 
 	agrp, version, err := iamuumClient.AccessGroup().Get(agrpID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access group: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving access group: %s", err))
 	}
 
 	d.Set("name", agrp.Name)
@@ -100,11 +107,11 @@ func resourceIBMIAMAccessGroupRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMIAMAccessGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	agrpID := d.Id()
 
@@ -124,48 +131,28 @@ func resourceIBMIAMAccessGroupUpdate(d *schema.ResourceData, meta interface{}) e
 	if hasChange {
 		_, err = iamuumClient.AccessGroup().Update(agrpID, updateReq, d.Get("version").(string))
 		if err != nil {
-			return fmt.Errorf("Error updating access group: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating access group: %s", err))
 		}
 	}
 
-	return resourceIBMIAMAccessGroupRead(d, meta)
+	return resourceIBMIAMAccessGroupRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMAccessGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	agID := d.Id()
 
 	err = iamuumClient.AccessGroup().Delete(agID, true)
 	if err != nil {
-		return fmt.Errorf("Error deleting access group: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting access group: %s", err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMIAMAccessGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
-	if err != nil {
-		return false, err
-	}
-	agID := d.Id()
-
-	agrp, _, err := iamuumClient.AccessGroup().Get(agID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return agrp.ID == agID, nil
-}
@@ -4,23 +4,25 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iamuum/iamuumv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMDynamicRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMDynamicRuleCreate,
-		Read:     resourceIBMIAMDynamicRuleRead,
-		Update:   resourceIBMIAMDynamicRuleUpdate,
-		Delete:   resourceIBMIAMDynamicRuleDelete,
-		Exists:   resourceIBMIAMDynamicRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMDynamicRuleCreate,
+		ReadContext:   resourceIBMIAMDynamicRuleRead,
+		UpdateContext: resourceIBMIAMDynamicRuleUpdate,
+		DeleteContext: resourceIBMIAMDynamicRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMDynamicRule, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -76,10 +78,10 @@ func resourceIBMIAMDynamicRule() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMDynamicRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	grpID := d.Get("access_group_id").(string)
@@ -111,23 +113,23 @@ func resourceIBMIAMDynamicRuleCreate(d *schema.ResourceData, meta interface{}) e
 
 	response, err := iamuumClient.DynamicRule().Create(grpID, createRuleReq)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	ruleID := response.RuleID
 	d.SetId(fmt.Sprintf("%s/%s", grpID, ruleID))
 
-	return resourceIBMIAMDynamicRuleRead(d, meta)
+	return resourceIBMIAMDynamicRuleRead(ctx, d, meta)
 }
 
-func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	grpID := parts[0]
@@ -135,7 +137,7 @@ func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) err
 
 	rules, _, err := iamuumClient.DynamicRule().Get(grpID, ruleID)
 	if err != nil && !strings.Contains(err.Error(), "404") {
-		return fmt.Errorf("Error retrieving access group Rules: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving access group Rules: %s", err))
 	} else if err != nil && strings.Contains(err.Error(), "404") {
 		d.SetId("")
 
@@ -152,22 +154,22 @@ func resourceIBMIAMDynamicRuleRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMIAMDynamicRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	grpID := parts[0]
 	ruleID := parts[1]
 	_, etag, err := iamuumClient.DynamicRule().Get(grpID, ruleID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access group Rules: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving access group Rules: %s", err))
 	}
 
 	name := d.Get("name").(string)
@@ -197,22 +199,22 @@ func resourceIBMIAMDynamicRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 	_, err = iamuumClient.DynamicRule().Replace(grpID, ruleID, createRuleReq, etag)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMIAMDynamicRuleRead(d, meta)
+	return resourceIBMIAMDynamicRuleRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMDynamicRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMDynamicRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	grpID := parts[0]
@@ -220,36 +222,10 @@ func resourceIBMIAMDynamicRuleDelete(d *schema.ResourceData, meta interface{}) e
 
 	err = iamuumClient.DynamicRule().Delete(grpID, ruleID)
 	if err != nil && !strings.Contains(err.Error(), "404") {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMIAMDynamicRuleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
-	if err != nil {
-		return false, err
-	}
-
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	grpID := parts[0]
-	ruleID := parts[1]
-
-	rules, _, err := iamuumClient.DynamicRule().Get(grpID, ruleID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return rules.AccessGroupID == grpID, nil
-}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,16 +12,19 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/crn"
 	"github.com/IBM-Cloud/bluemix-go/models"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMAccessGroupMembers() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMAccessGroupMembersCreate,
-		Read:     resourceIBMIAMAccessGroupMembersRead,
-		Update:   resourceIBMIAMAccessGroupMembersUpdate,
-		Delete:   resourceIBMIAMAccessGroupMembersDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMAccessGroupMembersCreate,
+		ReadContext:   resourceIBMIAMAccessGroupMembersRead,
+		UpdateContext: resourceIBMIAMAccessGroupMembersUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupMembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMAccessGroupMembers, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -61,17 +65,17 @@ func resourceIBMIAMAccessGroupMembers() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAccessGroupMembersCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	grpID := d.Get("access_group_id").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	accountID := userDetails.userAccount
@@ -82,72 +86,72 @@ func resourceIBMIAMAccessGroupMembersCreate(d *schema.ResourceData, meta interfa
 	services := expandStringList(d.Get("iam_service_ids").(*schema.Set).List())
 
 	if len(users) == 0 && len(services) == 0 {
-		return fmt.Errorf("Provide either `ibm_ids` or `iam_service_ids`")
+		return diagFromErr(fmt.Errorf("Provide either `ibm_ids` or `iam_service_ids`"))
 
 	}
 
 	userids, err = flattenUserIds(accountID, users, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceids, err = flattenServiceIds(services, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	request := prepareMemberAddRequest(userids, serviceids)
 
 	_, err = iamuumClient.AccessGroupMember().Add(grpID, request)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", grpID, time.Now().UTC().String()))
 
-	return resourceIBMIAMAccessGroupMembersRead(d, meta)
+	return resourceIBMIAMAccessGroupMembersRead(ctx, d, meta)
 }
 
-func resourceIBMIAMAccessGroupMembersRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	grpID := parts[0]
 
 	members, err := iamuumClient.AccessGroupMember().List(grpID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access group members: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving access group members: %s", err))
 	}
 
 	d.Set("access_group_id", grpID)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	accountID := userDetails.userAccount
 
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	client := userManagement.UserInvite()
 	res, err := client.ListUsers(accountID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	boundTo := crn.New(userDetails.cloudName, userDetails.cloudType)
@@ -156,7 +160,7 @@ func resourceIBMIAMAccessGroupMembersRead(d *schema.ResourceData, meta interface
 
 	serviceIDs, err := iamClient.ServiceIds().List(boundTo.String())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set("members", flattenAccessGroupMembers(members, res, serviceIDs))
@@ -164,23 +168,23 @@ func resourceIBMIAMAccessGroupMembersRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceIBMIAMAccessGroupMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	grpID := parts[0]
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	accountID := userDetails.userAccount
@@ -204,34 +208,34 @@ func resourceIBMIAMAccessGroupMembersUpdate(d *schema.ResourceData, meta interfa
 		var userids, serviceids []string
 		userids, err = flattenUserIds(accountID, addUsers, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		serviceids, err = flattenServiceIds(addServiceids, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		request := prepareMemberAddRequest(userids, serviceids)
 
 		_, err = iamuumClient.AccessGroupMember().Add(grpID, request)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 	}
 	if len(removeUsers) > 0 || len(removeServiceids) > 0 && !d.IsNewResource() {
 		iamClient, err := meta.(ClientSession).IAMAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		for _, u := range removeUsers {
 			ibmUniqueId, err := getIBMUniqueId(accountID, u, meta)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			err = iamuumClient.AccessGroupMember().Remove(grpID, ibmUniqueId)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 
 		}
@@ -239,36 +243,36 @@ func resourceIBMIAMAccessGroupMembersUpdate(d *schema.ResourceData, meta interfa
 		for _, s := range removeServiceids {
 			serviceID, err := iamClient.ServiceIds().Get(s)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			err = iamuumClient.AccessGroupMember().Remove(grpID, serviceID.IAMID)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 
 		}
 	}
 
-	return resourceIBMIAMAccessGroupMembersRead(d, meta)
+	return resourceIBMIAMAccessGroupMembersRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMAccessGroupMembersDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	grpID := parts[0]
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	users := expandStringList(d.Get("ibm_ids").(*schema.Set).List())
@@ -277,11 +281,11 @@ func resourceIBMIAMAccessGroupMembersDelete(d *schema.ResourceData, meta interfa
 
 		ibmUniqueID, err := getIBMUniqueId(userDetails.userAccount, name, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		err = iamuumClient.AccessGroupMember().Remove(grpID, ibmUniqueID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 	}
@@ -291,11 +295,11 @@ func resourceIBMIAMAccessGroupMembersDelete(d *schema.ResourceData, meta interfa
 	for _, id := range services {
 		serviceID, err := getServiceID(id, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		err = iamuumClient.AccessGroupMember().Remove(grpID, serviceID.IAMID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
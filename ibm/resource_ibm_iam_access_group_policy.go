@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
@@ -17,12 +19,13 @@ import (
 
 func resourceIBMIAMAccessGroupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMAccessGroupPolicyCreate,
-		Read:     resourceIBMIAMAccessGroupPolicyRead,
-		Update:   resourceIBMIAMAccessGroupPolicyUpdate,
-		Delete:   resourceIBMIAMAccessGroupPolicyDelete,
-		Exists:   resourceIBMIAMAccessGroupPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMAccessGroupPolicyCreate,
+		ReadContext:   resourceIBMIAMAccessGroupPolicyRead,
+		UpdateContext: resourceIBMIAMAccessGroupPolicyUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMAccessGroupPolicy, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -116,24 +119,24 @@ func resourceIBMIAMAccessGroupPolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	accessgrpID := d.Get("access_group_id").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var policy iampapv1.Policy
 
 	policy, err = generateAccountPolicyV2(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	policy.Subjects = []iampapv1.Subject{
@@ -154,35 +157,38 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	accgrpPolicy, err := iampapClient.V1Policy().Create(policy)
 
 	if err != nil {
-		return fmt.Errorf("Error creating access group policy: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating access group policy: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", accessgrpID, accgrpPolicy.ID))
 
-	return resourceIBMIAMAccessGroupPolicyRead(d, meta)
+	return resourceIBMIAMAccessGroupPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMIAMAccessGroupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	accessgrpID := parts[0]
 	accgrpPolicyID := parts[1]
 
 	accgrpPolicy, err := iampapClient.V1Policy().Get(accgrpPolicyID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving access group policy: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving access group policy: %s", err))
 	}
 
 	if accessgrpID != accgrpPolicy.Subjects[0].GetAttribute("access_group_id") {
-		return fmt.Errorf("Policy %s does not belong to access group %s", accgrpPolicyID, accessgrpID)
+		return diagFromErr(fmt.Errorf("Policy %s does not belong to access group %s", accgrpPolicyID, accessgrpID))
 	}
 
 	d.Set("access_group_id", accessgrpID)
@@ -205,30 +211,30 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("account_management") {
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		accessgrpID := parts[0]
 		accgrpPolicyID := parts[1]
 
 		userDetails, err := meta.(ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		var policy iampapv1.Policy
 
 		policy, err = generateAccountPolicyV2(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		policy.Subjects = []iampapv1.Subject{
@@ -248,31 +254,31 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 
 		_, err = iampapClient.V1Policy().Update(accgrpPolicyID, policy, d.Get("version").(string))
 		if err != nil {
-			return fmt.Errorf("Error updating access group policy: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating access group policy: %s", err))
 		}
 
 	}
 
-	return resourceIBMIAMAccessGroupPolicyRead(d, meta)
+	return resourceIBMIAMAccessGroupPolicyRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAccessGroupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	accgrpPolicyID := parts[1]
 
 	err = iampapClient.V1Policy().Delete(accgrpPolicyID)
 	if err != nil {
-		return fmt.Errorf("Error deleting access group policy: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting access group policy: %s", err))
 	}
 
 	d.SetId("")
@@ -280,33 +286,6 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	accgrpPolicyID := parts[1]
-
-	accgrpPolicy, err := iampapClient.V1Policy().Get(accgrpPolicyID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	tempID := fmt.Sprintf("%s/%s", accgrpPolicy.Subjects[0].GetAttribute("access_group_id"), accgrpPolicy.ID)
-
-	return tempID == d.Id(), nil
-}
-
 func generateAccountPolicy(d *schema.ResourceData, meta interface{}) (iampapv1.Policy, error) {
 
 	var serviceName string
@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMAccountSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMAccountSettingsCreate,
+		ReadContext:   resourceIBMIAMAccountSettingsRead,
+		UpdateContext: resourceIBMIAMAccountSettingsUpdate,
+		DeleteContext: resourceIBMIAMAccountSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMAccountSettings, nil),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"restrict_create_service_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_account_settings", "restrict_create_service_id"),
+				Description:  "Whether creating service IDs is restricted, RESTRICTED, NOT_RESTRICTED or NOT_SET",
+			},
+
+			"restrict_create_platform_apikey": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_account_settings", "restrict_create_platform_apikey"),
+				Description:  "Whether creating platform API keys is restricted, RESTRICTED, NOT_RESTRICTED or NOT_SET",
+			},
+
+			"allowed_ip_addresses": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of the IPv4 or IPv6 addresses and subnets allowed to access the account, the current list is kept when not set",
+			},
+
+			"mfa": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_account_settings", "mfa"),
+				Description:  "The multi-factor authentication level, NONE, TOTP, TOTP4ALL, LEVEL1, LEVEL2 or LEVEL3",
+			},
+
+			"session_expiration_in_seconds": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_account_settings", "session_expiration_in_seconds"),
+				Description:  "The number of seconds after which the sessions expire, from 900 to 86400 or NOT_SET",
+			},
+
+			"session_invalidation_in_seconds": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_account_settings", "session_invalidation_in_seconds"),
+				Description:  "The number of seconds of inactivity after which the sessions are invalidated, from 900 to 7200 or NOT_SET",
+			},
+
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the account",
+			},
+
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the account settings, the updates fail when the settings changed since the last refresh",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMAccountSettingsValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "restrict_create_service_id",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "RESTRICTED, NOT_RESTRICTED, NOT_SET"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "restrict_create_platform_apikey",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "RESTRICTED, NOT_RESTRICTED, NOT_SET"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "mfa",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "NONE, TOTP, TOTP4ALL, LEVEL1, LEVEL2, LEVEL3"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "session_expiration_in_seconds",
+			ValidateFunctionIdentifier: ValidateStringIntBetween,
+			Type:                       TypeString,
+			Optional:                   true,
+			MinValue:                   "900",
+			MaxValue:                   "86400",
+			AllowedValues:              "NOT_SET"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "session_invalidation_in_seconds",
+			ValidateFunctionIdentifier: ValidateStringIntBetween,
+			Type:                       TypeString,
+			Optional:                   true,
+			MinValue:                   "900",
+			MaxValue:                   "7200",
+			AllowedValues:              "NOT_SET"})
+
+	ibmIAMAccountSettingsResourceValidator := ResourceValidator{ResourceName: "ibm_iam_account_settings", Schema: validateSchema}
+	return &ibmIAMAccountSettingsResourceValidator
+}
+
+// expandIAMAccountSettings builds the settings of the configuration, the
+// settings that are not set and didn't change keep their current value
+func expandIAMAccountSettings(d *schema.ResourceData) iamAccountSettings {
+	settings := iamAccountSettings{}
+	if v, ok := d.GetOk("restrict_create_service_id"); ok {
+		settings.RestrictCreateServiceID = v.(string)
+	}
+	if v, ok := d.GetOk("restrict_create_platform_apikey"); ok {
+		settings.RestrictCreatePlatformAPIKey = v.(string)
+	}
+	if v, ok := d.GetOk("mfa"); ok {
+		settings.MFA = v.(string)
+	}
+	if v, ok := d.GetOk("session_expiration_in_seconds"); ok {
+		settings.SessionExpirationInSeconds = v.(string)
+	}
+	if v, ok := d.GetOk("session_invalidation_in_seconds"); ok {
+		settings.SessionInvalidationInSeconds = v.(string)
+	}
+	if _, ok := d.GetOk("allowed_ip_addresses"); ok || d.HasChange("allowed_ip_addresses") {
+		ips := make([]string, 0)
+		for _, ip := range d.Get("allowed_ip_addresses").([]interface{}) {
+			ips = append(ips, ip.(string))
+		}
+		allowedIPAddresses := strings.Join(ips, ",")
+		settings.AllowedIPAddresses = &allowedIPAddresses
+	}
+	return settings
+}
+
+func flattenIAMAccountSettingsAllowedIPAddresses(allowedIPAddresses string) []string {
+	ips := make([]string, 0)
+	for _, ip := range strings.Split(allowedIPAddresses, ",") {
+		if ip = strings.TrimSpace(ip); ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+func resourceIBMIAMAccountSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	accountID, err := getUserAccountID(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// The settings always exist, they are taken over from their current version
+	current, response, err := getIAMAccountSettings(ctx, iamIdentityClient, accountID)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error retrieving settings of account %s: %s\n%s", accountID, err, response))
+	}
+	_, response, err = updateIAMAccountSettings(ctx, iamIdentityClient, accountID, current.EntityTag, expandIAMAccountSettings(d))
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error updating settings of account %s: %s\n%s", accountID, err, response))
+	}
+
+	d.SetId(accountID)
+
+	return resourceIBMIAMAccountSettingsRead(ctx, d, meta)
+}
+
+func resourceIBMIAMAccountSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	settings, response, err := getIAMAccountSettings(ctx, iamIdentityClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving settings of account %s: %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set("account_id", d.Id())
+	d.Set("restrict_create_service_id", settings.RestrictCreateServiceID)
+	d.Set("restrict_create_platform_apikey", settings.RestrictCreatePlatformAPIKey)
+	allowedIPAddresses := ""
+	if settings.AllowedIPAddresses != nil {
+		allowedIPAddresses = *settings.AllowedIPAddresses
+	}
+	d.Set("allowed_ip_addresses", flattenIAMAccountSettingsAllowedIPAddresses(allowedIPAddresses))
+	d.Set("mfa", settings.MFA)
+	d.Set("session_expiration_in_seconds", settings.SessionExpirationInSeconds)
+	d.Set("session_invalidation_in_seconds", settings.SessionInvalidationInSeconds)
+	d.Set("entity_tag", settings.EntityTag)
+
+	return nil
+}
+
+func resourceIBMIAMAccountSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("restrict_create_service_id") || d.HasChange("restrict_create_platform_apikey") || d.HasChange("allowed_ip_addresses") ||
+		d.HasChange("mfa") || d.HasChange("session_expiration_in_seconds") || d.HasChange("session_invalidation_in_seconds") {
+		iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		// The entity tag of the state makes the update fail when the settings
+		// were changed outside of the configuration since the last refresh
+		_, response, err := updateIAMAccountSettings(ctx, iamIdentityClient, d.Id(), d.Get("entity_tag").(string), expandIAMAccountSettings(d))
+		if err != nil {
+			if response != nil && response.StatusCode == 409 {
+				return diagFromErr(fmt.Errorf("Error updating settings of account %s, they were changed since the last refresh: %s", d.Id(), err))
+			}
+			return diagFromErr(fmt.Errorf("Error updating settings of account %s: %s\n%s", d.Id(), err, response))
+		}
+	}
+
+	return resourceIBMIAMAccountSettingsRead(ctx, d, meta)
+}
+
+func resourceIBMIAMAccountSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The settings can't be deleted, they are only removed from the state
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMAccountSettings_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIAMAccountSettingsBasic("TOTP", "3600"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.settings", "mfa", "TOTP"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.settings", "session_expiration_in_seconds", "3600"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.settings", "restrict_create_platform_apikey", "NOT_RESTRICTED"),
+					resource.TestCheckResourceAttrSet("ibm_iam_account_settings.settings", "entity_tag"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMIAMAccountSettingsBasic("NONE", "NOT_SET"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.settings", "mfa", "NONE"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.settings", "session_expiration_in_seconds", "NOT_SET"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_iam_account_settings.settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccountSettingsBasic(mfa, sessionExpiration string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_account_settings" "settings" {
+			mfa                             = "%s"
+			session_expiration_in_seconds   = "%s"
+			restrict_create_platform_apikey = "NOT_RESTRICTED"
+		}
+	`, mfa, sessionExpiration)
+}
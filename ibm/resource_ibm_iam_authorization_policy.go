@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -11,17 +12,19 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/models"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMAuthorizationPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMAuthorizationPolicyCreate,
-		Read:     resourceIBMIAMAuthorizationPolicyRead,
-		Update:   resourceIBMIAMAuthorizationPolicyUpdate,
-		Delete:   resourceIBMIAMAuthorizationPolicyDelete,
-		Exists:   resourceIBMIAMAuthorizationPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMAuthorizationPolicyCreate,
+		ReadContext:   resourceIBMIAMAuthorizationPolicyRead,
+		UpdateContext: resourceIBMIAMAuthorizationPolicyUpdate,
+		DeleteContext: resourceIBMIAMAuthorizationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMAuthorizationPolicy, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"source_service_name": {
@@ -103,18 +106,18 @@ func resourceIBMIAMAuthorizationPolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sourceServiceName := d.Get("source_service_name").(string)
 	targetServiceName := d.Get("target_service_name").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	policy := iampapv1.Policy{
@@ -183,7 +186,7 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 
 	roles, err := getAuthorizationRolesByName(expandStringList(d.Get("roles").([]interface{})), sourceServiceName, targetServiceName, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	policy.Roles = iampapv1.ConvertRoleModels(roles)
@@ -191,24 +194,27 @@ func resourceIBMIAMAuthorizationPolicyCreate(d *schema.ResourceData, meta interf
 	authPolicy, err := iampapClient.V1Policy().Create(policy)
 
 	if err != nil {
-		return fmt.Errorf("Error creating authorization policy: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating authorization policy: %s", err))
 	}
 
 	d.SetId(authPolicy.ID)
 
-	return resourceIBMIAMAuthorizationPolicyRead(d, meta)
+	return resourceIBMIAMAuthorizationPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMIAMAuthorizationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	authorizationPolicy, err := iampapClient.V1Policy().Get(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error retrieving authorizationPolicy: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving authorizationPolicy: %s", err))
 	}
 	roles := make([]string, len(authorizationPolicy.Roles))
 	for i, role := range authorizationPolicy.Roles {
@@ -230,14 +236,14 @@ func resourceIBMIAMAuthorizationPolicyRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceIBMIAMAuthorizationPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMIAMAuthorizationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	authorizationPolicyID := d.Id()
@@ -253,25 +259,6 @@ func resourceIBMIAMAuthorizationPolicyDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceIBMIAMAuthorizationPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
-	if err != nil {
-		return false, err
-	}
-
-	authorizationPolicy, err := iampapClient.V1Policy().Get(d.Id())
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return authorizationPolicy.ID == d.Id(), nil
-}
-
 func getAuthorizationRolesByName(roleNames []string, sourceServiceName string, targetServiceName string, meta interface{}) ([]models.PolicyRole, error) {
 
 	iamClient, err := meta.(ClientSession).IAMAPI()
@@ -4,18 +4,21 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMAuthorizationPolicyDetach() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMIAMAuthorizationPolicyDetachCreate,
-		Read:   resourceIBMIAMAuthorizationPolicyDetachRead,
-		Delete: resourceIBMIAMAuthorizationPolicyDetachDelete,
-		Exists: resourceIBMIAMAuthorizationPolicyDetachExists,
+		CreateContext: resourceIBMIAMAuthorizationPolicyDetachCreate,
+		ReadContext:   resourceIBMIAMAuthorizationPolicyDetachRead,
+		DeleteContext: resourceIBMIAMAuthorizationPolicyDetachDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMAuthorizationPolicyDetach, importIDParts("authorization_policy_id")),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"authorization_policy_id": {
@@ -28,36 +31,29 @@ func resourceIBMIAMAuthorizationPolicyDetach() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMAuthorizationPolicyDetachCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDetachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	policyID := d.Get("authorization_policy_id").(string)
 	err = iampapClient.V1Policy().Delete(policyID)
 	if err != nil {
-		return fmt.Errorf("Error detaching authorization policy: %s", err)
+		return diagFromErr(fmt.Errorf("Error detaching authorization policy: %s", err))
 	}
 
-	d.SetId(time.Now().UTC().String())
+	d.SetId(policyID)
 
-	return resourceIBMIAMAuthorizationPolicyDetachRead(d, meta)
+	return resourceIBMIAMAuthorizationPolicyDetachRead(ctx, d, meta)
 }
 
-func resourceIBMIAMAuthorizationPolicyDetachRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDetachRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMIAMAuthorizationPolicyDetachDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMAuthorizationPolicyDetachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMIAMAuthorizationPolicyDetachExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	if d.Id() == "" {
-		return false, nil
-	}
-	return true, nil
-}
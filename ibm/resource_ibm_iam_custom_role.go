@@ -4,12 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv2"
-	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,12 +24,13 @@ const (
 
 func resourceIBMIAMCustomRole() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMCustomRoleCreate,
-		Read:     resourceIBMIAMCustomRoleRead,
-		Update:   resourceIBMIAMCustomRoleUpdate,
-		Delete:   resourceIBMIAMCustomRoleDelete,
-		Exists:   resourceIBMIAMCustomRoleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMCustomRoleCreate,
+		ReadContext:   resourceIBMIAMCustomRoleRead,
+		UpdateContext: resourceIBMIAMCustomRoleUpdate,
+		DeleteContext: resourceIBMIAMCustomRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMCustomRole, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			iamCRDisplayName: {
@@ -122,10 +124,10 @@ func resourceIBMIAMCustomRoleValidator() *ResourceValidator {
 	return &ibmIAMCustomRoleResourceValidator
 }
 
-func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapv2Client, err := meta.(ClientSession).IAMPAPAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	displayName := d.Get(iamCRDisplayName).(string)
@@ -136,7 +138,7 @@ func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) er
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	createRoleReq := iampapv2.CreateRoleRequest{
@@ -150,25 +152,25 @@ func resourceIBMIAMCustomRoleCreate(d *schema.ResourceData, meta interface{}) er
 
 	response, err := iampapv2Client.IAMRoles().Create(createRoleReq)
 	if err != nil {
-		return fmt.Errorf("Error creating Custom Roles: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Custom Roles: %s", err))
 	}
 
 	d.SetId(response.ID)
 
-	return resourceIBMIAMCustomRoleRead(d, meta)
+	return resourceIBMIAMCustomRoleRead(ctx, d, meta)
 }
 
-func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapv2Client, err := meta.(ClientSession).IAMPAPAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	roleID := d.Id()
 
 	role, _, err := iampapv2Client.IAMRoles().Get(roleID)
 	if err != nil && !strings.Contains(err.Error(), "404") {
-		return fmt.Errorf("Error retrieving Custom Roles: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Custom Roles: %s", err))
 	} else if err != nil && strings.Contains(err.Error(), "404") {
 		d.SetId("")
 
@@ -186,7 +188,7 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set(ResourceCRN, role.Crn)
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set(ResourceControllerURL, rcontroller+"/iam/roles")
@@ -194,11 +196,11 @@ func resourceIBMIAMCustomRoleRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iampapv2Client, err := meta.(ClientSession).IAMPAPAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	roleID := d.Id()
 
@@ -211,52 +213,32 @@ func resourceIBMIAMCustomRoleUpdate(d *schema.ResourceData, meta interface{}) er
 	if d.HasChange("display_name") || d.HasChange("desciption") || d.HasChange("actions") {
 		_, etag, err := iampapv2Client.IAMRoles().Get(roleID)
 		if err != nil {
-			return fmt.Errorf("Error retrieving Custom Role: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving Custom Role: %s", err))
 		}
 
 		_, err = iampapv2Client.IAMRoles().Update(updateReq, roleID, etag)
 		if err != nil {
-			return fmt.Errorf("Error updating Custom Role: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating Custom Role: %s", err))
 		}
 	}
 
-	return resourceIBMIAMCustomRoleRead(d, meta)
+	return resourceIBMIAMCustomRoleRead(ctx, d, meta)
 }
 
-func resourceIBMIAMCustomRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMCustomRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapv2Client, err := meta.(ClientSession).IAMPAPAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	roleID := d.Id()
 
 	err = iampapv2Client.IAMRoles().Delete(roleID)
 	if err != nil && !strings.Contains(err.Error(), "404") {
-		return fmt.Errorf("Error deleting Custom Roles: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Custom Roles: %s", err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMIAMCustomRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iampapv2Client, err := meta.(ClientSession).IAMPAPAPIV2()
-	if err != nil {
-		return false, err
-	}
-	roleID := d.Id()
-
-	role, _, err := iampapv2Client.IAMRoles().Get(roleID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return role.ID == roleID, nil
-}
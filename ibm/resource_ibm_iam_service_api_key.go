@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -11,18 +12,20 @@ import (
 	"strconv"
 
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
 
 func resourceIBMIAMServiceAPIKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMServiceAPIkeyCreate,
-		Read:     resourceIBMIAMServiceAPIKeyRead,
-		Update:   resourceIBMIAMServiceAPIKeyUpdate,
-		Delete:   resourceIBMIAMServiceAPIKeyDelete,
-		Exists:   resourceIBMIAMServiceAPIKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMServiceAPIkeyCreate,
+		ReadContext:   resourceIBMIAMServiceAPIKeyRead,
+		UpdateContext: resourceIBMIAMServiceAPIKeyUpdate,
+		DeleteContext: resourceIBMIAMServiceAPIKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMServiceAPIKey, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -122,10 +125,10 @@ type APIKey struct {
 	Locked      bool
 }
 
-func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIkeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get("name").(string)
@@ -143,7 +146,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	createAPIKeyOptions.AccountID = &userDetails.userAccount
 
@@ -164,7 +167,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 
 	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
 	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response))
 	}
 
 	d.SetId(*apiKey.ID)
@@ -176,13 +179,13 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+	return resourceIBMIAMServiceAPIKeyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	apiKeyID := d.Id()
 
@@ -196,7 +199,7 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response))
 	}
 	if apiKey.Name != nil {
 		d.Set("name", *apiKey.Name)
@@ -235,11 +238,11 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	apiKeyID := d.Id()
 
@@ -249,7 +252,7 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 
 	apiKey, resp, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
 	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, resp)
+		return diagFromErr(fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, resp))
 	}
 
 	updateAPIKeyOptions := &iamidentityv1.UpdateAPIKeyOptions{
@@ -273,18 +276,18 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if hasChange {
 		_, response, err := iamIdentityClient.UpdateAPIKey(updateAPIKeyOptions)
 		if err != nil {
-			return fmt.Errorf("[DEBUG] Error updating Service API Key: %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("[DEBUG] Error updating Service API Key: %s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+	return resourceIBMIAMServiceAPIKeyRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceAPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	apiKeyID := d.Id()
 
@@ -298,7 +301,7 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response))
 	}
 
 	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
@@ -307,34 +310,13 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 
 	resp, err := iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
 	if err != nil {
-		return fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp)
+		return diagFromErr(fmt.Errorf("[DEBUG] Error deleting Service API Key: %s\n%s", err, resp))
 	}
 	d.SetId("")
 
 	return nil
 }
 
-func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
-	if err != nil {
-		return false, err
-	}
-	apiKeyID := d.Id()
-
-	getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
-		ID: &apiKeyID,
-	}
-
-	apiKey, response, err := iamIdentityClient.GetAPIKey(getAPIKeyOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error retrieving Service API Key: %s\n%s", err, response)
-	}
-	return *apiKey.ID == apiKeyID, nil
-}
-
 func saveToFile(apiKey *iamidentityv1.APIKey, filePath string) error {
 	outputFilePath, err := homedir.Expand(filePath)
 	if err != nil {
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -11,17 +12,19 @@ import (
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/crn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMIAMServiceID() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMServiceIDCreate,
-		Read:     resourceIBMIAMServiceIDRead,
-		Update:   resourceIBMIAMServiceIDUpdate,
-		Delete:   resourceIBMIAMServiceIDDelete,
-		Exists:   resourceIBMIAMServiceIDExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMServiceIDCreate,
+		ReadContext:   resourceIBMIAMServiceIDRead,
+		UpdateContext: resourceIBMIAMServiceIDUpdate,
+		DeleteContext: resourceIBMIAMServiceIDDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMServiceID, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -64,10 +67,10 @@ func resourceIBMIAMServiceID() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMServiceIDCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceIDCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get("name").(string)
 
@@ -88,24 +91,28 @@ func resourceIBMIAMServiceIDCreate(d *schema.ResourceData, meta interface{}) err
 
 	serviceID, err := iamClient.ServiceIds().Create(request)
 	if err != nil {
-		return fmt.Errorf("Error creating serviceID: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating serviceID: %s", err))
 	}
 
 	d.SetId(serviceID.UUID)
 
-	return resourceIBMIAMServiceIDRead(d, meta)
+	return resourceIBMIAMServiceIDRead(ctx, d, meta)
 }
 
-func resourceIBMIAMServiceIDRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceIDRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	serviceIDUUID := d.Id()
 
 	serviceID, err := iamClient.ServiceIds().Get(serviceIDUUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving serviceID: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving serviceID: %s", err))
 	}
 
 	d.Set("name", serviceID.Name)
@@ -117,11 +124,11 @@ func resourceIBMIAMServiceIDRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMIAMServiceIDUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceIDUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	serviceIDUUID := d.Id()
 
@@ -141,25 +148,25 @@ func resourceIBMIAMServiceIDUpdate(d *schema.ResourceData, meta interface{}) err
 	if hasChange {
 		_, err = iamClient.ServiceIds().Update(serviceIDUUID, updateReq, "*")
 		if err != nil {
-			return fmt.Errorf("Error updating serviceID: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating serviceID: %s", err))
 		}
 	}
 
-	return resourceIBMIAMServiceIDRead(d, meta)
+	return resourceIBMIAMServiceIDRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMServiceIDDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServiceIDDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceIDUUID := d.Id()
 
 	err = iamClient.ServiceIds().Delete(serviceIDUUID)
 	if err != nil {
-		return fmt.Errorf("Error deleting serviceID: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting serviceID: %s", err))
 	}
 
 	d.SetId("")
@@ -167,26 +174,6 @@ func resourceIBMIAMServiceIDDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMIAMServiceIDExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iamClient, err := meta.(ClientSession).IAMAPI()
-	if err != nil {
-		return false, err
-	}
-	serviceIDUUID := d.Id()
-
-	serviceID, err := iamClient.ServiceIds().Get(serviceIDUUID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return serviceID.UUID == serviceIDUUID, nil
-}
-
 func CloudName(region models.Region) string {
 	regionID := region.ID
 	if regionID == "" {
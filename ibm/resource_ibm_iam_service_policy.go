@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
@@ -15,12 +17,13 @@ import (
 
 func resourceIBMIAMServicePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMServicePolicyCreate,
-		Read:     resourceIBMIAMServicePolicyRead,
-		Update:   resourceIBMIAMServicePolicyUpdate,
-		Delete:   resourceIBMIAMServicePolicyDelete,
-		Exists:   resourceIBMIAMServicePolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMServicePolicyCreate,
+		ReadContext:   resourceIBMIAMServicePolicyRead,
+		UpdateContext: resourceIBMIAMServicePolicyUpdate,
+		DeleteContext: resourceIBMIAMServicePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMServicePolicy, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"iam_service_id": {
@@ -114,33 +117,33 @@ func resourceIBMIAMServicePolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamClient, err := meta.(ClientSession).IAMAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	serviceIDUUID := d.Get("iam_service_id").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var policy iampapv1.Policy
 
 	policy, err = generateAccountPolicyV2(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceID, err := iamClient.ServiceIds().Get(serviceIDUUID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	policy.Resources[0].SetAccountID(userDetails.userAccount)
@@ -161,31 +164,34 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 	servicePolicy, err := iampapClient.V1Policy().Create(policy)
 
 	if err != nil {
-		return fmt.Errorf("Error creating servicePolicy: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating servicePolicy: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", serviceIDUUID, servicePolicy.ID))
 
-	return resourceIBMIAMServicePolicyRead(d, meta)
+	return resourceIBMIAMServicePolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMIAMServicePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	serviceIDUUID := parts[0]
 	servicePolicyID := parts[1]
 
 	servicePolicy, err := iampapClient.V1Policy().Get(servicePolicyID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving servicePolicy: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving servicePolicy: %s", err))
 	}
 
 	d.Set("iam_service_id", serviceIDUUID)
@@ -208,36 +214,36 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("account_management") {
 
 		iamClient, err := meta.(ClientSession).IAMAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		serviceIDUUID := parts[0]
 		servicePolicyID := parts[1]
 
 		userDetails, err := meta.(ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		serviceID, err := iamClient.ServiceIds().Get(serviceIDUUID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		var policy iampapv1.Policy
 
 		policy, err = generateAccountPolicyV2(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		policy.Resources[0].SetAccountID(userDetails.userAccount)
@@ -257,35 +263,35 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 
 		iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		_, err = iampapClient.V1Policy().Update(servicePolicyID, policy, d.Get("version").(string))
 		if err != nil {
-			return fmt.Errorf("Error updating service policy: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating service policy: %s", err))
 		}
 
 	}
 
-	return resourceIBMIAMServicePolicyRead(d, meta)
+	return resourceIBMIAMServicePolicyRead(ctx, d, meta)
 
 }
 
-func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMServicePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	servicePolicyID := parts[1]
 
 	err = iampapClient.V1Policy().Delete(servicePolicyID)
 	if err != nil {
-		return fmt.Errorf("Error deleting service policy: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting service policy: %s", err))
 	}
 
 	d.SetId("")
@@ -293,33 +299,6 @@ func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	serviceIDUUID := parts[0]
-	servicePolicyID := parts[1]
-
-	servicePolicy, err := iampapClient.V1Policy().Get(servicePolicyID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	tempID := fmt.Sprintf("%s/%s", serviceIDUUID, servicePolicy.ID)
-
-	return tempID == d.Id(), nil
-}
-
 // func generatePolicy(d *schema.ResourceData, meta interface{}, accountID string) (models.Policy, error) {
 
 // 	policyResources := []models.PolicyResource{}
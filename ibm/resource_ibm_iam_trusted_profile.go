@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileRead,
+		UpdateContext: resourceIBMIAMTrustedProfileUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMTrustedProfile, nil),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile", "name"),
+				Description:  "Name of the trusted profile",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the trusted profile",
+			},
+
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The account ID of the trusted profile",
+			},
+
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "crn of the trusted profile",
+			},
+
+			"iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the trusted profile",
+			},
+
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the trusted profile",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the trusted profile",
+			},
+
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last modification date of the trusted profile",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-zA-Z0-9_.@ -]*$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+
+	ibmIAMTrustedProfileResourceValidator := ResourceValidator{ResourceName: "ibm_iam_trusted_profile", Schema: validateSchema}
+	return &ibmIAMTrustedProfileResourceValidator
+}
+
+func resourceIBMIAMTrustedProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	profile := iamTrustedProfile{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		AccountID:   userDetails.userAccount,
+	}
+	result, response, err := createIAMTrustedProfile(ctx, iamIdentityClient, profile)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating trusted profile: %s\n%s", err, response))
+	}
+
+	d.SetId(result.ID)
+
+	return resourceIBMIAMTrustedProfileRead(ctx, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	profile, response, err := getIAMTrustedProfile(ctx, iamIdentityClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving trusted profile: %s\n%s", err, response))
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("account_id", profile.AccountID)
+	d.Set("crn", profile.CRN)
+	d.Set("iam_id", profile.IamID)
+	d.Set("entity_tag", profile.EntityTag)
+	d.Set("created_at", profile.CreatedAt)
+	d.Set("modified_at", profile.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("name") || d.HasChange("description") {
+		iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		// The update needs the entity tag of the current version of the profile
+		profile, response, err := getIAMTrustedProfile(ctx, iamIdentityClient, d.Id())
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error retrieving trusted profile: %s\n%s", err, response))
+		}
+
+		profile.Name = d.Get("name").(string)
+		profile.Description = d.Get("description").(string)
+		_, response, err = updateIAMTrustedProfile(ctx, iamIdentityClient, d.Id(), profile.EntityTag, *profile)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error updating trusted profile: %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMIAMTrustedProfileRead(ctx, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// The claim rules and links of the profile are deleted with it
+	response, err := deleteIAMTrustedProfile(ctx, iamIdentityClient, d.Id())
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diagFromErr(fmt.Errorf("Error deleting trusted profile: %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
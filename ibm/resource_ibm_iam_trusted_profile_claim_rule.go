@@ -0,0 +1,317 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	iamTrustedProfileClaimRuleSAML = "Profile-SAML"
+	iamTrustedProfileClaimRuleCR   = "Profile-CR"
+)
+
+func resourceIBMIAMTrustedProfileClaimRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileClaimRuleCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileClaimRuleRead,
+		UpdateContext: resourceIBMIAMTrustedProfileClaimRuleUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfileClaimRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMTrustedProfileClaimRule, nil),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the trusted profile",
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "type"),
+				Description:  "Type of the claim rule, Profile-SAML for federated users or Profile-CR for compute resources",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the claim rule",
+			},
+
+			"realm_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The realm name of the identity provider, required for the Profile-SAML rules",
+			},
+
+			"cr_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "cr_type"),
+				Description:  "The type of the compute resources, required for the Profile-CR rules",
+			},
+
+			"expiration": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Session expiration in seconds, only for the Profile-SAML rules",
+			},
+
+			"conditions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The conditions the claims of the token must match",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"claim": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The claim to evaluate",
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "operator"),
+							Description:  "The operation to perform on the claim",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value the claim is compared with",
+						},
+					},
+				},
+			},
+
+			"rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the claim rule",
+			},
+
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the claim rule",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the claim rule",
+			},
+
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last modification date of the claim rule",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "Profile-SAML, Profile-CR"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "cr_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "VSI, IKS_SA, ROKS_SA, CE"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "operator",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "EQUALS, NOT_EQUALS, EQUALS_IGNORE_CASE, NOT_EQUALS_IGNORE_CASE, CONTAINS, IN"})
+
+	ibmIAMTrustedProfileClaimRuleResourceValidator := ResourceValidator{ResourceName: "ibm_iam_trusted_profile_claim_rule", Schema: validateSchema}
+	return &ibmIAMTrustedProfileClaimRuleResourceValidator
+}
+
+// expandIAMTrustedProfileClaimRule builds the claim rule of the configuration,
+// the Profile-SAML rules need a realm and the Profile-CR rules a compute resource type
+func expandIAMTrustedProfileClaimRule(d *schema.ResourceData) (iamTrustedProfileClaimRule, error) {
+	rule := iamTrustedProfileClaimRule{
+		Name:       d.Get("name").(string),
+		Type:       d.Get("type").(string),
+		RealmName:  d.Get("realm_name").(string),
+		CRType:     d.Get("cr_type").(string),
+		Conditions: []iamTrustedProfileClaimRuleCondition{},
+	}
+	switch rule.Type {
+	case iamTrustedProfileClaimRuleSAML:
+		if rule.RealmName == "" {
+			return rule, fmt.Errorf("realm_name is required for the %s claim rules", rule.Type)
+		}
+		if rule.CRType != "" {
+			return rule, fmt.Errorf("cr_type can't be set for the %s claim rules", rule.Type)
+		}
+		if expiration, ok := d.GetOk("expiration"); ok {
+			rule.Expiration = expiration.(int)
+		}
+	case iamTrustedProfileClaimRuleCR:
+		if rule.CRType == "" {
+			return rule, fmt.Errorf("cr_type is required for the %s claim rules", rule.Type)
+		}
+		if rule.RealmName != "" {
+			return rule, fmt.Errorf("realm_name can't be set for the %s claim rules", rule.Type)
+		}
+	}
+	for _, c := range d.Get("conditions").([]interface{}) {
+		condition := c.(map[string]interface{})
+		rule.Conditions = append(rule.Conditions, iamTrustedProfileClaimRuleCondition{
+			Claim:    condition["claim"].(string),
+			Operator: condition["operator"].(string),
+			Value:    condition["value"].(string),
+		})
+	}
+	return rule, nil
+}
+
+func flattenIAMTrustedProfileClaimRuleConditions(conditions []iamTrustedProfileClaimRuleCondition) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(conditions))
+	for _, condition := range conditions {
+		result = append(result, map[string]interface{}{
+			"claim":    condition.Claim,
+			"operator": condition.Operator,
+			"value":    condition.Value,
+		})
+	}
+	return result
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	profileID := d.Get("profile_id").(string)
+
+	rule, err := expandIAMTrustedProfileClaimRule(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	result, response, err := createIAMTrustedProfileClaimRule(ctx, iamIdentityClient, profileID, rule)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating claim rule of trusted profile %s: %s\n%s", profileID, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", profileID, result.ID))
+
+	return resourceIBMIAMTrustedProfileClaimRuleRead(ctx, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if len(parts) != 2 {
+		return diagFromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of profileID/ruleID", d.Id()))
+	}
+	profileID := parts[0]
+	ruleID := parts[1]
+
+	rule, response, err := getIAMTrustedProfileClaimRule(ctx, iamIdentityClient, profileID, ruleID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving claim rule of trusted profile %s: %s\n%s", profileID, err, response))
+	}
+
+	d.Set("profile_id", profileID)
+	d.Set("rule_id", rule.ID)
+	d.Set("type", rule.Type)
+	d.Set("name", rule.Name)
+	d.Set("realm_name", rule.RealmName)
+	d.Set("cr_type", rule.CRType)
+	d.Set("expiration", rule.Expiration)
+	d.Set("conditions", flattenIAMTrustedProfileClaimRuleConditions(rule.Conditions))
+	d.Set("entity_tag", rule.EntityTag)
+	d.Set("created_at", rule.CreatedAt)
+	d.Set("modified_at", rule.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("name") || d.HasChange("realm_name") || d.HasChange("cr_type") || d.HasChange("expiration") || d.HasChange("conditions") {
+		iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return diagFromErr(err)
+		}
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return diagFromErr(err)
+		}
+		profileID := parts[0]
+		ruleID := parts[1]
+
+		rule, err := expandIAMTrustedProfileClaimRule(d)
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		// The update needs the entity tag of the current version of the rule
+		current, response, err := getIAMTrustedProfileClaimRule(ctx, iamIdentityClient, profileID, ruleID)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error retrieving claim rule of trusted profile %s: %s\n%s", profileID, err, response))
+		}
+		_, response, err = updateIAMTrustedProfileClaimRule(ctx, iamIdentityClient, profileID, ruleID, current.EntityTag, rule)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error updating claim rule of trusted profile %s: %s\n%s", profileID, err, response))
+		}
+	}
+
+	return resourceIBMIAMTrustedProfileClaimRuleRead(ctx, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	profileID := parts[0]
+	ruleID := parts[1]
+
+	response, err := deleteIAMTrustedProfileClaimRule(ctx, iamIdentityClient, profileID, ruleID)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diagFromErr(fmt.Errorf("Error deleting claim rule of trusted profile %s: %s\n%s", profileID, err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMTrustedProfileClaimRule_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTrustedProfileClaimRuleBasic(name, "batch"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.rule", "type", "Profile-CR"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.rule", "cr_type", "IKS_SA"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.rule", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.rule", "conditions.0.value", "\"batch\""),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile_claim_rule.rule", "rule_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTrustedProfileClaimRuleBasic(name, "jobs"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.rule", "conditions.0.value", "\"jobs\""),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_iam_trusted_profile_claim_rule.rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileClaimRuleBasic(name, namespace string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profile" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_claim_rule" "rule" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			type       = "Profile-CR"
+			cr_type    = "IKS_SA"
+			name       = "%s"
+			conditions {
+				claim    = "namespace"
+				operator = "EQUALS"
+				value    = "\"%s\""
+			}
+		}
+	`, name, name, namespace)
+}
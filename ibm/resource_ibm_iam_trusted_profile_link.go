@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfileLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileLinkCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileLinkRead,
+		DeleteContext: resourceIBMIAMTrustedProfileLinkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMTrustedProfileLink, nil),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the trusted profile",
+			},
+
+			"cr_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_link", "cr_type"),
+				Description:  "The type of the compute resource, VSI, IKS_SA, ROKS_SA or CE",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the link",
+			},
+
+			"link": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The compute resource allowed to assume the trusted profile",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The CRN of the virtual server instance, cluster or Code Engine project",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The namespace of the service account, required for the IKS_SA and ROKS_SA links",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The name of the service account, default when not set",
+						},
+					},
+				},
+			},
+
+			"link_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the link",
+			},
+
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the link",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the link",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileLinkValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "cr_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "VSI, IKS_SA, ROKS_SA, CE"})
+
+	ibmIAMTrustedProfileLinkResourceValidator := ResourceValidator{ResourceName: "ibm_iam_trusted_profile_link", Schema: validateSchema}
+	return &ibmIAMTrustedProfileLinkResourceValidator
+}
+
+// expandIAMTrustedProfileLink builds the link of the configuration, the
+// service accounts of the clusters are identified by their namespace
+func expandIAMTrustedProfileLink(d *schema.ResourceData) (iamTrustedProfileLink, error) {
+	link := iamTrustedProfileLink{
+		Name:   d.Get("name").(string),
+		CRType: d.Get("cr_type").(string),
+	}
+	target := d.Get("link").([]interface{})[0].(map[string]interface{})
+	link.Link = iamTrustedProfileLinkTarget{
+		CRN:       target["crn"].(string),
+		Namespace: target["namespace"].(string),
+		Name:      target["name"].(string),
+	}
+	switch link.CRType {
+	case "IKS_SA", "ROKS_SA":
+		if link.Link.Namespace == "" {
+			return link, fmt.Errorf("link.0.namespace is required for the %s links", link.CRType)
+		}
+	case "VSI":
+		if link.Link.Namespace != "" || link.Link.Name != "" {
+			return link, fmt.Errorf("link.0.namespace and link.0.name can't be set for the %s links", link.CRType)
+		}
+	}
+	return link, nil
+}
+
+func resourceIBMIAMTrustedProfileLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	profileID := d.Get("profile_id").(string)
+
+	link, err := expandIAMTrustedProfileLink(d)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	result, response, err := createIAMTrustedProfileLink(ctx, iamIdentityClient, profileID, link)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating link of trusted profile %s: %s\n%s", profileID, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", profileID, result.ID))
+
+	return resourceIBMIAMTrustedProfileLinkRead(ctx, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if len(parts) != 2 {
+		return diagFromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of profileID/linkID", d.Id()))
+	}
+	profileID := parts[0]
+	linkID := parts[1]
+
+	link, response, err := getIAMTrustedProfileLink(ctx, iamIdentityClient, profileID, linkID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving link of trusted profile %s: %s\n%s", profileID, err, response))
+	}
+
+	d.Set("profile_id", profileID)
+	d.Set("link_id", link.ID)
+	d.Set("cr_type", link.CRType)
+	d.Set("name", link.Name)
+	d.Set("link", []map[string]interface{}{{
+		"crn":       link.Link.CRN,
+		"namespace": link.Link.Namespace,
+		"name":      link.Link.Name,
+	}})
+	d.Set("entity_tag", link.EntityTag)
+	d.Set("created_at", link.CreatedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diagFromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	profileID := parts[0]
+	linkID := parts[1]
+
+	response, err := deleteIAMTrustedProfileLink(ctx, iamIdentityClient, profileID, linkID)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diagFromErr(fmt.Errorf("Error deleting link of trusted profile %s: %s\n%s", profileID, err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMTrustedProfileLink_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTrustedProfileLinkBasic(name, trustedProfileClusterCRN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_link.link", "cr_type", "IKS_SA"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_link.link", "link.0.crn", trustedProfileClusterCRN),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_link.link", "link.0.namespace", "default"),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile_link.link", "link_id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_iam_trusted_profile_link.link",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileLinkBasic(name, clusterCRN string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profile" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_link" "link" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			cr_type    = "IKS_SA"
+			name       = "%s"
+			link {
+				crn       = "%s"
+				namespace = "default"
+			}
+		}
+	`, name, name, clusterCRN)
+}
@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+func resourceIBMIAMTrustedProfilePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfilePolicyCreate,
+		ReadContext:   resourceIBMIAMTrustedProfilePolicyRead,
+		UpdateContext: resourceIBMIAMTrustedProfilePolicyUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfilePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMTrustedProfilePolicy, nil),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the trusted profile",
+				ForceNew:    true,
+			},
+
+			"roles": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names of the policy definition",
+			},
+
+			"resources": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"account_management"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service name of the policy definition",
+						},
+
+						"resource_instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of resource instance of the policy definition",
+						},
+
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Region of the policy definition",
+						},
+
+						"resource_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource type of the policy definition",
+						},
+
+						"resource": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource of the policy definition",
+						},
+
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the resource group.",
+						},
+
+						"attributes": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Set resource attributes in the form of 'name=value,name=value....",
+							Elem:        schema.TypeString,
+						},
+					},
+				},
+			},
+
+			"account_management": {
+				Type:          schema.TypeBool,
+				Default:       false,
+				Optional:      true,
+				Description:   "Give access to all account management services",
+				ConflictsWith: []string{"resources"},
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// generateIAMTrustedProfilePolicy returns the policy of the configuration
+// granted to the IAM ID of the trusted profile
+func generateIAMTrustedProfilePolicy(ctx context.Context, d *schema.ResourceData, meta interface{}, profileID string) (iampapv1.Policy, error) {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return iampapv1.Policy{}, err
+	}
+	profile, response, err := getIAMTrustedProfile(ctx, iamIdentityClient, profileID)
+	if err != nil {
+		return iampapv1.Policy{}, fmt.Errorf("Error retrieving trusted profile %s: %s\n%s", profileID, err, response)
+	}
+
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return iampapv1.Policy{}, err
+	}
+
+	policy, err := generateAccountPolicyV2(d, meta)
+	if err != nil {
+		return iampapv1.Policy{}, err
+	}
+
+	policy.Resources[0].SetAccountID(userDetails.userAccount)
+
+	policy.Subjects = []iampapv1.Subject{
+		{
+			Attributes: []iampapv1.Attribute{
+				{
+					Name:  "iam_id",
+					Value: profile.IamID,
+				},
+			},
+		},
+	}
+
+	policy.Type = iampapv1.AccessPolicyType
+
+	return policy, nil
+}
+
+func resourceIBMIAMTrustedProfilePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	profileID := d.Get("profile_id").(string)
+
+	policy, err := generateIAMTrustedProfilePolicy(ctx, d, meta, profileID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	profilePolicy, err := iampapClient.V1Policy().Create(policy)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating trusted profile policy: %s", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", profileID, profilePolicy.ID))
+
+	return resourceIBMIAMTrustedProfilePolicyRead(ctx, d, meta)
+}
+
+func resourceIBMIAMTrustedProfilePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if len(parts) != 2 {
+		return diagFromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of profileID/policyID", d.Id()))
+	}
+	profileID := parts[0]
+	profilePolicyID := parts[1]
+
+	profilePolicy, err := iampapClient.V1Policy().Get(profilePolicyID)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving trusted profile policy: %s", err))
+	}
+
+	d.Set("profile_id", profileID)
+	roles := make([]string, len(profilePolicy.Roles))
+	for i, role := range profilePolicy.Roles {
+		roles[i] = role.Name
+	}
+	d.Set("roles", roles)
+	d.Set("version", profilePolicy.Version)
+	d.Set("resources", flattenPolicyResource(profilePolicy.Resources))
+	if len(profilePolicy.Resources) > 0 {
+		if profilePolicy.Resources[0].GetAttribute("serviceType") == "service" {
+			d.Set("account_management", false)
+		}
+		if profilePolicy.Resources[0].GetAttribute("serviceType") == "platform_service" {
+			d.Set("account_management", true)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfilePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("account_management") {
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return diagFromErr(err)
+		}
+		profileID := parts[0]
+		profilePolicyID := parts[1]
+
+		policy, err := generateIAMTrustedProfilePolicy(ctx, d, meta, profileID)
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		iampapClient, err := meta.(ClientSession).IAMPAPAPI()
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		_, err = iampapClient.V1Policy().Update(profilePolicyID, policy, d.Get("version").(string))
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error updating trusted profile policy: %s", err))
+		}
+
+	}
+
+	return resourceIBMIAMTrustedProfilePolicyRead(ctx, d, meta)
+
+}
+
+func resourceIBMIAMTrustedProfilePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	profilePolicyID := parts[1]
+
+	err = iampapClient.V1Policy().Delete(profilePolicyID)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error deleting trusted profile policy: %s", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv2"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/bluemix-go/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -130,12 +132,13 @@ var permissionSets = map[string][]string{NOACCESS: noAccess, VIEWONLY: viewOnly,
 
 func resourceIBMUserInvite() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMInviteUsers,
-		Read:     resourceIBMIAMGetUsers,
-		Update:   resourceIBMIAMUpdateUserProfile,
-		Delete:   resourceIBMIAMRemoveUser,
-		Exists:   resourceIBMIAMGetUserProfileExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMInviteUsers,
+		ReadContext:   resourceIBMIAMGetUsers,
+		UpdateContext: resourceIBMIAMUpdateUserProfile,
+		DeleteContext: resourceIBMIAMRemoveUser,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMUserInvite, nil),
+		},
 		Schema: map[string]*schema.Schema{
 
 			"users": {
@@ -468,10 +471,10 @@ func resourceIBMUserInvite() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMInviteUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	client := userManagement.UserInvite()
 
@@ -482,7 +485,7 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 		users = append(users, v2.User{Email: user, AccountRole: MEMBER})
 	}
 	if len(users) == 0 {
-		return fmt.Errorf("Users email not provided")
+		return diagFromErr(fmt.Errorf("Users email not provided"))
 	}
 	var accessGroups = make([]string, 0)
 	if data, ok := d.GetOk("access_groups"); ok {
@@ -496,7 +499,7 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 		accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
 		if err != nil {
 			log.Println("IAM Acess policy: ", err.Error())
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -515,7 +518,7 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 	}
 	orgRoles, err := getCloudFoundryRoles(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if len(orgRoles) != 0 {
 		inviteUserPayload.OrganizationRoles = orgRoles
@@ -523,38 +526,50 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	_, InviteUserError := client.InviteUsers(accountID, inviteUserPayload)
 	if InviteUserError != nil {
-		return InviteUserError
+		return diagFromErr(InviteUserError)
 	}
 	d.SetId(time.Now().UTC().String())
-	return resourceIBMIAMUpdateUserProfile(d, meta)
+	return resourceIBMIAMUpdateUserProfile(ctx, d, meta)
 }
 
-func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMGetUsers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	Client := userManagement.UserInvite()
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	iamuumClient, err := meta.(ClientSession).IAMUUMAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	res, err := Client.ListUsers(accountID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
+	}
+	for _, email := range flattenUsersSet(d.Get("users").(*schema.Set)) {
+		isFound := false
+		for _, userInfo := range res {
+			if strings.Compare(userInfo.Email, email) == 0 {
+				isFound = true
+			}
+		}
+		if !isFound {
+			d.SetId("")
+			return nil
+		}
 	}
 	users := make([]string, 0)
 	invitedUsers := make([]map[string]interface{}, 0, len(res))
@@ -579,7 +594,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving user policies: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving user policies: %s", err))
 		}
 		userPolicies := make([]map[string]interface{}, 0, len(policies))
 		for _, policy := range policies {
@@ -601,7 +616,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		// Get AccessGroups associated with user
 		retreivedGroups, err := iamuumClient.AccessGroup().List(accountID, user.IamID)
 		if err != nil {
-			return fmt.Errorf("Error retrieving access groups: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving access groups: %s", err))
 		}
 
 		accGroupList := make([]map[string]interface{}, 0, len(retreivedGroups))
@@ -612,7 +627,7 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 				AccessGroupID: grpData.ID,
 			})
 			if err != nil {
-				return fmt.Errorf("Error retrieving access group policy: %s", err)
+				return diagFromErr(fmt.Errorf("Error retrieving access group policy: %s", err))
 			}
 
 			//Fetch access group policies
@@ -653,11 +668,11 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUpdateUserProfile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// validate change
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	Client := userManagement.UserInvite()
 
@@ -665,7 +680,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 		//var removedUsers, addedUsers []string
 		accountID, err := getAccountID(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		ousrs, nusrs := d.GetChange("users")
 		old := ousrs.(*schema.Set)
@@ -681,7 +696,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 				users = append(users, v2.User{Email: user, AccountRole: MEMBER})
 			}
 			if len(users) == 0 {
-				return fmt.Errorf("Users email not provided")
+				return diagFromErr(fmt.Errorf("Users email not provided"))
 			}
 
 			var accessPolicies []v2.UserPolicy
@@ -689,7 +704,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 				accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
 				if err != nil {
 					log.Println("IAM Acess policy: ", err.Error())
-					return err
+					return diagFromErr(err)
 				}
 			}
 
@@ -703,7 +718,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 			infraPermissions := getInfraPermissions(d, meta)
 			orgRoles, err := getCloudFoundryRoles(d, meta)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 
 			inviteUserPayload := v2.UserInvite{}
@@ -723,7 +738,7 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 			}
 			_, InviteUserError := Client.InviteUsers(accountID, inviteUserPayload)
 			if InviteUserError != nil {
-				return InviteUserError
+				return diagFromErr(InviteUserError)
 			}
 		}
 
@@ -732,30 +747,30 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 			for _, user := range removed {
 				IAMID, err := getUserIAMID(d, meta, user)
 				if err != nil {
-					return fmt.Errorf("User's IAM ID not found: %s", err.Error())
+					return diagFromErr(fmt.Errorf("User's IAM ID not found: %s", err.Error()))
 				}
 				Err := Client.RemoveUsers(accountID, IAMID)
 				if Err != nil {
 					log.Println("Failed to remove user: ", user)
-					return Err
+					return diagFromErr(Err)
 				}
 			}
 		}
 
 	}
-	return resourceIBMIAMGetUsers(d, meta)
+	return resourceIBMIAMGetUsers(ctx, d, meta)
 }
 
-func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMRemoveUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	Client := userManagement.UserInvite()
 
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	usersSet := d.Get("users").(*schema.Set)
@@ -764,50 +779,16 @@ func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
 		IAMID, err := getUserIAMID(d, meta, user)
 
 		if err != nil {
-			return fmt.Errorf("User's IAM ID not found: %s", err.Error())
+			return diagFromErr(fmt.Errorf("User's IAM ID not found: %s", err.Error()))
 		}
 		Err := Client.RemoveUsers(accountID, IAMID)
 		if Err != nil {
-			return Err
+			return diagFromErr(Err)
 		}
 	}
 	return nil
 }
 
-func resourceIBMIAMGetUserProfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userManagement, err := meta.(ClientSession).UserManagementAPI()
-	if err != nil {
-		return false, err
-	}
-	Client := userManagement.UserInvite()
-
-	accountID, err := getAccountID(d, meta)
-	if err != nil {
-		return false, err
-	}
-
-	usersSet := d.Get("users").(*schema.Set)
-	usersList := flattenUsersSet(usersSet)
-
-	res, err := Client.ListUsers(accountID)
-	if err != nil {
-		return false, err
-	}
-	var isFound bool
-	for _, user := range usersList {
-
-		for _, userInfo := range res {
-			if strings.Compare(userInfo.Email, user) == 0 {
-				isFound = true
-			}
-		}
-		if !isFound {
-			return false, nil
-		}
-	}
-	return true, nil
-}
-
 // getAccountID returns accountID
 func getAccountID(d *schema.ResourceData, meta interface{}) (string, error) {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv1"
@@ -15,12 +17,13 @@ import (
 
 func resourceIBMIAMUserPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMUserPolicyCreate,
-		Read:     resourceIBMIAMUserPolicyRead,
-		Update:   resourceIBMIAMUserPolicyUpdate,
-		Delete:   resourceIBMIAMUserPolicyDelete,
-		Exists:   resourceIBMIAMUserPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMUserPolicyCreate,
+		ReadContext:   resourceIBMIAMUserPolicyRead,
+		UpdateContext: resourceIBMIAMUserPolicyUpdate,
+		DeleteContext: resourceIBMIAMUserPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMIAMUserPolicy, nil),
+		},
 		Schema: map[string]*schema.Schema{
 
 			"ibm_id": {
@@ -113,24 +116,24 @@ func resourceIBMIAMUserPolicy() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userEmail := d.Get("ibm_id").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	accountID := userDetails.userAccount
 	var policy iampapv1.Policy
 	policy, err = generateAccountPolicyV2(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	policy.Resources[0].SetAccountID(accountID)
@@ -139,7 +142,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 
 	ibmUniqueID, err := getIBMUniqueId(accountID, userEmail, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	policy.Subjects = []iampapv1.Subject{
@@ -155,34 +158,38 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 
 	userPolicy, err := iampapClient.V1Policy().Create(policy)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", userEmail, userPolicy.ID))
-	return resourceIBMIAMUserPolicyRead(d, meta)
+	return resourceIBMIAMUserPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userEmail := parts[0]
 	userPolicyID := parts[1]
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userPolicy, err := iampapClient.V1Policy().Get(userPolicyID)
 	if err != nil {
-		return err
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
 	}
 	d.Set("ibm_id", userEmail)
 	roles := make([]string, len(userPolicy.Roles))
@@ -203,22 +210,22 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("account_management") {
 		parts, err := idParts(d.Id())
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		userEmail := parts[0]
 		userPolicyID := parts[1]
 
 		userDetails, err := meta.(ClientSession).BluemixUserDetails()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		var policy iampapv1.Policy
@@ -226,12 +233,12 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 
 		policy, err = generateAccountPolicyV2(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		ibmUniqueID, err := getIBMUniqueId(accountID, userEmail, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		policy.Resources[0].SetAccountID(accountID)
@@ -251,62 +258,33 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 
 		_, err = iampapClient.V1Policy().Update(userPolicyID, policy, d.Get("version").(string))
 		if err != nil {
-			return fmt.Errorf("Error updating user policy: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating user policy: %s", err))
 		}
 	}
-	return resourceIBMIAMUserPolicyRead(d, meta)
+	return resourceIBMIAMUserPolicyRead(ctx, d, meta)
 }
 
-func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	userPolicyID := parts[1]
 
 	err = iampapClient.V1Policy().Delete(userPolicyID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iampapClient, err := meta.(ClientSession).IAMPAPAPI()
-	if err != nil {
-		return false, err
-	}
-
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	userEmail := parts[0]
-	userPolicyID := parts[1]
-
-	userPolicy, err := iampapClient.V1Policy().Get(userPolicyID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	tempID := fmt.Sprintf("%s/%s", userEmail, userPolicy.ID)
-
-	return tempID == d.Id(), nil
-
-}
-
 func getIBMUniqueId(accountID, userEmail string, meta interface{}) (string, error) {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
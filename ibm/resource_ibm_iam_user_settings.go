@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,12 +20,13 @@ const (
 
 func resourceIBMUserSettings() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMIAMUserSettingsCreate,
-		Read:     resourceIBMIAMUserSettingsRead,
-		Update:   resourceIBMIAMUserSettingsUpdate,
-		Delete:   resourceIBMIAMUserSettingsDelete,
-		Exists:   resourceIBMIAMUserSettingsExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMIAMUserSettingsCreate,
+		ReadContext:   resourceIBMIAMUserSettingsRead,
+		UpdateContext: resourceIBMIAMUserSettingsUpdate,
+		DeleteContext: resourceIBMIAMUserSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMUserSettings, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -45,10 +48,10 @@ func resourceIBMUserSettings() *schema.Resource {
 	}
 }
 
-func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	client := userManagement.UserInvite()
 
@@ -57,12 +60,12 @@ func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{})
 	//Read from Bluemix UserConfig
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	iamID, err := getIBMUniqueId(accountID, userEmail, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	UserSettingsPayload := v2.UserSettingOptions{}
@@ -79,12 +82,12 @@ func resourceIBMIAMUserSettingsCreate(d *schema.ResourceData, meta interface{})
 	_, UserSettingError := client.ManageUserSettings(accountID, iamID, UserSettingsPayload)
 
 	if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
-		return fmt.Errorf("Error occured during user settings: %s", UserSettingError)
+		return diagFromErr(fmt.Errorf("Error occured during user settings: %s", UserSettingError))
 	}
 
 	d.SetId(userEmail)
 
-	return resourceIBMIAMUserSettingsRead(d, meta)
+	return resourceIBMIAMUserSettingsRead(ctx, d, meta)
 }
 
 func getUserAccountID(d *schema.ResourceData, meta interface{}) (string, error) {
@@ -95,28 +98,29 @@ func getUserAccountID(d *schema.ResourceData, meta interface{}) (string, error)
 	return userDetails.userAccount, nil
 }
 
-func resourceIBMIAMUserSettingsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	client := userManagement.UserInvite()
 
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	iamID, err := getIBMUniqueId(accountID, d.Id(), meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	UserSettings, UserSettingError := client.GetUserSettings(accountID, iamID)
 	if UserSettingError != nil {
-		return UserSettingError
+		return diagFromErr(UserSettingError)
 	}
 
+	d.Set(iamUserSettingIamID, d.Id())
 	iplist := strings.Split(UserSettings.AllowedIPAddresses, ",")
 	d.Set(iamUserSettingAllowedIPAddresses, iplist)
 
@@ -124,22 +128,22 @@ func resourceIBMIAMUserSettingsRead(d *schema.ResourceData, meta interface{}) er
 
 }
 
-func resourceIBMIAMUserSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// validate change
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	client := userManagement.UserInvite()
 
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	iamID, err := getIBMUniqueId(accountID, d.Id(), meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	hasChanged := false
@@ -161,61 +165,36 @@ func resourceIBMIAMUserSettingsUpdate(d *schema.ResourceData, meta interface{})
 	if hasChanged {
 		_, UserSettingError := client.ManageUserSettings(accountID, iamID, userSettingPayload)
 		if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
-			return fmt.Errorf("Error occured during user settings: %s", UserSettingError)
+			return diagFromErr(fmt.Errorf("Error occured during user settings: %s", UserSettingError))
 		}
 	}
 
-	return resourceIBMIAMUserSettingsRead(d, meta)
+	return resourceIBMIAMUserSettingsRead(ctx, d, meta)
 }
 
-func resourceIBMIAMUserSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMIAMUserSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userManagement, err := meta.(ClientSession).UserManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	client := userManagement.UserInvite()
 
 	accountID, err := getUserAccountID(d, meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	iamID, err := getIBMUniqueId(accountID, d.Id(), meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	userSettingPayload := v2.UserSettingOptions{}
 
 	_, UserSettingError := client.ManageUserSettings(accountID, iamID, userSettingPayload)
 	if UserSettingError != nil && !strings.Contains(UserSettingError.Error(), "EmptyResponseBody") {
-		return fmt.Errorf("Error occured during user settings: %s", UserSettingError)
+		return diagFromErr(fmt.Errorf("Error occured during user settings: %s", UserSettingError))
 	}
 
 	return nil
 }
-
-func resourceIBMIAMUserSettingsExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userManagement, err := meta.(ClientSession).UserManagementAPI()
-	if err != nil {
-		return false, err
-	}
-	client := userManagement.UserInvite()
-
-	accountID, err := getUserAccountID(d, meta)
-	if err != nil {
-		return false, err
-	}
-
-	iamID, err := getIBMUniqueId(accountID, d.Id(), meta)
-	if err != nil {
-		return false, err
-	}
-
-	_, settingErr := client.GetUserSettings(accountID, iamID)
-
-	if settingErr != nil {
-		return false, settingErr
-	}
-	return true, nil
-}
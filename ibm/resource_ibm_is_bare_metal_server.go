@@ -0,0 +1,819 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBareMetalServerName                    = "name"
+	isBareMetalServerProfile                 = "profile"
+	isBareMetalServerImage                   = "image"
+	isBareMetalServerKeys                    = "keys"
+	isBareMetalServerUserData                = "user_data"
+	isBareMetalServerZone                    = "zone"
+	isBareMetalServerVPC                     = "vpc"
+	isBareMetalServerResourceGroup           = "resource_group"
+	isBareMetalServerPrimaryNetworkInterface = "primary_network_interface"
+	isBareMetalServerNetworkInterfaces       = "network_interfaces"
+	isBareMetalServerAction                  = "action"
+	isBareMetalServerStatus                  = "status"
+	isBareMetalServerStatusReasons           = "status_reasons"
+	isBareMetalServerDisks                   = "disks"
+	isBareMetalServerCPU                     = "cpu"
+	isBareMetalServerMemory                  = "memory"
+	isBareMetalServerBandwidth               = "bandwidth"
+	isBareMetalServerCRN                     = "crn"
+	isBareMetalServerHref                    = "href"
+	isBareMetalServerCreatedAt               = "created_at"
+	isBareMetalServerResourceType            = "resource_type"
+
+	isBareMetalServerNicID                      = "id"
+	isBareMetalServerNicName                    = "name"
+	isBareMetalServerNicSubnet                  = "subnet"
+	isBareMetalServerNicAllowedVlans            = "allowed_vlans"
+	isBareMetalServerNicAllowIPSpoofing         = "allow_ip_spoofing"
+	isBareMetalServerNicEnableInfrastructureNat = "enable_infrastructure_nat"
+	isBareMetalServerNicSecurityGroups          = "security_groups"
+	isBareMetalServerNicPrimaryIpv4Address      = "primary_ipv4_address"
+	isBareMetalServerNicPortSpeed               = "port_speed"
+	isBareMetalServerNicMacAddress              = "mac_address"
+	isBareMetalServerNicInterfaceTypePCI        = "pci"
+	isBareMetalServerNicInterfaceTypeVlan       = "vlan"
+
+	isBareMetalServerDiskID            = "id"
+	isBareMetalServerDiskName          = "name"
+	isBareMetalServerDiskSize          = "size"
+	isBareMetalServerDiskInterfaceType = "interface_type"
+
+	isBareMetalServerStatusPending    = "pending"
+	isBareMetalServerStatusStarting   = "starting"
+	isBareMetalServerStatusRunning    = "running"
+	isBareMetalServerStatusStopping   = "stopping"
+	isBareMetalServerStatusStopped    = "stopped"
+	isBareMetalServerStatusRestarting = "restarting"
+	isBareMetalServerStatusDeleting   = "deleting"
+	isBareMetalServerStatusDeleted    = "done"
+	isBareMetalServerStatusFailed     = "failed"
+)
+
+func resourceIBMISBareMetalServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISBareMetalServerCreate,
+		ReadContext:   resourceIBMISBareMetalServerRead,
+		UpdateContext: resourceIBMISBareMetalServerUpdate,
+		DeleteContext: resourceIBMISBareMetalServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISBareMetalServer, nil),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISInstanceActionCustomizeDiff(diff)
+			},
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isBareMetalServerName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_bare_metal_server", isBareMetalServerName),
+				Description:  "The name of the bare metal server",
+			},
+
+			isBareMetalServerProfile: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the profile of the bare metal server",
+			},
+
+			isBareMetalServerImage: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the image the bare metal server is provisioned with",
+			},
+
+			isBareMetalServerKeys: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs of the SSH keys of the administrative user of the bare metal server",
+			},
+
+			isBareMetalServerUserData: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The user data given to the bare metal server",
+			},
+
+			isBareMetalServerZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The zone of the bare metal server",
+			},
+
+			isBareMetalServerVPC: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the VPC of the bare metal server, the VPC of the subnet of the primary network interface by default",
+			},
+
+			isBareMetalServerResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group ID of the bare metal server",
+			},
+
+			isBareMetalServerPrimaryNetworkInterface: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Description: "The primary network interface of the bare metal server, a PCI interface",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isBareMetalServerNicID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the network interface",
+						},
+						isBareMetalServerNicName: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The name of the network interface",
+						},
+						isBareMetalServerNicSubnet: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The ID of the subnet of the network interface",
+						},
+						isBareMetalServerNicAllowedVlans: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Set:         schema.HashInt,
+							Description: "The VLAN IDs of the VLAN interfaces allowed to use the network interface",
+						},
+						isBareMetalServerNicAllowIPSpoofing: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates whether IP spoofing is allowed on the network interface",
+						},
+						isBareMetalServerNicEnableInfrastructureNat: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, the VPC infrastructure performs any needed NAT operations",
+						},
+						isBareMetalServerNicSecurityGroups: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The IDs of the security groups of the network interface",
+						},
+						isBareMetalServerNicPrimaryIpv4Address: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The primary IPv4 address of the network interface",
+						},
+						isBareMetalServerNicPortSpeed: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The speed of the network interface, in megabits per second",
+						},
+						isBareMetalServerNicMacAddress: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The MAC address of the network interface",
+						},
+					},
+				},
+			},
+
+			isBareMetalServerNetworkInterfaces: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The secondary network interfaces of the bare metal server, managed with ibm_is_bare_metal_server_network_interface",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isBareMetalServerNicID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						isBareMetalServerNicName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						isBareMetalServerNicSubnet: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						isBareMetalServerNicPrimaryIpv4Address: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			isBareMetalServerAction: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_is_bare_metal_server", isBareMetalServerAction),
+				Description:  "The power action of the bare metal server, start, stop or reboot. A stop or start is performed when the status of the server does not match the action, a reboot when the action is changed to reboot",
+			},
+
+			isBareMetalServerStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the bare metal server",
+			},
+
+			isBareMetalServerStatusReasons: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The reasons of the status of the bare metal server",
+			},
+
+			isBareMetalServerDisks: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The local disks of the bare metal server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isBareMetalServerDiskID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						isBareMetalServerDiskName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						isBareMetalServerDiskSize: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						isBareMetalServerDiskInterfaceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			isBareMetalServerCPU: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The processor configuration of the bare metal server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"architecture": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"core_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"socket_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"threads_per_core": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			isBareMetalServerMemory: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of memory of the bare metal server, in gibibytes",
+			},
+
+			isBareMetalServerBandwidth: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total bandwidth of the bare metal server, in megabits per second",
+			},
+
+			isBareMetalServerCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the bare metal server",
+			},
+
+			isBareMetalServerHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the bare metal server",
+			},
+
+			isBareMetalServerCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the bare metal server was created",
+			},
+
+			isBareMetalServerResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the bare metal server",
+			},
+		},
+	}
+}
+
+func resourceIBMISBareMetalServerValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBareMetalServerName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBareMetalServerAction,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "start, stop, reboot"})
+
+	ibmISBareMetalServerResourceValidator := ResourceValidator{ResourceName: "ibm_is_bare_metal_server", Schema: validateSchema}
+	return &ibmISBareMetalServerResourceValidator
+}
+
+func bareMetalServersClient(meta interface{}) (vpcBareMetalServers, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	return vpcBareMetalServersAPI(sess), nil
+}
+
+// expandBareMetalServerPrototype returns the prototype of the bare metal server of the configuration
+func expandBareMetalServerPrototype(d *schema.ResourceData) *vpcBareMetalServerPrototype {
+	profile := d.Get(isBareMetalServerProfile).(string)
+	zone := d.Get(isBareMetalServerZone).(string)
+	image := d.Get(isBareMetalServerImage).(string)
+	prototype := &vpcBareMetalServerPrototype{
+		Profile: vpcReference{Name: &profile},
+		Zone:    vpcReference{Name: &zone},
+		Initialization: vpcBareMetalServerInitialization{
+			Image: vpcReference{ID: &image},
+		},
+	}
+	if name, ok := d.GetOk(isBareMetalServerName); ok {
+		namestr := name.(string)
+		prototype.Name = &namestr
+	}
+	for _, key := range expandStringList(d.Get(isBareMetalServerKeys).(*schema.Set).List()) {
+		keystr := key
+		prototype.Initialization.Keys = append(prototype.Initialization.Keys, vpcReference{ID: &keystr})
+	}
+	if userdata, ok := d.GetOk(isBareMetalServerUserData); ok {
+		userdatastr := userdata.(string)
+		prototype.Initialization.UserData = &userdatastr
+	}
+	if vpc, ok := d.GetOk(isBareMetalServerVPC); ok {
+		vpcstr := vpc.(string)
+		prototype.VPC = &vpcReference{ID: &vpcstr}
+	}
+	if rgrp, ok := d.GetOk(isBareMetalServerResourceGroup); ok {
+		rg := rgrp.(string)
+		prototype.ResourceGroup = &vpcReference{ID: &rg}
+	}
+
+	primnic := d.Get(isBareMetalServerPrimaryNetworkInterface).([]interface{})[0].(map[string]interface{})
+	subnet := primnic[isBareMetalServerNicSubnet].(string)
+	allowIPSpoofing := primnic[isBareMetalServerNicAllowIPSpoofing].(bool)
+	enableNat := primnic[isBareMetalServerNicEnableInfrastructureNat].(bool)
+	nic := vpcBareMetalServerNICPrototype{
+		InterfaceType:           isBareMetalServerNicInterfaceTypePCI,
+		Subnet:                  vpcReference{ID: &subnet},
+		AllowIPSpoofing:         &allowIPSpoofing,
+		EnableInfrastructureNat: &enableNat,
+	}
+	if name := primnic[isBareMetalServerNicName].(string); name != "" {
+		nic.Name = &name
+	}
+	if ipv4 := primnic[isBareMetalServerNicPrimaryIpv4Address].(string); ipv4 != "" {
+		nic.PrimaryIP = &vpcReservedIP{Address: &ipv4}
+	}
+	if vlans, ok := primnic[isBareMetalServerNicAllowedVlans].(*schema.Set); ok {
+		for _, vlan := range vlans.List() {
+			nic.AllowedVlans = append(nic.AllowedVlans, int64(vlan.(int)))
+		}
+	}
+	if secgrps, ok := primnic[isBareMetalServerNicSecurityGroups].(*schema.Set); ok {
+		for _, secgrp := range expandStringList(secgrps.List()) {
+			secgrpstr := secgrp
+			nic.SecurityGroups = append(nic.SecurityGroups, vpcReference{ID: &secgrpstr})
+		}
+	}
+	prototype.PrimaryNetworkInterface = nic
+	return prototype
+}
+
+func resourceIBMISBareMetalServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := bareMetalServersClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	server, response, err := client.CreateBareMetalServer(expandBareMetalServerPrototype(d))
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating the bare metal server: %s\n%s", err, response))
+	}
+	d.SetId(server.ID)
+	log.Printf("[INFO] Bare metal server : %s", server.ID)
+
+	_, err = isWaitForBareMetalServerAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	return resourceIBMISBareMetalServerUpdate(ctx, d, meta)
+}
+
+func resourceIBMISBareMetalServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := bareMetalServersClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	server, response, err := client.GetBareMetalServer(id)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Getting Bare Metal Server (%s): %s\n%s", id, err, response))
+	}
+	initialization, response, err := client.GetBareMetalServerInitialization(id)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error Getting the initialization of Bare Metal Server (%s): %s\n%s", id, err, response))
+	}
+	var primnic *vpcBareMetalServerNIC
+	if server.PrimaryNetworkInterface != nil {
+		primnic, response, err = client.GetBareMetalServerNetworkInterface(id, server.PrimaryNetworkInterface.ID)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error Getting the primary network interface of Bare Metal Server (%s): %s\n%s", id, err, response))
+		}
+	}
+	setBareMetalServerAttributes(d, server, initialization, primnic)
+	return nil
+}
+
+// setBareMetalServerAttributes sets the attributes of the bare metal server, its initialization
+// and its primary network interface
+func setBareMetalServerAttributes(d *schema.ResourceData, server *vpcBareMetalServer, initialization *vpcBareMetalServerInitialization, primnic *vpcBareMetalServerNIC) {
+	d.Set(isBareMetalServerName, server.Name)
+	d.Set(isBareMetalServerStatus, server.Status)
+	d.Set(isBareMetalServerMemory, server.Memory)
+	d.Set(isBareMetalServerBandwidth, server.Bandwidth)
+	d.Set(isBareMetalServerCRN, server.CRN)
+	d.Set(isBareMetalServerHref, server.Href)
+	d.Set(isBareMetalServerCreatedAt, server.CreatedAt)
+	d.Set(isBareMetalServerResourceType, server.ResourceType)
+	if server.Profile != nil && server.Profile.Name != nil {
+		d.Set(isBareMetalServerProfile, *server.Profile.Name)
+	}
+	if server.Zone != nil && server.Zone.Name != nil {
+		d.Set(isBareMetalServerZone, *server.Zone.Name)
+	}
+	if server.VPC != nil && server.VPC.ID != nil {
+		d.Set(isBareMetalServerVPC, *server.VPC.ID)
+	}
+	if server.ResourceGroup != nil && server.ResourceGroup.ID != nil {
+		d.Set(isBareMetalServerResourceGroup, *server.ResourceGroup.ID)
+	}
+
+	reasons := make([]string, 0, len(server.StatusReasons))
+	for _, reason := range server.StatusReasons {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", reason.Code, reason.Message))
+	}
+	d.Set(isBareMetalServerStatusReasons, reasons)
+
+	if server.CPU != nil {
+		d.Set(isBareMetalServerCPU, []map[string]interface{}{{
+			"architecture":     server.CPU.Architecture,
+			"core_count":       server.CPU.CoreCount,
+			"socket_count":     server.CPU.SocketCount,
+			"threads_per_core": server.CPU.ThreadsPerCore,
+		}})
+	}
+
+	disks := make([]map[string]interface{}, 0, len(server.Disks))
+	for _, disk := range server.Disks {
+		disks = append(disks, map[string]interface{}{
+			isBareMetalServerDiskID:            disk.ID,
+			isBareMetalServerDiskName:          disk.Name,
+			isBareMetalServerDiskSize:          disk.Size,
+			isBareMetalServerDiskInterfaceType: disk.InterfaceType,
+		})
+	}
+	d.Set(isBareMetalServerDisks, disks)
+
+	nics := make([]map[string]interface{}, 0, len(server.NetworkInterfaces))
+	for _, nic := range server.NetworkInterfaces {
+		if server.PrimaryNetworkInterface != nil && nic.ID == server.PrimaryNetworkInterface.ID {
+			continue
+		}
+		currentNic := map[string]interface{}{
+			isBareMetalServerNicID:   nic.ID,
+			isBareMetalServerNicName: nic.Name,
+		}
+		if nic.Subnet != nil && nic.Subnet.ID != nil {
+			currentNic[isBareMetalServerNicSubnet] = *nic.Subnet.ID
+		}
+		if nic.PrimaryIP != nil && nic.PrimaryIP.Address != nil {
+			currentNic[isBareMetalServerNicPrimaryIpv4Address] = *nic.PrimaryIP.Address
+		}
+		nics = append(nics, currentNic)
+	}
+	d.Set(isBareMetalServerNetworkInterfaces, nics)
+
+	if initialization != nil {
+		if initialization.Image.ID != nil {
+			d.Set(isBareMetalServerImage, *initialization.Image.ID)
+		}
+		keys := make([]string, 0, len(initialization.Keys))
+		for _, key := range initialization.Keys {
+			if key.ID != nil {
+				keys = append(keys, *key.ID)
+			}
+		}
+		d.Set(isBareMetalServerKeys, keys)
+	}
+
+	if primnic != nil {
+		d.Set(isBareMetalServerPrimaryNetworkInterface, []map[string]interface{}{flattenBareMetalServerNIC(primnic)})
+	}
+}
+
+// flattenBareMetalServerNIC returns the attributes of a network interface of a bare metal server
+// shared by the primary network interface block and ibm_is_bare_metal_server_network_interface
+func flattenBareMetalServerNIC(nic *vpcBareMetalServerNIC) map[string]interface{} {
+	vlans := make([]interface{}, 0, len(nic.AllowedVlans))
+	for _, vlan := range nic.AllowedVlans {
+		vlans = append(vlans, int(vlan))
+	}
+	secgrps := make([]interface{}, 0, len(nic.SecurityGroups))
+	for _, secgrp := range nic.SecurityGroups {
+		if secgrp.ID != nil {
+			secgrps = append(secgrps, *secgrp.ID)
+		}
+	}
+	flattened := map[string]interface{}{
+		isBareMetalServerNicID:                      nic.ID,
+		isBareMetalServerNicName:                    nic.Name,
+		isBareMetalServerNicAllowedVlans:            schema.NewSet(schema.HashInt, vlans),
+		isBareMetalServerNicAllowIPSpoofing:         nic.AllowIPSpoofing,
+		isBareMetalServerNicEnableInfrastructureNat: nic.EnableInfrastructureNat,
+		isBareMetalServerNicSecurityGroups:          schema.NewSet(schema.HashString, secgrps),
+		isBareMetalServerNicPortSpeed:               nic.PortSpeed,
+		isBareMetalServerNicMacAddress:              nic.MacAddress,
+	}
+	if nic.Subnet != nil && nic.Subnet.ID != nil {
+		flattened[isBareMetalServerNicSubnet] = *nic.Subnet.ID
+	}
+	if nic.PrimaryIP != nil && nic.PrimaryIP.Address != nil {
+		flattened[isBareMetalServerNicPrimaryIpv4Address] = *nic.PrimaryIP.Address
+	}
+	return flattened
+}
+
+// bareMetalServerNICPatch returns the patch of the updatable attributes of a network interface of
+// a bare metal server, from the old and new values of its attributes
+func bareMetalServerNICPatch(oldNic, newNic map[string]interface{}) map[string]interface{} {
+	patch := map[string]interface{}{}
+	if name := newNic[isBareMetalServerNicName].(string); name != "" && name != oldNic[isBareMetalServerNicName] {
+		patch[isBareMetalServerNicName] = name
+	}
+	for _, key := range []string{isBareMetalServerNicAllowIPSpoofing, isBareMetalServerNicEnableInfrastructureNat} {
+		if newNic[key] != oldNic[key] {
+			patch[key] = newNic[key]
+		}
+	}
+	oldVlans, _ := oldNic[isBareMetalServerNicAllowedVlans].(*schema.Set)
+	newVlans, _ := newNic[isBareMetalServerNicAllowedVlans].(*schema.Set)
+	if oldVlans == nil {
+		oldVlans = schema.NewSet(schema.HashInt, nil)
+	}
+	if newVlans == nil {
+		newVlans = schema.NewSet(schema.HashInt, nil)
+	}
+	if !oldVlans.Equal(newVlans) {
+		vlans := make([]int64, 0, newVlans.Len())
+		for _, vlan := range newVlans.List() {
+			vlans = append(vlans, int64(vlan.(int)))
+		}
+		patch[isBareMetalServerNicAllowedVlans] = vlans
+	}
+	return patch
+}
+
+func resourceIBMISBareMetalServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := bareMetalServersClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+
+	if d.HasChange(isBareMetalServerName) && !d.IsNewResource() {
+		_, response, err := client.UpdateBareMetalServer(id, d.Get(isBareMetalServerName).(string))
+		if err != nil {
+			return diagFromErr(newAttributeError(isBareMetalServerName, fmt.Sprintf("Error Updating Bare Metal Server (%s)", id), fmt.Errorf("%s\n%s", err, response)))
+		}
+	}
+
+	if d.HasChange(isBareMetalServerPrimaryNetworkInterface) && !d.IsNewResource() {
+		oldList, newList := d.GetChange(isBareMetalServerPrimaryNetworkInterface)
+		oldNic := oldList.([]interface{})[0].(map[string]interface{})
+		newNic := newList.([]interface{})[0].(map[string]interface{})
+		if patch := bareMetalServerNICPatch(oldNic, newNic); len(patch) > 0 {
+			nicID := oldNic[isBareMetalServerNicID].(string)
+			_, response, err := client.UpdateBareMetalServerNetworkInterface(id, nicID, patch)
+			if err != nil {
+				return diagFromErr(newAttributeError(isBareMetalServerPrimaryNetworkInterface, fmt.Sprintf("Error Updating the primary network interface of Bare Metal Server (%s)", id), fmt.Errorf("%s\n%s", err, response)))
+			}
+		}
+	}
+
+	if action, ok := d.GetOk(isBareMetalServerAction); ok && (d.HasChange(isBareMetalServerAction) || d.HasChange(isBareMetalServerStatus)) {
+		err = bareMetalServerAction(ctx, client, d, id, action.(string))
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+	return resourceIBMISBareMetalServerRead(ctx, d, meta)
+}
+
+// bareMetalServerAction performs the power action on the bare metal server when its status does
+// not match the action, like the actions of ibm_is_instance
+func bareMetalServerAction(ctx context.Context, client vpcBareMetalServers, d *schema.ResourceData, id, action string) error {
+	server, response, err := client.GetBareMetalServer(id)
+	if err != nil {
+		return fmt.Errorf("Error Getting Bare Metal Server (%s): %s\n%s", id, err, response)
+	}
+	rebootRequested := !d.IsNewResource() && d.HasChange(isBareMetalServerAction)
+	if !instanceActionRequired(action, server.Status, rebootRequested) {
+		return nil
+	}
+
+	switch action {
+	case isInstanceActionStart:
+		response, err = client.StartBareMetalServer(id)
+	case isInstanceActionStop:
+		response, err = client.StopBareMetalServer(id, vpcBareMetalServerStopTypeSoft)
+	case isInstanceActionReboot:
+		response, err = client.RestartBareMetalServer(id)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while performing the %s action on the bare metal server %s: %s\n%s", action, id, err, response)
+	}
+	if action == isInstanceActionStop {
+		_, err = isWaitForBareMetalServerStopped(ctx, client, id, d.Timeout(schema.TimeoutUpdate))
+	} else {
+		_, err = isWaitForBareMetalServerAvailable(ctx, client, id, d.Timeout(schema.TimeoutUpdate))
+	}
+	return err
+}
+
+func resourceIBMISBareMetalServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := bareMetalServersClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	response, err := client.DeleteBareMetalServer(id)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Deleting Bare Metal Server (%s): %s\n%s", id, err, response))
+	}
+	_, err = isWaitForBareMetalServerDeleted(ctx, client, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func isWaitForBareMetalServerAvailable(ctx context.Context, client vpcBareMetalServers, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for bare metal server (%s) to be running.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerStatusPending, isBareMetalServerStatusStarting, isBareMetalServerStatusRestarting, isBareMetalServerStatusStopped},
+		Target:     []string{isBareMetalServerStatusRunning, isBareMetalServerStatusFailed},
+		Refresh:    isBareMetalServerRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isWaitForBareMetalServerStopped(ctx context.Context, client vpcBareMetalServers, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for bare metal server (%s) to be stopped.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerStatusStopping, isBareMetalServerStatusRunning},
+		Target:     []string{isBareMetalServerStatusStopped, isBareMetalServerStatusFailed},
+		Refresh:    isBareMetalServerRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isBareMetalServerRefreshFunc(client vpcBareMetalServers, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		server, response, err := client.GetBareMetalServer(id)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error Getting Bare Metal Server: %s\n%s", err, response)
+		}
+		if server.Status == isBareMetalServerStatusFailed {
+			return server, server.Status, fmt.Errorf("The bare metal server %s failed: %v", id, server.StatusReasons)
+		}
+		return server, server.Status, nil
+	}
+}
+
+func isWaitForBareMetalServerDeleted(ctx context.Context, client vpcBareMetalServers, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for bare metal server (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerStatusDeleting},
+		Target:     []string{isBareMetalServerStatusDeleted, ""},
+		Refresh:    isBareMetalServerDeleteRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isBareMetalServerDeleteRefreshFunc(client vpcBareMetalServers, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		server, response, err := client.GetBareMetalServer(id)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return server, isBareMetalServerStatusDeleted, nil
+			}
+			return nil, "", fmt.Errorf("Error Getting Bare Metal Server: %s\n%s", err, response)
+		}
+		if server.Status == isBareMetalServerStatusFailed {
+			return server, server.Status, fmt.Errorf("The bare metal server %s failed to delete: %v", id, server.StatusReasons)
+		}
+		return server, isBareMetalServerStatusDeleting, nil
+	}
+}
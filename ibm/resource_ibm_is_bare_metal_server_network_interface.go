@@ -0,0 +1,419 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isBareMetalServerNicBareMetalServer       = "bare_metal_server"
+	isBareMetalServerNicNetworkInterfaceID    = "network_interface_id"
+	isBareMetalServerNicInterfaceType         = "interface_type"
+	isBareMetalServerNicVlan                  = "vlan"
+	isBareMetalServerNicAllowInterfaceToFloat = "allow_interface_to_float"
+	isBareMetalServerNicStatus                = "status"
+	isBareMetalServerNicType                  = "type"
+	isBareMetalServerNicHref                  = "href"
+	isBareMetalServerNicResourceType          = "resource_type"
+
+	isBareMetalServerNicStatusAvailable = "available"
+	isBareMetalServerNicStatusPending   = "pending"
+	isBareMetalServerNicStatusDeleting  = "deleting"
+	isBareMetalServerNicStatusDeleted   = "done"
+	isBareMetalServerNicStatusFailed    = "failed"
+)
+
+func resourceIBMISBareMetalServerNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISBareMetalServerNetworkInterfaceCreate,
+		ReadContext:   resourceIBMISBareMetalServerNetworkInterfaceRead,
+		UpdateContext: resourceIBMISBareMetalServerNetworkInterfaceUpdate,
+		DeleteContext: resourceIBMISBareMetalServerNetworkInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISBareMetalServerNetworkInterface, nil),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isBareMetalServerNicBareMetalServer: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the bare metal server of the network interface",
+			},
+
+			isBareMetalServerNicSubnet: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the subnet of the network interface",
+			},
+
+			isBareMetalServerNicInterfaceType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      isBareMetalServerNicInterfaceTypePCI,
+				ValidateFunc: InvokeValidator("ibm_is_bare_metal_server_network_interface", isBareMetalServerNicInterfaceType),
+				Description:  "The type of the network interface, pci or vlan",
+			},
+
+			isBareMetalServerNicName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_bare_metal_server_network_interface", isBareMetalServerNicName),
+				Description:  "The name of the network interface",
+			},
+
+			isBareMetalServerNicAllowedVlans: {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeInt},
+				Set:           schema.HashInt,
+				ConflictsWith: []string{isBareMetalServerNicVlan, isBareMetalServerNicAllowInterfaceToFloat},
+				Description:   "The VLAN IDs of the VLAN interfaces allowed to use the PCI network interface",
+			},
+
+			isBareMetalServerNicVlan: {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isBareMetalServerNicAllowedVlans},
+				Description:   "The VLAN ID of the VLAN network interface",
+			},
+
+			isBareMetalServerNicAllowInterfaceToFloat: {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isBareMetalServerNicAllowedVlans},
+				Description:   "Indicates whether the VLAN network interface can float to another bare metal server",
+			},
+
+			isBareMetalServerNicAllowIPSpoofing: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether IP spoofing is allowed on the network interface",
+			},
+
+			isBareMetalServerNicEnableInfrastructureNat: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set to true, the VPC infrastructure performs any needed NAT operations",
+			},
+
+			isBareMetalServerNicSecurityGroups: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs of the security groups of the network interface",
+			},
+
+			isBareMetalServerNicPrimaryIpv4Address: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The primary IPv4 address of the network interface",
+			},
+
+			isBareMetalServerNicNetworkInterfaceID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the network interface",
+			},
+
+			isBareMetalServerNicPortSpeed: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The speed of the network interface, in megabits per second",
+			},
+
+			isBareMetalServerNicMacAddress: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MAC address of the network interface",
+			},
+
+			isBareMetalServerNicStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the network interface",
+			},
+
+			isBareMetalServerNicType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the network interface, primary or secondary",
+			},
+
+			isBareMetalServerNicHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the network interface",
+			},
+
+			isBareMetalServerNicResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the network interface",
+			},
+		},
+	}
+}
+
+func resourceIBMISBareMetalServerNetworkInterfaceValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBareMetalServerNicName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isBareMetalServerNicInterfaceType,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "pci, vlan"})
+
+	ibmISBareMetalServerNetworkInterfaceResourceValidator := ResourceValidator{ResourceName: "ibm_is_bare_metal_server_network_interface", Schema: validateSchema}
+	return &ibmISBareMetalServerNetworkInterfaceResourceValidator
+}
+
+func resourceIBMISBareMetalServerNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := bareMetalServersClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	serverID := d.Get(isBareMetalServerNicBareMetalServer).(string)
+	subnet := d.Get(isBareMetalServerNicSubnet).(string)
+	allowIPSpoofing := d.Get(isBareMetalServerNicAllowIPSpoofing).(bool)
+	enableNat := d.Get(isBareMetalServerNicEnableInfrastructureNat).(bool)
+	prototype := &vpcBareMetalServerNICPrototype{
+		InterfaceType:           d.Get(isBareMetalServerNicInterfaceType).(string),
+		Subnet:                  vpcReference{ID: &subnet},
+		AllowIPSpoofing:         &allowIPSpoofing,
+		EnableInfrastructureNat: &enableNat,
+	}
+	if name, ok := d.GetOk(isBareMetalServerNicName); ok {
+		namestr := name.(string)
+		prototype.Name = &namestr
+	}
+	if ipv4, ok := d.GetOk(isBareMetalServerNicPrimaryIpv4Address); ok {
+		ipv4str := ipv4.(string)
+		prototype.PrimaryIP = &vpcReservedIP{Address: &ipv4str}
+	}
+	if secgrps, ok := d.GetOk(isBareMetalServerNicSecurityGroups); ok {
+		for _, secgrp := range expandStringList(secgrps.(*schema.Set).List()) {
+			secgrpstr := secgrp
+			prototype.SecurityGroups = append(prototype.SecurityGroups, vpcReference{ID: &secgrpstr})
+		}
+	}
+	if prototype.InterfaceType == isBareMetalServerNicInterfaceTypeVlan {
+		vlan := int64(d.Get(isBareMetalServerNicVlan).(int))
+		if vlan == 0 {
+			return diagFromErr(fmt.Errorf("%s is required for a network interface of type %s", isBareMetalServerNicVlan, isBareMetalServerNicInterfaceTypeVlan))
+		}
+		prototype.Vlan = &vlan
+		float := d.Get(isBareMetalServerNicAllowInterfaceToFloat).(bool)
+		prototype.AllowInterfaceToFloat = &float
+	} else {
+		for _, vlan := range d.Get(isBareMetalServerNicAllowedVlans).(*schema.Set).List() {
+			prototype.AllowedVlans = append(prototype.AllowedVlans, int64(vlan.(int)))
+		}
+	}
+
+	nic, response, err := client.CreateBareMetalServerNetworkInterface(serverID, prototype)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating the network interface of the bare metal server %s: %s\n%s", serverID, err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", serverID, nic.ID))
+	log.Printf("[INFO] Bare metal server network interface : %s", d.Id())
+
+	_, err = isWaitForBareMetalServerNetworkInterfaceAvailable(ctx, client, serverID, nic.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	return resourceIBMISBareMetalServerNetworkInterfaceRead(ctx, d, meta)
+}
+
+func resourceIBMISBareMetalServerNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := bareMetalServersClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	serverID, id, err := bareMetalServerNetworkInterfaceIDParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	nic, response, err := client.GetBareMetalServerNetworkInterface(serverID, id)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Getting Network Interface (%s) of Bare Metal Server (%s): %s\n%s", id, serverID, err, response))
+	}
+
+	d.Set(isBareMetalServerNicBareMetalServer, serverID)
+	d.Set(isBareMetalServerNicNetworkInterfaceID, nic.ID)
+	for key, value := range flattenBareMetalServerNIC(nic) {
+		if key != isBareMetalServerNicID {
+			d.Set(key, value)
+		}
+	}
+	d.Set(isBareMetalServerNicInterfaceType, nic.InterfaceType)
+	d.Set(isBareMetalServerNicVlan, nic.Vlan)
+	d.Set(isBareMetalServerNicAllowInterfaceToFloat, nic.AllowInterfaceToFloat)
+	d.Set(isBareMetalServerNicStatus, nic.Status)
+	d.Set(isBareMetalServerNicType, nic.Type)
+	d.Set(isBareMetalServerNicHref, nic.Href)
+	d.Set(isBareMetalServerNicResourceType, nic.ResourceType)
+	return nil
+}
+
+func resourceIBMISBareMetalServerNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := bareMetalServersClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	serverID, id, err := bareMetalServerNetworkInterfaceIDParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	oldNic, newNic := map[string]interface{}{}, map[string]interface{}{}
+	for _, key := range []string{isBareMetalServerNicName, isBareMetalServerNicAllowIPSpoofing, isBareMetalServerNicEnableInfrastructureNat, isBareMetalServerNicAllowedVlans} {
+		oldNic[key], newNic[key] = d.GetChange(key)
+	}
+	if patch := bareMetalServerNICPatch(oldNic, newNic); len(patch) > 0 {
+		_, response, err := client.UpdateBareMetalServerNetworkInterface(serverID, id, patch)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error Updating Network Interface (%s) of Bare Metal Server (%s): %s\n%s", id, serverID, err, response))
+		}
+	}
+	return resourceIBMISBareMetalServerNetworkInterfaceRead(ctx, d, meta)
+}
+
+func resourceIBMISBareMetalServerNetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := bareMetalServersClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	serverID, id, err := bareMetalServerNetworkInterfaceIDParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	response, err := client.DeleteBareMetalServerNetworkInterface(serverID, id)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Deleting Network Interface (%s) of Bare Metal Server (%s): %s\n%s", id, serverID, err, response))
+	}
+	_, err = isWaitForBareMetalServerNetworkInterfaceDeleted(ctx, client, serverID, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// bareMetalServerNetworkInterfaceIDParts returns the bare metal server ID and the network
+// interface ID of the ID of ibm_is_bare_metal_server_network_interface
+func bareMetalServerNetworkInterfaceIDParts(id string) (string, string, error) {
+	parts, err := idParts(id)
+	if err != nil {
+		return "", "", err
+	}
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("Incorrect ID %s: ID should be a combination of bareMetalServerID/networkInterfaceID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func isWaitForBareMetalServerNetworkInterfaceAvailable(ctx context.Context, client vpcBareMetalServers, serverID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for network interface (%s) of bare metal server (%s) to be available.", id, serverID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerNicStatusPending},
+		Target:     []string{isBareMetalServerNicStatusAvailable, isBareMetalServerNicStatusFailed},
+		Refresh:    isBareMetalServerNetworkInterfaceRefreshFunc(client, serverID, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isBareMetalServerNetworkInterfaceRefreshFunc(client vpcBareMetalServers, serverID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		nic, response, err := client.GetBareMetalServerNetworkInterface(serverID, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error Getting Network Interface of Bare Metal Server: %s\n%s", err, response)
+		}
+		if nic.Status == isBareMetalServerNicStatusFailed {
+			return nic, nic.Status, fmt.Errorf("The network interface %s of the bare metal server %s failed", id, serverID)
+		}
+		return nic, nic.Status, nil
+	}
+}
+
+func isWaitForBareMetalServerNetworkInterfaceDeleted(ctx context.Context, client vpcBareMetalServers, serverID, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for network interface (%s) of bare metal server (%s) to be deleted.", id, serverID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isBareMetalServerNicStatusDeleting},
+		Target:     []string{isBareMetalServerNicStatusDeleted, ""},
+		Refresh:    isBareMetalServerNetworkInterfaceDeleteRefreshFunc(client, serverID, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isBareMetalServerNetworkInterfaceDeleteRefreshFunc(client vpcBareMetalServers, serverID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		nic, response, err := client.GetBareMetalServerNetworkInterface(serverID, id)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return nic, isBareMetalServerNicStatusDeleted, nil
+			}
+			return nil, "", fmt.Errorf("Error Getting Network Interface of Bare Metal Server: %s\n%s", err, response)
+		}
+		return nic, isBareMetalServerNicStatusDeleting, nil
+	}
+}
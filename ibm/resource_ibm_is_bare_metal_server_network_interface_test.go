@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISBareMetalServerNetworkInterface_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-bms-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	nicname := fmt.Sprintf("tf-nic-%d", acctest.RandIntRange(10, 100))
+	nicname1 := fmt.Sprintf("tf-nic-upd-%d", acctest.RandIntRange(10, 100))
+	publicKey := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISBareMetalServerNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISBareMetalServerNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, nicname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBareMetalServerNetworkInterfaceExists("ibm_is_bare_metal_server_network_interface.testacc_nic"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "name", nicname),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "interface_type", "vlan"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "vlan", "101"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "status", "available"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISBareMetalServerNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, nicname1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISBareMetalServerNetworkInterfaceExists("ibm_is_bare_metal_server_network_interface.testacc_nic"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_bare_metal_server_network_interface.testacc_nic", "name", nicname1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISBareMetalServerNetworkInterfaceDestroy(s *terraform.State) error {
+	client, _ := bareMetalServersClient(testAccProvider.Meta())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_bare_metal_server_network_interface" {
+			continue
+		}
+
+		serverID, id, err := bareMetalServerNetworkInterfaceIDParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetBareMetalServerNetworkInterface(serverID, id)
+		if err == nil {
+			return fmt.Errorf("Bare metal server network interface still exists: %s", rs.Primary.ID)
+		}
+	}
+	return testAccCheckIBMISBareMetalServerDestroy(s)
+}
+
+func testAccCheckIBMISBareMetalServerNetworkInterfaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		client, err := bareMetalServersClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		serverID, id, err := bareMetalServerNetworkInterfaceIDParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetBareMetalServerNetworkInterface(serverID, id)
+		return err
+	}
+}
+
+func testAccCheckIBMISBareMetalServerNetworkInterfaceConfig(vpcname, subnetname, sshname, publicKey, name, nicname string) string {
+	return testAccCheckIBMISBareMetalServerConfig(vpcname, subnetname, sshname, publicKey, name, "start", false) + fmt.Sprintf(`
+	resource "ibm_is_bare_metal_server_network_interface" "testacc_nic" {
+		bare_metal_server = ibm_is_bare_metal_server.testacc_bms.id
+		subnet            = ibm_is_subnet.testacc_subnet.id
+		name              = "%s"
+		interface_type    = "vlan"
+		vlan              = 101
+	}`, nicname)
+}
@@ -0,0 +1,474 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isDedicatedHostName                      = "name"
+	isDedicatedHostProfile                   = "profile"
+	isDedicatedHostGroup                     = "host_group"
+	isDedicatedHostInstancePlacementEnabled  = "instance_placement_enabled"
+	isDedicatedHostResourceGroup             = "resource_group"
+	isDedicatedHostZone                      = "zone"
+	isDedicatedHostCRN                       = "crn"
+	isDedicatedHostHref                      = "href"
+	isDedicatedHostCreatedAt                 = "created_at"
+	isDedicatedHostLifecycleState            = "lifecycle_state"
+	isDedicatedHostState                     = "state"
+	isDedicatedHostProvisionable             = "provisionable"
+	isDedicatedHostMemory                    = "memory"
+	isDedicatedHostAvailableMemory           = "available_memory"
+	isDedicatedHostSocketCount               = "socket_count"
+	isDedicatedHostVcpuArchitecture          = "vcpu_architecture"
+	isDedicatedHostVcpuCount                 = "vcpu_count"
+	isDedicatedHostAvailableVcpuCount        = "available_vcpu_count"
+	isDedicatedHostInstances                 = "instances"
+	isDedicatedHostSupportedInstanceProfiles = "supported_instance_profiles"
+	isDedicatedHostResourceType              = "resource_type"
+
+	isDedicatedHostPending   = "pending"
+	isDedicatedHostStable    = "stable"
+	isDedicatedHostFailed    = "failed"
+	isDedicatedHostDeleting  = "deleting"
+	isDedicatedHostDeleted   = "done"
+	isDedicatedHostSuspended = "suspended"
+	isDedicatedHostUpdating  = "updating"
+	isDedicatedHostWaiting   = "waiting"
+)
+
+func resourceIBMISDedicatedHost() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISDedicatedHostCreate,
+		ReadContext:   resourceIBMISDedicatedHostRead,
+		UpdateContext: resourceIBMISDedicatedHostUpdate,
+		DeleteContext: resourceIBMISDedicatedHostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISDedicatedHost, nil),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isDedicatedHostName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_dedicated_host", isDedicatedHostName),
+				Description:  "The name of the dedicated host",
+			},
+
+			isDedicatedHostProfile: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the profile of the dedicated host",
+			},
+
+			isDedicatedHostGroup: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the dedicated host group of the dedicated host",
+			},
+
+			isDedicatedHostInstancePlacementEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set to true, instances can be placed on the dedicated host",
+			},
+
+			isDedicatedHostResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group ID of the dedicated host",
+			},
+
+			isDedicatedHostZone: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone of the dedicated host",
+			},
+
+			isDedicatedHostCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the dedicated host",
+			},
+
+			isDedicatedHostHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the dedicated host",
+			},
+
+			isDedicatedHostCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the dedicated host was created",
+			},
+
+			isDedicatedHostLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the dedicated host",
+			},
+
+			isDedicatedHostState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The administrative state of the dedicated host",
+			},
+
+			isDedicatedHostProvisionable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the dedicated host is available for instance creation",
+			},
+
+			isDedicatedHostMemory: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total amount of memory of the dedicated host, in gibibytes",
+			},
+
+			isDedicatedHostAvailableMemory: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of memory of the dedicated host available for instances, in gibibytes",
+			},
+
+			isDedicatedHostSocketCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of sockets of the dedicated host",
+			},
+
+			isDedicatedHostVcpuArchitecture: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The VCPU architecture of the dedicated host",
+			},
+
+			isDedicatedHostVcpuCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of VCPUs of the dedicated host",
+			},
+
+			isDedicatedHostAvailableVcpuCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of VCPUs of the dedicated host available for instances",
+			},
+
+			isDedicatedHostInstances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the instances placed on the dedicated host",
+			},
+
+			isDedicatedHostSupportedInstanceProfiles: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the instance profiles usable by the instances placed on the dedicated host",
+			},
+
+			isDedicatedHostResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the dedicated host",
+			},
+		},
+	}
+}
+
+func resourceIBMISDedicatedHostValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isDedicatedHostName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+
+	ibmISDedicatedHostResourceValidator := ResourceValidator{ResourceName: "ibm_is_dedicated_host", Schema: validateSchema}
+	return &ibmISDedicatedHostResourceValidator
+}
+
+func resourceIBMISDedicatedHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	profile := d.Get(isDedicatedHostProfile).(string)
+	group := d.Get(isDedicatedHostGroup).(string)
+	placementEnabled := d.Get(isDedicatedHostInstancePlacementEnabled).(bool)
+	prototype := &vpcv1.DedicatedHostPrototypeDedicatedHostByGroup{
+		Profile: &vpcv1.DedicatedHostProfileIdentityByName{
+			Name: &profile,
+		},
+		Group: &vpcv1.DedicatedHostGroupIdentityByID{
+			ID: &group,
+		},
+		InstancePlacementEnabled: &placementEnabled,
+	}
+	if name, ok := d.GetOk(isDedicatedHostName); ok {
+		namestr := name.(string)
+		prototype.Name = &namestr
+	}
+	if rgrp, ok := d.GetOk(isDedicatedHostResourceGroup); ok {
+		rg := rgrp.(string)
+		prototype.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rg,
+		}
+	}
+
+	options := &vpcv1.CreateDedicatedHostOptions{
+		DedicatedHostPrototype: prototype,
+	}
+	host, response, err := sess.CreateDedicatedHost(options)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating the dedicated host in group %s: %s\n%s", group, err, response))
+	}
+	d.SetId(*host.ID)
+	log.Printf("[INFO] Dedicated host : %s", *host.ID)
+
+	_, err = isWaitForDedicatedHostAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	return resourceIBMISDedicatedHostRead(ctx, d, meta)
+}
+
+func resourceIBMISDedicatedHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	options := &vpcv1.GetDedicatedHostOptions{
+		ID: &id,
+	}
+	host, response, err := sess.GetDedicatedHost(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Getting Dedicated Host (%s): %s\n%s", id, err, response))
+	}
+	setDedicatedHostAttributes(d, host)
+	return nil
+}
+
+// setDedicatedHostAttributes sets the attributes of the dedicated host shared by the resource and
+// the data source
+func setDedicatedHostAttributes(d *schema.ResourceData, host *vpcv1.DedicatedHost) {
+	d.Set(isDedicatedHostName, *host.Name)
+	d.Set(isDedicatedHostInstancePlacementEnabled, *host.InstancePlacementEnabled)
+	d.Set(isDedicatedHostCRN, *host.CRN)
+	d.Set(isDedicatedHostHref, *host.Href)
+	d.Set(isDedicatedHostLifecycleState, *host.LifecycleState)
+	d.Set(isDedicatedHostState, *host.State)
+	d.Set(isDedicatedHostProvisionable, *host.Provisionable)
+	d.Set(isDedicatedHostMemory, *host.Memory)
+	d.Set(isDedicatedHostAvailableMemory, *host.AvailableMemory)
+	d.Set(isDedicatedHostSocketCount, *host.SocketCount)
+	d.Set(isDedicatedHostResourceType, *host.ResourceType)
+	if host.CreatedAt != nil {
+		d.Set(isDedicatedHostCreatedAt, host.CreatedAt.String())
+	}
+	if host.Profile != nil {
+		d.Set(isDedicatedHostProfile, *host.Profile.Name)
+	}
+	if host.Group != nil {
+		d.Set(isDedicatedHostGroup, *host.Group.ID)
+	}
+	if host.Zone != nil {
+		d.Set(isDedicatedHostZone, *host.Zone.Name)
+	}
+	if host.ResourceGroup != nil {
+		d.Set(isDedicatedHostResourceGroup, *host.ResourceGroup.ID)
+	}
+	if host.Vcpu != nil {
+		d.Set(isDedicatedHostVcpuArchitecture, *host.Vcpu.Architecture)
+		d.Set(isDedicatedHostVcpuCount, *host.Vcpu.Count)
+	}
+	if host.AvailableVcpu != nil {
+		d.Set(isDedicatedHostAvailableVcpuCount, *host.AvailableVcpu.Count)
+	}
+	instances := make([]string, 0, len(host.Instances))
+	for _, instance := range host.Instances {
+		instances = append(instances, *instance.ID)
+	}
+	d.Set(isDedicatedHostInstances, instances)
+	profiles := make([]string, 0, len(host.SupportedInstanceProfiles))
+	for _, profile := range host.SupportedInstanceProfiles {
+		profiles = append(profiles, *profile.Name)
+	}
+	d.Set(isDedicatedHostSupportedInstanceProfiles, profiles)
+}
+
+func resourceIBMISDedicatedHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	if d.HasChange(isDedicatedHostName) || d.HasChange(isDedicatedHostInstancePlacementEnabled) {
+		hostPatchModel := &vpcv1.DedicatedHostPatch{}
+		if d.HasChange(isDedicatedHostName) {
+			name := d.Get(isDedicatedHostName).(string)
+			hostPatchModel.Name = &name
+		}
+		if d.HasChange(isDedicatedHostInstancePlacementEnabled) {
+			placementEnabled := d.Get(isDedicatedHostInstancePlacementEnabled).(bool)
+			hostPatchModel.InstancePlacementEnabled = &placementEnabled
+		}
+		err = updateDedicatedHost(sess, id, hostPatchModel)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+	return resourceIBMISDedicatedHostRead(ctx, d, meta)
+}
+
+func updateDedicatedHost(sess *vpcv1.VpcV1, id string, hostPatchModel *vpcv1.DedicatedHostPatch) error {
+	hostPatch, err := hostPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("Error calling asPatch for DedicatedHostPatch: %s", err)
+	}
+	options := &vpcv1.UpdateDedicatedHostOptions{
+		ID:                 &id,
+		DedicatedHostPatch: hostPatch,
+	}
+	_, response, err := sess.UpdateDedicatedHost(options)
+	if err != nil {
+		return fmt.Errorf("Error Updating Dedicated Host (%s): %s\n%s", id, err, response)
+	}
+	return nil
+}
+
+func resourceIBMISDedicatedHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+
+	// The instance placement must be disabled before the dedicated host is deleted
+	if d.Get(isDedicatedHostInstancePlacementEnabled).(bool) {
+		placementEnabled := false
+		err = updateDedicatedHost(sess, id, &vpcv1.DedicatedHostPatch{InstancePlacementEnabled: &placementEnabled})
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	options := &vpcv1.DeleteDedicatedHostOptions{
+		ID: &id,
+	}
+	response, err := sess.DeleteDedicatedHost(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Deleting Dedicated Host (%s): %s\n%s", id, err, response))
+	}
+	_, err = isWaitForDedicatedHostDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func isWaitForDedicatedHostAvailable(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for dedicated host (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isDedicatedHostPending, isDedicatedHostUpdating, isDedicatedHostWaiting},
+		Target:     []string{isDedicatedHostStable, isDedicatedHostFailed, isDedicatedHostSuspended},
+		Refresh:    isDedicatedHostRefreshFunc(sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isDedicatedHostRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		options := &vpcv1.GetDedicatedHostOptions{
+			ID: &id,
+		}
+		host, response, err := sess.GetDedicatedHost(options)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error Getting Dedicated Host: %s\n%s", err, response)
+		}
+		if *host.LifecycleState == isDedicatedHostFailed || *host.LifecycleState == isDedicatedHostSuspended {
+			return host, *host.LifecycleState, fmt.Errorf("The dedicated host %s is %s", id, *host.LifecycleState)
+		}
+		return host, *host.LifecycleState, nil
+	}
+}
+
+func isWaitForDedicatedHostDeleted(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for dedicated host (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isDedicatedHostDeleting, isDedicatedHostStable, isDedicatedHostPending, isDedicatedHostUpdating, isDedicatedHostWaiting},
+		Target:     []string{isDedicatedHostDeleted, ""},
+		Refresh:    isDedicatedHostDeleteRefreshFunc(sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isDedicatedHostDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		options := &vpcv1.GetDedicatedHostOptions{
+			ID: &id,
+		}
+		host, response, err := sess.GetDedicatedHost(options)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return host, isDedicatedHostDeleted, nil
+			}
+			return nil, "", fmt.Errorf("Error Getting Dedicated Host: %s\n%s", err, response)
+		}
+		if *host.LifecycleState == isDedicatedHostFailed {
+			return host, *host.LifecycleState, fmt.Errorf("The dedicated host %s failed to delete", id)
+		}
+		return host, isDedicatedHostDeleting, nil
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isDedicatedHostGroupName                      = "name"
+	isDedicatedHostGroupClass                     = "class"
+	isDedicatedHostGroupFamily                    = "family"
+	isDedicatedHostGroupZone                      = "zone"
+	isDedicatedHostGroupResourceGroup             = "resource_group"
+	isDedicatedHostGroupCRN                       = "crn"
+	isDedicatedHostGroupHref                      = "href"
+	isDedicatedHostGroupCreatedAt                 = "created_at"
+	isDedicatedHostGroupResourceType              = "resource_type"
+	isDedicatedHostGroupDedicatedHosts            = "dedicated_hosts"
+	isDedicatedHostGroupSupportedInstanceProfiles = "supported_instance_profiles"
+
+	isDedicatedHostGroupDeleting = "deleting"
+	isDedicatedHostGroupDeleted  = "done"
+)
+
+func resourceIBMISDedicatedHostGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISDedicatedHostGroupCreate,
+		ReadContext:   resourceIBMISDedicatedHostGroupRead,
+		UpdateContext: resourceIBMISDedicatedHostGroupUpdate,
+		DeleteContext: resourceIBMISDedicatedHostGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISDedicatedHostGroup, nil),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isDedicatedHostGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_dedicated_host_group", isDedicatedHostGroupName),
+				Description:  "The name of the dedicated host group",
+			},
+
+			isDedicatedHostGroupClass: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The dedicated host profile class of the hosts of the group",
+			},
+
+			isDedicatedHostGroupFamily: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_is_dedicated_host_group", isDedicatedHostGroupFamily),
+				Description:  "The dedicated host profile family of the hosts of the group, balanced, compute or memory",
+			},
+
+			isDedicatedHostGroupZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The zone of the dedicated host group",
+			},
+
+			isDedicatedHostGroupResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group ID of the dedicated host group",
+			},
+
+			isDedicatedHostGroupCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the dedicated host group",
+			},
+
+			isDedicatedHostGroupHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the dedicated host group",
+			},
+
+			isDedicatedHostGroupCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the dedicated host group was created",
+			},
+
+			isDedicatedHostGroupResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the dedicated host group",
+			},
+
+			isDedicatedHostGroupDedicatedHosts: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the dedicated hosts of the group",
+			},
+
+			isDedicatedHostGroupSupportedInstanceProfiles: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the instance profiles usable by the instances placed on the group",
+			},
+		},
+	}
+}
+
+func resourceIBMISDedicatedHostGroupValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isDedicatedHostGroupName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isDedicatedHostGroupFamily,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "balanced, compute, memory"})
+
+	ibmISDedicatedHostGroupResourceValidator := ResourceValidator{ResourceName: "ibm_is_dedicated_host_group", Schema: validateSchema}
+	return &ibmISDedicatedHostGroupResourceValidator
+}
+
+func resourceIBMISDedicatedHostGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	class := d.Get(isDedicatedHostGroupClass).(string)
+	family := d.Get(isDedicatedHostGroupFamily).(string)
+	zone := d.Get(isDedicatedHostGroupZone).(string)
+	options := &vpcv1.CreateDedicatedHostGroupOptions{
+		Class:  &class,
+		Family: &family,
+		Zone: &vpcv1.ZoneIdentity{
+			Name: &zone,
+		},
+	}
+	if name, ok := d.GetOk(isDedicatedHostGroupName); ok {
+		namestr := name.(string)
+		options.Name = &namestr
+	}
+	if rgrp, ok := d.GetOk(isDedicatedHostGroupResourceGroup); ok {
+		rg := rgrp.(string)
+		options.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rg,
+		}
+	}
+
+	group, response, err := sess.CreateDedicatedHostGroup(options)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating the dedicated host group: %s\n%s", err, response))
+	}
+	d.SetId(*group.ID)
+	log.Printf("[INFO] Dedicated host group : %s", *group.ID)
+	return resourceIBMISDedicatedHostGroupRead(ctx, d, meta)
+}
+
+func resourceIBMISDedicatedHostGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	options := &vpcv1.GetDedicatedHostGroupOptions{
+		ID: &id,
+	}
+	group, response, err := sess.GetDedicatedHostGroup(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Getting Dedicated Host Group (%s): %s\n%s", id, err, response))
+	}
+	setDedicatedHostGroupAttributes(d, group)
+	return nil
+}
+
+// setDedicatedHostGroupAttributes sets the attributes of the dedicated host group shared by the
+// resource and the data source
+func setDedicatedHostGroupAttributes(d *schema.ResourceData, group *vpcv1.DedicatedHostGroup) {
+	d.Set(isDedicatedHostGroupName, *group.Name)
+	d.Set(isDedicatedHostGroupClass, *group.Class)
+	d.Set(isDedicatedHostGroupFamily, *group.Family)
+	d.Set(isDedicatedHostGroupCRN, *group.CRN)
+	d.Set(isDedicatedHostGroupHref, *group.Href)
+	d.Set(isDedicatedHostGroupResourceType, *group.ResourceType)
+	if group.CreatedAt != nil {
+		d.Set(isDedicatedHostGroupCreatedAt, group.CreatedAt.String())
+	}
+	if group.Zone != nil {
+		d.Set(isDedicatedHostGroupZone, *group.Zone.Name)
+	}
+	if group.ResourceGroup != nil {
+		d.Set(isDedicatedHostGroupResourceGroup, *group.ResourceGroup.ID)
+	}
+	hosts := make([]string, 0, len(group.DedicatedHosts))
+	for _, host := range group.DedicatedHosts {
+		hosts = append(hosts, *host.ID)
+	}
+	d.Set(isDedicatedHostGroupDedicatedHosts, hosts)
+	profiles := make([]string, 0, len(group.SupportedInstanceProfiles))
+	for _, profile := range group.SupportedInstanceProfiles {
+		profiles = append(profiles, *profile.Name)
+	}
+	d.Set(isDedicatedHostGroupSupportedInstanceProfiles, profiles)
+}
+
+func resourceIBMISDedicatedHostGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	if d.HasChange(isDedicatedHostGroupName) {
+		name := d.Get(isDedicatedHostGroupName).(string)
+		groupPatchModel := &vpcv1.DedicatedHostGroupPatch{
+			Name: &name,
+		}
+		groupPatch, err := groupPatchModel.AsPatch()
+		if err != nil {
+			return diagFromErr(newAttributeError(isDedicatedHostGroupName, "Error calling asPatch for DedicatedHostGroupPatch", err))
+		}
+		options := &vpcv1.UpdateDedicatedHostGroupOptions{
+			ID:                      &id,
+			DedicatedHostGroupPatch: groupPatch,
+		}
+		_, response, err := sess.UpdateDedicatedHostGroup(options)
+		if err != nil {
+			return diagFromErr(newAttributeError(isDedicatedHostGroupName, fmt.Sprintf("Error Updating Dedicated Host Group (%s)", id), fmt.Errorf("%s\n%s", err, response)))
+		}
+	}
+	return resourceIBMISDedicatedHostGroupRead(ctx, d, meta)
+}
+
+func resourceIBMISDedicatedHostGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	options := &vpcv1.DeleteDedicatedHostGroupOptions{
+		ID: &id,
+	}
+	response, err := sess.DeleteDedicatedHostGroup(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Deleting Dedicated Host Group (%s): %s\n%s", id, err, response))
+	}
+	_, err = isWaitForDedicatedHostGroupDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func isWaitForDedicatedHostGroupDeleted(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for dedicated host group (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isDedicatedHostGroupDeleting},
+		Target:     []string{isDedicatedHostGroupDeleted, ""},
+		Refresh:    isDedicatedHostGroupDeleteRefreshFunc(sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isDedicatedHostGroupDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		options := &vpcv1.GetDedicatedHostGroupOptions{
+			ID: &id,
+		}
+		group, response, err := sess.GetDedicatedHostGroup(options)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return group, isDedicatedHostGroupDeleted, nil
+			}
+			return nil, "", fmt.Errorf("Error Getting Dedicated Host Group: %s\n%s", err, response)
+		}
+		return group, isDedicatedHostGroupDeleting, nil
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISDedicatedHostGroup_basic(t *testing.T) {
+	groupname := fmt.Sprintf("tf-dhgroup-%d", acctest.RandIntRange(10, 100))
+	groupname1 := fmt.Sprintf("tf-dhgroup-upd-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISDedicatedHostGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISDedicatedHostGroupConfig(groupname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISDedicatedHostGroupExists("ibm_is_dedicated_host_group.testacc_dhgroup"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_dedicated_host_group.testacc_dhgroup", "name", groupname),
+					resource.TestCheckResourceAttr(
+						"ibm_is_dedicated_host_group.testacc_dhgroup", "class", dedicatedHostClass),
+					resource.TestCheckResourceAttr(
+						"ibm_is_dedicated_host_group.testacc_dhgroup", "zone", ISZoneName),
+				),
+			},
+			{
+				Config: testAccCheckIBMISDedicatedHostGroupConfig(groupname1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISDedicatedHostGroupExists("ibm_is_dedicated_host_group.testacc_dhgroup"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_dedicated_host_group.testacc_dhgroup", "name", groupname1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISDedicatedHostGroupDestroy(s *terraform.State) error {
+	sess, _ := testAccProvider.Meta().(ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_dedicated_host_group" {
+			continue
+		}
+
+		getgroupoptions := &vpcv1.GetDedicatedHostGroupOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err := sess.GetDedicatedHostGroup(getgroupoptions)
+		if err == nil {
+			return fmt.Errorf("Dedicated host group still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISDedicatedHostGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, _ := testAccProvider.Meta().(ClientSession).VpcV1API()
+		getgroupoptions := &vpcv1.GetDedicatedHostGroupOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err := sess.GetDedicatedHostGroup(getgroupoptions)
+		return err
+	}
+}
+
+func testAccCheckIBMISDedicatedHostGroupConfig(groupname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_dedicated_host_group" "testacc_dhgroup" {
+		name   = "%s"
+		class  = "%s"
+		family = "balanced"
+		zone   = "%s"
+	}`, groupname, dedicatedHostClass, ISZoneName)
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISDedicatedHost_basic(t *testing.T) {
+	groupname := fmt.Sprintf("tf-dhgroup-%d", acctest.RandIntRange(10, 100))
+	hostname := fmt.Sprintf("tf-dhost-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISDedicatedHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISDedicatedHostConfig(groupname, hostname, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISDedicatedHostExists("ibm_is_dedicated_host.testacc_dhost"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_dedicated_host.testacc_dhost", "name", hostname),
+					resource.TestCheckResourceAttr(
+						"ibm_is_dedicated_host.testacc_dhost", "profile", dedicatedHostProfileName),
+					resource.TestCheckResourceAttr(
+						"ibm_is_dedicated_host.testacc_dhost", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_dedicated_host.testacc_dhost", "zone", ISZoneName),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_dedicated_host.testacc_dhost", "host_group", "ibm_is_dedicated_host_group.testacc_dhgroup", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISDedicatedHostConfig(groupname, hostname, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISDedicatedHostExists("ibm_is_dedicated_host.testacc_dhost"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_dedicated_host.testacc_dhost", "instance_placement_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISDedicatedHostDestroy(s *terraform.State) error {
+	sess, _ := testAccProvider.Meta().(ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_dedicated_host" {
+			continue
+		}
+
+		gethostoptions := &vpcv1.GetDedicatedHostOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err := sess.GetDedicatedHost(gethostoptions)
+		if err == nil {
+			return fmt.Errorf("Dedicated host still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISDedicatedHostExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, _ := testAccProvider.Meta().(ClientSession).VpcV1API()
+		gethostoptions := &vpcv1.GetDedicatedHostOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err := sess.GetDedicatedHost(gethostoptions)
+		return err
+	}
+}
+
+func testAccCheckIBMISDedicatedHostConfig(groupname, hostname string, placementEnabled bool) string {
+	return testAccCheckIBMISDedicatedHostGroupConfig(groupname) + fmt.Sprintf(`
+	resource "ibm_is_dedicated_host" "testacc_dhost" {
+		name                       = "%s"
+		profile                    = "%s"
+		host_group                 = ibm_is_dedicated_host_group.testacc_dhgroup.id
+		instance_placement_enabled = %t
+	}`, hostname, dedicatedHostProfileName, placementEnabled)
+}
@@ -7,11 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,12 +34,13 @@ const (
 
 func resourceIBMISFloatingIP() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISFloatingIPCreate,
-		Read:     resourceIBMISFloatingIPRead,
-		Update:   resourceIBMISFloatingIPUpdate,
-		Delete:   resourceIBMISFloatingIPDelete,
-		Exists:   resourceIBMISFloatingIPExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISFloatingIPCreate,
+		ReadContext:   resourceIBMISFloatingIPRead,
+		UpdateContext: resourceIBMISFloatingIPUpdate,
+		DeleteContext: resourceIBMISFloatingIPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISFloatingIP, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -48,7 +49,7 @@ func resourceIBMISFloatingIP() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -107,6 +108,7 @@ func resourceIBMISFloatingIP() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "Floating IP tags",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -168,29 +170,29 @@ func resourceIBMISFloatingIPValidator() *ResourceValidator {
 	return &ibmISFloatingIPResourceValidator
 }
 
-func resourceIBMISFloatingIPCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get(isFloatingIPName).(string)
 	if userDetails.generation == 1 {
-		err := classicFipCreate(d, meta, name)
+		err := classicFipCreate(ctx, d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := fipCreate(d, meta, name)
+		err := fipCreate(ctx, d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISFloatingIPRead(d, meta)
+	return resourceIBMISFloatingIPRead(ctx, d, meta)
 }
-func classicFipCreate(d *schema.ResourceData, meta interface{}, name string) error {
+func classicFipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -228,13 +230,12 @@ func classicFipCreate(d *schema.ResourceData, meta interface{}, name string) err
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
-	_, err = isWaitForClassicInstanceFloatingIP(sess, d.Id(), d)
+	_, err = isWaitForClassicInstanceFloatingIP(ctx, sess, d.Id(), d)
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
-		oldList, newList := d.GetChange(isFloatingIPTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *floatingip.CRN)
 		if err != nil {
 			log.Printf(
@@ -244,7 +245,7 @@ func classicFipCreate(d *schema.ResourceData, meta interface{}, name string) err
 	return nil
 }
 
-func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
+func fipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -289,13 +290,12 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	}
 	d.SetId(*floatingip.ID)
 	log.Printf("[INFO] Floating IP : %s[%s]", *floatingip.ID, *floatingip.Address)
-	_, err = isWaitForInstanceFloatingIP(sess, d.Id(), d)
+	_, err = isWaitForInstanceFloatingIP(ctx, sess, d.Id(), d)
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFloatingIPTags); ok || v != "" {
-		oldList, newList := d.GetChange(isFloatingIPTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *floatingip.CRN)
 		if err != nil {
 			log.Printf(
@@ -305,22 +305,22 @@ func fipCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	return nil
 }
 
-func resourceIBMISFloatingIPRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicFipGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := fipGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -356,7 +356,7 @@ func classicFipGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of vpc Floating IP (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isFloatingIPTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -398,7 +398,7 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of vpc Floating IP (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isFloatingIPTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -414,25 +414,25 @@ func fipGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISFloatingIPUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicFipUpdate(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := fipUpdate(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISFloatingIPRead(d, meta)
+	return resourceIBMISFloatingIPRead(ctx, d, meta)
 }
 
 func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
@@ -440,7 +440,7 @@ func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isFloatingIPTags) {
+	if d.HasChange("tags_all") {
 		options := &vpcclassicv1.GetFloatingIPOptions{
 			ID: &id,
 		}
@@ -448,7 +448,7 @@ func classicFipUpdate(d *schema.ResourceData, meta interface{}, id string) error
 		if err != nil {
 			return fmt.Errorf("Error getting Floating IP: %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isFloatingIPTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *fip.CRN)
 		if err != nil {
 			log.Printf(
@@ -498,7 +498,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isFloatingIPTags) {
+	if d.HasChange("tags_all") {
 		options := &vpcv1.GetFloatingIPOptions{
 			ID: &id,
 		}
@@ -506,7 +506,7 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 		if err != nil {
 			return fmt.Errorf("Error getting Floating IP: %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isFloatingIPTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *fip.CRN)
 		if err != nil {
 			log.Printf(
@@ -550,27 +550,27 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISFloatingIPDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFloatingIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicFipDelete(d, meta, id)
+		err := classicFipDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := fipDelete(d, meta, id)
+		err := fipDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicFipDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicFipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -595,7 +595,7 @@ func classicFipDelete(d *schema.ResourceData, meta interface{}, id string) error
 	if err != nil {
 		return fmt.Errorf("Error Deleting Floating IP : %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicFloatingIPDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -603,7 +603,7 @@ func classicFipDelete(d *schema.ResourceData, meta interface{}, id string) error
 	return nil
 }
 
-func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func fipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -627,7 +627,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return fmt.Errorf("Error Deleting Floating IP : %s\n%s", err, response)
 	}
-	_, err = isWaitForFloatingIPDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForFloatingIPDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -635,59 +635,7 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISFloatingIPExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-
-	if userDetails.generation == 1 {
-		exists, err := classicFipExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := fipExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicFipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getFloatingIpOptions := &vpcclassicv1.GetFloatingIPOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetFloatingIP(getFloatingIpOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting floating IP: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func fipExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getFloatingIpOptions := &vpcv1.GetFloatingIPOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetFloatingIP(getFloatingIpOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting floating IP: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func isWaitForClassicFloatingIPDeleted(fip *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicFloatingIPDeleted(ctx context.Context, fip *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for FloatingIP (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -699,7 +647,7 @@ func isWaitForClassicFloatingIPDeleted(fip *vpcclassicv1.VpcClassicV1, id string
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicFloatingIPDeleteRefreshFunc(fip *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -719,7 +667,7 @@ func isClassicFloatingIPDeleteRefreshFunc(fip *vpcclassicv1.VpcClassicV1, id str
 	}
 }
 
-func isWaitForFloatingIPDeleted(fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForFloatingIPDeleted(ctx context.Context, fip *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for FloatingIP (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -731,7 +679,7 @@ func isWaitForFloatingIPDeleted(fip *vpcv1.VpcV1, id string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -751,7 +699,7 @@ func isFloatingIPDeleteRefreshFunc(fip *vpcv1.VpcV1, id string) resource.StateRe
 	}
 }
 
-func isWaitForClassicInstanceFloatingIP(floatingipC *vpcclassicv1.VpcClassicV1, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForClassicInstanceFloatingIP(ctx context.Context, floatingipC *vpcclassicv1.VpcClassicV1, id string, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for floating IP (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -763,7 +711,7 @@ func isWaitForClassicInstanceFloatingIP(floatingipC *vpcclassicv1.VpcClassicV1,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicInstanceFloatingIPRefreshFunc(floatingipC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -784,7 +732,7 @@ func isClassicInstanceFloatingIPRefreshFunc(floatingipC *vpcclassicv1.VpcClassic
 	}
 }
 
-func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceFloatingIP(ctx context.Context, floatingipC *vpcv1.VpcV1, id string, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for floating IP (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -796,7 +744,7 @@ func isWaitForInstanceFloatingIP(floatingipC *vpcv1.VpcV1, id string, d *schema.
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isInstanceFloatingIPRefreshFunc(floatingipC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
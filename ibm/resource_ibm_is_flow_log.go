@@ -7,10 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -34,12 +34,13 @@ const (
 
 func resourceIBMISFlowLog() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISFlowLogCreate,
-		Read:     resourceIBMISFlowLogRead,
-		Update:   resourceIBMISFlowLogUpdate,
-		Delete:   resourceIBMISFlowLogDelete,
-		Exists:   resourceIBMISFlowLogExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISFlowLogCreate,
+		ReadContext:   resourceIBMISFlowLogRead,
+		UpdateContext: resourceIBMISFlowLogUpdate,
+		DeleteContext: resourceIBMISFlowLogDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISFlowLog, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -49,7 +50,7 @@ func resourceIBMISFlowLog() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -135,6 +136,7 @@ func resourceIBMISFlowLog() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "Tags for the VPC Flow logs",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -187,10 +189,10 @@ func resourceIBMISFlowLogValidator() *ResourceValidator {
 	return &ibmISFlowLogValidator
 }
 
-func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	createFlowLogCollectorOptionsModel := &vpcv1.CreateFlowLogCollectorOptions{}
@@ -220,15 +222,14 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 
 	flowlogCollector, response, err := sess.CreateFlowLogCollector(createFlowLogCollectorOptionsModel)
 	if err != nil {
-		return fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Create Flow Log Collector err %s\n%s", err, response))
 	}
 	d.SetId(*flowlogCollector.ID)
 
 	log.Printf("Flow log collector : %s", *flowlogCollector.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isFlowLogTags); ok || v != "" {
-		oldList, newList := d.GetChange(isFlowLogTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
 			log.Printf(
@@ -236,14 +237,13 @@ func resourceIBMISFlowLogCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return resourceIBMISFlowLogRead(d, meta)
+	return resourceIBMISFlowLogRead(ctx, d, meta)
 }
 
-func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMISFlowLogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -253,7 +253,11 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Flow Log Collector: %s\n%s", err, response)
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Getting Flow Log Collector: %s\n%s", err, response))
 	}
 
 	if flowlogCollector.Name != nil {
@@ -300,10 +304,10 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf(
 			"Error on get of resource vpc flow log (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isFlowLogTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set(ResourceControllerURL, controller+"/vpc-ext/network/flowLogs")
@@ -319,11 +323,11 @@ func resourceIBMISFlowLogRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -333,11 +337,11 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 	flowlogCollector, response, err := sess.GetFlowLogCollector(getOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Flow Log Collector: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error Getting Flow Log Collector: %s\n%s", err, response))
 	}
 
-	if d.HasChange(isFlowLogTags) {
-		oldList, newList := d.GetChange(isFlowLogTags)
+	if d.HasChange("tags_all") {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *flowlogCollector.CRN)
 		if err != nil {
 			log.Printf(
@@ -357,23 +361,23 @@ func resourceIBMISFlowLogUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		flowLogCollectorPatch, err := flowLogCollectorPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for FlowLogCollectorPatch: %s", err)
+			return diagFromErr(fmt.Errorf("Error calling asPatch for FlowLogCollectorPatch: %s", err))
 		}
 		updoptions.FlowLogCollectorPatch = flowLogCollectorPatch
 		_, response, err = sess.UpdateFlowLogCollector(updoptions)
 		if err != nil {
-			return fmt.Errorf("Error updating flow log collector:%s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error updating flow log collector:%s\n%s", err, response))
 		}
 	}
 
-	return resourceIBMISFlowLogRead(d, meta)
+	return resourceIBMISFlowLogRead(ctx, d, meta)
 }
 
-func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISFlowLogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	ID := d.Id()
 	delOptions := &vpcv1.DeleteFlowLogCollectorOptions{
@@ -382,31 +386,9 @@ func resourceIBMISFlowLogDelete(d *schema.ResourceData, meta interface{}) error
 	response, err := sess.DeleteFlowLogCollector(delOptions)
 
 	if err != nil && response.StatusCode != 404 {
-		return fmt.Errorf("Error deleting flow log collector:%s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error deleting flow log collector:%s\n%s", err, response))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMISFlowLogExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	ID := d.Id()
-
-	getOptions := &vpcv1.GetFlowLogCollectorOptions{
-		ID: &ID,
-	}
-	_, response, err := sess.GetFlowLogCollector(getOptions)
-	if err != nil && response.StatusCode != 404 {
-		return false, fmt.Errorf("Error Getting Flow Log Collector : %s\n%s", err, response)
-	}
-	if response.StatusCode == 404 {
-		d.SetId("")
-		return false, nil
-	}
-	return true, nil
-}
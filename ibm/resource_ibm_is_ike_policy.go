@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -30,12 +32,13 @@ const (
 
 func resourceIBMISIKEPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISIKEPolicyCreate,
-		Read:     resourceIBMISIKEPolicyRead,
-		Update:   resourceIBMISIKEPolicyUpdate,
-		Delete:   resourceIBMISIKEPolicyDelete,
-		Exists:   resourceIBMISIKEPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISIKEPolicyCreate,
+		ReadContext:   resourceIBMISIKEPolicyRead,
+		UpdateContext: resourceIBMISIKEPolicyUpdate,
+		DeleteContext: resourceIBMISIKEPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISIKEPolicy, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			isIKEName: {
@@ -190,10 +193,10 @@ func resourceIBMISIKEValidator() *ResourceValidator {
 	return &ibmISIKEResourceValidator
 }
 
-func resourceIBMISIKEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("[DEBUG] IKE Policy create")
@@ -205,15 +208,15 @@ func resourceIBMISIKEPolicyCreate(d *schema.ResourceData, meta interface{}) erro
 	if userDetails.generation == 1 {
 		err := classicIkepCreate(d, meta, authenticationAlg, encryptionAlg, name, dhGroup)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := ikepCreate(d, meta, authenticationAlg, encryptionAlg, name, dhGroup)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISIKEPolicyRead(d, meta)
+	return resourceIBMISIKEPolicyRead(ctx, d, meta)
 }
 
 func classicIkepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, encryptionAlg, name string, dhGroup int64) error {
@@ -297,21 +300,21 @@ func ikepCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, enc
 	return nil
 }
 
-func resourceIBMISIKEPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicIkepGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := ikepGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -433,25 +436,25 @@ func ikepGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISIKEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 
 	if userDetails.generation == 1 {
 		err := classicIkepUpdate(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := ikepUpdate(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISIKEPolicyRead(d, meta)
+	return resourceIBMISIKEPolicyRead(ctx, d, meta)
 }
 
 func classicIkepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
@@ -528,22 +531,22 @@ func ikepUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISIKEPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIKEPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicIkepDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := ikepDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -605,57 +608,3 @@ func ikepDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMISIKEPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-
-	if userDetails.generation == 1 {
-		exists, err := classicikepExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := ikepExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicikepExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	options := &vpcclassicv1.GetIkePolicyOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetIkePolicy(options)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
-	}
-
-	return true, nil
-}
-
-func ikepExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	options := &vpcv1.GetIkePolicyOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetIkePolicy(options)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting IKE Policy(%s): %s\n%s", id, err, response)
-	}
-
-	return true, nil
-}
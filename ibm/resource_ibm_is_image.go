@@ -7,11 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -40,12 +40,13 @@ const (
 
 func resourceIBMISImage() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISImageCreate,
-		Read:     resourceIBMISImageRead,
-		Update:   resourceIBMISImageUpdate,
-		Delete:   resourceIBMISImageDelete,
-		Exists:   resourceIBMISImageExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISImageCreate,
+		ReadContext:   resourceIBMISImageRead,
+		UpdateContext: resourceIBMISImageUpdate,
+		DeleteContext: resourceIBMISImageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISImage, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -55,7 +56,7 @@ func resourceIBMISImage() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -95,6 +96,7 @@ func resourceIBMISImage() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "Tags for the image",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			isImageOperatingSystem: {
 				Type:        schema.TypeString,
@@ -190,10 +192,10 @@ func resourceIBMISImageValidator() *ResourceValidator {
 	return &ibmISImageResourceValidator
 }
 
-func resourceIBMISImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("[DEBUG] Image create")
@@ -202,20 +204,20 @@ func resourceIBMISImageCreate(d *schema.ResourceData, meta interface{}) error {
 	operatingSystem := d.Get(isImageOperatingSystem).(string)
 
 	if userDetails.generation == 1 {
-		err := classicImgCreate(d, meta, href, name, operatingSystem)
+		err := classicImgCreate(ctx, d, meta, href, name, operatingSystem)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := imgCreate(d, meta, href, name, operatingSystem)
+		err := imgCreate(ctx, d, meta, href, name, operatingSystem)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISImageRead(d, meta)
+	return resourceIBMISImageRead(ctx, d, meta)
 }
 
-func classicImgCreate(d *schema.ResourceData, meta interface{}, href, name, operatingSystem string) error {
+func classicImgCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, href, name, operatingSystem string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -245,13 +247,12 @@ func classicImgCreate(d *schema.ResourceData, meta interface{}, href, name, oper
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Floating IP : %s", *image.ID)
-	_, err = isWaitForClassicImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicImageAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
-		oldList, newList := d.GetChange(isImageTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
 			log.Printf(
@@ -261,7 +262,7 @@ func classicImgCreate(d *schema.ResourceData, meta interface{}, href, name, oper
 	return nil
 }
 
-func imgCreate(d *schema.ResourceData, meta interface{}, href, name, operatingSystem string) error {
+func imgCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, href, name, operatingSystem string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -302,13 +303,12 @@ func imgCreate(d *schema.ResourceData, meta interface{}, href, name, operatingSy
 	}
 	d.SetId(*image.ID)
 	log.Printf("[INFO] Image ID : %s", *image.ID)
-	_, err = isWaitForImageAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForImageAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isImageTags); ok || v != "" {
-		oldList, newList := d.GetChange(isImageTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
 			log.Printf(
@@ -318,7 +318,7 @@ func imgCreate(d *schema.ResourceData, meta interface{}, href, name, operatingSy
 	return nil
 }
 
-func isWaitForClassicImageAvailable(imageC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicImageAvailable(ctx context.Context, imageC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -330,7 +330,7 @@ func isWaitForClassicImageAvailable(imageC *vpcclassicv1.VpcClassicV1, id string
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicImageRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -350,7 +350,7 @@ func isClassicImageRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) res
 		return image, isImageProvisioning, nil
 	}
 }
-func isWaitForImageAvailable(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageAvailable(ctx context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -362,7 +362,7 @@ func isWaitForImageAvailable(imageC *vpcv1.VpcV1, id string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -383,10 +383,10 @@ func isImageRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFun
 	}
 }
 
-func resourceIBMISImageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
@@ -400,15 +400,15 @@ func resourceIBMISImageUpdate(d *schema.ResourceData, meta interface{}) error {
 	if userDetails.generation == 1 {
 		err := classicImgUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := imgUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISImageRead(d, meta)
+	return resourceIBMISImageRead(ctx, d, meta)
 }
 
 func classicImgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
@@ -416,7 +416,7 @@ func classicImgUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isImageTags) {
+	if d.HasChange("tags_all") {
 		options := &vpcclassicv1.GetImageOptions{
 			ID: &id,
 		}
@@ -424,7 +424,7 @@ func classicImgUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		if err != nil {
 			return fmt.Errorf("Error getting Image IP: %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isImageTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
 			log.Printf(
@@ -457,7 +457,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isImageTags) {
+	if d.HasChange("tags_all") {
 		options := &vpcv1.GetImageOptions{
 			ID: &id,
 		}
@@ -465,7 +465,7 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		if err != nil {
 			return fmt.Errorf("Error getting Image IP: %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isImageTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *image.CRN)
 		if err != nil {
 			log.Printf(
@@ -492,22 +492,22 @@ func imgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	return nil
 }
 
-func resourceIBMISImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicImgGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := imgGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -543,7 +543,7 @@ func classicImgGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc Image (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isImageTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -603,7 +603,7 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc Image (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isImageTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -618,27 +618,27 @@ func imgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicImgDelete(d, meta, id)
+		err := classicImgDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := imgDelete(d, meta, id)
+		err := imgDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicImgDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicImgDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -661,7 +661,7 @@ func classicImgDelete(d *schema.ResourceData, meta interface{}, id string) error
 	if err != nil {
 		return fmt.Errorf("Error Deleting Image : %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicImageDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -669,7 +669,7 @@ func classicImgDelete(d *schema.ResourceData, meta interface{}, id string) error
 	return nil
 }
 
-func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func imgDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -692,7 +692,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return fmt.Errorf("Error Deleting Image : %s\n%s", err, response)
 	}
-	_, err = isWaitForImageDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForImageDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -700,7 +700,7 @@ func imgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicImageDeleted(imageC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicImageDeleted(ctx context.Context, imageC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -712,7 +712,7 @@ func isWaitForClassicImageDeleted(imageC *vpcclassicv1.VpcClassicV1, id string,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicImageDeleteRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -731,7 +731,7 @@ func isClassicImageDeleteRefreshFunc(imageC *vpcclassicv1.VpcClassicV1, id strin
 		return image, isImageDeleting, err
 	}
 }
-func isWaitForImageDeleted(imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForImageDeleted(ctx context.Context, imageC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for image (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -743,7 +743,7 @@ func isWaitForImageDeleted(imageC *vpcv1.VpcV1, id string, timeout time.Duration
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -762,54 +762,3 @@ func isImageDeleteRefreshFunc(imageC *vpcv1.VpcV1, id string) resource.StateRefr
 		return image, isImageDeleting, err
 	}
 }
-func resourceIBMISImageExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-
-	if userDetails.generation == 1 {
-		exists, err := classicImgExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := imgExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicImgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	options := &vpcclassicv1.GetImageOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetImage(options)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Image: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func imgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	options := &vpcv1.GetImageOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetImage(options)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Image: %s\n%s", err, response)
-	}
-	return true, nil
-}
@@ -7,11 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -61,6 +61,8 @@ const (
 	isInstanceGpuModel                = "model"
 	isInstanceMemory                  = "memory"
 	isInstanceStatus                  = "status"
+	isInstanceAction                  = "action"
+	isInstanceDesiredStatus           = "desired_status"
 
 	isEnableCleanDelete        = "wait_before_delete"
 	isInstanceProvisioning     = "provisioning"
@@ -76,11 +78,21 @@ const (
 	isInstanceStatusRunning        = "running"
 	isInstanceStatusFailed         = "failed"
 
+	isInstanceActionStart  = "start"
+	isInstanceActionStop   = "stop"
+	isInstanceActionReboot = "reboot"
+
 	isInstanceBootName       = "name"
 	isInstanceBootSize       = "size"
 	isInstanceBootIOPS       = "iops"
 	isInstanceBootEncryption = "encryption"
 	isInstanceBootProfile    = "profile"
+	isInstanceBootSnapshot   = "source_snapshot"
+
+	isInstancePlacementTarget                   = "placement_target"
+	isInstancePlacementTargetDedicatedHost      = "dedicated_host"
+	isInstancePlacementTargetDedicatedHostGroup = "dedicated_host_group"
+	isInstancePlacementTargetPlacementGroup     = "placement_group"
 
 	isInstanceVolumeAttachments = "volume_attachments"
 	isInstanceVolumeAttaching   = "attaching"
@@ -91,12 +103,13 @@ const (
 
 func resourceIBMISInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisInstanceCreate,
-		Read:     resourceIBMisInstanceRead,
-		Update:   resourceIBMisInstanceUpdate,
-		Delete:   resourceIBMisInstanceDelete,
-		Exists:   resourceIBMisInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisInstanceCreate,
+		ReadContext:   resourceIBMisInstanceRead,
+		UpdateContext: resourceIBMisInstanceUpdate,
+		DeleteContext: resourceIBMisInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISInstance, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -106,7 +119,13 @@ func resourceIBMISInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISInstanceProfileCustomizeDiff(diff, v)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMISInstanceActionCustomizeDiff(diff)
 			},
 		),
 
@@ -135,9 +154,24 @@ func resourceIBMISInstance() *schema.Resource {
 
 			isInstanceProfile: {
 				Type:        schema.TypeString,
-				ForceNew:    true,
 				Required:    true,
-				Description: "Profile info",
+				Description: "Profile info, the instance is stopped and started to be resized in place",
+			},
+
+			isInstanceAction: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  InvokeValidator("ibm_is_instance", isInstanceAction),
+				ConflictsWith: []string{isInstanceDesiredStatus},
+				Description:   "The power action of the instance, start, stop or reboot",
+			},
+
+			isInstanceDesiredStatus: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  InvokeValidator("ibm_is_instance", isInstanceDesiredStatus),
+				ConflictsWith: []string{isInstanceAction},
+				Description:   "The desired status of the instance, running or stopped",
 			},
 
 			isInstanceKeys: {
@@ -157,6 +191,7 @@ func resourceIBMISInstance() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "list of tags for the instance",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			isEnableCleanDelete: {
 				Type:        schema.TypeBool,
@@ -295,10 +330,12 @@ func resourceIBMISInstance() *schema.Resource {
 			},
 
 			isInstanceImage: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
-				Description: "image name",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{isInstanceImage, "boot_volume.0.source_snapshot"},
+				Description:  "image name",
 			},
 
 			isInstanceBootVolume: {
@@ -331,13 +368,23 @@ func resourceIBMISInstance() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						isInstanceBootSnapshot: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The ID of the snapshot the boot volume is restored from",
+						},
 					},
 				},
 			},
 
+			isInstancePlacementTarget: instancePlacementTargetSchema(),
+
 			isInstanceVolumes: {
 				Type:        schema.TypeSet,
 				Optional:    true,
+				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Set:         schema.HashString,
 				Description: "List of volumes",
@@ -467,12 +514,26 @@ func resourceIBMISInstanceValidator() *ResourceValidator {
 			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
 			MinValueLength:             1,
 			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isInstanceAction,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "start, stop, reboot"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isInstanceDesiredStatus,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "running, stopped"})
 
 	ibmISInstanceValidator := ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema}
 	return &ibmISInstanceValidator
 }
 
-func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+func classicInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -637,14 +698,13 @@ func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, na
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForClassicInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForClassicInstanceAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
@@ -654,15 +714,12 @@ func classicInstanceCreate(d *schema.ResourceData, meta interface{}, profile, na
 	return nil
 }
 
-func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
+func instanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
 	instanceproto := &vpcv1.InstancePrototype{
-		Image: &vpcv1.ImageIdentity{
-			ID: &image,
-		},
 		Zone: &vpcv1.ZoneIdentity{
 			Name: &zone,
 		},
@@ -674,6 +731,14 @@ func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpc
 			ID: &vpcID,
 		},
 	}
+	if image != "" {
+		instanceproto.Image = &vpcv1.ImageIdentity{
+			ID: &image,
+		}
+	}
+	if placementTarget, ok := d.GetOk(isInstancePlacementTarget); ok {
+		instanceproto.PlacementTarget = expandInstancePlacementTarget(placementTarget.([]interface{}))
+	}
 	if boot, ok := d.GetOk(isInstanceBootVolume); ok {
 		bootvol := boot.([]interface{})[0].(map[string]interface{})
 		var volTemplate = &vpcv1.VolumePrototypeInstanceByImageContext{}
@@ -816,6 +881,9 @@ func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpc
 	options := &vpcv1.CreateInstanceOptions{
 		InstancePrototype: instanceproto,
 	}
+	if snapshot, ok := d.GetOk("boot_volume.0.source_snapshot"); ok {
+		options.InstancePrototype = instancePrototypeBySourceSnapshot(instanceproto, snapshot.(string))
+	}
 
 	instance, response, err := sess.CreateInstance(options)
 	if err != nil {
@@ -827,14 +895,13 @@ func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpc
 	log.Printf("[INFO] Instance : %s", *instance.ID)
 	d.Set(isInstanceStatus, instance.Status)
 
-	_, err = isWaitForInstanceAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
+	_, err = isWaitForInstanceAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate), d)
 	if err != nil {
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isInstanceTags); ok || v != "" {
-		oldList, newList := d.GetChange(isInstanceTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
@@ -844,10 +911,10 @@ func instanceCreate(d *schema.ResourceData, meta interface{}, profile, name, vpc
 	return nil
 }
 
-func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	profile := d.Get(isInstanceProfile).(string)
@@ -857,21 +924,27 @@ func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	image := d.Get(isInstanceImage).(string)
 
 	if userDetails.generation == 1 {
-		err := classicInstanceCreate(d, meta, profile, name, vpcID, zone, image)
+		if _, ok := d.GetOk("boot_volume.0.source_snapshot"); ok {
+			return diagFromErr(fmt.Errorf("boot_volume.0.source_snapshot is not supported by the classic infrastructure"))
+		}
+		if _, ok := d.GetOk(isInstancePlacementTarget); ok {
+			return diagFromErr(fmt.Errorf("%s is not supported by the classic infrastructure", isInstancePlacementTarget))
+		}
+		err := classicInstanceCreate(ctx, d, meta, profile, name, vpcID, zone, image)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := instanceCreate(d, meta, profile, name, vpcID, zone, image)
+		err := instanceCreate(ctx, d, meta, profile, name, vpcID, zone, image)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMisInstanceUpdate(d, meta)
+	return resourceIBMisInstanceUpdate(ctx, d, meta)
 }
 
-func isWaitForClassicInstanceAvailable(instanceC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForClassicInstanceAvailable(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -883,10 +956,10 @@ func isWaitForClassicInstanceAvailable(instanceC *vpcclassicv1.VpcClassicV1, id
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceAvailable(ctx context.Context, instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
 	log.Printf("Waiting for instance (%s) to be available.", id)
 
 	communicator := make(chan interface{})
@@ -902,9 +975,9 @@ func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.
 
 	if v, ok := d.GetOk("force_recovery_time"); ok {
 		forceTimeout := v.(int)
-		go isRestartStartAction(instanceC, id, d, forceTimeout, communicator)
+		go isRestartStartAction(ctx, instanceC, id, d, forceTimeout, communicator)
 	}
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicInstanceRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id string, d *schema.ResourceData) resource.StateRefreshFunc {
@@ -955,7 +1028,7 @@ func isInstanceRefreshFunc(instanceC *vpcv1.VpcV1, id string, d *schema.Resource
 	}
 }
 
-func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
+func isRestartStartAction(ctx context.Context, instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
 	subticker := time.NewTicker(time.Duration(forceTimeout) * time.Minute)
 	//subticker := time.NewTicker(time.Duration(forceTimeout) * time.Second)
 	for {
@@ -974,7 +1047,7 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 				return
 			}
 			waitTimeout := time.Duration(1) * time.Minute
-			_, _ = isWaitForInstanceActionStop(instanceC, waitTimeout, id, d)
+			_, _ = isWaitForInstanceActionStop(ctx, instanceC, waitTimeout, id, d)
 			actiontype = "start"
 			createinsactoptions = &vpcv1.CreateInstanceActionOptions{
 				InstanceID: &id,
@@ -993,21 +1066,21 @@ func isRestartStartAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceD
 		}
 	}
 }
-func resourceIBMisInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	ID := d.Id()
 	if userDetails.generation == 1 {
 		err := classicInstanceGet(d, meta, ID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := instanceGet(d, meta, ID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -1175,7 +1248,7 @@ func classicInstanceGet(d *schema.ResourceData, meta interface{}, id string) err
 		log.Printf(
 			"Error on get of resource vpc Instance (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isInstanceTags, tags)
+	setResourceTags(d, meta, tags)
 
 	controller, err := getBaseController(meta)
 	if err != nil {
@@ -1212,6 +1285,11 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	if instance.Profile != nil {
 		d.Set(isInstanceProfile, *instance.Profile.Name)
 	}
+	placementTarget, response, err := getVPCInstancePlacementTarget(instanceC, id)
+	if err != nil {
+		return fmt.Errorf("Error Getting the placement target of the Instance: %s\n%s", err, response)
+	}
+	d.Set(isInstancePlacementTarget, flattenInstancePlacementTarget(placementTarget))
 	cpuList := make([]map[string]interface{}, 0)
 	if instance.Vcpu != nil {
 		currentCPU := map[string]interface{}{}
@@ -1348,6 +1426,9 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 				if vol.EncryptionKey != nil {
 					bootVol[isInstanceBootEncryption] = *vol.EncryptionKey.CRN
 				}
+				if vol.SourceSnapshot != nil {
+					bootVol[isInstanceBootSnapshot] = *vol.SourceSnapshot.ID
+				}
 			}
 		}
 		bootVolList = append(bootVolList, bootVol)
@@ -1358,7 +1439,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc Instance (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isInstanceTags, tags)
+	setResourceTags(d, meta, tags)
 
 	controller, err := getBaseController(meta)
 	if err != nil {
@@ -1375,7 +1456,7 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func classicInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	instanceC, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -1399,9 +1480,9 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				vol, response, err := instanceC.CreateInstanceVolumeAttachment(createvolattoptions)
 				if err != nil {
-					return fmt.Errorf("Error while attaching volume %q for instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return newAttributeError(isInstanceVolumes, fmt.Sprintf("Error while attaching volume %q for instance %s", add[i], d.Id()), fmt.Errorf("%s\n%s", err, response))
 				}
-				_, err = isWaitForClassicInstanceVolumeAttached(instanceC, d, id, *vol.ID)
+				_, err = isWaitForClassicInstanceVolumeAttached(ctx, instanceC, d, id, *vol.ID)
 				if err != nil {
 					return err
 				}
@@ -1425,9 +1506,9 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						response, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing volume %q for instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return newAttributeError(isInstanceVolumes, fmt.Sprintf("Error while removing volume %q for instance %s", remove[i], d.Id()), fmt.Errorf("%s\n%s", err, response))
 						}
-						_, err = isWaitForClassicInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+						_, err = isWaitForClassicInstanceVolumeDetached(ctx, instanceC, d, d.Id(), *vol.ID)
 						if err != nil {
 							return err
 						}
@@ -1453,9 +1534,9 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return newAttributeError(isInstancePrimaryNetworkInterface, fmt.Sprintf("Error while creating security group %q for primary network interface of instance %s", add[i], d.Id()), fmt.Errorf("%s\n%s", err, response))
 				}
-				_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForClassicInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return err
 				}
@@ -1471,9 +1552,9 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+					return newAttributeError(isInstancePrimaryNetworkInterface, fmt.Sprintf("Error while removing security group %q for primary network interface of instance %s", remove[i], d.Id()), fmt.Errorf("%s\n%s", err, response))
 				}
-				_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForClassicInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return err
 				}
@@ -1515,9 +1596,9 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+							return newAttributeError(isInstanceNetworkInterfaces, fmt.Sprintf("Error while creating security group %q for network interface of instance %s", add[i], d.Id()), fmt.Errorf("%s\n%s", err, response))
 						}
-						_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForClassicInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							return err
 						}
@@ -1534,9 +1615,9 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return newAttributeError(isInstanceNetworkInterfaces, fmt.Sprintf("Error while removing security group %q for network interface of instance %s", remove[i], d.Id()), fmt.Errorf("%s\n%s", err, response))
 						}
-						_, err = isWaitForClassicInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForClassicInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							return err
 						}
@@ -1583,7 +1664,7 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange(isInstanceTags) {
+	if d.HasChange("tags_all") {
 		getinsOptions := &vpcclassicv1.GetInstanceOptions{
 			ID: &id,
 		}
@@ -1591,7 +1672,7 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			log.Printf("Error Getting Instance: %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isInstanceTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
@@ -1601,7 +1682,7 @@ func classicInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func instanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1624,16 +1705,16 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			for i := range add {
 				createvolattoptions := &vpcv1.CreateInstanceVolumeAttachmentOptions{
 					InstanceID: &id,
-					Volume: &vpcv1.VolumeIdentity{
+					Volume: &vpcv1.VolumeAttachmentPrototypeVolumeVolumeIdentity{
 						ID: &add[i],
 					},
 					DeleteVolumeOnInstanceDelete: &volautoDelete,
 				}
 				vol, _, err := instanceC.CreateInstanceVolumeAttachment(createvolattoptions)
 				if err != nil {
-					return fmt.Errorf("Error while attaching volume %q for instance %s: %q", add[i], d.Id(), err)
+					return newAttributeError(isInstanceVolumes, fmt.Sprintf("Error while attaching volume %q for instance %s", add[i], d.Id()), err)
 				}
-				_, err = isWaitForInstanceVolumeAttached(instanceC, d, id, *vol.ID)
+				_, err = isWaitForInstanceVolumeAttached(ctx, instanceC, d.Timeout(schema.TimeoutUpdate), id, *vol.ID)
 				if err != nil {
 					return err
 				}
@@ -1657,9 +1738,9 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing volume %q for instance %s: %q", remove[i], d.Id(), err)
+							return newAttributeError(isInstanceVolumes, fmt.Sprintf("Error while removing volume %q for instance %s", remove[i], d.Id()), err)
 						}
-						_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+						_, err = isWaitForInstanceVolumeDetached(ctx, instanceC, d.Timeout(schema.TimeoutUpdate), d.Id(), *vol.ID)
 						if err != nil {
 							return err
 						}
@@ -1685,9 +1766,9 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return newAttributeError(isInstancePrimaryNetworkInterface, fmt.Sprintf("Error while creating security group %q for primary network interface of instance %s", add[i], d.Id()), fmt.Errorf("%s\n%s", err, response))
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return err
 				}
@@ -1703,9 +1784,9 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
 				if err != nil {
-					return fmt.Errorf("Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+					return newAttributeError(isInstancePrimaryNetworkInterface, fmt.Sprintf("Error while removing security group %q for primary network interface of instance %s", remove[i], d.Id()), fmt.Errorf("%s\n%s", err, response))
 				}
-				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+				_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
 					return err
 				}
@@ -1734,9 +1815,9 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
 		if err != nil {
-			return fmt.Errorf("Error while updating name %s for primary network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+			return newAttributeError(isInstancePrimaryNetworkInterface, fmt.Sprintf("Error while updating name %s for primary network interface of instance %s", newName, d.Id()), fmt.Errorf("%s\n%s", err, response))
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
 			return err
 		}
@@ -1764,9 +1845,9 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, response, err := instanceC.AddSecurityGroupNetworkInterface(createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+							return newAttributeError(isInstanceNetworkInterfaces, fmt.Sprintf("Error while creating security group %q for network interface of instance %s", add[i], d.Id()), fmt.Errorf("%s\n%s", err, response))
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							return err
 						}
@@ -1783,9 +1864,9 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						response, err := instanceC.RemoveSecurityGroupNetworkInterface(deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return newAttributeError(isInstanceNetworkInterfaces, fmt.Sprintf("Error while removing security group %q for network interface of instance %s", remove[i], d.Id()), fmt.Errorf("%s\n%s", err, response))
 						}
-						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+						_, err = isWaitForInstanceAvailable(ctx, instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
 							return err
 						}
@@ -1816,7 +1897,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 				_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
 				if err != nil {
-					return fmt.Errorf("Error while updating name %s for network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+					return newAttributeError(isInstanceNetworkInterfaces, fmt.Sprintf("Error while updating name %s for network interface of instance %s", newName, d.Id()), fmt.Errorf("%s\n%s", err, response))
 				}
 				if err != nil {
 					return err
@@ -1826,6 +1907,20 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	}
 
+	if d.HasChange(isInstanceProfile) && !d.IsNewResource() {
+		err := instanceResize(ctx, instanceC, d, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	if action := instancePowerAction(d.Get(isInstanceDesiredStatus).(string), d.Get(isInstanceAction).(string)); action != "" && d.HasChanges(isInstanceAction, isInstanceDesiredStatus, isInstanceStatus) {
+		err := instanceAction(ctx, instanceC, d, id, action)
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange(isInstanceName) {
 		name := d.Get(isInstanceName).(string)
 		updnetoptions := &vpcv1.UpdateInstanceOptions{
@@ -1854,8 +1949,8 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
 	}
-	if d.HasChange(isInstanceTags) {
-		oldList, newList := d.GetChange(isInstanceTags)
+	if d.HasChange("tags_all") {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
 			log.Printf(
@@ -1865,28 +1960,28 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
-		err := classicInstanceUpdate(d, meta)
+		err := classicInstanceUpdate(ctx, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := instanceUpdate(d, meta)
+		err := instanceUpdate(ctx, d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMisInstanceRead(d, meta)
+	return resourceIBMisInstanceRead(ctx, d, meta)
 }
 
-func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -1915,7 +2010,7 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 		}
 		return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicInstanceActionStop(instanceC, d, meta, id)
+	_, err = isWaitForClassicInstanceActionStop(ctx, instanceC, d, meta, id)
 	if err != nil {
 		return err
 	}
@@ -1937,7 +2032,7 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 			if err != nil {
 				return fmt.Errorf("Error while removing volume attachment %q for instance %s: %q", *vol.ID, d.Id(), err)
 			}
-			_, err = isWaitForClassicInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+			_, err = isWaitForClassicInstanceVolumeDetached(ctx, instanceC, d, d.Id(), *vol.ID)
 			if err != nil {
 				return err
 			}
@@ -1953,12 +2048,12 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForClassicInstanceDelete(instanceC, d, d.Id())
+	_, err = isWaitForClassicInstanceDelete(ctx, instanceC, d, d.Id())
 	if err != nil {
 		return err
 	}
 	if _, ok := d.GetOk(isInstanceBootVolume); ok {
-		_, err = isWaitForClassicVolumeDeleted(instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForClassicVolumeDeleted(ctx, instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -1966,7 +2061,7 @@ func classicInstanceDelete(d *schema.ResourceData, meta interface{}, id string)
 	return nil
 }
 
-func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func instanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -2000,7 +2095,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 			}
 			return fmt.Errorf("Error Creating Instance Action: %s\n%s", err, response)
 		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutDelete), id, d)
+		_, err = isWaitForInstanceActionStop(ctx, instanceC, d.Timeout(schema.TimeoutDelete), id, d)
 		if err != nil {
 			return err
 		}
@@ -2022,7 +2117,7 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 				if err != nil {
 					return fmt.Errorf("Error while removing volume Attachment %q for instance %s: %q", *vol.ID, d.Id(), err)
 				}
-				_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
+				_, err = isWaitForInstanceVolumeDetached(ctx, instanceC, d.Timeout(schema.TimeoutDelete), d.Id(), *vol.ID)
 				if err != nil {
 					return err
 				}
@@ -2040,12 +2135,12 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		return err
 	}
 	if cleanDelete {
-		_, err = isWaitForInstanceDelete(instanceC, d, d.Id())
+		_, err = isWaitForInstanceDelete(ctx, instanceC, d, d.Id())
 		if err != nil {
 			return err
 		}
 		if _, ok := d.GetOk(isInstanceBootVolume); ok {
-			_, err = isWaitForVolumeDeleted(instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
+			_, err = isWaitForVolumeDeleted(ctx, instanceC, bootvolid, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
 				return err
 			}
@@ -2054,22 +2149,22 @@ func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMisInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicInstanceDelete(d, meta, id)
+		err := classicInstanceDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := instanceDelete(d, meta, id)
+		err := instanceDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -2077,58 +2172,7 @@ func resourceIBMisInstanceDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func classicInstanceExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	instanceC, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getinsOptions := &vpcclassicv1.GetInstanceOptions{
-		ID: &id,
-	}
-	_, response, err := instanceC.GetInstance(getinsOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func instanceExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	instanceC, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getinsOptions := &vpcv1.GetInstanceOptions{
-		ID: &id,
-	}
-	_, response, err := instanceC.GetInstance(getinsOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func resourceIBMisInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-	if userDetails.generation == 1 {
-		exists, err := classicInstanceExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := instanceExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func isWaitForClassicInstanceDelete(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForClassicInstanceDelete(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceDeleting, isInstanceAvailable},
@@ -2154,10 +2198,10 @@ func isWaitForClassicInstanceDelete(instanceC *vpcclassicv1.VpcClassicV1, d *sch
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
+func isWaitForInstanceDelete(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceDeleting, isInstanceAvailable},
@@ -2183,9 +2227,9 @@ func isWaitForInstanceDelete(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
-func isWaitForClassicInstanceActionStop(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, meta interface{}, id string) (interface{}, error) {
+func isWaitForClassicInstanceActionStop(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, meta interface{}, id string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
@@ -2208,9 +2252,9 @@ func isWaitForClassicInstanceActionStop(instanceC *vpcclassicv1.VpcClassicV1, d
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
-func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
+func isWaitForInstanceActionStop(ctx context.Context, instanceC *vpcv1.VpcV1, timeout time.Duration, id string, d *schema.ResourceData) (interface{}, error) {
 	communicator := make(chan interface{})
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceStatusRunning, isInstanceStatusPending, isInstanceActionStatusStopping},
@@ -2246,7 +2290,7 @@ func isWaitForInstanceActionStop(instanceC *vpcv1.VpcV1, timeout time.Duration,
 		go isRestartStopAction(instanceC, id, d, forceTimeout, communicator)
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceData, forceTimeout int, communicator chan interface{}) {
@@ -2276,7 +2320,7 @@ func isRestartStopAction(instanceC *vpcv1.VpcV1, id string, d *schema.ResourceDa
 	}
 }
 
-func isWaitForClassicInstanceVolumeAttached(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForClassicInstanceVolumeAttached(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 	log.Printf("Waiting for instance volume (%s) to be attched.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -2288,7 +2332,7 @@ func isWaitForClassicInstanceVolumeAttached(instanceC *vpcclassicv1.VpcClassicV1
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicInstanceVolumeRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id, volID string) resource.StateRefreshFunc {
@@ -2310,19 +2354,19 @@ func isClassicInstanceVolumeRefreshFunc(instanceC *vpcclassicv1.VpcClassicV1, id
 	}
 }
 
-func isWaitForInstanceVolumeAttached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeAttached(ctx context.Context, instanceC *vpcv1.VpcV1, timeout time.Duration, id, volID string) (interface{}, error) {
 	log.Printf("Waiting for instance volume (%s) to be attched.", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isInstanceVolumeAttaching},
 		Target:     []string{isInstanceVolumeAttached, ""},
 		Refresh:    isInstanceVolumeRefreshFunc(instanceC, id, volID),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resource.StateRefreshFunc {
@@ -2344,7 +2388,7 @@ func isInstanceVolumeRefreshFunc(instanceC *vpcv1.VpcV1, id, volID string) resou
 	}
 }
 
-func isWaitForClassicInstanceVolumeDetached(instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForClassicInstanceVolumeDetached(ctx context.Context, instanceC *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
@@ -2371,10 +2415,10 @@ func isWaitForClassicInstanceVolumeDetached(instanceC *vpcclassicv1.VpcClassicV1
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, volID string) (interface{}, error) {
+func isWaitForInstanceVolumeDetached(ctx context.Context, instanceC *vpcv1.VpcV1, timeout time.Duration, id, volID string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isInstanceVolumeAttached, isInstanceVolumeDetaching},
@@ -2392,14 +2436,279 @@ func isWaitForInstanceVolumeDetached(instanceC *vpcv1.VpcV1, d *schema.ResourceD
 				return nil, "", fmt.Errorf("Error Detaching: %s\n%s", err, response)
 			}
 			if *vol.Status == isInstanceFailed {
-				return vol, *vol.Status, fmt.Errorf("The instance %s failed to detach volume %s: %v", d.Id(), volID, err)
+				return vol, *vol.Status, fmt.Errorf("The instance %s failed to detach volume %s: %v", id, volID, err)
 			}
 			return vol, isInstanceVolumeDetaching, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
+}
+
+// resourceIBMISInstanceProfileCustomizeDiff recreates the classic instances on a profile change,
+// only the gen2 instances can be resized in place
+func resourceIBMISInstanceProfileCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange(isInstanceProfile) {
+		return nil
+	}
+	sess, ok := meta.(ClientSession)
+	if !ok {
+		return nil
+	}
+	userDetails, err := sess.BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	if userDetails.generation == 1 {
+		return diff.ForceNew(isInstanceProfile)
+	}
+	return nil
+}
+
+// resourceIBMISInstanceActionCustomizeDiff plans a status change when the status of the instance
+// drifted from its desired status or its start or stop action
+func resourceIBMISInstanceActionCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	status := diff.Get(isInstanceStatus).(string)
+	switch action := instancePowerAction(diff.Get(isInstanceDesiredStatus).(string), diff.Get(isInstanceAction).(string)); {
+	case action == isInstanceActionStart && status == isInstanceActionStatusStopped:
+		return diff.SetNew(isInstanceStatus, isInstanceStatusRunning)
+	case action == isInstanceActionStop && status == isInstanceStatusRunning:
+		return diff.SetNew(isInstanceStatus, isInstanceActionStatusStopped)
+	}
+	return nil
+}
+
+// instancePowerAction returns the power action of the instance, the desired status is reached
+// with the start or stop action
+func instancePowerAction(desiredStatus, action string) string {
+	switch desiredStatus {
+	case isInstanceStatusRunning:
+		return isInstanceActionStart
+	case isInstanceActionStatusStopped:
+		return isInstanceActionStop
+	}
+	return action
+}
+
+// instanceActionRequired returns whether the action has to be performed on an instance with the
+// status, a reboot is only performed when it is requested by a change of the action
+func instanceActionRequired(action, status string, rebootRequested bool) bool {
+	switch action {
+	case isInstanceActionStart:
+		return status == isInstanceActionStatusStopped
+	case isInstanceActionStop:
+		return status == isInstanceStatusRunning
+	case isInstanceActionReboot:
+		return status == isInstanceStatusRunning && rebootRequested
+	}
+	return false
+}
+
+func instanceAction(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id, action string) error {
+	getinsOptions := &vpcv1.GetInstanceOptions{
+		ID: &id,
+	}
+	instance, response, err := instanceC.GetInstance(getinsOptions)
+	if err != nil {
+		return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+	}
+	rebootRequested := !d.IsNewResource() && d.HasChange(isInstanceAction)
+	if !instanceActionRequired(action, *instance.Status, rebootRequested) {
+		return nil
+	}
+
+	actionOptions := &vpcv1.CreateInstanceActionOptions{
+		InstanceID: &id,
+		Type:       &action,
+	}
+	_, response, err = instanceC.CreateInstanceAction(actionOptions)
+	if err != nil {
+		return fmt.Errorf("Error while performing the %s action on the instance %s: %s\n%s", action, id, err, response)
+	}
+	if action == isInstanceActionStop {
+		_, err = isWaitForInstanceActionStop(ctx, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+	} else {
+		_, err = isWaitForInstanceAvailable(ctx, instanceC, id, d.Timeout(schema.TimeoutUpdate), d)
+	}
+	return err
+}
+
+// instanceResize changes the profile of the instance, a running instance is stopped during the
+// resize and started again unless its desired status is stopped or its action is stop
+func instanceResize(ctx context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string) error {
+	getinsOptions := &vpcv1.GetInstanceOptions{
+		ID: &id,
+	}
+	instance, response, err := instanceC.GetInstance(getinsOptions)
+	if err != nil {
+		return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
+	}
+	running := *instance.Status == isInstanceStatusRunning
+	if running {
+		stopType := isInstanceActionStop
+		actionOptions := &vpcv1.CreateInstanceActionOptions{
+			InstanceID: &id,
+			Type:       &stopType,
+		}
+		_, response, err = instanceC.CreateInstanceAction(actionOptions)
+		if err != nil {
+			return fmt.Errorf("Error while stopping the instance %s to resize it: %s\n%s", id, err, response)
+		}
+		_, err = isWaitForInstanceActionStop(ctx, instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
+		if err != nil {
+			return err
+		}
+	}
+
+	profile := d.Get(isInstanceProfile).(string)
+	instancePatchModel := &vpcv1.InstancePatch{
+		Profile: &vpcv1.InstancePatchProfileInstanceProfileIdentityByName{
+			Name: &profile,
+		},
+	}
+	instancePatch, err := instancePatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("Error calling asPatch for InstancePatch: %s", err)
+	}
+	updateOptions := &vpcv1.UpdateInstanceOptions{
+		ID:            &id,
+		InstancePatch: instancePatch,
+	}
+	_, response, err = instanceC.UpdateInstance(updateOptions)
+	if err != nil {
+		return fmt.Errorf("Error while resizing the instance %s to the profile %s: %s\n%s", id, profile, err, response)
+	}
+
+	if running && instancePowerAction(d.Get(isInstanceDesiredStatus).(string), d.Get(isInstanceAction).(string)) != isInstanceActionStop {
+		startType := isInstanceActionStart
+		actionOptions := &vpcv1.CreateInstanceActionOptions{
+			InstanceID: &id,
+			Type:       &startType,
+		}
+		_, response, err = instanceC.CreateInstanceAction(actionOptions)
+		if err != nil {
+			return fmt.Errorf("Error while starting the instance %s after resizing it: %s\n%s", id, err, response)
+		}
+		_, err = isWaitForInstanceAvailable(ctx, instanceC, id, d.Timeout(schema.TimeoutUpdate), d)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// instancePrototypeBySourceSnapshot converts the prototype of an instance booting from an image to a
+// prototype booting from a volume restored from the snapshot
+func instancePrototypeBySourceSnapshot(instanceproto *vpcv1.InstancePrototype, snapshot string) *vpcv1.InstancePrototypeInstanceByVolume {
+	volTemplate := &vpcv1.VolumeAttachmentVolumePrototypeInstanceByVolumeContext{
+		SourceSnapshot: &vpcv1.SnapshotIdentity{
+			ID: &snapshot,
+		},
+	}
+	if instanceproto.BootVolumeAttachment != nil && instanceproto.BootVolumeAttachment.Volume != nil {
+		bootvol := instanceproto.BootVolumeAttachment.Volume
+		volTemplate.Name = bootvol.Name
+		volTemplate.EncryptionKey = bootvol.EncryptionKey
+		volTemplate.Profile = bootvol.Profile
+	}
+	deletebool := true
+	return &vpcv1.InstancePrototypeInstanceByVolume{
+		Keys:                    instanceproto.Keys,
+		Name:                    instanceproto.Name,
+		NetworkInterfaces:       instanceproto.NetworkInterfaces,
+		PlacementTarget:         instanceproto.PlacementTarget,
+		Profile:                 instanceproto.Profile,
+		ResourceGroup:           instanceproto.ResourceGroup,
+		UserData:                instanceproto.UserData,
+		VolumeAttachments:       instanceproto.VolumeAttachments,
+		VPC:                     instanceproto.VPC,
+		PrimaryNetworkInterface: instanceproto.PrimaryNetworkInterface,
+		Zone:                    instanceproto.Zone,
+		BootVolumeAttachment: &vpcv1.VolumeAttachmentPrototypeInstanceByVolumeContext{
+			DeleteVolumeOnInstanceDelete: &deletebool,
+			Volume:                       volTemplate,
+		},
+	}
+}
+
+// instancePlacementTargetSchema returns the schema of the placement target of the instances and the
+// instance templates
+func instancePlacementTargetSchema() *schema.Schema {
+	targets := []string{
+		isInstancePlacementTarget + ".0." + isInstancePlacementTargetDedicatedHost,
+		isInstancePlacementTarget + ".0." + isInstancePlacementTargetDedicatedHostGroup,
+		isInstancePlacementTarget + ".0." + isInstancePlacementTargetPlacementGroup,
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "The placement of the instance, on a dedicated host, a dedicated host group or a placement group",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				isInstancePlacementTargetDedicatedHost: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ExactlyOneOf: targets,
+					Description:  "The ID of the dedicated host to place the instance on",
+				},
+				isInstancePlacementTargetDedicatedHostGroup: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ExactlyOneOf: targets,
+					Description:  "The ID of the dedicated host group to place the instance on",
+				},
+				isInstancePlacementTargetPlacementGroup: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ExactlyOneOf: targets,
+					Description:  "The ID of the placement group to place the instance in",
+				},
+			},
+		},
+	}
+}
+
+// flattenInstancePlacementTarget returns the placement_target block of the placement target of an
+// instance, the key of its ID is its resource type
+func flattenInstancePlacementTarget(placementTarget *vpcInstancePlacementTarget) []interface{} {
+	if placementTarget == nil {
+		return []interface{}{}
+	}
+	switch placementTarget.ResourceType {
+	case isInstancePlacementTargetDedicatedHost, isInstancePlacementTargetDedicatedHostGroup, isInstancePlacementTargetPlacementGroup:
+		return []interface{}{
+			map[string]interface{}{placementTarget.ResourceType: placementTarget.ID},
+		}
+	}
+	return []interface{}{}
+}
+
+// expandInstancePlacementTarget returns the placement target prototype of the placement_target
+// block, the dedicated hosts, the dedicated host groups and the placement groups are all
+// identified by their ID
+func expandInstancePlacementTarget(placementTarget []interface{}) vpcv1.InstancePlacementTargetPrototypeIntf {
+	if len(placementTarget) == 0 || placementTarget[0] == nil {
+		return nil
+	}
+	target := placementTarget[0].(map[string]interface{})
+	for _, key := range []string{isInstancePlacementTargetDedicatedHost, isInstancePlacementTargetDedicatedHostGroup, isInstancePlacementTargetPlacementGroup} {
+		if id, ok := target[key].(string); ok && id != "" {
+			return &vpcv1.InstancePlacementTargetPrototype{
+				ID: &id,
+			}
+		}
+	}
+	return nil
 }
@@ -7,10 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,16 +27,17 @@ const (
 
 func resourceIBMISInstanceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISInstanceGroupCreate,
-		Read:     resourceIBMISInstanceGroupRead,
-		Update:   resourceIBMISInstanceGroupUpdate,
-		Delete:   resourceIBMISInstanceGroupDelete,
-		Exists:   resourceIBMISInstanceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISInstanceGroupCreate,
+		ReadContext:   resourceIBMISInstanceGroupRead,
+		UpdateContext: resourceIBMISInstanceGroupUpdate,
+		DeleteContext: resourceIBMISInstanceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISInstanceGroup, nil),
+		},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -135,6 +136,7 @@ func resourceIBMISInstanceGroup() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "List of tags for instance group",
 			},
+			"tags_all": resourceTagsAllSchema(),
 		},
 	}
 }
@@ -170,7 +172,7 @@ func resourceIBMISInstanceGroupValidator() *ResourceValidator {
 	return &ibmISInstanceGroupResourceValidator
 }
 
-func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get("name").(string)
 	instanceTemplate := d.Get("instance_template").(string)
@@ -179,7 +181,7 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var subnetIDs []vpcv1.SubnetIdentityIntf
@@ -225,18 +227,17 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 
 	instanceGroup, response, err := sess.CreateInstanceGroup(&instanceGroupOptions)
 	if err != nil || instanceGroup == nil {
-		return fmt.Errorf("Error Creating InstanceGroup: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error Creating InstanceGroup: %s\n%s", err, response))
 	}
 	d.SetId(*instanceGroup.ID)
 
-	_, healthError := waitForHealthyInstanceGroup(d, meta, d.Timeout(schema.TimeoutCreate))
+	_, healthError := waitForHealthyInstanceGroup(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
-		return healthError
+		return diagFromErr(healthError)
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
-		oldList, newList := d.GetChange("tags")
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
 		if err != nil {
 			log.Printf(
@@ -244,28 +245,28 @@ func resourceIBMISInstanceGroupCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return resourceIBMISInstanceGroupRead(d, meta)
+	return resourceIBMISInstanceGroupRead(ctx, d, meta)
 
 }
 
-func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var changed bool
 	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{}
 	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		instanceGroupID := d.Id()
 		getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
 		instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
 		if err != nil || instanceGroup == nil {
-			return fmt.Errorf("Error getting instance group: %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error getting instance group: %s\n%s", err, response))
 		}
-		oldList, newList := d.GetChange("tags")
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *instanceGroup.CRN)
 		if err != nil {
 			log.Printf(
@@ -320,27 +321,27 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 		instanceGroupUpdateOptions.ID = &instanceGroupID
 		instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("Error calling asPatch for InstanceGroupPatch: %s", err)
+			return diagFromErr(fmt.Errorf("Error calling asPatch for InstanceGroupPatch: %s", err))
 		}
 		instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
 		_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
 		if err != nil {
-			return fmt.Errorf("Error Updating InstanceGroup: %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error Updating InstanceGroup: %s\n%s", err, response))
 		}
 
 		// wait for instance group health update with update timeout configured.
-		_, healthError := waitForHealthyInstanceGroup(d, meta, d.Timeout(schema.TimeoutUpdate))
+		_, healthError := waitForHealthyInstanceGroup(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
-			return healthError
+			return diagFromErr(healthError)
 		}
 	}
-	return resourceIBMISInstanceGroupRead(d, meta)
+	return resourceIBMISInstanceGroupRead(ctx, d, meta)
 }
 
-func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceGroupID := d.Id()
@@ -351,7 +352,7 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting InstanceGroup: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error Getting InstanceGroup: %s\n%s", err, response))
 	}
 	d.Set("name", *instanceGroup.Name)
 	d.Set("instance_template", *instanceGroup.InstanceTemplate.ID)
@@ -384,14 +385,14 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 		log.Printf(
 			"Error on get of instance group (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	return nil
 }
 
-func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISInstanceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceGroupID := d.Id()
 
@@ -403,18 +404,18 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 	instanceGroupPatchModel.MembershipCount = &zeroMembers
 	instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
 	if err != nil {
-		return fmt.Errorf("Error calling asPatch for ImagePatch: %s", err)
+		return diagFromErr(fmt.Errorf("Error calling asPatch for ImagePatch: %s", err))
 	}
 
 	instanceGroupUpdateOptions.ID = &instanceGroupID
 	instanceGroupUpdateOptions.InstanceGroupPatch = instanceGroupPatch
 	_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
 	if err != nil {
-		return fmt.Errorf("Error updating instanceGroup's instance count to 0 : %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error updating instanceGroup's instance count to 0 : %s\n%s", err, response))
 	}
-	_, healthError := waitForHealthyInstanceGroup(d, meta, d.Timeout(schema.TimeoutUpdate))
+	_, healthError := waitForHealthyInstanceGroup(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
 	if healthError != nil {
-		return healthError
+		return diagFromErr(healthError)
 	}
 
 	deleteInstanceGroupOptions := vpcv1.DeleteInstanceGroupOptions{ID: &instanceGroupID}
@@ -424,12 +425,12 @@ func resourceIBMISInstanceGroupDelete(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Deleting the InstanceGroup: %s\n%s", Err, response)
+		return diagFromErr(fmt.Errorf("Error Deleting the InstanceGroup: %s\n%s", Err, response))
 	}
 
-	_, deleteError := waitForInstanceGroupDelete(d, meta)
+	_, deleteError := waitForInstanceGroupDelete(ctx, d, meta)
 	if deleteError != nil {
-		return deleteError
+		return diagFromErr(deleteError)
 	}
 	return nil
 }
@@ -451,7 +452,7 @@ func resourceIBMISInstanceGroupExists(d *schema.ResourceData, meta interface{})
 	return true, nil
 }
 
-func waitForHealthyInstanceGroup(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+func waitForHealthyInstanceGroup(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return nil, err
@@ -481,11 +482,11 @@ func waitForHealthyInstanceGroup(d *schema.ResourceData, meta interface{}, timeo
 		PollInterval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return healthStateConf.WaitForStateContext(ctx)
 
 }
 
-func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForInstanceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	healthStateConf := &resource.StateChangeConf{
 		Pending: []string{HEALTHY},
 		Target:  []string{DELETING},
@@ -502,6 +503,6 @@ func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (inter
 		PollInterval: 10 * time.Second,
 	}
 
-	return healthStateConf.WaitForState()
+	return healthStateConf.WaitForStateContext(ctx)
 
 }
@@ -8,7 +8,7 @@ import (
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -45,18 +45,13 @@ const (
 
 func resourceIBMISInstanceTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisInstanceTemplateCreate,
-		Read:     resourceIBMisInstanceTemplateRead,
-		Update:   resourceIBMisInstanceTemplateUpdate,
-		Delete:   resourceIBMisInstanceTemplateDelete,
-		Exists:   resourceIBMisInstanceTemplateExists,
-		Importer: &schema.ResourceImporter{},
-
-		CustomizeDiff: customdiff.Sequence(
-			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
-			},
-		),
+		CreateContext: resourceIBMisInstanceTemplateCreate,
+		ReadContext:   resourceIBMisInstanceTemplateRead,
+		UpdateContext: resourceIBMisInstanceTemplateUpdate,
+		DeleteContext: resourceIBMisInstanceTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISInstanceTemplate, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			isInstanceTemplateName: {
@@ -250,11 +245,13 @@ func resourceIBMISInstanceTemplate() *schema.Resource {
 				Computed:    true,
 				Description: "Instance template resource group",
 			},
+
+			isInstanceTemplatePlacementTarget: instancePlacementTargetSchema(),
 		},
 	}
 }
 
-func resourceIBMisInstanceTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	profile := d.Get(isInstanceTemplateProfile).(string)
 	name := d.Get(isInstanceTemplateName).(string)
 	vpcID := d.Get(isInstanceTemplateVPC).(string)
@@ -263,48 +260,39 @@ func resourceIBMisInstanceTemplateCreate(d *schema.ResourceData, meta interface{
 
 	err := instanceTemplateCreate(d, meta, profile, name, vpcID, zone, image)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMisInstanceTemplateRead(d, meta)
+	return resourceIBMisInstanceTemplateRead(ctx, d, meta)
 }
 
-func resourceIBMisInstanceTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	ID := d.Id()
 	err := instanceTemplateGet(d, meta, ID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func resourceIBMisInstanceTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	ID := d.Id()
 
 	err := instanceTemplateDelete(d, meta, ID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func resourceIBMisInstanceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	err := instanceTemplateUpdate(d, meta)
 	if err != nil {
-		return err
-	}
-	return resourceIBMisInstanceTemplateRead(d, meta)
-}
-
-func resourceIBMisInstanceTemplateExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	ID := d.Id()
-	ok, err := instanceTemplateExists(d, meta, ID)
-	if err != nil {
-		return false, err
+		return diagFromErr(err)
 	}
-	return ok, err
+	return resourceIBMisInstanceTemplateRead(ctx, d, meta)
 }
 
 func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, name, vpcID, zone, image string) error {
@@ -509,6 +497,10 @@ func instanceTemplateCreate(d *schema.ResourceData, meta interface{}, profile, n
 
 	}
 
+	if placementTarget, ok := d.GetOk(isInstanceTemplatePlacementTarget); ok {
+		instanceproto.PlacementTarget = expandInstancePlacementTarget(placementTarget.([]interface{}))
+	}
+
 	options := &vpcv1.CreateInstanceTemplateOptions{
 		InstanceTemplatePrototype: instanceproto,
 	}
@@ -532,6 +524,10 @@ func instanceTemplateGet(d *schema.ResourceData, meta interface{}, ID string) er
 	}
 	instanceIntf, response, err := instanceC.GetInstanceTemplate(getinsOptions)
 	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Error Getting Instance template: %s\n%s", err, response)
 	}
 	instance := instanceIntf.(*vpcv1.InstanceTemplate)
@@ -703,21 +699,3 @@ func instanceTemplateDelete(d *schema.ResourceData, meta interface{}, ID string)
 	}
 	return nil
 }
-
-func instanceTemplateExists(d *schema.ResourceData, meta interface{}, ID string) (bool, error) {
-	instanceC, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getinsOptions := &vpcv1.GetInstanceTemplateOptions{
-		ID: &ID,
-	}
-	_, response, err := instanceC.GetInstanceTemplate(getinsOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error Getting InstanceTemplate: %s\n%s", err, response)
-	}
-	return true, nil
-}
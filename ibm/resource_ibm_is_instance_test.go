@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -13,7 +14,10 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
 )
 
 func TestAccIBMISInstance_basic(t *testing.T) {
@@ -429,3 +433,122 @@ func testAccCheckIBMISInstanceVolumeUpdate(vpcname, subnetname, sshname, publicK
 	
 `, vpcname, subnetname, ISZoneName, ISCIDR, sshname, publicKey, volName, ISZoneName, name, isImage, instanceProfileName, ISZoneName)
 }
+
+func TestResourceIBMISInstanceActionCustomizeDiff(t *testing.T) {
+	meta := &clientSession{config: &Config{}}
+	r := resourceIBMISInstance()
+	raw := map[string]interface{}{
+		"name":    "instance",
+		"vpc":     "vpc1",
+		"zone":    "us-south-1",
+		"profile": "bx2-2x8",
+		"image":   "image1",
+		"keys":    []interface{}{"key1"},
+		"primary_network_interface": []interface{}{
+			map[string]interface{}{"subnet": "subnet1"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("instance1")
+	d.Set("status", "stopped")
+	// The placement target is read back on refresh, an instance without one has an empty list
+	d.Set(isInstancePlacementTarget, []interface{}{})
+	state := d.State()
+
+	raw["action"] = "start"
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil)
+	assert.Equal(t, "running", diff.Attributes["status"].New)
+
+	// The status of a stopped instance is left unchanged by the stop and reboot actions
+	for _, action := range []string{"stop", "reboot"} {
+		raw["action"] = action
+		diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+		assert.NilError(t, err)
+		assert.Assert(t, diff != nil)
+		_, ok := diff.Attributes["status"]
+		assert.Assert(t, !ok, action)
+	}
+
+	state.Attributes["status"] = "running"
+	raw["action"] = "stop"
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil)
+	assert.Equal(t, "stopped", diff.Attributes["status"].New)
+
+	// The desired status converges the status like the start and stop actions
+	delete(raw, "action")
+	raw["desired_status"] = "stopped"
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil)
+	assert.Equal(t, "stopped", diff.Attributes["status"].New)
+
+	state.Attributes["status"] = "stopped"
+	raw["desired_status"] = "running"
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil)
+	assert.Equal(t, "running", diff.Attributes["status"].New)
+}
+
+func TestInstanceActionRequired(t *testing.T) {
+	assert.Assert(t, instanceActionRequired("start", "stopped", false))
+	assert.Assert(t, !instanceActionRequired("start", "running", false))
+	assert.Assert(t, instanceActionRequired("stop", "running", false))
+	assert.Assert(t, !instanceActionRequired("stop", "stopping", false))
+	assert.Assert(t, !instanceActionRequired("reboot", "running", false))
+	assert.Assert(t, instanceActionRequired("reboot", "running", true))
+	assert.Assert(t, !instanceActionRequired("reboot", "stopped", true))
+
+	assert.Equal(t, "start", instancePowerAction("running", ""))
+	assert.Equal(t, "stop", instancePowerAction("stopped", ""))
+	assert.Equal(t, "reboot", instancePowerAction("", "reboot"))
+	assert.Equal(t, "", instancePowerAction("", ""))
+}
+
+func TestInstancePrototypeBySourceSnapshot(t *testing.T) {
+	name, zone, bootName := "instance", "us-south-1", "boot"
+	instanceproto := &vpcv1.InstancePrototype{
+		Name: &name,
+		Zone: &vpcv1.ZoneIdentity{
+			Name: &zone,
+		},
+		PlacementTarget: expandInstancePlacementTarget([]interface{}{
+			map[string]interface{}{"placement_group": "r006-placement-group"},
+		}),
+		BootVolumeAttachment: &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
+			Volume: &vpcv1.VolumePrototypeInstanceByImageContext{
+				Name: &bootName,
+			},
+		},
+	}
+	prototype := instancePrototypeBySourceSnapshot(instanceproto, "r006-snapshot")
+	assert.Equal(t, "instance", *prototype.Name)
+	assert.Equal(t, instanceproto.Zone, prototype.Zone)
+	assert.Assert(t, *prototype.BootVolumeAttachment.DeleteVolumeOnInstanceDelete)
+	bootvol := prototype.BootVolumeAttachment.Volume.(*vpcv1.VolumeAttachmentVolumePrototypeInstanceByVolumeContext)
+	assert.Equal(t, "boot", *bootvol.Name)
+	assert.Assert(t, is.Nil(bootvol.Capacity))
+	assert.Equal(t, "r006-snapshot", *bootvol.SourceSnapshot.(*vpcv1.SnapshotIdentity).ID)
+	assert.Equal(t, instanceproto.PlacementTarget, prototype.PlacementTarget)
+}
+
+func TestExpandInstancePlacementTarget(t *testing.T) {
+	assert.Assert(t, is.Nil(expandInstancePlacementTarget(nil)))
+	assert.Assert(t, is.Nil(expandInstancePlacementTarget([]interface{}{nil})))
+
+	for _, key := range []string{"dedicated_host", "dedicated_host_group", "placement_group"} {
+		target := map[string]interface{}{
+			"dedicated_host":       "",
+			"dedicated_host_group": "",
+			"placement_group":      "",
+		}
+		target[key] = "r006-" + key
+		prototype := expandInstancePlacementTarget([]interface{}{target})
+		assert.Assert(t, prototype != nil, key)
+		assert.Equal(t, "r006-"+key, *prototype.(*vpcv1.InstancePlacementTargetPrototype).ID)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isInstanceVolumeAttachmentInstance     = "instance"
+	isInstanceVolumeAttachmentVolume       = "volume"
+	isInstanceVolumeAttachmentName         = "name"
+	isInstanceVolumeAttachmentAutoDelete   = "delete_volume_on_instance_delete"
+	isInstanceVolumeAttachmentID           = "volume_attachment_id"
+	isInstanceVolumeAttachmentStatus       = "status"
+	isInstanceVolumeAttachmentType         = "type"
+	isInstanceVolumeAttachmentDevice       = "device"
+	isInstanceVolumeAttachmentResourceName = "ibm_is_instance_volume_attachment"
+)
+
+func resourceIBMISInstanceVolumeAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISInstanceVolumeAttachmentCreate,
+		ReadContext:   resourceIBMISInstanceVolumeAttachmentRead,
+		UpdateContext: resourceIBMISInstanceVolumeAttachmentUpdate,
+		DeleteContext: resourceIBMISInstanceVolumeAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISInstanceVolumeAttachment, nil),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isInstanceVolumeAttachmentInstance: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the instance",
+			},
+
+			isInstanceVolumeAttachmentVolume: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the volume to attach to the instance",
+			},
+
+			isInstanceVolumeAttachmentName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator(isInstanceVolumeAttachmentResourceName, isInstanceVolumeAttachmentName),
+				Description:  "The name of the volume attachment",
+			},
+
+			isInstanceVolumeAttachmentAutoDelete: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, the volume is deleted when the instance is deleted",
+			},
+
+			isInstanceVolumeAttachmentID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the volume attachment",
+			},
+
+			isInstanceVolumeAttachmentStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the volume attachment",
+			},
+
+			isInstanceVolumeAttachmentType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the volume attachment, boot or data",
+			},
+
+			isInstanceVolumeAttachmentDevice: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the device on the instance",
+			},
+		},
+	}
+}
+
+func resourceIBMISInstanceVolumeAttachmentValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isInstanceVolumeAttachmentName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+
+	ibmISInstanceVolumeAttachmentValidator := ResourceValidator{ResourceName: isInstanceVolumeAttachmentResourceName, Schema: validateSchema}
+	return &ibmISInstanceVolumeAttachmentValidator
+}
+
+func resourceIBMISInstanceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceC, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	instanceID := d.Get(isInstanceVolumeAttachmentInstance).(string)
+	volumeID := d.Get(isInstanceVolumeAttachmentVolume).(string)
+	autoDelete := d.Get(isInstanceVolumeAttachmentAutoDelete).(bool)
+
+	createvolattoptions := &vpcv1.CreateInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		Volume: &vpcv1.VolumeAttachmentPrototypeVolumeVolumeIdentity{
+			ID: &volumeID,
+		},
+		DeleteVolumeOnInstanceDelete: &autoDelete,
+	}
+	if name, ok := d.GetOk(isInstanceVolumeAttachmentName); ok {
+		namestr := name.(string)
+		createvolattoptions.Name = &namestr
+	}
+	vol, response, err := instanceC.CreateInstanceVolumeAttachment(createvolattoptions)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error while attaching volume %q to instance %s: %s\n%s", volumeID, instanceID, err, response))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *vol.ID))
+	log.Printf("[INFO] Instance volume attachment : %s", d.Id())
+
+	_, err = isWaitForInstanceVolumeAttached(ctx, instanceC, d.Timeout(schema.TimeoutCreate), instanceID, *vol.ID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	return resourceIBMISInstanceVolumeAttachmentRead(ctx, d, meta)
+}
+
+func resourceIBMISInstanceVolumeAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceC, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	instanceID := parts[0]
+	attachmentID := parts[1]
+
+	getvolattoptions := &vpcv1.GetInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		ID:         &attachmentID,
+	}
+	vol, response, err := instanceC.GetInstanceVolumeAttachment(getvolattoptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Getting Instance Volume Attachment: %s\n%s", err, response))
+	}
+	d.Set(isInstanceVolumeAttachmentInstance, instanceID)
+	d.Set(isInstanceVolumeAttachmentID, *vol.ID)
+	d.Set(isInstanceVolumeAttachmentName, *vol.Name)
+	d.Set(isInstanceVolumeAttachmentStatus, *vol.Status)
+	d.Set(isInstanceVolumeAttachmentType, *vol.Type)
+	if vol.Volume != nil {
+		d.Set(isInstanceVolumeAttachmentVolume, *vol.Volume.ID)
+	}
+	if vol.DeleteVolumeOnInstanceDelete != nil {
+		d.Set(isInstanceVolumeAttachmentAutoDelete, *vol.DeleteVolumeOnInstanceDelete)
+	}
+	if vol.Device != nil && vol.Device.ID != nil {
+		d.Set(isInstanceVolumeAttachmentDevice, *vol.Device.ID)
+	}
+	return nil
+}
+
+func resourceIBMISInstanceVolumeAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceC, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	instanceID := parts[0]
+	attachmentID := parts[1]
+
+	if d.HasChange(isInstanceVolumeAttachmentName) || d.HasChange(isInstanceVolumeAttachmentAutoDelete) {
+		volumeAttachmentPatchModel := &vpcv1.VolumeAttachmentPatch{}
+		if d.HasChange(isInstanceVolumeAttachmentName) {
+			name := d.Get(isInstanceVolumeAttachmentName).(string)
+			volumeAttachmentPatchModel.Name = &name
+		}
+		if d.HasChange(isInstanceVolumeAttachmentAutoDelete) {
+			autoDelete := d.Get(isInstanceVolumeAttachmentAutoDelete).(bool)
+			volumeAttachmentPatchModel.DeleteVolumeOnInstanceDelete = &autoDelete
+		}
+		volumeAttachmentPatch, err := volumeAttachmentPatchModel.AsPatch()
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error calling asPatch for VolumeAttachmentPatch: %s", err))
+		}
+		updvolattoptions := &vpcv1.UpdateInstanceVolumeAttachmentOptions{
+			InstanceID:            &instanceID,
+			ID:                    &attachmentID,
+			VolumeAttachmentPatch: volumeAttachmentPatch,
+		}
+		_, response, err := instanceC.UpdateInstanceVolumeAttachment(updvolattoptions)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error Updating Instance Volume Attachment: %s\n%s", err, response))
+		}
+	}
+	return resourceIBMISInstanceVolumeAttachmentRead(ctx, d, meta)
+}
+
+func resourceIBMISInstanceVolumeAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceC, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	instanceID := parts[0]
+	attachmentID := parts[1]
+
+	delvolattoptions := &vpcv1.DeleteInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		ID:         &attachmentID,
+	}
+	response, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error while removing volume attachment %q of instance %s: %s\n%s", attachmentID, instanceID, err, response))
+	}
+	_, err = isWaitForInstanceVolumeDetached(ctx, instanceC, d.Timeout(schema.TimeoutDelete), instanceID, attachmentID)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,12 +31,13 @@ const (
 
 func resourceIBMISIPSecPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISIPSecPolicyCreate,
-		Read:     resourceIBMISIPSecPolicyRead,
-		Update:   resourceIBMISIPSecPolicyUpdate,
-		Delete:   resourceIBMISIPSecPolicyDelete,
-		Exists:   resourceIBMISIPSecPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISIPSecPolicyCreate,
+		ReadContext:   resourceIBMISIPSecPolicyRead,
+		UpdateContext: resourceIBMISIPSecPolicyUpdate,
+		DeleteContext: resourceIBMISIPSecPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISIPSecPolicy, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			isIpSecName: {
@@ -181,10 +184,10 @@ func resourceIBMISIPSECValidator() *ResourceValidator {
 	return &ibmISIPSECResourceValidator
 }
 
-func resourceIBMISIPSecPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("[DEBUG] Ip Sec create")
@@ -196,15 +199,15 @@ func resourceIBMISIPSecPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	if userDetails.generation == 1 {
 		err := classicIpsecpCreate(d, meta, authenticationAlg, encryptionAlg, name, pfs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := ipsecpCreate(d, meta, authenticationAlg, encryptionAlg, name, pfs)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISIPSecPolicyRead(d, meta)
+	return resourceIBMISIPSecPolicyRead(ctx, d, meta)
 }
 
 func classicIpsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, encryptionAlg, name, pfs string) error {
@@ -277,21 +280,21 @@ func ipsecpCreate(d *schema.ResourceData, meta interface{}, authenticationAlg, e
 	return nil
 }
 
-func resourceIBMISIPSecPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicIpsecpGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := ipsecpGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -414,24 +417,24 @@ func ipsecpGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISIPSecPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicIpsecpUpdate(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := ipsecpUpdate(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISIPSecPolicyRead(d, meta)
+	return resourceIBMISIPSecPolicyRead(ctx, d, meta)
 }
 
 func classicIpsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
@@ -508,21 +511,21 @@ func ipsecpUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISIPSecPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISIPSecPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicIpsecpDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := ipsecpDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -582,55 +585,3 @@ func ipsecpDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMISIPSecPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-
-	if userDetails.generation == 1 {
-		exists, err := classicIpsecpExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := ipsecpExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicIpsecpExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getIpsecPolicyOptions := &vpcclassicv1.GetIpsecPolicyOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
-	}
-	return true, nil
-}
-
-func ipsecpExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getIpsecPolicyOptions := &vpcv1.GetIpsecPolicyOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetIpsecPolicy(getIpsecPolicyOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting IPSEC Policy(%s): %s\n%s", id, err, response)
-	}
-	return true, nil
-}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -32,12 +34,13 @@ const (
 
 func resourceIBMISLBListener() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBListenerCreate,
-		Read:     resourceIBMISLBListenerRead,
-		Update:   resourceIBMISLBListenerUpdate,
-		Delete:   resourceIBMISLBListenerDelete,
-		Exists:   resourceIBMISLBListenerExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBListenerCreate,
+		ReadContext:   resourceIBMISLBListenerRead,
+		UpdateContext: resourceIBMISLBListenerUpdate,
+		DeleteContext: resourceIBMISLBListenerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISLBListener, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -142,10 +145,10 @@ func resourceIBMISLBListenerValidator() *ResourceValidator {
 	return &ibmISLBListenerResourceValidator
 }
 
-func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("[DEBUG] LB Listener create")
@@ -157,7 +160,7 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 	if pool, ok := d.GetOk(isLBListenerDefaultPool); ok {
 		lbPool, err := getPoolId(pool.(string))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		defPool = lbPool
 	}
@@ -177,20 +180,20 @@ func resourceIBMISLBListenerCreate(d *schema.ResourceData, meta interface{}) err
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLBListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, port, connLimit)
+		err := classicLBListenerCreate(ctx, d, meta, lbID, protocol, defPool, certificateCRN, port, connLimit)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, port, connLimit)
+		err := lbListenerCreate(ctx, d, meta, lbID, protocol, defPool, certificateCRN, port, connLimit)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISLBListenerRead(d, meta)
+	return resourceIBMISLBListenerRead(ctx, d, meta)
 }
 
-func classicLBListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol, defPool, certificateCRN string, port, connLimit int64) error {
+func classicLBListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, protocol, defPool, certificateCRN string, port, connLimit int64) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -213,7 +216,7 @@ func classicLBListenerCreate(d *schema.ResourceData, meta interface{}, lbID, pro
 	if connLimit > int64(0) {
 		options.ConnectionLimit = &connLimit
 	}
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -224,12 +227,12 @@ func classicLBListenerCreate(d *schema.ResourceData, meta interface{}, lbID, pro
 		return fmt.Errorf("Error while creating Load Balanacer Listener err %s\n%s", err, response)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
-	_, err = isWaitForClassicLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBListenerAvailable(ctx, sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 	}
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to become ready: %s", lbID, err)
@@ -239,7 +242,7 @@ func classicLBListenerCreate(d *schema.ResourceData, meta interface{}, lbID, pro
 	return nil
 }
 
-func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol, defPool, certificateCRN string, port, connLimit int64) error {
+func lbListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, protocol, defPool, certificateCRN string, port, connLimit int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -262,7 +265,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	if connLimit > int64(0) {
 		options.ConnectionLimit = &connLimit
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -273,12 +276,12 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 		return fmt.Errorf("Error while creating Load Balanacer Listener err %s\n%s", err, response)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbListener.ID))
-	_, err = isWaitForLBListenerAvailable(sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBListenerAvailable(ctx, sess, lbID, *lbListener.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to become ready: %s", lbID, err)
@@ -288,7 +291,7 @@ func lbListenerCreate(d *schema.ResourceData, meta interface{}, lbID, protocol,
 	return nil
 }
 
-func isWaitForClassicLBListenerAvailable(sess *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBListenerAvailable(ctx context.Context, sess *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer Listener(%s) to be available.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
@@ -300,7 +303,7 @@ func isWaitForClassicLBListenerAvailable(sess *vpcclassicv1.VpcClassicV1, lbID,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicLBListenerRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -323,7 +326,7 @@ func isClassicLBListenerRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbID, lbLis
 	}
 }
 
-func isWaitForLBListenerAvailable(sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerAvailable(ctx context.Context, sess *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer Listener(%s) to be available.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
@@ -335,7 +338,7 @@ func isWaitForLBListenerAvailable(sess *vpcv1.VpcV1, lbID, lbListenerID string,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -358,15 +361,15 @@ func isLBListenerRefreshFunc(sess *vpcv1.VpcV1, lbID, lbListenerID string) resou
 	}
 }
 
-func resourceIBMISLBListenerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -375,12 +378,12 @@ func resourceIBMISLBListenerRead(d *schema.ResourceData, meta interface{}) error
 	if userDetails.generation == 1 {
 		err := classicLBListenerGet(d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := lbListenerGet(d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -471,36 +474,36 @@ func lbListenerGet(d *schema.ResourceData, meta interface{}, lbID, lbListenerID
 	return nil
 }
 
-func resourceIBMISLBListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
 	lbListenerID := parts[1]
 
 	if userDetails.generation == 1 {
-		err := classicLBListenerUpdate(d, meta, lbID, lbListenerID)
+		err := classicLBListenerUpdate(ctx, d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbListenerUpdate(d, meta, lbID, lbListenerID)
+		err := lbListenerUpdate(ctx, d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISLBListenerRead(d, meta)
+	return resourceIBMISLBListenerRead(ctx, d, meta)
 }
 
-func classicLBListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func classicLBListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -562,7 +565,7 @@ func classicLBListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbL
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -572,13 +575,13 @@ func classicLBListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbL
 			return fmt.Errorf("Error Updating Load Balancer Listener : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForClassicLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBListenerAvailable(ctx, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 		}
 
-		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer (%s) to become ready: %s", lbID, err)
@@ -587,7 +590,7 @@ func classicLBListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbL
 	return nil
 }
 
-func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func lbListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -650,7 +653,7 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -660,13 +663,13 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 			return fmt.Errorf("Error Updating Load Balancer Listener : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLBListenerAvailable(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBListenerAvailable(ctx, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer listener(%s) to become ready: %s", d.Id(), err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for load balancer (%s) to become ready: %s", lbID, err)
@@ -675,14 +678,14 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	return nil
 }
 
-func resourceIBMISLBListenerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -693,20 +696,20 @@ func resourceIBMISLBListenerDelete(d *schema.ResourceData, meta interface{}) err
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLBListenerDelete(d, meta, lbID, lbListenerID)
+		err := classicLBListenerDelete(ctx, d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbListenerDelete(d, meta, lbID, lbListenerID)
+		err := lbListenerDelete(ctx, d, meta, lbID, lbListenerID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func classicLBListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -724,7 +727,7 @@ func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbL
 		}
 		return fmt.Errorf("Error Getting vpc load balancer listener(%s): %s\n%s", lbListenerID, err, response)
 	}
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -737,11 +740,11 @@ func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbL
 	if err != nil {
 		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBListenerDeleted(ctx, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to be active: %s", lbID, err)
@@ -751,7 +754,7 @@ func classicLBListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbL
 	return nil
 }
 
-func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
+func lbListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -769,7 +772,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		}
 		return fmt.Errorf("Error Getting vpc load balancer listener(%s): %s\n%s", lbListenerID, err, response)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -782,11 +785,11 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	if err != nil {
 		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
-	_, err = isWaitForLBListenerDeleted(sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBListenerDeleted(ctx, sess, lbID, lbListenerID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for load balancer (%s) to be active: %s", lbID, err)
@@ -796,7 +799,7 @@ func lbListenerDelete(d *schema.ResourceData, meta interface{}, lbID, lbListener
 	return nil
 }
 
-func isWaitForClassicLBListenerDeleted(lbc *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBListenerDeleted(ctx context.Context, lbc *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
@@ -808,7 +811,7 @@ func isWaitForClassicLBListenerDeleted(lbc *vpcclassicv1.VpcClassicV1, lbID, lbL
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicLBListenerDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -828,7 +831,7 @@ func isClassicLBListenerDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID,
 	}
 }
 
-func isWaitForLBListenerDeleted(lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBListenerDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbListenerID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbListenerID)
 
 	stateConf := &resource.StateChangeConf{
@@ -840,7 +843,7 @@ func isWaitForLBListenerDeleted(lbc *vpcv1.VpcV1, lbID, lbListenerID string, tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string) resource.StateRefreshFunc {
@@ -859,66 +862,3 @@ func isLBListenerDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbListenerID string)
 		return lbLis, isLBListenerDeleting, nil
 	}
 }
-
-func resourceIBMISLBListenerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	lbID := parts[0]
-	lbListenerID := parts[1]
-
-	if userDetails.generation == 1 {
-		exists, err := classicLBListenerExists(d, meta, lbID, lbListenerID)
-		return exists, err
-	} else {
-		exists, err := lbListenerExists(d, meta, lbID, lbListenerID)
-		return exists, err
-	}
-}
-
-func classicLBListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	getLoadBalancerListenerOptions := &vpcclassicv1.GetLoadBalancerListenerOptions{
-		LoadBalancerID: &lbID,
-		ID:             &lbListenerID,
-	}
-	_, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Load balancer Listener: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func lbListenerExists(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	getLoadBalancerListenerOptions := &vpcv1.GetLoadBalancerListenerOptions{
-		LoadBalancerID: &lbID,
-		ID:             &lbListenerID,
-	}
-	_, response, err := sess.GetLoadBalancerListener(getLoadBalancerListenerOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Load balancer Listener: %s\n%s", err, response)
-	}
-	return true, nil
-}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -45,12 +47,13 @@ const (
 
 func resourceIBMISLBListenerPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBListenerPolicyCreate,
-		Read:     resourceIBMISLBListenerPolicyRead,
-		Update:   resourceIBMISLBListenerPolicyUpdate,
-		Delete:   resourceIBMISLBListenerPolicyDelete,
-		Exists:   resourceIBMISLBListenerPolicyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBListenerPolicyCreate,
+		ReadContext:   resourceIBMISLBListenerPolicyRead,
+		UpdateContext: resourceIBMISLBListenerPolicyUpdate,
+		DeleteContext: resourceIBMISLBListenerPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISLBListenerPolicy, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -251,10 +254,10 @@ func resourceIBMISLBListenerPolicyValidator() *ResourceValidator {
 	return &ibmISLBListenerPolicyResourceValidator
 }
 
-func resourceIBMISLBListenerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	//Get the Load balancer ID
@@ -263,7 +266,7 @@ func resourceIBMISLBListenerPolicyCreate(d *schema.ResourceData, meta interface{
 	//User can set listener id as combination of lbID/listenerID, parse and get the listenerID
 	listenerID, err := getListenerID(d.Get(isLBListenerPolicyListenerID).(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	action := d.Get(isLBListenerPolicyAction).(string)
@@ -276,18 +279,18 @@ func resourceIBMISLBListenerPolicyCreate(d *schema.ResourceData, meta interface{
 	}
 
 	if userDetails.generation == 1 {
-		err := classicLbListenerPolicyCreate(d, meta, lbID, listenerID, action, name, priority)
+		err := classicLbListenerPolicyCreate(ctx, d, meta, lbID, listenerID, action, name, priority)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbListenerPolicyCreate(d, meta, lbID, listenerID, action, name, priority)
+		err := lbListenerPolicyCreate(ctx, d, meta, lbID, listenerID, action, name, priority)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISLBListenerPolicyRead(d, meta)
+	return resourceIBMISLBListenerPolicyRead(ctx, d, meta)
 }
 
 func getListenerID(id string) (string, error) {
@@ -303,7 +306,7 @@ func getListenerID(id string) (string, error) {
 	}
 }
 
-func classicLbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) error {
+func classicLbListenerPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -407,7 +410,7 @@ func classicLbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbI
 	ibmMutexKV.Lock(isLBKey)
 	defer ibmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForClassicLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLbAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -420,7 +423,7 @@ func classicLbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbI
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, listenerID, *(policy.ID)))
 
-	_, err = isWaitForClassicLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLbListenerPolicyAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -440,7 +443,7 @@ func getPoolID(id string) (string, error) {
 
 }
 
-func isWaitForClassicLbAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLbAvailable(ctx context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -451,7 +454,7 @@ func isWaitForClassicLbAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, time
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLbClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -474,7 +477,7 @@ func isLbClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.
 	}
 }
 
-func isWaitForClassicLbListenerPolicyAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLbListenerPolicyAvailable(ctx context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -485,7 +488,7 @@ func isWaitForClassicLbListenerPolicyAvailable(vpc *vpcclassicv1.VpcClassicV1, i
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLbListenerPolicyClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -519,7 +522,7 @@ func isLbListenerPolicyClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id str
 	}
 }
 
-func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) error {
+func lbListenerPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) error {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -623,7 +626,7 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 	ibmMutexKV.Lock(isLBKey)
 	defer ibmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -636,14 +639,14 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, listenerID, *(policy.ID)))
 
-	_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func isWaitForLbAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyPending},
@@ -654,7 +657,7 @@ func isWaitForLbAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (i
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -677,7 +680,7 @@ func isLbRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
 	}
 }
 
-func isWaitForLbListenerPolicyAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -688,7 +691,7 @@ func isWaitForLbListenerPolicyAvailable(vpc *vpcv1.VpcV1, id string, timeout tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -723,17 +726,16 @@ func isLbListenerPolicyRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRe
 	}
 }
 
-func resourceIBMISLBListenerPolicyRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMISLBListenerPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
 	parts, err := idParts(ID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -743,109 +745,27 @@ func resourceIBMISLBListenerPolicyRead(d *schema.ResourceData, meta interface{})
 	if userDetails.generation == 1 {
 		err := classicLbListenerPolicyGet(d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := lbListenerPolicyGet(d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
 }
 
-func resourceIBMISLBListenerPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	ID := d.Id()
-	if userDetails.generation == 1 {
-		exists, err := classicLbListenerPolicyExists(d, meta, ID)
-		return exists, err
-	} else {
-		exists, err := lbListenerPolicyExists(d, meta, ID)
-		return exists, err
-	}
-}
-
-func classicLbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	//Retrieve lbID, listenerID and policyID
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	lbID := parts[0]
-	listenerID := parts[1]
-	policyID := parts[2]
-
-	//populate lblistenerpolicyOPtions
-	getLbListenerPolicyOptions := &vpcclassicv1.GetLoadBalancerListenerPolicyOptions{
-		LoadBalancerID: &lbID,
-		ListenerID:     &listenerID,
-		ID:             &policyID,
-	}
-
-	//Getting lb listener policy
-	_, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
-
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Load balancer policy: %s\n%s", err, response)
-	}
-
-	return true, nil
-}
-
-func lbListenerPolicyExists(d *schema.ResourceData, meta interface{}, ID string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	lbID := parts[0]
-	listenerID := parts[1]
-	policyID := parts[2]
-
-	getLbListenerPolicyOptions := &vpcv1.GetLoadBalancerListenerPolicyOptions{
-		LoadBalancerID: &lbID,
-		ListenerID:     &listenerID,
-		ID:             &policyID,
-	}
-
-	//Getting lb listener policy
-	_, response, err := sess.GetLoadBalancerListenerPolicy(getLbListenerPolicyOptions)
-
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Load balancer policy: %s\n%s", err, response)
-	}
-	return true, nil
-}
-func resourceIBMISLBListenerPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -854,22 +774,22 @@ func resourceIBMISLBListenerPolicyUpdate(d *schema.ResourceData, meta interface{
 
 	if userDetails.generation == 1 {
 
-		err := classicLbListenerPolicyUpdate(d, meta, lbID, listenerID, policyID)
+		err := classicLbListenerPolicyUpdate(ctx, d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 
-		err := lbListenerPolicyUpdate(d, meta, lbID, listenerID, policyID)
+		err := lbListenerPolicyUpdate(ctx, d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISLBListenerPolicyRead(d, meta)
+	return resourceIBMISLBListenerPolicyRead(ctx, d, meta)
 }
 
-func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
+func classicLbListenerPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -952,7 +872,7 @@ func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbI
 			return fmt.Errorf("Error calling asPatch for LoadBalancerListenerPolicyPatch: %s", err)
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
-		_, err = isWaitForClassicLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForClassicLbAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -962,7 +882,7 @@ func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbI
 			return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
 		}
 
-		_, err = isWaitForClassicLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForClassicLbListenerPolicyAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -970,7 +890,7 @@ func classicLbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbI
 	return nil
 }
 
-func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
+func lbListenerPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -1050,7 +970,7 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -1060,7 +980,7 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 			return fmt.Errorf("Error Updating in policy : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLbListenerPolicyAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -1068,17 +988,17 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 	return nil
 }
 
-func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	//Retrieve lbId, listenerId and policyID
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -1090,14 +1010,14 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLbListenerPolicycDelete(d, meta, lbID, listenerID, policyID)
+		err := classicLbListenerPolicycDelete(ctx, d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbListenerPolicyDelete(d, meta, lbID, listenerID, policyID)
+		err := lbListenerPolicyDelete(ctx, d, meta, lbID, listenerID, policyID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	d.SetId("")
@@ -1105,7 +1025,7 @@ func resourceIBMISLBListenerPolicyDelete(d *schema.ResourceData, meta interface{
 
 }
 
-func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
+func classicLbListenerPolicycDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -1134,7 +1054,7 @@ func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lb
 		ID:             &ID,
 	}
 
-	_, err = isWaitForClassicLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLbAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -1144,14 +1064,14 @@ func classicLbListenerPolicycDelete(d *schema.ResourceData, meta interface{}, lb
 	if err != nil {
 		return fmt.Errorf("Error in classicLbListenerPolicycDelete: %s\n%s", err, response)
 	}
-	_, err = isWaitForLbListenerPolicyClassicDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListenerPolicyClassicDeleted(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
+func lbListenerPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) error {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -1179,7 +1099,7 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 		ID:             &ID,
 	}
 
-	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -1189,13 +1109,13 @@ func lbListenerPolicyDelete(d *schema.ResourceData, meta interface{}, lbID, list
 	if err != nil {
 		return fmt.Errorf("Error in lbListenerPolicyDelete: %s\n%s", err, response)
 	}
-	_, err = isWaitForLbListnerPolicyDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyDeleted(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting},
@@ -1206,7 +1126,7 @@ func isWaitForLbListnerPolicyDeleted(vpc *vpcv1.VpcV1, id string, timeout time.D
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLbListenerPolicyDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1411,7 +1331,7 @@ func lbListenerPolicyGet(d *schema.ResourceData, meta interface{}, lbID, listene
 	return nil
 }
 
-func isWaitForLbListenerPolicyClassicDeleted(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyClassicDeleted(ctx context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRetry, isLBListenerPolicyDeleting, "delete_pending"},
@@ -1422,7 +1342,7 @@ func isWaitForLbListenerPolicyClassicDeleted(vpc *vpcclassicv1.VpcClassicV1, id
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLbListenerPolicyClassicDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
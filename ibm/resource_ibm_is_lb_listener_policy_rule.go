@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -37,12 +39,13 @@ const (
 
 func resourceIBMISLBListenerPolicyRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBListenerPolicyRuleCreate,
-		Read:     resourceIBMISLBListenerPolicyRuleRead,
-		Update:   resourceIBMISLBListenerPolicyRuleUpdate,
-		Delete:   resourceIBMISLBListenerPolicyRuleDelete,
-		Exists:   resourceIBMISLBListenerPolicyRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBListenerPolicyRuleCreate,
+		ReadContext:   resourceIBMISLBListenerPolicyRuleRead,
+		UpdateContext: resourceIBMISLBListenerPolicyRuleUpdate,
+		DeleteContext: resourceIBMISLBListenerPolicyRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISLBListenerPolicyRule, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -181,10 +184,10 @@ func resourceIBMISLBListenerPolicyRuleValidator() *ResourceValidator {
 	return &ibmISLBListenerPolicyRuleResourceValidator
 }
 
-func resourceIBMISLBListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	//Read lb, listerner, policy IDs
@@ -192,12 +195,12 @@ func resourceIBMISLBListenerPolicyRuleCreate(d *schema.ResourceData, meta interf
 	lbID := d.Get(isLBListenerPolicyRuleLBID).(string)
 	listenerID, err := getLbListenerID(d.Get(isLBListenerPolicyRuleListenerID).(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	policyID, err := getLbPolicyID(d.Get(isLBListenerPolicyRulePolicyID).(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	condition := d.Get(isLBListenerPolicyRulecondition).(string)
@@ -208,18 +211,18 @@ func resourceIBMISLBListenerPolicyRuleCreate(d *schema.ResourceData, meta interf
 	}
 
 	if userDetails.generation == 1 {
-		err := classicLbListenerPolicyRuleCreate(d, meta, lbID, listenerID, policyID, condition, ty, value, field)
+		err := classicLbListenerPolicyRuleCreate(ctx, d, meta, lbID, listenerID, policyID, condition, ty, value, field)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbListenerPolicyRuleCreate(d, meta, lbID, listenerID, policyID, condition, ty, value, field)
+		err := lbListenerPolicyRuleCreate(ctx, d, meta, lbID, listenerID, policyID, condition, ty, value, field)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISLBListenerPolicyRuleRead(d, meta)
+	return resourceIBMISLBListenerPolicyRuleRead(ctx, d, meta)
 }
 
 func getLbListenerID(id string) (string, error) {
@@ -248,7 +251,7 @@ func getLbPolicyID(id string) (string, error) {
 	}
 }
 
-func classicLbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, condition, ty, value, field string) error {
+func classicLbListenerPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, condition, ty, value, field string) error {
 	sess, err := classicVpcSdkClient(meta)
 	if err != nil {
 		return err
@@ -268,7 +271,7 @@ func classicLbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{},
 	ibmMutexKV.Lock(isLBKey)
 	defer ibmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForClassicLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLoadbalancerAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -281,14 +284,14 @@ func classicLbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{},
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", lbID, listenerID, policyID, *(rule.ID)))
 
-	_, err = isWaitForClassicLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLbListenerPolicyRuleAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func isWaitForClassicLoadbalancerAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLoadbalancerAvailable(ctx context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -299,7 +302,7 @@ func isWaitForClassicLoadbalancerAvailable(vpc *vpcclassicv1.VpcClassicV1, id st
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLoadbalancerClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -322,7 +325,7 @@ func isLoadbalancerClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string)
 	}
 }
 
-func isWaitForClassicLbListenerPolicyRuleAvailable(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLbListenerPolicyRuleAvailable(ctx context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -333,7 +336,7 @@ func isWaitForClassicLbListenerPolicyRuleAvailable(vpc *vpcclassicv1.VpcClassicV
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLbListenerPolicyRuleClassicRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -374,7 +377,7 @@ func vpcSdkClient(meta interface{}) (*vpcv1.VpcV1, error) {
 	return sess, err
 }
 
-func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, condition, ty, value, field string) error {
+func lbListenerPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, condition, ty, value, field string) error {
 
 	sess, err := vpcSdkClient(meta)
 	if err != nil {
@@ -395,7 +398,7 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 	ibmMutexKV.Lock(isLBKey)
 	defer ibmMutexKV.Unlock(isLBKey)
 
-	_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLoadbalancerAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -408,7 +411,7 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 
 	d.SetId(fmt.Sprintf("%s/%s/%s/%s", lbID, listenerID, policyID, *(rule.ID)))
 
-	_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLbListenerPolicyRuleAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -416,7 +419,7 @@ func lbListenerPolicyRuleCreate(d *schema.ResourceData, meta interface{}, lbID,
 	return nil
 }
 
-func isWaitForLoadbalancerAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLoadbalancerAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRulePending},
@@ -427,7 +430,7 @@ func isWaitForLoadbalancerAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Du
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -450,7 +453,7 @@ func isLoadbalancerRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefres
 	}
 }
 
-func isWaitForLbListenerPolicyRuleAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyRuleAvailable(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", isLBListenerPolicyRuleProvisioning, "create_pending", "update_pending", "maintenance_pending"},
@@ -461,7 +464,7 @@ func isWaitForLbListenerPolicyRuleAvailable(vpc *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -498,17 +501,16 @@ func isLbListenerPolicyRuleRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.Sta
 	}
 }
 
-func resourceIBMISLBListenerPolicyRuleRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMISLBListenerPolicyRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
 	parts, err := idParts(ID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -519,111 +521,27 @@ func resourceIBMISLBListenerPolicyRuleRead(d *schema.ResourceData, meta interfac
 	if userDetails.generation == 1 {
 		err := classicLbListenerPolicyRuleGet(d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := lbListenerPolicyRuleGet(d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
 }
 
-func resourceIBMISLBListenerPolicyRuleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	ID := d.Id()
-	if userDetails.generation == 1 {
-		exists, err := classicLbListenerPolicyRuleExists(d, meta, ID)
-		return exists, err
-	} else {
-		exists, err := lbListenerPolicyRuleExists(d, meta, ID)
-		return exists, err
-	}
-}
-
-func classicLbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{}, ID string) (bool, error) {
-	sess, err := classicVpcSdkClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	//Retrieve lbID, listenerID and policyID
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	lbID := parts[0]
-	listenerID := parts[1]
-	policyID := parts[2]
-	ruleID := parts[3]
-
-	//populate lblistenerpolicyOPtions
-	getLbListenerPolicyRuleOptions := &vpcclassicv1.GetLoadBalancerListenerPolicyRuleOptions{
-		LoadBalancerID: &lbID,
-		ListenerID:     &listenerID,
-		PolicyID:       &policyID,
-		ID:             &ruleID,
-	}
-
-	//Getting lb listener policy
-	_, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting policy: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func lbListenerPolicyRuleExists(d *schema.ResourceData, meta interface{}, ID string) (bool, error) {
-	sess, err := vpcSdkClient(meta)
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	lbID := parts[0]
-	listenerID := parts[1]
-	policyID := parts[2]
-	ruleID := parts[3]
-
-	getLbListenerPolicyRuleOptions := &vpcv1.GetLoadBalancerListenerPolicyRuleOptions{
-		LoadBalancerID: &lbID,
-		ListenerID:     &listenerID,
-		PolicyID:       &policyID,
-		ID:             &ruleID,
-	}
-
-	//Getting lb listener policy
-	_, response, err := sess.GetLoadBalancerListenerPolicyRule(getLbListenerPolicyRuleOptions)
-
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting policy: %s\n%s", err, response)
-	}
-	return true, nil
-}
-func resourceIBMISLBListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -633,22 +551,22 @@ func resourceIBMISLBListenerPolicyRuleUpdate(d *schema.ResourceData, meta interf
 
 	if userDetails.generation == 1 {
 
-		err := classicLbListenerPolicyRuleUpdate(d, meta, lbID, listenerID, policyID, ruleID)
+		err := classicLbListenerPolicyRuleUpdate(ctx, d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 
-		err := lbListenerPolicyRuleUpdate(d, meta, lbID, listenerID, policyID, ruleID)
+		err := lbListenerPolicyRuleUpdate(ctx, d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISLBListenerPolicyRuleRead(d, meta)
+	return resourceIBMISLBListenerPolicyRuleRead(ctx, d, meta)
 }
 
-func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
+func classicLbListenerPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
 	sess, err := classicVpcSdkClient(meta)
 	if err != nil {
 		return err
@@ -698,7 +616,7 @@ func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{},
 		}
 		updatePolicyRuleOptions.LoadBalancerListenerPolicyRulePatch = loadBalancerListenerPolicyRulePatch
 
-		_, err = isWaitForClassicLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForClassicLoadbalancerAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -708,7 +626,7 @@ func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{},
 			return fmt.Errorf("Error Getting Instance: %s\n%s", err, response)
 		}
 
-		_, err = isWaitForClassicLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForClassicLbListenerPolicyRuleAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -716,7 +634,7 @@ func classicLbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{},
 	return nil
 }
 
-func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
+func lbListenerPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
 	sess, err := vpcSdkClient(meta)
 	if err != nil {
 		return err
@@ -765,7 +683,7 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLoadbalancerAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return fmt.Errorf(
 				"LB-LP Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -776,7 +694,7 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 			return fmt.Errorf("Error Updating in policy : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLbListenerPolicyRuleAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLbListenerPolicyRuleAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -784,17 +702,17 @@ func lbListenerPolicyRuleUpdate(d *schema.ResourceData, meta interface{}, lbID,
 	return nil
 }
 
-func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBListenerPolicyRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	//Retrieve lbId, listenerId and policyID
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -807,14 +725,14 @@ func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interf
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLbListenerPolicyRuleDelete(d, meta, lbID, listenerID, policyID, ruleID)
+		err := classicLbListenerPolicyRuleDelete(ctx, d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbListenerPolicyRuleDelete(d, meta, lbID, listenerID, policyID, ruleID)
+		err := lbListenerPolicyRuleDelete(ctx, d, meta, lbID, listenerID, policyID, ruleID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -823,7 +741,7 @@ func resourceIBMISLBListenerPolicyRuleDelete(d *schema.ResourceData, meta interf
 
 }
 
-func classicLbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
+func classicLbListenerPolicyRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
 	sess, err := classicVpcSdkClient(meta)
 	if err != nil {
 		return err
@@ -859,14 +777,14 @@ func classicLbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{},
 	if err != nil {
 		return fmt.Errorf("Error in classicLbListenerPolicyRuleDelete: %s\n%s", err, response)
 	}
-	_, err = isWaitForLbListenerPolicyRuleClassicDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListenerPolicyRuleClassicDeleted(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
+func lbListenerPolicyRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, policyID, ID string) error {
 
 	sess, err := vpcSdkClient(meta)
 	if err != nil {
@@ -901,13 +819,13 @@ func lbListenerPolicyRuleDelete(d *schema.ResourceData, meta interface{}, lbID,
 	if err != nil {
 		return fmt.Errorf("Error in lbListenerPolicyRuleDelete: %s\n%s", err, response)
 	}
-	_, err = isWaitForLbListnerPolicyRuleDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLbListnerPolicyRuleDeleted(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 	return nil
 }
-func isWaitForLbListnerPolicyRuleDeleted(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListnerPolicyRuleDeleted(ctx context.Context, vpc *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting},
@@ -918,7 +836,7 @@ func isWaitForLbListnerPolicyRuleDeleted(vpc *vpcv1.VpcV1, id string, timeout ti
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLbListenerPolicyRuleDeleteRefreshFunc(vpc *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -1052,7 +970,7 @@ func lbListenerPolicyRuleGet(d *schema.ResourceData, meta interface{}, lbID, lis
 	return nil
 }
 
-func isWaitForLbListenerPolicyRuleClassicDeleted(vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForLbListenerPolicyRuleClassicDeleted(ctx context.Context, vpc *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{isLBListenerPolicyRuleRetry, isLBListenerPolicyRuleDeleting, "delete_pending"},
@@ -1063,7 +981,7 @@ func isWaitForLbListenerPolicyRuleClassicDeleted(vpc *vpcclassicv1.VpcClassicV1,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLbListenerPolicyRuleClassicDeleteRefreshFunc(vpc *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
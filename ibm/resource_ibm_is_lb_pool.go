@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,12 +42,13 @@ const (
 
 func resourceIBMISLBPool() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBPoolCreate,
-		Read:     resourceIBMISLBPoolRead,
-		Update:   resourceIBMISLBPoolUpdate,
-		Delete:   resourceIBMISLBPoolDelete,
-		Exists:   resourceIBMISLBPoolExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBPoolCreate,
+		ReadContext:   resourceIBMISLBPoolRead,
+		UpdateContext: resourceIBMISLBPoolUpdate,
+		DeleteContext: resourceIBMISLBPoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISLBPool, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -203,10 +206,10 @@ func resourceIBMISLBPoolValidator() *ResourceValidator {
 	return &ibmISLBPoolResourceValidator
 }
 
-func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("[DEBUG] LB Pool create")
@@ -241,26 +244,26 @@ func resourceIBMISLBPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLBPoolCreate(d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
+		err := classicLBPoolCreate(ctx, d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbPoolCreate(d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
+		err := lbPoolCreate(ctx, d, meta, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL, healthDelay, maxRetries, healthTimeOut, healthMonitorPort)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISLBPoolRead(d, meta)
+	return resourceIBMISLBPoolRead(ctx, d, meta)
 }
 
-func classicLBPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL string, healthDelay, maxRetries, healthTimeOut, healthMonitorPort int64) error {
+func classicLBPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL string, healthDelay, maxRetries, healthTimeOut, healthMonitorPort int64) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -297,13 +300,13 @@ func classicLBPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, a
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
 	log.Printf("[INFO] lbpool : %s", *lbPool.ID)
 
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBPoolActive(ctx, sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", *lbPool.ID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -312,13 +315,13 @@ func classicLBPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, a
 	return nil
 }
 
-func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL string, healthDelay, maxRetries, healthTimeOut, healthMonitorPort int64) error {
+func lbPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, lbID, algorithm, protocol, healthType, spType, cName, healthMonitorURL string, healthDelay, maxRetries, healthTimeOut, healthMonitorPort int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -355,13 +358,13 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	d.SetId(fmt.Sprintf("%s/%s", lbID, *lbPool.ID))
 	log.Printf("[INFO] lbpool : %s", *lbPool.ID)
 
-	_, err = isWaitForLBPoolActive(sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, *lbPool.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", *lbPool.ID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -370,15 +373,15 @@ func lbPoolCreate(d *schema.ResourceData, meta interface{}, name, lbID, algorith
 	return nil
 }
 
-func resourceIBMISLBPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -387,12 +390,12 @@ func resourceIBMISLBPoolRead(d *schema.ResourceData, meta interface{}) error {
 	if userDetails.generation == 1 {
 		err := classicLBPoolGet(d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := lbPoolGet(d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -500,36 +503,36 @@ func lbPoolGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string)
 	return nil
 }
 
-func resourceIBMISLBPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
 	lbPoolID := parts[1]
 
 	if userDetails.generation == 1 {
-		err := classicLBPoolUpdate(d, meta, lbID, lbPoolID)
+		err := classicLBPoolUpdate(ctx, d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbPoolUpdate(d, meta, lbID, lbPoolID)
+		err := lbPoolUpdate(ctx, d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISLBPoolRead(d, meta)
+	return resourceIBMISLBPoolRead(ctx, d, meta)
 }
 
-func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func classicLBPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -589,13 +592,13 @@ func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 		isLBKey := "load_balancer_key_" + lbID
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
-		_, err := isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err := isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 
-		_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
@@ -612,13 +615,13 @@ func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 			return fmt.Errorf("Error Updating Load Balancer Pool : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -627,7 +630,7 @@ func classicLBPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	return nil
 }
 
-func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func lbPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -686,13 +689,13 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		isLBKey := "load_balancer_key_" + lbID
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
-		_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err := isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
@@ -709,13 +712,13 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 			return fmt.Errorf("Error Updating Load Balancer Pool : %s\n%s", err, response)
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -724,15 +727,15 @@ func lbPoolUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	return nil
 }
 
-func resourceIBMISLBPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -743,20 +746,20 @@ func resourceIBMISLBPoolDelete(d *schema.ResourceData, meta interface{}) error {
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classicLBPoolDelete(d, meta, lbID, lbPoolID)
+		err := classicLBPoolDelete(ctx, d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbPoolDelete(d, meta, lbID, lbPoolID)
+		err := lbPoolDelete(ctx, d, meta, lbID, lbPoolID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func classicLBPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -774,13 +777,13 @@ func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 		}
 		return fmt.Errorf("Error Getting vpc load balancer pool(%s): %s\n%s", lbPoolID, err, response)
 	}
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
@@ -794,13 +797,13 @@ func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	if err != nil {
 		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolDeleted(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is deleted: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -809,7 +812,7 @@ func classicLBPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolI
 	return nil
 }
 
-func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
+func lbPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -826,13 +829,13 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 		}
 		return fmt.Errorf("Error Getting vpc load balancer pool(%s): %s\n%s", lbPoolID, err, response)
 	}
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
@@ -846,13 +849,13 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	if err != nil {
 		return fmt.Errorf("Error Deleting Load Balancer Pool : %s\n%s", err, response)
 	}
-	_, err = isWaitForLBPoolDeleted(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolDeleted(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is deleted: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -861,70 +864,7 @@ func lbPoolDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID strin
 	return nil
 }
 
-func resourceIBMISLBPoolExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	lbID := parts[0]
-	lbPoolID := parts[1]
-
-	if userDetails.generation == 1 {
-		exists, err := classicLBPoolExists(d, meta, lbID, lbPoolID)
-		return exists, err
-	} else {
-		exists, err := lbPoolExists(d, meta, lbID, lbPoolID)
-		return exists, err
-	}
-}
-
-func classicLBPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	getLoadBalancerPoolOptions := &vpcclassicv1.GetLoadBalancerPoolOptions{
-		LoadBalancerID: &lbID,
-		ID:             &lbPoolID,
-	}
-	_, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Load balancer pool: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func lbPoolExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	getLoadBalancerPoolOptions := &vpcv1.GetLoadBalancerPoolOptions{
-		LoadBalancerID: &lbID,
-		ID:             &lbPoolID,
-	}
-	_, response, err := sess.GetLoadBalancerPool(getLoadBalancerPoolOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Load balancer pool: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func isWaitForLBPoolActive(sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolActive(ctx context.Context, sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool (%s) to be available.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
@@ -936,7 +876,7 @@ func isWaitForLBPoolActive(sess *vpcv1.VpcV1, lbId, lbPoolId string, timeout tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -959,7 +899,7 @@ func isLBPoolRefreshFunc(sess *vpcv1.VpcV1, lbId, lbPoolId string) resource.Stat
 	}
 }
 
-func isWaitForClassicLBPoolActive(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBPoolActive(ctx context.Context, sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool (%s) to be available.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
@@ -971,7 +911,7 @@ func isWaitForClassicLBPoolActive(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolI
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicLBPoolRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -994,7 +934,7 @@ func isClassicLBPoolRefreshFunc(sess *vpcclassicv1.VpcClassicV1, lbId, lbPoolId
 	}
 }
 
-func isWaitForClassicLBPoolDeleted(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBPoolDeleted(ctx context.Context, lbc *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
@@ -1006,7 +946,7 @@ func isWaitForClassicLBPoolDeleted(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPoolI
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicLBPoolDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPoolId string) resource.StateRefreshFunc {
@@ -1027,7 +967,7 @@ func isClassicLBPoolDeleteRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbId, lbPo
 	}
 }
 
-func isWaitForLBPoolDeleted(lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolId)
 
 	stateConf := &resource.StateChangeConf{
@@ -1039,7 +979,7 @@ func isWaitForLBPoolDeleted(lbc *vpcv1.VpcV1, lbId, lbPoolId string, timeout tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLBPoolDeleteRefreshFunc(lbc *vpcv1.VpcV1, lbId, lbPoolId string) resource.StateRefreshFunc {
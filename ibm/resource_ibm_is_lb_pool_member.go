@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -32,12 +34,13 @@ const (
 
 func resourceIBMISLBPoolMember() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISLBPoolMemberCreate,
-		Read:     resourceIBMISLBPoolMemberRead,
-		Update:   resourceIBMISLBPoolMemberUpdate,
-		Delete:   resourceIBMISLBPoolMemberDelete,
-		Exists:   resourceIBMISLBPoolMemberExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISLBPoolMemberCreate,
+		ReadContext:   resourceIBMISLBPoolMemberRead,
+		UpdateContext: resourceIBMISLBPoolMemberUpdate,
+		DeleteContext: resourceIBMISLBPoolMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISLBPoolMember, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -132,15 +135,15 @@ func resourceIBMISLBPoolMember() *schema.Resource {
 	}
 }
 
-func resourceIBMISLBPoolMemberCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	log.Printf("[DEBUG] LB Pool create")
 	lbPoolID, err := getPoolId(d.Get(isLBPoolID).(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := d.Get(isLBID).(string)
@@ -157,32 +160,32 @@ func resourceIBMISLBPoolMemberCreate(d *schema.ResourceData, meta interface{}) e
 
 	if userDetails.generation == 1 {
 		targetAddress := d.Get(isLBPoolMemberTargetAddress).(string)
-		err := classiclbpMemberCreate(d, meta, lbID, lbPoolID, targetAddress, port64, weight)
+		err := classiclbpMemberCreate(ctx, d, meta, lbID, lbPoolID, targetAddress, port64, weight)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbpMemberCreate(d, meta, lbID, lbPoolID, port64, weight)
+		err := lbpMemberCreate(ctx, d, meta, lbID, lbPoolID, port64, weight)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISLBPoolMemberRead(d, meta)
+	return resourceIBMISLBPoolMemberRead(ctx, d, meta)
 }
 
-func classiclbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, targetAddress string, port, weight int64) error {
+func classiclbpMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, targetAddress string, port, weight int64) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -207,18 +210,18 @@ func classiclbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, lbPoolID, *lbPoolMember.ID))
 	log.Printf("[INFO] lbpool member : %s", *lbPoolMember.ID)
 
-	_, err = isWaitForClassicLBPoolMemberAvailable(sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -227,18 +230,18 @@ func classiclbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	return nil
 }
 
-func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID string, port, weight int64) error {
+func lbpMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID string, port, weight int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
 	}
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -275,18 +278,18 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	d.SetId(fmt.Sprintf("%s/%s/%s", lbID, lbPoolID, *lbPoolMember.ID))
 	log.Printf("[INFO] lbpool member : %s", *lbPoolMember.ID)
 
-	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, *lbPoolMember.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -295,7 +298,7 @@ func lbpMemberCreate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID st
 	return nil
 }
 
-func isWaitForClassicLBPoolMemberAvailable(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBPoolMemberAvailable(ctx context.Context, lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool member(%s) to be available.", lbPoolMemID)
 
 	stateConf := &resource.StateChangeConf{
@@ -307,7 +310,7 @@ func isWaitForClassicLBPoolMemberAvailable(lbc *vpcclassicv1.VpcClassicV1, lbID,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
@@ -331,7 +334,7 @@ func isClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPo
 	}
 }
 
-func isWaitForLBPoolMemberAvailable(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolMemberAvailable(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for load balancer pool member(%s) to be available.", lbPoolMemID)
 
 	stateConf := &resource.StateChangeConf{
@@ -343,7 +346,7 @@ func isWaitForLBPoolMemberAvailable(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemI
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
@@ -367,20 +370,20 @@ func isLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID str
 	}
 }
 
-func resourceIBMISLBPoolMemberRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if len(parts) < 3 {
-		return fmt.Errorf(
-			"The id should contain loadbalancer Id, loadbalancer pool Id and loadbalancer poolmemebr Id")
+		return diagFromErr(fmt.Errorf(
+			"The id should contain loadbalancer Id, loadbalancer pool Id and loadbalancer poolmemebr Id"))
 	}
 
 	lbID := parts[0]
@@ -390,12 +393,12 @@ func resourceIBMISLBPoolMemberRead(d *schema.ResourceData, meta interface{}) err
 	if userDetails.generation == 1 {
 		err := classiclbpmemberGet(d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := lbpmemberGet(d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -486,15 +489,15 @@ func lbpmemberGet(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPo
 	return nil
 }
 
-func resourceIBMISLBPoolMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -502,20 +505,20 @@ func resourceIBMISLBPoolMemberUpdate(d *schema.ResourceData, meta interface{}) e
 	lbPoolMemID := parts[2]
 
 	if userDetails.generation == 1 {
-		err := classiclbpmemberUpdate(d, meta, lbID, lbPoolID, lbPoolMemID)
+		err := classiclbpmemberUpdate(ctx, d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbpmemberUpdate(d, meta, lbID, lbPoolID, lbPoolMemID)
+		err := lbpmemberUpdate(ctx, d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISLBPoolMemberRead(d, meta)
+	return resourceIBMISLBPoolMemberRead(ctx, d, meta)
 }
-func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func classiclbpmemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -530,18 +533,18 @@ func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForClassicLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -571,18 +574,18 @@ func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 		if err != nil {
 			return fmt.Errorf("Error Updating Load Balancer Pool Member: %s\n%s", err, response)
 		}
-		_, err = isWaitForClassicLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -591,7 +594,7 @@ func classiclbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	return nil
 }
 
-func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func lbpmemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -606,18 +609,18 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		ibmMutexKV.Lock(isLBKey)
 		defer ibmMutexKV.Unlock(isLBKey)
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -658,18 +661,18 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		if err != nil {
 			return fmt.Errorf("Error Updating Load Balancer Pool Member: %s\n%s", err, response)
 		}
-		_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
+		_, err = isWaitForLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 
-		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 		}
 
-		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
+		_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf(
 				"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -678,16 +681,16 @@ func lbpmemberUpdate(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	return nil
 }
 
-func resourceIBMISLBPoolMemberDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISLBPoolMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	lbID := parts[0]
@@ -699,21 +702,21 @@ func resourceIBMISLBPoolMemberDelete(d *schema.ResourceData, meta interface{}) e
 	defer ibmMutexKV.Unlock(isLBKey)
 
 	if userDetails.generation == 1 {
-		err := classiclbpmemberDelete(d, meta, lbID, lbPoolID, lbPoolMemID)
+		err := classiclbpmemberDelete(ctx, d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := lbpmemberDelete(d, meta, lbID, lbPoolID, lbPoolMemID)
+		err := lbpmemberDelete(ctx, d, meta, lbID, lbPoolID, lbPoolMemID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func classiclbpmemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -731,18 +734,18 @@ func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPo
 		}
 		return fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -758,18 +761,18 @@ func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPo
 		return fmt.Errorf("Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
 	}
 
-	_, err = isWaitForClassicLBPoolMemberDeleted(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolMemberDeleted(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForClassicLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForClassicLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -779,7 +782,7 @@ func classiclbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPo
 	return nil
 }
 
-func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
+func lbpmemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -798,18 +801,18 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		}
 		return fmt.Errorf("Error Getting Load Balancer Pool Member: %s\n%s", err, response)
 	}
-	_, err = isWaitForLBPoolMemberAvailable(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolMemberAvailable(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -825,18 +828,18 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 		return fmt.Errorf("Error Deleting Load Balancer Pool Member: %s\n%s", err, response)
 	}
 
-	_, err = isWaitForLBPoolMemberDeleted(sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolMemberDeleted(ctx, sess, lbID, lbPoolID, lbPoolMemID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
 
-	_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBPoolActive(ctx, sess, lbID, lbPoolID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer pool (%s) is active: %s", lbPoolID, err)
 	}
 
-	_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLBAvailable(ctx, sess, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for load balancer (%s) is active: %s", lbID, err)
@@ -845,7 +848,7 @@ func lbpmemberDelete(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, l
 	d.SetId("")
 	return nil
 }
-func isWaitForClassicLBPoolMemberDeleted(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicLBPoolMemberDeleted(ctx context.Context, lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolMemID)
 
 	stateConf := &resource.StateChangeConf{
@@ -857,9 +860,9 @@ func isWaitForClassicLBPoolMemberDeleted(lbc *vpcclassicv1.VpcClassicV1, lbID, l
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
-func isWaitForLBPoolMemberDeleted(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
+func isWaitForLBPoolMemberDeleted(ctx context.Context, lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", lbPoolMemID)
 
 	stateConf := &resource.StateChangeConf{
@@ -871,7 +874,7 @@ func isWaitForLBPoolMemberDeleted(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMemID
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isDeleteClassicLBPoolMemberRefreshFunc(lbc *vpcclassicv1.VpcClassicV1, lbID, lbPoolID, lbPoolMemID string) resource.StateRefreshFunc {
@@ -912,75 +915,6 @@ func isDeleteLBPoolMemberRefreshFunc(lbc *vpcv1.VpcV1, lbID, lbPoolID, lbPoolMem
 	}
 }
 
-func resourceIBMISLBPoolMemberExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	if len(parts) < 3 {
-		return false, fmt.Errorf(
-			"The id should contain loadbalancer Id, loadbalancer pool Id and loadbalancer poolmemebr Id")
-	}
-
-	lbID := parts[0]
-	lbPoolID := parts[1]
-	lbPoolMemID := parts[2]
-
-	if userDetails.generation == 1 {
-		exists, err := classiclbpmemberExists(d, meta, lbID, lbPoolID, lbPoolMemID)
-		return exists, err
-	} else {
-		exists, err := lbpmemberExists(d, meta, lbID, lbPoolID, lbPoolMemID)
-		return exists, err
-	}
-}
-
-func classiclbpmemberExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	getlbpmoptions := &vpcclassicv1.GetLoadBalancerPoolMemberOptions{
-		LoadBalancerID: &lbID,
-		PoolID:         &lbPoolID,
-		ID:             &lbPoolMemID,
-	}
-	_, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Load balancer pool member: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func lbpmemberExists(d *schema.ResourceData, meta interface{}, lbID, lbPoolID, lbPoolMemID string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	getlbpmoptions := &vpcv1.GetLoadBalancerPoolMemberOptions{
-		LoadBalancerID: &lbID,
-		PoolID:         &lbPoolID,
-		ID:             &lbPoolMemID,
-	}
-	_, response, err := sess.GetLoadBalancerPoolMember(getlbpmoptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Load balancer pool member: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
 func getPoolId(id string) (string, error) {
 	if strings.Contains(id, "/") {
 		parts, err := idParts(id)
@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"container/list"
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -43,12 +45,13 @@ const (
 
 func resourceIBMISNetworkACL() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISNetworkACLCreate,
-		Read:     resourceIBMISNetworkACLRead,
-		Update:   resourceIBMISNetworkACLUpdate,
-		Delete:   resourceIBMISNetworkACLDelete,
-		Exists:   resourceIBMISNetworkACLExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISNetworkACLCreate,
+		ReadContext:   resourceIBMISNetworkACLRead,
+		UpdateContext: resourceIBMISNetworkACLUpdate,
+		DeleteContext: resourceIBMISNetworkACLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISNetworkACL, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -341,25 +344,25 @@ func resourceIBMISNetworkACLValidator() *ResourceValidator {
 	return &ibmISNetworkACLResourceValidator
 }
 
-func resourceIBMISNetworkACLCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get(isNetworkACLName).(string)
 
 	if userDetails.generation == 1 {
 		err := classicNwaclCreate(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := nwaclCreate(d, meta, name)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISNetworkACLRead(d, meta)
+	return resourceIBMISNetworkACLRead(ctx, d, meta)
 
 }
 
@@ -467,22 +470,21 @@ func nwaclCreate(d *schema.ResourceData, meta interface{}, name string) error {
 	return nil
 }
 
-func resourceIBMISNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMISNetworkACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicNwaclGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := nwaclGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -711,10 +713,10 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISNetworkACLUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 
@@ -729,15 +731,15 @@ func resourceIBMISNetworkACLUpdate(d *schema.ResourceData, meta interface{}) err
 	if userDetails.generation == 1 {
 		err := classicNwaclUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := nwaclUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISNetworkACLRead(d, meta)
+	return resourceIBMISNetworkACLRead(ctx, d, meta)
 }
 
 func classicNwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
@@ -825,21 +827,21 @@ func nwaclUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 	return nil
 }
 
-func resourceIBMISNetworkACLDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicNwaclDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := nwaclDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -906,57 +908,6 @@ func nwaclDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISNetworkACLExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-	if userDetails.generation == 1 {
-		exists, err := classicNwaclExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := nwaclExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicNwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getNetworkAclOptions := &vpcclassicv1.GetNetworkACLOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetNetworkACL(getNetworkAclOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Network ACL: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func nwaclExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getNetworkAclOptions := &vpcv1.GetNetworkACLOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetNetworkACL(getNetworkAclOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Network ACL: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
 func sortclassicrules(rules []*vpcclassicv1.NetworkACLRuleItem) *list.List {
 	sortedrules := list.New()
 	for _, rule := range rules {
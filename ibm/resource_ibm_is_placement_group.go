@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isPlacementGroupName           = "name"
+	isPlacementGroupStrategy       = "strategy"
+	isPlacementGroupResourceGroup  = "resource_group"
+	isPlacementGroupCRN            = "crn"
+	isPlacementGroupHref           = "href"
+	isPlacementGroupCreatedAt      = "created_at"
+	isPlacementGroupLifecycleState = "lifecycle_state"
+	isPlacementGroupResourceType   = "resource_type"
+
+	isPlacementGroupPending   = "pending"
+	isPlacementGroupStable    = "stable"
+	isPlacementGroupFailed    = "failed"
+	isPlacementGroupDeleting  = "deleting"
+	isPlacementGroupDeleted   = "done"
+	isPlacementGroupSuspended = "suspended"
+	isPlacementGroupUpdating  = "updating"
+	isPlacementGroupWaiting   = "waiting"
+)
+
+func resourceIBMISPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISPlacementGroupCreate,
+		ReadContext:   resourceIBMISPlacementGroupRead,
+		UpdateContext: resourceIBMISPlacementGroupUpdate,
+		DeleteContext: resourceIBMISPlacementGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISPlacementGroup, nil),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isPlacementGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_placement_group", isPlacementGroupName),
+				Description:  "The name of the placement group",
+			},
+
+			isPlacementGroupStrategy: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_is_placement_group", isPlacementGroupStrategy),
+				Description:  "The strategy of the placement group, host_spread places the instances on different compute hosts, power_spread on compute hosts with different power sources",
+			},
+
+			isPlacementGroupResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group ID of the placement group",
+			},
+
+			isPlacementGroupCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the placement group",
+			},
+
+			isPlacementGroupHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the placement group",
+			},
+
+			isPlacementGroupCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the placement group was created",
+			},
+
+			isPlacementGroupLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the placement group",
+			},
+
+			isPlacementGroupResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the placement group",
+			},
+		},
+	}
+}
+
+func resourceIBMISPlacementGroupValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isPlacementGroupName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isPlacementGroupStrategy,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              vpcPlacementGroupStrategyHostSpread + ", " + vpcPlacementGroupStrategyPowerSpread})
+
+	ibmISPlacementGroupResourceValidator := ResourceValidator{ResourceName: "ibm_is_placement_group", Schema: validateSchema}
+	return &ibmISPlacementGroupResourceValidator
+}
+
+func placementGroupsClient(meta interface{}) (vpcPlacementGroups, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return nil, err
+	}
+	return vpcPlacementGroupsAPI(sess), nil
+}
+
+func resourceIBMISPlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := placementGroupsClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	prototype := &vpcPlacementGroupPrototype{
+		Name:     d.Get(isPlacementGroupName).(string),
+		Strategy: d.Get(isPlacementGroupStrategy).(string),
+	}
+	if rgrp, ok := d.GetOk(isPlacementGroupResourceGroup); ok {
+		rg := rgrp.(string)
+		prototype.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rg,
+		}
+	}
+
+	placementGroup, response, err := client.CreatePlacementGroup(prototype)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating the placement group: %s\n%s", err, response))
+	}
+	d.SetId(placementGroup.ID)
+	log.Printf("[INFO] Placement group : %s", placementGroup.ID)
+
+	_, err = isWaitForPlacementGroupAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	return resourceIBMISPlacementGroupRead(ctx, d, meta)
+}
+
+func resourceIBMISPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := placementGroupsClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	placementGroup, response, err := client.GetPlacementGroup(id)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Getting Placement Group (%s): %s\n%s", id, err, response))
+	}
+	setPlacementGroupAttributes(d, placementGroup)
+	return nil
+}
+
+// setPlacementGroupAttributes sets the attributes of the placement group shared by the resource and
+// the data source
+func setPlacementGroupAttributes(d *schema.ResourceData, placementGroup *vpcPlacementGroup) {
+	d.Set(isPlacementGroupName, placementGroup.Name)
+	d.Set(isPlacementGroupStrategy, placementGroup.Strategy)
+	d.Set(isPlacementGroupCRN, placementGroup.CRN)
+	d.Set(isPlacementGroupHref, placementGroup.Href)
+	d.Set(isPlacementGroupCreatedAt, placementGroup.CreatedAt)
+	d.Set(isPlacementGroupLifecycleState, placementGroup.LifecycleState)
+	d.Set(isPlacementGroupResourceType, placementGroup.ResourceType)
+	if placementGroup.ResourceGroup != nil && placementGroup.ResourceGroup.ID != nil {
+		d.Set(isPlacementGroupResourceGroup, *placementGroup.ResourceGroup.ID)
+	}
+}
+
+func resourceIBMISPlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := placementGroupsClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	if d.HasChange(isPlacementGroupName) {
+		_, response, err := client.UpdatePlacementGroup(id, d.Get(isPlacementGroupName).(string))
+		if err != nil {
+			return diagFromErr(newAttributeError(isPlacementGroupName, fmt.Sprintf("Error Updating Placement Group (%s)", id), fmt.Errorf("%s\n%s", err, response)))
+		}
+	}
+	return resourceIBMISPlacementGroupRead(ctx, d, meta)
+}
+
+func resourceIBMISPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := placementGroupsClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	response, err := client.DeletePlacementGroup(id)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Deleting Placement Group (%s): %s\n%s", id, err, response))
+	}
+	_, err = isWaitForPlacementGroupDeleted(ctx, client, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func isWaitForPlacementGroupAvailable(ctx context.Context, client vpcPlacementGroups, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for placement group (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isPlacementGroupPending, isPlacementGroupUpdating, isPlacementGroupWaiting},
+		Target:     []string{isPlacementGroupStable, isPlacementGroupFailed, isPlacementGroupSuspended},
+		Refresh:    isPlacementGroupRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isPlacementGroupRefreshFunc(client vpcPlacementGroups, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		placementGroup, response, err := client.GetPlacementGroup(id)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error Getting Placement Group: %s\n%s", err, response)
+		}
+		if placementGroup.LifecycleState == isPlacementGroupFailed || placementGroup.LifecycleState == isPlacementGroupSuspended {
+			return placementGroup, placementGroup.LifecycleState, fmt.Errorf("The placement group %s is %s", id, placementGroup.LifecycleState)
+		}
+		return placementGroup, placementGroup.LifecycleState, nil
+	}
+}
+
+func isWaitForPlacementGroupDeleted(ctx context.Context, client vpcPlacementGroups, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for placement group (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isPlacementGroupDeleting, isPlacementGroupStable, isPlacementGroupPending, isPlacementGroupUpdating, isPlacementGroupWaiting},
+		Target:     []string{isPlacementGroupDeleted, ""},
+		Refresh:    isPlacementGroupDeleteRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isPlacementGroupDeleteRefreshFunc(client vpcPlacementGroups, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		placementGroup, response, err := client.GetPlacementGroup(id)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return placementGroup, isPlacementGroupDeleted, nil
+			}
+			return nil, "", fmt.Errorf("Error Getting Placement Group: %s\n%s", err, response)
+		}
+		if placementGroup.LifecycleState == isPlacementGroupFailed {
+			return placementGroup, placementGroup.LifecycleState, fmt.Errorf("The placement group %s failed to delete", id)
+		}
+		return placementGroup, isPlacementGroupDeleting, nil
+	}
+}
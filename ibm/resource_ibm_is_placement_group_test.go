@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISPlacementGroup_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-pgroup-%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("tf-pgroup-upd-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISPlacementGroupConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISPlacementGroupExists("ibm_is_placement_group.testacc_pgroup"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_placement_group.testacc_pgroup", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_placement_group.testacc_pgroup", "strategy", "host_spread"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_placement_group.testacc_pgroup", "lifecycle_state", "stable"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISPlacementGroupConfig(name1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISPlacementGroupExists("ibm_is_placement_group.testacc_pgroup"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_placement_group.testacc_pgroup", "name", name1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMISInstance_placementGroup(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	pgroupname := fmt.Sprintf("tf-pgroup-%d", acctest.RandIntRange(10, 100))
+	publicKey := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstancePlacementGroupConfig(vpcname, subnetname, sshname, publicKey, name, pgroupname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_is_instance.testacc_instance", "status", "running"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_instance.testacc_instance", "placement_target.0.placement_group", "ibm_is_placement_group.testacc_pgroup", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISPlacementGroupDestroy(s *terraform.State) error {
+	client, _ := placementGroupsClient(testAccProvider.Meta())
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_placement_group" {
+			continue
+		}
+
+		_, _, err := client.GetPlacementGroup(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Placement group still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISPlacementGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		client, err := placementGroupsClient(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		_, _, err = client.GetPlacementGroup(rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckIBMISPlacementGroupConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_placement_group" "testacc_pgroup" {
+		name     = "%s"
+		strategy = "host_spread"
+	}`, name)
+}
+
+func testAccCheckIBMISInstancePlacementGroupConfig(vpcname, subnetname, sshname, publicKey, name, pgroupname string) string {
+	return testAccCheckIBMISPlacementGroupConfig(pgroupname) + fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+
+	resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	}
+
+	resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+			subnet = ibm_is_subnet.testacc_subnet.id
+		}
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+		placement_target {
+			placement_group = ibm_is_placement_group.testacc_pgroup.id
+		}
+	}`, vpcname, subnetname, ISZoneName, ISCIDR, sshname, publicKey, name, isImage, instanceProfileName, ISZoneName)
+}
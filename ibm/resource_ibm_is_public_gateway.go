@@ -7,11 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,12 +36,13 @@ const (
 
 func resourceIBMISPublicGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISPublicGatewayCreate,
-		Read:     resourceIBMISPublicGatewayRead,
-		Update:   resourceIBMISPublicGatewayUpdate,
-		Delete:   resourceIBMISPublicGatewayDelete,
-		Exists:   resourceIBMISPublicGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISPublicGatewayCreate,
+		ReadContext:   resourceIBMISPublicGatewayRead,
+		UpdateContext: resourceIBMISPublicGatewayUpdate,
+		DeleteContext: resourceIBMISPublicGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISPublicGateway, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -50,7 +51,7 @@ func resourceIBMISPublicGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -106,6 +107,7 @@ func resourceIBMISPublicGateway() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "Service tags for the public gateway instance",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -157,11 +159,11 @@ func resourceIBMISPublicGatewayValidator() *ResourceValidator {
 	return &ibmISPublicGatewayResourceValidator
 }
 
-func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get(isPublicGatewayName).(string)
@@ -169,20 +171,20 @@ func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{})
 	zone := d.Get(isPublicGatewayZone).(string)
 
 	if userDetails.generation == 1 {
-		err := classicPgwCreate(d, meta, name, vpc, zone)
+		err := classicPgwCreate(ctx, d, meta, name, vpc, zone)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := pgwCreate(d, meta, name, vpc, zone)
+		err := pgwCreate(ctx, d, meta, name, vpc, zone)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISPublicGatewayRead(d, meta)
+	return resourceIBMISPublicGatewayRead(ctx, d, meta)
 }
 
-func classicPgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone string) error {
+func classicPgwCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -220,14 +222,13 @@ func classicPgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
 
-	_, err = isWaitForClassicPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicPublicGatewayAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isPublicGatewayTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
 			log.Printf(
@@ -237,7 +238,7 @@ func classicPgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone
 	return nil
 }
 
-func pgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone string) error {
+func pgwCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -281,14 +282,13 @@ func pgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone string)
 	d.SetId(*publicgw.ID)
 	log.Printf("[INFO] PublicGateway : %s", *publicgw.ID)
 
-	_, err = isWaitForPublicGatewayAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForPublicGatewayAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isPublicGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isPublicGatewayTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
 			log.Printf(
@@ -298,7 +298,7 @@ func pgwCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone string)
 	return nil
 }
 
-func isWaitForClassicPublicGatewayAvailable(publicgwC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicPublicGatewayAvailable(ctx context.Context, publicgwC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -310,7 +310,7 @@ func isWaitForClassicPublicGatewayAvailable(publicgwC *vpcclassicv1.VpcClassicV1
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicPublicGatewayRefreshFunc(publicgwC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -331,7 +331,7 @@ func isClassicPublicGatewayRefreshFunc(publicgwC *vpcclassicv1.VpcClassicV1, id
 	}
 }
 
-func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayAvailable(ctx context.Context, publicgwC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -343,7 +343,7 @@ func isWaitForPublicGatewayAvailable(publicgwC *vpcv1.VpcV1, id string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -364,21 +364,21 @@ func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.Stat
 	}
 }
 
-func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicPgwGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := pgwGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -417,7 +417,7 @@ func classicPgwGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of vpc public gateway (%s) tags: %s", id, err)
 	}
-	d.Set(isPublicGatewayTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -462,7 +462,7 @@ func pgwGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of vpc public gateway (%s) tags: %s", id, err)
 	}
-	d.Set(isPublicGatewayTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -478,10 +478,10 @@ func pgwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 
@@ -495,15 +495,15 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 	if userDetails.generation == 1 {
 		err := classicPgwUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := pgwUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISPublicGatewayRead(d, meta)
+	return resourceIBMISPublicGatewayRead(ctx, d, meta)
 }
 
 func classicPgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
@@ -511,7 +511,7 @@ func classicPgwUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isPublicGatewayTags) {
+	if d.HasChange("tags_all") {
 		getPublicGatewayOptions := &vpcclassicv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
@@ -519,7 +519,7 @@ func classicPgwUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		if err != nil {
 			return fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isPublicGatewayTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
 			log.Printf(
@@ -553,7 +553,7 @@ func pgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isPublicGatewayTags) {
+	if d.HasChange("tags_all") {
 		getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
 			ID: &id,
 		}
@@ -561,7 +561,7 @@ func pgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		if err != nil {
 			return fmt.Errorf("Error getting Public Gateway : %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isPublicGatewayTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *publicgw.CRN)
 		if err != nil {
 			log.Printf(
@@ -588,27 +588,27 @@ func pgwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	return nil
 }
 
-func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISPublicGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicPgwDelete(d, meta, id)
+		err := classicPgwDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := pgwDelete(d, meta, id)
+		err := pgwDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicPgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicPgwDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -633,7 +633,7 @@ func classicPgwDelete(d *schema.ResourceData, meta interface{}, id string) error
 	if err != nil {
 		return fmt.Errorf("Error Deleting Public Gateway : %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicPublicGatewayDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -641,7 +641,7 @@ func classicPgwDelete(d *schema.ResourceData, meta interface{}, id string) error
 	return nil
 }
 
-func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func pgwDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -666,7 +666,7 @@ func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return fmt.Errorf("Error Deleting Public Gateway : %s\n%s", err, response)
 	}
-	_, err = isWaitForPublicGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForPublicGatewayDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -674,7 +674,7 @@ func pgwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicPublicGatewayDeleted(pg *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicPublicGatewayDeleted(ctx context.Context, pg *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -686,7 +686,7 @@ func isWaitForClassicPublicGatewayDeleted(pg *vpcclassicv1.VpcClassicV1, id stri
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicPublicGatewayDeleteRefreshFunc(pg *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -706,7 +706,7 @@ func isClassicPublicGatewayDeleteRefreshFunc(pg *vpcclassicv1.VpcClassicV1, id s
 	}
 }
 
-func isWaitForPublicGatewayDeleted(pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForPublicGatewayDeleted(ctx context.Context, pg *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for public gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -718,7 +718,7 @@ func isWaitForPublicGatewayDeleted(pg *vpcv1.VpcV1, id string, timeout time.Dura
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -737,54 +737,3 @@ func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.State
 		return pgw, isPublicGatewayDeleting, nil
 	}
 }
-
-func resourceIBMISPublicGatewayExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-	if userDetails.generation == 1 {
-		exists, err := classicPgwExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := pgwExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicPgwExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getPublicGatewayOptions := &vpcclassicv1.GetPublicGatewayOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Public Gateway: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func pgwExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getPublicGatewayOptions := &vpcv1.GetPublicGatewayOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetPublicGateway(getPublicGatewayOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Public Gateway: %s\n%s", err, response)
-	}
-	return true, nil
-}
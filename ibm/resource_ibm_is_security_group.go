@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,12 +24,13 @@ const (
 func resourceIBMISSecurityGroup() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupCreate,
-		Read:     resourceIBMISSecurityGroupRead,
-		Update:   resourceIBMISSecurityGroupUpdate,
-		Delete:   resourceIBMISSecurityGroupDelete,
-		Exists:   resourceIBMISSecurityGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupCreate,
+		ReadContext:   resourceIBMISSecurityGroupRead,
+		UpdateContext: resourceIBMISSecurityGroupUpdate,
+		DeleteContext: resourceIBMISSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISSecurityGroup, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -106,24 +109,24 @@ func resourceIBMISSecurityGroupValidator() *ResourceValidator {
 	return &ibmISSecurityGroupResourceValidator
 }
 
-func resourceIBMISSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	vpc := d.Get(isSecurityGroupVPC).(string)
 	if userDetails.generation == 1 {
 		err := classicSgCreate(d, meta, vpc)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgCreate(d, meta, vpc)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISSecurityGroupRead(d, meta)
+	return resourceIBMISSecurityGroupRead(ctx, d, meta)
 }
 
 func classicSgCreate(d *schema.ResourceData, meta interface{}, vpc string) error {
@@ -185,21 +188,21 @@ func sgCreate(d *schema.ResourceData, meta interface{}, vpc string) error {
 	return nil
 }
 
-func resourceIBMISSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicSgGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -457,10 +460,10 @@ func sgGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	name := ""
@@ -470,20 +473,20 @@ func resourceIBMISSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 		name = d.Get(isSecurityGroupName).(string)
 		hasChanged = true
 	} else {
-		return resourceIBMISSecurityGroupRead(d, meta)
+		return resourceIBMISSecurityGroupRead(ctx, d, meta)
 	}
 	if userDetails.generation == 1 {
 		err := classicSgUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISSecurityGroupRead(d, meta)
+	return resourceIBMISSecurityGroupRead(ctx, d, meta)
 }
 
 func classicSgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
@@ -536,21 +539,21 @@ func sgUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChan
 	return nil
 }
 
-func resourceIBMISSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicSgDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	d.SetId("")
@@ -613,57 +616,6 @@ func sgDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSecurityGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-	if userDetails.generation == 1 {
-		exists, err := classicSgExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := sgExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicSgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getSecurityGroupOptions := &vpcclassicv1.GetSecurityGroupOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Security Group: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func sgExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getSecurityGroupOptions := &vpcv1.GetSecurityGroupOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetSecurityGroup(getSecurityGroupOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Security Group: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
 func makeIBMISSecurityRuleSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 
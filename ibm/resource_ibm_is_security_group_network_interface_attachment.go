@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -34,11 +36,12 @@ const (
 
 func resourceIBMISSecurityGroupNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate,
-		Read:     resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead,
-		Delete:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete,
-		Exists:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate,
+		ReadContext:   resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead,
+		DeleteContext: resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISSecurityGroupNetworkInterfaceAttachment, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			isSGNICAGroupId: {
@@ -151,10 +154,10 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	sgID := d.Get(isSGNICAGroupId).(string)
 	nicID := d.Get(isSGNICANicId).(string)
@@ -162,15 +165,15 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentCreate(d *schema.Resour
 	if userDetails.generation == 1 {
 		err := classicSgnicCreate(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgnicCreate(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d, meta)
+	return resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(ctx, d, meta)
 
 }
 
@@ -208,26 +211,26 @@ func sgnicCreate(d *schema.ResourceData, meta interface{}, sgID, nicID string) e
 	return nil
 }
 
-func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupNetworkInterfaceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	sgID := parts[0]
 	nicID := parts[1]
 	if userDetails.generation == 1 {
 		err := classicSgnicGet(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgnicGet(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -353,14 +356,14 @@ func sgnicGet(d *schema.ResourceData, meta interface{}, sgID, nicID string) erro
 	return nil
 }
 
-func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	sgID := parts[0]
@@ -368,12 +371,12 @@ func resourceIBMISSecurityGroupNetworkInterfaceAttachmentDelete(d *schema.Resour
 	if userDetails.generation == 1 {
 		err := classicSgnicDelete(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgnicDelete(d, meta, sgID, nicID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	d.SetId("")
@@ -441,62 +444,3 @@ func sgnicDelete(d *schema.ResourceData, meta interface{}, sgID, nicID string) e
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMISSecurityGroupNetworkInterfaceAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	sgID := parts[0]
-	nicID := parts[1]
-	if userDetails.generation == 1 {
-		exists, err := classicSgnicExists(d, meta, sgID, nicID)
-		return exists, err
-	} else {
-		exists, err := sgnicExists(d, meta, sgID, nicID)
-		return exists, err
-	}
-}
-
-func classicSgnicExists(d *schema.ResourceData, meta interface{}, sgID, nicID string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getSecurityGroupNetworkInterfaceOptions := &vpcclassicv1.GetSecurityGroupNetworkInterfaceOptions{
-		SecurityGroupID: &sgID,
-		ID:              &nicID,
-	}
-	_, response, err := sess.GetSecurityGroupNetworkInterface(getSecurityGroupNetworkInterfaceOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
-	}
-	return true, nil
-}
-
-func sgnicExists(d *schema.ResourceData, meta interface{}, sgID, nicID string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getSecurityGroupNetworkInterfaceOptions := &vpcv1.GetSecurityGroupNetworkInterfaceOptions{
-		SecurityGroupID: &sgID,
-		ID:              &nicID,
-	}
-	_, response, err := sess.GetSecurityGroupNetworkInterface(getSecurityGroupNetworkInterfaceOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting NetworkInterface(%s) for the SecurityGroup (%s) : %s\n%s", nicID, sgID, err, response)
-	}
-	return true, nil
-}
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -33,12 +35,13 @@ const (
 func resourceIBMISSecurityGroupRule() *schema.Resource {
 
 	return &schema.Resource{
-		Create:   resourceIBMISSecurityGroupRuleCreate,
-		Read:     resourceIBMISSecurityGroupRuleRead,
-		Update:   resourceIBMISSecurityGroupRuleUpdate,
-		Delete:   resourceIBMISSecurityGroupRuleDelete,
-		Exists:   resourceIBMISSecurityGroupRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSecurityGroupRuleCreate,
+		ReadContext:   resourceIBMISSecurityGroupRuleRead,
+		UpdateContext: resourceIBMISSecurityGroupRuleUpdate,
+		DeleteContext: resourceIBMISSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISSecurityGroupRule, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -225,24 +228,24 @@ func resourceIBMISSecurityGroupRuleValidator() *ResourceValidator {
 	return &ibmISSecurityGroupRuleResourceValidator
 }
 
-func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if userDetails.generation == 1 {
 		err := classicSgRuleCreate(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgRuleCreate(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return err
+	return diagFromErr(err)
 }
 
 func classicSgRuleCreate(d *schema.ResourceData, meta interface{}) error {
@@ -341,24 +344,24 @@ func sgRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMISSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	secgrpID, ruleID, err := parseISTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classicSgRuleGet(d, meta, secgrpID, ruleID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgRuleGet(d, meta, secgrpID, ruleID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -399,6 +402,7 @@ func classicSgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID
 			tfID := makeTerraformRuleID(secgrpID, *rule.ID)
 			d.SetId(tfID)
 			d.Set(isSecurityGroupRuleIPVersion, *rule.IPVersion)
+			d.Set(isSecurityGroupRuleDirection, *rule.Direction)
 			d.Set(isSecurityGroupRuleProtocol, *rule.Protocol)
 			icmpProtocol := map[string]interface{}{}
 
@@ -431,6 +435,7 @@ func classicSgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID
 			tfID := makeTerraformRuleID(secgrpID, *rule.ID)
 			d.SetId(tfID)
 			d.Set(isSecurityGroupRuleIPVersion, *rule.IPVersion)
+			d.Set(isSecurityGroupRuleDirection, *rule.Direction)
 			d.Set(isSecurityGroupRuleProtocol, *rule.Protocol)
 			remote, ok := rule.Remote.(*vpcclassicv1.SecurityGroupRuleRemote)
 			if ok {
@@ -452,6 +457,7 @@ func classicSgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID
 			tfID := makeTerraformRuleID(secgrpID, *rule.ID)
 			d.SetId(tfID)
 			d.Set(isSecurityGroupRuleIPVersion, *rule.IPVersion)
+			d.Set(isSecurityGroupRuleDirection, *rule.Direction)
 			d.Set(isSecurityGroupRuleProtocol, *rule.Protocol)
 			tcpProtocol := map[string]interface{}{}
 
@@ -519,6 +525,7 @@ func sgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string
 			tfID := makeTerraformRuleID(secgrpID, *rule.ID)
 			d.SetId(tfID)
 			d.Set(isSecurityGroupRuleIPVersion, *rule.IPVersion)
+			d.Set(isSecurityGroupRuleDirection, *rule.Direction)
 			d.Set(isSecurityGroupRuleProtocol, *rule.Protocol)
 			icmpProtocol := map[string]interface{}{}
 
@@ -551,6 +558,7 @@ func sgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string
 			tfID := makeTerraformRuleID(secgrpID, *rule.ID)
 			d.SetId(tfID)
 			d.Set(isSecurityGroupRuleIPVersion, *rule.IPVersion)
+			d.Set(isSecurityGroupRuleDirection, *rule.Direction)
 			d.Set(isSecurityGroupRuleProtocol, *rule.Protocol)
 			remote, ok := rule.Remote.(*vpcv1.SecurityGroupRuleRemote)
 			if ok {
@@ -572,6 +580,7 @@ func sgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string
 			tfID := makeTerraformRuleID(secgrpID, *rule.ID)
 			d.SetId(tfID)
 			d.Set(isSecurityGroupRuleIPVersion, *rule.IPVersion)
+			d.Set(isSecurityGroupRuleDirection, *rule.Direction)
 			d.Set(isSecurityGroupRuleProtocol, *rule.Protocol)
 			tcpProtocol := map[string]interface{}{}
 
@@ -605,23 +614,23 @@ func sgRuleGet(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string
 	return nil
 }
 
-func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if userDetails.generation == 1 {
 		err := classicSgRuleUpdate(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgRuleUpdate(d, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISSecurityGroupRuleRead(d, meta)
+	return resourceIBMISSecurityGroupRuleRead(ctx, d, meta)
 }
 
 func classicSgRuleUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -670,14 +679,14 @@ func sgRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	secgrpID, ruleID, err := parseISTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
@@ -687,12 +696,12 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 	if userDetails.generation == 1 {
 		err := classicSgRuleDelete(d, meta, secgrpID, ruleID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := sgRuleDelete(d, meta, secgrpID, ruleID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	d.SetId("")
@@ -763,62 +772,6 @@ func sgRuleDelete(d *schema.ResourceData, meta interface{}, secgrpID, ruleID str
 	return nil
 }
 
-func resourceIBMISSecurityGroupRuleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	secgrpID, ruleID, err := parseISTerraformID(d.Id())
-	if err != nil {
-		return false, err
-	}
-	if userDetails.generation == 1 {
-		exists, err := classicSgRuleExists(d, meta, secgrpID, ruleID)
-		return exists, err
-	} else {
-		exists, err := sgRuleExists(d, meta, secgrpID, ruleID)
-		return exists, err
-	}
-}
-
-func classicSgRuleExists(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getSecurityGroupRuleOptions := &vpcclassicv1.GetSecurityGroupRuleOptions{
-		SecurityGroupID: &secgrpID,
-		ID:              &ruleID,
-	}
-	_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
-	}
-	return true, nil
-}
-
-func sgRuleExists(d *schema.ResourceData, meta interface{}, secgrpID, ruleID string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
-		SecurityGroupID: &secgrpID,
-		ID:              &ruleID,
-	}
-	_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Security Group Rule (%s): %s\n%s", ruleID, err, response)
-	}
-	return true, nil
-}
-
 func parseISTerraformID(s string) (string, string, error) {
 	segments := strings.Split(s, ".")
 	if len(segments) != 2 {
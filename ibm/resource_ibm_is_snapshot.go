@@ -0,0 +1,380 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isSnapshotName            = "name"
+	isSnapshotIdentifier      = "identifier"
+	isSnapshotSourceVolume    = "source_volume"
+	isSnapshotSourceImage     = "source_image"
+	isSnapshotResourceGroup   = "resource_group"
+	isSnapshotBootable        = "bootable"
+	isSnapshotCRN             = "crn"
+	isSnapshotDeletable       = "deletable"
+	isSnapshotEncryption      = "encryption"
+	isSnapshotEncryptionKey   = "encryption_key"
+	isSnapshotHref            = "href"
+	isSnapshotLifecycleState  = "lifecycle_state"
+	isSnapshotMinCapacity     = "minimum_capacity"
+	isSnapshotOperatingSystem = "operating_system"
+	isSnapshotResourceType    = "resource_type"
+	isSnapshotSize            = "size"
+
+	isSnapshotPending   = "pending"
+	isSnapshotStable    = "stable"
+	isSnapshotFailed    = "failed"
+	isSnapshotDeleting  = "deleting"
+	isSnapshotDeleted   = "done"
+	isSnapshotSuspended = "suspended"
+	isSnapshotUpdating  = "updating"
+	isSnapshotWaiting   = "waiting"
+)
+
+func resourceIBMISSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISSnapshotCreate,
+		ReadContext:   resourceIBMISSnapshotRead,
+		UpdateContext: resourceIBMISSnapshotUpdate,
+		DeleteContext: resourceIBMISSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISSnapshot, nil),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			isSnapshotName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_is_snapshot", isSnapshotName),
+				Description:  "Snapshot name",
+			},
+
+			isSnapshotSourceVolume: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the boot or data volume to snapshot",
+			},
+
+			isSnapshotResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource group ID of the snapshot",
+			},
+
+			isSnapshotSourceImage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the image the source volume was provisioned from, for a bootable snapshot",
+			},
+
+			isSnapshotBootable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if a boot volume attachment can be created with a volume created from this snapshot",
+			},
+
+			isSnapshotCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the snapshot",
+			},
+
+			isSnapshotDeletable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether this snapshot can be deleted",
+			},
+
+			isSnapshotEncryption: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of encryption used on the source volume, provider_managed or user_managed",
+			},
+
+			isSnapshotEncryptionKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the root key used to wrap the data encryption key for the source volume",
+			},
+
+			isSnapshotHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for the snapshot",
+			},
+
+			isSnapshotLifecycleState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The lifecycle state of the snapshot",
+			},
+
+			isSnapshotMinCapacity: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum capacity of a volume created from this snapshot, in gigabytes",
+			},
+
+			isSnapshotOperatingSystem: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the operating system of the bootable snapshot",
+			},
+
+			isSnapshotResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type of the snapshot",
+			},
+
+			isSnapshotSize: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the snapshot, in gigabytes",
+			},
+		},
+	}
+}
+
+func resourceIBMISSnapshotValidator() *ResourceValidator {
+
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 isSnapshotName,
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+
+	ibmISSnapshotResourceValidator := ResourceValidator{ResourceName: "ibm_is_snapshot", Schema: validateSchema}
+	return &ibmISSnapshotResourceValidator
+}
+
+func resourceIBMISSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	volume := d.Get(isSnapshotSourceVolume).(string)
+	options := &vpcv1.CreateSnapshotOptions{
+		SourceVolume: &vpcv1.VolumeIdentity{
+			ID: &volume,
+		},
+	}
+	if name, ok := d.GetOk(isSnapshotName); ok {
+		namestr := name.(string)
+		options.Name = &namestr
+	}
+	if rgrp, ok := d.GetOk(isSnapshotResourceGroup); ok {
+		rg := rgrp.(string)
+		options.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rg,
+		}
+	}
+
+	snapshot, response, err := sess.CreateSnapshot(options)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating the snapshot of volume %s: %s\n%s", volume, err, response))
+	}
+	d.SetId(*snapshot.ID)
+	log.Printf("[INFO] Snapshot : %s", *snapshot.ID)
+
+	_, err = isWaitForSnapshotAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	return resourceIBMISSnapshotRead(ctx, d, meta)
+}
+
+func resourceIBMISSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	options := &vpcv1.GetSnapshotOptions{
+		ID: &id,
+	}
+	snapshot, response, err := sess.GetSnapshot(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Getting Snapshot (%s): %s\n%s", id, err, response))
+	}
+	setSnapshotAttributes(d, snapshot)
+	return nil
+}
+
+// setSnapshotAttributes sets the attributes of the snapshot shared by the resource and the data source
+func setSnapshotAttributes(d *schema.ResourceData, snapshot *vpcv1.Snapshot) {
+	d.Set(isSnapshotName, *snapshot.Name)
+	d.Set(isSnapshotBootable, *snapshot.Bootable)
+	d.Set(isSnapshotCRN, *snapshot.CRN)
+	d.Set(isSnapshotDeletable, *snapshot.Deletable)
+	d.Set(isSnapshotEncryption, *snapshot.Encryption)
+	d.Set(isSnapshotHref, *snapshot.Href)
+	d.Set(isSnapshotLifecycleState, *snapshot.LifecycleState)
+	d.Set(isSnapshotMinCapacity, *snapshot.MinimumCapacity)
+	d.Set(isSnapshotResourceType, *snapshot.ResourceType)
+	d.Set(isSnapshotSize, *snapshot.Size)
+	if snapshot.EncryptionKey != nil {
+		d.Set(isSnapshotEncryptionKey, *snapshot.EncryptionKey.CRN)
+	}
+	if snapshot.OperatingSystem != nil {
+		d.Set(isSnapshotOperatingSystem, *snapshot.OperatingSystem.Name)
+	}
+	if snapshot.ResourceGroup != nil {
+		d.Set(isSnapshotResourceGroup, *snapshot.ResourceGroup.ID)
+	}
+	if snapshot.SourceImage != nil {
+		d.Set(isSnapshotSourceImage, *snapshot.SourceImage.ID)
+	}
+	if snapshot.SourceVolume != nil {
+		d.Set(isSnapshotSourceVolume, *snapshot.SourceVolume.ID)
+	}
+}
+
+func resourceIBMISSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	if d.HasChange(isSnapshotName) {
+		name := d.Get(isSnapshotName).(string)
+		snapshotPatchModel := &vpcv1.SnapshotPatch{
+			Name: &name,
+		}
+		snapshotPatch, err := snapshotPatchModel.AsPatch()
+		if err != nil {
+			return diagFromErr(newAttributeError(isSnapshotName, "Error calling asPatch for SnapshotPatch", err))
+		}
+		options := &vpcv1.UpdateSnapshotOptions{
+			ID:            &id,
+			SnapshotPatch: snapshotPatch,
+		}
+		_, response, err := sess.UpdateSnapshot(options)
+		if err != nil {
+			return diagFromErr(newAttributeError(isSnapshotName, fmt.Sprintf("Error Updating Snapshot (%s)", id), fmt.Errorf("%s\n%s", err, response)))
+		}
+	}
+	return resourceIBMISSnapshotRead(ctx, d, meta)
+}
+
+func resourceIBMISSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	id := d.Id()
+	options := &vpcv1.DeleteSnapshotOptions{
+		ID: &id,
+	}
+	response, err := sess.DeleteSnapshot(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error Deleting Snapshot (%s): %s\n%s", id, err, response))
+	}
+	_, err = isWaitForSnapshotDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func isWaitForSnapshotAvailable(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for snapshot (%s) to be stable.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isSnapshotPending, isSnapshotUpdating, isSnapshotWaiting},
+		Target:     []string{isSnapshotStable, isSnapshotFailed, isSnapshotSuspended},
+		Refresh:    isSnapshotRefreshFunc(sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isSnapshotRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		options := &vpcv1.GetSnapshotOptions{
+			ID: &id,
+		}
+		snapshot, response, err := sess.GetSnapshot(options)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error Getting Snapshot: %s\n%s", err, response)
+		}
+		if *snapshot.LifecycleState == isSnapshotFailed || *snapshot.LifecycleState == isSnapshotSuspended {
+			return snapshot, *snapshot.LifecycleState, fmt.Errorf("The snapshot %s is %s", id, *snapshot.LifecycleState)
+		}
+		return snapshot, *snapshot.LifecycleState, nil
+	}
+}
+
+func isWaitForSnapshotDeleted(ctx context.Context, sess *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for snapshot (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{isSnapshotDeleting, isSnapshotStable, isSnapshotPending, isSnapshotUpdating, isSnapshotWaiting},
+		Target:     []string{isSnapshotDeleted, ""},
+		Refresh:    isSnapshotDeleteRefreshFunc(sess, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isSnapshotDeleteRefreshFunc(sess *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		options := &vpcv1.GetSnapshotOptions{
+			ID: &id,
+		}
+		snapshot, response, err := sess.GetSnapshot(options)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return snapshot, isSnapshotDeleted, nil
+			}
+			return nil, "", fmt.Errorf("Error Getting Snapshot: %s\n%s", err, response)
+		}
+		if *snapshot.LifecycleState == isSnapshotFailed {
+			return snapshot, *snapshot.LifecycleState, fmt.Errorf("The snapshot %s failed to delete", id)
+		}
+		return snapshot, isSnapshotDeleting, nil
+	}
+}
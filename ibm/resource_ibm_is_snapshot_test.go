@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSnapshot_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	snapshotname := fmt.Sprintf("tf-snapshot-%d", acctest.RandIntRange(10, 100))
+	snapshotname1 := fmt.Sprintf("tf-snapshot-upd-%d", acctest.RandIntRange(10, 100))
+	volname := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMISSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSnapshotConfig(vpcname, subnetname, sshname, publicKey, name, snapshotname, volname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSnapshotExists("ibm_is_snapshot.testacc_snapshot"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot", "name", snapshotname),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot", "lifecycle_state", "stable"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot", "bootable", "true"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_is_volume.testacc_volume", "source_snapshot", "ibm_is_snapshot.testacc_snapshot", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISSnapshotConfig(vpcname, subnetname, sshname, publicKey, name, snapshotname1, volname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSnapshotExists("ibm_is_snapshot.testacc_snapshot"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_snapshot.testacc_snapshot", "name", snapshotname1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSnapshotDestroy(s *terraform.State) error {
+	sess, _ := testAccProvider.Meta().(ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_snapshot" {
+			continue
+		}
+
+		getsnapshotoptions := &vpcv1.GetSnapshotOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err := sess.GetSnapshot(getsnapshotoptions)
+		if err == nil {
+			return fmt.Errorf("Snapshot still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISSnapshotExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, _ := testAccProvider.Meta().(ClientSession).VpcV1API()
+		getsnapshotoptions := &vpcv1.GetSnapshotOptions{
+			ID: &rs.Primary.ID,
+		}
+		_, _, err := sess.GetSnapshot(getsnapshotoptions)
+		return err
+	}
+}
+
+func testAccCheckIBMISSnapshotConfig(vpcname, subnetname, sshname, publicKey, name, snapshotname, volname string) string {
+	return testAccCheckIBMISInstanceConfig(vpcname, subnetname, sshname, publicKey, name) + fmt.Sprintf(`
+	resource "ibm_is_snapshot" "testacc_snapshot" {
+		name          = "%s"
+		source_volume = ibm_is_instance.testacc_instance.volume_attachments[0].volume_id
+	}
+
+	resource "ibm_is_volume" "testacc_volume" {
+		name            = "%s"
+		profile         = "general-purpose"
+		zone            = "%s"
+		source_snapshot = ibm_is_snapshot.testacc_snapshot.id
+	}`, snapshotname, volname, ISZoneName)
+}
@@ -7,10 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -27,16 +27,17 @@ const (
 
 func resourceIBMISSSHKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSSHKeyCreate,
-		Read:     resourceIBMISSSHKeyRead,
-		Update:   resourceIBMISSSHKeyUpdate,
-		Delete:   resourceIBMISSSHKeyDelete,
-		Exists:   resourceIBMISSSHKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSSHKeyCreate,
+		ReadContext:   resourceIBMISSSHKeyRead,
+		UpdateContext: resourceIBMISSSHKeyUpdate,
+		DeleteContext: resourceIBMISSSHKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISSSHKey, nil),
+		},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -81,6 +82,7 @@ func resourceIBMISSSHKey() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "List of tags for SSH key",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			isKeyResourceGroup: {
 				Type:        schema.TypeString,
@@ -134,10 +136,10 @@ func resourceIBMISSHKeyValidator() *ResourceValidator {
 	return &ibmISSSHKeyResourceValidator
 }
 
-func resourceIBMISSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	log.Printf("[DEBUG] Key create")
 	name := d.Get(isKeyName).(string)
@@ -146,15 +148,15 @@ func resourceIBMISSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if userDetails.generation == 1 {
 		err := classicKeyCreate(d, meta, name, publickey)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := keyCreate(d, meta, name, publickey)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISSSHKeyRead(d, meta)
+	return resourceIBMISSSHKeyRead(ctx, d, meta)
 }
 
 func classicKeyCreate(d *schema.ResourceData, meta interface{}, name, publickey string) error {
@@ -181,9 +183,8 @@ func classicKeyCreate(d *schema.ResourceData, meta interface{}, name, publickey
 	d.SetId(*key.ID)
 	log.Printf("[INFO] Key : %s", *key.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
-		oldList, newList := d.GetChange(isKeyTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
 			log.Printf(
@@ -217,9 +218,8 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 	d.SetId(*key.ID)
 	log.Printf("[INFO] Key : %s", *key.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isKeyTags); ok || v != "" {
-		oldList, newList := d.GetChange(isKeyTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
 			log.Printf(
@@ -229,22 +229,22 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 	return nil
 }
 
-func resourceIBMISSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicKeyGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := keyGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -276,7 +276,7 @@ func classicKeyGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of vpc SSH Key (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isKeyTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -317,7 +317,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of vpc SSH Key (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isKeyTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -332,10 +332,10 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
@@ -350,15 +350,15 @@ func resourceIBMISSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	if userDetails.generation == 1 {
 		err := classicKeyUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := keyUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISSSHKeyRead(d, meta)
+	return resourceIBMISSSHKeyRead(ctx, d, meta)
 }
 
 func classicKeyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
@@ -366,7 +366,7 @@ func classicKeyUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isKeyTags) {
+	if d.HasChange("tags_all") {
 		options := &vpcclassicv1.GetKeyOptions{
 			ID: &id,
 		}
@@ -374,7 +374,7 @@ func classicKeyUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		if err != nil {
 			return fmt.Errorf("Error getting SSH Key : %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isKeyTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
 			log.Printf(
@@ -406,7 +406,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isKeyTags) {
+	if d.HasChange("tags_all") {
 		options := &vpcv1.GetKeyOptions{
 			ID: &id,
 		}
@@ -414,7 +414,7 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		if err != nil {
 			return fmt.Errorf("Error getting SSH Key : %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isKeyTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *key.CRN)
 		if err != nil {
 			log.Printf(
@@ -441,21 +441,21 @@ func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	return nil
 }
 
-func resourceIBMISSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicKeyDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := keyDelete(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -516,56 +516,3 @@ func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMISSSHKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-
-	if userDetails.generation == 1 {
-		exists, err := classicKeyExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := keyExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicKeyExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	options := &vpcclassicv1.GetKeyOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetKey(options)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting SSH Key: %s\n%s", err, response)
-	}
-
-	return true, nil
-}
-
-func keyExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	options := &vpcv1.GetKeyOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetKey(options)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting SSH Key: %s\n%s", err, response)
-	}
-	return true, nil
-}
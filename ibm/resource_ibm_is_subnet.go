@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -38,12 +40,13 @@ const (
 
 func resourceIBMISSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSubnetCreate,
-		Read:     resourceIBMISSubnetRead,
-		Update:   resourceIBMISSubnetUpdate,
-		Delete:   resourceIBMISSubnetDelete,
-		Exists:   resourceIBMISSubnetExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSubnetCreate,
+		ReadContext:   resourceIBMISSubnetRead,
+		UpdateContext: resourceIBMISSubnetUpdate,
+		DeleteContext: resourceIBMISSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISSubnet, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -204,10 +207,10 @@ func resourceIBMISSubnetValidator() *ResourceValidator {
 	return &ibmISSubnetResourceValidator
 }
 
-func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get(isSubnetName).(string)
@@ -225,11 +228,11 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		ipv4addrcount64 = int64(ipv4addrcount)
 	}
 	if ipv4cidr == "" && ipv4addrcount == 0 {
-		return fmt.Errorf("%s or %s need to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
+		return diagFromErr(fmt.Errorf("%s or %s need to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount))
 	}
 
 	if ipv4cidr != "" && ipv4addrcount != 0 {
-		return fmt.Errorf("only one of %s or %s needs to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount)
+		return diagFromErr(fmt.Errorf("only one of %s or %s needs to be provided", isSubnetIpv4CidrBlock, isSubnetTotalIpv4AddressCount))
 	}
 	isSubnetKey := "subnet_key_" + vpc + "_" + zone
 	ibmMutexKV.Lock(isSubnetKey)
@@ -251,21 +254,21 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		rtID = rt.(string)
 	}
 	if userDetails.generation == 1 {
-		err := classicSubnetCreate(d, meta, name, vpc, zone, ipv4cidr, acl, gw, ipv4addrcount64)
+		err := classicSubnetCreate(ctx, d, meta, name, vpc, zone, ipv4cidr, acl, gw, ipv4addrcount64)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := subnetCreate(d, meta, name, vpc, zone, ipv4cidr, acl, gw, rtID, ipv4addrcount64)
+		err := subnetCreate(ctx, d, meta, name, vpc, zone, ipv4cidr, acl, gw, rtID, ipv4addrcount64)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISSubnetRead(d, meta)
+	return resourceIBMISSubnetRead(ctx, d, meta)
 }
 
-func classicSubnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw string, ipv4addrcount64 int64) error {
+func classicSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw string, ipv4addrcount64 int64) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -307,14 +310,14 @@ func classicSubnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zo
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
-	_, err = isWaitForClassicSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw, rtID string, ipv4addrcount64 int64) error {
+func subnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw, rtID string, ipv4addrcount64 int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -369,14 +372,14 @@ func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv
 	}
 	d.SetId(*subnet.ID)
 	log.Printf("[INFO] Subnet : %s", *subnet.ID)
-	_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func isWaitForClassicSubnetAvailable(subnetC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicSubnetAvailable(ctx context.Context, subnetC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -388,7 +391,7 @@ func isWaitForClassicSubnetAvailable(subnetC *vpcclassicv1.VpcClassicV1, id stri
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicSubnetRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -409,7 +412,7 @@ func isClassicSubnetRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) r
 	}
 }
 
-func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetAvailable(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -421,7 +424,7 @@ func isWaitForSubnetAvailable(subnetC *vpcv1.VpcV1, id string, timeout time.Dura
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -442,21 +445,21 @@ func isSubnetRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshF
 	}
 }
 
-func resourceIBMISSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicSubnetGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := subnetGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -559,29 +562,29 @@ func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 
 	if userDetails.generation == 1 {
-		err := classicSubnetUpdate(d, meta, id)
+		err := classicSubnetUpdate(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := subnetUpdate(d, meta, id)
+		err := subnetUpdate(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISSubnetRead(d, meta)
+	return resourceIBMISSubnetRead(ctx, d, meta)
 }
 
-func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func classicSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -615,7 +618,7 @@ func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) er
 			if err != nil {
 				return fmt.Errorf("Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
 			}
-			_, err = isWaitForClassicSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForClassicSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -630,7 +633,7 @@ func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) er
 			if err != nil {
 				return fmt.Errorf("Error Attaching public gateway to the subnet : %s\n%s", err, response)
 			}
-			_, err = isWaitForClassicSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForClassicSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -651,7 +654,7 @@ func classicSubnetUpdate(d *schema.ResourceData, meta interface{}, id string) er
 	return nil
 }
 
-func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
+func subnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -683,7 +686,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			if err != nil {
 				return fmt.Errorf("Error Detaching the public gateway attached to the subnet : %s\n%s", err, response)
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -698,7 +701,7 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 			if err != nil {
 				return fmt.Errorf("Error Attaching public gateway to the subnet : %s\n%s", err, response)
 			}
-			_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
+			_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -738,21 +741,21 @@ func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicSubnetDelete(d, meta, id)
+		err := classicSubnetDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := subnetDelete(d, meta, id)
+		err := subnetDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -760,7 +763,7 @@ func resourceIBMISSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -783,7 +786,7 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 		if err != nil {
 			return err
 		}
-		_, err = isWaitForClassicSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForClassicSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -795,7 +798,7 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 	if err != nil {
 		return fmt.Errorf("Error Deleting Subnet : %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicSubnetDeleted(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -803,7 +806,7 @@ func classicSubnetDelete(d *schema.ResourceData, meta interface{}, id string) er
 	return nil
 }
 
-func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func subnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -826,7 +829,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		if err != nil {
 			return err
 		}
-		_, err = isWaitForSubnetAvailable(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+		_, err = isWaitForSubnetAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 		if err != nil {
 			return err
 		}
@@ -838,7 +841,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return fmt.Errorf("Error Deleting Subnet : %s\n%s", err, response)
 	}
-	_, err = isWaitForSubnetDeleted(sess, d.Id(), d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForSubnetDeleted(ctx, sess, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -846,7 +849,7 @@ func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicSubnetDeleted(subnetC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicSubnetDeleted(ctx context.Context, subnetC *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -858,7 +861,7 @@ func isWaitForClassicSubnetDeleted(subnetC *vpcclassicv1.VpcClassicV1, id string
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicSubnetDeleteRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -878,7 +881,7 @@ func isClassicSubnetDeleteRefreshFunc(subnetC *vpcclassicv1.VpcClassicV1, id str
 	}
 }
 
-func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForSubnetDeleted(ctx context.Context, subnetC *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for subnet (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -890,7 +893,7 @@ func isWaitForSubnetDeleted(subnetC *vpcv1.VpcV1, id string, timeout time.Durati
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -912,54 +915,3 @@ func isSubnetDeleteRefreshFunc(subnetC *vpcv1.VpcV1, id string) resource.StateRe
 		return subnet, isSubnetDeleting, err
 	}
 }
-
-func resourceIBMISSubnetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-	if userDetails.generation == 1 {
-		exists, err := classicSubnetExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := subnetExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicSubnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getsubnetOptions := &vpcclassicv1.GetSubnetOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetSubnet(getsubnetOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Subnet: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func subnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getsubnetOptions := &vpcv1.GetSubnetOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetSubnet(getsubnetOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Subnet: %s\n%s", err, response)
-	}
-	return true, nil
-}
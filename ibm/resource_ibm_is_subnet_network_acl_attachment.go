@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,12 +22,13 @@ const (
 
 func resourceIBMISSubnetNetworkACLAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISSubnetNetworkACLAttachmentCreate,
-		Read:     resourceIBMISSubnetNetworkACLAttachmentRead,
-		Update:   resourceIBMISSubnetNetworkACLAttachmentUpdate,
-		Delete:   resourceIBMISSubnetNetworkACLAttachmentDelete,
-		Exists:   resourceIBMISSubnetNetworkACLAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISSubnetNetworkACLAttachmentCreate,
+		ReadContext:   resourceIBMISSubnetNetworkACLAttachmentRead,
+		UpdateContext: resourceIBMISSubnetNetworkACLAttachmentUpdate,
+		DeleteContext: resourceIBMISSubnetNetworkACLAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISSubnetNetworkACLAttachment, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -188,10 +191,10 @@ func resourceIBMISSubnetNetworkACLAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	subnet := d.Get(isSubnetID).(string)
@@ -209,20 +212,20 @@ func resourceIBMISSubnetNetworkACLAttachmentCreate(d *schema.ResourceData, meta
 
 	if err != nil {
 		log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-		return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response))
 	}
 	d.SetId(subnet)
 	log.Printf("[INFO] Network ACL : %s", *resultACL.ID)
 	log.Printf("[INFO] Subnet ID : %s", subnet)
 
-	return resourceIBMISSubnetNetworkACLAttachmentRead(d, meta)
+	return resourceIBMISSubnetNetworkACLAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	getSubnetNetworkACLOptionsModel := &vpcv1.GetSubnetNetworkACLOptions{
@@ -235,8 +238,10 @@ func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting subnet's (%s) attached network ACL: %s\n%s", id, err, response)
+		return diagFromErr(fmt.Errorf("Error getting subnet's (%s) attached network ACL: %s\n%s", id, err, response))
 	}
+	d.Set(isSubnetID, id)
+	d.Set(isNetworkACLID, *nwacl.ID)
 	d.Set(isNetworkACLName, *nwacl.Name)
 	d.Set(isNetworkACLVPC, *nwacl.VPC.ID)
 	if nwacl.ResourceGroup != nil {
@@ -326,11 +331,11 @@ func resourceIBMISSubnetNetworkACLAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if d.HasChange(isNetworkACLID) {
 		subnet := d.Get(isSubnetID).(string)
@@ -348,22 +353,22 @@ func resourceIBMISSubnetNetworkACLAttachmentUpdate(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-			return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			return diagFromErr(newAttributeError(isNetworkACLID, "Error while attaching a network ACL to a subnet", fmt.Errorf("%s\n%s", err, response)))
 		}
 		log.Printf("[INFO] Updated subnet %s with Network ACL : %s", subnet, *resultACL.ID)
 
 		d.SetId(subnet)
-		return resourceIBMISSubnetNetworkACLAttachmentRead(d, meta)
+		return resourceIBMISSubnetNetworkACLAttachmentRead(ctx, d, meta)
 	}
 
-	return resourceIBMISSubnetNetworkACLAttachmentRead(d, meta)
+	return resourceIBMISSubnetNetworkACLAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISSubnetNetworkACLAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	// Set the subnet with VPC default network ACL
 	getSubnetOptions := &vpcv1.GetSubnetOptions{
@@ -375,7 +380,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response)
+		return diagFromErr(fmt.Errorf("Error Getting Subnet (%s): %s\n%s", id, err, response))
 	}
 	// Fetch VPC
 	vpcID := *subnet.VPC.ID
@@ -389,7 +394,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error getting VPC : %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error getting VPC : %s\n%s", err, response))
 	}
 
 	// Fetch default network ACL
@@ -407,7 +412,7 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 
 		if err != nil {
 			log.Printf("[DEBUG] Error while attaching a network ACL to a subnet %s\n%s", err, response)
-			return fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error while attaching a network ACL to a subnet %s\n%s", err, response))
 		}
 		log.Printf("[INFO] Updated subnet %s with VPC default Network ACL : %s", id, *resultACL.ID)
 	} else {
@@ -417,22 +422,3 @@ func resourceIBMISSubnetNetworkACLAttachmentDelete(d *schema.ResourceData, meta
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMISSubnetNetworkACLAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	id := d.Id()
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getSubnetNetworkACLOptionsModel := &vpcv1.GetSubnetNetworkACLOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetSubnetNetworkACL(getSubnetNetworkACLOptionsModel)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting subnet's attached network ACL: %s\n%s", err, response)
-	}
-	return true, nil
-}
@@ -7,11 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,16 +41,17 @@ func resourceIBMISEndpointGateway() *schema.Resource {
 	targetNameFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetName)
 	targetCRNFmt := fmt.Sprintf("%s.0.%s", isVirtualEndpointGatewayTarget, isVirtualEndpointGatewayTargetCRN)
 	return &schema.Resource{
-		Create:   resourceIBMisVirtualEndpointGatewayCreate,
-		Read:     resourceIBMisVirtualEndpointGatewayRead,
-		Update:   resourceIBMisVirtualEndpointGatewayUpdate,
-		Delete:   resourceIBMisVirtualEndpointGatewayDelete,
-		Exists:   resourceIBMisVirtualEndpointGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisVirtualEndpointGatewayCreate,
+		ReadContext:   resourceIBMisVirtualEndpointGatewayRead,
+		UpdateContext: resourceIBMisVirtualEndpointGatewayUpdate,
+		DeleteContext: resourceIBMisVirtualEndpointGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISEndpointGateway, nil),
+		},
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -179,14 +180,15 @@ func resourceIBMISEndpointGateway() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "List of tags for VPE",
 			},
+			"tags_all": resourceTagsAllSchema(),
 		},
 	}
 }
 
-func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get(isVirtualEndpointGatewayName).(string)
@@ -234,26 +236,25 @@ func resourceIBMisVirtualEndpointGatewayCreate(d *schema.ResourceData, meta inte
 	result, response, err := sess.CreateEndpointGateway(opt)
 	if err != nil {
 		log.Printf("Create Endpoint Gateway failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*result.ID)
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVirtualEndpointGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *result.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on create of VPE (%s) tags: %s", d.Id(), err)
 		}
 	}
-	return resourceIBMisVirtualEndpointGatewayRead(d, meta)
+	return resourceIBMisVirtualEndpointGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if d.HasChange(isVirtualEndpointGatewayName) {
@@ -267,37 +268,42 @@ func resourceIBMisVirtualEndpointGatewayUpdate(d *schema.ResourceData, meta inte
 		_, response, err := sess.UpdateEndpointGateway(opt)
 		if err != nil {
 			log.Printf("Update Endpoint Gateway failed: %v", response)
-			return err
+			return diagFromErr(err)
 		}
 
 	}
-	if d.HasChange(isVirtualEndpointGatewayTags) {
+	if d.HasChange("tags_all") {
 		opt := sess.NewGetEndpointGatewayOptions(d.Id())
 		result, response, err := sess.GetEndpointGateway(opt)
 		if err != nil {
-			return fmt.Errorf("Error getting VPE: %s\n%s", err, response)
+			return diagFromErr(fmt.Errorf("Error getting VPE: %s\n%s", err, response))
 		}
-		oldList, newList := d.GetChange(isVirtualEndpointGatewayTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *result.CRN)
 		if err != nil {
 			log.Printf(
 				"Error on update of VPE (%s) tags: %s", d.Id(), err)
 		}
 	}
-	return resourceIBMisVirtualEndpointGatewayRead(d, meta)
+	return resourceIBMisVirtualEndpointGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	// read option
 	opt := sess.NewGetEndpointGatewayOptions(d.Id())
 	result, response, err := sess.GetEndpointGateway(opt)
 	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("Endpoint Gateway does not exist.")
+			d.SetId("")
+			return nil
+		}
 		log.Printf("Get Endpoint Gateway failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(isVirtualEndpointGatewayName, result.Name)
 	d.Set(isVirtualEndpointGatewayHealthState, result.HealthState)
@@ -314,14 +320,14 @@ func resourceIBMisVirtualEndpointGatewayRead(d *schema.ResourceData, meta interf
 		log.Printf(
 			"Error on get of VPE (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVirtualEndpointGatewayTags, tags)
+	setResourceTags(d, meta, tags)
 	return nil
 }
 
-func resourceIBMisVirtualEndpointGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	opt := sess.NewDeleteEndpointGatewayOptions(d.Id())
@@ -332,25 +338,6 @@ func resourceIBMisVirtualEndpointGatewayDelete(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMisVirtualEndpointGatewayExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	opt := sess.NewGetEndpointGatewayOptions(d.Id())
-	_, response, err := sess.GetEndpointGateway(opt)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			log.Printf("Endpoint Gateway does not exist.")
-			return false, nil
-		}
-		log.Printf("Error : %s", response)
-		return false, err
-	}
-	return true, nil
-}
-
 func expandIPs(ipsSet []interface{}) (ipsOptions []vpcv1.EndpointGatewayReservedIPIntf) {
 	ipsList := ipsSet
 	for _, item := range ipsList {
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -28,11 +30,12 @@ const (
 
 func resourceIBMISEndpointGatewayIP() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisVirtualEndpointGatewayIPCreate,
-		Read:     resourceIBMisVirtualEndpointGatewayIPRead,
-		Delete:   resourceIBMisVirtualEndpointGatewayIPDelete,
-		Exists:   resourceIBMisVirtualEndpointGatewayIPExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisVirtualEndpointGatewayIPCreate,
+		ReadContext:   resourceIBMisVirtualEndpointGatewayIPRead,
+		DeleteContext: resourceIBMisVirtualEndpointGatewayIPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISEndpointGatewayIP, importIDParts(isVirtualEndpointGatewayID, "")),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -106,10 +109,10 @@ func resourceIBMISEndpointGatewayIP() *schema.Resource {
 	}
 }
 
-func resourceIBMisVirtualEndpointGatewayIPCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	gatewayID := d.Get(isVirtualEndpointGatewayID).(string)
 	ipID := d.Get(isVirtualEndpointGatewayIPID).(string)
@@ -117,28 +120,33 @@ func resourceIBMisVirtualEndpointGatewayIPCreate(d *schema.ResourceData, meta in
 	_, response, err := sess.AddEndpointGatewayIP(opt)
 	if err != nil {
 		log.Printf("Add Endpoint Gateway failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, ipID))
-	return resourceIBMisVirtualEndpointGatewayIPRead(d, meta)
+	return resourceIBMisVirtualEndpointGatewayIPRead(ctx, d, meta)
 }
 
-func resourceIBMisVirtualEndpointGatewayIPRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	gatewayID := parts[0]
 	ipID := parts[1]
 	opt := sess.NewGetEndpointGatewayIPOptions(gatewayID, ipID)
 	result, response, err := sess.GetEndpointGatewayIP(opt)
 	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("Endpoint Gateway IP does not exist.")
+			d.SetId("")
+			return nil
+		}
 		log.Printf("Get Endpoint Gateway IP failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(isVirtualEndpointGatewayIPID, result.ID)
 	d.Set(isVirtualEndpointGatewayIPName, result.Name)
@@ -151,14 +159,14 @@ func resourceIBMisVirtualEndpointGatewayIPRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMisVirtualEndpointGatewayIPDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisVirtualEndpointGatewayIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	gatewayID := parts[0]
 	ipID := parts[1]
@@ -166,37 +174,12 @@ func resourceIBMisVirtualEndpointGatewayIPDelete(d *schema.ResourceData, meta in
 	response, err := sess.RemoveEndpointGatewayIP(opt)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Remove Endpoint Gateway IP failed: %v", response)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMisVirtualEndpointGatewayIPExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	gatewayID := parts[0]
-	ipID := parts[1]
-	opt := sess.NewGetEndpointGatewayIPOptions(gatewayID, ipID)
-	_, response, err := sess.GetEndpointGatewayIP(opt)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			log.Printf("Endpoint Gateway IP does not exist.")
-			return false, nil
-		}
-		log.Printf("Error : %s", response)
-		return false, err
-	}
-	return true, nil
-}
-
 func flattenEndpointGatewayIPTarget(target *vpcv1.ReservedIPTarget) interface{} {
 	targetSlice := []interface{}{}
 	targetOutput := map[string]string{}
@@ -7,11 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,16 +32,18 @@ const (
 	isVolumeProvisioning     = "provisioning"
 	isVolumeProvisioningDone = "done"
 	isVolumeResourceGroup    = "resource_group"
+	isVolumeSourceSnapshot   = "source_snapshot"
 )
 
 func resourceIBMISVolume() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVolumeCreate,
-		Read:     resourceIBMISVolumeRead,
-		Update:   resourceIBMISVolumeUpdate,
-		Delete:   resourceIBMISVolumeDelete,
-		Exists:   resourceIBMISVolumeExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVolumeCreate,
+		ReadContext:   resourceIBMISVolumeRead,
+		UpdateContext: resourceIBMISVolumeUpdate,
+		DeleteContext: resourceIBMISVolumeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISVolume, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -50,7 +52,7 @@ func resourceIBMISVolume() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -88,9 +90,16 @@ func resourceIBMISVolume() *schema.Resource {
 			isVolumeCapacity: {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Default:     100,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Vloume capacity value, 100 by default or the size of the source snapshot",
+			},
+			isVolumeSourceSnapshot: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
-				Description: "Vloume capacity value",
+				Description: "The ID of the snapshot the volume is restored from",
 			},
 			isVolumeResourceGroup: {
 				Type:        schema.TypeString,
@@ -125,6 +134,7 @@ func resourceIBMISVolume() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "Tags for the volume instance",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -159,6 +169,13 @@ func resourceIBMISVolume() *schema.Resource {
 	}
 }
 
+// volumePrototypeBySourceSnapshot is a volume prototype restoring the volume from a snapshot, the
+// VolumePrototype of the SDK has no source snapshot
+type volumePrototypeBySourceSnapshot struct {
+	*vpcv1.VolumePrototype
+	SourceSnapshot vpcv1.SnapshotIdentityIntf `json:"source_snapshot"`
+}
+
 func resourceIBMISVolumeValidator() *ResourceValidator {
 
 	validateSchema := make([]ValidateSchema, 1)
@@ -176,10 +193,10 @@ func resourceIBMISVolumeValidator() *ResourceValidator {
 	return &ibmISVolumeResourceValidator
 }
 
-func resourceIBMISVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	volName := d.Get(isVolumeName).(string)
@@ -192,20 +209,23 @@ func resourceIBMISVolumeCreate(d *schema.ResourceData, meta interface{}) error {
 		volCapacity = 100
 	}
 	if userDetails.generation == 1 {
-		err := classicVolCreate(d, meta, volName, profile, zone, volCapacity)
+		if _, ok := d.GetOk(isVolumeSourceSnapshot); ok {
+			return diagFromErr(fmt.Errorf("%s is not supported by the classic infrastructure", isVolumeSourceSnapshot))
+		}
+		err := classicVolCreate(ctx, d, meta, volName, profile, zone, volCapacity)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := volCreate(d, meta, volName, profile, zone, volCapacity)
+		err := volCreate(ctx, d, meta, volName, profile, zone, volCapacity)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISVolumeRead(d, meta)
+	return resourceIBMISVolumeRead(ctx, d, meta)
 }
 
-func classicVolCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone string, volCapacity int64) error {
+func classicVolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, volName, profile, zone string, volCapacity int64) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -249,13 +269,12 @@ func classicVolCreate(d *schema.ResourceData, meta interface{}, volName, profile
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
-	_, err = isWaitForClassicVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicVolumeAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVolumeTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVolumeTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vol.CRN)
 		if err != nil {
 			log.Printf(
@@ -265,7 +284,7 @@ func classicVolCreate(d *schema.ResourceData, meta interface{}, volName, profile
 	return nil
 }
 
-func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone string, volCapacity int64) error {
+func volCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, volName, profile, zone string, volCapacity int64) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -303,19 +322,32 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 		volTemplate.Iops = &iops
 	}
 
+	if snapshot, ok := d.GetOk(isVolumeSourceSnapshot); ok {
+		snapshotID := snapshot.(string)
+		// The capacity of the volume defaults to the size of the snapshot
+		if _, ok := d.GetOk(isVolumeCapacity); !ok {
+			volTemplate.Capacity = nil
+		}
+		options.VolumePrototype = &volumePrototypeBySourceSnapshot{
+			VolumePrototype: volTemplate,
+			SourceSnapshot: &vpcv1.SnapshotIdentity{
+				ID: &snapshotID,
+			},
+		}
+	}
+
 	vol, response, err := sess.CreateVolume(options)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create volume err %s\n%s", err, response)
 	}
 	d.SetId(*vol.ID)
 	log.Printf("[INFO] Volume : %s", *vol.ID)
-	_, err = isWaitForVolumeAvailable(sess, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVolumeAvailable(ctx, sess, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVolumeTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVolumeTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vol.CRN)
 		if err != nil {
 			log.Printf(
@@ -325,22 +357,22 @@ func volCreate(d *schema.ResourceData, meta interface{}, volName, profile, zone
 	return nil
 }
 
-func resourceIBMISVolumeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicVolGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := volGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -378,7 +410,7 @@ func classicVolGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc volume (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVolumeTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -421,12 +453,15 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 	d.Set(isVolumeCapacity, *vol.Capacity)
 	d.Set(isVolumeCrn, *vol.CRN)
 	d.Set(isVolumeStatus, *vol.Status)
+	if vol.SourceSnapshot != nil {
+		d.Set(isVolumeSourceSnapshot, *vol.SourceSnapshot.ID)
+	}
 	tags, err := GetTagsUsingCRN(meta, *vol.CRN)
 	if err != nil {
 		log.Printf(
 			"Error on get of resource vpc volume (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVolumeTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -442,10 +477,10 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
@@ -460,15 +495,15 @@ func resourceIBMISVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	if userDetails.generation == 1 {
 		err := classicVolUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := volUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISVolumeRead(d, meta)
+	return resourceIBMISVolumeRead(ctx, d, meta)
 }
 
 func classicVolUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
@@ -476,7 +511,7 @@ func classicVolUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVolumeTags) {
+	if d.HasChange("tags_all") {
 		options := &vpcclassicv1.GetVolumeOptions{
 			ID: &id,
 		}
@@ -484,7 +519,7 @@ func classicVolUpdate(d *schema.ResourceData, meta interface{}, id, name string,
 		if err != nil {
 			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isVolumeTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vol.CRN)
 		if err != nil {
 			log.Printf(
@@ -516,7 +551,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVolumeTags) {
+	if d.HasChange("tags_all") {
 		options := &vpcv1.GetVolumeOptions{
 			ID: &id,
 		}
@@ -524,7 +559,7 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 		if err != nil {
 			return fmt.Errorf("Error getting Volume : %s\n%s", err, response)
 		}
-		oldList, newList := d.GetChange(isVolumeTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vol.CRN)
 		if err != nil {
 			log.Printf(
@@ -551,27 +586,27 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasCha
 	return nil
 }
 
-func resourceIBMISVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicVolDelete(d, meta, id)
+		err := classicVolDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := volDelete(d, meta, id)
+		err := volDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicVolDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicVolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -595,7 +630,7 @@ func classicVolDelete(d *schema.ResourceData, meta interface{}, id string) error
 	if err != nil {
 		return fmt.Errorf("Error Deleting Volume : %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicVolumeDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -603,7 +638,7 @@ func classicVolDelete(d *schema.ResourceData, meta interface{}, id string) error
 	return nil
 }
 
-func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func volDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -627,7 +662,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return fmt.Errorf("Error Deleting Volume : %s\n%s", err, response)
 	}
-	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVolumeDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -635,7 +670,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicVolumeDeleted(vol *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVolumeDeleted(ctx context.Context, vol *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -647,7 +682,7 @@ func isWaitForClassicVolumeDeleted(vol *vpcclassicv1.VpcClassicV1, id string, ti
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicVolumeDeleteRefreshFunc(vol *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -666,7 +701,7 @@ func isClassicVolumeDeleteRefreshFunc(vol *vpcclassicv1.VpcClassicV1, id string)
 	}
 }
 
-func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeDeleted(ctx context.Context, vol *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -678,7 +713,7 @@ func isWaitForVolumeDeleted(vol *vpcv1.VpcV1, id string, timeout time.Duration)
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -697,59 +732,7 @@ func isVolumeDeleteRefreshFunc(vol *vpcv1.VpcV1, id string) resource.StateRefres
 	}
 }
 
-func resourceIBMISVolumeExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-
-	if userDetails.generation == 1 {
-		exists, err := classicVolExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := volExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicVolExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	options := &vpcclassicv1.GetVolumeOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetVolume(options)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Volume: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func volExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	options := &vpcv1.GetVolumeOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetVolume(options)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Volume: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func isWaitForClassicVolumeAvailable(client *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVolumeAvailable(ctx context.Context, client *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -761,7 +744,7 @@ func isWaitForClassicVolumeAvailable(client *vpcclassicv1.VpcClassicV1, id strin
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicVolumeRefreshFunc(client *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -782,7 +765,7 @@ func isClassicVolumeRefreshFunc(client *vpcclassicv1.VpcClassicV1, id string) re
 	}
 }
 
-func isWaitForVolumeAvailable(client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVolumeAvailable(ctx context.Context, client *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -794,7 +777,7 @@ func isWaitForVolumeAvailable(client *vpcv1.VpcV1, id string, timeout time.Durat
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isVolumeRefreshFunc(client *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 )
 
 func TestAccIBMISVolume_basic(t *testing.T) {
@@ -135,3 +137,31 @@ func testAccCheckIBMISVolumeConfig(name string) string {
 }`, name)
 
 }
+
+func TestVolumePrototypeBySourceSnapshot(t *testing.T) {
+	name, zone, profile, snapshot := "restored", "us-south-1", "general-purpose", "r006-snapshot"
+	var prototype vpcv1.VolumePrototypeIntf = &volumePrototypeBySourceSnapshot{
+		VolumePrototype: &vpcv1.VolumePrototype{
+			Name: &name,
+			Zone: &vpcv1.ZoneIdentity{
+				Name: &zone,
+			},
+			Profile: &vpcv1.VolumeProfileIdentity{
+				Name: &profile,
+			},
+		},
+		SourceSnapshot: &vpcv1.SnapshotIdentity{
+			ID: &snapshot,
+		},
+	}
+	body, err := json.Marshal(prototype)
+	assert.NilError(t, err)
+	var got map[string]interface{}
+	assert.NilError(t, json.Unmarshal(body, &got))
+	assert.DeepEqual(t, got, map[string]interface{}{
+		"name":            "restored",
+		"zone":            map[string]interface{}{"name": "us-south-1"},
+		"profile":         map[string]interface{}{"name": "general-purpose"},
+		"source_snapshot": map[string]interface{}{"id": "r006-snapshot"},
+	})
+}
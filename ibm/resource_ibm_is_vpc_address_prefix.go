@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,19 +23,20 @@ const (
 
 func resourceIBMISVpcAddressPrefix() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVpcAddressPrefixCreate,
-		Read:     resourceIBMISVpcAddressPrefixRead,
-		Update:   resourceIBMISVpcAddressPrefixUpdate,
-		Delete:   resourceIBMISVpcAddressPrefixDelete,
-		Exists:   resourceIBMISVpcAddressPrefixExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVpcAddressPrefixCreate,
+		ReadContext:   resourceIBMISVpcAddressPrefixRead,
+		UpdateContext: resourceIBMISVpcAddressPrefixUpdate,
+		DeleteContext: resourceIBMISVpcAddressPrefixDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISVpcAddressPrefix, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			isVPCAddressPrefixPrefixName: {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     false,
-				ValidateFunc: InvokeValidator("ibm_is_address_prefix", isVPCAddressPrefixPrefixName),
+				ValidateFunc: InvokeValidator("ibm_is_vpc_address_prefix", isVPCAddressPrefixPrefixName),
 				Description:  "Name",
 			},
 			isVPCAddressPrefixZoneName: {
@@ -47,7 +50,7 @@ func resourceIBMISVpcAddressPrefix() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: InvokeValidator("ibm_is_address_prefix", isVPCAddressPrefixCIDR),
+				ValidateFunc: InvokeValidator("ibm_is_vpc_address_prefix", isVPCAddressPrefixCIDR),
 				Description:  "CIDIR address prefix",
 			},
 
@@ -87,21 +90,21 @@ func resourceIBMISAddressPrefixValidator() *ResourceValidator {
 			MaxValueLength:             63})
 	validateSchema = append(validateSchema,
 		ValidateSchema{
-			Identifier:                 isVPCRouteDestinationCIDR,
+			Identifier:                 isVPCAddressPrefixCIDR,
 			ValidateFunctionIdentifier: ValidateCIDRAddress,
 			Type:                       TypeString,
 			ForceNew:                   true,
 			Required:                   true})
 
-	ibmISAddressPrefixResourceValidator := ResourceValidator{ResourceName: "ibm_is_address_prefix", Schema: validateSchema}
+	ibmISAddressPrefixResourceValidator := ResourceValidator{ResourceName: "ibm_is_vpc_address_prefix", Schema: validateSchema}
 	return &ibmISAddressPrefixResourceValidator
 }
 
-func resourceIBMISVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	prefixName := d.Get(isVPCAddressPrefixPrefixName).(string)
 	zoneName := d.Get(isVPCAddressPrefixZoneName).(string)
@@ -115,15 +118,15 @@ func resourceIBMISVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{
 	if userDetails.generation == 1 {
 		err := classicVpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpcAddressPrefixCreate(d, meta, prefixName, zoneName, cidr, vpcID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISVpcAddressPrefixRead(d, meta)
+	return resourceIBMISVpcAddressPrefixRead(ctx, d, meta)
 }
 
 func classicVpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone, cidr, vpcID string) error {
@@ -173,14 +176,14 @@ func vpcAddressPrefixCreate(d *schema.ResourceData, meta interface{}, name, zone
 	return nil
 }
 
-func resourceIBMISVpcAddressPrefixRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	vpcID := parts[0]
@@ -188,12 +191,12 @@ func resourceIBMISVpcAddressPrefixRead(d *schema.ResourceData, meta interface{})
 	if userDetails.generation == 1 {
 		err := classicVpcAddressPrefixGet(d, meta, vpcID, addrPrefixID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpcAddressPrefixGet(d, meta, vpcID, addrPrefixID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -272,10 +275,10 @@ func vpcAddressPrefixGet(d *schema.ResourceData, meta interface{}, vpcID, addrPr
 	return nil
 }
 
-func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := ""
@@ -283,7 +286,7 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	vpcID := parts[0]
 	addrPrefixID := parts[1]
@@ -300,16 +303,16 @@ func resourceIBMISVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{
 	if userDetails.generation == 1 {
 		err := classicVpcAddressPrefixUpdate(d, meta, vpcID, addrPrefixID, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpcAddressPrefixUpdate(d, meta, vpcID, addrPrefixID, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISVpcAddressPrefixRead(d, meta)
+	return resourceIBMISVpcAddressPrefixRead(ctx, d, meta)
 }
 
 func classicVpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, addrPrefixID, name string, hasChanged bool) error {
@@ -364,15 +367,15 @@ func vpcAddressPrefixUpdate(d *schema.ResourceData, meta interface{}, vpcID, add
 	return nil
 }
 
-func resourceIBMISVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcAddressPrefixDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	vpcID := parts[0]
 	addrPrefixID := parts[1]
@@ -384,12 +387,12 @@ func resourceIBMISVpcAddressPrefixDelete(d *schema.ResourceData, meta interface{
 	if userDetails.generation == 1 {
 		err := classicVpcAddressPrefixDelete(d, meta, vpcID, addrPrefixID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpcAddressPrefixDelete(d, meta, vpcID, addrPrefixID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -461,62 +464,3 @@ func vpcAddressPrefixDelete(d *schema.ResourceData, meta interface{}, vpcID, add
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMISVpcAddressPrefixExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	vpcID := parts[0]
-	addrPrefixID := parts[1]
-
-	if userDetails.generation == 1 {
-		exists, err := classicVpcAddressPrefixExists(d, meta, vpcID, addrPrefixID)
-		return exists, err
-	} else {
-		exists, err := vpcAddressPrefixExists(d, meta, vpcID, addrPrefixID)
-		return exists, err
-	}
-}
-
-func classicVpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpcID, addrPrefixID string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getvpcAddressPrefixOptions := &vpcclassicv1.GetVPCAddressPrefixOptions{
-		VPCID: &vpcID,
-		ID:    &addrPrefixID,
-	}
-	_, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting VPC Address Prefix: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func vpcAddressPrefixExists(d *schema.ResourceData, meta interface{}, vpcID, addrPrefixID string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getvpcAddressPrefixOptions := &vpcv1.GetVPCAddressPrefixOptions{
-		VPCID: &vpcID,
-		ID:    &addrPrefixID,
-	}
-	_, response, err := sess.GetVPCAddressPrefix(getvpcAddressPrefixOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting VPC Address Prefix: %s\n%s", err, response)
-	}
-	return true, nil
-}
@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -33,12 +35,13 @@ const (
 
 func resourceIBMISVpcRoute() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVpcRouteCreate,
-		Read:     resourceIBMISVpcRouteRead,
-		Update:   resourceIBMISVpcRouteUpdate,
-		Delete:   resourceIBMISVpcRouteDelete,
-		Exists:   resourceIBMISVpcRouteExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVpcRouteCreate,
+		ReadContext:   resourceIBMISVpcRouteRead,
+		UpdateContext: resourceIBMISVpcRouteUpdate,
+		DeleteContext: resourceIBMISVpcRouteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISVpcRoute, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -50,7 +53,7 @@ func resourceIBMISVpcRoute() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     false,
-				ValidateFunc: InvokeValidator("ibm_is_route", isVPCRouteName),
+				ValidateFunc: InvokeValidator("ibm_is_vpc_route", isVPCRouteName),
 				Description:  "VPC route name",
 			},
 			isVPCRouteLocation: {
@@ -64,7 +67,7 @@ func resourceIBMISVpcRoute() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: InvokeValidator("ibm_is_route", isVPCRouteDestinationCIDR),
+				ValidateFunc: InvokeValidator("ibm_is_vpc_route", isVPCRouteDestinationCIDR),
 				Description:  "VPC route destination CIDR value",
 			},
 
@@ -116,14 +119,14 @@ func resourceIBMISRouteValidator() *ResourceValidator {
 			ForceNew:                   true,
 			Required:                   true})
 
-	ibmISRouteResourceValidator := ResourceValidator{ResourceName: "ibm_is_route", Schema: validateSchema}
+	ibmISRouteResourceValidator := ResourceValidator{ResourceName: "ibm_is_vpc_route", Schema: validateSchema}
 	return &ibmISRouteResourceValidator
 }
 
-func resourceIBMISVpcRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	routeName := d.Get(isVPCRouteName).(string)
@@ -132,20 +135,20 @@ func resourceIBMISVpcRouteCreate(d *schema.ResourceData, meta interface{}) error
 	vpcID := d.Get(isVPCRouteVPCID).(string)
 	nextHop := d.Get(isVPCRouteNextHop).(string)
 	if userDetails.generation == 1 {
-		err := classicVpcRouteCreate(d, meta, routeName, zoneName, cidr, vpcID, nextHop)
+		err := classicVpcRouteCreate(ctx, d, meta, routeName, zoneName, cidr, vpcID, nextHop)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := vpcRouteCreate(d, meta, routeName, zoneName, cidr, vpcID, nextHop)
+		err := vpcRouteCreate(ctx, d, meta, routeName, zoneName, cidr, vpcID, nextHop)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISVpcRouteRead(d, meta)
+	return resourceIBMISVpcRouteRead(ctx, d, meta)
 }
 
-func classicVpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneName, cidr, vpcID, nextHop string) error {
+func classicVpcRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, routeName, zoneName, cidr, vpcID, nextHop string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -169,14 +172,14 @@ func classicVpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName,
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, routeID))
 
-	_, err = isWaitForClassicRouteStable(sess, d, vpcID, routeID)
+	_, err = isWaitForClassicRouteStable(ctx, sess, d, vpcID, routeID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneName, cidr, vpcID, nextHop string) error {
+func vpcRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, routeName, zoneName, cidr, vpcID, nextHop string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -200,14 +203,14 @@ func vpcRouteCreate(d *schema.ResourceData, meta interface{}, routeName, zoneNam
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, routeID))
 
-	_, err = isWaitForRouteStable(sess, d, vpcID, routeID)
+	_, err = isWaitForRouteStable(ctx, sess, d, vpcID, routeID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func isWaitForClassicRouteStable(sess *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
+func isWaitForClassicRouteStable(ctx context.Context, sess *vpcclassicv1.VpcClassicV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isRouteStatusPending, isRouteStatusUpdating},
@@ -232,10 +235,10 @@ func isWaitForClassicRouteStable(sess *vpcclassicv1.VpcClassicV1, d *schema.Reso
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
+func isWaitForRouteStable(ctx context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, routeID string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{isRouteStatusPending, isRouteStatusUpdating},
@@ -260,17 +263,17 @@ func isWaitForRouteStable(sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, rout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func resourceIBMISVpcRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	vpcID := parts[0]
@@ -278,12 +281,12 @@ func resourceIBMISVpcRouteRead(d *schema.ResourceData, meta interface{}) error {
 	if userDetails.generation == 1 {
 		err := classicVpcRouteGet(d, meta, vpcID, routeID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpcRouteGet(d, meta, vpcID, routeID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -364,18 +367,18 @@ func vpcRouteGet(d *schema.ResourceData, meta interface{}, vpcID, routeID string
 	return nil
 }
 
-func resourceIBMISVpcRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := ""
 	hasChanged := false
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	vpcID := parts[0]
@@ -388,16 +391,16 @@ func resourceIBMISVpcRouteUpdate(d *schema.ResourceData, meta interface{}) error
 	if userDetails.generation == 1 {
 		err := classicVpcRouteUpdate(d, meta, vpcID, routeID, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpcRouteUpdate(d, meta, vpcID, routeID, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISVpcRouteRead(d, meta)
+	return resourceIBMISVpcRouteRead(ctx, d, meta)
 }
 
 func classicVpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, routeID, name string, hasChanged bool) error {
@@ -452,26 +455,26 @@ func vpcRouteUpdate(d *schema.ResourceData, meta interface{}, vpcID, routeID, na
 	return nil
 }
 
-func resourceIBMISVpcRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVpcRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	vpcID := parts[0]
 	routeID := parts[1]
 	if userDetails.generation == 1 {
-		err := classicVpcRouteDelete(d, meta, vpcID, routeID)
+		err := classicVpcRouteDelete(ctx, d, meta, vpcID, routeID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := vpcRouteDelete(d, meta, vpcID, routeID)
+		err := vpcRouteDelete(ctx, d, meta, vpcID, routeID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -479,7 +482,7 @@ func resourceIBMISVpcRouteDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func classicVpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
+func classicVpcRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -504,7 +507,7 @@ func classicVpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, rout
 	if err != nil {
 		return fmt.Errorf("Error Deleting VPC Route: %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicVPCRouteDeleted(sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicVPCRouteDeleted(ctx, sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -512,7 +515,7 @@ func classicVpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, rout
 	return nil
 }
 
-func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
+func vpcRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcID, routeID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -537,7 +540,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 	if err != nil {
 		return fmt.Errorf("Error Deleting VPC Route: %s\n%s", err, response)
 	}
-	_, err = isWaitForVPCRouteDeleted(sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPCRouteDeleted(ctx, sess, vpcID, routeID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -545,7 +548,7 @@ func vpcRouteDelete(d *schema.ResourceData, meta interface{}, vpcID, routeID str
 	return nil
 }
 
-func isWaitForClassicVPCRouteDeleted(sess *vpcclassicv1.VpcClassicV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVPCRouteDeleted(ctx context.Context, sess *vpcclassicv1.VpcClassicV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for VPC Route (%s) to be deleted.", routeID)
 	stateConf := &resource.StateChangeConf{
@@ -571,10 +574,10 @@ func isWaitForClassicVPCRouteDeleted(sess *vpcclassicv1.VpcClassicV1, vpcID, rou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPCRouteDeleted(ctx context.Context, sess *vpcv1.VpcV1, vpcID, routeID string, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for VPC Route (%s) to be deleted.", routeID)
 	stateConf := &resource.StateChangeConf{
@@ -599,64 +602,5 @@ func isWaitForVPCRouteDeleted(sess *vpcv1.VpcV1, vpcID, routeID string, timeout
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
-}
-
-func resourceIBMISVpcRouteExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	vpcID := parts[0]
-	routeID := parts[1]
-	if userDetails.generation == 1 {
-		exists, err := classicVpcRouteExists(d, meta, vpcID, routeID)
-		return exists, err
-	} else {
-		exists, err := vpcRouteExists(d, meta, vpcID, routeID)
-		return exists, err
-	}
-}
-
-func classicVpcRouteExists(d *schema.ResourceData, meta interface{}, vpcID, routeID string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getVpcRouteOptions := &vpcclassicv1.GetVPCRouteOptions{
-		VPCID: &vpcID,
-		ID:    &routeID,
-	}
-	_, response, err := sess.GetVPCRoute(getVpcRouteOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting VPC Route: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func vpcRouteExists(d *schema.ResourceData, meta interface{}, vpcID, routeID string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getVpcRouteOptions := &vpcv1.GetVPCRouteOptions{
-		VPCID: &vpcID,
-		ID:    &routeID,
-	}
-	_, response, err := sess.GetVPCRoute(getVpcRouteOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting VPC Route: %s\n%s", err, response)
-	}
-	return true, nil
+	return stateConf.WaitForStateContext(ctx)
 }
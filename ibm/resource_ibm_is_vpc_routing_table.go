@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"github.com/IBM/go-sdk-core/v4/core"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -36,12 +38,13 @@ const (
 
 func resourceIBMISVPCRoutingTable() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPCRoutingTableCreate,
-		Read:     resourceIBMISVPCRoutingTableRead,
-		Update:   resourceIBMISVPCRoutingTableUpdate,
-		Delete:   resourceIBMISVPCRoutingTableDelete,
-		Exists:   resourceIBMISVPCRoutingTableExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPCRoutingTableCreate,
+		ReadContext:   resourceIBMISVPCRoutingTableRead,
+		UpdateContext: resourceIBMISVPCRoutingTableUpdate,
+		DeleteContext: resourceIBMISVPCRoutingTableDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISVPCRoutingTable, importIDParts(rtVpcID, "")),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -163,10 +166,10 @@ func resourceIBMISVPCRoutingTableValidator() *ResourceValidator {
 	return &ibmISVPCRoutingTableValidator
 }
 
-func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	vpcID := d.Get(rtVpcID).(string)
@@ -189,18 +192,18 @@ func resourceIBMISVPCRoutingTableCreate(d *schema.ResourceData, meta interface{}
 	routeTable, response, err := sess.CreateVPCRoutingTable(createVpcRoutingTableOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create VPC Routing table err %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", vpcID, *routeTable.ID))
 
-	return resourceIBMISVPCRoutingTableRead(d, meta)
+	return resourceIBMISVPCRoutingTableRead(ctx, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -211,7 +214,7 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Routing table: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error Getting VPC Routing table: %s\n%s", err, response))
 	}
 
 	d.Set(rtID, routeTable.ID)
@@ -240,10 +243,10 @@ func resourceIBMISVPCRoutingTableRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -271,21 +274,21 @@ func resourceIBMISVPCRoutingTableUpdate(d *schema.ResourceData, meta interface{}
 	}
 	routingTablePatchModelAsPatch, asPatchErr := routingTablePatchModel.AsPatch()
 	if asPatchErr != nil {
-		return fmt.Errorf("Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr)
+		return diagFromErr(fmt.Errorf("Error calling asPatch for RoutingTablePatchModel: %s", asPatchErr))
 	}
 	updateVpcRoutingTableOptions.RoutingTablePatch = routingTablePatchModelAsPatch
 	_, response, err := sess.UpdateVPCRoutingTable(updateVpcRoutingTableOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update VPC Routing table err %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
-	return resourceIBMISVPCRoutingTableRead(d, meta)
+	return resourceIBMISVPCRoutingTableRead(ctx, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -294,28 +297,9 @@ func resourceIBMISVPCRoutingTableDelete(d *schema.ResourceData, meta interface{}
 	response, err := sess.DeleteVPCRoutingTable(deleteTableOptions)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting VPC Routing table : %s", response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMISVPCRoutingTableExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	idSet := strings.Split(d.Id(), "/")
-	getVpcRoutingTableOptions := sess.NewGetVPCRoutingTableOptions(idSet[0], idSet[1])
-	_, response, err := sess.GetVPCRoutingTable(getVpcRoutingTableOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			d.SetId("")
-			return false, nil
-		}
-		return false, fmt.Errorf("Error Getting VPC Routing table : %s\n%s", err, response)
-	}
-	return true, nil
-}
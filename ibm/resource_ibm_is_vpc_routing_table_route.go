@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/IBM/go-sdk-core/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,12 +27,13 @@ const (
 
 func resourceIBMISVPCRoutingTableRoute() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPCRoutingTableRouteCreate,
-		Read:     resourceIBMISVPCRoutingTableRouteRead,
-		Update:   resourceIBMISVPCRoutingTableRouteUpdate,
-		Delete:   resourceIBMISVPCRoutingTableRouteDelete,
-		Exists:   resourceIBMISVPCRoutingTableRouteExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPCRoutingTableRouteCreate,
+		ReadContext:   resourceIBMISVPCRoutingTableRouteRead,
+		UpdateContext: resourceIBMISVPCRoutingTableRouteUpdate,
+		DeleteContext: resourceIBMISVPCRoutingTableRouteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISVPCRoutingTableRoute, importIDParts(rtVpcID, rtID, "")),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -141,10 +144,10 @@ func resourceIBMISVPCRoutingTableRouteValidator() *ResourceValidator {
 	return &ibmVPCRoutingTableRouteValidator
 }
 
-func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	vpcID := d.Get(rtVpcID).(string)
@@ -159,7 +162,7 @@ func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interf
 		Address: core.StringPtr(nextHop),
 	}
 
-	createVpcRoutingTableRouteOptions := sess.NewCreateVPCRoutingTableRouteOptions(vpcID, tableID, destination, nh, z)
+	createVpcRoutingTableRouteOptions := sess.NewCreateVPCRoutingTableRouteOptions(vpcID, tableID, destination, z)
 	createVpcRoutingTableRouteOptions.SetZone(z)
 	createVpcRoutingTableRouteOptions.SetDestination(destination)
 	createVpcRoutingTableRouteOptions.SetNextHop(nh)
@@ -177,18 +180,18 @@ func resourceIBMISVPCRoutingTableRouteCreate(d *schema.ResourceData, meta interf
 	route, response, err := sess.CreateVPCRoutingTableRoute(createVpcRoutingTableRouteOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Create VPC Routing table route err %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", vpcID, tableID, *route.ID))
 	d.Set(rID, *route.ID)
-	return resourceIBMISVPCRoutingTableRouteRead(d, meta)
+	return resourceIBMISVPCRoutingTableRouteRead(ctx, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -199,7 +202,7 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting VPC Routing table route: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error Getting VPC Routing table route: %s\n%s", err, response))
 	}
 
 	d.Set(rID, *route.ID)
@@ -222,10 +225,10 @@ func resourceIBMISVPCRoutingTableRouteRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceIBMISVPCRoutingTableRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -240,24 +243,24 @@ func resourceIBMISVPCRoutingTableRouteUpdate(d *schema.ResourceData, meta interf
 		routePatchModelAsPatch, patchErr := routePatchModel.AsPatch()
 
 		if patchErr != nil {
-			return fmt.Errorf("Error calling asPatch for VPC Routing Table Route Patch: %s", patchErr)
+			return diagFromErr(fmt.Errorf("Error calling asPatch for VPC Routing Table Route Patch: %s", patchErr))
 		}
 
 		updateVpcRoutingTableRouteOptions.RoutePatch = routePatchModelAsPatch
 		_, response, err := sess.UpdateVPCRoutingTableRoute(updateVpcRoutingTableRouteOptions)
 		if err != nil {
 			log.Printf("[DEBUG] Update VPC Routing table route err %s\n%s", err, response)
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMISVPCRoutingTableRouteRead(d, meta)
+	return resourceIBMISVPCRoutingTableRouteRead(ctx, d, meta)
 }
 
-func resourceIBMISVPCRoutingTableRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPCRoutingTableRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -265,28 +268,9 @@ func resourceIBMISVPCRoutingTableRouteDelete(d *schema.ResourceData, meta interf
 	response, err := sess.DeleteVPCRoutingTableRoute(deleteVpcRoutingTableRouteOptions)
 	if err != nil && response.StatusCode != 404 {
 		log.Printf("Error deleting VPC Routing table route : %s", response)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMISVPCRoutingTableRouteExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	idSet := strings.Split(d.Id(), "/")
-	getVpcRoutingTableRouteOptions := sess.NewGetVPCRoutingTableRouteOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.GetVPCRoutingTableRoute(getVpcRoutingTableRouteOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			d.SetId("")
-			return false, nil
-		}
-		return false, fmt.Errorf("Error Getting VPC Routing table route : %s\n%s", err, response)
-	}
-	return true, nil
-}
@@ -7,11 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,12 +36,13 @@ const (
 
 func resourceIBMISVPNGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPNGatewayCreate,
-		Read:     resourceIBMISVPNGatewayRead,
-		Update:   resourceIBMISVPNGatewayUpdate,
-		Delete:   resourceIBMISVPNGatewayDelete,
-		Exists:   resourceIBMISVPNGatewayExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPNGatewayCreate,
+		ReadContext:   resourceIBMISVPNGatewayRead,
+		UpdateContext: resourceIBMISVPNGatewayUpdate,
+		DeleteContext: resourceIBMISVPNGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISVPNGateway, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -50,7 +51,7 @@ func resourceIBMISVPNGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -60,7 +61,7 @@ func resourceIBMISVPNGateway() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     false,
-				ValidateFunc: InvokeValidator("ibm_is_route", isVPNGatewayName),
+				ValidateFunc: InvokeValidator("ibm_is_vpc_route", isVPNGatewayName),
 				Description:  "VPN Gateway instance name",
 			},
 
@@ -103,6 +104,7 @@ func resourceIBMISVPNGateway() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "VPN Gateway tags list",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -202,10 +204,10 @@ func resourceIBMISVPNGatewayValidator() *ResourceValidator {
 	return &ibmISVPNGatewayResourceValidator
 }
 
-func resourceIBMISVPNGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	log.Printf("[DEBUG] VPNGateway create")
 	name := d.Get(isVPNGatewayName).(string)
@@ -213,20 +215,20 @@ func resourceIBMISVPNGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	mode := d.Get(isVPNGatewayMode).(string)
 
 	if userDetails.generation == 1 {
-		err := classicVpngwCreate(d, meta, name, subnetID)
+		err := classicVpngwCreate(ctx, d, meta, name, subnetID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := vpngwCreate(d, meta, name, subnetID, mode)
+		err := vpngwCreate(ctx, d, meta, name, subnetID, mode)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISVPNGatewayRead(d, meta)
+	return resourceIBMISVPNGatewayRead(ctx, d, meta)
 }
 
-func classicVpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID string) error {
+func classicVpngwCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, subnetID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -253,7 +255,7 @@ func classicVpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID
 		return fmt.Errorf("[DEBUG] Create vpc VPN Gateway %s\n%s", err, response)
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcclassicv1.VPNGateway)
-	_, err = isWaitForClassicVpnGatewayAvailable(sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForClassicVpnGatewayAvailable(ctx, sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -261,9 +263,8 @@ func classicVpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID
 	d.SetId(*vpnGateway.ID)
 	log.Printf("[INFO] VPNGateway : %s", *vpnGateway.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVPNGatewayTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN)
 		if err != nil {
 			log.Printf(
@@ -273,7 +274,7 @@ func classicVpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID
 	return nil
 }
 
-func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode string) error {
+func vpngwCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, name, subnetID, mode string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -302,7 +303,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 	}
 	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
-	_, err = isWaitForVpnGatewayAvailable(sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForVpnGatewayAvailable(ctx, sess, *vpnGateway.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -310,9 +311,8 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 	d.SetId(*vpnGateway.ID)
 	log.Printf("[INFO] VPNGateway : %s", *vpnGateway.ID)
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(isVPNGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(isVPNGatewayTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN)
 		if err != nil {
 			log.Printf(
@@ -322,7 +322,7 @@ func vpngwCreate(d *schema.ResourceData, meta interface{}, name, subnetID, mode
 	return nil
 }
 
-func isWaitForClassicVpnGatewayAvailable(vpnGateway *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVpnGatewayAvailable(ctx context.Context, vpnGateway *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for vpn gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -334,7 +334,7 @@ func isWaitForClassicVpnGatewayAvailable(vpnGateway *vpcclassicv1.VpcClassicV1,
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicVpnGatewayRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -356,7 +356,7 @@ func isClassicVpnGatewayRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id st
 	}
 }
 
-func isWaitForVpnGatewayAvailable(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayAvailable(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for vpn gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -368,7 +368,7 @@ func isWaitForVpnGatewayAvailable(vpnGateway *vpcv1.VpcV1, id string, timeout ti
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -390,22 +390,22 @@ func isVpnGatewayRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateR
 	}
 }
 
-func resourceIBMISVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
 	if userDetails.generation == 1 {
 		err := classicVpngwGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpngwGet(d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -446,7 +446,7 @@ func classicVpngwGet(d *schema.ResourceData, meta interface{}, id string) error
 		log.Printf(
 			"Error on get of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVPNGatewayTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -496,7 +496,7 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 		log.Printf(
 			"Error on get of resource vpc VPN Gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(isVPNGatewayTags, tags)
+	setResourceTags(d, meta, tags)
 	controller, err := getBaseController(meta)
 	if err != nil {
 		return err
@@ -529,10 +529,10 @@ func vpngwGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	id := d.Id()
@@ -547,15 +547,15 @@ func resourceIBMISVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 	if userDetails.generation == 1 {
 		err := classicVpngwUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpngwUpdate(d, meta, id, name, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISVPNGatewayRead(d, meta)
+	return resourceIBMISVPNGatewayRead(ctx, d, meta)
 }
 
 func classicVpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
@@ -563,7 +563,7 @@ func classicVpngwUpdate(d *schema.ResourceData, meta interface{}, id, name strin
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPNGatewayTags) {
+	if d.HasChange("tags_all") {
 		getVpnGatewayOptions := &vpcclassicv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
@@ -573,7 +573,7 @@ func classicVpngwUpdate(d *schema.ResourceData, meta interface{}, id, name strin
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcclassicv1.VPNGateway)
 
-		oldList, newList := d.GetChange(isVPNGatewayTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN)
 		if err != nil {
 			log.Printf(
@@ -605,7 +605,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 	if err != nil {
 		return err
 	}
-	if d.HasChange(isVPNGatewayTags) {
+	if d.HasChange("tags_all") {
 		getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 			ID: &id,
 		}
@@ -615,7 +615,7 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 		}
 		vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
 
-		oldList, newList := d.GetChange(isVPNGatewayTags)
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *vpnGateway.CRN)
 		if err != nil {
 			log.Printf(
@@ -642,27 +642,27 @@ func vpngwUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasC
 	return nil
 }
 
-func resourceIBMISVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 	if userDetails.generation == 1 {
-		err := classicVpngwDelete(d, meta, id)
+		err := classicVpngwDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := vpngwDelete(d, meta, id)
+		err := vpngwDelete(ctx, d, meta, id)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicVpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func classicVpngwDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -686,7 +686,7 @@ func classicVpngwDelete(d *schema.ResourceData, meta interface{}, id string) err
 	if err != nil {
 		return fmt.Errorf("Error Deleting Vpn Gateway : %s\n%s", err, response)
 	}
-	_, err = isWaitForClassicVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicVpnGatewayDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -694,7 +694,7 @@ func classicVpngwDelete(d *schema.ResourceData, meta interface{}, id string) err
 	return nil
 }
 
-func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
+func vpngwDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -718,7 +718,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return fmt.Errorf("Error Deleting Vpn Gateway : %s\n%s", err, response)
 	}
-	_, err = isWaitForVpnGatewayDeleted(sess, id, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVpnGatewayDeleted(ctx, sess, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return err
 	}
@@ -726,7 +726,7 @@ func vpngwDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func isWaitForClassicVpnGatewayDeleted(vpnGateway *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVpnGatewayDeleted(ctx context.Context, vpnGateway *vpcclassicv1.VpcClassicV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -738,7 +738,7 @@ func isWaitForClassicVpnGatewayDeleted(vpnGateway *vpcclassicv1.VpcClassicV1, id
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicVpnGatewayDeleteRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1, id string) resource.StateRefreshFunc {
@@ -757,7 +757,7 @@ func isClassicVpnGatewayDeleteRefreshFunc(vpnGateway *vpcclassicv1.VpcClassicV1,
 	}
 }
 
-func isWaitForVpnGatewayDeleted(vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForVpnGatewayDeleted(ctx context.Context, vpnGateway *vpcv1.VpcV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -769,7 +769,7 @@ func isWaitForVpnGatewayDeleted(vpnGateway *vpcv1.VpcV1, id string, timeout time
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.StateRefreshFunc {
@@ -787,54 +787,3 @@ func isVpnGatewayDeleteRefreshFunc(vpnGateway *vpcv1.VpcV1, id string) resource.
 		return vpngw, isVPNGatewayDeleting, err
 	}
 }
-
-func resourceIBMISVPNGatewayExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-	if userDetails.generation == 1 {
-		exists, err := classicVpngwExists(d, meta, id)
-		return exists, err
-	} else {
-		exists, err := vpngwExists(d, meta, id)
-		return exists, err
-	}
-}
-
-func classicVpngwExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getVpnGatewayOptions := &vpcclassicv1.GetVPNGatewayOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Vpn Gatewa: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func vpngwExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-	getVpnGatewayOptions := &vpcv1.GetVPNGatewayOptions{
-		ID: &id,
-	}
-	_, response, err := sess.GetVPNGateway(getVpnGatewayOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Vpn Gatewa: %s\n%s", err, response)
-	}
-	return true, nil
-}
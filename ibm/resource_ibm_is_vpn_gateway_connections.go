@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/vpc-go-sdk/vpcclassicv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,12 +43,13 @@ const (
 
 func resourceIBMISVPNGatewayConnection() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVPNGatewayConnectionCreate,
-		Read:     resourceIBMISVPNGatewayConnectionRead,
-		Update:   resourceIBMISVPNGatewayConnectionUpdate,
-		Delete:   resourceIBMISVPNGatewayConnectionDelete,
-		Exists:   resourceIBMISVPNGatewayConnectionExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISVPNGatewayConnectionCreate,
+		ReadContext:   resourceIBMISVPNGatewayConnectionRead,
+		UpdateContext: resourceIBMISVPNGatewayConnectionUpdate,
+		DeleteContext: resourceIBMISVPNGatewayConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMISVPNGatewayConnection, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -239,10 +242,10 @@ func resourceIBMISVPNGatewayConnectionValidator() *ResourceValidator {
 	return &ibmISVPNGatewayConnectionResourceValidator
 }
 
-func resourceIBMISVPNGatewayConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("[DEBUG] VPNGatewayConnection create")
@@ -277,15 +280,15 @@ func resourceIBMISVPNGatewayConnectionCreate(d *schema.ResourceData, meta interf
 	if userDetails.generation == 1 {
 		err := classicVpngwconCreate(d, meta, name, gatewayID, peerAddress, prephasedKey, action, interval, timeout, stateUp)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpngwconCreate(d, meta, name, gatewayID, peerAddress, prephasedKey, action, interval, timeout, stateUp)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISVPNGatewayConnectionRead(d, meta)
+	return resourceIBMISVPNGatewayConnectionRead(ctx, d, meta)
 }
 
 func classicVpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, peerAddress, prephasedKey, action string, interval, timeout int64, stateUp bool) error {
@@ -403,15 +406,15 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 	return nil
 }
 
-func resourceIBMISVPNGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	gID := parts[0]
@@ -420,12 +423,12 @@ func resourceIBMISVPNGatewayConnectionRead(d *schema.ResourceData, meta interfac
 	if userDetails.generation == 1 {
 		err := classicVpngwconGet(d, meta, gID, gConnID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpngwconGet(d, meta, gID, gConnID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
@@ -561,16 +564,16 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	return nil
 }
 
-func resourceIBMISVPNGatewayConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	hasChanged := false
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	gID := parts[0]
@@ -579,15 +582,15 @@ func resourceIBMISVPNGatewayConnectionUpdate(d *schema.ResourceData, meta interf
 	if userDetails.generation == 1 {
 		err := classicVpngwconUpdate(d, meta, gID, gConnID, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
 		err := vpngwconUpdate(d, meta, gID, gConnID, hasChanged)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMISVPNGatewayConnectionRead(d, meta)
+	return resourceIBMISVPNGatewayConnectionRead(ctx, d, meta)
 }
 
 func classicVpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID string, hasChanged bool) error {
@@ -752,35 +755,35 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	return nil
 }
 
-func resourceIBMISVPNGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVPNGatewayConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	gID := parts[0]
 	gConnID := parts[1]
 
 	if userDetails.generation == 1 {
-		err := classicVpngwconDelete(d, meta, gID, gConnID)
+		err := classicVpngwconDelete(ctx, d, meta, gID, gConnID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	} else {
-		err := vpngwconDelete(d, meta, gID, gConnID)
+		err := vpngwconDelete(ctx, d, meta, gID, gConnID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	return nil
 }
 
-func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
+func classicVpngwconDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
 	sess, err := classicVpcClient(meta)
 	if err != nil {
 		return err
@@ -807,7 +810,7 @@ func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnI
 		return fmt.Errorf("Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
 	}
 
-	_, err = isWaitForClassicVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForClassicVPNGatewayConnectionDeleted(ctx, sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for Vpn Gateway Connection (%s) is deleted: %s", gConnID, err)
@@ -817,7 +820,7 @@ func classicVpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnI
 	return nil
 }
 
-func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
+func vpngwconDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, gID, gConnID string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -845,7 +848,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		return fmt.Errorf("Error Deleting Vpn Gateway Connection : %s\n%s", err, response)
 	}
 
-	_, err = isWaitForVPNGatewayConnectionDeleted(sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForVPNGatewayConnectionDeleted(ctx, sess, gID, gConnID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return fmt.Errorf(
 			"Error checking for Vpn Gateway Connection (%s) is deleted: %s", gConnID, err)
@@ -855,7 +858,7 @@ func vpngwconDelete(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	return nil
 }
 
-func isWaitForClassicVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcclassicv1.VpcClassicV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+func isWaitForClassicVPNGatewayConnectionDeleted(ctx context.Context, vpnGatewayConnection *vpcclassicv1.VpcClassicV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
 
 	stateConf := &resource.StateChangeConf{
@@ -867,7 +870,7 @@ func isWaitForClassicVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcclassi
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isClassicVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcclassicv1.VpcClassicV1, gID, gConnID string) resource.StateRefreshFunc {
@@ -887,7 +890,7 @@ func isClassicVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpccla
 	}
 }
 
-func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+func isWaitForVPNGatewayConnectionDeleted(ctx context.Context, vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for VPNGatewayConnection (%s) to be deleted.", gConnID)
 
 	stateConf := &resource.StateChangeConf{
@@ -899,7 +902,7 @@ func isWaitForVPNGatewayConnectionDeleted(vpnGatewayConnection *vpcv1.VpcV1, gID
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1, gID, gConnID string) resource.StateRefreshFunc {
@@ -918,64 +921,3 @@ func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1,
 		return vpngwcon, isVPNGatewayConnectionDeleting, nil
 	}
 }
-
-func resourceIBMISVPNGatewayConnectionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	userDetails, err := meta.(ClientSession).BluemixUserDetails()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	gID := parts[0]
-	gConnID := parts[1]
-	if userDetails.generation == 1 {
-		exists, err := classicVpngwconExists(d, meta, gID, gConnID)
-		return exists, err
-	} else {
-		exists, err := vpngwconExists(d, meta, gID, gConnID)
-		return exists, err
-	}
-}
-
-func classicVpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnID string) (bool, error) {
-	sess, err := classicVpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	getVpnGatewayConnectionOptions := &vpcclassicv1.GetVPNGatewayConnectionOptions{
-		VPNGatewayID: &gID,
-		ID:           &gConnID,
-	}
-	_, response, err := sess.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Vpn Gateway Connection: %s\n%s", err, response)
-	}
-	return true, nil
-}
-
-func vpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnID string) (bool, error) {
-	sess, err := vpcClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	getVpnGatewayConnectionOptions := &vpcv1.GetVPNGatewayConnectionOptions{
-		VPNGatewayID: &gID,
-		ID:           &gConnID,
-	}
-	_, response, err := sess.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error getting Vpn Gateway Connection: %s\n%s", err, response)
-	}
-	return true, nil
-}
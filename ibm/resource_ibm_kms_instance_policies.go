@@ -0,0 +1,316 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	kmsDualAuthDelete        = "dual_auth_delete"
+	kmsAllowedNetwork        = "allowed_network"
+	kmsKeyCreateImportAccess = "key_create_import_access"
+)
+
+func resourceIBMKmsInstancePolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsInstancePoliciesCreate,
+		ReadContext:   resourceIBMKmsInstancePoliciesRead,
+		UpdateContext: resourceIBMKmsInstancePoliciesUpdate,
+		DeleteContext: resourceIBMKmsInstancePoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMKmsInstancePolicies, nil),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			kmsDualAuthDelete: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{kmsDualAuthDelete, kmsAllowedNetwork, kmsKeyCreateImportAccess},
+				Description:  "Requires two users to authorize the deletion of the keys of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Whether the policy is enabled",
+						},
+					},
+				},
+			},
+			kmsAllowedNetwork: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{kmsDualAuthDelete, kmsAllowedNetwork, kmsKeyCreateImportAccess},
+				Description:  "Restricts the network the instance can be reached from",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Whether the policy is enabled",
+						},
+						"network": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "public-and-private",
+							ValidateFunc: InvokeValidator("ibm_kms_instance_policies", "network"),
+							Description:  "public-and-private or private-only",
+						},
+					},
+				},
+			},
+			kmsKeyCreateImportAccess: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{kmsDualAuthDelete, kmsAllowedNetwork, kmsKeyCreateImportAccess},
+				Description:  "Restricts how the keys of the instance can be created",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Whether the policy is enabled",
+						},
+						"create_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Allows the creation of root keys",
+						},
+						"create_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Allows the creation of standard keys",
+						},
+						"import_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Allows the import of root keys",
+						},
+						"import_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Allows the import of standard keys",
+						},
+						"enforce_token": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Requires an import token to import keys",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMKmsInstancePoliciesValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "network",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public-and-private, private-only"})
+
+	ibmKmsInstancePoliciesResourceValidator := ResourceValidator{ResourceName: "ibm_kms_instance_policies", Schema: validateSchema}
+	return &ibmKmsInstancePoliciesResourceValidator
+}
+
+func expandKMSKeyCreateImportAccess(policy map[string]interface{}) *kmsKeyCreateImportAccessAttributes {
+	return &kmsKeyCreateImportAccessAttributes{
+		CreateRootKey:     policy["create_root_key"].(bool),
+		CreateStandardKey: policy["create_standard_key"].(bool),
+		ImportRootKey:     policy["import_root_key"].(bool),
+		ImportStandardKey: policy["import_standard_key"].(bool),
+		EnforceToken:      policy["enforce_token"].(bool),
+	}
+}
+
+func flattenKMSAllowedNetwork(policy *kp.InstancePolicy) []map[string]interface{} {
+	enabled := policy.PolicyData.Enabled != nil && *policy.PolicyData.Enabled
+	network := "public-and-private"
+	if policy.PolicyData.Attributes != nil && policy.PolicyData.Attributes.AllowedNetwork != "" {
+		network = policy.PolicyData.Attributes.AllowedNetwork
+	}
+	return []map[string]interface{}{{"enabled": enabled, "network": network}}
+}
+
+func flattenKMSKeyCreateImportAccess(policy *kmsKeyCreateImportAccessPolicy) []map[string]interface{} {
+	// Without attributes every way of creating a key is allowed
+	attributes := kmsKeyCreateImportAccessAttributes{true, true, true, true, false}
+	if policy.PolicyData.Attributes != nil {
+		attributes = *policy.PolicyData.Attributes
+	}
+	return []map[string]interface{}{{
+		"enabled":             policy.PolicyData.Enabled,
+		"create_root_key":     attributes.CreateRootKey,
+		"create_standard_key": attributes.CreateStandardKey,
+		"import_root_key":     attributes.ImportRootKey,
+		"import_standard_key": attributes.ImportStandardKey,
+		"enforce_token":       attributes.EnforceToken,
+	}}
+}
+
+// setKMSInstancePolicy sets the policy from its block, a removed block
+// disables the policy
+func setKMSInstancePolicy(ctx context.Context, api *kp.Client, name string, block []interface{}) error {
+	policy := map[string]interface{}{"enabled": false}
+	if len(block) > 0 && block[0] != nil {
+		policy = block[0].(map[string]interface{})
+	}
+	enabled := policy["enabled"].(bool)
+	switch name {
+	case kmsDualAuthDelete:
+		return api.SetDualAuthInstancePolicy(ctx, enabled)
+	case kmsAllowedNetwork:
+		network, _ := policy["network"].(string)
+		if network == "" {
+			network = "public-and-private"
+		}
+		return api.SetAllowedNetworkInstancePolicy(ctx, enabled, network)
+	case kmsKeyCreateImportAccess:
+		var attributes *kmsKeyCreateImportAccessAttributes
+		if enabled {
+			attributes = expandKMSKeyCreateImportAccess(policy)
+		}
+		return setKMSKeyCreateImportAccessPolicy(ctx, api, enabled, attributes)
+	}
+	return fmt.Errorf("Unknown instance policy %s", name)
+}
+
+func resourceIBMKmsInstancePoliciesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	api, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	for _, name := range []string{kmsDualAuthDelete, kmsAllowedNetwork, kmsKeyCreateImportAccess} {
+		if block, ok := d.GetOk(name); ok {
+			err = setKMSInstancePolicy(ctx, api, name, block.([]interface{}))
+			if err != nil {
+				return diagFromErr(newAttributeError(name, fmt.Sprintf("Error setting the %s policy of instance %s", name, instanceID), err))
+			}
+		}
+	}
+	d.SetId(instanceID)
+	return resourceIBMKmsInstancePoliciesRead(ctx, d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	// An imported resource reads every enabled policy, otherwise only the
+	// policies of the configuration are read
+	importing := d.Get("instance_id").(string) == ""
+	managed := func(name string) bool {
+		return importing || len(d.Get(name).([]interface{})) > 0
+	}
+	endpointType := d.Get("endpoint_type").(string)
+	api, _, err := kmsInstanceAPI(meta, instanceID, endpointType)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if managed(kmsDualAuthDelete) {
+		policy, err := api.GetDualAuthInstancePolicy(ctx)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error retrieving the %s policy of instance %s: %s", kmsDualAuthDelete, instanceID, err))
+		}
+		enabled := policy != nil && policy.PolicyData.Enabled != nil && *policy.PolicyData.Enabled
+		if enabled || !importing {
+			d.Set(kmsDualAuthDelete, []map[string]interface{}{{"enabled": enabled}})
+		}
+	}
+	if managed(kmsAllowedNetwork) {
+		policy, err := api.GetAllowedNetworkInstancePolicy(ctx)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error retrieving the %s policy of instance %s: %s", kmsAllowedNetwork, instanceID, err))
+		}
+		if policy == nil {
+			policy = &kp.InstancePolicy{}
+		}
+		allowedNetwork := flattenKMSAllowedNetwork(policy)
+		if allowedNetwork[0]["enabled"].(bool) || !importing {
+			d.Set(kmsAllowedNetwork, allowedNetwork)
+		}
+	}
+	if managed(kmsKeyCreateImportAccess) {
+		policy, err := getKMSKeyCreateImportAccessPolicy(ctx, api)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error retrieving the %s policy of instance %s: %s", kmsKeyCreateImportAccess, instanceID, err))
+		}
+		if policy == nil {
+			policy = &kmsKeyCreateImportAccessPolicy{}
+		}
+		if policy.PolicyData.Enabled || !importing {
+			d.Set(kmsKeyCreateImportAccess, flattenKMSKeyCreateImportAccess(policy))
+		}
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+	return nil
+}
+
+func resourceIBMKmsInstancePoliciesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	api, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	for _, name := range []string{kmsDualAuthDelete, kmsAllowedNetwork, kmsKeyCreateImportAccess} {
+		if d.HasChange(name) {
+			err = setKMSInstancePolicy(ctx, api, name, d.Get(name).([]interface{}))
+			if err != nil {
+				return diagFromErr(newAttributeError(name, fmt.Sprintf("Error updating the %s policy of instance %s", name, instanceID), err))
+			}
+		}
+	}
+	return resourceIBMKmsInstancePoliciesRead(ctx, d, meta)
+}
+
+// resourceIBMKmsInstancePoliciesDelete disables the policies managed by the resource
+func resourceIBMKmsInstancePoliciesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	api, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	for _, name := range []string{kmsDualAuthDelete, kmsAllowedNetwork, kmsKeyCreateImportAccess} {
+		if len(d.Get(name).([]interface{})) > 0 {
+			err = setKMSInstancePolicy(ctx, api, name, nil)
+			if err != nil && !isKMSNotFound(err) {
+				return diagFromErr(fmt.Errorf("Error disabling the %s policy of instance %s: %s", name, instanceID, err))
+			}
+		}
+	}
+	d.SetId("")
+	return nil
+}
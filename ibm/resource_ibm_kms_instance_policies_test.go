@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSInstancePoliciesResource_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "dual_auth_delete.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "allowed_network.0.network", "public-and-private"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "key_create_import_access.0.enabled", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "dual_auth_delete.0.enabled", "false"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "key_create_import_access.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "key_create_import_access.0.create_standard_key", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsInstancePoliciesConfig(instanceName string, dualAuthDelete, keyCreateImportAccess bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_instance_policies" "test" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		dual_auth_delete {
+			enabled = %t
+		}
+		allowed_network {
+			enabled = true
+			network = "public-and-private"
+		}
+		key_create_import_access {
+			enabled             = %t
+			create_standard_key = false
+		}
+	}
+`, instanceName, dualAuthDelete, keyCreateImportAccess)
+}
@@ -13,17 +13,19 @@ import (
 	"time"
 
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMKmskey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKmsKeyCreate,
-		Read:     resourceIBMKmsKeyRead,
-		Update:   resourceIBMKmsKeyUpdate,
-		Delete:   resourceIBMKmsKeyDelete,
-		Exists:   resourceIBMKmsKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMKmsKeyCreate,
+		ReadContext:   resourceIBMKmsKeyRead,
+		UpdateContext: resourceIBMKmsKeyUpdate,
+		DeleteContext: resourceIBMKmsKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMKmskey, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -240,15 +242,15 @@ func resourceIBMKmskey() *schema.Resource {
 	}
 }
 
-func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
@@ -258,7 +260,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 	instanceData, err := rContollerApi.GetInstance(instanceID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceCRN := instanceData.Crn.String()
 	crnData := strings.Split(instanceCRN, ":")
@@ -268,12 +270,12 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -284,7 +286,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diagFromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 		}
 		kpAPI.URL = u
 	} else if crnData[4] == "kms" {
@@ -294,7 +296,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diagFromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 	kpAPI.Config.InstanceID = instanceID
 	name := d.Get("key_name").(string)
@@ -306,7 +308,7 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		// parse string to required time format
 		expiration_time, err := time.Parse(time.RFC3339, expiration_string)
 		if err != nil {
-			return fmt.Errorf("Invalid time format (the date format follows RFC 3339): %s", err)
+			return diagFromErr(newAttributeError("expiration_date", "Invalid time format (the date format follows RFC 3339)", err))
 		}
 		expiration = &expiration_time
 	} else {
@@ -318,20 +320,20 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := d.GetOk("payload"); ok {
 			//import standard key
 			payload := v.(string)
-			stkey, err := kpAPI.CreateImportedStandardKey(context.Background(), name, expiration, payload)
+			stkey, err := kpAPI.CreateImportedStandardKey(ctx, name, expiration, payload)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating standard key with payload: %s", err)
+				return diagFromErr(fmt.Errorf(
+					"Error while creating standard key with payload: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
 
 		} else {
 			//create standard key
-			stkey, err := kpAPI.CreateStandardKey(context.Background(), name, expiration)
+			stkey, err := kpAPI.CreateStandardKey(ctx, name, expiration)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating standard key: %s", err)
+				return diagFromErr(fmt.Errorf(
+					"Error while creating standard key: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
@@ -342,33 +344,33 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 			payload := v.(string)
 			encryptedNonce := d.Get("encrypted_nonce").(string)
 			iv := d.Get("iv_value").(string)
-			stkey, err := kpAPI.CreateImportedRootKey(context.Background(), name, expiration, payload, encryptedNonce, iv)
+			stkey, err := kpAPI.CreateImportedRootKey(ctx, name, expiration, payload, encryptedNonce, iv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating Root key with payload: %s", err)
+				return diagFromErr(fmt.Errorf(
+					"Error while creating Root key with payload: %s", err))
 			}
 
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
 
 		} else {
-			stkey, err := kpAPI.CreateRootKey(context.Background(), name, expiration)
+			stkey, err := kpAPI.CreateRootKey(ctx, name, expiration)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating Root key: %s", err)
+				return diagFromErr(fmt.Errorf(
+					"Error while creating Root key: %s", err))
 			}
 			keyCRN = stkey.CRN
 			d.SetId(keyCRN)
 
 		}
 	}
-	return resourceIBMKmsKeyUpdate(d, meta)
+	return resourceIBMKmsKeyUpdate(ctx, d, meta)
 }
 
-func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -383,12 +385,12 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 		instanceType = "hs-crypto"
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -399,7 +401,7 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diagFromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 
 		}
 		kpAPI.URL = u
@@ -411,20 +413,24 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diagFromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 
 	kpAPI.Config.InstanceID = instanceID
 	// keyid := d.Id()
-	key, err := kpAPI.GetKey(context.Background(), keyid)
+	key, err := kpAPI.GetKey(ctx, keyid)
 	if err != nil {
-		return fmt.Errorf("Get Key failed with error: %s", err)
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Get Key failed with error: %s", err))
 	}
 
-	policies, err := kpAPI.GetPolicies(context.Background(), keyid)
+	policies, err := kpAPI.GetPolicies(ctx, keyid)
 
 	if err != nil {
-		return fmt.Errorf("Failed to read policies: %s", err)
+		return diagFromErr(fmt.Errorf("Failed to read policies: %s", err))
 	}
 	if len(policies) == 0 {
 		log.Printf("No Policy Configurations read\n")
@@ -454,7 +460,7 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set(ResourceStatus, strconv.Itoa(state))
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := key.ID
 	crn1 := strings.TrimSuffix(key.CRN, ":key:"+id)
@@ -465,7 +471,7 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
@@ -474,12 +480,12 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		kpAPI, err := meta.(ClientSession).keyManagementAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		instanceID := d.Get("instance_id").(string)
@@ -489,7 +495,7 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		instanceData, err := rContollerApi.GetInstance(instanceID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		instanceCRN := instanceData.Crn.String()
 		crnData := strings.Split(instanceCRN, ":")
@@ -499,12 +505,12 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		if crnData[4] == "hs-crypto" {
 			hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 
 			resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 
 			if endpointType == "public" {
@@ -515,7 +521,7 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 
 			u, err := url.Parse(hpcsEndpointURL)
 			if err != nil {
-				return fmt.Errorf("Error Parsing hpcs EndpointURL")
+				return diagFromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 			}
 			kpAPI.URL = u
 		} else if crnData[4] == "kms" {
@@ -525,7 +531,7 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 			}
 		} else {
-			return fmt.Errorf("Invalid or unsupported service Instance")
+			return diagFromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 		}
 
 		kpAPI.Config.InstanceID = instanceID
@@ -534,20 +540,20 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		crnData = strings.Split(crn, ":")
 		key_id := crnData[len(crnData)-1]
 
-		err = handlePolicies(d, kpAPI, meta, key_id)
+		err = handlePolicies(ctx, d, kpAPI, meta, key_id)
 		if err != nil {
-			resourceIBMKmsKeyRead(d, meta)
-			return fmt.Errorf("Could not create policies: %s", err)
+			resourceIBMKmsKeyRead(ctx, d, meta)
+			return diagFromErr(newAttributeError("policies", "Could not create policies", err))
 		}
 	}
-	return resourceIBMKmsKeyRead(d, meta)
+	return resourceIBMKmsKeyRead(ctx, d, meta)
 
 }
 
-func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKmsKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	kpAPI, err := meta.(ClientSession).keyManagementAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -561,12 +567,12 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	if crnData[4] == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		if endpointType == "public" {
@@ -577,7 +583,7 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
+			return diagFromErr(fmt.Errorf("Error Parsing hpcs EndpointURL"))
 		}
 		kpAPI.URL = u
 	} else if crnData[4] == "kms" {
@@ -587,84 +593,24 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+		return diagFromErr(fmt.Errorf("Invalid or unsupported service Instance"))
 	}
 
 	force := d.Get("force_delete").(bool)
 	f := kp.ForceOpt{
 		Force: force,
 	}
-	_, err1 := kpAPI.DeleteKey(context.Background(), keyid, kp.ReturnRepresentation, f)
+	_, err1 := kpAPI.DeleteKey(ctx, keyid, kp.ReturnRepresentation, f)
 	if err1 != nil {
-		return fmt.Errorf(
-			"Error while deleting: %s", err1)
+		return diagFromErr(fmt.Errorf(
+			"Error while deleting: %s", err1))
 	}
 	d.SetId("")
 	return nil
 
 }
 
-func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	kpAPI, err := meta.(ClientSession).keyManagementAPI()
-	if err != nil {
-		return false, err
-	}
-
-	crn := d.Id()
-	crnData := strings.Split(crn, ":")
-	endpointType := crnData[3]
-	instanceID := crnData[len(crnData)-3]
-	keyid := crnData[len(crnData)-1]
-	kpAPI.Config.InstanceID = instanceID
-
-	var hpcsEndpointURL string
-
-	if crnData[4] == "hs-crypto" {
-		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
-		if err != nil {
-			return false, err
-		}
-
-		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
-		if err != nil {
-			return false, err
-		}
-
-		if endpointType == "public" {
-			hpcsEndpointURL = "https://" + resp.Kms.Public + "/api/v2/keys"
-		} else {
-			hpcsEndpointURL = "https://" + resp.Kms.Private + "/api/v2/keys"
-		}
-
-		u, err := url.Parse(hpcsEndpointURL)
-		if err != nil {
-			return false, fmt.Errorf("Error Parsing hpcs EndpointURL")
-
-		}
-		kpAPI.URL = u
-	} else if crnData[4] == "kms" {
-		if endpointType == "private" {
-			if !strings.HasPrefix(kpAPI.Config.BaseURL, "private") {
-				kpAPI.Config.BaseURL = "private." + kpAPI.Config.BaseURL
-			}
-		}
-	} else {
-		return false, fmt.Errorf("Invalid or unsupported service Instance")
-	}
-
-	_, err = kpAPI.GetKey(context.Background(), keyid)
-	if err != nil {
-		kpError := err.(*kp.Error)
-		if kpError.StatusCode == 404 {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
-
-}
-
-func handlePolicies(d *schema.ResourceData, kpAPI *kp.Client, meta interface{}, key_id string) error {
+func handlePolicies(ctx context.Context, d *schema.ResourceData, kpAPI *kp.Client, meta interface{}, key_id string) error {
 	var setRotation, setDualAuthDelete, dualAuthEnable bool
 	var rotationInterval int
 
@@ -688,7 +634,7 @@ func handlePolicies(d *schema.ResourceData, kpAPI *kp.Client, meta interface{},
 			}
 		}
 
-		_, err := kpAPI.SetPolicies(context.Background(), key_id, setRotation, rotationInterval, setDualAuthDelete, dualAuthEnable)
+		_, err := kpAPI.SetPolicies(ctx, key_id, setRotation, rotationInterval, setDualAuthDelete, dualAuthEnable)
 		if err != nil {
 			return fmt.Errorf("Error while creating policies: %s", err)
 		}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKeyAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyAliasCreate,
+		ReadContext:   resourceIBMKmsKeyAliasRead,
+		DeleteContext: resourceIBMKmsKeyAliasDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMKmsKeyAlias, nil),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the key",
+			},
+			"alias": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_kms_key_alias", "alias"),
+				Description:  "The alias of the key, unique in the instance",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyAliasValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "alias",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-zA-Z0-9_.-]*$`,
+			MinValueLength:             2,
+			MaxValueLength:             90})
+
+	ibmKmsKeyAliasResourceValidator := ResourceValidator{ResourceName: "ibm_kms_key_alias", Schema: validateSchema}
+	return &ibmKmsKeyAliasResourceValidator
+}
+
+func resourceIBMKmsKeyAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	keyID := d.Get("key_id").(string)
+	alias := d.Get("alias").(string)
+	api, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	_, err = createKMSKeyAlias(ctx, api, keyID, alias)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating alias %s of key %s: %s", alias, keyID, err))
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, keyID, alias))
+	return resourceIBMKmsKeyAliasRead(ctx, d, meta)
+}
+
+func resourceIBMKmsKeyAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if len(parts) != 3 {
+		return diagFromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of instanceID/keyID/alias", d.Id()))
+	}
+	instanceID, keyID, alias := parts[0], parts[1], parts[2]
+	endpointType := d.Get("endpoint_type").(string)
+	api, _, err := kmsInstanceAPI(meta, instanceID, endpointType)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	aliases, err := getKMSKeyAliases(ctx, api, keyID)
+	if err != nil {
+		if isKMSNotFound(err) {
+			log.Printf("[INFO] resourceIBMKmsKeyAliasRead key %s does not exist", keyID)
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving the aliases of key %s: %s", keyID, err))
+	}
+	for _, a := range aliases {
+		if a == alias {
+			d.Set("instance_id", instanceID)
+			d.Set("key_id", keyID)
+			d.Set("alias", alias)
+			d.Set("endpoint_type", endpointType)
+			return nil
+		}
+	}
+	log.Printf("[INFO] resourceIBMKmsKeyAliasRead alias %s of key %s does not exist", alias, keyID)
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMKmsKeyAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	instanceID, keyID, alias := parts[0], parts[1], parts[2]
+	api, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	err = deleteKMSKeyAlias(ctx, api, keyID, alias)
+	if err != nil && !isKMSNotFound(err) {
+		return diagFromErr(fmt.Errorf("Error deleting alias %s of key %s: %s", alias, keyID, err))
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKeyRings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyRingsCreate,
+		ReadContext:   resourceIBMKmsKeyRingsRead,
+		DeleteContext: resourceIBMKmsKeyRingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMKmsKeyRings, nil),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"key_ring_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_kms_key_rings", "key_ring_id"),
+				Description:  "The ID of the key ring",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creator of the key ring",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the key ring",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyRingsValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "key_ring_id",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Required:                   true,
+			Regexp:                     `^[a-zA-Z0-9-]*$`,
+			MinValueLength:             2,
+			MaxValueLength:             100})
+
+	ibmKmsKeyRingsResourceValidator := ResourceValidator{ResourceName: "ibm_kms_key_rings", Schema: validateSchema}
+	return &ibmKmsKeyRingsResourceValidator
+}
+
+func resourceIBMKmsKeyRingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	keyRingID := d.Get("key_ring_id").(string)
+	api, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	err = createKMSKeyRing(ctx, api, keyRingID)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating key ring %s in instance %s: %s", keyRingID, instanceID, err))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, keyRingID))
+	return resourceIBMKmsKeyRingsRead(ctx, d, meta)
+}
+
+func resourceIBMKmsKeyRingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if len(parts) != 2 {
+		return diagFromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of instanceID/keyRingID", d.Id()))
+	}
+	instanceID, keyRingID := parts[0], parts[1]
+	endpointType := d.Get("endpoint_type").(string)
+	api, _, err := kmsInstanceAPI(meta, instanceID, endpointType)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	keyRings, err := listKMSKeyRings(ctx, api)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error retrieving the key rings of instance %s: %s", instanceID, err))
+	}
+	for _, keyRing := range keyRings {
+		if keyRing.ID != keyRingID {
+			continue
+		}
+		d.Set("instance_id", instanceID)
+		d.Set("key_ring_id", keyRingID)
+		d.Set("endpoint_type", endpointType)
+		d.Set("created_by", keyRing.CreatedBy)
+		if keyRing.CreationDate != nil {
+			d.Set("creation_date", keyRing.CreationDate.String())
+		}
+		return nil
+	}
+	log.Printf("[INFO] resourceIBMKmsKeyRingsRead key ring %s does not exist in instance %s", keyRingID, instanceID)
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMKmsKeyRingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	instanceID, keyRingID := parts[0], parts[1]
+	api, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	// A key ring with keys can't be deleted, the keys have to be deleted first
+	err = deleteKMSKeyRing(ctx, api, keyRingID)
+	if err != nil && !isKMSNotFound(err) {
+		return diagFromErr(fmt.Errorf("Error deleting key ring %s in instance %s: %s", keyRingID, instanceID, err))
+	}
+	d.SetId("")
+	return nil
+}
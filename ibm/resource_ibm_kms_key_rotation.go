@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyRotationCreate,
+		ReadContext:   resourceIBMKmsKeyRotationRead,
+		DeleteContext: resourceIBMKmsKeyRotationDelete,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the root key to rotate",
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The new key material of an imported root key, base64 encoded",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values, a change rotates the key again",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"key_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key version created by the rotation",
+			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date of the rotation",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	keyID := d.Get("key_id").(string)
+	api, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	err = api.Rotate(ctx, keyID, d.Get("payload").(string))
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error rotating key %s: %s", keyID, err))
+	}
+	key, err := api.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error retrieving key %s after the rotation: %s", keyID, err))
+	}
+	versionID := ""
+	if key.KeyVersion != nil {
+		versionID = key.KeyVersion.ID
+	}
+	log.Printf("[INFO] resourceIBMKmsKeyRotationCreate key %s rotated to version %s", keyID, versionID)
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, keyID, versionID))
+	d.Set("key_version_id", versionID)
+	if key.LastRotateDate != nil {
+		d.Set("last_rotate_date", key.LastRotateDate.String())
+	}
+	return nil
+}
+
+// resourceIBMKmsKeyRotationRead only checks that the key still exists, a
+// later rotation of the key doesn't undo this one
+func resourceIBMKmsKeyRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	keyID := d.Get("key_id").(string)
+	api, _, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	_, err = api.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		if isKMSNotFound(err) {
+			log.Printf("[INFO] resourceIBMKmsKeyRotationRead key %s does not exist", keyID)
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving key %s: %s", keyID, err))
+	}
+	return nil
+}
+
+// resourceIBMKmsKeyRotationDelete removes the rotation from the state, a
+// rotation can't be undone
+func resourceIBMKmsKeyRotationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
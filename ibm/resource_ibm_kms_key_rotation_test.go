@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMKMSKeyRotationResource_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	var firstVersion string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotation.test", "key_version_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotation.test", "last_rotate_date"),
+					func(s *terraform.State) error {
+						firstVersion = s.RootModule().Resources["ibm_kms_key_rotation.test"].Primary.Attributes["key_version_id"]
+						return nil
+					},
+				),
+			},
+			resource.TestStep{
+				// A new trigger rotates the key again
+				Config: testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, "2"),
+				Check: func(s *terraform.State) error {
+					version := s.RootModule().Resources["ibm_kms_key_rotation.test"].Primary.Attributes["key_version_id"]
+					if version == firstVersion {
+						return fmt.Errorf("The key was not rotated again, its version is still %s", version)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, rotation string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_key_rotation" "test" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		key_id      = ibm_kms_key.test.key_id
+		triggers = {
+			rotation = "%s"
+		}
+	}
+`, instanceName, keyName, rotation)
+}
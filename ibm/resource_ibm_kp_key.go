@@ -12,17 +12,19 @@ import (
 	"time"
 
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMkey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMKeyCreate,
-		Read:     resourceIBMKeyRead,
-		Update:   resourceIBMKeyUpdate,
-		Delete:   resourceIBMKeyDelete,
-		Exists:   resourceIBMKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMKeyCreate,
+		ReadContext:   resourceIBMKeyRead,
+		UpdateContext: resourceIBMKeyUpdate,
+		DeleteContext: resourceIBMKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMkey, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -114,10 +116,10 @@ func resourceIBMkey() *schema.Resource {
 	}
 }
 
-func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(ClientSession).keyProtectAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceID := d.Get("key_protect_id").(string)
 	api.Config.InstanceID = instanceID
@@ -129,18 +131,18 @@ func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := d.GetOk("payload"); ok {
 			//import standard key
 			payload := v.(string)
-			stkey, err := api.CreateImportedStandardKey(context.Background(), name, nil, payload)
+			stkey, err := api.CreateImportedStandardKey(ctx, name, nil, payload)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating standard key: %s", err)
+				return diagFromErr(fmt.Errorf(
+					"Error while creating standard key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		} else {
 			//create standard key
-			stkey, err := api.CreateStandardKey(context.Background(), name, nil)
+			stkey, err := api.CreateStandardKey(ctx, name, nil)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating standard key: %s", err)
+				return diagFromErr(fmt.Errorf(
+					"Error while creating standard key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		}
@@ -150,17 +152,17 @@ func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
 			payload := v.(string)
 			encryptedNonce := d.Get("encrypted_nonce").(string)
 			iv := d.Get("iv_value").(string)
-			stkey, err := api.CreateImportedRootKey(context.Background(), name, nil, payload, encryptedNonce, iv)
+			stkey, err := api.CreateImportedRootKey(ctx, name, nil, payload, encryptedNonce, iv)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating Root key: %s", err)
+				return diagFromErr(fmt.Errorf(
+					"Error while creating Root key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		} else {
-			stkey, err := api.CreateRootKey(context.Background(), name, nil)
+			stkey, err := api.CreateRootKey(ctx, name, nil)
 			if err != nil {
-				return fmt.Errorf(
-					"Error while creating Root key: %s", err)
+				return diagFromErr(fmt.Errorf(
+					"Error while creating Root key: %s", err))
 			}
 			keyCRN = stkey.CRN
 		}
@@ -170,26 +172,34 @@ func resourceIBMKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("force_delete", d.Get("force_delete").(bool))
 
-	return resourceIBMKeyRead(d, meta)
+	return resourceIBMKeyRead(ctx, d, meta)
 }
 
-func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(ClientSession).keyProtectAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
+	if len(crnData) < 3 {
+		return diagFromErr(fmt.Errorf("Incorrect ID %s: it should be the CRN of a key", crn))
+	}
 
 	instanceID := crnData[len(crnData)-3]
 	keyid := crnData[len(crnData)-1]
 	api.Config.InstanceID = instanceID
 	// keyid := d.Id()
-	key, err := api.GetKey(context.Background(), keyid)
+	key, err := api.GetKey(ctx, keyid)
 	if err != nil {
-		return fmt.Errorf(
-			"Get Key failed with error: %s", err)
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf(
+			"Get Key failed with error: %s", err))
 	}
+	d.Set("key_protect_id", instanceID)
 	d.Set("key_id", keyid)
 	d.Set("standard_key", key.Extractable)
 	d.Set("payload", key.Payload)
@@ -206,7 +216,7 @@ func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := key.ID
 	crn1 := strings.TrimSuffix(key.CRN, ":key:"+id)
@@ -217,19 +227,19 @@ func resourceIBMKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
-	return resourceIBMKeyRead(d, meta)
+	return resourceIBMKeyRead(ctx, d, meta)
 
 }
 
-func resourceIBMKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := meta.(ClientSession).keyProtectAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	crn := d.Id()
 	crnData := strings.Split(crn, ":")
@@ -241,36 +251,12 @@ func resourceIBMKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	f := kp.ForceOpt{
 		Force: force,
 	}
-	_, err1 := api.DeleteKey(context.Background(), keyid, kp.ReturnRepresentation, f)
+	_, err1 := api.DeleteKey(ctx, keyid, kp.ReturnRepresentation, f)
 	if err1 != nil {
-		return fmt.Errorf(
-			"Error while deleting: %s", err1)
+		return diagFromErr(fmt.Errorf(
+			"Error while deleting: %s", err1))
 	}
 	d.SetId("")
 	return nil
 
 }
-
-func resourceIBMKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	api, err := meta.(ClientSession).keyProtectAPI()
-	if err != nil {
-		return false, err
-	}
-	crn := d.Id()
-	crnData := strings.Split(crn, ":")
-
-	instanceID := crnData[len(crnData)-3]
-	keyid := crnData[len(crnData)-1]
-	api.Config.InstanceID = instanceID
-	// keyid := d.Id()
-	_, err = api.GetKey(context.Background(), keyid)
-	if err != nil {
-		kpError := err.(*kp.Error)
-		if kpError.StatusCode == 404 {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
-
-}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -22,12 +24,13 @@ import (
 
 func resourceIBMLbService() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbServiceCreate,
-		Read:     resourceIBMLbServiceRead,
-		Update:   resourceIBMLbServiceUpdate,
-		Delete:   resourceIBMLbServiceDelete,
-		Exists:   resourceIBMLbServiceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbServiceCreate,
+		ReadContext:   resourceIBMLbServiceRead,
+		UpdateContext: resourceIBMLbServiceUpdate,
+		DeleteContext: resourceIBMLbServiceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMLbService, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_group_id": {
@@ -73,7 +76,7 @@ func resourceIBMLbService() *schema.Resource {
 	}
 }
 
-func resourceIBMLbServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	// SoftLayer Local LBs consist of a multi-level hierarchy of types.
@@ -88,7 +91,7 @@ func resourceIBMLbServiceCreate(d *schema.ResourceData, meta interface{}) error
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer service group from SoftLayer, %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving load balancer service group from SoftLayer, %s", err))
 	}
 
 	// Store the IDs for later use
@@ -98,7 +101,7 @@ func resourceIBMLbServiceCreate(d *schema.ResourceData, meta interface{}) error
 	// Convert the health check type name to an ID
 	healthCheckTypeId, err := getHealthCheckTypeId(sess, d.Get("health_check_type").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// The API only exposes edit capability at the root of the tree (virtualIpAddress),
@@ -135,10 +138,10 @@ func resourceIBMLbServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Println("[INFO] Creating load balancer service")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(ctx, sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
-		return fmt.Errorf("Error creating load balancer service: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating load balancer service: %s", err))
 	}
 
 	// Retrieve the newly created object, to obtain its ID
@@ -151,17 +154,17 @@ func resourceIBMLbServiceCreate(d *schema.ResourceData, meta interface{}) error
 		GetServices()
 
 	if err != nil || len(svcs) == 0 {
-		return fmt.Errorf("Error retrieving load balancer: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving load balancer: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*svcs[0].Id))
 
 	log.Printf("[INFO] Load Balancer Service ID: %s", d.Id())
 
-	return resourceIBMLbServiceRead(d, meta)
+	return resourceIBMLbServiceRead(ctx, d, meta)
 }
 
-func resourceIBMLbServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	// Using the ID stored in the config, find the IDs of the respective
@@ -173,7 +176,7 @@ func resourceIBMLbServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer service group from SoftLayer, %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving load balancer service group from SoftLayer, %s", err))
 	}
 
 	// Store the IDs for later use
@@ -184,7 +187,7 @@ func resourceIBMLbServiceUpdate(d *schema.ResourceData, meta interface{}) error
 	// Convert the health check type name to an ID
 	healthCheckTypeId, err := getHealthCheckTypeId(sess, d.Get("health_check_type").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// The API only exposes edit capability at the root of the tree (virtualIpAddress),
@@ -222,16 +225,16 @@ func resourceIBMLbServiceUpdate(d *schema.ResourceData, meta interface{}) error
 
 	log.Println("[INFO] Updating load balancer service")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(ctx, sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
-		return fmt.Errorf("Error updating load balancer service: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating load balancer service: %s", err))
 	}
 
-	return resourceIBMLbServiceRead(d, meta)
+	return resourceIBMLbServiceRead(ctx, d, meta)
 }
 
-func resourceIBMLbServiceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	svcID, _ := strconv.Atoi(d.Id())
@@ -242,7 +245,11 @@ func resourceIBMLbServiceRead(d *schema.ResourceData, meta interface{}) error {
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving service: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving service: %s", err))
 	}
 
 	d.Set("ip_address_id", svc.IpAddressId)
@@ -255,7 +262,7 @@ func resourceIBMLbServiceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMLbServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	svcID, _ := strconv.Atoi(d.Id())
@@ -292,36 +299,15 @@ func resourceIBMLbServiceDelete(d *schema.ResourceData, meta interface{}) error
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		return fmt.Errorf("Error deleting service: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting service: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMLbServiceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-
-	svcID, _ := strconv.Atoi(d.Id())
-
-	_, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerServiceService(sess).
-		Id(svcID).
-		Mask("id").
-		GetObject()
-
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return true, nil
-}
-
 func getHealthCheckTypeId(sess *session.Session, healthCheckTypeName string) (int, error) {
 	healthCheckTypes, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerHealthCheckTypeService(sess).
 		Mask("id").
@@ -341,7 +327,7 @@ func getHealthCheckTypeId(sess *session.Session, healthCheckTypeName string) (in
 	return *healthCheckTypes[0].Id, nil
 }
 
-func updateLoadBalancerService(sess *session.Session, vipID int, vip *datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) error {
+func updateLoadBalancerService(ctx context.Context, sess *session.Session, vipID int, vip *datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -370,7 +356,7 @@ func updateLoadBalancerService(sess *session.Session, vipID int, vip *datatypes.
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 
 	return err
 }
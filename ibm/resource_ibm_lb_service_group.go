@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -23,12 +25,13 @@ import (
 
 func resourceIBMLbServiceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbServiceGroupCreate,
-		Read:     resourceIBMLbServiceGroupRead,
-		Update:   resourceIBMLbServiceGroupUpdate,
-		Delete:   resourceIBMLbServiceGroupDelete,
-		Exists:   resourceIBMLbServiceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbServiceGroupCreate,
+		ReadContext:   resourceIBMLbServiceGroupRead,
+		UpdateContext: resourceIBMLbServiceGroupUpdate,
+		DeleteContext: resourceIBMLbServiceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMLbServiceGroup, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"virtual_server_id": {
@@ -84,19 +87,19 @@ func resourceIBMLbServiceGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vipID := d.Get("load_balancer_id").(int)
 
 	routingMethodID, err := getRoutingMethodId(sess, d.Get("routing_method").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	routingTypeID, err := getRoutingTypeId(sess, d.Get("routing_type").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	timeout := d.Get("timeout").(int)
@@ -119,10 +122,10 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Creating load balancer service group")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(ctx, sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
-		return fmt.Errorf("Error creating load balancer service group: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating load balancer service group: %s", err))
 	}
 
 	// Retrieve the newly created object, to obtain its ID
@@ -133,7 +136,7 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 		GetVirtualServers()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving load balancer: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*vs[0].Id))
@@ -141,9 +144,9 @@ func resourceIBMLbServiceGroupCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] Load Balancer Service Group ID: %s", d.Id())
 
-	return resourceIBMLbServiceGroupRead(d, meta)
+	return resourceIBMLbServiceGroupRead(ctx, d, meta)
 }
-func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vipID := d.Get("load_balancer_id").(int)
@@ -152,12 +155,12 @@ func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) e
 
 	routingMethodId, err := getRoutingMethodId(sess, d.Get("routing_method").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	routingTypeId, err := getRoutingTypeId(sess, d.Get("routing_type").(string))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	vip := datatypes.Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{
@@ -185,16 +188,16 @@ func resourceIBMLbServiceGroupUpdate(d *schema.ResourceData, meta interface{}) e
 
 	log.Println("[INFO] Updating load balancer service group")
 
-	err = updateLoadBalancerService(sess.SetRetries(0), vipID, &vip)
+	err = updateLoadBalancerService(ctx, sess.SetRetries(0), vipID, &vip)
 
 	if err != nil {
-		return fmt.Errorf("Error creating load balancer service group: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating load balancer service group: %s", err))
 	}
 
-	return resourceIBMLbServiceGroupRead(d, meta)
+	return resourceIBMLbServiceGroupRead(ctx, d, meta)
 }
 
-func resourceIBMLbServiceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vsID, _ := strconv.Atoi(d.Id())
@@ -205,7 +208,11 @@ func resourceIBMLbServiceGroupRead(d *schema.ResourceData, meta interface{}) err
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving load balancer: %s", err))
 	}
 
 	d.Set("allocation", vs.Allocation)
@@ -219,7 +226,7 @@ func resourceIBMLbServiceGroupRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMLbServiceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbServiceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vsID, _ := strconv.Atoi(d.Id())
@@ -257,37 +264,15 @@ func resourceIBMLbServiceGroupDelete(d *schema.ResourceData, meta interface{}) e
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		return fmt.Errorf("Error deleting service: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting service: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMLbServiceGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-
-	vsID, _ := strconv.Atoi(d.Id())
-
-	_, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerVirtualServerService(sess).
-		Id(vsID).
-		Mask("id").
-		GetObject()
-
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return true, nil
-}
-
 func getRoutingTypeId(sess *session.Session, routingTypeName string) (int, error) {
 	routingTypes, err := services.GetNetworkApplicationDeliveryControllerLoadBalancerRoutingTypeService(sess).
 		Mask("id").
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -30,12 +32,13 @@ const (
 
 func resourceIBMLbVpx() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbVpxCreate,
-		Read:     resourceIBMLbVpxRead,
-		Update:   resourceIBMLbVpxUpdate,
-		Delete:   resourceIBMLbVpxDelete,
-		Exists:   resourceIBMLbVpxExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbVpxCreate,
+		ReadContext:   resourceIBMLbVpxRead,
+		UpdateContext: resourceIBMLbVpxUpdate,
+		DeleteContext: resourceIBMLbVpxDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMLbVpx, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -274,7 +277,7 @@ func findVPXPriceItems(version string, speed int, plan string, ipCount int, meta
 	}, nil
 }
 
-func findVPXByOrderId(orderId int, meta interface{}) (datatypes.Network_Application_Delivery_Controller, error) {
+func findVPXByOrderId(ctx context.Context, orderId int, meta interface{}) (datatypes.Network_Application_Delivery_Controller, error) {
 	service := services.GetAccountService(meta.(ClientSession).SoftLayerSession())
 
 	stateConf := &resource.StateChangeConf{
@@ -304,7 +307,7 @@ func findVPXByOrderId(orderId int, meta interface{}) (datatypes.Network_Applicat
 		MinTimeout: 10 * time.Second,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Application_Delivery_Controller{}, err
@@ -365,7 +368,7 @@ func prepareHardwareOptions(d *schema.ResourceData, meta interface{}) ([]datatyp
 	return hardwareOpts, nil
 }
 
-func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	NADCService := services.GetNetworkApplicationDeliveryControllerService(sess)
 	productOrderService := services.GetProductOrderService(sess.SetRetries(0))
@@ -384,7 +387,7 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 		meta)
 
 	if err != nil {
-		return fmt.Errorf("Error Cannot find Application Delivery Controller prices '%s'.", err)
+		return diagFromErr(fmt.Errorf("Error Cannot find Application Delivery Controller prices '%s'.", err))
 	}
 
 	datacenter := d.Get("datacenter").(string)
@@ -392,14 +395,14 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	if len(datacenter) > 0 {
 		datacenter, err := location.GetDatacenterByName(sess, datacenter, "id")
 		if err != nil {
-			return fmt.Errorf("Error creating network application delivery controller: %s", err)
+			return diagFromErr(fmt.Errorf("Error creating network application delivery controller: %s", err))
 		}
 		opts.Location = sl.String(strconv.Itoa(*datacenter.Id))
 	}
 
 	opts.Hardware, err = prepareHardwareOptions(d, meta)
 	if err != nil {
-		return fmt.Errorf("Error Cannot get hardware options '%s'.", err)
+		return diagFromErr(fmt.Errorf("Error Cannot get hardware options '%s'.", err))
 	}
 
 	log.Println("[INFO] Creating network application delivery controller")
@@ -407,14 +410,14 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	receipt, err := productOrderService.PlaceOrder(&opts, sl.Bool(false))
 
 	if err != nil {
-		return fmt.Errorf("Error creating network application delivery controller: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating network application delivery controller: %s", err))
 	}
 
 	// Wait VPX provisioning
-	VPX, err := findVPXByOrderId(*receipt.OrderId, meta)
+	VPX, err := findVPXByOrderId(ctx, *receipt.OrderId, meta)
 
 	if err != nil {
-		return fmt.Errorf("Error creating network application delivery controller: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating network application delivery controller: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *VPX.Id))
@@ -423,7 +426,7 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	// Wait Virtual IP provisioning
@@ -432,7 +435,7 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	for vipWaitCount := 0; vipWaitCount < 270; vipWaitCount++ {
 		getObjectResult, err := NADCService.Id(id).Mask("subnets[ipAddresses],password[password]").GetObject()
 		if err != nil {
-			return fmt.Errorf("Error retrieving network application delivery controller: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving network application delivery controller: %s", err))
 		}
 
 		ipCount := 0
@@ -449,7 +452,7 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if !IsVipReady {
-		return fmt.Errorf("Failed to create VIPs for Netscaler VPX ID: %d", id)
+		return diagFromErr(fmt.Errorf("Failed to create VIPs for Netscaler VPX ID: %d", id))
 	}
 
 	// Wait while VPX service is initializing. GetLoadBalancers() internally calls REST API of VPX and returns
@@ -469,22 +472,22 @@ func resourceIBMLbVpxCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if !IsRESTReady {
-		return fmt.Errorf("Failed to intialize VPX REST Service for Netscaler VPX ID: %d", id)
+		return diagFromErr(fmt.Errorf("Failed to intialize VPX REST Service for Netscaler VPX ID: %d", id))
 	}
 
 	// Wait additional buffer time for VPX service.
 	time.Sleep(time.Minute)
 
-	return resourceIBMLbVpxRead(d, meta)
+	return resourceIBMLbVpxRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	service := services.GetNetworkApplicationDeliveryControllerService(sess)
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	getObjectResult, err := service.
@@ -493,7 +496,11 @@ func resourceIBMLbVpxRead(d *schema.ResourceData, meta interface{}) error {
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving network application delivery controller: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving network application delivery controller: %s", err))
 	}
 
 	d.Set("name", *getObjectResult.Name)
@@ -573,30 +580,30 @@ func resourceIBMLbVpxRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMLbVpxUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMLbVpxDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkApplicationDeliveryControllerService(sess)
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	billingItem, err := service.Id(id).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("Error deleting network application delivery controller: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting network application delivery controller: %s", err))
 	}
 
 	if *billingItem.Id > 0 {
 		billingItemService := services.GetBillingItemService(sess)
 		deleted, err := billingItemService.Id(*billingItem.Id).CancelService()
 		if err != nil {
-			return fmt.Errorf("Error deleting network application delivery controller: %s", err)
+			return diagFromErr(fmt.Errorf("Error deleting network application delivery controller: %s", err))
 		}
 
 		if deleted {
@@ -606,22 +613,3 @@ func resourceIBMLbVpxDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
-
-func resourceIBMLbVpxExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	service := services.GetNetworkApplicationDeliveryControllerService(meta.(ClientSession).SoftLayerSession())
-
-	id, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-	nadc, err := service.Mask("id").Id(id).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return nadc.Id != nil && *nadc.Id == id, nil
-}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	dt "github.com/minsikl/netscaler-nitro-go/datatypes"
 	"github.com/minsikl/netscaler-nitro-go/op"
@@ -41,12 +43,13 @@ var (
 
 func resourceIBMLbVpxService() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbVpxServiceCreate,
-		Read:     resourceIBMLbVpxServiceRead,
-		Update:   resourceIBMLbVpxServiceUpdate,
-		Delete:   resourceIBMLbVpxServiceDelete,
-		Exists:   resourceIBMLbVpxServiceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbVpxServiceCreate,
+		ReadContext:   resourceIBMLbVpxServiceRead,
+		UpdateContext: resourceIBMLbVpxServiceUpdate,
+		DeleteContext: resourceIBMLbVpxServiceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMLbVpxService, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -156,99 +159,81 @@ func updateVpxService(sess *session.Session, nadcId int, lbVip *datatypes.Networ
 	return successFlag, err
 }
 
-func resourceIBMLbVpxServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vipId := d.Get("vip_id").(string)
 	_, nadcId, _, err := parseServiceId(vipId)
 
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
-	}
-
-	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
-	if err != nil {
-		return fmt.Errorf("Error creating Virtual Ip Address: %s", err)
-	}
-
-	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceCreate101(d, meta)
-	}
-
-	return resourceIBMLbVpxServiceCreate105(d, meta)
-}
-
-func resourceIBMLbVpxServiceRead(d *schema.ResourceData, meta interface{}) error {
-	_, nadcId, _, err := parseServiceId(d.Id())
-	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diagFromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error Reading Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceRead101(d, meta)
+		return resourceIBMLbVpxServiceCreate101(ctx, d, meta)
 	}
 
-	return resourceIBMLbVpxServiceRead105(d, meta)
+	return resourceIBMLbVpxServiceCreate105(ctx, d, meta)
 }
 
-func resourceIBMLbVpxServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, nadcId, _, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual IP Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error Reading Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceUpdate101(d, meta)
+		return resourceIBMLbVpxServiceRead101(ctx, d, meta)
 	}
 
-	return resourceIBMLbVpxServiceUpdate105(d, meta)
+	return resourceIBMLbVpxServiceRead105(ctx, d, meta)
 }
 
-func resourceIBMLbVpxServiceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, nadcId, _, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating Virtual IP Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceDelete101(d, meta)
+		return resourceIBMLbVpxServiceUpdate101(ctx, d, meta)
 	}
 
-	return resourceIBMLbVpxServiceDelete105(d, meta)
+	return resourceIBMLbVpxServiceUpdate105(ctx, d, meta)
 }
 
-func resourceIBMLbVpxServiceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+func resourceIBMLbVpxServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, nadcId, _, err := parseServiceId(d.Id())
 	if err != nil {
-		return false, fmt.Errorf("Error in exists: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Virtual Ip Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return false, fmt.Errorf("Error in exists: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxServiceExists101(d, meta)
+		return resourceIBMLbVpxServiceDelete101(ctx, d, meta)
 	}
 
-	return resourceIBMLbVpxServiceExists105(d, meta)
+	return resourceIBMLbVpxServiceDelete105(ctx, d, meta)
 }
 
-func resourceIBMLbVpxServiceCreate101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceCreate101(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 
@@ -257,7 +242,7 @@ func resourceIBMLbVpxServiceCreate101(d *schema.ResourceData, meta interface{})
 	serviceName := d.Get("name").(string)
 
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diagFromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	lb_services := []datatypes.Network_LoadBalancer_Service{
@@ -281,8 +266,8 @@ func resourceIBMLbVpxServiceCreate101(d *schema.ResourceData, meta interface{})
 
 	_, err = network.GetNadcLbVipServiceByName(sess, nadcId, vipName, serviceName)
 	if err == nil {
-		return fmt.Errorf("Error creating LoadBalancer Service: The service name '%s' is already used.",
-			serviceName)
+		return diagFromErr(fmt.Errorf("Error creating LoadBalancer Service: The service name '%s' is already used.",
+			serviceName))
 	}
 
 	log.Printf("[INFO] Creating LoadBalancer Service %s", serviceName)
@@ -290,19 +275,19 @@ func resourceIBMLbVpxServiceCreate101(d *schema.ResourceData, meta interface{})
 	successFlag, err := updateVpxService(sess.SetRetries(0), nadcId, lbVip)
 
 	if err != nil {
-		return fmt.Errorf("Error creating LoadBalancer Service: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating LoadBalancer Service: %s", err))
 	}
 
 	if !successFlag {
-		return errors.New("Error creating LoadBalancer Service")
+		return diagFromErr(errors.New("Error creating LoadBalancer Service"))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", vipId, serviceName))
 
-	return resourceIBMLbVpxServiceRead(d, meta)
+	return resourceIBMLbVpxServiceRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxServiceCreate105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceCreate105(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 
@@ -311,12 +296,12 @@ func resourceIBMLbVpxServiceCreate105(d *schema.ResourceData, meta interface{})
 	serviceName := d.Get("name").(string)
 
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diagFromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	nClient, err := getNitroClient(sess, nadcId)
 	if err != nil {
-		return fmt.Errorf("Error getting netscaler information ID: %d", nadcId)
+		return diagFromErr(fmt.Errorf("Error getting netscaler information ID: %d", nadcId))
 	}
 
 	// Create a service
@@ -334,13 +319,13 @@ func resourceIBMLbVpxServiceCreate105(d *schema.ResourceData, meta interface{})
 	vip := dt.LbvserverRes{}
 	err = nClient.Get(&vip, vipName)
 	if err != nil {
-		return fmt.Errorf("Error creating LoadBalancer Service : %s", err)
+		return diagFromErr(fmt.Errorf("Error creating LoadBalancer Service : %s", err))
 	}
 
 	if vip.Lbvserver[0].ServiceType != nil {
 		svcReq.Service.ServiceType = vip.Lbvserver[0].ServiceType
 	} else {
-		return fmt.Errorf("Error creating LoadBalancer : type of VIP '%s' is null.", vipName)
+		return diagFromErr(fmt.Errorf("Error creating LoadBalancer : type of VIP '%s' is null.", vipName))
 	}
 
 	// SSL offload
@@ -353,7 +338,7 @@ func resourceIBMLbVpxServiceCreate105(d *schema.ResourceData, meta interface{})
 	// Add the service
 	err = nClient.Add(&svcReq)
 	if err != nil {
-		return fmt.Errorf("Error creating LoadBalancer Service: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating LoadBalancer Service: %s", err))
 	}
 
 	// Bind the virtual server and the service
@@ -366,7 +351,7 @@ func resourceIBMLbVpxServiceCreate105(d *schema.ResourceData, meta interface{})
 
 	err = nClient.Add(&lbvserverServiceBindingReq)
 	if err != nil {
-		return fmt.Errorf("Error creating LoadBalancer Service: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating LoadBalancer Service: %s", err))
 	}
 
 	// Bind Health_check monitor
@@ -384,25 +369,29 @@ func resourceIBMLbVpxServiceCreate105(d *schema.ResourceData, meta interface{})
 
 	err = nClient.Add(&serviceLbmonitorBindingReq)
 	if err != nil {
-		return fmt.Errorf("Error creating LoadBalancer Service: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating LoadBalancer Service: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", vipId, serviceName))
 
-	return resourceIBMLbVpxServiceRead(d, meta)
+	return resourceIBMLbVpxServiceRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxServiceRead101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceRead101(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vipName, nadcId, serviceName, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diagFromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	lbService, err := network.GetNadcLbVipServiceByName(sess, nadcId, vipName, serviceName)
 	if err != nil {
-		return fmt.Errorf("Unable to get load balancer service %s: %s", serviceName, err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Unable to get load balancer service %s: %s", serviceName, err))
 	}
 
 	d.Set("vip_id", strconv.Itoa(nadcId)+":"+vipName)
@@ -416,22 +405,25 @@ func resourceIBMLbVpxServiceRead101(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMLbVpxServiceRead105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceRead105(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vipName, nadcId, serviceName, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diagFromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	nClient, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), nadcId)
 	if err != nil {
-		return fmt.Errorf("Error getting netscaler information ID: %d", nadcId)
+		return diagFromErr(fmt.Errorf("Error getting netscaler information ID: %d", nadcId))
 	}
 
 	// Read a service
 
 	svc := dt.ServiceRes{}
 	err = nClient.Get(&svc, serviceName)
-	if err != nil {
+	if err != nil && strings.Contains(err.Error(), "No Service") {
+		d.SetId("")
+		return nil
+	} else if err != nil {
 		fmt.Printf("Error getting service information : %s", err.Error())
 	}
 	d.Set("vip_id", strconv.Itoa(nadcId)+":"+vipName)
@@ -462,18 +454,18 @@ func resourceIBMLbVpxServiceRead105(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMLbVpxServiceUpdate101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceUpdate101(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	vipName, nadcId, serviceName, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diagFromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	lbService, err := network.GetNadcLbVipServiceByName(sess, nadcId, vipName, serviceName)
 	if err != nil {
-		return fmt.Errorf("Unable to get load balancer service: %s", err)
+		return diagFromErr(fmt.Errorf("Unable to get load balancer service: %s", err))
 	}
 
 	// copy current service
@@ -507,25 +499,25 @@ func resourceIBMLbVpxServiceUpdate101(d *schema.ResourceData, meta interface{})
 	successFlag, err := updateVpxService(sess.SetRetries(0), nadcId, lbVip)
 
 	if err != nil {
-		return fmt.Errorf("Error updating LoadBalancer Service: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating LoadBalancer Service: %s", err))
 	}
 
 	if !successFlag {
-		return errors.New("Error updating LoadBalancer Service")
+		return diagFromErr(errors.New("Error updating LoadBalancer Service"))
 	}
 
 	return nil
 }
 
-func resourceIBMLbVpxServiceUpdate105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceUpdate105(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, nadcId, serviceName, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diagFromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	nClient, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), nadcId)
 	if err != nil {
-		return fmt.Errorf("Error getting netscaler information ID: %d", nadcId)
+		return diagFromErr(fmt.Errorf("Error getting netscaler information ID: %d", nadcId))
 	}
 
 	// Update a service
@@ -548,7 +540,7 @@ func resourceIBMLbVpxServiceUpdate105(d *schema.ResourceData, meta interface{})
 			// Delete previous health_check
 			err = nClient.Delete(&dt.ServiceLbmonitorBindingReq{}, serviceName, "args=monitor_name:"+*monitorName)
 			if err != nil {
-				return fmt.Errorf("Error deleting monitor %s: %s", *monitorName, err)
+				return diagFromErr(newAttributeError("health_check", fmt.Sprintf("Error deleting monitor %s", *monitorName), err))
 			}
 		}
 
@@ -567,7 +559,7 @@ func resourceIBMLbVpxServiceUpdate105(d *schema.ResourceData, meta interface{})
 
 		err = nClient.Add(&serviceLbmonitorBindingReq)
 		if err != nil {
-			return fmt.Errorf("Error adding a monitor: %s", err)
+			return diagFromErr(newAttributeError("health_check", "Error adding a monitor", err))
 		}
 	}
 
@@ -588,16 +580,16 @@ func resourceIBMLbVpxServiceUpdate105(d *schema.ResourceData, meta interface{})
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error updating LoadBalancer Service: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating LoadBalancer Service: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMLbVpxServiceDelete101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceDelete101(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vipName, nadcId, serviceName, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diagFromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	sess := meta.(ClientSession).SoftLayerSession()
@@ -633,67 +625,28 @@ func resourceIBMLbVpxServiceDelete101(d *schema.ResourceData, meta interface{})
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error deleting LoadBalancer Service %s: %s", serviceName, err)
+		return diagFromErr(fmt.Errorf("Error deleting LoadBalancer Service %s: %s", serviceName, err))
 	}
 
 	return nil
 }
 
-func resourceIBMLbVpxServiceDelete105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxServiceDelete105(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, nadcId, serviceName, err := parseServiceId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error parsing vip id: %s", err)
+		return diagFromErr(fmt.Errorf("Error parsing vip id: %s", err))
 	}
 
 	nClient, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), nadcId)
 	if err != nil {
-		return fmt.Errorf("Error getting netscaler information ID: %d", nadcId)
+		return diagFromErr(fmt.Errorf("Error getting netscaler information ID: %d", nadcId))
 	}
 
 	// Delete a service
 	err = nClient.Delete(&dt.ServiceReq{}, serviceName)
 	if err != nil {
-		return fmt.Errorf("Error deleting service %s: %s", serviceName, err)
+		return diagFromErr(fmt.Errorf("Error deleting service %s: %s", serviceName, err))
 	}
 
 	return nil
 }
-
-func resourceIBMLbVpxServiceExists101(d *schema.ResourceData, meta interface{}) (bool, error) {
-	vipName, nadcId, serviceName, err := parseServiceId(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Error parsing vip id: %s", err)
-	}
-	lbService, err := network.GetNadcLbVipServiceByName(meta.(ClientSession).SoftLayerSession(), nadcId, vipName, serviceName)
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return *lbService.Name == serviceName, nil
-}
-
-func resourceIBMLbVpxServiceExists105(d *schema.ResourceData, meta interface{}) (bool, error) {
-	_, nadcId, serviceName, err := parseServiceId(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Error parsing vip id: %s", err)
-	}
-
-	nClient, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), nadcId)
-	if err != nil {
-		return false, fmt.Errorf("Error getting netscaler information ID: %d", nadcId)
-	}
-
-	svc := dt.ServiceRes{}
-	err = nClient.Get(&svc, serviceName)
-	if err != nil && strings.Contains(err.Error(), "No Service") {
-		return false, nil
-	} else if err != nil {
-		return false, fmt.Errorf("Unable to get load balancer service %s: %s", serviceName, err)
-	}
-
-	return *svc.Service[0].Name == serviceName, nil
-}
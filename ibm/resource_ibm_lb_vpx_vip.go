@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/helpers/network"
@@ -58,12 +60,13 @@ var (
 
 func resourceIBMLbVpxVip() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbVpxVipCreate,
-		Read:     resourceIBMLbVpxVipRead,
-		Update:   resourceIBMLbVpxVipUpdate,
-		Delete:   resourceIBMLbVpxVipDelete,
-		Exists:   resourceIBMLbVpxVipExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbVpxVipCreate,
+		ReadContext:   resourceIBMLbVpxVipRead,
+		UpdateContext: resourceIBMLbVpxVipUpdate,
+		DeleteContext: resourceIBMLbVpxVipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMLbVpxVip, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"nad_controller_id": {
@@ -134,89 +137,71 @@ func resourceIBMLbVpxVip() *schema.Resource {
 	}
 }
 
-func resourceIBMLbVpxVipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	version, err := getVPXVersion(d.Get("nad_controller_id").(int), meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error creating Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipCreate101(d, meta)
+		return resourceIBMLbVpxVipCreate101(ctx, d, meta)
 	}
 
-	return resourceIBMLbVpxVipCreate105(d, meta)
+	return resourceIBMLbVpxVipCreate105(ctx, d, meta)
 }
 
-func resourceIBMLbVpxVipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId, _, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error Reading Virtual IP Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error Reading Virtual IP Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error Reading Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error Reading Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipRead101(d, meta)
+		return resourceIBMLbVpxVipRead101(ctx, d, meta)
 	}
 
-	return resourceIBMLbVpxVipRead105(d, meta)
+	return resourceIBMLbVpxVipRead105(ctx, d, meta)
 }
 
-func resourceIBMLbVpxVipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId, _, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual IP Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating Virtual IP Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipUpdate101(d, meta)
+		return resourceIBMLbVpxVipUpdate101(ctx, d, meta)
 	}
 
-	return resourceIBMLbVpxVipUpdate105(d, meta)
+	return resourceIBMLbVpxVipUpdate105(ctx, d, meta)
 }
 
-func resourceIBMLbVpxVipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId, _, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Virtual Ip Address: %s", err))
 	}
 
 	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Virtual Ip Address: %s", err))
 	}
 
 	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipDelete101(d, meta)
+		return resourceIBMLbVpxVipDelete101(ctx, d, meta)
 	}
 
-	return resourceIBMLbVpxVipDelete105(d, meta)
-}
-
-func resourceIBMLbVpxVipExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	nadcId, _, err := parseId(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Error in exists: %s", err)
-	}
-
-	version, err := getVPXVersion(nadcId, meta.(ClientSession).SoftLayerSession())
-	if err != nil {
-		return false, fmt.Errorf("Error in exists: %s", err)
-	}
-
-	if version == VPX_VERSION_10_1 {
-		return resourceIBMLbVpxVipExists101(d, meta)
-	}
-
-	return resourceIBMLbVpxVipExists105(d, meta)
+	return resourceIBMLbVpxVipDelete105(ctx, d, meta)
 }
 
 func parseId(id string) (int, string, error) {
@@ -238,9 +223,9 @@ func parseId(id string) (int, string, error) {
 	return nadcId, vipName, nil
 }
 
-func resourceIBMLbVpxVipCreate101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipCreate101(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if _, ok := d.GetOk("security_certificate_id"); ok {
-		return fmt.Errorf("Error creating Virtual Ip Address: security_certificate_id is not supported with VPX 10.1.")
+		return diagFromErr(fmt.Errorf("Error creating Virtual Ip Address: security_certificate_id is not supported with VPX 10.1."))
 	}
 
 	sess := meta.(ClientSession).SoftLayerSession()
@@ -283,25 +268,25 @@ func resourceIBMLbVpxVipCreate101(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error creating Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Virtual Ip Address: %s", err))
 	}
 
 	if !successFlag {
-		return errors.New("Error creating Virtual Ip Address")
+		return diagFromErr(errors.New("Error creating Virtual Ip Address"))
 	}
 
 	d.SetId(fmt.Sprintf("%d:%s", nadcId, vipName))
 
 	log.Printf("[INFO] Netscaler VPX VIP ID: %s", d.Id())
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return resourceIBMLbVpxVipRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxVipCreate105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipCreate105(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId := d.Get("nad_controller_id").(int)
 	nClient, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), nadcId)
 	if err != nil {
-		return fmt.Errorf("Error getting netscaler information ID: %d", nadcId)
+		return diagFromErr(fmt.Errorf("Error getting netscaler information ID: %d", nadcId))
 	}
 
 	vipName := d.Get("name").(string)
@@ -334,16 +319,16 @@ func resourceIBMLbVpxVipCreate105(d *schema.ResourceData, meta interface{}) erro
 
 	// security_certificated_id is only available when type is 'SSL'
 	if securityCertificateId > 0 && vipType != "SSL" {
-		return fmt.Errorf("Error creating VIP : security_certificated_id is only available when type is 'SSL'")
+		return diagFromErr(fmt.Errorf("Error creating VIP : security_certificated_id is only available when type is 'SSL'"))
 	} else if securityCertificateId == 0 && vipType == "SSL" {
-		return fmt.Errorf("Error creating VIP : 'SSL' type requires security_certificated_id.")
+		return diagFromErr(fmt.Errorf("Error creating VIP : 'SSL' type requires security_certificated_id."))
 
 	}
 
 	// Create a virtual server
 	err = nClient.Add(&lbvserverReq)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Configure security_certificate for SSL Offload.
@@ -355,8 +340,8 @@ func resourceIBMLbVpxVipCreate105(d *schema.ResourceData, meta interface{}) erro
 
 		if err != nil {
 			// Rollback VIP creation and return an error.
-			resourceIBMLbVpxVipDelete105(d, meta)
-			return err
+			resourceIBMLbVpxVipDelete105(ctx, d, meta)
+			return diagFromErr(err)
 		}
 	}
 
@@ -364,20 +349,24 @@ func resourceIBMLbVpxVipCreate105(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[INFO] Netscaler VPX VIP ID: %s", d.Id())
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return resourceIBMLbVpxVipRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxVipRead101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipRead101(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	nadcId, vipName, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("ibm_lb_vpx : %s", err)
+		return diagFromErr(fmt.Errorf("ibm_lb_vpx : %s", err))
 	}
 
 	vip, err := network.GetNadcLbVipByName(sess, nadcId, vipName)
 	if err != nil {
-		return fmt.Errorf("ibm_lb_vpx : while looking up a virtual ip address : %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("ibm_lb_vpx : while looking up a virtual ip address : %s", err))
 	}
 
 	d.Set("nad_controller_id", nadcId)
@@ -404,21 +393,24 @@ func resourceIBMLbVpxVipRead101(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMLbVpxVipRead105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipRead105(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId, vipName, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("ibm_lb_vpx : %s", err)
+		return diagFromErr(fmt.Errorf("ibm_lb_vpx : %s", err))
 	}
 
 	nClient, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), nadcId)
 	if err != nil {
-		return fmt.Errorf("Error getting netscaler information ID: %d", nadcId)
+		return diagFromErr(fmt.Errorf("Error getting netscaler information ID: %d", nadcId))
 	}
 
 	// Read a virtual server
 	vip := dt.LbvserverRes{}
 	err = nClient.Get(&vip, vipName)
-	if err != nil {
+	if err != nil && strings.Contains(err.Error(), "No such resource") {
+		d.SetId("")
+		return nil
+	} else if err != nil {
 		fmt.Printf("Error getting VIP information : %s", err.Error())
 	}
 
@@ -469,7 +461,7 @@ func resourceIBMLbVpxVipRead105(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMLbVpxVipUpdate101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipUpdate101(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkApplicationDeliveryControllerService(sess.SetRetries(0))
 
@@ -503,17 +495,17 @@ func resourceIBMLbVpxVipUpdate101(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual Ip Address: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating Virtual Ip Address: %s", err))
 	}
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return resourceIBMLbVpxVipRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxVipUpdate105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipUpdate105(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId := d.Get("nad_controller_id").(int)
 	nClient, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), nadcId)
 	if err != nil {
-		return fmt.Errorf("Error getting netscaler information ID: %d", nadcId)
+		return diagFromErr(fmt.Errorf("Error getting netscaler information ID: %d", nadcId))
 	}
 
 	lbvserverReq := dt.LbvserverReq{
@@ -544,19 +536,19 @@ func resourceIBMLbVpxVipUpdate105(d *schema.ResourceData, meta interface{}) erro
 	// Update the virtual server
 	err = nClient.Update(&lbvserverReq)
 	if err != nil {
-		return fmt.Errorf("Error updating Virtual Ip Address: " + err.Error())
+		return diagFromErr(fmt.Errorf("Error updating Virtual Ip Address: " + err.Error()))
 	}
 
-	return resourceIBMLbVpxVipRead(d, meta)
+	return resourceIBMLbVpxVipRead(ctx, d, meta)
 }
 
-func resourceIBMLbVpxVipDelete101(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipDelete101(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkApplicationDeliveryControllerService(sess)
 
 	nadcId, vipName, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("ibm_lb_vpx : %s", err)
+		return diagFromErr(fmt.Errorf("ibm_lb_vpx : %s", err))
 	}
 
 	for count := 0; count < 10; count++ {
@@ -584,27 +576,27 @@ func resourceIBMLbVpxVipDelete101(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address %s: %s", vipName, err)
+		return diagFromErr(fmt.Errorf("Error deleting Virtual Ip Address %s: %s", vipName, err))
 	}
 
 	return nil
 }
 
-func resourceIBMLbVpxVipDelete105(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbVpxVipDelete105(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nadcId, vipName, err := parseId(d.Id())
 	if err != nil {
-		return fmt.Errorf("ibm_lb_vpx : %s", err)
+		return diagFromErr(fmt.Errorf("ibm_lb_vpx : %s", err))
 	}
 
 	nClient, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), nadcId)
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address %s: %s", vipName, err)
+		return diagFromErr(fmt.Errorf("Error deleting Virtual Ip Address %s: %s", vipName, err))
 	}
 
 	// Delete a virtual server
 	err = nClient.Delete(&dt.LbvserverReq{}, vipName)
 	if err != nil {
-		return fmt.Errorf("Error deleting Virtual Ip Address %s: %s", vipName, err)
+		return diagFromErr(fmt.Errorf("Error deleting Virtual Ip Address %s: %s", vipName, err))
 	}
 
 	// Delete a security certificate
@@ -616,50 +608,6 @@ func resourceIBMLbVpxVipDelete105(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMLbVpxVipExists101(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-
-	nadcId, vipName, err := parseId(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("ibm_lb_vpx : %s", err)
-	}
-
-	vip, err := network.GetNadcLbVipByName(sess, nadcId, vipName)
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return vip != nil && *vip.Name == vipName, nil
-}
-
-func resourceIBMLbVpxVipExists105(d *schema.ResourceData, meta interface{}) (bool, error) {
-	nadcId, vipName, err := parseId(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("ibm_lb_vpx : %s", err)
-	}
-
-	nClient, err := getNitroClient(meta.(ClientSession).SoftLayerSession(), nadcId)
-	if err != nil {
-		return false, err
-	}
-
-	// Read a virtual server
-	vip := dt.LbvserverRes{}
-	err = nClient.Get(&vip, vipName)
-
-	if err != nil && strings.Contains(err.Error(), "No such resource") {
-		return false, nil
-	} else if err != nil {
-		return false, err
-	}
-
-	return true, nil
-}
-
 func getNitroClient(sess *session.Session, nadcId int) (*client.NitroClient, error) {
 	service := services.GetNetworkApplicationDeliveryControllerService(sess)
 	nadc, err := service.Id(nadcId).Mask("managementIpAddress,password[password]").GetObject()
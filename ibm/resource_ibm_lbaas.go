@@ -5,12 +5,14 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -52,12 +54,13 @@ func init() {
 
 func resourceIBMLbaas() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbaasCreate,
-		Read:     resourceIBMLbaasRead,
-		Delete:   resourceIBMLbaasDelete,
-		Exists:   resourceIBMLbaasExists,
-		Update:   resourceIBMLbaasUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbaasCreate,
+		ReadContext:   resourceIBMLbaasRead,
+		DeleteContext: resourceIBMLbaasDelete,
+		UpdateContext: resourceIBMLbaasUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMLbaas, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -241,14 +244,14 @@ func resourceIBMLbaas() *schema.Resource {
 	}
 }
 
-func resourceIBMLbaasCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	// Find price items
 	productOrderContainer, err := buildLbaasLBProductOrderContainer(d, sess)
 	if err != nil {
-		return fmt.Errorf("Error creating Load balancer: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Load balancer: %s", err))
 	}
 	log.Println("[INFO] Creating Load Balancer")
 
@@ -256,34 +259,38 @@ func resourceIBMLbaasCreate(d *schema.ResourceData, meta interface{}) error {
 	_, err = services.GetProductOrderService(sess).
 		VerifyOrder(productOrderContainer)
 	if err != nil {
-		return fmt.Errorf("Error during creation of Load balancer: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of Load balancer: %s", err))
 	}
 	//place order
 	_, err = services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of Load balancer: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of Load balancer: %s", err))
 	}
 
 	name := d.Get("name").(string)
 
-	lbaasLB, err := findLbaasLBByOrderId(sess, name, d)
+	lbaasLB, err := findLbaasLBByOrderId(ctx, sess, name, d)
 	if err != nil {
-		return fmt.Errorf("Error during creation of Load balancer: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of Load balancer: %s", err))
 	}
 
 	d.SetId(*lbaasLB.Uuid)
 
-	return resourceIBMLbaasUpdate(d, meta)
+	return resourceIBMLbaasUpdate(ctx, d, meta)
 }
 
-func resourceIBMLbaasRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
 	result, err := service.Mask("datacenter,members,listeners.defaultPool,listeners.defaultPool.sessionAffinity,listeners.defaultPool.healthMonitor,healthMonitors,sslCiphers[name],useSystemPublicIpPool,isPublic,name,description,operatingStatus,address").GetLoadBalancer(sl.String(d.Id()))
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && (apiErr.StatusCode == 404 || apiErr.Exception == NOT_FOUND) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving load balancer: %s", err))
 	}
 
 	var lbType string
@@ -316,14 +323,14 @@ func resourceIBMLbaasRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess.SetRetries(0))
 
 	if d.HasChange("description") {
 		_, err := service.UpdateLoadBalancer(sl.String(d.Id()), sl.String(d.Get("description").(string)))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 	listenerService := services.GetNetworkLBaaSListenerService(sess.SetRetries(0))
@@ -334,7 +341,7 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		add, err := expandProtocols(ns.Difference(os).List())
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		rem := os.Difference(ns).List()
 		removeList := make([]string, len(rem), len(rem))
@@ -347,24 +354,24 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 		if len(removeList) > 0 {
 			_, err := listenerService.DeleteLoadBalancerProtocols(sl.String(d.Id()), removeList)
 			if err != nil {
-				return fmt.Errorf("Error removing protocols: %#v", err)
+				return diagFromErr(fmt.Errorf("Error removing protocols: %#v", err))
 			}
-			_, err = waitForLbaasLBAvailable(d, meta)
+			_, err = waitForLbaasLBAvailable(ctx, d, meta)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+				return diagFromErr(fmt.Errorf(
+					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 
 		if len(add) > 0 {
 			_, err := listenerService.UpdateLoadBalancerProtocols(sl.String(d.Id()), add)
 			if err != nil {
-				return fmt.Errorf("Error adding protocols: %#v", err)
+				return diagFromErr(fmt.Errorf("Error adding protocols: %#v", err))
 			}
-			_, err = waitForLbaasLBAvailable(d, meta)
+			_, err = waitForLbaasLBAvailable(ctx, d, meta)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+				return diagFromErr(fmt.Errorf(
+					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 			}
 		}
 
@@ -374,7 +381,7 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 			service := services.GetNetworkLBaaSLoadBalancerService(sess.SetRetries(0))
 			supportedCiphers, err := services.GetNetworkLBaaSSSLCipherService(sess).Mask("id,name").GetAllObjects()
 			if err != nil {
-				return fmt.Errorf("Error retreving list of ssl ciphers: %#v", err)
+				return diagFromErr(fmt.Errorf("Error retreving list of ssl ciphers: %#v", err))
 			}
 			ciphers := make([]int, v.(*schema.Set).Len())
 			for i, v := range v.(*schema.Set).List() {
@@ -387,22 +394,22 @@ func resourceIBMLbaasUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, err = service.UpdateSslCiphers(sl.String(d.Id()), ciphers)
 			if err != nil {
-				return fmt.Errorf("Error updating ssl ciphers: %#v", err)
+				return diagFromErr(fmt.Errorf("Error updating ssl ciphers: %#v", err))
 			}
-			_, err = waitForLbaasLBAvailable(d, meta)
+			_, err = waitForLbaasLBAvailable(ctx, d, meta)
 			if err != nil {
-				return fmt.Errorf(
-					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+				return diagFromErr(fmt.Errorf(
+					"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 			}
 
 		}
 
 	}
 
-	return resourceIBMLbaasRead(d, meta)
+	return resourceIBMLbaasRead(ctx, d, meta)
 }
 
-func resourceIBMLbaasDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
@@ -411,32 +418,18 @@ func resourceIBMLbaasDelete(d *schema.ResourceData, meta interface{}) error {
 		if strings.Contains(err.Error(), "DELETE_PENDING") {
 			log.Println("Deletion is already in progress, probably from previous runs")
 		} else {
-			return fmt.Errorf("Error deleting load balancer: %s", err)
+			return diagFromErr(fmt.Errorf("Error deleting load balancer: %s", err))
 		}
 	}
-	_, err = waitForLbaasLBDelete(d, meta)
+	_, err = waitForLbaasLBDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for load balancer (%s) to be deleted: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for load balancer (%s) to be deleted: %s", d.Id(), err))
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMLbaasExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetNetworkLBaaSLoadBalancerService(sess)
-
-	result, err := service.GetLoadBalancer(sl.String(d.Id()))
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && (apiErr.StatusCode == 404 || apiErr.Exception == NOT_FOUND) {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error retrieving load balancer: %s", err)
-	}
-	return result.Uuid != nil && *result.Uuid == d.Id(), nil
-}
-
 func buildLbaasLBProductOrderContainer(d *schema.ResourceData, sess *session.Session) (*datatypes.Container_Product_Order_Network_LoadBalancer_AsAService, error) {
 	// 1. Get a package
 	name := d.Get("name").(string)
@@ -499,7 +492,7 @@ func buildLbaasLBProductOrderContainer(d *schema.ResourceData, sess *session.Ses
 	return &productOrderContainer, nil
 }
 
-func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.ResourceData) (*datatypes.Network_LBaaS_LoadBalancer, error) {
+func findLbaasLBByOrderId(ctx context.Context, sess *session.Session, name string, d *schema.ResourceData) (*datatypes.Network_LBaaS_LoadBalancer, error) {
 
 	isIDSet := false
 	stateConf := &resource.StateChangeConf{
@@ -536,7 +529,7 @@ func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.Resource
 		NotFoundChecks: 40,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return nil, err
@@ -550,7 +543,7 @@ func findLbaasLBByOrderId(sess *session.Session, name string, d *schema.Resource
 		fmt.Errorf("Cannot find a load balancer with name '%s' ", name)
 }
 
-func waitForLbaasLBAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLbaasLBAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
@@ -577,10 +570,10 @@ func waitForLbaasLBAvailable(d *schema.ResourceData, meta interface{}) (interfac
 		NotFoundChecks: 40,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForLbaasLBDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLbaasLBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 
@@ -603,7 +596,7 @@ func waitForLbaasLBDelete(d *schema.ResourceData, meta interface{}) (interface{}
 		PollInterval: 60 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func resourceIBMLBProtocolHash(v interface{}) int {
@@ -4,8 +4,10 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/services"
@@ -14,11 +16,13 @@ import (
 
 func resourceIBMLbaasHealthMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbaasHealthMonitorCreate,
-		Read:     resourceIBMLbaasHealthMonitorRead,
-		Delete:   resourceIBMLbaasHealthMonitorDelete,
-		Update:   resourceIBMLbaasHealthMonitorUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbaasHealthMonitorCreate,
+		ReadContext:   resourceIBMLbaasHealthMonitorRead,
+		DeleteContext: resourceIBMLbaasHealthMonitorDelete,
+		UpdateContext: resourceIBMLbaasHealthMonitorUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMLbaasHealthMonitor, importIDParts("lbaas_id", "monitor_id")),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -78,7 +82,7 @@ func resourceIBMLbaasHealthMonitor() *schema.Resource {
 	}
 }
 
-func resourceIBMLbaasHealthMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasHealthMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	healthMonitorService := services.GetNetworkLBaaSHealthMonitorService(sess.SetRetries(0))
 
@@ -96,38 +100,38 @@ func resourceIBMLbaasHealthMonitorCreate(d *schema.ResourceData, meta interface{
 
 	healthMonitors = append(healthMonitors, healthMonitor)
 
-	_, err := waitForLbaasLBActive(d, meta)
+	_, err := waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 	}
 
 	_, err = healthMonitorService.UpdateLoadBalancerHealthMonitors(sl.String(lbaasID), healthMonitors)
 	if err != nil {
-		return fmt.Errorf("Error adding health monitors: %#v", err)
+		return diagFromErr(fmt.Errorf("Error adding health monitors: %#v", err))
 	}
-	_, err = waitForLbaasLBActive(d, meta)
+	_, err = waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 	}
 	d.SetId(fmt.Sprintf("%s/%s", lbaasID, d.Get("monitor_id").(string)))
-	return resourceIBMLbaasHealthMonitorRead(d, meta)
+	return resourceIBMLbaasHealthMonitorRead(ctx, d, meta)
 }
 
-func resourceIBMLbaasHealthMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasHealthMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	lbaasID := parts[0]
 	monitorID := parts[1]
 
 	result, err := service.Mask("listeners.defaultPool.healthMonitor").GetLoadBalancer(sl.String(lbaasID))
 	if err != nil {
-		return fmt.Errorf("Error retrieving load balancer: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving load balancer: %s", err))
 	}
 	for _, i := range result.Listeners {
 		if monitorID == *i.DefaultPool.HealthMonitor.Uuid {
@@ -147,12 +151,12 @@ func resourceIBMLbaasHealthMonitorRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMLbaasHealthMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasHealthMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	healthMonitorService := services.GetNetworkLBaaSHealthMonitorService(sess.SetRetries(0))
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	lbaasID := parts[0]
 	monitorID := parts[1]
@@ -171,26 +175,26 @@ func resourceIBMLbaasHealthMonitorUpdate(d *schema.ResourceData, meta interface{
 
 		healthMonitors = append(healthMonitors, healthMonitor)
 
-		_, err = waitForLbaasLBActive(d, meta)
+		_, err = waitForLbaasLBActive(ctx, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 		}
 
 		_, err := healthMonitorService.UpdateLoadBalancerHealthMonitors(sl.String(lbaasID), healthMonitors)
 		if err != nil {
-			return fmt.Errorf("Error adding health monitors: %#v", err)
+			return diagFromErr(fmt.Errorf("Error adding health monitors: %#v", err))
 		}
-		_, err = waitForLbaasLBActive(d, meta)
+		_, err = waitForLbaasLBActive(ctx, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 		}
 	}
-	return resourceIBMLbaasHealthMonitorRead(d, meta)
+	return resourceIBMLbaasHealthMonitorRead(ctx, d, meta)
 }
 
-func resourceIBMLbaasHealthMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasHealthMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	fmt.Println("Health monitor is destroyed only when the corresponding protocol is removed")
 	d.SetId("")
 	return nil
@@ -4,10 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -17,12 +20,13 @@ import (
 
 func resourceIBMLbaasServerInstanceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMLbaasServerInstanceAttachmentCreate,
-		Read:     resourceIBMLbaasServerInstanceAttachmentRead,
-		Delete:   resourceIBMLbaasServerInstanceAttachmentDelete,
-		Exists:   resourceIBMLbaasServerInstanceAttachmentExists,
-		Update:   resourceIBMLbaasServerInstanceAttachmentUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMLbaasServerInstanceAttachmentCreate,
+		ReadContext:   resourceIBMLbaasServerInstanceAttachmentRead,
+		DeleteContext: resourceIBMLbaasServerInstanceAttachmentDelete,
+		UpdateContext: resourceIBMLbaasServerInstanceAttachmentUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMLbaasServerInstanceAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"private_ip_address": {
@@ -54,7 +58,7 @@ func resourceIBMLbaasServerInstanceAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMLbaasServerInstanceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasServerInstanceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 	memberService := services.GetNetworkLBaaSMemberService(sess)
@@ -66,19 +70,19 @@ func resourceIBMLbaasServerInstanceAttachmentCreate(d *schema.ResourceData, meta
 	p.Weight = sl.Int(weight)
 	members := make([]datatypes.Network_LBaaS_LoadBalancerServerInstanceInfo, 0, 1)
 	members = append(members, *p)
-	_, err := waitForLbaasLBActive(d, meta)
+	_, err := waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error checking for load balancer (%s) is active: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error checking for load balancer (%s) is active: %s", d.Id(), err))
 	}
 	_, err = memberService.AddLoadBalancerMembers(sl.String(lbaasId), members)
 	if err != nil {
-		return fmt.Errorf("Error adding server instances: %#v", err)
+		return diagFromErr(fmt.Errorf("Error adding server instances: %#v", err))
 	}
-	_, err = waitForLbaasLBActive(d, meta)
+	_, err = waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 	}
 	result, err := service.Mask("members").GetLoadBalancer(sl.String(lbaasId))
 	lbaasMembers := result.Members
@@ -89,18 +93,22 @@ func resourceIBMLbaasServerInstanceAttachmentCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	return resourceIBMLbaasServerInstanceAttachmentRead(d, meta)
+	return resourceIBMLbaasServerInstanceAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMLbaasServerInstanceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasServerInstanceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	memberService := services.GetNetworkLBaaSMemberService(sess)
 	id := d.Id()
 	memId, _ := strconv.Atoi(d.Id())
 	member, err := memberService.Id(memId).GetObject()
 	if err != nil {
-		return fmt.Errorf(
-			"Error retrieving load balancer member(%s) : %s", id, err)
+		if apiErr, ok := err.(sl.Error); ok && (apiErr.StatusCode == 404 || apiErr.Exception == NOT_FOUND) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf(
+			"Error retrieving load balancer member(%s) : %s", id, err))
 	}
 	d.Set("private_ip_address", member.Address)
 	d.Set("weight", member.Weight)
@@ -109,7 +117,23 @@ func resourceIBMLbaasServerInstanceAttachmentRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceIBMLbaasServerInstanceAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+// resourceIBMLbaasServerInstanceAttachmentImport imports a load balancer member from the ID <lbaas_id>/<member_id>,
+// the member does not refer to its load balancer
+func resourceIBMLbaasServerInstanceAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	args, err := importIDParts("lbaas_id", "")(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	memberID := d.Id()[strings.LastIndex(d.Id(), "/")+1:]
+	if _, err := strconv.Atoi(memberID); err != nil {
+		return nil, fmt.Errorf("The member ID %s of the ibm_lbaas_server_instance_attachment import ID must be an integer", memberID)
+	}
+	d.SetId(memberID)
+	d.Set("lbaas_id", args["lbaas_id"])
+	return importStateWithRead(resourceIBMLbaasServerInstanceAttachment, nil)(ctx, d, meta)
+}
+
+func resourceIBMLbaasServerInstanceAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	memberService := services.GetNetworkLBaaSMemberService(sess)
 	if d.HasChange("weight") {
@@ -124,64 +148,50 @@ func resourceIBMLbaasServerInstanceAttachmentUpdate(d *schema.ResourceData, meta
 		updateParam.Address = sl.String(privateIpAddress)
 		members := make([]datatypes.Network_LBaaS_Member, 0, 1)
 		members = append(members, *updateParam)
-		_, err := waitForLbaasLBActive(d, meta)
+		_, err := waitForLbaasLBActive(ctx, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error checking for load balancer (%s) is active: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error checking for load balancer (%s) is active: %s", d.Id(), err))
 		}
 		_, err = memberService.UpdateLoadBalancerMembers(sl.String(lbaasId), members)
 		if err != nil {
-			return fmt.Errorf("Error updating loadbalnacer: %#v", err)
+			return diagFromErr(fmt.Errorf("Error updating loadbalnacer: %#v", err))
 		}
-		_, err = waitForLbaasLBActive(d, meta)
+		_, err = waitForLbaasLBActive(ctx, d, meta)
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for load balancer (%s) to become ready: %s", lbaasId, err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for load balancer (%s) to become ready: %s", lbaasId, err))
 		}
 
 	}
 
-	return resourceIBMLbaasServerInstanceAttachmentRead(d, meta)
-}
-
-func resourceIBMLbaasServerInstanceAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	memberService := services.GetNetworkLBaaSMemberService(sess)
-	memId, _ := strconv.Atoi(d.Id())
-	result, err := memberService.Id(memId).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && (apiErr.StatusCode == 404 || apiErr.Exception == NOT_FOUND) {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error retrieving load balancer member: %s", err)
-	}
-	return result.Id != nil && *result.Id == memId, nil
+	return resourceIBMLbaasServerInstanceAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMLbaasServerInstanceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMLbaasServerInstanceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	memberService := services.GetNetworkLBaaSMemberService(sess)
 	lbaasId := d.Get("lbaas_id").(string)
 	removeList := make([]string, 0, 1)
 	removeList = append(removeList, d.Get("uuid").(string))
-	_, err := waitForLbaasLBActive(d, meta)
+	_, err := waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error checking for load balancer (%s) is active: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error checking for load balancer (%s) is active: %s", d.Id(), err))
 	}
 	_, err = memberService.DeleteLoadBalancerMembers(sl.String(lbaasId), removeList)
 	if err != nil {
-		return fmt.Errorf("Error removing server instances: %#v", err)
+		return diagFromErr(fmt.Errorf("Error removing server instances: %#v", err))
 	}
-	_, err = waitForLbaasLBActive(d, meta)
+	_, err = waitForLbaasLBActive(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for load balancer (%s) to become ready: %s", d.Id(), err))
 	}
 	return nil
 }
 
-func waitForLbaasLBActive(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForLbaasLBActive(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkLBaaSLoadBalancerService(sess)
 	lbaasId := d.Get("lbaas_id").(string)
@@ -209,5 +219,5 @@ func waitForLbaasLBActive(d *schema.ResourceData, meta interface{}) (interface{}
 		NotFoundChecks: 40,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
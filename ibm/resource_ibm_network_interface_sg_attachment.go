@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/services"
@@ -19,10 +21,12 @@ import (
 
 func resourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMNetworkInterfaceSGAttachmentCreate,
-		Read:   resourceIBMNetworkInterfaceSGAttachmentRead,
-		Delete: resourceIBMNetworkInterfaceSGAttachmentDelete,
-		Exists: resourceIBMNetworkInterfaceSGAttachmentExists,
+		CreateContext: resourceIBMNetworkInterfaceSGAttachmentCreate,
+		ReadContext:   resourceIBMNetworkInterfaceSGAttachmentRead,
+		DeleteContext: resourceIBMNetworkInterfaceSGAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMNetworkInterfaceSGAttachment, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
 		},
@@ -50,7 +54,7 @@ func resourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkInterfaceSGAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
 	ibmMutexKV.Lock(mk)
 	defer ibmMutexKV.Unlock(mk)
@@ -61,14 +65,14 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 
 	sgID := d.Get("security_group_id").(int)
 	interfaceID := d.Get("network_interface_id").(int)
-	_, err := WaitForVSAvailable(d, meta, d.Timeout(schema.TimeoutCreate))
+	_, err := WaitForVSAvailable(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	_, err = service.Id(sgID).AttachNetworkComponents([]int{interfaceID})
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%d_%d", sgID, interfaceID))
 
@@ -77,22 +81,22 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 		//Check if a soft reboot is required and perform it
 		ready, err := ncs.Id(interfaceID).SecurityGroupsReady()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		if !ready {
 			log.Println("Soft reboot the VSI whose network component is", interfaceID)
 		}
 		guest, err := ncs.Id(interfaceID).GetGuest()
 		if err != nil {
-			return fmt.Errorf("Couldn't retrieve the virtual guest on interface %d", interfaceID)
+			return diagFromErr(fmt.Errorf("Couldn't retrieve the virtual guest on interface %d", interfaceID))
 		}
 		guestService := services.GetVirtualGuestService(sess)
 		ok, err := guestService.Id(*guest.Id).RebootSoft()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		if !ok {
-			return fmt.Errorf("Couldn't reboot the VSI %d", *guest.Id)
+			return diagFromErr(fmt.Errorf("Couldn't reboot the VSI %d", *guest.Id))
 		}
 		//Wait for security group to be ready again after reboot
 		stateConf := &resource.StateChangeConf{
@@ -101,35 +105,41 @@ func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta
 			Timeout: d.Timeout(schema.TimeoutCreate),
 			Refresh: securityGroupReadyRefreshStateFunc(sess, interfaceID),
 		}
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMNetworkInterfaceSGAttachmentRead(d, meta)
+	return resourceIBMNetworkInterfaceSGAttachmentRead(ctx, d, meta)
 }
 
-func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkInterfaceSGAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	bindings, err := service.Id(sgID).GetNetworkComponentBindings()
 	if err != nil {
-		return err
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
 	}
 	for _, b := range bindings {
 		if *b.NetworkComponentId == interfaceID {
+			d.Set("security_group_id", sgID)
+			d.Set("network_interface_id", interfaceID)
 			return nil
 		}
 	}
-	return fmt.Errorf("No association found between security group %d and network interface %d", sgID, interfaceID)
+	return diagFromErr(fmt.Errorf("No association found between security group %d and network interface %d", sgID, interfaceID))
 }
 
-func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkInterfaceSGAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
 	ibmMutexKV.Lock(mk)
 	defer ibmMutexKV.Unlock(mk)
@@ -137,42 +147,16 @@ func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	_, err = service.Id(sgID).DetachNetworkComponents([]int{interfaceID})
 	if err != nil {
-		return fmt.Errorf("Error detaching network components from Security Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error detaching network components from Security Group: %s", err))
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMNetworkInterfaceSGAttachmentExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetNetworkSecurityGroupService(sess)
-
-	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	bindings, err := service.Id(sgID).GetNetworkComponentBindings()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	for _, b := range bindings {
-		if *b.NetworkComponentId == interfaceID {
-			return true, nil
-		}
-	}
-	return false, fmt.Errorf("No association found between security group %d and network interface %d", sgID, interfaceID)
-}
-
 func decomposeNetworkSGAttachmentID(attachmentID string) (sgID, interfaceID int, err error) {
 	ids := strings.Split(attachmentID, "_")
 	if len(ids) != 2 {
@@ -203,7 +187,7 @@ func securityGroupReadyRefreshStateFunc(sess *slsession.Session, ifcID int) reso
 }
 
 // WaitForVirtualGuestAvailable Waits for virtual guest creation
-func WaitForVSAvailable(d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
+func WaitForVSAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) (interface{}, error) {
 	interfaceID := d.Get("network_interface_id").(int)
 	log.Printf("Waiting for server (%d) to be available.", interfaceID)
 	sess := meta.(ClientSession).SoftLayerSession()
@@ -216,7 +200,7 @@ func WaitForVSAvailable(d *schema.ResourceData, meta interface{}, timeout time.D
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func vsReadyRefreshStateFunc(sess *slsession.Session, ifcID int) resource.StateRefreshFunc {
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -29,12 +31,13 @@ const (
 
 func resourceIBMNetworkPublicIp() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkPublicIpCreate,
-		Read:     resourceIBMNetworkPublicIpRead,
-		Update:   resourceIBMNetworkPublicIpUpdate,
-		Delete:   resourceIBMNetworkPublicIpDelete,
-		Exists:   resourceIBMNetworkPublicIpExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkPublicIpCreate,
+		ReadContext:   resourceIBMNetworkPublicIpRead,
+		UpdateContext: resourceIBMNetworkPublicIpUpdate,
+		DeleteContext: resourceIBMNetworkPublicIpDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMNetworkPublicIp, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
@@ -80,7 +83,7 @@ func resourceIBMNetworkPublicIp() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 
@@ -90,7 +93,7 @@ func resourceIBMNetworkPublicIpCreate(d *schema.ResourceData, meta interface{})
 		// Find price items with AdditionalServices
 		productOrderContainer, err = buildGlobalIpProductOrderContainer(d, sess, AdditionalServicesPackageType)
 		if err != nil {
-			return fmt.Errorf("Error creating network public ip: %s", err)
+			return diagFromErr(fmt.Errorf("Error creating network public ip: %s", err))
 		}
 	}
 
@@ -99,32 +102,36 @@ func resourceIBMNetworkPublicIpCreate(d *schema.ResourceData, meta interface{})
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of network public ip: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of network public ip: %s", err))
 	}
 
-	globalIp, err := findGlobalIpByOrderId(sess, *receipt.OrderId, d)
+	globalIp, err := findGlobalIpByOrderId(ctx, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("Error during creation of network public ip: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of network public ip: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *globalIp.Id))
 	d.Set("ip_address", *globalIp.IpAddress.IpAddress)
 
-	return resourceIBMNetworkPublicIpUpdate(d, meta)
+	return resourceIBMNetworkPublicIpUpdate(ctx, d, meta)
 }
 
-func resourceIBMNetworkPublicIpRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err))
 	}
 
 	globalIp, err := service.Id(globalIpId).Mask(GlobalIpMask).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving network public Ip: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving network public Ip: %s", err))
 	}
 
 	d.Set("ip_address", *globalIp.IpAddress.IpAddress)
@@ -137,13 +144,13 @@ func resourceIBMNetworkPublicIpRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err))
 	}
 
 	routes_to := d.Get("routes_to").(string)
@@ -164,17 +171,17 @@ func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{})
 
 	_, err = service.Id(globalIpId).Route(sl.String(routes_to))
 	if err != nil {
-		return fmt.Errorf("Error editing network public Ip: %s", err)
+		return diagFromErr(fmt.Errorf("Error editing network public Ip: %s", err))
 	}
 	// Update notes
 	if d.HasChange("notes") {
 		publicIp, err := service.Id(globalIpId).Mask(GlobalIpMask).GetObject()
 		if err != nil {
-			return fmt.Errorf("Error updating network public Ip: %s", err)
+			return diagFromErr(newAttributeError("notes", "Error updating network public Ip", err))
 		}
 		err = updatePublicIPNotes(d, sess, publicIp)
 		if err != nil {
-			return fmt.Errorf("Error editing network public Ip: %s", err)
+			return diagFromErr(newAttributeError("notes", "Error editing network public Ip", err))
 		}
 	}
 
@@ -196,10 +203,10 @@ func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{})
 		MinTimeout: 3 * time.Second,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
-		return fmt.Errorf("Error waiting for network public ip destination ip address to become active: %s", err)
+		return diagFromErr(fmt.Errorf("Error waiting for network public ip destination ip address to become active: %s", err))
 	}
 
 	if _, ok := pendingResult.(datatypes.Network_Subnet_IpAddress_Global); ok {
@@ -209,18 +216,18 @@ func resourceIBMNetworkPublicIpUpdate(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMNetworkPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkPublicIpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
 
 	globalIpId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid network public ip ID, must be an integer: %s", err))
 	}
 
 	billingItem, err := service.Id(globalIpId).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("Error deleting network public ip: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting network public ip: %s", err))
 	}
 
 	if billingItem.Id == nil {
@@ -229,29 +236,10 @@ func resourceIBMNetworkPublicIpDelete(d *schema.ResourceData, meta interface{})
 
 	_, err = services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 
-	return err
-}
-
-func resourceIBMNetworkPublicIpExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetNetworkSubnetIpAddressGlobalService(sess)
-
-	globalIpId, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(globalIpId).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error retrieving network public ip: %s", err)
-	}
-	return result.Id != nil && *result.Id == globalIpId, nil
+	return diagFromErr(err)
 }
 
-func findGlobalIpByOrderId(sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Subnet_IpAddress_Global, error) {
+func findGlobalIpByOrderId(ctx context.Context, sess *session.Session, orderId int, d *schema.ResourceData) (datatypes.Network_Subnet_IpAddress_Global, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -279,7 +267,7 @@ func findGlobalIpByOrderId(sess *session.Session, orderId int, d *schema.Resourc
 		NotFoundChecks: 24 * 60,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Subnet_IpAddress_Global{}, err
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -34,12 +36,13 @@ const (
 
 func resourceIBMNetworkVlan() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMNetworkVlanCreate,
-		Read:     resourceIBMNetworkVlanRead,
-		Update:   resourceIBMNetworkVlanUpdate,
-		Delete:   resourceIBMNetworkVlanDelete,
-		Exists:   resourceIBMNetworkVlanExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMNetworkVlanCreate,
+		ReadContext:   resourceIBMNetworkVlanRead,
+		UpdateContext: resourceIBMNetworkVlanUpdate,
+		DeleteContext: resourceIBMNetworkVlanDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMNetworkVlan, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -148,7 +151,7 @@ func resourceIBMNetworkVlan() *schema.Resource {
 	}
 }
 
-func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess := meta.(ClientSession).SoftLayerSession()
 	router := d.Get("router_hostname").(string)
@@ -157,7 +160,7 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 	vlanType := d.Get("type").(string)
 	if (vlanType == "PRIVATE" && len(router) > 0 && strings.Contains(router, "fcr")) ||
 		(vlanType == "PUBLIC" && len(router) > 0 && strings.Contains(router, "bcr")) {
-		return fmt.Errorf("Error creating vlan: mismatch between vlan_type '%s' and router_hostname '%s'", vlanType, router)
+		return diagFromErr(fmt.Errorf("Error creating vlan: mismatch between vlan_type '%s' and router_hostname '%s'", vlanType, router))
 	}
 
 	// Find price items with AdditionalServicesNetworkVlan
@@ -166,7 +169,7 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 		// Find price items with AdditionalServices
 		productOrderContainer, err = buildVlanProductOrderContainer(d, sess, AdditionalServicesPackageType)
 		if err != nil {
-			return fmt.Errorf("Error creating vlan: %s", err)
+			return diagFromErr(fmt.Errorf("Error creating vlan: %s", err))
 		}
 	}
 
@@ -175,19 +178,19 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of vlan: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of vlan: %s", err))
 	}
 
-	vlan, err := findVlanByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	vlan, err := findVlanByOrderId(ctx, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return fmt.Errorf("Error finding VLAN order %d: %s", *receipt.OrderId, err)
+		return diagFromErr(fmt.Errorf("Error finding VLAN order %d: %s", *receipt.OrderId, err))
 	}
 
 	if len(name) > 0 {
 		_, err = services.GetNetworkVlanService(sess).
 			Id(*vlan.Id).EditObject(&datatypes.Network_Vlan{Name: sl.String(name)})
 		if err != nil {
-			return fmt.Errorf("Error updating vlan: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating vlan: %s", err))
 		}
 	}
 
@@ -200,25 +203,29 @@ func resourceIBMNetworkVlanCreate(d *schema.ResourceData, meta interface{}) erro
 		//Try setting only when it is non empty as we are creating vlan
 		err = setVlanTags(id, tags, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
-	return resourceIBMNetworkVlanRead(d, meta)
+	return resourceIBMNetworkVlanRead(ctx, d, meta)
 }
 
-func resourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err))
 	}
 
 	vlan, err := service.Id(vlanId).Mask(VlanMask).GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving vlan: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving vlan: %s", err))
 	}
 
 	d.Set("vlan_number", *vlan.VlanNumber)
@@ -284,13 +291,13 @@ func resourceIBMNetworkVlanRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err))
 	}
 
 	opts := datatypes.Network_Vlan{}
@@ -307,7 +314,7 @@ func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) erro
 		tags := getTags(d)
 		err := setVlanTags(vlanId, tags, meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -315,20 +322,20 @@ func resourceIBMNetworkVlanUpdate(d *schema.ResourceData, meta interface{}) erro
 		_, err = service.Id(vlanId).EditObject(&opts)
 
 		if err != nil {
-			return fmt.Errorf("Error updating vlan: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating vlan: %s", err))
 		}
 	}
 
-	return resourceIBMNetworkVlanRead(d, meta)
+	return resourceIBMNetworkVlanRead(ctx, d, meta)
 }
 
-func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMNetworkVlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkVlanService(sess)
 
 	vlanId, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err))
 	}
 
 	const (
@@ -355,14 +362,14 @@ func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) erro
 			return vms, noVms, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	billingItem, err := service.Id(vlanId).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("Error deleting vlan: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting vlan: %s", err))
 	}
 
 	// VLANs which don't have billing items are managed by SoftLayer. They can't be deleted by
@@ -378,31 +385,10 @@ func resourceIBMNetworkVlanDelete(d *schema.ResourceData, meta interface{}) erro
 	// automatically which the VLAN doesn't have any child resources.
 	_, err = services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 
-	return err
-}
-
-func resourceIBMNetworkVlanExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetNetworkVlanService(sess)
-
-	vlanID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid vlan ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(vlanID).Mask("id").GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return result.Id != nil && *result.Id == vlanID, nil
+	return diagFromErr(err)
 }
 
-func findVlanByOrderId(sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Vlan, error) {
+func findVlanByOrderId(ctx context.Context, sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Vlan, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -429,7 +415,7 @@ func findVlanByOrderId(sess *session.Session, orderId int, timeout time.Duration
 		MinTimeout: 3 * time.Second,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Vlan{}, err
@@ -4,9 +4,11 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
@@ -24,12 +26,13 @@ var (
 
 func resourceIBMOrg() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMOrgCreate,
-		Read:     resourceIBMOrgRead,
-		Delete:   resourceIBMOrgDelete,
-		Update:   resourceIBMOrgUpdate,
-		Exists:   resourceIBMOrgExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMOrgCreate,
+		ReadContext:   resourceIBMOrgRead,
+		DeleteContext: resourceIBMOrgDelete,
+		UpdateContext: resourceIBMOrgUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMOrg, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -77,10 +80,10 @@ func resourceIBMOrg() *schema.Resource {
 		},
 	}
 }
-func resourceIBMOrgCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	orgName := d.Get("name").(string)
@@ -92,47 +95,51 @@ func resourceIBMOrgCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	orgFields, err := orgAPI.Create(req)
 	if err != nil {
-		return fmt.Errorf("Error creating organisation: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating organisation: %s", err))
 	}
 	orgGUID := orgFields.Metadata.GUID
 	d.SetId(orgGUID)
 
-	return resourceIBMOrgUpdate(d, meta)
+	return resourceIBMOrgUpdate(ctx, d, meta)
 }
 
-func resourceIBMOrgRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	id := d.Id()
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	orgOwnerID := userDetails.userEmail
 	orgFields, err := orgAPI.Get(id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving organisation: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving organisation: %s", err))
 	}
 	d.Set("name", orgFields.Entity.Name)
 	billingManager, err := orgAPI.ListBillingManager(id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving billing manager in the org: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving billing manager in the org: %s", err))
 	}
 	managers, err := orgAPI.ListManager(id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving managers in the org: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving managers in the org: %s", err))
 	}
 	auditors, err := orgAPI.ListAuditors(id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving auditors in space: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving auditors in space: %s", err))
 	}
 	users, err := orgAPI.ListUsers(id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving users in space: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving users in space: %s", err))
 	}
 	if len(auditors) > 0 {
 		d.Set("auditors", flattenOrgRole(auditors, ""))
@@ -152,10 +159,10 @@ func resourceIBMOrgRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMOrgUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	id := d.Id()
@@ -166,61 +173,43 @@ func resourceIBMOrgUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	_, err = orgAPI.Update(id, req)
 	if err != nil {
-		return fmt.Errorf("Error updating organisation: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating organisation: %s", err))
 	}
 	err = updateOrgBillingManagers(orgAPI, id, d)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	err = updateOrgManagers(meta, id, d)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	err = updateOrgAuditors(orgAPI, id, d)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	err = updateOrgUsers(meta, id, d)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMOrgRead(d, meta)
+	return resourceIBMOrgRead(ctx, d, meta)
 }
 
-func resourceIBMOrgDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMOrgDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfAPI, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	orgAPI := cfAPI.Organizations()
 	id := d.Id()
 	err = orgAPI.Delete(id, false)
 	if err != nil {
-		return fmt.Errorf("Error deleting organisation: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting organisation: %s", err))
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMOrgExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cfClient, err := meta.(ClientSession).MccpAPI()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-	org, err := cfClient.Organizations().Get(id)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return org.Metadata.GUID == id, nil
-}
-
 func updateOrgBillingManagers(api mccpv2.Organizations, orgGUID string, d *schema.ResourceData) error {
 	if !d.HasChange("billing_managers") {
 		return nil
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"log"
 	"time"
@@ -12,17 +13,20 @@ import (
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
 	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMPICapture() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMPICaptureCreate,
-		Read:   resourceIBMPICaptureRead,
-		Update: resourceIBMPICaptureUpdate,
-		Delete: resourceIBMPICaptureDelete,
+		CreateContext: resourceIBMPICaptureCreate,
+		ReadContext:   resourceIBMPICaptureRead,
+		UpdateContext: resourceIBMPICaptureUpdate,
+		DeleteContext: resourceIBMPICaptureDelete,
 		//Exists:   resourceIBMPICaptureExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPICapture, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -88,10 +92,10 @@ func resourceIBMPICapture() *schema.Resource {
 	}
 }
 
-func resourceIBMPICaptureCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get(helpers.PIInstanceName).(string)
@@ -129,7 +133,7 @@ func resourceIBMPICaptureCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("Printing the data from the capture %+v", &captureinfo)
 
 	if err != nil {
-		return errors.New("The capture cannot be performed")
+		return diagFromErr(errors.New("The capture cannot be performed"))
 	}
 
 	// If this is an image catalog then we need to check what the status is
@@ -138,7 +142,7 @@ func resourceIBMPICaptureCreate(d *schema.ResourceData, meta interface{}) error
 	imagedata, err := imageClient.Get(d.Get(helpers.PIInstanceCaptureName).(string), powerinstanceid)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	log.Printf("Printing the data %s - %s", *imagedata.ImageID, imagedata.State)
 
@@ -153,12 +157,12 @@ func resourceIBMPICaptureCreate(d *schema.ResourceData, meta interface{}) error
 
 }
 
-func resourceIBMPICaptureRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPICaptureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	return nil
 }
 
-func resourceIBMPICaptureUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPICaptureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, _ := meta.(ClientSession).IBMPISession()
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -174,18 +178,18 @@ func resourceIBMPICaptureUpdate(d *schema.ResourceData, meta interface{}) error
 
 	volrequest, err := client.Update(d.Id(), name, size, shareable, powerinstanceid, postTimeOut)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	_, err = isWaitForIBMPIVolumeAvailable(client, *volrequest.VolumeID, powerinstanceid, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForIBMPIVolumeAvailable(ctx, client, *volrequest.VolumeID, powerinstanceid, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMPIVolumeRead(d, meta)
+	return resourceIBMPIVolumeRead(ctx, d, meta)
 }
 
-func resourceIBMPICaptureDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPICaptureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, _ := meta.(ClientSession).IBMPISession()
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -193,7 +197,7 @@ func resourceIBMPICaptureDelete(d *schema.ResourceData, meta interface{}) error
 
 	err := client.Delete(d.Id(), powerinstanceid, deleteTimeOut)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// wait for power volume states to be back as available. if it's attached it will be in-use
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -18,12 +20,13 @@ import (
 
 func resourceIBMPIImage() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPIImageCreate,
-		Read:     resourceIBMPIImageRead,
-		Update:   resourceIBMPIImageUpdate,
-		Delete:   resourceIBMPIImageDelete,
-		Exists:   resourceIBMPIImageExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPIImageCreate,
+		ReadContext:   resourceIBMPIImageRead,
+		UpdateContext: resourceIBMPIImageUpdate,
+		DeleteContext: resourceIBMPIImageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPIImage, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -63,11 +66,11 @@ func resourceIBMPIImage() *schema.Resource {
 	}
 }
 
-func resourceIBMPIImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
 		log.Printf("Failed to get the session")
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -78,37 +81,40 @@ func resourceIBMPIImageCreate(d *schema.ResourceData, meta interface{}) error {
 
 	imageResponse, err := client.Create(name, imageid, powerinstanceid)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	IBMPIImageID := imageResponse.ImageID
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, *IBMPIImageID))
 
-	_, err = isWaitForIBMPIImageAvailable(client, *IBMPIImageID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
+	_, err = isWaitForIBMPIImageAvailable(ctx, client, *IBMPIImageID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMPIImageRead(d, meta)
+	return resourceIBMPIImageRead(ctx, d, meta)
 }
 
-func resourceIBMPIImageRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := parts[0]
 	imageC := st.NewIBMPIImageClient(sess, powerinstanceid)
 	imagedata, err := imageC.Get(parts[1], powerinstanceid)
 
 	if err != nil {
-		return err
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
 	}
 
 	imageid := *imagedata.ImageID
@@ -119,58 +125,32 @@ func resourceIBMPIImageRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMPIImageUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIImageUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMPIImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := parts[0]
 	imageC := st.NewIBMPIImageClient(sess, powerinstanceid)
 	err = imageC.Delete(parts[1], powerinstanceid)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 
 }
 
-func resourceIBMPIImageExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	sess, err := meta.(ClientSession).IBMPISession()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	name := parts[1]
-	powerinstanceid := parts[0]
-	client := st.NewIBMPIImageClient(sess, powerinstanceid)
-
-	image, err := client.Get(parts[1], powerinstanceid)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return *image.ImageID == name, nil
-}
-
-func isWaitForIBMPIImageAvailable(client *st.IBMPIImageClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForIBMPIImageAvailable(ctx context.Context, client *st.IBMPIImageClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 	log.Printf("Waiting for Power Image (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -182,7 +162,7 @@ func isWaitForIBMPIImageAvailable(client *st.IBMPIImageClient, id string, timeou
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isIBMPIImageRefreshFunc(client *st.IBMPIImageClient, id, powerinstanceid string) resource.StateRefreshFunc {
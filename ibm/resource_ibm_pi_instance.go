@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -29,16 +31,22 @@ const (
 	//Added timeout values for warning  and active status
 	warningTimeOut = 30 * time.Second
 	activeTimeOut  = 2 * time.Minute
+
+	piInstanceState        = "pi_instance_state"
+	piInstanceStateActive  = "active"
+	piInstanceStateShutoff = "shutoff"
+	piImmediateShutdown    = "pi_immediate_shutdown"
 )
 
 func resourceIBMPIInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPIInstanceCreate,
-		Read:     resourceIBMPIInstanceRead,
-		Update:   resourceIBMPIInstanceUpdate,
-		Delete:   resourceIBMPIInstanceDelete,
-		Exists:   resourceIBMPIInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPIInstanceCreate,
+		ReadContext:   resourceIBMPIInstanceRead,
+		UpdateContext: resourceIBMPIInstanceUpdate,
+		DeleteContext: resourceIBMPIInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPIInstance, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
@@ -251,6 +259,19 @@ func resourceIBMPIInstance() *schema.Resource {
 				Default:      "OK",
 				Description:  "Allow the user to set the status of the lpar so that they can connect to it faster",
 			},
+			piInstanceState: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{piInstanceStateActive, piInstanceStateShutoff}),
+				Description:  "The power state of the PI instance, active or shutoff",
+			},
+			piImmediateShutdown: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Stop the PI instance with an immediate shutdown instead of a graceful stop",
+			},
 			helpers.PIVirtualCoresAssigned: {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -271,11 +292,11 @@ func resourceIBMPIInstance() *schema.Resource {
 	}
 }
 
-func resourceIBMPIInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Now in the PowerVMCreate")
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	name := d.Get(helpers.PIInstanceName).(string)
@@ -322,7 +343,7 @@ func resourceIBMPIInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	err = checkBase64(userData)
 	if err != nil {
 		log.Printf("Data is not base64 encoded")
-		return err
+		return diagFromErr(err)
 	}
 
 	//publicinterface := d.Get(helpers.PIInstancePublicNetwork).(bool)
@@ -360,7 +381,7 @@ func resourceIBMPIInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	}, powerinstanceid, createTimeOut)
 
 	if err != nil {
-		return fmt.Errorf("failed to provision %s", err)
+		return diagFromErr(fmt.Errorf("failed to provision %s", err))
 	}
 
 	var pvminstanceids []string
@@ -379,31 +400,43 @@ func resourceIBMPIInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	for ids := range pvminstanceids {
-		_, err = isWaitForPIInstanceAvailable(client, pvminstanceids[ids], d.Timeout(schema.TimeoutCreate), powerinstanceid, instanceReadyStatus)
+		_, err = isWaitForPIInstanceAvailable(ctx, client, pvminstanceids[ids], d.Timeout(schema.TimeoutCreate), powerinstanceid, instanceReadyStatus)
 		if err != nil {
-			return err
+			return diagFromErr(err)
+		}
+	}
+
+	if d.Get(piInstanceState).(string) == piInstanceStateShutoff {
+		for ids := range pvminstanceids {
+			err = piInstanceAction(ctx, client, pvminstanceids[ids], powerinstanceid, piInstanceStopAction(d), instanceReadyStatus, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return diagFromErr(err)
+			}
 		}
 	}
 
-	return resourceIBMPIInstanceRead(d, meta)
+	return resourceIBMPIInstanceRead(ctx, d, meta)
 
 }
 
-func resourceIBMPIInstanceRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := parts[0]
 	powerC := st.NewIBMPIInstanceClient(sess, powerinstanceid)
 	powervmdata, err := powerC.Get(parts[1], powerinstanceid, getTimeOut)
 	if err != nil {
-		return fmt.Errorf("failed to get the instance %v", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("failed to get the instance %v", err))
 	}
 
 	d.Set(helpers.PIInstanceMemory, powervmdata.Memory)
@@ -493,6 +526,7 @@ func resourceIBMPIInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if powervmdata.Health != nil {
 		d.Set("health_status", powervmdata.Health.Status)
 	}
+	d.Set(piInstanceState, flattenPIInstanceState(powervmdata))
 	if powervmdata.VirtualCores.Assigned != nil {
 		d.Set(helpers.PIVirtualCoresAssigned, powervmdata.VirtualCores.Assigned)
 	}
@@ -507,181 +541,162 @@ func resourceIBMPIInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMPIInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	name := d.Get(helpers.PIInstanceName).(string)
 	mem := d.Get(helpers.PIInstanceMemory).(float64)
 	procs := d.Get(helpers.PIInstanceProcessors).(float64)
 	processortype := d.Get(helpers.PIInstanceProcType).(string)
 	assignedVirtualCores := int64(d.Get(helpers.PIVirtualCoresAssigned).(int))
+	instanceReadyStatus := d.Get(helpers.PIInstanceHealthStatus).(string)
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return fmt.Errorf("failed to get the session from the IBM Cloud Service")
-	}
-	if d.Get("health_status") == "WARNING" {
-
-		return fmt.Errorf("the operation cannot be performed when the lpar health in the WARNING State")
+		return diagFromErr(fmt.Errorf("failed to get the session from the IBM Cloud Service"))
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := parts[0]
 	client := st.NewIBMPIInstanceClient(sess, powerinstanceid)
 
-	//if d.HasChange(helpers.PIInstanceName) || d.HasChange(helpers.PIInstanceProcessors) || d.HasChange(helpers.PIInstanceProcType) || d.HasChange(helpers.PIInstancePinPolicy){
-	if d.HasChange(helpers.PIInstanceProcType) {
-
-		// Stop the lpar
-		processortype := d.Get(helpers.PIInstanceProcType).(string)
-		if d.Get("status") == "SHUTOFF" {
-			log.Printf("the lpar is in the shutoff state. Nothing to do . Moving on ")
-		} else {
-
-			body := &models.PVMInstanceAction{
-				Action: ptrToString("immediate-shutdown"),
-			}
-			_, err = client.Action(&p_cloud_p_vm_instances.PcloudPvminstancesActionPostParams{Body: body}, parts[1], powerinstanceid, postTimeOut)
-			if err != nil {
-				return fmt.Errorf("failed to perform the stop action on the pvm instance %v", err)
-
-			}
-
-			_, err = isWaitForPIInstanceStopped(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid)
-			if err != nil {
-				return fmt.Errorf("failed to perform the stop action on the pvm instance %v", err)
-			}
-		}
-
-		// Modify
-
-		log.Printf("At this point the lpar should be off. Executing the Processor Update Change")
-		updatebody := &models.PVMInstanceUpdate{ProcType: processortype}
-		_, err = client.Update(parts[1], powerinstanceid, &p_cloud_p_vm_instances.PcloudPvminstancesPutParams{Body: updatebody}, updateTimeOut)
-		if err != nil {
-			return fmt.Errorf("failed to perform the modify operation on the pvm instance %v", err)
-		}
-		_, err = isWaitForPIInstanceStopped(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid)
-		if err != nil {
-			return err
-		}
-
-		// Start
-
-		startbody := &models.PVMInstanceAction{
-			Action: ptrToString("start"),
-		}
-		_, err = client.Action(&p_cloud_p_vm_instances.PcloudPvminstancesActionPostParams{Body: startbody}, parts[1], powerinstanceid, postTimeOut)
-		if err != nil {
-			return fmt.Errorf("failed to perform the start action on the pvm instance %v", err)
-		}
+	running := d.Get("status").(string) != "SHUTOFF"
+	wantRunning := d.Get(piInstanceState).(string) != piInstanceStateShutoff
 
-		_, err = isWaitForPIInstanceAvailable(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid, "OK")
+	resize := d.HasChange(helpers.PIInstanceMemory) || d.HasChange(helpers.PIInstanceProcessors) || d.HasChange(helpers.PIInstanceProcType) || d.HasChange(helpers.PIVirtualCoresAssigned)
+	if resize && running && !piInstanceResizeInPlace(d) {
+		log.Printf("The resize of the lpar [%s] can't be performed by DLPAR, the lpar will be stopped", parts[1])
+		err = piInstanceAction(ctx, client, parts[1], powerinstanceid, piInstanceStopAction(d), instanceReadyStatus, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-
+		running = false
 	}
 
-	// Start of the change for Memory and Processors
-	if d.HasChange(helpers.PIVirtualCoresAssigned) {
-		parts, err := idParts(d.Id())
-		if err != nil {
-			return err
-		}
-		powerinstanceid := parts[0]
-
-		client := st.NewIBMPIInstanceClient(sess, powerinstanceid)
-
+	if resize {
 		body := &models.PVMInstanceUpdate{
-			VirtualCores: &models.VirtualCores{Assigned: &assignedVirtualCores},
+			Memory:     mem,
+			ProcType:   processortype,
+			Processors: procs,
+			ServerName: name,
+		}
+		if assignedVirtualCores > 0 {
+			body.VirtualCores = &models.VirtualCores{Assigned: &assignedVirtualCores}
 		}
 		_, err = client.Update(parts[1], powerinstanceid, &p_cloud_p_vm_instances.PcloudPvminstancesPutParams{Body: body}, updateTimeOut)
 		if err != nil {
-			return fmt.Errorf("failed to update the lpar with the change for virtual cores %s", err)
+			return diagFromErr(fmt.Errorf("failed to update the lpar with the change %s", err))
+		}
+		if running {
+			_, err = isWaitForPIInstanceAvailable(ctx, client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid, instanceReadyStatus)
+		} else {
+			_, err = isWaitforPIInstanceUpdate(ctx, client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid)
 		}
-		_, err = isWaitForPIInstanceAvailable(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid, "OK")
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	if d.HasChange(helpers.PIInstanceMemory) || d.HasChange(helpers.PIInstanceProcessors) {
-
-		maxMemLpar := d.Get("max_memory").(float64)
-		maxCPULpar := d.Get("max_processors").(float64)
-		//log.Printf("the required memory is set to [%d] and current max memory is set to  [%d] ", int(mem), int(maxMemLpar))
-
-		if mem > maxMemLpar || procs > maxCPULpar {
-			log.Printf("Will require a shutdown to perform the change")
-
-		} else {
-			log.Printf("maxMemLpar is set to %f", maxMemLpar)
-			log.Printf("maxCPULpar is set to %f", maxCPULpar)
-		}
-
-		//if d.GetOkExists("reboot_for_resource_change")
-
-		if mem > maxMemLpar || procs > maxCPULpar {
-
-			_, err = performChangeAndReboot(client, parts[1], powerinstanceid, mem, procs)
-			//_, err = stopLparForResourceChange(client, parts[1], powerinstanceid)
-			if err != nil {
-				return fmt.Errorf("failed to perform the operation for the change")
-			}
+	switch {
+	case running && !wantRunning:
+		err = piInstanceAction(ctx, client, parts[1], powerinstanceid, piInstanceStopAction(d), instanceReadyStatus, d.Timeout(schema.TimeoutUpdate))
+	case !running && wantRunning:
+		err = piInstanceAction(ctx, client, parts[1], powerinstanceid, "start", instanceReadyStatus, d.Timeout(schema.TimeoutUpdate))
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
 
-		} else {
-			parts, err := idParts(d.Id())
-			if err != nil {
-				return err
-			}
-			powerinstanceid := parts[0]
+	return resourceIBMPIInstanceRead(ctx, d, meta)
 
-			client := st.NewIBMPIInstanceClient(sess, powerinstanceid)
+}
 
-			body := &models.PVMInstanceUpdate{
-				Memory:     mem,
-				ProcType:   processortype,
-				Processors: procs,
-				ServerName: name,
-			}
-			body.VirtualCores = &models.VirtualCores{Assigned: &assignedVirtualCores}
+// piInstanceResizeInPlace reports whether the processors and memory changes
+// can be applied by DLPAR on the running lpar. A change of the processor type,
+// a change beyond the maximum processors or memory of the lpar, or an lpar
+// whose health isn't OK requires the lpar to be stopped.
+func piInstanceResizeInPlace(d *schema.ResourceData) bool {
+	if d.HasChange(helpers.PIInstanceProcType) {
+		return false
+	}
+	if d.Get("health_status").(string) != helpers.PIInstanceHealthOk {
+		return false
+	}
+	mem := d.Get(helpers.PIInstanceMemory).(float64)
+	procs := d.Get(helpers.PIInstanceProcessors).(float64)
+	return mem <= d.Get("max_memory").(float64) && procs <= d.Get("max_processors").(float64)
+}
 
-			_, err = client.Update(parts[1], powerinstanceid, &p_cloud_p_vm_instances.PcloudPvminstancesPutParams{Body: body}, updateTimeOut)
-			if err != nil {
-				return fmt.Errorf("failed to update the lpar with the change %s", err)
-			}
-			_, err = isWaitForPIInstanceAvailable(client, parts[1], d.Timeout(schema.TimeoutUpdate), powerinstanceid, "OK")
-			if err != nil {
-				return err
-			}
+// piInstanceStopAction returns the action stopping the lpar, the graceful stop
+// unless the immediate shutdown is requested.
+func piInstanceStopAction(d *schema.ResourceData) string {
+	if d.Get(piImmediateShutdown).(bool) {
+		return "immediate-shutdown"
+	}
+	return "stop"
+}
 
-		}
+// flattenPIInstanceState returns the power state of the lpar, shutoff when it
+// is stopped and active otherwise. The status and the health of the lpar are
+// read into the status and health_status attributes.
+func flattenPIInstanceState(pvm *models.PVMInstance) string {
+	if pvm.Status == nil {
+		return ""
+	}
+	if *pvm.Status == "SHUTOFF" {
+		return piInstanceStateShutoff
+	}
+	return piInstanceStateActive
+}
 
+// piInstanceReady reports whether the lpar is available with a health that
+// meets the instanceReadyStatus, WARNING accepting both WARNING and OK.
+func piInstanceReady(pvm *models.PVMInstance, instanceReadyStatus string) bool {
+	if pvm.Status == nil || *pvm.Status != helpers.PIInstanceAvailable || pvm.Health == nil {
+		return false
 	}
+	return pvm.Health.Status == helpers.PIInstanceHealthOk || (instanceReadyStatus == helpers.PIInstanceHealthWarning && pvm.Health.Status == helpers.PIInstanceHealthWarning)
+}
 
-	return resourceIBMPIInstanceRead(d, meta)
+// piInstanceAction performs a power action on the lpar and waits for the lpar
+// to be stopped or to be active with the instanceReadyStatus health.
+func piInstanceAction(ctx context.Context, client *st.IBMPIInstanceClient, id, powerinstanceid, action, instanceReadyStatus string, timeout time.Duration) error {
+	body := &models.PVMInstanceAction{Action: ptrToString(action)}
+	_, err := client.Action(&p_cloud_p_vm_instances.PcloudPvminstancesActionPostParams{Body: body}, id, powerinstanceid, postTimeOut)
+	if err != nil {
+		return fmt.Errorf("failed to perform the %s action on the pvm instance %v", action, err)
+	}
 
+	switch action {
+	case "stop", "immediate-shutdown":
+		_, err = isWaitForPIInstanceStopped(ctx, client, id, timeout, powerinstanceid)
+	case "start", "soft-reboot", "hard-reboot":
+		_, err = isWaitForPIInstanceAvailable(ctx, client, id, timeout, powerinstanceid, instanceReadyStatus)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to wait for the %s action on the pvm instance %v", action, err)
+	}
+	return nil
 }
 
-func resourceIBMPIInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, _ := meta.(ClientSession).IBMPISession()
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := parts[0]
 	client := st.NewIBMPIInstanceClient(sess, powerinstanceid)
 	err = client.Delete(parts[1], powerinstanceid, deleteTimeOut)
 	if err != nil {
-		return fmt.Errorf("failed to perform the delete action on the pvm instance %s", err)
+		return diagFromErr(fmt.Errorf("failed to perform the delete action on the pvm instance %s", err))
 	}
 
-	_, err = isWaitForPIInstanceDeleted(client, parts[1], d.Timeout(schema.TimeoutDelete), powerinstanceid)
+	_, err = isWaitForPIInstanceDeleted(ctx, client, parts[1], d.Timeout(schema.TimeoutDelete), powerinstanceid)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -690,35 +705,7 @@ func resourceIBMPIInstanceDelete(d *schema.ResourceData, meta interface{}) error
 
 // Exists
 
-func resourceIBMPIInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	log.Printf("Calling the PowerInstance Exists method")
-	sess, err := meta.(ClientSession).IBMPISession()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	powerinstanceid := parts[0]
-	client := st.NewIBMPIInstanceClient(sess, powerinstanceid)
-
-	instance, err := client.Get(parts[1], powerinstanceid, getTimeOut)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("error communicating with the API: %s", err)
-	}
-
-	truepvmid := *instance.PvmInstanceID
-	return truepvmid == parts[1], nil
-}
-
-func isWaitForPIInstanceDeleted(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForPIInstanceDeleted(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
@@ -731,7 +718,7 @@ func isWaitForPIInstanceDeleted(client *st.IBMPIInstanceClient, id string, timeo
 		Timeout:    10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -747,7 +734,7 @@ func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id, powerinst
 	}
 }
 
-func isWaitForPIInstanceAvailable(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string, instanceReadyStatus string) (interface{}, error) {
+func isWaitForPIInstanceAvailable(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string, instanceReadyStatus string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be available and active ", id)
 
 	var queryTimeOut time.Duration
@@ -767,7 +754,7 @@ func isWaitForPIInstanceAvailable(client *st.IBMPIInstanceClient, id string, tim
 		Timeout:    120 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid, instanceReadyStatus string) resource.StateRefreshFunc {
@@ -777,8 +764,7 @@ func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid
 		if err != nil {
 			return nil, "", err
 		}
-		allowableStatus := instanceReadyStatus
-		if *pvm.Status == helpers.PIInstanceAvailable && (pvm.Health.Status == allowableStatus) {
+		if piInstanceReady(pvm, instanceReadyStatus) {
 			return pvm, helpers.PIInstanceAvailable, nil
 		}
 		if *pvm.Status == "ERROR" {
@@ -798,7 +784,7 @@ func checkBase64(input string) error {
 
 }
 
-func isWaitForPIInstanceStopped(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForPIInstanceStopped(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be stopped and powered off ", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -810,7 +796,7 @@ func isWaitForPIInstanceStopped(client *st.IBMPIInstanceClient, id string, timeo
 		Timeout:    30 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -828,115 +814,7 @@ func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id, powerinstanc
 	}
 }
 
-func stopLparForResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string) (interface{}, error) {
-	//TODO
-
-	log.Printf("Callin the stop lpar for Resource Change code ..")
-	body := &models.PVMInstanceAction{
-		//Action: ptrToString("stop"),
-		Action: ptrToString("immediate-shutdown"),
-	}
-	_, err := client.Action(&p_cloud_p_vm_instances.PcloudPvminstancesActionPostParams{Body: body}, id, powerinstanceid, postTimeOut)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = isWaitForPIInstanceStopped(client, id, 30, powerinstanceid)
-	if err != nil {
-		return nil, fmt.Errorf("failed to stop the lpar")
-	}
-
-	return nil, err
-}
-
-// Start the lpar
-
-func startLparAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string) (interface{}, error) {
-	//TODO
-	body := &models.PVMInstanceAction{
-		Action: ptrToString("start"),
-	}
-	_, err := client.Action(&p_cloud_p_vm_instances.PcloudPvminstancesActionPostParams{Body: body}, id, powerinstanceid, postTimeOut)
-	if err != nil {
-		return nil, fmt.Errorf("start Action failed on [%s] %s", id, err)
-	}
-
-	_, err = isWaitForPIInstanceAvailable(client, id, 30, powerinstanceid, "OK")
-	if err != nil {
-		return nil, fmt.Errorf("failed to stop the lpar")
-	}
-
-	return nil, err
-}
-
-// Stop / Modify / Start only when the lpar is off limits
-
-func performChangeAndReboot(client *st.IBMPIInstanceClient, id, powerinstanceid string, mem, procs float64) (interface{}, error) {
-	/*
-		These are the steps
-		1. Stop the lpar - Check if the lpar is SHUTOFF
-		2. Once the lpar is SHUTOFF - Make the cpu / memory change - DUring this time , you can check for RESIZE and VERIFY_RESIZE as the transition states
-		3. If the change is successful , the lpar state will be back in SHUTOFF
-		4. Once the LPAR state is SHUTOFF , initiate the start again and check for ACTIVE + OK
-	*/
-	//Execute the stop
-
-	log.Printf("Callin the stop lpar for Resource Change code ..")
-	stopbody := &models.PVMInstanceAction{
-		//Action: ptrToString("stop"),
-		Action: ptrToString("immediate-shutdown"),
-	}
-
-	_, err := client.Action(&p_cloud_p_vm_instances.PcloudPvminstancesActionPostParams{Body: stopbody}, id, powerinstanceid, postTimeOut)
-	if err != nil {
-		return nil, fmt.Errorf("Stop Action failed on [%s]: %s", id, err)
-	}
-	_, err = isWaitForPIInstanceStopped(client, id, 30, powerinstanceid)
-	if err != nil {
-		return nil, fmt.Errorf("failed to stop the lpar")
-	}
-
-	body := &models.PVMInstanceUpdate{
-		Memory: mem,
-		//ProcType:   processortype,
-		Processors: procs,
-		//ServerName: name,
-	}
-
-	_, updateErr := client.Update(id, powerinstanceid, &p_cloud_p_vm_instances.PcloudPvminstancesPutParams{Body: body}, updateTimeOut)
-	if updateErr != nil {
-		return nil, fmt.Errorf("failed to update the lpar with the change, %s", updateErr)
-	}
-
-	_, err = isWaitforPIInstanceUpdate(client, id, 30, powerinstanceid)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get an update from the Service after the resource change, %s", err)
-	}
-
-	// Now we can start the lpar
-
-	log.Printf("Calling the start lpar After the  Resource Change code ..")
-	startbody := &models.PVMInstanceAction{
-		//Action: ptrToString("stop"),
-		Action: ptrToString("start"),
-	}
-	_, starterr := client.Action(&p_cloud_p_vm_instances.PcloudPvminstancesActionPostParams{Body: startbody}, id, powerinstanceid, postTimeOut)
-	if starterr != nil {
-		log.Printf("Start Action failed on [%s]", id)
-
-		return nil, fmt.Errorf("the error from the start is %s", starterr)
-	}
-
-	_, err = isWaitForPIInstanceAvailable(client, id, 30, powerinstanceid, "OK")
-	if err != nil {
-		return nil, fmt.Errorf("failed to stop the lpar %s", err)
-	}
-
-	return nil, err
-
-}
-
-func isWaitforPIInstanceUpdate(client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitforPIInstanceUpdate(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be SHUTOFF AFTER THE RESIZE Due to DLPAR Operation ", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -948,7 +826,7 @@ func isWaitforPIInstanceUpdate(client *st.IBMPIInstanceClient, id string, timeou
 		Timeout:    60 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isPIInstanceShutAfterResourceChange(client *st.IBMPIInstanceClient, id, powerinstanceid string) resource.StateRefreshFunc {
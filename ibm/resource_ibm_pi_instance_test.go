@@ -4,15 +4,19 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 )
 
 func TestAccIBMPIInstancebasic(t *testing.T) {
@@ -29,11 +33,128 @@ func TestAccIBMPIInstancebasic(t *testing.T) {
 					testAccCheckIBMPIInstanceExists("ibm_pi_instance.power_instance"),
 					resource.TestCheckResourceAttr(
 						"ibm_pi_instance.power_instance", "pi_instance_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_instance.power_instance", "pi_instance_state", "active"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIInstanceStateConfig(name, "shutoff"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists("ibm_pi_instance.power_instance"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_instance.power_instance", "pi_instance_state", "shutoff"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIInstanceStateConfig(name, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists("ibm_pi_instance.power_instance"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_instance.power_instance", "pi_instance_state", "active"),
 				),
 			},
 		},
 	})
 }
+
+func TestFlattenPIInstanceState(t *testing.T) {
+	testCases := []struct {
+		status   string
+		health   string
+		expected string
+	}{
+		{status: "SHUTOFF", expected: "shutoff"},
+		{status: "ACTIVE", health: "OK", expected: "active"},
+		{status: "ACTIVE", health: "WARNING", expected: "active"},
+		{status: "ACTIVE", health: "CRITICAL", expected: "active"},
+		{status: "BUILD", expected: "active"},
+		{status: "ERROR", expected: "active"},
+	}
+	for _, tc := range testCases {
+		pvm := &models.PVMInstance{Status: ptrToString(tc.status)}
+		if tc.health != "" {
+			pvm.Health = &models.PVMInstanceHealth{Status: tc.health}
+		}
+		assert.Equal(t, tc.expected, flattenPIInstanceState(pvm), "%s/%s", tc.status, tc.health)
+	}
+	assert.Equal(t, "", flattenPIInstanceState(&models.PVMInstance{}))
+}
+
+func TestPIInstanceReady(t *testing.T) {
+	testCases := []struct {
+		status   string
+		health   string
+		ready    string
+		expected bool
+	}{
+		{status: "SHUTOFF", ready: "OK", expected: false},
+		{status: "ACTIVE", health: "OK", ready: "OK", expected: true},
+		{status: "ACTIVE", health: "WARNING", ready: "OK", expected: false},
+		{status: "ACTIVE", health: "WARNING", ready: "WARNING", expected: true},
+		{status: "ACTIVE", health: "OK", ready: "WARNING", expected: true},
+		{status: "ACTIVE", health: "CRITICAL", ready: "WARNING", expected: false},
+		{status: "BUILD", ready: "OK", expected: false},
+	}
+	for _, tc := range testCases {
+		pvm := &models.PVMInstance{Status: ptrToString(tc.status)}
+		if tc.health != "" {
+			pvm.Health = &models.PVMInstanceHealth{Status: tc.health}
+		}
+		assert.Equal(t, tc.expected, piInstanceReady(pvm, tc.ready), "%s/%s/%s", tc.status, tc.health, tc.ready)
+	}
+	assert.Assert(t, !piInstanceReady(&models.PVMInstance{}, "OK"))
+}
+
+func TestPIInstanceResizeInPlace(t *testing.T) {
+	r := resourceIBMPIInstance()
+	state := func(health string) *terraform.InstanceState {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"pi_memory":     4,
+			"pi_processors": 1,
+			"pi_proc_type":  "shared",
+		})
+		d.SetId("cloud1/instance1")
+		d.Set("health_status", health)
+		d.Set("max_memory", 8)
+		d.Set("max_processors", 2)
+		return d.State()
+	}
+	testCases := []struct {
+		health   string
+		memory   float64
+		procs    float64
+		procType string
+		expected bool
+	}{
+		{health: "OK", memory: 8, procs: 2, procType: "shared", expected: true},
+		{health: "OK", memory: 16, procs: 1, procType: "shared", expected: false},
+		{health: "OK", memory: 4, procs: 3, procType: "shared", expected: false},
+		{health: "OK", memory: 4, procs: 1, procType: "dedicated", expected: false},
+		{health: "WARNING", memory: 8, procs: 1, procType: "shared", expected: false},
+	}
+	for _, tc := range testCases {
+		s := state(tc.health)
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"pi_memory":     tc.memory,
+			"pi_processors": tc.procs,
+			"pi_proc_type":  tc.procType,
+		})
+		diff, err := r.Diff(context.Background(), s, config, nil)
+		assert.NilError(t, err)
+		d, err := schema.InternalMap(r.Schema).Data(s, diff)
+		assert.NilError(t, err)
+		assert.Equal(t, tc.expected, piInstanceResizeInPlace(d), "%+v", tc)
+	}
+}
+
+func TestPIInstanceStopAction(t *testing.T) {
+	r := resourceIBMPIInstance()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	assert.Equal(t, "stop", piInstanceStopAction(d))
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"pi_immediate_shutdown": true})
+	assert.Equal(t, "immediate-shutdown", piInstanceStopAction(d))
+}
+
 func testAccCheckIBMPIInstanceDestroy(s *terraform.State) error {
 
 	sess, err := testAccProvider.Meta().(ClientSession).IBMPISession()
@@ -127,3 +248,43 @@ func testAccCheckIBMPIInstanceConfig(name string) string {
 	  }
 	`, pi_cloud_instance_id, name)
 }
+
+func testAccCheckIBMPIInstanceStateConfig(name, state string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_key" "key" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_key_name          = "%[2]s"
+		pi_ssh_key           = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR"
+	  }
+	  resource "ibm_pi_image" "power_image" {
+		pi_image_name       = "%[2]s"
+		pi_image_id         = "f31da27a-b634-45e5-913a-3f4d964e5a02"
+		pi_cloud_instance_id = "%[1]s"
+	  }
+	  resource "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[2]s"
+		pi_network_type      = "pub-vlan"
+	  }
+	  resource "ibm_pi_volume" "power_volume" {
+		pi_volume_size       = 20
+		pi_volume_name       = "%[2]s"
+		pi_volume_type       = "tier1"
+		pi_volume_shareable  = true
+		pi_cloud_instance_id = "%[1]s"
+	  }
+	  resource "ibm_pi_instance" "power_instance" {
+		pi_memory             = "4"
+		pi_processors         = "2"
+		pi_instance_name      = "%[2]s"
+		pi_proc_type          = "shared"
+		pi_image_id           = ibm_pi_image.power_image.image_id
+		pi_network_ids        = [ibm_pi_network.power_networks.network_id]
+		pi_key_pair_name      = ibm_pi_key.key.key_id
+		pi_sys_type           = "s922"
+		pi_cloud_instance_id  = "%[1]s"
+		pi_volume_ids         = [ibm_pi_volume.power_volume.volume_id]
+		pi_instance_state     = "%[3]s"
+	  }
+	`, pi_cloud_instance_id, name, state)
+}
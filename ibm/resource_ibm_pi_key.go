@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -17,12 +19,13 @@ import (
 
 func resourceIBMPIKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPIKeyCreate,
-		Read:     resourceIBMPIKeyRead,
-		Update:   resourceIBMPIKeyUpdate,
-		Delete:   resourceIBMPIKeyDelete,
-		Exists:   resourceIBMPIKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPIKeyCreate,
+		ReadContext:   resourceIBMPIKeyRead,
+		UpdateContext: resourceIBMPIKeyUpdate,
+		DeleteContext: resourceIBMPIKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPIKey, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -63,10 +66,10 @@ func resourceIBMPIKey() *schema.Resource {
 	}
 }
 
-func resourceIBMPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -77,25 +80,24 @@ func resourceIBMPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	sshResponse, _, err := client.Create(name, sshkey, powerinstanceid)
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return fmt.Errorf("Failed to create the key %v", err)
+		return diagFromErr(fmt.Errorf("Failed to create the key %v", err))
 
 	}
 
 	log.Printf("Printing the sshkey %+v", &sshResponse)
 
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, name))
-	return resourceIBMPIKeyRead(d, meta)
+	return resourceIBMPIKeyRead(ctx, d, meta)
 }
 
-func resourceIBMPIKeyRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return fmt.Errorf("Failed to obtain the key %v", err)
+		return diagFromErr(fmt.Errorf("Failed to obtain the key %v", err))
 	}
 
 	powerinstanceid := parts[0]
@@ -103,7 +105,11 @@ func resourceIBMPIKeyRead(d *schema.ResourceData, meta interface{}) error {
 	sshkeydata, err := sshkeyC.Get(parts[1], powerinstanceid)
 
 	if err != nil {
-		return err
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
 	}
 
 	d.Set(helpers.PIKeyName, sshkeydata.Name)
@@ -116,18 +122,18 @@ func resourceIBMPIKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMPIKeyUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIKeyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := parts[0]
@@ -135,34 +141,8 @@ func resourceIBMPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	err = sshkeyC.Delete(parts[1], powerinstanceid)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMPIKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	sess, err := meta.(ClientSession).IBMPISession()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-	name := parts[1]
-	powerinstanceid := parts[0]
-	client := st.NewIBMPIKeyClient(sess, powerinstanceid)
-
-	key, err := client.Get(parts[1], powerinstanceid)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return *key.Name == name, nil
-}
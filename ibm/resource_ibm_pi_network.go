@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -20,12 +22,14 @@ import (
 
 func resourceIBMPINetwork() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMPINetworkCreate,
-		Read:   resourceIBMPINetworkRead,
-		Update: resourceIBMPINetworkUpdate,
-		Delete: resourceIBMPINetworkDelete,
+		CreateContext: resourceIBMPINetworkCreate,
+		ReadContext:   resourceIBMPINetworkRead,
+		UpdateContext: resourceIBMPINetworkUpdate,
+		DeleteContext: resourceIBMPINetworkDelete,
 		//Exists:   resourceIBMPINetworkExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPINetwork, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -88,10 +92,10 @@ func resourceIBMPINetwork() *schema.Resource {
 	}
 }
 
-func resourceIBMPINetworkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	networkname := d.Get(helpers.PINetworkName).(string)
@@ -106,38 +110,38 @@ func resourceIBMPINetworkCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	networkResponse, _, err := client.Create(networkname, networktype, networkcidr, networkdns, networkgateway, firstip, lastip, powerinstanceid, postTimeOut)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	IBMPINetworkID := *networkResponse.NetworkID
 
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, IBMPINetworkID))
 
-	_, err = isWaitForIBMPINetworkAvailable(client, IBMPINetworkID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
+	_, err = isWaitForIBMPINetworkAvailable(ctx, client, IBMPINetworkID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMPINetworkRead(d, meta)
+	return resourceIBMPINetworkRead(ctx, d, meta)
 }
 
-func resourceIBMPINetworkRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := parts[0]
 	networkC := st.NewIBMPINetworkClient(sess, powerinstanceid)
 	networkdata, err := networkC.Get(parts[1], powerinstanceid, getTimeOut)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set("network_id", networkdata.NetworkID)
@@ -152,27 +156,27 @@ func resourceIBMPINetworkRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
-func resourceIBMPINetworkUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMPINetworkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Calling the network delete functions. ")
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := parts[0]
 	networkC := st.NewIBMPINetworkClient(sess, powerinstanceid)
 	err = networkC.Delete(parts[1], powerinstanceid, deleteTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -199,7 +203,7 @@ func resourceIBMPINetworkExists(d *schema.ResourceData, meta interface{}) (bool,
 	return *network.NetworkID == parts[1], nil
 }
 
-func isWaitForIBMPINetworkAvailable(client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForIBMPINetworkAvailable(ctx context.Context, client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"retry", helpers.PINetworkProvisioning},
@@ -210,7 +214,7 @@ func isWaitForIBMPINetworkAvailable(client *st.IBMPINetworkClient, id string, ti
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isIBMPINetworkRefreshFunc(client *st.IBMPINetworkClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -19,12 +21,14 @@ import (
 
 func resourceIBMPINetworkPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMPINetworkPortCreate,
-		Read:   resourceIBMPINetworkPortRead,
-		Update: resourceIBMPINetworkPortUpdate,
-		Delete: resourceIBMPINetworkPortDelete,
+		CreateContext: resourceIBMPINetworkPortCreate,
+		ReadContext:   resourceIBMPINetworkPortRead,
+		UpdateContext: resourceIBMPINetworkPortUpdate,
+		DeleteContext: resourceIBMPINetworkPortDelete,
 		//Exists:   resourceIBMPINetworkExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPINetworkPort, importIDParts(helpers.PICloudInstanceId, "", helpers.PINetworkName)),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -75,10 +79,10 @@ func resourceIBMPINetworkPort() *schema.Resource {
 	}
 }
 
-func resourceIBMPINetworkPortCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	networkname := d.Get(helpers.PINetworkName).(string)
@@ -98,7 +102,7 @@ func resourceIBMPINetworkPortCreate(d *schema.ResourceData, meta interface{}) er
 	networkPortResponse, err := client.CreatePort(networkname, powerinstanceid, &p_cloud_networks.PcloudNetworksPortsPostParams{Body: nwportBody}, postTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("Printing the networkresponse %+v", &networkPortResponse)
@@ -108,26 +112,26 @@ func resourceIBMPINetworkPortCreate(d *schema.ResourceData, meta interface{}) er
 	d.SetId(fmt.Sprintf("%s/%s/%s", powerinstanceid, IBMPINetworkPortID, networkname))
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return err
+		return diagFromErr(err)
 	}
-	_, err = isWaitForIBMPINetworkPortAvailable(client, IBMPINetworkPortID, d.Timeout(schema.TimeoutCreate), powerinstanceid, networkname)
+	_, err = isWaitForIBMPINetworkPortAvailable(ctx, client, IBMPINetworkPortID, d.Timeout(schema.TimeoutCreate), powerinstanceid, networkname)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMPINetworkPortRead(d, meta)
+	return resourceIBMPINetworkPortRead(ctx, d, meta)
 }
 
-func resourceIBMPINetworkPortRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var powernetworkname string
 	if len(parts) > 2 {
@@ -142,7 +146,7 @@ func resourceIBMPINetworkPortRead(d *schema.ResourceData, meta interface{}) erro
 	networkdata, err := networkC.GetPort(powernetworkname, powerinstanceid, parts[1], getTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set(helpers.PINetworkPortIPAddress, networkdata.IPAddress)
@@ -155,21 +159,21 @@ func resourceIBMPINetworkPortRead(d *schema.ResourceData, meta interface{}) erro
 
 }
 
-func resourceIBMPINetworkPortUpdate(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMPINetworkPortDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Calling the network delete functions. ")
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	var powernetworkname string
 	if len(parts) > 2 {
@@ -187,13 +191,13 @@ func resourceIBMPINetworkPortDelete(d *schema.ResourceData, meta interface{}) er
 	log.Printf("Response from the deleteport call %v", networkdata)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func isWaitForIBMPINetworkPortAvailable(client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid, networkname string) (interface{}, error) {
+func isWaitForIBMPINetworkPortAvailable(ctx context.Context, client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid, networkname string) (interface{}, error) {
 	log.Printf("Waiting for Power Network (%s) that was created for Network Zone (%s) to be available.", id, networkname)
 
 	stateConf := &resource.StateChangeConf{
@@ -205,7 +209,7 @@ func isWaitForIBMPINetworkPortAvailable(client *st.IBMPINetworkClient, id string
 		MinTimeout: 10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isIBMPINetworkPortRefreshFunc(client *st.IBMPINetworkClient, id, powerinstanceid, networkname string) resource.StateRefreshFunc {
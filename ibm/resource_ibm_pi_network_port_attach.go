@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -18,12 +20,14 @@ import (
 func resourceIBMPINetworkPortAttach() *schema.Resource {
 	return &schema.Resource{
 
-		Create: resourceIBMPINetworkPortAttachCreate,
-		Read:   resourceIBMPINetworkPortAttachRead,
-		Update: resourceIBMPINetworkPortAttachUpdate,
-		Delete: resourceIBMPINetworkPortAttachDelete,
+		CreateContext: resourceIBMPINetworkPortAttachCreate,
+		ReadContext:   resourceIBMPINetworkPortAttachRead,
+		UpdateContext: resourceIBMPINetworkPortAttachUpdate,
+		DeleteContext: resourceIBMPINetworkPortAttachDelete,
 		//Exists:   resourceIBMPINetworkExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPINetworkPortAttachImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -69,10 +73,10 @@ func resourceIBMPINetworkPortAttach() *schema.Resource {
 
 }
 
-func resourceIBMPINetworkPortAttachCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	networkname := d.Get(helpers.PINetworkName).(string)
@@ -85,7 +89,7 @@ func resourceIBMPINetworkPortAttachCreate(d *schema.ResourceData, meta interface
 	networkPortResponse, err := client.AttachPort(powerinstanceid, networkname, portid, description, instancename, postTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("Printing the networkresponse %+v", &networkPortResponse)
@@ -95,17 +99,17 @@ func resourceIBMPINetworkPortAttachCreate(d *schema.ResourceData, meta interface
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, IBMPINetworkPortID))
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return err
+		return diagFromErr(err)
 	}
-	_, err = isWaitForIBMPINetworkPortAttachAvailable(client, IBMPINetworkPortID, d.Timeout(schema.TimeoutCreate), powerinstanceid, networkname)
+	_, err = isWaitForIBMPINetworkPortAttachAvailable(ctx, client, IBMPINetworkPortID, d.Timeout(schema.TimeoutCreate), powerinstanceid, networkname)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMPINetworkPortAttachRead(d, meta)
+	return resourceIBMPINetworkPortAttachRead(ctx, d, meta)
 }
 
-func resourceIBMPINetworkPortAttachRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortAttachRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Calling ther Network Port Attach Read code")
 	sess, err := meta.(ClientSession).IBMPISession()
 
@@ -115,14 +119,19 @@ func resourceIBMPINetworkPortAttachRead(d *schema.ResourceData, meta interface{}
 
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := parts[0]
 	powernetworkname := d.Get(helpers.PINetworkName).(string)
 	networkC := st.NewIBMPINetworkClient(sess, powerinstanceid)
 	networkdata, err := networkC.GetPort(powernetworkname, powerinstanceid, parts[1], getTimeOut)
+	if err != nil {
+		return diagFromErr(err)
+	}
 
+	d.Set(helpers.PICloudInstanceId, powerinstanceid)
+	d.Set("port_id", parts[1])
 	d.Set("ipaddress", networkdata.IPAddress)
 	d.Set("macaddress", networkdata.MacAddress)
 	d.Set("status", networkdata.Status)
@@ -133,12 +142,26 @@ func resourceIBMPINetworkPortAttachRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceIBMPINetworkPortAttachUpdate(d *schema.ResourceData, meta interface{}) error {
+// resourceIBMPINetworkPortAttachImport imports a network port attachment from the ID
+// <pi_cloud_instance_id>/<port_id>/<pi_network_name>, the port is read from its network
+func resourceIBMPINetworkPortAttachImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	args, err := importIDParts(helpers.PICloudInstanceId, "port_id", helpers.PINetworkName)(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range args {
+		d.Set(k, v)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", args[helpers.PICloudInstanceId], args["port_id"]))
+	return importStateWithRead(resourceIBMPINetworkPortAttach, nil)(ctx, d, meta)
+}
+
+func resourceIBMPINetworkPortAttachUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Calling the attach update ")
 	return nil
 }
 
-func resourceIBMPINetworkPortAttachDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPINetworkPortAttachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Detaching the network port from the Instance ")
 
 	sess, err := meta.(ClientSession).IBMPISession()
@@ -149,7 +172,7 @@ func resourceIBMPINetworkPortAttachDelete(d *schema.ResourceData, meta interface
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := parts[0]
@@ -160,7 +183,7 @@ func resourceIBMPINetworkPortAttachDelete(d *schema.ResourceData, meta interface
 	log.Printf("Calling the network delete functions. ")
 	network, err := client.DetachPort(powerinstanceid, powernetworkname, portid, deleteTimeOut)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("Printing the networkresponse %+v", &network)
@@ -172,7 +195,7 @@ func resourceIBMPINetworkPortAttachDelete(d *schema.ResourceData, meta interface
 
 }
 
-func isWaitForIBMPINetworkPortAttachAvailable(client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid, networkname string) (interface{}, error) {
+func isWaitForIBMPINetworkPortAttachAvailable(ctx context.Context, client *st.IBMPINetworkClient, id string, timeout time.Duration, powerinstanceid, networkname string) (interface{}, error) {
 	log.Printf("Waiting for Power Network (%s) that was created for Network Zone (%s) to be available.", id, networkname)
 
 	stateConf := &resource.StateChangeConf{
@@ -184,7 +207,7 @@ func isWaitForIBMPINetworkPortAttachAvailable(client *st.IBMPINetworkClient, id
 		MinTimeout: 10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isIBMPINetworkPortAttachRefreshFunc(client *st.IBMPINetworkClient, id, powerinstanceid, networkname string) resource.StateRefreshFunc {
@@ -4,13 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
-	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
-	"github.com/IBM-Cloud/power-go-client/power/models"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"log"
@@ -34,12 +33,15 @@ SHUTOFF--> ACTIVE
 
 func resourceIBMPIIOperations() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMPIOperationsCreate,
-		Read:   resourceIBMPIOperationsRead,
-		Update: resourceIBMPIOperationsUpdate,
-		Delete: resourceIBMPIOperationsDelete,
+		CreateContext: resourceIBMPIOperationsCreate,
+		ReadContext:   resourceIBMPIOperationsRead,
+		UpdateContext: resourceIBMPIOperationsUpdate,
+		DeleteContext: resourceIBMPIOperationsDelete,
 		//Exists:   resourceIBMPIOperationsExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPIIOperations, nil),
+		},
+		DeprecationMessage: "ibm_pi_operations is deprecated, use the pi_instance_state argument of ibm_pi_instance to start and stop an instance",
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -116,72 +118,35 @@ func resourceIBMPIIOperations() *schema.Resource {
 	}
 }
 
-func resourceIBMPIOperationsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Now in the Power Operations Code")
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	operation := d.Get(helpers.PIInstanceOperationType).(string)
 	name := d.Get(helpers.PIInstanceOperationServerName).(string)
 
-	body := &models.PVMInstanceAction{Action: ptrToString(operation)}
 	log.Printf("Calling the IBM PI Operations [ %s ] with on the instance with name [ %s ]", operation, name)
 	client := st.NewIBMPIInstanceClient(sess, powerinstanceid)
 
-	/*
-		TODO
-		To add a check if the action performed is applicable on the current state of the instance
-	*/
-
-	pvmoperation, err := client.Action(&p_cloud_p_vm_instances.PcloudPvminstancesActionPostParams{
-		Body: body,
-	}, name, powerinstanceid, 30*time.Second)
-
+	err = piInstanceAction(ctx, client, name, powerinstanceid, operation, helpers.PIInstanceHealthOk, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		log.Printf("[DEBUG]  err %s", err)
-		return fmt.Errorf("Failed to perform the operation on  the instance %v", err)
-
-	} else {
-		log.Printf("Executed the stop operation on the lpar")
-	}
-
-	log.Printf("Printing the instance info %+v", &pvmoperation)
-
-	if operation == "stop" || operation == "immediate-shutdown" {
-		var targetStatus = "SHUTOFF"
-		log.Printf("Calling the check opertion that was invoked [%s]  to check for status [ %s ]", operation, targetStatus)
-		_, err = isWaitForPIInstanceOperationStatus(client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
-		if err != nil {
-			return err
-		} else {
-			log.Printf("Executed the start operation on the lpar")
-		}
-
-	}
-
-	if operation == "start" || operation == "soft-reboot" || operation == "hard-reboot" {
-		var targetStatus = "ACTIVE"
-		log.Printf("Calling the check opertion that was invoked [%s]  to check for status [ %s ]", operation, targetStatus)
-		_, err = isWaitForPIInstanceOperationStatus(client, name, d.Timeout(schema.TimeoutCreate), powerinstanceid, operation, targetStatus)
-		if err != nil {
-			return err
-		}
-
+		return diagFromErr(err)
 	}
 
-	return resourceIBMPIOperationsRead(d, meta)
+	return resourceIBMPIOperationsRead(ctx, d, meta)
 }
 
-func resourceIBMPIOperationsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Calling the PowerOperations Read code..for instance name %s", d.Get(helpers.PIInstanceOperationServerName).(string))
 
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	name := d.Get(helpers.PIInstanceOperationServerName).(string)
@@ -189,7 +154,7 @@ func resourceIBMPIOperationsRead(d *schema.ResourceData, meta interface{}) error
 	powervmdata, err := powerC.Get(name, powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set("status", powervmdata.Status)
@@ -207,12 +172,12 @@ func resourceIBMPIOperationsRead(d *schema.ResourceData, meta interface{}) error
 
 }
 
-func resourceIBMPIOperationsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	return nil
 }
 
-func resourceIBMPIOperationsDelete(data *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIOperationsDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	return nil
 }
@@ -236,40 +201,3 @@ func resourceIBMPIOperationsExists(d *schema.ResourceData, meta interface{}) (bo
 	}
 	return instance.PvmInstanceID == &id, nil
 }
-
-func isWaitForPIInstanceOperationStatus(client *st.IBMPIInstanceClient, name string, timeout time.Duration, powerinstanceid, operation, targetstatus string) (interface{}, error) {
-
-	log.Printf("Waiting for the Operation [ %s ] to be performed on the instance with name [ %s ]", operation, name)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"ACTIVE", "SHUTOFF", "WARNING"},
-		Target:     []string{targetstatus},
-		Refresh:    isPIOperationsRefreshFunc(client, name, powerinstanceid, targetstatus),
-		Delay:      1 * time.Minute,
-		MinTimeout: 2 * time.Minute,
-		Timeout:    120 * time.Minute,
-	}
-
-	return stateConf.WaitForState()
-
-}
-
-func isPIOperationsRefreshFunc(client *st.IBMPIInstanceClient, id, powerinstanceid, targetstatus string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-
-		log.Printf("Waiting for the target status to be [ %s ]", targetstatus)
-		pvm, err := client.Get(id, powerinstanceid, getTimeOut)
-		if err != nil {
-			return nil, "", err
-		}
-
-		if *pvm.Status == targetstatus && pvm.Health.Status == helpers.PIInstanceHealthOk {
-			log.Printf("The health status is now ok")
-			//if *pvm.Status == "active" ; if *pvm.Addresses[0].IP == nil  {
-			return pvm, targetstatus, nil
-			//}
-		}
-
-		return pvm, helpers.PIInstanceHealthWarning, nil
-	}
-}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,18 +15,20 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMPISnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPISnapshotCreate,
-		Read:     resourceIBMPISnapshotRead,
-		Update:   resourceIBMPISnapshotUpdate,
-		Delete:   resourceIBMPISnapshotDelete,
-		Exists:   resourceIBMPISnapshotExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPISnapshotCreate,
+		ReadContext:   resourceIBMPISnapshotRead,
+		UpdateContext: resourceIBMPISnapshotUpdate,
+		DeleteContext: resourceIBMPISnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPISnapshot, importIDParts(helpers.PICloudInstanceId, "")),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -97,10 +100,10 @@ func resourceIBMPISnapshot() *schema.Resource {
 	}
 }
 
-func resourceIBMPISnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPISnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -128,33 +131,33 @@ func resourceIBMPISnapshotCreate(d *schema.ResourceData, meta interface{}) error
 
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", powerinstanceid, *snapshotResponse.SnapshotID))
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return fmt.Errorf("failed to get the snapshotid %v", err)
+		return diagFromErr(fmt.Errorf("failed to get the snapshotid %v", err))
 	}
 
 	pisnapclient := st.NewIBMPISnapshotClient(sess, powerinstanceid)
-	_, err = isWaitForPIInstanceSnapshotAvailable(pisnapclient, *snapshotResponse.SnapshotID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
+	_, err = isWaitForPIInstanceSnapshotAvailable(ctx, pisnapclient, *snapshotResponse.SnapshotID, d.Timeout(schema.TimeoutCreate), powerinstanceid)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMPISnapshotRead(d, meta)
+	return resourceIBMPISnapshotRead(ctx, d, meta)
 }
 
-func resourceIBMPISnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPISnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Calling the Snapshot Read function post create")
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	powerinstanceid := parts[0]
@@ -162,7 +165,11 @@ func resourceIBMPISnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	snapshotdata, err := snapshot.Get(parts[1], powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return err
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
 	}
 
 	d.Set(helpers.PISnapshotName, snapshotdata.Name)
@@ -175,13 +182,13 @@ func resourceIBMPISnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMPISnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPISnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Calling the IBM Power Snapshot  update call")
 	sess, _ := meta.(ClientSession).IBMPISession()
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := parts[0]
 	client := st.NewIBMPISnapshotClient(sess, powerinstanceid)
@@ -194,25 +201,25 @@ func resourceIBMPISnapshotUpdate(d *schema.ResourceData, meta interface{}) error
 		_, err := client.Update(parts[1], powerinstanceid, snapshotBody, 60)
 
 		if err != nil {
-			return fmt.Errorf("failed to update the snapshot request %v", err)
+			return diagFromErr(fmt.Errorf("failed to update the snapshot request %v", err))
 
 		}
 
-		_, err = isWaitForPIInstanceSnapshotAvailable(client, parts[1], d.Timeout(schema.TimeoutCreate), powerinstanceid)
+		_, err = isWaitForPIInstanceSnapshotAvailable(ctx, client, parts[1], d.Timeout(schema.TimeoutCreate), powerinstanceid)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return resourceIBMPISnapshotRead(d, meta)
+	return resourceIBMPISnapshotRead(ctx, d, meta)
 }
 
-func resourceIBMPISnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPISnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, _ := meta.(ClientSession).IBMPISession()
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	powerinstanceid := parts[0]
 
@@ -220,55 +227,25 @@ func resourceIBMPISnapshotDelete(d *schema.ResourceData, meta interface{}) error
 
 	snapshot, err := client.Get(parts[1], powerinstanceid, getTimeOut)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("The snapshot  to be deleted is in the following state .. %s", snapshot.Status)
 
 	snapshotdel_err := client.Delete(parts[1], powerinstanceid, deleteTimeOut)
 	if snapshotdel_err != nil {
-		return snapshotdel_err
+		return diagFromErr(snapshotdel_err)
 	}
 
-	_, err = isWaitForPIInstanceSnapshotDeleted(client, parts[1], d.Timeout(schema.TimeoutDelete), powerinstanceid)
+	_, err = isWaitForPIInstanceSnapshotDeleted(ctx, client, parts[1], d.Timeout(schema.TimeoutDelete), powerinstanceid)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
-func resourceIBMPISnapshotExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	sess, err := meta.(ClientSession).IBMPISession()
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	powerinstanceid := parts[0]
-	client := st.NewIBMPISnapshotClient(sess, powerinstanceid)
-
-	snapshotdelete, err := client.Get(parts[1], powerinstanceid, getTimeOut)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	log.Printf("Calling the existing function.. %s", *(snapshotdelete.SnapshotID))
-
-	volumeid := *snapshotdelete.SnapshotID
-	return volumeid == parts[1], nil
-}
-
-func isWaitForPIInstanceSnapshotAvailable(client *st.IBMPISnapshotClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForPIInstanceSnapshotAvailable(ctx context.Context, client *st.IBMPISnapshotClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
 	log.Printf("Waiting for PIInstance Snapshot (%s) to be available and active ", id)
 
@@ -281,7 +258,7 @@ func isWaitForPIInstanceSnapshotAvailable(client *st.IBMPISnapshotClient, id str
 		Timeout:    60 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isPIInstanceSnapshotRefreshFunc(client *st.IBMPISnapshotClient, id, powerinstanceid string) resource.StateRefreshFunc {
@@ -304,7 +281,7 @@ func isPIInstanceSnapshotRefreshFunc(client *st.IBMPISnapshotClient, id, powerin
 
 // Delete Snapshot
 
-func isWaitForPIInstanceSnapshotDeleted(client *st.IBMPISnapshotClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
+func isWaitForPIInstanceSnapshotDeleted(ctx context.Context, client *st.IBMPISnapshotClient, id string, timeout time.Duration, powerinstanceid string) (interface{}, error) {
 
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
@@ -317,7 +294,7 @@ func isWaitForPIInstanceSnapshotDeleted(client *st.IBMPISnapshotClient, id strin
 		Timeout:    10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isPIInstanceSnapshotDeleteRefreshFunc(client *st.IBMPISnapshotClient, id, powerinstanceid string) resource.StateRefreshFunc {
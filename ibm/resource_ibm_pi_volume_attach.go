@@ -4,13 +4,16 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,12 +27,14 @@ const (
 
 func resourceIBMPIVolumeAttach() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMPIVolumeAttachCreate,
-		Read:   resourceIBMPIVolumeAttachRead,
-		Update: resourceIBMPIVolumeAttachUpdate,
-		Delete: resourceIBMPIVolumeAttachDelete,
+		CreateContext: resourceIBMPIVolumeAttachCreate,
+		ReadContext:   resourceIBMPIVolumeAttachRead,
+		UpdateContext: resourceIBMPIVolumeAttachUpdate,
+		DeleteContext: resourceIBMPIVolumeAttachDelete,
 		//Exists:   resourceIBMPowerVolumeExists,
-		Importer: &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIVolumeAttachImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -78,10 +83,10 @@ func resourceIBMPIVolumeAttach() *schema.Resource {
 	}
 }
 
-func resourceIBMPIVolumeAttachCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).IBMPISession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	name := d.Get(helpers.PIVolumeAttachName).(string)
@@ -93,7 +98,7 @@ func resourceIBMPIVolumeAttachCreate(d *schema.ResourceData, meta interface{}) e
 	volinfo, err := client.Get(name, powerinstanceid, getTimeOut)
 
 	if err != nil {
-		return fmt.Errorf("The volume [ %s] cannot be attached since it's not available", name)
+		return diagFromErr(fmt.Errorf("The volume [ %s] cannot be attached since it's not available", name))
 	}
 	//log.Print("The volume info is %s", volinfo)
 
@@ -108,31 +113,31 @@ func resourceIBMPIVolumeAttachCreate(d *schema.ResourceData, meta interface{}) e
 
 	if volinfo.State == helpers.PIVolumeAllowableAttachStatus && *volinfo.Shareable == false {
 
-		return errors.New("The volume cannot be attached in the current state. The volume must be in the *available* state. No other states are permissible")
+		return diagFromErr(errors.New("The volume cannot be attached in the current state. The volume must be in the *available* state. No other states are permissible"))
 	}
 
 	resp, err := client.Attach(servername, name, powerinstanceid, attachVolumeTimeOut)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	log.Printf("Printing the resp %+v", resp)
 
 	d.SetId(*volinfo.VolumeID)
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return err
+		return diagFromErr(err)
 	}
 
-	_, err = isWaitForIBMPIVolumeAttachAvailable(client, d.Id(), powerinstanceid, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForIBMPIVolumeAttachAvailable(ctx, client, d.Id(), powerinstanceid, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	//return nil
-	return resourceIBMPIVolumeAttachRead(d, meta)
+	return resourceIBMPIVolumeAttachRead(ctx, d, meta)
 }
 
-func resourceIBMPIVolumeAttachRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeAttachRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, _ := meta.(ClientSession).IBMPISession()
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	servername := d.Get(helpers.PIInstanceName).(string)
@@ -141,7 +146,7 @@ func resourceIBMPIVolumeAttachRead(d *schema.ResourceData, meta interface{}) err
 
 	vol, err := client.CheckVolumeAttach(powerinstanceid, servername, d.Id(), getTimeOut)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	//d.SetId(vol.ID.String())
@@ -151,7 +156,21 @@ func resourceIBMPIVolumeAttachRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMPIVolumeAttachUpdate(d *schema.ResourceData, meta interface{}) error {
+// resourceIBMPIVolumeAttachImport imports a volume attachment from the ID <pi_cloud_instance_id>/<pi_instance_name>/<volume_id>,
+// the volume is read from the instance it is attached to
+func resourceIBMPIVolumeAttachImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	args, err := importIDParts(helpers.PICloudInstanceId, helpers.PIInstanceName, "")(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range args {
+		d.Set(k, v)
+	}
+	d.SetId(d.Id()[strings.LastIndex(d.Id(), "/")+1:])
+	return importStateWithRead(resourceIBMPIVolumeAttach, nil)(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeAttachUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, _ := meta.(ClientSession).IBMPISession()
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -167,18 +186,18 @@ func resourceIBMPIVolumeAttachUpdate(d *schema.ResourceData, meta interface{}) e
 
 	volrequest, err := client.Update(d.Id(), name, size, shareable, powerinstanceid, postTimeOut)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	_, err = isWaitForIBMPIVolumeAttachAvailable(client, *volrequest.VolumeID, powerinstanceid, d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForIBMPIVolumeAttachAvailable(ctx, client, *volrequest.VolumeID, powerinstanceid, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMPIVolumeRead(d, meta)
+	return resourceIBMPIVolumeRead(ctx, d, meta)
 }
 
-func resourceIBMPIVolumeAttachDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPIVolumeAttachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, _ := meta.(ClientSession).IBMPISession()
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
@@ -189,7 +208,7 @@ func resourceIBMPIVolumeAttachDelete(d *schema.ResourceData, meta interface{}) e
 	log.Printf("the id of the volume to detach is%s ", d.Id())
 	_, err := client.Detach(servername, name, powerinstanceid, deleteTimeOut)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// wait for power volume states to be back as available. if it's attached it will be in-use
@@ -197,7 +216,7 @@ func resourceIBMPIVolumeAttachDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func isWaitForIBMPIVolumeAttachAvailable(client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
+func isWaitForIBMPIVolumeAttachAvailable(ctx context.Context, client *st.IBMPIVolumeClient, id, powerinstanceid string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available for attachment", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -209,7 +228,7 @@ func isWaitForIBMPIVolumeAttachAvailable(client *st.IBMPIVolumeClient, id, power
 		Timeout:    10 * time.Minute,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isIBMPIVolumeAttachRefreshFunc(client *st.IBMPIVolumeClient, id, powerinstanceid string) resource.StateRefreshFunc {
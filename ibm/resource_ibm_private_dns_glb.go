@@ -4,12 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -34,12 +36,13 @@ const (
 
 func resourceIBMPrivateDNSGLB() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSGLBCreate,
-		Read:     resourceIBMPrivateDNSGLBRead,
-		Update:   resourceIBMPrivateDNSGLBUpdate,
-		Delete:   resourceIBMPrivateDNSGLBDelete,
-		Exists:   resourceIBMPrivateDNSGLBExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSGLBCreate,
+		ReadContext:   resourceIBMPrivateDNSGLBRead,
+		UpdateContext: resourceIBMPrivateDNSGLBUpdate,
+		DeleteContext: resourceIBMPrivateDNSGLBDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPrivateDNSGLB, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -143,10 +146,10 @@ func resourceIBMPrivateDNSGLB() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSGLBCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	zoneID := d.Get(pdnsZoneID).(string)
@@ -170,7 +173,7 @@ func resourceIBMPrivateDNSGLBCreate(d *schema.ResourceData, meta interface{}) er
 	if AZpools, ok := d.GetOk(pdnsGLBAZPools); ok {
 		expandedAzpools, err := expandPDNSGlbAZPools(AZpools)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		createlbOptions.SetAzPools(expandedAzpools)
 	}
@@ -178,24 +181,29 @@ func resourceIBMPrivateDNSGLBCreate(d *schema.ResourceData, meta interface{}) er
 	result, resp, err := sess.CreateLoadBalancer(createlbOptions)
 	if err != nil {
 		log.Printf("create global load balancer failed %s", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *result.ID))
-	return resourceIBMPrivateDNSGLBRead(d, meta)
+	return resourceIBMPrivateDNSGLBRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	idset := strings.Split(d.Id(), "/")
 
 	getlbOptions := sess.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
 	presponse, resp, err := sess.GetLoadBalancer(getlbOptions)
 	if err != nil {
-		return fmt.Errorf("Error fetching pdns GLB :%s\n%s", err, resp)
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("Get GLB failed with status code 404")
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error fetching pdns GLB :%s\n%s", err, resp))
 	}
 
 	response := *presponse
@@ -215,10 +223,10 @@ func resourceIBMPrivateDNSGLBRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMPrivateDNSGLBUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
@@ -250,58 +258,39 @@ func resourceIBMPrivateDNSGLBUpdate(d *schema.ResourceData, meta interface{}) er
 		if AZpools, ok := d.GetOk(pdnsGLBAZPools); ok {
 			expandedAzpools, err := expandPDNSGlbAZPools(AZpools)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			updatelbOptions.SetAzPools(expandedAzpools)
 		}
 
 		_, detail, err := sess.UpdateLoadBalancer(updatelbOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating pdns GLB :%s\n%s", err, detail)
+			return diagFromErr(fmt.Errorf("Error updating pdns GLB :%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSGLBRead(d, meta)
+	return resourceIBMPrivateDNSGLBRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
 	deletelbOptions := sess.NewDeleteLoadBalancerOptions(idset[0], idset[1], idset[2])
 	response, err := sess.DeleteLoadBalancer(deletelbOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting pdns GLB :%s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error deleting pdns GLB :%s\n%s", err, response))
 	}
-	_, err = isWaitForLoadBalancerDeleted(sess, d, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForLoadBalancerDeleted(ctx, sess, d, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	return nil
 }
 
-func resourceIBMPrivateDNSGLBExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := meta.(ClientSession).PrivateDNSClientSession()
-	if err != nil {
-		return false, err
-	}
-	idset := strings.Split(d.Id(), "/")
-	getlbOptions := sess.NewGetLoadBalancerOptions(idset[0], idset[1], idset[2])
-	_, detail, err := sess.GetLoadBalancer(getlbOptions)
-	if err != nil {
-		if detail != nil && detail.StatusCode == 404 {
-			log.Printf("Get GLB failed with status code 404: %v", detail)
-			return false, nil
-		}
-		log.Printf("Get GLB failed: %v", detail)
-		return false, err
-	}
-	return true, nil
-}
-
 func expandPDNSGlbAZPools(azpool interface{}) ([]dnssvcsv1.LoadBalancerAzPoolsItem, error) {
 	azpools := azpool.(*schema.Set).List()
 	expandAZpools := make([]dnssvcsv1.LoadBalancerAzPoolsItem, 0)
@@ -338,7 +327,7 @@ func suppressPDNSGlbNameDiff(k, old, new string, d *schema.ResourceData) bool {
 	return false
 }
 
-func isWaitForLoadBalancerDeleted(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
+func isWaitForLoadBalancerDeleted(ctx context.Context, LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData, timeout time.Duration) (interface{}, error) {
 	idset := strings.Split(d.Id(), "/")
 	log.Printf("Waiting for PDNS GLB (%s) to be deleted.", idset[2])
 	stateConf := &resource.StateChangeConf{
@@ -350,7 +339,7 @@ func isWaitForLoadBalancerDeleted(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.R
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isVLoadBalancerDeleteRefreshFunc(LoadBalancer *dnssvcsv1.DnsSvcsV1, d *schema.ResourceData) resource.StateRefreshFunc {
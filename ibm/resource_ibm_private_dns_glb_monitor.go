@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -36,12 +38,13 @@ const (
 
 func resourceIBMPrivateDNSGLBMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSGLBMonitorCreate,
-		Read:     resourceIBMPrivateDNSGLBMonitorRead,
-		Update:   resourceIBMPrivateDNSGLBMonitorUpdate,
-		Delete:   resourceIBMPrivateDNSGLBMonitorDelete,
-		Exists:   resourceIBMPrivateDNSGLBMonitorExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSGLBMonitorCreate,
+		ReadContext:   resourceIBMPrivateDNSGLBMonitorRead,
+		UpdateContext: resourceIBMPrivateDNSGLBMonitorUpdate,
+		DeleteContext: resourceIBMPrivateDNSGLBMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPrivateDNSGLBMonitor, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -217,10 +220,10 @@ func resourceIBMPrivateDNSGLBMonitorValidator() *ResourceValidator {
 	return &dnsMonitorValidator
 }
 
-func resourceIBMPrivateDNSGLBMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	instanceID := d.Get(pdnsInstanceID).(string)
 	createMonitorOptions := sess.NewCreateMonitorOptions(instanceID)
@@ -257,7 +260,7 @@ func resourceIBMPrivateDNSGLBMonitorCreate(d *schema.ResourceData, meta interfac
 	if monitorheaders, ok := d.GetOk(pdnsGlbMonitorHeaders); ok {
 		expandedmonitorheaders, err := expandPDNSGLBMonitorsHeader(monitorheaders)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		createMonitorOptions.SetHeadersVar(expandedmonitorheaders)
 	}
@@ -267,11 +270,11 @@ func resourceIBMPrivateDNSGLBMonitorCreate(d *schema.ResourceData, meta interfac
 
 	response, detail, err := sess.CreateMonitor(createMonitorOptions)
 	if err != nil {
-		return fmt.Errorf("Error creating pdns GLB monitor:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error creating pdns GLB monitor:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", instanceID, *response.ID))
-	return resourceIBMPrivateDNSGLBMonitorRead(d, meta)
+	return resourceIBMPrivateDNSGLBMonitorRead(ctx, d, meta)
 }
 
 func expandPDNSGLBMonitorsHeader(header interface{}) ([]dnssvcsv1.HealthcheckHeader, error) {
@@ -290,17 +293,21 @@ func expandPDNSGLBMonitorsHeader(header interface{}) ([]dnssvcsv1.HealthcheckHea
 	return expandheaders, nil
 }
 
-func resourceIBMPrivateDNSGLBMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	idset := strings.Split(d.Id(), "/")
 
 	getMonitorOptions := sess.NewGetMonitorOptions(idset[0], idset[1])
 	response, detail, err := sess.GetMonitor(getMonitorOptions)
 	if err != nil {
-		return fmt.Errorf("Error fetching pdns GLB Monitor:%s\n%s", err, detail)
+		if detail != nil && detail.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error fetching pdns GLB Monitor:%s\n%s", err, detail))
 	}
 	d.Set(pdnsInstanceID, idset[0])
 	d.Set(pdnsGlbMonitorID, response.ID)
@@ -355,10 +362,10 @@ func flattenDataSourceLoadBalancerHeader(header []dnssvcsv1.HealthcheckHeader) i
 	return flattened
 }
 
-func resourceIBMPrivateDNSGLBMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
@@ -411,7 +418,7 @@ func resourceIBMPrivateDNSGLBMonitorUpdate(d *schema.ResourceData, meta interfac
 		if monitorheaders, ok := d.GetOk(pdnsGlbMonitorHeaders); ok {
 			expandedmonitorheaders, err := expandPDNSGLBMonitorsHeader(monitorheaders)
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			updateMonitorOptions.SetHeadersVar(expandedmonitorheaders)
 		}
@@ -422,17 +429,17 @@ func resourceIBMPrivateDNSGLBMonitorUpdate(d *schema.ResourceData, meta interfac
 		_, detail, err := sess.UpdateMonitor(updateMonitorOptions)
 
 		if err != nil {
-			return fmt.Errorf("Error updating pdns GLB Monitor:%s\n%s", err, detail)
+			return diagFromErr(fmt.Errorf("Error updating pdns GLB Monitor:%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSGLBMonitorRead(d, meta)
+	return resourceIBMPrivateDNSGLBMonitorRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSGLBMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSGLBMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idset := strings.Split(d.Id(), "/")
@@ -441,28 +448,9 @@ func resourceIBMPrivateDNSGLBMonitorDelete(d *schema.ResourceData, meta interfac
 	response, err := sess.DeleteMonitor(DeleteMonitorOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error deleting pdns GLB Monitor:%s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error deleting pdns GLB Monitor:%s\n%s", err, response))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMPrivateDNSGLBMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := meta.(ClientSession).PrivateDNSClientSession()
-	if err != nil {
-		return false, err
-	}
-
-	idset := strings.Split(d.Id(), "/")
-
-	getMonitorOptions := sess.NewGetMonitorOptions(idset[0], idset[1])
-	response, detail, err := sess.GetMonitor(getMonitorOptions)
-	if err != nil {
-		if response != nil && detail != nil && detail.StatusCode == 404 {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
-}
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,11 +29,12 @@ var allowedNetworkTypes = []string{
 
 func resourceIBMPrivateDNSPermittedNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSPermittedNetworkCreate,
-		Read:     resourceIBMPrivateDNSPermittedNetworkRead,
-		Delete:   resourceIBMPrivateDNSPermittedNetworkDelete,
-		Exists:   resourceIBMPrivateDNSPermittedNetworkExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSPermittedNetworkCreate,
+		ReadContext:   resourceIBMPrivateDNSPermittedNetworkRead,
+		DeleteContext: resourceIBMPrivateDNSPermittedNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPrivateDNSPermittedNetwork, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -96,10 +99,10 @@ func resourceIBMPrivateDNSPermittedNetwork() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Get(pdnsInstanceID).(string)
@@ -113,25 +116,25 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	createPermittedNetworkOptions := sess.NewCreatePermittedNetworkOptions(instanceID, zoneID)
 	permittedNetworkCrn, err := sess.NewPermittedNetworkVpc(vpcCRN)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	createPermittedNetworkOptions.SetPermittedNetwork(permittedNetworkCrn)
 	createPermittedNetworkOptions.SetType(nwType)
 	response, detail, err := sess.CreatePermittedNetwork(createPermittedNetworkOptions)
 	if err != nil {
-		return fmt.Errorf("Error creating pdns permitted network:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error creating pdns permitted network:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
 
-	return resourceIBMPrivateDNSPermittedNetworkRead(d, meta)
+	return resourceIBMPrivateDNSPermittedNetworkRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -139,7 +142,11 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 	response, detail, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error reading pdns permitted network:%s\n%s", err, detail)
+		if detail != nil && detail.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error reading pdns permitted network:%s\n%s", err, detail))
 	}
 
 	d.Set(pdnsInstanceID, idSet[0])
@@ -154,10 +161,10 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -168,30 +175,9 @@ func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta in
 	_, response, err := sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("Error deleting pdns permitted network:%s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error deleting pdns permitted network:%s\n%s", err, response))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMPrivateDNSPermittedNetworkExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := meta.(ClientSession).PrivateDNSClientSession()
-	if err != nil {
-		return false, err
-	}
-
-	idSet := strings.Split(d.Id(), "/")
-	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
-	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
-}
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -37,12 +39,13 @@ func caseDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
 
 func resourceIBMPrivateDNSResourceRecord() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSResourceRecordCreate,
-		Read:     resourceIBMPrivateDNSResourceRecordRead,
-		Update:   resourceIBMPrivateDNSResourceRecordUpdate,
-		Delete:   resourceIBMPrivateDNSResourceRecordDelete,
-		Exists:   resourceIBMPrivateDNSResourceRecordExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSResourceRecordCreate,
+		ReadContext:   resourceIBMPrivateDNSResourceRecordRead,
+		UpdateContext: resourceIBMPrivateDNSResourceRecordUpdate,
+		DeleteContext: resourceIBMPrivateDNSResourceRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPrivateDNSResourceRecord, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -185,10 +188,10 @@ func resourceIBMPrivateDNSResourceRecord() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var (
@@ -225,31 +228,31 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	case "A":
 		resourceRecordAData, err := sess.NewResourceRecordInputRdataRdataARecord(rdata)
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record A data:%s", err)
+			return diagFromErr(fmt.Errorf("Error creating pdns resource record A data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordAData)
 	case "AAAA":
 		resourceRecordAaaaData, err := sess.NewResourceRecordInputRdataRdataAaaaRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Aaaa data:%s", err)
+			return diagFromErr(fmt.Errorf("Error creating pdns resource record Aaaa data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordAaaaData)
 	case "CNAME":
 		resourceRecordCnameData, err := sess.NewResourceRecordInputRdataRdataCnameRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Cname data:%s", err)
+			return diagFromErr(fmt.Errorf("Error creating pdns resource record Cname data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordCnameData)
 	case "PTR":
 		resourceRecordPtrData, err := sess.NewResourceRecordInputRdataRdataPtrRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Ptr data:%s", err)
+			return diagFromErr(fmt.Errorf("Error creating pdns resource record Ptr data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordPtrData)
 	case "TXT":
 		resourceRecordTxtData, err := sess.NewResourceRecordInputRdataRdataTxtRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Txt data:%s", err)
+			return diagFromErr(fmt.Errorf("Error creating pdns resource record Txt data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordTxtData)
 	case "MX":
@@ -258,7 +261,7 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 		}
 		resourceRecordMxData, err := sess.NewResourceRecordInputRdataRdataMxRecord(rdata, int64(preference))
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Mx data:%s", err)
+			return diagFromErr(fmt.Errorf("Error creating pdns resource record Mx data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordMxData)
 	case "SRV":
@@ -273,7 +276,7 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 		}
 		resourceRecordSrvData, err := sess.NewResourceRecordInputRdataRdataSrvRecord(int64(port), int64(priority), rdata, int64(weight))
 		if err != nil {
-			return fmt.Errorf("Error creating pdns resource record Srv data:%s", err)
+			return diagFromErr(fmt.Errorf("Error creating pdns resource record Srv data:%s", err))
 		}
 		if v, ok := d.GetOk(pdnsSrvService); ok {
 			service = v.(string)
@@ -290,24 +293,28 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	defer ibmMutexKV.Unlock(mk)
 	response, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("Error creating pdns resource record:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error creating pdns resource record:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
 
-	return resourceIBMPrivateDNSResourceRecordRead(d, meta)
+	return resourceIBMPrivateDNSResourceRecordRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	response, detail, err := sess.GetResourceRecord(getResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("Error reading pdns resource record:%s\n%s", err, detail)
+		if detail != nil && detail.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error reading pdns resource record:%s\n%s", err, detail))
 	}
 
 	// extract the record name by removing zone details
@@ -367,12 +374,12 @@ func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
 
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1]
@@ -401,7 +408,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordAData, err := sess.NewResourceRecordUpdateInputRdataRdataARecord(rdata)
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record A data:%s", err)
+				return diagFromErr(fmt.Errorf("Error creating pdns resource record A data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordAData)
 
@@ -410,7 +417,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordAaaaData, err := sess.NewResourceRecordUpdateInputRdataRdataAaaaRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record Aaaa data:%s", err)
+				return diagFromErr(fmt.Errorf("Error creating pdns resource record Aaaa data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordAaaaData)
 
@@ -419,7 +426,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordCnameData, err := sess.NewResourceRecordUpdateInputRdataRdataCnameRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record Cname data:%s", err)
+				return diagFromErr(fmt.Errorf("Error creating pdns resource record Cname data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordCnameData)
 
@@ -431,7 +438,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordTxtData, err := sess.NewResourceRecordUpdateInputRdataRdataTxtRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record Txt data:%s", err)
+				return diagFromErr(fmt.Errorf("Error creating pdns resource record Txt data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordTxtData)
 
@@ -442,7 +449,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 			resourceRecordMxData, err := sess.NewResourceRecordUpdateInputRdataRdataMxRecord(rdata, int64(preference))
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record Mx data:%s", err)
+				return diagFromErr(fmt.Errorf("Error creating pdns resource record Mx data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordMxData)
 
@@ -455,7 +462,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 			resourceRecordSrvData, err := sess.NewResourceRecordUpdateInputRdataRdataSrvRecord(int64(port), int64(priority), rdata, int64(weight))
 			if err != nil {
-				return fmt.Errorf("Error creating pdns resource record Srv data:%s", err)
+				return diagFromErr(fmt.Errorf("Error creating pdns resource record Srv data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordSrvData)
 
@@ -467,19 +474,19 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 		_, detail, err := sess.UpdateResourceRecord(updateResourceRecordOptions)
 		if err != nil {
-			return fmt.Errorf("Error updating pdns resource record:%s\n%s", err, detail)
+			return diagFromErr(fmt.Errorf("Error updating pdns resource record:%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSResourceRecordRead(d, meta)
+	return resourceIBMPrivateDNSResourceRecordRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
 
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	deleteResourceRecordOptions := sess.NewDeleteResourceRecordOptions(idSet[0], idSet[1], idSet[2])
@@ -488,35 +495,13 @@ func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta inte
 	defer ibmMutexKV.Unlock(mk)
 	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting pdns resource record:%s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error deleting pdns resource record:%s\n%s", err, response))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMPrivateDNSResourceRecordExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := meta.(ClientSession).PrivateDNSClientSession()
-	if err != nil {
-		return false, err
-	}
-
-	idSet := strings.Split(d.Id(), "/")
-	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
-	mk := "private_dns_resource_record_" + idSet[0] + idSet[1]
-	ibmMutexKV.Lock(mk)
-	defer ibmMutexKV.Unlock(mk)
-	_, response, err := sess.GetResourceRecord(getResourceRecordOptions)
-
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
-}
-
 func suppressPDNSRecordNameDiff(k, old, new string, d *schema.ResourceData) bool {
 	// PDNS concantenates name with domain. So just check name is the same
 	if strings.ToUpper(strings.SplitN(old, ".", 2)[0]) == strings.ToUpper(strings.SplitN(new, ".", 2)[0]) {
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,12 +26,13 @@ const (
 
 func resourceIBMPrivateDNSZone() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSZoneCreate,
-		Read:     resourceIBMPrivateDNSZoneRead,
-		Update:   resourceIBMPrivateDNSZoneUpdate,
-		Delete:   resourceIBMPrivateDNSZoneDelete,
-		Exists:   resourceIBMPrivateDNSZoneExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSZoneCreate,
+		ReadContext:   resourceIBMPrivateDNSZoneRead,
+		UpdateContext: resourceIBMPrivateDNSZoneUpdate,
+		DeleteContext: resourceIBMPrivateDNSZoneDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMPrivateDNSZone, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -93,10 +96,10 @@ func resourceIBMPrivateDNSZone() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSZoneCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var (
@@ -120,26 +123,30 @@ func resourceIBMPrivateDNSZoneCreate(d *schema.ResourceData, meta interface{}) e
 	createZoneOptions.SetLabel(zoneLabel)
 	response, detail, err := sess.CreateDnszone(createZoneOptions)
 	if err != nil {
-		return fmt.Errorf("Error creating pdns zone:%s\n%s", err, detail)
+		return diagFromErr(fmt.Errorf("Error creating pdns zone:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", *response.InstanceID, *response.ID))
 	d.Set(pdnsZoneID, *response.ID)
 
-	return resourceIBMPrivateDNSZoneRead(d, meta)
+	return resourceIBMPrivateDNSZoneRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSZoneRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 	getZoneOptions := sess.NewGetDnszoneOptions(idSet[0], idSet[1])
 	response, detail, err := sess.GetDnszone(getZoneOptions)
 	if err != nil {
-		return fmt.Errorf("Error fetching pdns zone:%s\n%s", err, detail)
+		if detail != nil && detail.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error fetching pdns zone:%s\n%s", err, detail))
 	}
 
 	d.Set(pdnsZoneID, response.ID)
@@ -154,10 +161,10 @@ func resourceIBMPrivateDNSZoneRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMPrivateDNSZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -166,7 +173,7 @@ func resourceIBMPrivateDNSZoneUpdate(d *schema.ResourceData, meta interface{}) e
 	getZoneOptions := sess.NewGetDnszoneOptions(idSet[0], idSet[1])
 	_, response, err := sess.GetDnszone(getZoneOptions)
 	if err != nil {
-		return fmt.Errorf("Error fetching pdns zone:%s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error fetching pdns zone:%s\n%s", err, response))
 	}
 
 	// Update DNS zone if attributes has any change
@@ -181,17 +188,17 @@ func resourceIBMPrivateDNSZoneUpdate(d *schema.ResourceData, meta interface{}) e
 		_, detail, err := sess.UpdateDnszone(updateZoneOptions)
 
 		if err != nil {
-			return fmt.Errorf("Error updating pdns zone:%s\n%s", err, detail)
+			return diagFromErr(fmt.Errorf("Error updating pdns zone:%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSZoneRead(d, meta)
+	return resourceIBMPrivateDNSZoneRead(ctx, d, meta)
 }
 
-func resourceIBMPrivateDNSZoneDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
@@ -199,28 +206,9 @@ func resourceIBMPrivateDNSZoneDelete(d *schema.ResourceData, meta interface{}) e
 	deleteZoneOptions := sess.NewDeleteDnszoneOptions(idSet[0], idSet[1])
 	response, err := sess.DeleteDnszone(deleteZoneOptions)
 	if err != nil {
-		return fmt.Errorf("Error deleting pdns zone:%s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error deleting pdns zone:%s\n%s", err, response))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMPrivateDNSZoneExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	sess, err := meta.(ClientSession).PrivateDNSClientSession()
-	if err != nil {
-		return false, err
-	}
-
-	idSet := strings.Split(d.Id(), "/")
-	getZoneOptions := sess.NewGetDnszoneOptions(idSet[0], idSet[1])
-	_, response, err := sess.GetDnszone(getZoneOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
-}
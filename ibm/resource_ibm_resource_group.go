@@ -4,21 +4,24 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceGroupCreate,
-		Read:     resourceIBMResourceGroupRead,
-		Update:   resourceIBMResourceGroupUpdate,
-		Delete:   resourceIBMResourceGroupDelete,
-		Exists:   resourceIBMResourceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMResourceGroupCreate,
+		ReadContext:   resourceIBMResourceGroupRead,
+		UpdateContext: resourceIBMResourceGroupUpdate,
+		DeleteContext: resourceIBMResourceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMResourceGroup, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -89,16 +92,16 @@ func resourceIBMResourceGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get("name").(string)
 
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	accountID := userDetails.userAccount
 
@@ -109,18 +112,18 @@ func resourceIBMResourceGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 	resourceGroup, resp, err := rMgtClient.CreateResourceGroup(&resourceGroupCreate)
 	if err != nil {
-		return fmt.Errorf("Error creating resource group: %s with responce code  %s", err, resp)
+		return diagFromErr(fmt.Errorf("Error creating resource group: %s with responce code  %s", err, resp))
 	}
 
 	d.SetId(*resourceGroup.ID)
 
-	return resourceIBMResourceGroupRead(d, meta)
+	return resourceIBMResourceGroupRead(ctx, d, meta)
 }
 
-func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	resourceGroupID := d.Id()
 	resourceGroupGet := rg.GetResourceGroupOptions{
@@ -134,7 +137,7 @@ func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving resource group: %swith responce code  %s", err, resp)
+		return diagFromErr(fmt.Errorf("Error retrieving resource group: %swith responce code  %s", err, resp))
 	}
 
 	d.Set("name", *resourceGroup.Name)
@@ -177,10 +180,10 @@ func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	resourceGroupID := d.Id()
@@ -197,17 +200,17 @@ func resourceIBMResourceGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	if hasChange {
 		_, resp, err := rMgtClient.UpdateResourceGroup(&resourceGroupUpdate)
 		if err != nil {
-			return fmt.Errorf("Error updating resource group: %s with responce code  %s", err, resp)
+			return diagFromErr(fmt.Errorf("Error updating resource group: %s with responce code  %s", err, resp))
 		}
 
 	}
-	return resourceIBMResourceGroupRead(d, meta)
+	return resourceIBMResourceGroupRead(ctx, d, meta)
 }
 
-func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rMgtClient, err := meta.(ClientSession).ResourceManagerV2API()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	resourceGroupID := d.Id()
@@ -221,31 +224,10 @@ func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 			log.Printf("[WARN] Resource Group is not found")
 			return nil
 		}
-		return fmt.Errorf("Error Deleting resource group: %s with responce code  %s", err, resp)
+		return diagFromErr(fmt.Errorf("Error Deleting resource group: %s with responce code  %s", err, resp))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMResourceGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	rMgtClient, err := meta.(ClientSession).ResourceManagerV2API()
-	if err != nil {
-		return false, err
-	}
-	resourceGroupID := d.Id()
-	resourceGroupGet := rg.GetResourceGroupOptions{
-		ID: &resourceGroupID,
-	}
-
-	resourceGroup, resp, err := rMgtClient.GetResourceGroup(&resourceGroupGet)
-	if err != nil {
-		if resp != nil && resp.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s with responce code  %s", err, resp)
-	}
-
-	return *resourceGroup.ID == resourceGroupID, nil
-}
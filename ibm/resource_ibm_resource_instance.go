@@ -7,11 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,12 +33,13 @@ const (
 
 func resourceIBMResourceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceInstanceCreate,
-		Read:     resourceIBMResourceInstanceRead,
-		Update:   resourceIBMResourceInstanceUpdate,
-		Delete:   resourceIBMResourceInstanceDelete,
-		Exists:   resourceIBMResourceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMResourceInstanceCreate,
+		ReadContext:   resourceIBMResourceInstanceRead,
+		UpdateContext: resourceIBMResourceInstanceUpdate,
+		DeleteContext: resourceIBMResourceInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMResourceInstance, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -48,7 +49,7 @@ func resourceIBMResourceInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -100,6 +101,7 @@ func resourceIBMResourceInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      resourceIBMVPCHash,
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			"status": {
 				Type:        schema.TypeString,
@@ -169,10 +171,10 @@ func resourceIBMResourceInstance() *schema.Resource {
 	}
 }
 
-func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	serviceName := d.Get("service").(string)
 	plan := d.Get("plan").(string)
@@ -185,35 +187,35 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	if metadata, ok := serviceOff[0].Metadata.(*models.ServiceResourceMetadata); ok {
 		if !metadata.Service.RCProvisionable {
-			return fmt.Errorf("%s cannot be provisioned by resource controller", serviceName)
+			return diagFromErr(fmt.Errorf("%s cannot be provisioned by resource controller", serviceName))
 		}
 	} else {
-		return fmt.Errorf("Cannot create instance of resource %s\nUse 'ibm_service_instance' if the resource is a Cloud Foundry service", serviceName)
+		return diagFromErr(fmt.Errorf("Cannot create instance of resource %s\nUse 'ibm_service_instance' if the resource is a Cloud Foundry service", serviceName))
 	}
 
 	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	rsInst.ServicePlanID = servicePlan
 
 	deployments, err := rsCatRepo.ListDeployments(servicePlan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err)
+		return diagFromErr(fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err))
 	}
 	if len(deployments) == 0 {
-		return fmt.Errorf("No deployment found for service plan : %s", plan)
+		return diagFromErr(fmt.Errorf("No deployment found for service plan : %s", plan))
 	}
 	deployments, supportedLocations := filterDeployments(deployments, location)
 
@@ -222,7 +224,7 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		for l := range supportedLocations {
 			locationList = append(locationList, l)
 		}
-		return fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.\nUse 'ibm_service_instance' if the service is a Cloud Foundry service.", plan, location, locationList)
+		return diagFromErr(fmt.Errorf("No deployment found for service plan %s at location %s.\nValid location(s) are: %q.\nUse 'ibm_service_instance' if the service is a Cloud Foundry service.", plan, location, locationList))
 	}
 
 	rsInst.TargetCrn = deployments[0].CatalogCRN
@@ -232,7 +234,7 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 	} else {
 		defaultRg, err := defaultResourceGroup(meta)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		rsInst.ResourceGroupID = defaultRg
 	}
@@ -270,20 +272,19 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	instance, err := rsConClient.ResourceServiceInstance().CreateInstance(rsInst)
 	if err != nil {
-		return fmt.Errorf("Error creating resource instance: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating resource instance: %s", err))
 	}
 
 	d.SetId(instance.ID)
 
-	_, err = waitForResourceInstanceCreate(d, meta)
+	_, err = waitForResourceInstanceCreate(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk("tags"); ok || v != "" {
-		oldList, newList := d.GetChange("tags")
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, instance.Crn.String())
 		if err != nil {
 			log.Printf(
@@ -291,20 +292,24 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return resourceIBMResourceInstanceRead(d, meta)
+	return resourceIBMResourceInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Id()
 
 	instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving resource instance: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving resource instance: %s", err))
 	}
 
 	tags, err := GetTagsUsingCRN(meta, instance.Crn.String())
@@ -312,7 +317,7 @@ func resourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 		log.Printf(
 			"Error on get of resource instance tags (%s) tags: %s", d.Id(), err)
 	}
-	d.Set("tags", tags)
+	setResourceTags(d, meta, tags)
 	d.Set("name", instance.Name)
 	d.Set("status", instance.State)
 	d.Set("resource_group_id", instance.ResourceGroupID)
@@ -322,13 +327,13 @@ func resourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	rsCatRepo := rsCatClient.ResourceCatalog()
 
 	serviceOff, err := rsCatRepo.GetServiceName(instance.ServiceID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	d.Set("service", serviceOff)
@@ -340,13 +345,13 @@ func resourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	rcontroller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set(ResourceControllerURL, rcontroller+"/services/")
 
 	servicePlan, err := rsCatRepo.GetServicePlanName(instance.ServicePlanID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	d.Set("plan", servicePlan)
 	d.Set("guid", instance.Guid)
@@ -364,10 +369,10 @@ func resourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Id()
@@ -382,18 +387,18 @@ func resourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		service := d.Get("service").(string)
 		rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		rsCatRepo := rsCatClient.ResourceCatalog()
 
 		serviceOff, err := rsCatRepo.FindByName(service, true)
 		if err != nil {
-			return fmt.Errorf("Error retrieving service offering: %s", err)
+			return diagFromErr(newAttributeError("plan", "Error retrieving service offering", err))
 		}
 
 		servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
 		if err != nil {
-			return fmt.Errorf("Error retrieving plan: %s", err)
+			return diagFromErr(newAttributeError("plan", "Error retrieving plan", err))
 		}
 
 		updateReq.ServicePlanID = servicePlan
@@ -409,7 +414,7 @@ func resourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("parameters") {
 		instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
 		if err != nil {
-			return fmt.Errorf("Error retrieving resource instance: %s", err)
+			return diagFromErr(newAttributeError("parameters", "Error retrieving resource instance", err))
 		}
 
 		if parameters, ok := d.GetOk("parameters"); ok {
@@ -449,11 +454,11 @@ func resourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
 	if err != nil {
-		return fmt.Errorf("Error Getting resource instance: %s", err)
+		return diagFromErr(fmt.Errorf("Error Getting resource instance: %s", err))
 	}
 
-	if d.HasChange("tags") {
-		oldList, newList := d.GetChange(isVPCTags)
+	if d.HasChange("tags_all") {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, instance.Crn.String())
 		if err != nil {
 			log.Printf(
@@ -463,61 +468,41 @@ func resourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	_, err = rsConClient.ResourceServiceInstance().UpdateInstance(instanceID, updateReq)
 	if err != nil {
-		return fmt.Errorf("Error updating resource instance: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating resource instance: %s", err))
 	}
 
-	_, err = waitForResourceInstanceUpdate(d, meta)
+	_, err = waitForResourceInstanceUpdate(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMResourceInstanceRead(d, meta)
+	return resourceIBMResourceInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 
 	err = rsConClient.ResourceServiceInstance().DeleteInstance(id, true)
 	if err != nil {
-		return fmt.Errorf("Error deleting resource instance: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting resource instance: %s", err))
 	}
 
-	_, err = waitForResourceInstanceDelete(d, meta)
+	_, err = waitForResourceInstanceDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-func resourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
-	if err != nil {
-		return false, err
-	}
-	instanceID := d.Id()
-
-	instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return instance.ID == instanceID, nil
-}
-
-func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
 		return false, err
@@ -545,10 +530,10 @@ func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
 		return false, err
@@ -576,10 +561,10 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForResourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
 		return false, err
@@ -606,7 +591,7 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func filterDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/api/iampap/iampapv2"
@@ -21,12 +23,13 @@ import (
 
 func resourceIBMResourceKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceKeyCreate,
-		Read:     resourceIBMResourceKeyRead,
-		Update:   resourceIBMResourceKeyUpdate,
-		Delete:   resourceIBMResourceKeyDelete,
-		Exists:   resourceIBMResourceKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMResourceKeyCreate,
+		ReadContext:   resourceIBMResourceKeyRead,
+		UpdateContext: resourceIBMResourceKeyUpdate,
+		DeleteContext: resourceIBMResourceKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMResourceKey, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -101,10 +104,10 @@ func resourceIBMResourceKey() *schema.Resource {
 	}
 }
 
-func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsContClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get("name").(string)
 	role := d.Get("role").(string)
@@ -119,7 +122,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if instanceID == "" && aliasID == "" {
-		return fmt.Errorf("Provide either `resource_instance_id` or `resource_alias_id`")
+		return diagFromErr(fmt.Errorf("Provide either `resource_instance_id` or `resource_alias_id`"))
 	}
 
 	var keyParams map[string]interface{}
@@ -142,24 +145,24 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 
 	resourceInstance, sourceCRN, err := getResourceInstanceAndCRN(d, meta)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating resource key: %s", err))
 	}
 
 	serviceID := resourceInstance.ServiceID
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return fmt.Errorf("Error creating resource key: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating resource key: %s", err))
 	}
 
 	service, err := rsCatClient.ResourceCatalog().Get(serviceID, true)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating resource key: %s", err))
 	}
 
 	serviceRole, err := getRoleFromName(role, service.Name, meta)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating resource key: %s", err))
 	}
 	keyParams["role_crn"] = serviceRole.Crn
 
@@ -171,28 +174,36 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 
 	resourceKey, err := rsContClient.ResourceServiceKey().CreateKey(request)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating resource key: %s", err))
 	}
 
 	d.SetId(resourceKey.ID)
 
-	return resourceIBMResourceKeyRead(d, meta)
+	return resourceIBMResourceKeyRead(ctx, d, meta)
 }
 
-func resourceIBMResourceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
-func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsContClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	resourceKeyID := d.Id()
 
 	resourceKey, err := rsContClient.ResourceServiceKey().GetKey(resourceKeyID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving resource key: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && (apiErr.StatusCode() == 404 || apiErr.StatusCode() == 410) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving resource key: %s", err))
+	}
+	if resourceKey.State == "removed" {
+		d.SetId("")
+		return nil
 	}
 	d.Set("credentials", Flatten(resourceKey.Credentials))
 	d.Set("name", resourceKey.Name)
@@ -228,17 +239,17 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMResourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsContClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	resourceKeyID := d.Id()
 
 	err = rsContClient.ResourceServiceKey().DeleteKey(resourceKeyID)
 	if err != nil {
-		return fmt.Errorf("Error deleting resource key: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting resource key: %s", err))
 	}
 
 	d.SetId("")
@@ -246,29 +257,6 @@ func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	rsContClient, err := meta.(ClientSession).ResourceControllerAPI()
-	if err != nil {
-		return false, err
-	}
-	resourceKeyID := d.Id()
-
-	resourceKey, err := rsContClient.ResourceServiceKey().GetKey(resourceKeyID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 || apiErr.StatusCode() == 410 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	if err == nil && resourceKey.State == "removed" {
-		return false, nil
-	}
-
-	return resourceKey.ID == resourceKeyID, nil
-}
-
 func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*models.ServiceInstance, *crn.CRN, error) {
 	rsContClient, err := meta.(ClientSession).ResourceControllerAPI()
 	if err != nil {
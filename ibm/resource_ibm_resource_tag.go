@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMResourceTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMResourceTagCreate,
+		ReadContext:   resourceIBMResourceTagRead,
+		UpdateContext: resourceIBMResourceTagUpdate,
+		DeleteContext: resourceIBMResourceTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMResourceTag, nil),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Description: "The CRN of the resource to tag",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"tags": {
+				Description: "The tags attached to the resource, the other tags of the resource are left unchanged",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         resourceIBMVPCHash,
+			},
+			"tag_type": {
+				Description:  "The type of the tags, 'user', 'access' or 'service'",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      tagTypeUser,
+				ValidateFunc: validateAllowedStringValue([]string{tagTypeUser, tagTypeAccess, tagTypeService}),
+			},
+		},
+	}
+}
+
+// parseResourceTagID parses the composite ID <tag_type>:<resource_id> of an ibm_resource_tag
+func parseResourceTagID(id string) (tagType, resourceID string, err error) {
+	ids, crn, err := parseCompositeID(id, 1)
+	if err != nil {
+		return "", "", fmt.Errorf("Invalid resource tag ID, expected <tag_type>:<resource_id>: %s", err)
+	}
+	return ids[0], crn, nil
+}
+
+func resourceIBMResourceTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	resourceID := d.Get("resource_id").(string)
+	tagType := d.Get("tag_type").(string)
+	err := UpdateGlobalTagsUsingCRN(nil, d.Get("tags"), meta, resourceID, tagType)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId(buildCompositeID(resourceID, tagType))
+	return resourceIBMResourceTagRead(ctx, d, meta)
+}
+
+func resourceIBMResourceTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tagType, resourceID, err := parseResourceTagID(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	tags, err := GetGlobalTagsUsingCRN(meta, resourceID, tagType)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error getting the %s tags of %s: %s", tagType, resourceID, err))
+	}
+
+	// Only the tags of the configuration are managed, all the tags are read on import
+	if configured := d.Get("tags").(*schema.Set); configured.Len() > 0 {
+		tags = tags.Intersection(configured)
+	}
+	d.Set("resource_id", resourceID)
+	d.Set("tag_type", tagType)
+	d.Set("tags", tags)
+	return nil
+}
+
+func resourceIBMResourceTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("tags") {
+		oldList, newList := d.GetChange("tags")
+		err := UpdateGlobalTagsUsingCRN(oldList, newList, meta, d.Get("resource_id").(string), d.Get("tag_type").(string))
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+	return resourceIBMResourceTagRead(ctx, d, meta)
+}
+
+func resourceIBMResourceTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := UpdateGlobalTagsUsingCRN(d.Get("tags"), nil, meta, d.Get("resource_id").(string), d.Get("tag_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
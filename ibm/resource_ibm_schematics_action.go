@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	schematicsActionWorkspaceID = "workspace_id"
+	schematicsActionAction      = "action"
+	schematicsActionTriggers    = "triggers"
+	schematicsActionActivityID  = "activity_id"
+	schematicsActionStatus      = "status"
+	schematicsActionMessage     = "message"
+	schematicsActionPerformedAt = "performed_at"
+
+	schematicsActivityStatusInProgress = "INPROGRESS"
+	schematicsActivityStatusCompleted  = "COMPLETED"
+	schematicsActivityStatusFailed     = "FAILED"
+	schematicsActivityStatusStopped    = "STOPPED"
+)
+
+func resourceIBMSchematicsAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsActionCreate,
+		ReadContext:   resourceIBMSchematicsActionRead,
+		DeleteContext: resourceIBMSchematicsActionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMSchematicsAction, nil),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			schematicsActionWorkspaceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the workspace",
+			},
+			schematicsActionAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_schematics_action", schematicsActionAction),
+				Description:  "The job to run on the workspace, plan, apply or destroy",
+			},
+			schematicsActionTriggers: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that run the job again when they change",
+			},
+			schematicsActionActivityID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the job",
+			},
+			schematicsActionStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the job",
+			},
+			schematicsActionMessage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The message of the job",
+			},
+			schematicsActionPerformedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the job ran at",
+			},
+		},
+	}
+}
+
+func resourceIBMSchematicsActionValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 schematicsActionAction,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "plan, apply, destroy"})
+
+	ibmSchematicsActionResourceValidator := ResourceValidator{ResourceName: "ibm_schematics_action", Schema: validateSchema}
+	return &ibmSchematicsActionResourceValidator
+}
+
+func resourceIBMSchematicsActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := schematicsWorkspacesAPI(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	workspaceID := d.Get(schematicsActionWorkspaceID).(string)
+	action := d.Get(schematicsActionAction).(string)
+
+	// Only one job runs at a time on a workspace
+	_, err = waitForSchematicsWorkspaceReady(ctx, client, workspaceID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	activityID, err := client.RunWorkspaceAction(workspaceID, action)
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error running %s on schematics workspace (%s): %s", action, workspaceID, err))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", workspaceID, activityID))
+	log.Printf("[INFO] Schematics %s job : %s", action, activityID)
+
+	activity, err := waitForSchematicsActivity(ctx, client, workspaceID, activityID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if activity.Status != schematicsActivityStatusCompleted {
+		return diagFromErr(fmt.Errorf("The %s job (%s) of schematics workspace (%s) ended with status %s: %s", action, activityID, workspaceID, activity.Status, activity.Message))
+	}
+
+	return resourceIBMSchematicsActionRead(ctx, d, meta)
+}
+
+func resourceIBMSchematicsActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := schematicsWorkspacesAPI(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+	workspaceID := parts[0]
+	activityID := parts[1]
+
+	activity, err := client.GetWorkspaceActivity(workspaceID, activityID)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving the job (%s) of schematics workspace (%s): %s", activityID, workspaceID, err))
+	}
+
+	d.Set(schematicsActionWorkspaceID, workspaceID)
+	d.Set(schematicsActionActivityID, activityID)
+	d.Set(schematicsActionStatus, activity.Status)
+	d.Set(schematicsActionMessage, activity.Message)
+	d.Set(schematicsActionPerformedAt, activity.PerformedAt)
+	if action := schematicsActivityAction(activity.Name); action != "" {
+		d.Set(schematicsActionAction, action)
+	}
+
+	return nil
+}
+
+// resourceIBMSchematicsActionDelete only removes the job from the state, a job
+// that ran can't be undone.
+func resourceIBMSchematicsActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// schematicsActivityAction returns the action of a job from its name, like
+// WORKSPACE_APPLY.
+func schematicsActivityAction(name string) string {
+	action := strings.ToLower(strings.TrimPrefix(strings.ToUpper(name), "WORKSPACE_"))
+	switch action {
+	case schematicsActionPlan, schematicsActionApply, schematicsActionDestroy:
+		return action
+	}
+	return ""
+}
+
+func waitForSchematicsActivity(ctx context.Context, client schematicsWorkspaces, workspaceID, activityID string, timeout time.Duration) (schematicsActivity, error) {
+	log.Printf("Waiting for the job (%s) of schematics workspace (%s) to end.", activityID, workspaceID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{schematicsActivityStatusInProgress},
+		Target:     []string{schematicsActivityStatusCompleted, schematicsActivityStatusFailed, schematicsActivityStatusStopped},
+		Refresh:    schematicsActivityRefreshFunc(client, workspaceID, activityID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	activity, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return schematicsActivity{}, err
+	}
+	return activity.(schematicsActivity), nil
+}
+
+// schematicsActivityRefreshFunc reports every status of a job that hasn't
+// ended as in progress.
+func schematicsActivityRefreshFunc(client schematicsWorkspaces, workspaceID, activityID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		activity, err := client.GetWorkspaceActivity(workspaceID, activityID)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving the job (%s) of schematics workspace (%s): %s", activityID, workspaceID, err)
+		}
+		switch activity.Status {
+		case schematicsActivityStatusCompleted, schematicsActivityStatusFailed, schematicsActivityStatusStopped:
+			return activity, activity.Status, nil
+		}
+		return activity, schematicsActivityStatusInProgress, nil
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsAction_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-workspace-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSchematicsWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsActionConfig(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_action.plan", "status", "COMPLETED"),
+					resource.TestCheckResourceAttr("ibm_schematics_action.apply", "status", "COMPLETED"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_action.apply", "activity_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSchematicsActionConfig(name, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_action.apply", "triggers.run", "2"),
+					resource.TestCheckResourceAttr("ibm_schematics_action.apply", "status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsActionConfig(name, run string) string {
+	return fmt.Sprintf(`
+resource "ibm_schematics_workspace" "testacc_workspace" {
+  name     = "%s"
+  location = "us-south"
+
+  template_repo {
+    url = "%s"
+  }
+
+  destroy_resources_on_delete = true
+}
+
+resource "ibm_schematics_action" "plan" {
+  workspace_id = ibm_schematics_workspace.testacc_workspace.id
+  action       = "plan"
+}
+
+resource "ibm_schematics_action" "apply" {
+  workspace_id = ibm_schematics_workspace.testacc_workspace.id
+  action       = "apply"
+  triggers = {
+    run = "%s"
+  }
+  depends_on = [ibm_schematics_action.plan]
+}`, name, schematicsTemplateRepoURL, run)
+}
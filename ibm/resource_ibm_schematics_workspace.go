@@ -0,0 +1,466 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/api/schematics"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	schematicsWorkspaceName             = "name"
+	schematicsWorkspaceDescription      = "description"
+	schematicsWorkspaceLocation         = "location"
+	schematicsWorkspaceResourceGroup    = "resource_group"
+	schematicsWorkspaceTags             = "tags"
+	schematicsWorkspaceTerraformVersion = "terraform_version"
+	schematicsWorkspaceTemplateRepo     = "template_repo"
+	schematicsWorkspaceTemplateFolder   = "template_folder"
+	schematicsWorkspaceVariableStore    = "variable_store"
+	schematicsWorkspaceDestroyOnDelete  = "destroy_resources_on_delete"
+	schematicsWorkspaceTemplateID       = "template_id"
+	schematicsWorkspaceStatus           = "status"
+	schematicsWorkspaceCRN              = "crn"
+
+	schematicsWorkspaceStatusConnecting = "CONNECTING"
+	schematicsWorkspaceStatusInProgress = "INPROGRESS"
+	schematicsWorkspaceStatusDraft      = "DRAFT"
+	schematicsWorkspaceStatusInactive   = "INACTIVE"
+	schematicsWorkspaceStatusActive     = "ACTIVE"
+	schematicsWorkspaceStatusFailed     = "FAILED"
+	schematicsWorkspaceStatusStopped    = "STOPPED"
+	schematicsWorkspaceStatusDeleting   = "deleting"
+	schematicsWorkspaceStatusDeleted    = "deleted"
+)
+
+func resourceIBMSchematicsWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsWorkspaceCreate,
+		ReadContext:   resourceIBMSchematicsWorkspaceRead,
+		UpdateContext: resourceIBMSchematicsWorkspaceUpdate,
+		DeleteContext: resourceIBMSchematicsWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMSchematicsWorkspace, nil),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			schematicsWorkspaceName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the workspace",
+			},
+			schematicsWorkspaceDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the workspace",
+			},
+			schematicsWorkspaceLocation: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_schematics_workspace", schematicsWorkspaceLocation),
+				Description:  "The location where the workspace is created",
+			},
+			schematicsWorkspaceResourceGroup: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the resource group of the workspace",
+			},
+			schematicsWorkspaceTags: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The tags of the workspace",
+			},
+			schematicsWorkspaceTerraformVersion: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "terraform_v0.13",
+				ValidateFunc: InvokeValidator("ibm_schematics_workspace", schematicsWorkspaceTerraformVersion),
+				Description:  "The Terraform version used to run the template",
+			},
+			schematicsWorkspaceTemplateRepo: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The git repository of the template",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The URL of the git repository or of a folder of the repository",
+						},
+						"branch": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The branch of the repository",
+						},
+						"release": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The release of the repository",
+						},
+					},
+				},
+			},
+			schematicsWorkspaceTemplateFolder: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     ".",
+				Description: "The folder of the template in the repository",
+			},
+			schematicsWorkspaceVariableStore: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The input variables of the template",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the variable",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "The value of the variable, complex values use the HCL syntax",
+						},
+						"secure": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Set to true to hide the value of the variable in the workspace",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The description of the variable",
+						},
+					},
+				},
+			},
+			schematicsWorkspaceDestroyOnDelete: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true to destroy the resources of the workspace when the workspace is deleted",
+			},
+			schematicsWorkspaceTemplateID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the template of the workspace",
+			},
+			schematicsWorkspaceStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the workspace",
+			},
+			schematicsWorkspaceCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the workspace",
+			},
+			ResourceControllerURL: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the IBM Cloud dashboard that can be used to explore and view details about this workspace",
+			},
+		},
+	}
+}
+
+func resourceIBMSchematicsWorkspaceValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 schematicsWorkspaceLocation,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "us-south, us-east, eu-gb, eu-de"})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 schematicsWorkspaceTerraformVersion,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "terraform_v0.12, terraform_v0.13, terraform_v0.14"})
+
+	ibmSchematicsWorkspaceResourceValidator := ResourceValidator{ResourceName: "ibm_schematics_workspace", Schema: validateSchema}
+	return &ibmSchematicsWorkspaceResourceValidator
+}
+
+func expandSchematicsWorkspace(d *schema.ResourceData, templateID string) schematicsWorkspacePayload {
+	terraformVersion := d.Get(schematicsWorkspaceTerraformVersion).(string)
+	payload := schematicsWorkspacePayload{
+		Name:          d.Get(schematicsWorkspaceName).(string),
+		Type:          []string{terraformVersion},
+		Description:   d.Get(schematicsWorkspaceDescription).(string),
+		Location:      d.Get(schematicsWorkspaceLocation).(string),
+		ResourceGroup: d.Get(schematicsWorkspaceResourceGroup).(string),
+		Tags:          expandStringList(d.Get(schematicsWorkspaceTags).(*schema.Set).List()),
+	}
+	if repos := d.Get(schematicsWorkspaceTemplateRepo).([]interface{}); len(repos) > 0 && repos[0] != nil {
+		repo := repos[0].(map[string]interface{})
+		payload.TemplateRepo = &schematicsTemplateRepo{
+			URL:     repo["url"].(string),
+			Branch:  repo["branch"].(string),
+			Release: repo["release"].(string),
+		}
+	}
+	payload.TemplateData = []schematicsTemplateData{{
+		ID:            templateID,
+		Folder:        d.Get(schematicsWorkspaceTemplateFolder).(string),
+		Type:          terraformVersion,
+		Variablestore: expandSchematicsVariableStore(d.Get(schematicsWorkspaceVariableStore).([]interface{})),
+	}}
+	return payload
+}
+
+func expandSchematicsVariableStore(variables []interface{}) []schematics.Variablestore {
+	variablestore := make([]schematics.Variablestore, 0, len(variables))
+	for _, v := range variables {
+		variable := v.(map[string]interface{})
+		variablestore = append(variablestore, schematics.Variablestore{
+			Name:        variable["name"].(string),
+			Value:       variable["value"].(string),
+			Secure:      variable["secure"].(bool),
+			Description: variable["description"].(string),
+		})
+	}
+	return variablestore
+}
+
+// flattenSchematicsVariableStore keeps the values of the secure variables of
+// the state, the workspace doesn't return them.
+func flattenSchematicsVariableStore(variablestore []schematics.Variablestore, current []interface{}) []map[string]interface{} {
+	secureValues := map[string]string{}
+	for _, v := range current {
+		variable := v.(map[string]interface{})
+		if variable["secure"].(bool) {
+			secureValues[variable["name"].(string)] = variable["value"].(string)
+		}
+	}
+	variables := make([]map[string]interface{}, 0, len(variablestore))
+	for _, variable := range variablestore {
+		value := variable.Value
+		if variable.Secure {
+			value = secureValues[variable.Name]
+		}
+		variables = append(variables, map[string]interface{}{
+			"name":        variable.Name,
+			"value":       value,
+			"secure":      variable.Secure,
+			"description": variable.Description,
+		})
+	}
+	return variables
+}
+
+func resourceIBMSchematicsWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := schematicsWorkspacesAPI(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	workspace, err := client.CreateWorkspace(expandSchematicsWorkspace(d, ""))
+	if err != nil {
+		return diagFromErr(fmt.Errorf("Error creating schematics workspace: %s", err))
+	}
+	d.SetId(workspace.ID)
+	log.Printf("[INFO] Schematics workspace : %s", workspace.ID)
+
+	_, err = waitForSchematicsWorkspaceReady(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceIBMSchematicsWorkspaceRead(ctx, d, meta)
+}
+
+func resourceIBMSchematicsWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := schematicsWorkspacesAPI(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	workspace, err := client.GetWorkspace(d.Id())
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving schematics workspace (%s): %s", d.Id(), err))
+	}
+
+	d.Set(schematicsWorkspaceName, workspace.Name)
+	d.Set(schematicsWorkspaceDescription, workspace.Description)
+	d.Set(schematicsWorkspaceLocation, workspace.Location)
+	d.Set(schematicsWorkspaceResourceGroup, workspace.ResourceGroup)
+	d.Set(schematicsWorkspaceTags, workspace.Tags)
+	d.Set(schematicsWorkspaceStatus, workspace.Status)
+	d.Set(schematicsWorkspaceCRN, workspace.CRN)
+	if len(workspace.Type) > 0 {
+		d.Set(schematicsWorkspaceTerraformVersion, workspace.Type[0])
+	}
+	if workspace.TemplateRepo.URL != "" {
+		d.Set(schematicsWorkspaceTemplateRepo, []map[string]interface{}{{
+			"url":     workspace.TemplateRepo.URL,
+			"branch":  workspace.TemplateRepo.Branch,
+			"release": workspace.TemplateRepo.Release,
+		}})
+	}
+	if len(workspace.TemplateData) > 0 {
+		template := workspace.TemplateData[0]
+		d.Set(schematicsWorkspaceTemplateID, template.TemplateID)
+		if template.Folder != "" {
+			d.Set(schematicsWorkspaceTemplateFolder, template.Folder)
+		}
+		current := d.Get(schematicsWorkspaceVariableStore).([]interface{})
+		d.Set(schematicsWorkspaceVariableStore, flattenSchematicsVariableStore(template.Variablestore, current))
+	}
+
+	controller, err := getBaseController(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	d.Set(ResourceControllerURL, controller+"/schematics")
+
+	return nil
+}
+
+func resourceIBMSchematicsWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := schematicsWorkspacesAPI(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if d.HasChangesExcept(schematicsWorkspaceDestroyOnDelete) {
+		// The workspace can't be updated while a job runs on it
+		_, err = waitForSchematicsWorkspaceReady(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diagFromErr(err)
+		}
+		payload := expandSchematicsWorkspace(d, d.Get(schematicsWorkspaceTemplateID).(string))
+		// The location and the resource group of a workspace can't be replaced
+		payload.Location = ""
+		payload.ResourceGroup = ""
+		_, err = client.ReplaceWorkspace(d.Id(), payload)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("Error updating schematics workspace (%s): %s", d.Id(), err))
+		}
+		_, err = waitForSchematicsWorkspaceReady(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return resourceIBMSchematicsWorkspaceRead(ctx, d, meta)
+}
+
+func resourceIBMSchematicsWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := schematicsWorkspacesAPI(meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = waitForSchematicsWorkspaceReady(ctx, client, d.Id(), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	destroyResources := d.Get(schematicsWorkspaceDestroyOnDelete).(bool)
+	err = client.DeleteWorkspace(d.Id(), destroyResources)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error deleting schematics workspace (%s): %s", d.Id(), err))
+	}
+	_, err = waitForSchematicsWorkspaceDeleted(ctx, client, d.Id(), destroyResources, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForSchematicsWorkspaceReady(ctx context.Context, client schematicsWorkspaces, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for schematics workspace (%s) to be ready.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{schematicsWorkspaceStatusConnecting, schematicsWorkspaceStatusInProgress},
+		Target:     []string{schematicsWorkspaceStatusDraft, schematicsWorkspaceStatusInactive, schematicsWorkspaceStatusActive, schematicsWorkspaceStatusFailed, schematicsWorkspaceStatusStopped},
+		Refresh:    schematicsWorkspaceRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func schematicsWorkspaceRefreshFunc(client schematicsWorkspaces, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		workspace, err := client.GetWorkspace(id)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving schematics workspace (%s): %s", id, err)
+		}
+		// A locked workspace runs a job
+		if workspace.WorkspaceStatus.Locked {
+			return workspace, schematicsWorkspaceStatusInProgress, nil
+		}
+		return workspace, workspace.Status, nil
+	}
+}
+
+// waitForSchematicsWorkspaceDeleted waits for the workspace to be gone, when
+// its resources are destroyed first a failed destroy job stops the wait.
+func waitForSchematicsWorkspaceDeleted(ctx context.Context, client schematicsWorkspaces, id string, destroyResources bool, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for schematics workspace (%s) to be deleted.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{schematicsWorkspaceStatusDeleting},
+		Target:  []string{schematicsWorkspaceStatusDeleted},
+		Refresh: func() (interface{}, string, error) {
+			workspace, err := client.GetWorkspace(id)
+			if err != nil {
+				if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+					return workspace, schematicsWorkspaceStatusDeleted, nil
+				}
+				return nil, "", fmt.Errorf("Error retrieving schematics workspace (%s): %s", id, err)
+			}
+			if destroyResources && workspace.Status == schematicsWorkspaceStatusFailed && !workspace.WorkspaceStatus.Locked {
+				return workspace, workspace.Status, fmt.Errorf("The schematics workspace (%s) failed to be deleted: %s", id, workspace.WorkspaceStatusMsg.StatusMsg)
+			}
+			return workspace, schematicsWorkspaceStatusDeleting, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMSchematicsWorkspace_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-workspace-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSchematicsWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceConfig(name, "tf-service"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMSchematicsWorkspaceExists("ibm_schematics_workspace.testacc_workspace"),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace.testacc_workspace", "name", name),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace.testacc_workspace", "terraform_version", "terraform_v0.13"),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace.testacc_workspace", "variable_store.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace.testacc_workspace", "template_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceConfig(name, "tf-service-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_workspace.testacc_workspace", "variable_store.0.value", "tf-service-updated"),
+				),
+			},
+			{
+				ResourceName:            "ibm_schematics_workspace.testacc_workspace",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"destroy_resources_on_delete", "variable_store"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsWorkspaceDestroy(s *terraform.State) error {
+	client, err := schematicsWorkspacesAPI(testAccProvider.Meta())
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_schematics_workspace" {
+			continue
+		}
+
+		_, err := client.GetWorkspace(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Schematics workspace still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMSchematicsWorkspaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		client, err := schematicsWorkspacesAPI(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+		_, err = client.GetWorkspace(rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckIBMSchematicsWorkspaceConfig(name, serviceName string) string {
+	return fmt.Sprintf(`
+resource "ibm_schematics_workspace" "testacc_workspace" {
+  name        = "%s"
+  description = "terraform acceptance test"
+  location    = "us-south"
+  tags        = ["test:acc"]
+
+  template_repo {
+    url = "%s"
+  }
+
+  variable_store {
+    name  = "service_name"
+    value = "%s"
+  }
+
+  variable_store {
+    name   = "plan"
+    value  = "lite"
+    secure = true
+  }
+
+  destroy_resources_on_delete = true
+}`, name, schematicsTemplateRepoURL, serviceName)
+}
@@ -4,10 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -17,12 +19,13 @@ import (
 
 func resourceIBMSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSecurityGroupCreate,
-		Read:     resourceIBMSecurityGroupRead,
-		Update:   resourceIBMSecurityGroupUpdate,
-		Delete:   resourceIBMSecurityGroupDelete,
-		Exists:   resourceIBMSecurityGroupExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSecurityGroupCreate,
+		ReadContext:   resourceIBMSecurityGroupRead,
+		UpdateContext: resourceIBMSecurityGroupUpdate,
+		DeleteContext: resourceIBMSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMSecurityGroup, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -40,7 +43,7 @@ func resourceIBMSecurityGroup() *schema.Resource {
 	}
 }
 
-func resourceIBMSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess.SetRetries(0))
 
@@ -73,7 +76,7 @@ func resourceIBMSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 
 		if editSG {
 			_, err = service.EditObject(&group)
-			return err
+			return diagFromErr(err)
 		}
 
 		return nil
@@ -84,16 +87,16 @@ func resourceIBMSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	res, err := service.CreateObject(sg)
 	if err != nil {
-		return fmt.Errorf("Error creating Security Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Security Group: %s", err))
 	}
 
 	d.SetId(strconv.Itoa(*res.Id))
 	log.Printf("[INFO] Security Group: %d", *res.Id)
 
-	return resourceIBMSecurityGroupRead(d, meta)
+	return resourceIBMSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
@@ -106,7 +109,7 @@ func resourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Security Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Security Group: %s", err))
 	}
 
 	d.Set("name", group.Name)
@@ -114,18 +117,18 @@ func resourceIBMSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
 	groupID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	group, err := services.GetNetworkSecurityGroupService(sess).Id(groupID).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Security Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Security Group: %s", err))
 	}
 
 	if d.HasChange("description") {
@@ -137,46 +140,25 @@ func resourceIBMSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 	_, err = service.Id(groupID).EditObject(&group)
 	if err != nil {
-		return fmt.Errorf("Error editing Security Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error editing Security Group: %s", err))
 	}
-	return resourceIBMSecurityGroupRead(d, meta)
+	return resourceIBMSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceIBMSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
 	groupID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 	log.Printf("[INFO] Deleting Security Group: %d", groupID)
 	_, err = service.Id(groupID).DeleteObject()
 	if err != nil {
-		return fmt.Errorf("Error deleting Security Group: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Security Group: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMSecurityGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetNetworkSecurityGroupService(sess)
-
-	groupID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	result, err := service.Id(groupID).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok {
-			if apiErr.StatusCode == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-	return result.Id != nil && *result.Id == groupID, nil
-}
@@ -4,10 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/filter"
@@ -17,12 +20,13 @@ import (
 
 func resourceIBMSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSecurityGroupRuleCreate,
-		Read:     resourceIBMSecurityGroupRuleRead,
-		Delete:   resourceIBMSecurityGroupRuleDelete,
-		Update:   resourceIBMSecurityGroupRuleUpdate,
-		Exists:   resourceIBMSecurityGroupRuleExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSecurityGroupRuleCreate,
+		ReadContext:   resourceIBMSecurityGroupRuleRead,
+		DeleteContext: resourceIBMSecurityGroupRuleDelete,
+		UpdateContext: resourceIBMSecurityGroupRuleUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMSecurityGroupRuleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"direction": {
@@ -117,7 +121,7 @@ func findMatchingRule(sgID int, rule *datatypes.Network_SecurityGroup_Rule,
 	return &rules[0], nil
 }
 
-func resourceIBMSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
@@ -162,7 +166,7 @@ func resourceIBMSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 
 	matchingrule, err := findMatchingRule(sgID, &sgrule, service)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if matchingrule != nil {
@@ -177,19 +181,19 @@ func resourceIBMSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 	log.Println("[INFO] creating security group rule")
 	_, err = service.Id(sgID).AddRules(opts)
 	if err != nil {
-		return fmt.Errorf("Error creating Security Group Rule: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating Security Group Rule: %s", err))
 	}
 
 	matchingrule, err = findMatchingRule(sgID, &sgrule, service)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(strconv.Itoa(*matchingrule.Id))
 
-	return resourceIBMSecurityGroupRuleRead(d, meta)
+	return resourceIBMSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func resourceIBMSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 
@@ -203,7 +207,7 @@ func resourceIBMSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Security Group Rule: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Security Group Rule: %s", err))
 	}
 
 	if len(matchingrules) == 0 {
@@ -235,14 +239,30 @@ func resourceIBMSecurityGroupRuleRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceIBMSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+// resourceIBMSecurityGroupRuleImport imports a security group rule from the ID <security_group_id>/<rule_id>,
+// the rules can only be read from their security group
+func resourceIBMSecurityGroupRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	args, err := importIDParts("security_group_id", "")(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	sgID, err := strconv.Atoi(args["security_group_id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("The security group ID of the ibm_security_group_rule import ID %s must be an integer", d.Id())
+	}
+	d.SetId(d.Id()[strings.LastIndex(d.Id(), "/")+1:])
+	d.Set("security_group_id", sgID)
+	return importStateWithRead(resourceIBMSecurityGroupRule, nil)(ctx, d, meta)
+}
+
+func resourceIBMSecurityGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	securityGroupID := d.Get("security_group_id").(int)
 	matchingrules, err := service.Filter(filter.Build(
 		filter.Path("rules.id").Eq(d.Id()))).Id(securityGroupID).GetRules()
 	if err != nil {
-		return fmt.Errorf("Error retrieving Security Group Rule: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Security Group Rule: %s", err))
 	}
 	if d.HasChange("direction") {
 		matchingrules[0].Direction = sl.String(d.Get("direction").(string))
@@ -267,12 +287,12 @@ func resourceIBMSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}
 	}
 	_, err = service.Id(securityGroupID).EditRules([]datatypes.Network_SecurityGroup_Rule{matchingrules[0]})
 	if err != nil {
-		return fmt.Errorf("Couldn't update Security Group Rule: %s", err)
+		return diagFromErr(fmt.Errorf("Couldn't update Security Group Rule: %s", err))
 	}
-	return resourceIBMSecurityGroupRuleRead(d, meta)
+	return resourceIBMSecurityGroupRuleRead(ctx, d, meta)
 }
 
-func resourceIBMSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID := d.Get("security_group_id").(int)
@@ -283,34 +303,9 @@ func resourceIBMSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error deleting Security Group Rule: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting Security Group Rule: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMSecurityGroupRuleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetNetworkSecurityGroupService(sess)
-
-	sgID := d.Get("security_group_id").(int)
-	matchingrules, err := service.Filter(filter.Build(
-		filter.Path("rules.id").Eq(d.Id()))).Id(sgID).GetRules()
-	if err != nil {
-		// If the group is somehow already destroyed, mark as
-		// succesfully gone
-		if err, ok := err.(sl.Error); ok && err.StatusCode == 404 {
-			d.SetId("")
-			return false, nil
-		}
-		return false, fmt.Errorf("Error retrieving Security Group Rule: %s", err)
-	}
-
-	if len(matchingrules) == 0 {
-		d.SetId("")
-		return false, nil
-	}
-
-	return true, nil
-}
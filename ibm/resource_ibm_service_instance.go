@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -23,12 +25,13 @@ const (
 
 func resourceIBMServiceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMServiceInstanceCreate,
-		Read:     resourceIBMServiceInstanceRead,
-		Update:   resourceIBMServiceInstanceUpdate,
-		Delete:   resourceIBMServiceInstanceDelete,
-		Exists:   resourceIBMServiceInstanceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMServiceInstanceCreate,
+		ReadContext:   resourceIBMServiceInstanceRead,
+		UpdateContext: resourceIBMServiceInstanceUpdate,
+		DeleteContext: resourceIBMServiceInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMServiceInstance, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -118,10 +121,10 @@ func resourceIBMServiceInstance() *schema.Resource {
 	}
 }
 
-func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	serviceName := d.Get("service").(string)
 	plan := d.Get("plan").(string)
@@ -135,12 +138,12 @@ func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	serviceOff, err := cfClient.ServiceOfferings().FindByLabel(serviceName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service offering: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving service offering: %s", err))
 	}
 
 	servicePlan, err := cfClient.ServicePlans().FindPlanInServiceOffering(serviceOff.GUID, plan)
 	if err != nil {
-		return fmt.Errorf("Error retrieving plan: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving plan: %s", err))
 	}
 	svcInst.PlanGUID = servicePlan.GUID
 
@@ -165,31 +168,35 @@ func resourceIBMServiceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	service, err := cfClient.ServiceInstances().Create(svcInst)
 	if err != nil {
-		return fmt.Errorf("Error creating service: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating service: %s", err))
 	}
 
 	d.SetId(service.Metadata.GUID)
 
-	_, err = waitForServiceInstanceAvailable(d, meta)
+	_, err = waitForServiceInstanceAvailable(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create service (%s) to be succeeded: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for create service (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMServiceInstanceRead(d, meta)
+	return resourceIBMServiceInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMServiceInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceGUID := d.Id()
 
 	service, err := cfClient.ServiceInstances().Get(serviceGUID, 1)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving service: %s", err))
 	}
 
 	servicePlanGUID := service.Entity.ServicePlanGUID
@@ -206,17 +213,17 @@ func resourceIBMServiceInstanceRead(d *schema.ResourceData, meta interface{}) er
 
 	svcOff, err := cfClient.ServiceOfferings().Get(service.Entity.ServicePlan.Entity.ServiceGUID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.Set("service", svcOff.Entity.Label)
 
 	return nil
 }
 
-func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceGUID := d.Id()
@@ -231,12 +238,12 @@ func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		service := d.Get("service").(string)
 		serviceOff, err := cfClient.ServiceOfferings().FindByLabel(service)
 		if err != nil {
-			return fmt.Errorf("Error retrieving service offering: %s", err)
+			return diagFromErr(newAttributeError("plan", "Error retrieving service offering", err))
 		}
 
 		servicePlan, err := cfClient.ServicePlans().FindPlanInServiceOffering(serviceOff.GUID, plan)
 		if err != nil {
-			return fmt.Errorf("Error retrieving plan: %s", err)
+			return diagFromErr(newAttributeError("plan", "Error retrieving plan", err))
 		}
 		updateReq.PlanGUID = helpers.String(servicePlan.GUID)
 
@@ -253,60 +260,40 @@ func resourceIBMServiceInstanceUpdate(d *schema.ResourceData, meta interface{})
 
 	_, err = cfClient.ServiceInstances().Update(serviceGUID, updateReq)
 	if err != nil {
-		return fmt.Errorf("Error updating service: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating service: %s", err))
 	}
 
-	_, err = waitForServiceInstanceAvailable(d, meta)
+	_, err = waitForServiceInstanceAvailable(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for update service (%s) to be succeeded: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for update service (%s) to be succeeded: %s", d.Id(), err))
 	}
 
-	return resourceIBMServiceInstanceRead(d, meta)
+	return resourceIBMServiceInstanceRead(ctx, d, meta)
 }
 
-func resourceIBMServiceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 
 	err = cfClient.ServiceInstances().Delete(id, true)
 	if err != nil {
-		return fmt.Errorf("Error deleting service: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting service: %s", err))
 	}
 
-	_, err = waitForServiceInstanceDelete(d, meta)
+	_, err = waitForServiceInstanceDelete(ctx, d, meta)
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for service (%s) to be deleted: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for service (%s) to be deleted: %s", d.Id(), err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-func resourceIBMServiceInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cfClient, err := meta.(ClientSession).MccpAPI()
-	if err != nil {
-		return false, err
-	}
-	serviceGUID := d.Id()
-
-	service, err := cfClient.ServiceInstances().Get(serviceGUID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return service.Metadata.GUID == serviceGUID, nil
-}
-
 func getServiceTags(d *schema.ResourceData) []string {
 	tagSet := d.Get("tags").(*schema.Set)
 
@@ -323,7 +310,7 @@ func getServiceTags(d *schema.ResourceData) []string {
 	return tags
 }
 
-func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForServiceInstanceAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
 		return false, err
@@ -351,10 +338,10 @@ func waitForServiceInstanceAvailable(d *schema.ResourceData, meta interface{}) (
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
-func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func waitForServiceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
 		return false, err
@@ -381,5 +368,5 @@ func waitForServiceInstanceDelete(d *schema.ResourceData, meta interface{}) (int
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
@@ -4,21 +4,24 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMServiceKey() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMServiceKeyCreate,
-		Read:     resourceIBMServiceKeyRead,
-		Update:   resourceIBMServiceKeyUpdate,
-		Delete:   resourceIBMServiceKeyDelete,
-		Exists:   resourceIBMServiceKeyExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMServiceKeyCreate,
+		ReadContext:   resourceIBMServiceKeyRead,
+		UpdateContext: resourceIBMServiceKeyUpdate,
+		DeleteContext: resourceIBMServiceKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMServiceKey, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -56,10 +59,10 @@ func resourceIBMServiceKey() *schema.Resource {
 	}
 }
 
-func resourceIBMServiceKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	name := d.Get("name").(string)
 	serviceInstanceGUID := d.Get("service_instance_guid").(string)
@@ -81,29 +84,33 @@ func resourceIBMServiceKeyCreate(d *schema.ResourceData, meta interface{}) error
 
 	serviceKey, err := cfClient.ServiceKeys().Create(serviceInstanceGUID, name, keyParams)
 	if err != nil {
-		return fmt.Errorf("Error creating service key: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating service key: %s", err))
 	}
 
 	d.SetId(serviceKey.Metadata.GUID)
 
-	return resourceIBMServiceKeyRead(d, meta)
+	return resourceIBMServiceKeyRead(ctx, d, meta)
 }
 
-func resourceIBMServiceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	//Only tags are updated and that too locally hence nothing to validate and update in terms of real API at this point
 	return nil
 }
 
-func resourceIBMServiceKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	serviceKeyGUID := d.Id()
 
 	serviceKey, err := cfClient.ServiceKeys().Get(serviceKeyGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving service key: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving service key: %s", err))
 	}
 	d.Set("credentials", Flatten(serviceKey.Entity.Credentials))
 	d.Set("service_instance_guid", serviceKey.Entity.ServiceInstanceGUID)
@@ -112,40 +119,20 @@ func resourceIBMServiceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMServiceKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMServiceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	serviceKeyGUID := d.Id()
 
 	err = cfClient.ServiceKeys().Delete(serviceKeyGUID)
 	if err != nil {
-		return fmt.Errorf("Error deleting service key: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting service key: %s", err))
 	}
 
 	d.SetId("")
 
 	return nil
 }
-
-func resourceIBMServiceKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cfClient, err := meta.(ClientSession).MccpAPI()
-	if err != nil {
-		return false, err
-	}
-	serviceKeyGUID := d.Id()
-
-	serviceKey, err := cfClient.ServiceKeys().Get(serviceKeyGUID)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return serviceKey.Metadata.GUID == serviceKeyGUID, nil
-}
@@ -4,22 +4,25 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/bluemix-go/api/mccp/mccpv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceIBMSpace() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSpaceCreate,
-		Read:     resourceIBMSpaceRead,
-		Update:   resourceIBMSpaceUpdate,
-		Delete:   resourceIBMSpaceDelete,
-		Exists:   resourceIBMSpaceExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSpaceCreate,
+		ReadContext:   resourceIBMSpaceRead,
+		UpdateContext: resourceIBMSpaceUpdate,
+		DeleteContext: resourceIBMSpaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMSpace, nil),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -67,10 +70,10 @@ func resourceIBMSpace() *schema.Resource {
 	}
 }
 
-func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	org := d.Get("org").(string)
 	name := d.Get("name").(string)
@@ -81,14 +84,14 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	orgFields, err := cfClient.Organizations().FindByName(org, BluemixRegion)
 	if err != nil {
-		return fmt.Errorf("Error retrieving org: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving org: %s", err))
 	}
 	req.OrgGUID = orgFields.GUID
 
 	if spaceQuota, ok := d.GetOk("space_quota"); ok {
 		quota, err := cfClient.SpaceQuotas().FindByName(spaceQuota.(string), orgFields.GUID)
 		if err != nil {
-			return fmt.Errorf("Error retrieving space quota: %s", err)
+			return diagFromErr(newAttributeError("space_quota", "Error retrieving space quota", err))
 		}
 		req.SpaceQuotaGUID = quota.GUID
 	}
@@ -96,7 +99,7 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 	spaceAPI := cfClient.Spaces()
 	space, err := spaceAPI.Create(req)
 	if err != nil {
-		return fmt.Errorf("Error creating space: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating space: %s", err))
 	}
 
 	spaceGUID := space.Metadata.GUID
@@ -107,7 +110,7 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, d := range developers {
 			_, err := spaceAPI.AssociateDeveloper(spaceGUID, d)
 			if err != nil {
-				return fmt.Errorf("Error associating developer %s with space %s : %s", d, spaceGUID, err)
+				return diagFromErr(fmt.Errorf("Error associating developer %s with space %s : %s", d, spaceGUID, err))
 			}
 		}
 	}
@@ -117,7 +120,7 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, d := range auditors {
 			_, err := spaceAPI.AssociateAuditor(spaceGUID, d)
 			if err != nil {
-				return fmt.Errorf("Error associating auditor %s with space %s : %s", d, spaceGUID, err)
+				return diagFromErr(fmt.Errorf("Error associating auditor %s with space %s : %s", d, spaceGUID, err))
 			}
 		}
 
@@ -127,18 +130,18 @@ func resourceIBMSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		for _, d := range managers {
 			_, err := spaceAPI.AssociateManager(spaceGUID, d)
 			if err != nil {
-				return fmt.Errorf("Error associating manager %s with space %s : %s", d, spaceGUID, err)
+				return diagFromErr(fmt.Errorf("Error associating manager %s with space %s : %s", d, spaceGUID, err))
 			}
 		}
 	}
 
-	return resourceIBMSpaceRead(d, meta)
+	return resourceIBMSpaceRead(ctx, d, meta)
 }
 
-func resourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	spaceGUID := d.Id()
 
@@ -146,22 +149,26 @@ func resourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
 	orgAPI := cfClient.Organizations()
 	spaceDetails, err := spaceAPI.Get(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving space: %s", err)
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving space: %s", err))
 	}
 
 	auditors, err := spaceAPI.ListAuditors(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving auditors in the space: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving auditors in the space: %s", err))
 	}
 
 	managers, err := spaceAPI.ListManagers(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving managers in the space: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving managers in the space: %s", err))
 	}
 
 	developers, err := spaceAPI.ListDevelopers(spaceGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving developers in space: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving developers in space: %s", err))
 	}
 
 	d.Set("auditors", flattenSpaceRoleUsers(auditors))
@@ -172,23 +179,23 @@ func resourceIBMSpaceRead(d *schema.ResourceData, meta interface{}) error {
 		sqAPI := cfClient.SpaceQuotas()
 		quota, err := sqAPI.Get(spaceDetails.Entity.SpaceQuotaGUID)
 		if err != nil {
-			return fmt.Errorf("Error retrieving quotas details for space: %s", err)
+			return diagFromErr(fmt.Errorf("Error retrieving quotas details for space: %s", err))
 		}
 		d.Set("space_quota", quota.Entity.Name)
 	}
 	d.Set("name", spaceDetails.Entity.Name)
 	org, err := orgAPI.Get(spaceDetails.Entity.OrgGUID)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Organization details for space: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving Organization details for space: %s", err))
 	}
 	d.Set("org", org.Entity.Name)
 	return nil
 }
 
-func resourceIBMSpaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 
@@ -200,60 +207,40 @@ func resourceIBMSpaceUpdate(d *schema.ResourceData, meta interface{}) error {
 	api := cfClient.Spaces()
 	_, err = api.Update(id, req)
 	if err != nil {
-		return fmt.Errorf("Error updating space: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating space: %s", err))
 	}
 
 	err = updateAuditors(api, id, d)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	err = updateManagers(api, id, d)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	err = updateDevelopers(api, id, d)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceIBMSpaceRead(d, meta)
+	return resourceIBMSpaceRead(ctx, d, meta)
 }
 
-func resourceIBMSpaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSpaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfClient, err := meta.(ClientSession).MccpAPI()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	id := d.Id()
 
 	err = cfClient.Spaces().Delete(id, false)
 	if err != nil {
-		return fmt.Errorf("Error deleting space: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting space: %s", err))
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceIBMSpaceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	cfClient, err := meta.(ClientSession).MccpAPI()
-	if err != nil {
-		return false, err
-	}
-	id := d.Id()
-
-	space, err := cfClient.Spaces().Get(id)
-	if err != nil {
-		if apiErr, ok := err.(bmxerror.RequestFailure); ok {
-			if apiErr.StatusCode() == 404 {
-				return false, nil
-			}
-		}
-		return false, fmt.Errorf("Error communicating with the API: %s", err)
-	}
-
-	return space.Metadata.GUID == id, nil
-}
-
 func updateDevelopers(api mccpv2.Spaces, spaceGUID string, d *schema.ResourceData) error {
 	if !d.HasChange("developers") {
 		return nil
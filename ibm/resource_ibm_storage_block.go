@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
 	"github.com/softlayer/softlayer-go/helpers/network"
@@ -20,12 +22,13 @@ import (
 
 func resourceIBMStorageBlock() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMStorageBlockCreate,
-		Read:     resourceIBMStorageBlockRead,
-		Update:   resourceIBMStorageBlockUpdate,
-		Delete:   resourceIBMStorageBlockDelete,
-		Exists:   resourceIBMStorageBlockExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMStorageBlockCreate,
+		ReadContext:   resourceIBMStorageBlockRead,
+		UpdateContext: resourceIBMStorageBlockUpdate,
+		DeleteContext: resourceIBMStorageBlockDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMStorageBlock, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(45 * time.Minute),
@@ -246,7 +249,7 @@ func resourceIBMStorageBlock() *schema.Resource {
 	}
 }
 
-func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageBlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	storageType := d.Get("type").(string)
@@ -259,12 +262,12 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 	hourlyBilling := d.Get("hourly_billing").(bool)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	storageOrderContainer, err := buildStorageProductOrderContainer(sess, storageType, iops, capacity, snapshotCapacity, blockStorage, datacenter, hourlyBilling)
 	if err != nil {
-		return fmt.Errorf("Error while creating storage:%s", err)
+		return diagFromErr(fmt.Errorf("Error while creating storage:%s", err))
 	}
 
 	log.Println("[INFO] Creating storage")
@@ -294,43 +297,43 @@ func resourceIBMStorageBlockCreate(d *schema.ResourceData, meta interface{}) err
 				VolumeSize: &capacity,
 			}, sl.Bool(false))
 	default:
-		return fmt.Errorf("Error during creation of storage: Invalid storageType %s", storageType)
+		return diagFromErr(fmt.Errorf("Error during creation of storage: Invalid storageType %s", storageType))
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 
 	// Find the storage device
-	blockStorage, err := findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	blockStorage, err := findStorageByOrderId(ctx, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *blockStorage.Id))
 
 	// Wait for storage availability
-	_, err = WaitForStorageAvailable(d, meta)
+	_, err = WaitForStorageAvailable(ctx, d, meta)
 
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for storage (%s) to become ready: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for storage (%s) to become ready: %s", d.Id(), err))
 	}
 
 	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
-	blockStorage, err = findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	blockStorage, err = findStorageByOrderId(ctx, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *blockStorage.Id))
 
 	log.Printf("[INFO] Storage ID: %s", d.Id())
 
-	return resourceIBMStorageBlockUpdate(d, meta)
+	return resourceIBMStorageBlockUpdate(ctx, d, meta)
 }
 
-func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageBlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	storageId, _ := strconv.Atoi(d.Id())
 
@@ -340,7 +343,11 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving storage information: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving storage information: %s", err))
 	}
 
 	storageType := strings.Fields(*storage.StorageType.Description)[0]
@@ -348,7 +355,7 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 	// Calculate IOPS
 	iops, err := getIops(storage, storageType)
 	if err != nil {
-		return fmt.Errorf("Error retrieving storage information: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving storage information: %s", err))
 	}
 
 	d.Set("type", storageType)
@@ -436,11 +443,11 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageBlockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	storage, err := services.GetNetworkStorageService(sess).
@@ -449,14 +456,14 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error updating storage information: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating storage information: %s", err))
 	}
 
 	// Update allowed_ip_addresses
 	if d.HasChange("allowed_ip_addresses") {
 		err := updateAllowedIpAddresses(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diagFromErr(newAttributeError("allowed_ip_addresses", "Error updating storage information", err))
 		}
 	}
 
@@ -464,7 +471,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("allowed_subnets") {
 		err := updateAllowedSubnets(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diagFromErr(newAttributeError("allowed_subnets", "Error updating storage information", err))
 		}
 	}
 
@@ -472,7 +479,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("allowed_virtual_guest_ids") {
 		err := updateAllowedVirtualGuestIds(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diagFromErr(newAttributeError("allowed_virtual_guest_ids", "Error updating storage information", err))
 		}
 	}
 
@@ -480,7 +487,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("allowed_hardware_ids") {
 		err := updateAllowedHardwareIds(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diagFromErr(newAttributeError("allowed_hardware_ids", "Error updating storage information", err))
 		}
 	}
 
@@ -488,7 +495,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("notes") {
 		err := updateNotes(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diagFromErr(newAttributeError("notes", "Error updating storage information", err))
 		}
 	}
 
@@ -498,7 +505,7 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 
 		modifyOrder, err := prepareModifyOrder(sess, storage, iops, size)
 		if err != nil {
-			return fmt.Errorf("Error updating storage: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating storage: %s", err))
 		}
 
 		_, err = services.GetProductOrderService(sess.SetRetries(0)).PlaceOrder(
@@ -509,21 +516,17 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 				},
 			}, sl.Bool(false))
 		// Wait for storage availability
-		_, err = WaitForStorageUpdate(d, meta)
+		_, err = WaitForStorageUpdate(ctx, d, meta)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for storage (%s) to update: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for storage (%s) to update: %s", d.Id(), err))
 		}
 	}
 
-	return resourceIBMStorageBlockRead(d, meta)
-}
-
-func resourceIBMStorageBlockDelete(d *schema.ResourceData, meta interface{}) error {
-	return resourceIBMStorageFileDelete(d, meta)
+	return resourceIBMStorageBlockRead(ctx, d, meta)
 }
 
-func resourceIBMStorageBlockExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	return resourceIBMStorageFileExists(d, meta)
+func resourceIBMStorageBlockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceIBMStorageFileDelete(ctx, d, meta)
 }
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -22,12 +24,13 @@ import (
 
 func resourceIBMStorageEvault() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMStorageEvaultCreate,
-		Read:     resourceIBMStorageEvaultRead,
-		Update:   resourceIBMStorageEvaultUpdate,
-		Delete:   resourceIBMStorageEvaultDelete,
-		Exists:   resourceIBMStorageEvaultExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMStorageEvaultCreate,
+		ReadContext:   resourceIBMStorageEvaultRead,
+		UpdateContext: resourceIBMStorageEvaultUpdate,
+		DeleteContext: resourceIBMStorageEvaultDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMStorageEvault, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -92,13 +95,13 @@ const (
 	evaultStorageMask   = "id,billingItem.orderItem.order.id"
 )
 
-func resourceIBMStorageEvaultCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageEvaultCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	// Find price items
 	productOrderContainer, err := buildEvaultProductOrderContainer(d, sess)
 	if err != nil {
-		return fmt.Errorf("Error creating evault: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating evault: %s", err))
 	}
 
 	log.Println("[INFO] Creating Evault")
@@ -106,35 +109,35 @@ func resourceIBMStorageEvaultCreate(d *schema.ResourceData, meta interface{}) er
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of evault: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of evault: %s", err))
 	}
-	evaultStorage, err := findEvaultStorageByOrderID(d, meta, *receipt.OrderId)
+	evaultStorage, err := findEvaultStorageByOrderID(ctx, d, meta, *receipt.OrderId)
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *evaultStorage.Id))
 
 	// Wait for storage availability
-	_, err = WaitForEvaultAvailable(d, meta, schema.TimeoutCreate)
+	_, err = WaitForEvaultAvailable(ctx, d, meta, schema.TimeoutCreate)
 
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for evault (%s) to become ready: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for evault (%s) to become ready: %s", d.Id(), err))
 	}
 
 	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
-	evaultStorage, err = findEvaultStorageByOrderID(d, meta, *receipt.OrderId)
+	evaultStorage, err = findEvaultStorageByOrderID(ctx, d, meta, *receipt.OrderId)
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *evaultStorage.Id))
 
 	log.Printf("[INFO] Storage ID: %s", d.Id())
 
-	return resourceIBMStorageEvaultRead(d, meta)
+	return resourceIBMStorageEvaultRead(ctx, d, meta)
 }
 
 func buildEvaultProductOrderContainer(d *schema.ResourceData, sess *session.Session) (
@@ -222,7 +225,7 @@ func buildEvaultProductOrderContainer(d *schema.ResourceData, sess *session.Sess
 	return &order, nil
 }
 
-func resourceIBMStorageEvaultRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageEvaultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	evaultID, _ := strconv.Atoi(d.Id())
@@ -232,7 +235,11 @@ func resourceIBMStorageEvaultRead(d *schema.ResourceData, meta interface{}) erro
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving evault information: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving evault information: %s", err))
 	}
 
 	d.Set("capacity", evault.CapacityGb)
@@ -253,43 +260,43 @@ func resourceIBMStorageEvaultRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceIBMStorageEvaultUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageEvaultUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	if d.HasChange("capacity") && !d.IsNewResource() {
 		sess := meta.(ClientSession).SoftLayerSession()
 
 		evaultID, err := strconv.Atoi(d.Id())
 		if err != nil {
-			return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+			return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 		}
 
 		priceID, err := getEvaultUpgradePriceItem(d, sess)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		_, err = services.GetNetworkStorageBackupEvaultService(sess).
 			Id(evaultID).UpgradeVolumeCapacity(sl.Int(priceID))
 
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		// Wait for storage availability
-		_, err = WaitForEvaultAvailable(d, meta, schema.TimeoutUpdate)
+		_, err = WaitForEvaultAvailable(ctx, d, meta, schema.TimeoutUpdate)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for evault upgrade (%s) to become ready: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for evault upgrade (%s) to become ready: %s", d.Id(), err))
 		}
 
-		return resourceIBMStorageEvaultRead(d, meta)
+		return resourceIBMStorageEvaultRead(ctx, d, meta)
 	}
 	return nil
 
 }
 
-func resourceIBMStorageEvaultDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageEvaultDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	evaultService := services.GetNetworkStorageBackupEvaultService(sess)
 	evaultID, _ := strconv.Atoi(d.Id())
@@ -298,25 +305,25 @@ func resourceIBMStorageEvaultDelete(d *schema.ResourceData, meta interface{}) er
 	billingItem, err := evaultService.Id(evaultID).GetBillingItem()
 
 	if err != nil {
-		return fmt.Errorf("Error while looking up billing item associated with the evault: %s", err)
+		return diagFromErr(fmt.Errorf("Error while looking up billing item associated with the evault: %s", err))
 	}
 
 	if billingItem.Id == nil {
-		return fmt.Errorf("Error while looking up billing item associated with the evault: No billing item for ID:%d", evaultID)
+		return diagFromErr(fmt.Errorf("Error while looking up billing item associated with the evault: No billing item for ID:%d", evaultID))
 	}
 
 	success, err := services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diagFromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 	return nil
 }
 
-func findEvaultStorageByOrderID(d *schema.ResourceData, meta interface{}, orderId int) (datatypes.Network_Storage, error) {
+func findEvaultStorageByOrderID(ctx context.Context, d *schema.ResourceData, meta interface{}, orderId int) (datatypes.Network_Storage, error) {
 	filterPath := "evaultNetworkStorage.billingItem.orderItem.order.id"
 	sess := meta.(ClientSession).SoftLayerSession()
 
@@ -349,7 +356,7 @@ func findEvaultStorageByOrderID(d *schema.ResourceData, meta interface{}, orderI
 		NotFoundChecks: 300,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Storage{}, err
@@ -366,7 +373,7 @@ func findEvaultStorageByOrderID(d *schema.ResourceData, meta interface{}, orderI
 }
 
 // Waits for storage provisioning
-func WaitForEvaultAvailable(d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
+func WaitForEvaultAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) (interface{}, error) {
 	log.Printf("Waiting for evault (%s) to be available.", d.Id())
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -399,28 +406,7 @@ func WaitForEvaultAvailable(d *schema.ResourceData, meta interface{}, timeout st
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
-}
-
-func resourceIBMStorageEvaultExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-
-	evaultID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	_, err = services.GetNetworkStorageBackupEvaultService(sess).
-		Id(evaultID).
-		GetObject()
-
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error retrieving evault information: %s", err)
-	}
-	return true, nil
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func getEvaultUpgradePriceItem(d *schema.ResourceData, sess *session.Session) (int, error) {
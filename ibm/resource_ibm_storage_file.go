@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -13,6 +14,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -67,12 +69,13 @@ var (
 
 func resourceIBMStorageFile() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMStorageFileCreate,
-		Read:     resourceIBMStorageFileRead,
-		Update:   resourceIBMStorageFileUpdate,
-		Delete:   resourceIBMStorageFileDelete,
-		Exists:   resourceIBMStorageFileExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMStorageFileCreate,
+		ReadContext:   resourceIBMStorageFileRead,
+		UpdateContext: resourceIBMStorageFileUpdate,
+		DeleteContext: resourceIBMStorageFileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMStorageFile, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(45 * time.Minute),
@@ -256,7 +259,7 @@ func resourceIBMStorageFile() *schema.Resource {
 	}
 }
 
-func resourceIBMStorageFileCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	storageType := d.Get("type").(string)
@@ -273,7 +276,7 @@ func resourceIBMStorageFileCreate(d *schema.ResourceData, meta interface{}) erro
 
 	storageOrderContainer, err = buildStorageProductOrderContainer(sess, storageType, iops, capacity, snapshotCapacity, fileStorage, datacenter, hourlyBilling)
 	if err != nil {
-		return fmt.Errorf("Error while creating storage:%s", err)
+		return diagFromErr(fmt.Errorf("Error while creating storage:%s", err))
 	}
 
 	log.Println("[INFO] Creating storage")
@@ -296,43 +299,43 @@ func resourceIBMStorageFileCreate(d *schema.ResourceData, meta interface{}) erro
 			}, sl.Bool(false))
 
 	default:
-		return fmt.Errorf("Error during creation of storage: Invalid storageType %s", storageType)
+		return diagFromErr(fmt.Errorf("Error during creation of storage: Invalid storageType %s", storageType))
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 
 	// Find the storage device
-	fileStorage, err := findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	fileStorage, err := findStorageByOrderId(ctx, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *fileStorage.Id))
 
 	// Wait for storage availability
-	_, err = WaitForStorageAvailable(d, meta)
+	_, err = WaitForStorageAvailable(ctx, d, meta)
 
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for storage (%s) to become ready: %s", d.Id(), err)
+		return diagFromErr(fmt.Errorf(
+			"Error waiting for storage (%s) to become ready: %s", d.Id(), err))
 	}
 
 	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
-	fileStorage, err = findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	fileStorage, err = findStorageByOrderId(ctx, sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("Error during creation of storage: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of storage: %s", err))
 	}
 	d.SetId(fmt.Sprintf("%d", *fileStorage.Id))
 
 	log.Printf("[INFO] Storage ID: %s", d.Id())
 
-	return resourceIBMStorageFileUpdate(d, meta)
+	return resourceIBMStorageFileUpdate(ctx, d, meta)
 }
 
-func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	storageId, _ := strconv.Atoi(d.Id())
@@ -343,18 +346,22 @@ func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving storage information: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving storage information: %s", err))
 	}
 
 	storageType, err := getStorageTypeFromKeyName(*storage.StorageType.KeyName)
 	if err != nil {
-		return fmt.Errorf("Error retrieving storage information: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving storage information: %s", err))
 	}
 
 	// Calculate IOPS
 	iops, err := getIops(storage, storageType)
 	if err != nil {
-		return fmt.Errorf("Error retrieving storage information: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving storage information: %s", err))
 	}
 	d.Set("iops", iops)
 
@@ -411,7 +418,7 @@ func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error
 
 	mountpoint, err := services.GetNetworkStorageService(sess).Id(storageId).GetFileNetworkMountAddress()
 	if err != nil {
-		return fmt.Errorf("Error retrieving storage information: %s", err)
+		return diagFromErr(fmt.Errorf("Error retrieving storage information: %s", err))
 	}
 	d.Set("mountpoint", mountpoint)
 
@@ -455,11 +462,11 @@ func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageFileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid ID, must be an integer: %s", err))
 	}
 
 	storage, err := services.GetNetworkStorageService(sess).
@@ -468,14 +475,14 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 		GetObject()
 
 	if err != nil {
-		return fmt.Errorf("Error updating storage information: %s", err)
+		return diagFromErr(fmt.Errorf("Error updating storage information: %s", err))
 	}
 
 	// Update allowed_ip_addresses
 	if d.HasChange("allowed_ip_addresses") {
 		err := updateAllowedIpAddresses(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diagFromErr(newAttributeError("allowed_ip_addresses", "Error updating storage information", err))
 		}
 	}
 
@@ -483,7 +490,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("allowed_subnets") {
 		err := updateAllowedSubnets(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diagFromErr(newAttributeError("allowed_subnets", "Error updating storage information", err))
 		}
 	}
 
@@ -491,7 +498,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("allowed_virtual_guest_ids") {
 		err := updateAllowedVirtualGuestIds(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diagFromErr(newAttributeError("allowed_virtual_guest_ids", "Error updating storage information", err))
 		}
 	}
 
@@ -499,7 +506,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("allowed_hardware_ids") {
 		err := updateAllowedHardwareIds(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diagFromErr(newAttributeError("allowed_hardware_ids", "Error updating storage information", err))
 		}
 	}
 
@@ -507,7 +514,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("notes") {
 		err := updateNotes(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error updating storage information: %s", err)
+			return diagFromErr(newAttributeError("notes", "Error updating storage information", err))
 		}
 	}
 
@@ -515,7 +522,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChange("snapshot_schedule") {
 		err := enableStorageSnapshot(d, sess, storage)
 		if err != nil {
-			return fmt.Errorf("Error creating storage snapshot schedule: %s", err)
+			return diagFromErr(newAttributeError("snapshot_schedule", "Error creating storage snapshot schedule", err))
 		}
 	}
 
@@ -525,7 +532,7 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 
 		modifyOrder, err := prepareModifyOrder(sess, storage, iops, size)
 		if err != nil {
-			return fmt.Errorf("Error updating storage: %s", err)
+			return diagFromErr(fmt.Errorf("Error updating storage: %s", err))
 		}
 
 		_, err = services.GetProductOrderService(sess.SetRetries(0)).PlaceOrder(
@@ -536,18 +543,18 @@ func resourceIBMStorageFileUpdate(d *schema.ResourceData, meta interface{}) erro
 				},
 			}, sl.Bool(false))
 		// Wait for storage availability
-		_, err = WaitForStorageUpdate(d, meta)
+		_, err = WaitForStorageUpdate(ctx, d, meta)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for storage (%s) to update: %s", d.Id(), err)
+			return diagFromErr(fmt.Errorf(
+				"Error waiting for storage (%s) to update: %s", d.Id(), err))
 		}
 	}
 
-	return resourceIBMStorageFileRead(d, meta)
+	return resourceIBMStorageFileRead(ctx, d, meta)
 }
 
-func resourceIBMStorageFileDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMStorageFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	storageService := services.GetNetworkStorageService(sess)
 	storageID, _ := strconv.Atoi(d.Id())
@@ -556,45 +563,24 @@ func resourceIBMStorageFileDelete(d *schema.ResourceData, meta interface{}) erro
 	billingItem, err := storageService.Id(storageID).GetBillingItem()
 
 	if err != nil {
-		return fmt.Errorf("Error while looking up billing item associated with the storage: %s", err)
+		return diagFromErr(fmt.Errorf("Error while looking up billing item associated with the storage: %s", err))
 	}
 
 	if billingItem.Id == nil {
-		return fmt.Errorf("Error while looking up billing item associated with the storage: No billing item for ID:%d", storageID)
+		return diagFromErr(fmt.Errorf("Error while looking up billing item associated with the storage: No billing item for ID:%d", storageID))
 	}
 
 	success, err := services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if !success {
-		return fmt.Errorf("SoftLayer reported an unsuccessful cancellation")
+		return diagFromErr(fmt.Errorf("SoftLayer reported an unsuccessful cancellation"))
 	}
 	return nil
 }
 
-func resourceIBMStorageFileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-
-	storageID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
-	}
-
-	_, err = services.GetNetworkStorageService(sess).
-		Id(storageID).
-		GetObject()
-
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error retrieving storage information: %s", err)
-	}
-	return true, nil
-}
-
 func buildStorageProductOrderContainer(
 	sess *session.Session,
 	storageType string,
@@ -698,7 +684,7 @@ func buildStorageProductOrderContainer(
 	return productOrderContainer, nil
 }
 
-func findStorageByOrderId(sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Storage, error) {
+func findStorageByOrderId(ctx context.Context, sess *session.Session, orderId int, timeout time.Duration) (datatypes.Network_Storage, error) {
 	filterPath := "networkStorage.billingItem.orderItem.order.id"
 
 	stateConf := &resource.StateChangeConf{
@@ -729,7 +715,7 @@ func findStorageByOrderId(sess *session.Session, orderId int, timeout time.Durat
 		NotFoundChecks: 300,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Storage{}, err
@@ -746,7 +732,7 @@ func findStorageByOrderId(sess *session.Session, orderId int, timeout time.Durat
 }
 
 // Waits for storage provisioning
-func WaitForStorageAvailable(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForStorageAvailable(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for storage (%s) to be available.", d.Id())
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -798,7 +784,7 @@ func WaitForStorageAvailable(d *schema.ResourceData, meta interface{}) (interfac
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func getIops(storage datatypes.Network_Storage, storageType string) (float64, error) {
@@ -1516,7 +1502,7 @@ func findEnduranceTierIopsPerGb(originalVolume datatypes.Network_Storage) (iopsP
 }
 
 // Waits for storage update
-func WaitForStorageUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+func WaitForStorageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("Waiting for storage (%s) to be updated.", d.Id())
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -1555,5 +1541,5 @@ func WaitForStorageUpdate(d *schema.ResourceData, meta interface{}) (interface{}
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
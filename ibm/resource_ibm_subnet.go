@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/datatypes"
@@ -36,12 +38,13 @@ var (
 
 func resourceIBMSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMSubnetCreate,
-		Read:     resourceIBMSubnetRead,
-		Update:   resourceIBMSubnetUpdate,
-		Delete:   resourceIBMSubnetDelete,
-		Exists:   resourceIBMSubnetExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMSubnetCreate,
+		ReadContext:   resourceIBMSubnetRead,
+		UpdateContext: resourceIBMSubnetUpdate,
+		DeleteContext: resourceIBMSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMSubnet, nil),
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
@@ -138,13 +141,13 @@ func resourceIBMSubnet() *schema.Resource {
 	}
 }
 
-func resourceIBMSubnetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 
 	// Find price items with AdditionalServicesSubnetAddresses
 	productOrderContainer, err := buildSubnetProductOrderContainer(d, sess)
 	if err != nil {
-		return fmt.Errorf("Error creating subnet: %s", err)
+		return diagFromErr(fmt.Errorf("Error creating subnet: %s", err))
 	}
 
 	log.Println("[INFO] Creating subnet")
@@ -152,31 +155,35 @@ func resourceIBMSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).
 		PlaceOrder(productOrderContainer, sl.Bool(false))
 	if err != nil {
-		return fmt.Errorf("Error during creation of subnet: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of subnet: %s", err))
 	}
 
-	Subnet, err := findSubnetByOrderID(sess, *receipt.OrderId, d)
+	Subnet, err := findSubnetByOrderID(ctx, sess, *receipt.OrderId, d)
 	if err != nil {
-		return fmt.Errorf("Error during creation of subnet: %s", err)
+		return diagFromErr(fmt.Errorf("Error during creation of subnet: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", *Subnet.Id))
 
-	return resourceIBMSubnetUpdate(d, meta)
+	return resourceIBMSubnetUpdate(ctx, d, meta)
 }
 
-func resourceIBMSubnetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetService(sess)
 
 	subnetID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err))
 	}
 
 	subnet, err := service.Id(subnetID).Mask(SubnetMask).GetObject()
 	if err != nil {
-		return fmt.Errorf("Error retrieving a subnet: %s", err)
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(fmt.Errorf("Error retrieving a subnet: %s", err))
 	}
 
 	if *subnet.AddressSpace == "PRIVATE" {
@@ -186,14 +193,14 @@ func resourceIBMSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if subnet.SubnetType == nil {
-		return fmt.Errorf("Invalid vlan type: the subnet type is null")
+		return diagFromErr(fmt.Errorf("Invalid vlan type: the subnet type is null"))
 	}
 	if strings.Contains(*subnet.SubnetType, "STATIC") {
 		d.Set("type", "Static")
 	} else if strings.Contains(*subnet.SubnetType, "VLAN") {
 		d.Set("type", "Portable")
 	} else {
-		return fmt.Errorf("Invalid vlan type: %s", *subnet.SubnetType)
+		return diagFromErr(fmt.Errorf("Invalid vlan type: %s", *subnet.SubnetType))
 	}
 	d.Set("ip_version", *subnet.Version)
 	d.Set("capacity", *subnet.TotalIpAddresses)
@@ -215,36 +222,36 @@ func resourceIBMSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceIBMSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetService(sess)
 
 	subnetID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err))
 	}
 
 	if d.HasChange("notes") {
 		_, err = service.Id(subnetID).EditNote(sl.String(d.Get("notes").(string)))
 		if err != nil {
-			return fmt.Errorf("Error updating subnet: %s", err)
+			return diagFromErr(newAttributeError("notes", "Error updating subnet", err))
 		}
 	}
-	return resourceIBMSubnetRead(d, meta)
+	return resourceIBMSubnetRead(ctx, d, meta)
 }
 
-func resourceIBMSubnetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess := meta.(ClientSession).SoftLayerSession()
 	service := services.GetNetworkSubnetService(sess)
 
 	subnetID, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Not a valid subnet ID, must be an integer: %s", err))
 	}
 
 	billingItem, err := service.Id(subnetID).GetBillingItem()
 	if err != nil {
-		return fmt.Errorf("Error deleting subnet: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting subnet: %s", err))
 	}
 
 	if billingItem.Id == nil {
@@ -252,32 +259,13 @@ func resourceIBMSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	_, err = services.GetBillingItemService(sess).Id(*billingItem.Id).CancelService()
 	if err != nil {
-		return fmt.Errorf("Error deleting subnet: %s", err)
-	}
-
-	return err
-}
-
-func resourceIBMSubnetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess := meta.(ClientSession).SoftLayerSession()
-	service := services.GetNetworkSubnetService(sess)
-
-	subnetID, err := strconv.Atoi(d.Id())
-	if err != nil {
-		return false, fmt.Errorf("Not a valid ID, must be an integer: %s", err)
+		return diagFromErr(fmt.Errorf("Error deleting subnet: %s", err))
 	}
 
-	result, err := service.Id(subnetID).GetObject()
-	if err != nil {
-		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
-			return false, nil
-		}
-		return false, fmt.Errorf("Error retrieving subnet: %s", err)
-	}
-	return result.Id != nil && *result.Id == subnetID, nil
+	return diagFromErr(err)
 }
 
-func findSubnetByOrderID(sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Subnet, error) {
+func findSubnetByOrderID(ctx context.Context, sess *session.Session, orderID int, d *schema.ResourceData) (datatypes.Network_Subnet, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"complete"},
@@ -302,7 +290,7 @@ func findSubnetByOrderID(sess *session.Session, orderID int, d *schema.ResourceD
 		NotFoundChecks: 1440,
 	}
 
-	pendingResult, err := stateConf.WaitForState()
+	pendingResult, err := stateConf.WaitForStateContext(ctx)
 
 	if err != nil {
 		return datatypes.Network_Subnet{}, err
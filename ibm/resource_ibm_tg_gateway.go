@@ -7,10 +7,10 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -37,12 +37,13 @@ const (
 
 func resourceIBMTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayCreate,
-		Read:     resourceIBMTransitGatewayRead,
-		Delete:   resourceIBMTransitGatewayDelete,
-		Exists:   resourceIBMTransitGatewayExists,
-		Update:   resourceIBMTransitGatewayUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayCreate,
+		ReadContext:   resourceIBMTransitGatewayRead,
+		DeleteContext: resourceIBMTransitGatewayDelete,
+		UpdateContext: resourceIBMTransitGatewayUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMTransitGateway, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -52,7 +53,7 @@ func resourceIBMTransitGateway() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return resourceTagsCustomizeDiff(diff)
+				return resourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -88,6 +89,7 @@ func resourceIBMTransitGateway() *schema.Resource {
 				Set:         resourceIBMVPCHash,
 				Description: "Tags for the transit gateway instance",
 			},
+			"tags_all": resourceTagsAllSchema(),
 
 			tgResourceGroup: {
 				Type:     schema.TypeString,
@@ -171,10 +173,10 @@ func transitgatewayClient(meta interface{}) (*transitgatewayapisv1.TransitGatewa
 	return sess, err
 }
 
-func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	location := d.Get(tgLocation).(string)
@@ -197,29 +199,28 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 
 	if err != nil {
 		log.Printf("[DEBUG] Create Transit Gateway err %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId(*tgw.ID)
 
-	_, err = isWaitForTransitGatewayAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	v := os.Getenv("IC_ENV_TAGS")
-	if _, ok := d.GetOk(tgGatewayTags); ok || v != "" {
-		oldList, newList := d.GetChange(tgGatewayTags)
+	if _, ok := d.GetOk("tags_all"); ok {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
 			log.Printf(
 				"Error on create of transit gateway (%s) tags: %s", d.Id(), err)
 		}
 	}
-	return resourceIBMTransitGatewayRead(d, meta)
+	return resourceIBMTransitGatewayRead(ctx, d, meta)
 }
 
-func isWaitForTransitGatewayAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -231,7 +232,7 @@ func isWaitForTransitGatewayAvailable(client *transitgatewayapisv1.TransitGatewa
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isTransitGatewayRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
@@ -252,11 +253,11 @@ func isTransitGatewayRefreshFunc(client *transitgatewayapisv1.TransitGatewayApis
 	}
 }
 
-func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	tgOptions := &transitgatewayapisv1.GetTransitGatewayOptions{}
 	if id != "" {
@@ -269,7 +270,7 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(*tgw.ID)
@@ -289,11 +290,11 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 		log.Printf(
 			"Error on get of transit gateway (%s) tags: %s", d.Id(), err)
 	}
-	d.Set(tgGatewayTags, tags)
+	setResourceTags(d, meta, tags)
 
 	controller, err := getBaseController(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set(ResourceControllerURL, controller+"/interconnectivity/transit")
@@ -308,11 +309,11 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -323,7 +324,7 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if err != nil {
 		log.Printf("Error fetching Tranisit  Gateway: %s", resp)
-		return err
+		return diagFromErr(err)
 	}
 
 	updateTransitGatewayOptions := &transitgatewayapisv1.UpdateTransitGatewayOptions{}
@@ -340,8 +341,8 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 			updateTransitGatewayOptions.Global = &global
 		}
 	}
-	if d.HasChange(tgGatewayTags) {
-		oldList, newList := d.GetChange(tgGatewayTags)
+	if d.HasChange("tags_all") {
+		oldList, newList := d.GetChange("tags_all")
 		err = UpdateTagsUsingCRN(oldList, newList, meta, *tgw.Crn)
 		if err != nil {
 			log.Printf(
@@ -352,17 +353,17 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 	_, response, err := client.UpdateTransitGateway(updateTransitGatewayOptions)
 	if err != nil {
 		log.Printf("[DEBUG] Update Transit Gateway err %s\n%s", err, response)
-		return err
+		return diagFromErr(err)
 	}
 
-	return resourceIBMTransitGatewayRead(d, meta)
+	return resourceIBMTransitGatewayRead(ctx, d, meta)
 }
 
-func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	ID := d.Id()
@@ -375,17 +376,17 @@ func resourceIBMTransitGatewayDelete(d *schema.ResourceData, meta interface{}) e
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Transit Gateway (%s): %s\n%s", ID, err, response)
+		return diagFromErr(fmt.Errorf("Error deleting Transit Gateway (%s): %s\n%s", ID, err, response))
 	}
-	_, err = isWaitForTransitGatewayDeleted(client, ID, d.Timeout(schema.TimeoutDelete))
+	_, err = isWaitForTransitGatewayDeleted(ctx, client, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func isWaitForTransitGatewayDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayDeleted(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway (%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -397,7 +398,7 @@ func isWaitForTransitGatewayDeleted(client *transitgatewayapisv1.TransitGatewayA
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isTransitGatewayDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
@@ -416,28 +417,3 @@ func isTransitGatewayDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatew
 		return transitGateway, isTransitGatewayDeleting, err
 	}
 }
-
-func resourceIBMTransitGatewayExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-
-	client, err := transitgatewayClient(meta)
-	if err != nil {
-		return false, err
-	}
-
-	ID := d.Id()
-
-	tgOptions := &transitgatewayapisv1.GetTransitGatewayOptions{}
-	if ID != "" {
-		tgOptions.ID = &ID
-	}
-	_, response, err := client.GetTransitGateway(tgOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			d.SetId("")
-			return false, nil
-		}
-		return false, fmt.Errorf("Error Getting Transit Gateway: %s\n%s", err, response)
-	}
-
-	return true, nil
-}
@@ -4,11 +4,13 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -32,12 +34,13 @@ const (
 
 func resourceIBMTransitGatewayConnection() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMTransitGatewayConnectionCreate,
-		Read:     resourceIBMTransitGatewayConnectionRead,
-		Delete:   resourceIBMTransitGatewayConnectionDelete,
-		Exists:   resourceIBMTransitGatewayConnectionExists,
-		Update:   resourceIBMTransitGatewayConnectionUpdate,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMTransitGatewayConnectionCreate,
+		ReadContext:   resourceIBMTransitGatewayConnectionRead,
+		DeleteContext: resourceIBMTransitGatewayConnectionDelete,
+		UpdateContext: resourceIBMTransitGatewayConnectionUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithRead(resourceIBMTransitGatewayConnection, nil),
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -138,10 +141,10 @@ func resourceIBMTransitGatewayConnectionValidator() *ResourceValidator {
 
 	return &ibmTransitGatewayConnectionResourceValidator
 }
-func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	createTransitGatewayConnectionOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionOptions{}
@@ -167,7 +170,7 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 
 	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("Create Transit Gateway connection err %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Create Transit Gateway connection err %s\n%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", gatewayId, *tgConnections.ID))
@@ -175,16 +178,16 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 
 	if tgConnections.NetworkAccountID != nil {
 		d.Set(tgNetworkAccountID, *tgConnections.NetworkAccountID)
-		return resourceIBMTransitGatewayConnectionRead(d, meta)
+		return resourceIBMTransitGatewayConnectionRead(ctx, d, meta)
 	}
-	_, err = isWaitForTransitGatewayConnectionAvailable(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
-	return resourceIBMTransitGatewayConnectionRead(d, meta)
+	return resourceIBMTransitGatewayConnectionRead(ctx, d, meta)
 }
-func isWaitForTransitGatewayConnectionAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionAvailable(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway connection (%s) to be available.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -196,7 +199,7 @@ func isWaitForTransitGatewayConnectionAvailable(client *transitgatewayapisv1.Tra
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -223,15 +226,14 @@ func isTransitGatewayConnectionRefreshFunc(client *transitgatewayapisv1.TransitG
 		return tgConnection, isTransitGatewayConnectionPending, nil
 	}
 }
-func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
-
+func resourceIBMTransitGatewayConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -246,7 +248,7 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response)
+		return diagFromErr(fmt.Errorf("Error Getting Transit Gateway Connection (%s): %s\n%s", ID, err, response))
 	}
 
 	if instance.Name != nil {
@@ -280,22 +282,22 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	}
 	tgw, response, err := client.GetTransitGateway(getTransitGatewayOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Transit Gateway : %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error Getting Transit Gateway : %s\n%s", err, response))
 	}
 	d.Set(RelatedCRN, *tgw.Crn)
 
 	return nil
 }
 
-func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -308,7 +310,7 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 
 	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("Error Getting Transit Gateway Connection: %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error Getting Transit Gateway Connection: %s\n%s", err, response))
 	}
 
 	updateTransitGatewayConnectionOptions := &transitgatewayapisv1.UpdateTransitGatewayConnectionOptions{}
@@ -323,21 +325,21 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 
 	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
 	if err != nil {
-		return fmt.Errorf("Error in Update Transit Gateway Connection : %s\n%s", err, response)
+		return diagFromErr(fmt.Errorf("Error in Update Transit Gateway Connection : %s\n%s", err, response))
 	}
 
-	return resourceIBMTransitGatewayConnectionRead(d, meta)
+	return resourceIBMTransitGatewayConnectionRead(ctx, d, meta)
 }
 
-func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMTransitGatewayConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := transitgatewayClient(meta)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	parts, err := idParts(d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	gatewayId := parts[0]
@@ -352,19 +354,19 @@ func resourceIBMTransitGatewayConnectionDelete(d *schema.ResourceData, meta inte
 		if response != nil && response.StatusCode == 404 {
 			return nil
 		}
-		return fmt.Errorf("Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response)
+		return diagFromErr(fmt.Errorf("Error deleting Transit Gateway Connection(%s): %s\n%s", ID, err, response))
 	}
-	_, err = isWaitForTransitGatewayConnectionDeleted(client, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = isWaitForTransitGatewayConnectionDeleted(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func isWaitForTransitGatewayConnectionDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+func isWaitForTransitGatewayConnectionDeleted(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway Connection(%s) to be deleted.", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -376,7 +378,7 @@ func isWaitForTransitGatewayConnectionDeleted(client *transitgatewayapisv1.Trans
 		MinTimeout: 10 * time.Second,
 	}
 
-	return stateConf.WaitForState()
+	return stateConf.WaitForStateContext(ctx)
 }
 
 func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.TransitGatewayApisV1, id string) resource.StateRefreshFunc {
@@ -406,31 +408,3 @@ func isTransitGatewayConnectionDeleteRefreshFunc(client *transitgatewayapisv1.Tr
 		return tgConnection, isTransitGatewayConnectionDeleting, err
 	}
 }
-func resourceIBMTransitGatewayConnectionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client, err := transitgatewayClient(meta)
-	if err != nil {
-		return false, err
-	}
-	parts, err := idParts(d.Id())
-	if err != nil {
-		return false, err
-	}
-
-	gatewayId := parts[0]
-	ID := parts[1]
-
-	getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{
-		ID: &ID,
-	}
-	getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
-	_, response, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			d.SetId("")
-			return false, nil
-		}
-		return false, fmt.Errorf("Error Getting Transit Gateway Connection: %s\n%s", err, response)
-	}
-
-	return true, nil
-}
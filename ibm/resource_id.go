@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The resources that live in a service instance, such as the CIS DNS records or the COS
+// buckets, have a composite ID. It joins with ":" the identifiers of the resource, from the
+// most specific to the least specific one, followed by the CRN of the service instance:
+//
+//	<record_id>:<zone_id>:<cis_crn>                                    ibm_cis_dns_record
+//	<zone_id>:<cis_crn>                                                ibm_cis_domain_settings
+//	<bucket_name>:<bucket_type>:<location>:<endpoint_type>:<cos_crn>   ibm_cos_bucket
+//
+// The CRN contains ":" itself, so the number of identifiers is fixed for each resource.
+
+// buildCompositeID returns the composite ID of a resource of the service instance crn
+func buildCompositeID(crn string, ids ...string) string {
+	parts := make([]string, 0, len(ids)+1)
+	parts = append(parts, ids...)
+	return strings.Join(append(parts, crn), ":")
+}
+
+// splitCompositeID splits the composite ID in count identifiers followed by the CRN of the
+// service instance. It only fails when the ID does not have enough parts, the identifiers
+// found are returned in that case.
+func splitCompositeID(id string, count int) (ids []string, crn string, err error) {
+	parts := strings.SplitN(id, ":", count+1)
+	ids = make([]string, count)
+	copy(ids, parts)
+	if len(parts) <= count {
+		return ids, "", fmt.Errorf("The ID %q must contain %d identifiers followed by the CRN of the service instance", id, count)
+	}
+	return ids, parts[count], nil
+}
+
+// parseCompositeID splits the composite ID like splitCompositeID and also checks that none of
+// the identifiers is empty and that the last part is a CRN
+func parseCompositeID(id string, count int) ([]string, string, error) {
+	ids, crn, err := splitCompositeID(id, count)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, part := range ids {
+		if part == "" {
+			return nil, "", fmt.Errorf("The ID %q must contain %d identifiers followed by the CRN of the service instance, found an empty identifier", id, count)
+		}
+	}
+	if !strings.HasPrefix(crn, "crn:") {
+		return nil, "", fmt.Errorf("The ID %q must end with the CRN of the service instance, found %q", id, crn)
+	}
+	return ids, crn, nil
+}
+
+// cosBucketID is the composite ID of a COS bucket. The bucket type is "ssl" for a
+// single_site_location, "rl" for a region_location or "crl" for a cross_region_location.
+type cosBucketID struct {
+	bucketName   string
+	bucketType   string
+	location     string
+	endpointType string
+	serviceID    string
+}
+
+func (id cosBucketID) String() string {
+	return buildCompositeID(id.serviceID, id.bucketName, id.bucketType, id.location, id.endpointType)
+}
+
+func parseCOSBucketID(id string) (cosBucketID, error) {
+	ids, crn, err := parseCompositeID(id, 4)
+	if err != nil {
+		return cosBucketID{}, fmt.Errorf("Invalid COS bucket ID, expected <bucket_name>:<bucket_type>:<location>:<endpoint_type>:<resource_instance_id>: %s", err)
+	}
+	return cosBucketID{
+		bucketName:   ids[0],
+		bucketType:   ids[1],
+		location:     ids[2],
+		endpointType: ids[3],
+		serviceID:    crn,
+	}, nil
+}
+
+// parseLegacyCOSBucketID parses the COS bucket ID used before the schema version 1:
+// "<bucket_crn>:meta:<bucket_type>:<location>:<endpoint_type>". The oldest IDs are only
+// made of the bucket CRN and do not contain the endpoint type, these values are left empty.
+func parseLegacyCOSBucketID(id string) (cosBucketID, bool) {
+	parts := strings.SplitN(id, ":meta:", 2)
+	crn := strings.SplitN(parts[0], ":bucket:", 2)
+	if len(crn) != 2 || !strings.HasPrefix(crn[0], "crn:") || crn[1] == "" {
+		return cosBucketID{}, false
+	}
+	bucketID := cosBucketID{
+		bucketName: crn[1],
+		serviceID:  fmt.Sprintf("%s::", strings.TrimSuffix(crn[0], "::")),
+	}
+	if len(parts) == 2 {
+		meta := strings.Split(parts[1], ":")
+		bucketID.bucketType = meta[0]
+		if len(meta) > 1 {
+			bucketID.location = meta[1]
+		}
+		if len(meta) > 2 {
+			bucketID.endpointType = meta[2]
+		}
+	}
+	return bucketID, true
+}
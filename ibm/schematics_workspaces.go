@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	gohttp "net/http"
+
+	"github.com/IBM-Cloud/bluemix-go/api/schematics"
+)
+
+const (
+	schematicsActionPlan    = "plan"
+	schematicsActionApply   = "apply"
+	schematicsActionDestroy = "destroy"
+)
+
+// schematicsRESTClient is the part of the bluemix-go REST client embedded in
+// the schematics service API, used for the calls bluemix-go doesn't cover.
+type schematicsRESTClient interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Put(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Delete(path string, extraHeader ...interface{}) (*gohttp.Response, error)
+}
+
+// schematicsTemplateRepo is the git repository of the template of a workspace
+type schematicsTemplateRepo struct {
+	URL     string `json:"url"`
+	Branch  string `json:"branch,omitempty"`
+	Release string `json:"release,omitempty"`
+}
+
+// schematicsTemplateData is the template of a workspace and its variables
+type schematicsTemplateData struct {
+	ID            string                     `json:"id,omitempty"`
+	Folder        string                     `json:"folder,omitempty"`
+	Type          string                     `json:"type"`
+	Variablestore []schematics.Variablestore `json:"variablestore"`
+}
+
+// schematicsWorkspacePayload is the body of the create and replace workspace requests
+type schematicsWorkspacePayload struct {
+	Name          string                   `json:"name"`
+	Type          []string                 `json:"type"`
+	Description   string                   `json:"description"`
+	Location      string                   `json:"location,omitempty"`
+	ResourceGroup string                   `json:"resource_group,omitempty"`
+	Tags          []string                 `json:"tags"`
+	TemplateRepo  *schematicsTemplateRepo  `json:"template_repo,omitempty"`
+	TemplateData  []schematicsTemplateData `json:"template_data"`
+}
+
+// schematicsActivity is a job run on a workspace
+type schematicsActivity struct {
+	ActionID    string `json:"action_id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Message     string `json:"message"`
+	PerformedAt string `json:"performed_at"`
+}
+
+type schematicsWorkspaces interface {
+	GetWorkspace(id string) (schematics.WorkspaceConfig, error)
+	CreateWorkspace(payload schematicsWorkspacePayload) (schematics.WorkspaceConfig, error)
+	ReplaceWorkspace(id string, payload schematicsWorkspacePayload) (schematics.WorkspaceConfig, error)
+	DeleteWorkspace(id string, destroyResources bool) error
+	RunWorkspaceAction(id string, action string) (string, error)
+	GetWorkspaceActivity(id string, activityID string) (schematicsActivity, error)
+}
+
+type workspaces struct {
+	client       schematicsRESTClient
+	refreshToken string
+}
+
+func schematicsWorkspacesAPI(meta interface{}) (schematicsWorkspaces, error) {
+	scClient, err := meta.(ClientSession).SchematicsAPI()
+	if err != nil {
+		return nil, err
+	}
+	client, ok := scClient.(schematicsRESTClient)
+	if !ok {
+		return nil, fmt.Errorf("The schematics client doesn't support the workspace updates")
+	}
+	bmxSess, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	return &workspaces{client: client, refreshToken: bmxSess.Config.IAMRefreshToken}, nil
+}
+
+// refreshTokenHeader is required by the schematics calls that run terraform
+func (r *workspaces) refreshTokenHeader() map[string]string {
+	return map[string]string{"refresh_token": r.refreshToken}
+}
+
+func (r *workspaces) GetWorkspace(id string) (schematics.WorkspaceConfig, error) {
+	var workspace schematics.WorkspaceConfig
+	_, err := r.client.Get(fmt.Sprintf("/v1/workspaces/%s", id), &workspace)
+	return workspace, err
+}
+
+func (r *workspaces) CreateWorkspace(payload schematicsWorkspacePayload) (schematics.WorkspaceConfig, error) {
+	var workspace schematics.WorkspaceConfig
+	_, err := r.client.Post("/v1/workspaces", payload, &workspace)
+	return workspace, err
+}
+
+func (r *workspaces) ReplaceWorkspace(id string, payload schematicsWorkspacePayload) (schematics.WorkspaceConfig, error) {
+	var workspace schematics.WorkspaceConfig
+	_, err := r.client.Put(fmt.Sprintf("/v1/workspaces/%s", id), payload, &workspace)
+	return workspace, err
+}
+
+func (r *workspaces) DeleteWorkspace(id string, destroyResources bool) error {
+	_, err := r.client.Delete(fmt.Sprintf("/v1/workspaces/%s?destroyResources=%t", id, destroyResources), r.refreshTokenHeader())
+	return err
+}
+
+// RunWorkspaceAction starts a plan, apply or destroy job on the workspace
+// and returns the ID of the job
+func (r *workspaces) RunWorkspaceAction(id string, action string) (string, error) {
+	var activity struct {
+		ActivityID string `json:"activityid"`
+	}
+	path := fmt.Sprintf("/v1/workspaces/%s/%s", id, action)
+	var err error
+	switch action {
+	case schematicsActionPlan:
+		_, err = r.client.Post(path, nil, &activity, r.refreshTokenHeader())
+	case schematicsActionApply, schematicsActionDestroy:
+		_, err = r.client.Put(path, nil, &activity, r.refreshTokenHeader())
+	default:
+		return "", fmt.Errorf("Unsupported schematics action %q", action)
+	}
+	return activity.ActivityID, err
+}
+
+func (r *workspaces) GetWorkspaceActivity(id string, activityID string) (schematicsActivity, error) {
+	var activity schematicsActivity
+	_, err := r.client.Get(fmt.Sprintf("/v1/workspaces/%s/actions/%s", id, activityID), &activity)
+	return activity, err
+}
@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	gohttp "net/http"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/api/schematics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+// fakeSchematicsRequest is a request sent to fakeSchematicsClient
+type fakeSchematicsRequest struct {
+	Method string
+	Path   string
+	Header map[string]string
+}
+
+// fakeSchematicsClient records the requests and answers them with response
+type fakeSchematicsClient struct {
+	requests []fakeSchematicsRequest
+	response string
+}
+
+func (f *fakeSchematicsClient) do(method, path string, respV interface{}, extraHeader []interface{}) (*gohttp.Response, error) {
+	request := fakeSchematicsRequest{Method: method, Path: path}
+	for _, h := range extraHeader {
+		request.Header = h.(map[string]string)
+	}
+	f.requests = append(f.requests, request)
+	if respV != nil && f.response != "" {
+		if err := json.Unmarshal([]byte(f.response), respV); err != nil {
+			return nil, err
+		}
+	}
+	return &gohttp.Response{StatusCode: 200}, nil
+}
+
+func (f *fakeSchematicsClient) Get(path string, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error) {
+	return f.do("GET", path, respV, extraHeader)
+}
+
+func (f *fakeSchematicsClient) Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error) {
+	return f.do("POST", path, respV, extraHeader)
+}
+
+func (f *fakeSchematicsClient) Put(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error) {
+	return f.do("PUT", path, respV, extraHeader)
+}
+
+func (f *fakeSchematicsClient) Delete(path string, extraHeader ...interface{}) (*gohttp.Response, error) {
+	return f.do("DELETE", path, nil, extraHeader)
+}
+
+func TestSchematicsRunWorkspaceAction(t *testing.T) {
+	testcases := []struct {
+		action string
+		method string
+	}{
+		{action: schematicsActionPlan, method: "POST"},
+		{action: schematicsActionApply, method: "PUT"},
+		{action: schematicsActionDestroy, method: "PUT"},
+	}
+	for _, tc := range testcases {
+		client := &fakeSchematicsClient{response: `{"activityid": "activity-1"}`}
+		api := &workspaces{client: client, refreshToken: "refresh"}
+
+		activityID, err := api.RunWorkspaceAction("ws-1", tc.action)
+		assert.NilError(t, err, tc.action)
+		assert.Equal(t, "activity-1", activityID, tc.action)
+		assert.Assert(t, is.DeepEqual([]fakeSchematicsRequest{{
+			Method: tc.method,
+			Path:   "/v1/workspaces/ws-1/" + tc.action,
+			Header: map[string]string{"refresh_token": "refresh"},
+		}}, client.requests), tc.action)
+	}
+
+	client := &fakeSchematicsClient{}
+	_, err := (&workspaces{client: client}).RunWorkspaceAction("ws-1", "refresh")
+	assert.Assert(t, err != nil)
+	assert.Assert(t, is.Len(client.requests, 0))
+}
+
+func TestSchematicsDeleteWorkspace(t *testing.T) {
+	client := &fakeSchematicsClient{}
+	api := &workspaces{client: client, refreshToken: "refresh"}
+
+	assert.NilError(t, api.DeleteWorkspace("ws-1", true))
+	assert.NilError(t, api.DeleteWorkspace("ws-2", false))
+	assert.DeepEqual(t, []fakeSchematicsRequest{
+		{Method: "DELETE", Path: "/v1/workspaces/ws-1?destroyResources=true", Header: map[string]string{"refresh_token": "refresh"}},
+		{Method: "DELETE", Path: "/v1/workspaces/ws-2?destroyResources=false", Header: map[string]string{"refresh_token": "refresh"}},
+	}, client.requests)
+}
+
+func TestExpandSchematicsWorkspace(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceIBMSchematicsWorkspace().Schema, map[string]interface{}{
+		"name":     "ws",
+		"location": "us-south",
+		"tags":     []interface{}{"env:test"},
+		"template_repo": []interface{}{map[string]interface{}{
+			"url":    "https://github.com/org/repo",
+			"branch": "main",
+		}},
+		"variable_store": []interface{}{
+			map[string]interface{}{"name": "region", "value": "us-south"},
+			map[string]interface{}{"name": "apikey", "value": "secret", "secure": true},
+		},
+	})
+
+	payload := expandSchematicsWorkspace(d, "template-1")
+	assert.Equal(t, "ws", payload.Name)
+	assert.DeepEqual(t, []string{"terraform_v0.13"}, payload.Type)
+	assert.Equal(t, "us-south", payload.Location)
+	assert.DeepEqual(t, []string{"env:test"}, payload.Tags)
+	assert.DeepEqual(t, &schematicsTemplateRepo{URL: "https://github.com/org/repo", Branch: "main"}, payload.TemplateRepo)
+	assert.DeepEqual(t, []schematicsTemplateData{{
+		ID:     "template-1",
+		Folder: ".",
+		Type:   "terraform_v0.13",
+		Variablestore: []schematics.Variablestore{
+			{Name: "region", Value: "us-south"},
+			{Name: "apikey", Value: "secret", Secure: true},
+		},
+	}}, payload.TemplateData)
+}
+
+func TestFlattenSchematicsVariableStore(t *testing.T) {
+	current := []interface{}{
+		map[string]interface{}{"name": "region", "value": "us-south", "secure": false, "description": ""},
+		map[string]interface{}{"name": "apikey", "value": "secret", "secure": true, "description": ""},
+	}
+	variablestore := []schematics.Variablestore{
+		{Name: "region", Value: "eu-de"},
+		{Name: "apikey", Value: "********", Secure: true, Description: "The API key"},
+		{Name: "token", Value: "********", Secure: true},
+	}
+
+	assert.DeepEqual(t, []map[string]interface{}{
+		{"name": "region", "value": "eu-de", "secure": false, "description": ""},
+		{"name": "apikey", "value": "secret", "secure": true, "description": "The API key"},
+		{"name": "token", "value": "", "secure": true, "description": ""},
+	}, flattenSchematicsVariableStore(variablestore, current))
+}
+
+func TestSchematicsActivityAction(t *testing.T) {
+	assert.Equal(t, schematicsActionPlan, schematicsActivityAction("WORKSPACE_PLAN"))
+	assert.Equal(t, schematicsActionApply, schematicsActivityAction("WORKSPACE_APPLY"))
+	assert.Equal(t, schematicsActionDestroy, schematicsActivityAction("workspace_destroy"))
+	assert.Equal(t, "", schematicsActivityAction("WORKSPACE_REFRESH"))
+}
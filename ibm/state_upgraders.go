@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withStateUpgrades sets the schema version of the resource to the number of upgrades and
+// registers them as its state upgraders. The upgrade at index i converts a state stored
+// with the schema version i to the version i+1, a new upgrade is always appended.
+//
+// The type of the state upgraders is only used to decode the states written before
+// Terraform 0.12, the current schema is used as the upgrades keep the attributes.
+func withStateUpgrades(r *schema.Resource, upgrades ...schema.StateUpgradeFunc) *schema.Resource {
+	stateType := r.CoreConfigSchema().ImpliedType()
+	r.SchemaVersion = len(upgrades)
+	r.StateUpgraders = make([]schema.StateUpgrader, 0, len(upgrades))
+	for version, upgrade := range upgrades {
+		r.StateUpgraders = append(r.StateUpgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    stateType,
+			Upgrade: upgrade,
+		})
+	}
+	return r
+}
+
+// idUpgradeFunc returns the ID of the resource in the format of the next schema version,
+// the raw state gives access to the other attributes of the resource
+type idUpgradeFunc func(id string, rawState map[string]interface{}) (string, error)
+
+// upgradeStateID returns the state upgrade rewriting the ID of the resource
+func upgradeStateID(upgrade idUpgradeFunc) schema.StateUpgradeFunc {
+	return func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+		id, _ := rawState["id"].(string)
+		if id == "" {
+			return rawState, nil
+		}
+		newID, err := upgrade(id, rawState)
+		if err != nil {
+			return nil, fmt.Errorf("Error upgrading the state of %s: %s", id, err)
+		}
+		if newID != id {
+			log.Printf("[INFO] Upgraded the ID %s to %s", id, newID)
+		}
+		rawState["id"] = newID
+		return rawState, nil
+	}
+}
+
+// upgradeCISID normalises the ID of a CIS resource made of count identifiers followed by the
+// CRN of the CIS instance. The CRN and the zone ID are restored from the cis_id and domain_id
+// attributes when they are missing in the ID.
+func upgradeCISID(count int) idUpgradeFunc {
+	return func(id string, rawState map[string]interface{}) (string, error) {
+		var ids []string
+		var crn string
+		if strings.HasPrefix(id, "crn:") {
+			crn = id
+		} else if i := strings.Index(id, ":crn:"); i >= 0 {
+			ids, crn = strings.Split(id[:i], ":"), id[i+1:]
+		} else {
+			ids = strings.Split(id, ":")
+		}
+		if crn == "" {
+			crn, _ = rawState[cisID].(string)
+		}
+		if domainID, ok := rawState[cisDomainID].(string); ok && domainID != "" && len(ids) == count-1 {
+			zoneID, _, err := convertTftoCisTwoVar(domainID)
+			if err != nil {
+				return "", err
+			}
+			ids = append(ids, zoneID)
+		}
+
+		newID := buildCompositeID(crn, ids...)
+		if _, _, err := parseCompositeID(newID, count); err != nil {
+			return "", err
+		}
+		return newID, nil
+	}
+}
+
+// upgradeCOSBucketID converts the legacy ID of a COS bucket to the composite ID. The bucket type,
+// the location and the endpoint type are taken from the attributes when the ID does not have them.
+func upgradeCOSBucketID(id string, rawState map[string]interface{}) (string, error) {
+	bucketID, ok := parseLegacyCOSBucketID(id)
+	if !ok {
+		return "", fmt.Errorf("Unknown COS bucket ID %q", id)
+	}
+	if bucketID.bucketType == "" || bucketID.location == "" {
+		for bucketType, attribute := range map[string]string{"ssl": "single_site_location", "rl": "region_location", "crl": "cross_region_location"} {
+			if location, ok := rawState[attribute].(string); ok && location != "" {
+				bucketID.bucketType, bucketID.location = bucketType, location
+			}
+		}
+	}
+	if bucketID.endpointType == "" {
+		bucketID.endpointType = "public"
+		if endpointType, ok := rawState["endpoint_type"].(string); ok && endpointType != "" {
+			bucketID.endpointType = endpointType
+		}
+	}
+
+	newID := bucketID.String()
+	if _, err := parseCOSBucketID(newID); err != nil {
+		return "", err
+	}
+	return newID, nil
+}
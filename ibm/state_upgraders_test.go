@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+const (
+	testCISCRN = "crn:v1:bluemix:public:internet-svcs:global:a/4ea1882a2d3401ed1e459979941966ea:31fa970d-51d0-4b05-893e-251cba75a7b3::"
+	testCOSCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/4ea1882a2d3401ed1e459979941966ea:31fa970d-51d0-4b05-893e-251cba75a7b3::"
+)
+
+// upgradeState runs the state upgraders of the resource from the given schema version
+func upgradeState(t *testing.T, r *schema.Resource, version int, rawState map[string]interface{}) (map[string]interface{}, error) {
+	assert.Assert(t, is.Nil(r.InternalValidate(nil, true)))
+	var err error
+	for _, upgrader := range r.StateUpgraders[version:] {
+		rawState, err = upgrader.Upgrade(context.Background(), rawState, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rawState, nil
+}
+
+func TestCompositeID(t *testing.T) {
+	id := buildCompositeID(testCISCRN, "record", "zone")
+	assert.Equal(t, "record:zone:"+testCISCRN, id)
+
+	ids, crn, err := parseCompositeID(id, 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"record", "zone"}, ids)
+	assert.Equal(t, testCISCRN, crn)
+
+	_, _, err = parseCompositeID(id, 3)
+	assert.Assert(t, err != nil)
+	_, _, err = parseCompositeID("record::"+testCISCRN, 2)
+	assert.Assert(t, err != nil)
+	_, _, err = parseCompositeID("record:zone", 1)
+	assert.Assert(t, err != nil)
+
+	ids, _, err = splitCompositeID("record", 2)
+	assert.Assert(t, err != nil)
+	assert.DeepEqual(t, []string{"record", ""}, ids)
+}
+
+func TestCISStateUpgrade(t *testing.T) {
+	testCases := []struct {
+		name     string
+		resource *schema.Resource
+		rawState map[string]interface{}
+		id       string
+	}{
+		{
+			name:     "dns record",
+			resource: resourceIBMCISDnsRecord(),
+			rawState: map[string]interface{}{"id": "record:zone:" + testCISCRN, cisID: testCISCRN, cisDomainID: "zone:" + testCISCRN},
+			id:       "record:zone:" + testCISCRN,
+		},
+		{
+			name:     "dns record without crn",
+			resource: resourceIBMCISDnsRecord(),
+			rawState: map[string]interface{}{"id": "record:zone", cisID: testCISCRN, cisDomainID: "zone:" + testCISCRN},
+			id:       "record:zone:" + testCISCRN,
+		},
+		{
+			name:     "dns record without zone",
+			resource: resourceIBMCISDnsRecord(),
+			rawState: map[string]interface{}{"id": "record:" + testCISCRN, cisID: testCISCRN, cisDomainID: "zone:" + testCISCRN},
+			id:       "record:zone:" + testCISCRN,
+		},
+		{
+			name:     "domain settings without zone",
+			resource: resourceIBMCISSettings(),
+			rawState: map[string]interface{}{"id": testCISCRN, cisID: testCISCRN, cisDomainID: "zone:" + testCISCRN},
+			id:       "zone:" + testCISCRN,
+		},
+		{
+			name:     "firewall",
+			resource: resourceIBMCISFirewallRecord(),
+			rawState: map[string]interface{}{"id": "lockdowns:lockdown:zone:" + testCISCRN, cisID: testCISCRN, cisDomainID: "zone:" + testCISCRN},
+			id:       "lockdowns:lockdown:zone:" + testCISCRN,
+		},
+		{
+			name:     "healthcheck",
+			resource: resourceIBMCISHealthCheck(),
+			rawState: map[string]interface{}{"id": "monitor", cisID: testCISCRN},
+			id:       "monitor:" + testCISCRN,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, 1, tc.resource.SchemaVersion)
+			rawState, err := upgradeState(t, tc.resource, 0, tc.rawState)
+			assert.NilError(t, err)
+			assert.Equal(t, tc.id, rawState["id"])
+		})
+	}
+
+	_, err := upgradeState(t, resourceIBMCISDnsRecord(), 0, map[string]interface{}{"id": "record"})
+	assert.Assert(t, err != nil)
+}
+
+func TestCOSBucketStateUpgrade(t *testing.T) {
+	bucketCRN := testCOSCRN[:len(testCOSCRN)-2] + ":bucket:mybucket"
+	testCases := []struct {
+		name     string
+		rawState map[string]interface{}
+		id       string
+	}{
+		{
+			name:     "endpoint type",
+			rawState: map[string]interface{}{"id": bucketCRN + ":meta:crl:us:private", "cross_region_location": "us", "endpoint_type": "private"},
+			id:       "mybucket:crl:us:private:" + testCOSCRN,
+		},
+		{
+			name:     "without endpoint type",
+			rawState: map[string]interface{}{"id": bucketCRN + ":meta:rl:us-south", "region_location": "us-south"},
+			id:       "mybucket:rl:us-south:public:" + testCOSCRN,
+		},
+		{
+			name:     "without meta",
+			rawState: map[string]interface{}{"id": bucketCRN, "single_site_location": "ams03", "endpoint_type": "private"},
+			id:       "mybucket:ssl:ams03:private:" + testCOSCRN,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rawState, err := upgradeState(t, resourceIBMCOS(), 0, tc.rawState)
+			assert.NilError(t, err)
+			assert.Equal(t, tc.id, rawState["id"])
+
+			bucketID, err := parseCOSBucketID(tc.id)
+			assert.NilError(t, err)
+			assert.Equal(t, "mybucket", bucketID.bucketName)
+			assert.Equal(t, testCOSCRN, bucketID.serviceID)
+		})
+	}
+
+	_, err := upgradeState(t, resourceIBMCOS(), 0, map[string]interface{}{"id": bucketCRN})
+	assert.Assert(t, err != nil)
+}
+
+func TestCOSBucketImport(t *testing.T) {
+	bucketCRN := testCOSCRN[:len(testCOSCRN)-2] + ":bucket:mybucket"
+	for id, expected := range map[string]string{
+		"mybucket:crl:eu:public:" + testCOSCRN: "mybucket:crl:eu:public:" + testCOSCRN,
+		bucketCRN + ":meta:crl:eu:private":     "mybucket:crl:eu:private:" + testCOSCRN,
+		bucketCRN + ":meta:rl:us-south":        "mybucket:rl:us-south:public:" + testCOSCRN,
+	} {
+		d := resourceIBMCOS().Data(nil)
+		d.SetId(id)
+		imported, err := resourceIBMCOSImport(context.Background(), d, nil)
+		assert.NilError(t, err)
+		assert.Equal(t, expected, imported[0].Id())
+	}
+
+	d := resourceIBMCOS().Data(nil)
+	d.SetId(bucketCRN)
+	_, err := resourceIBMCOSImport(context.Background(), d, nil)
+	assert.Assert(t, err != nil)
+}
@@ -11,13 +11,13 @@ import (
 	"io/ioutil"
 	"log"
 	"net/url"
-	"os"
 	"path"
 	"reflect"
 	"strconv"
 	"strings"
 
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	kp "github.com/IBM/keyprotect-go-client"
 	"github.com/apache/openwhisk-client-go/whisk"
@@ -674,8 +674,8 @@ func flattenMetricsMonitor(in *resourceconfigurationv1.MetricsMonitoring) []inte
 func archiveRuleGet(in []*s3.LifecycleRule) []interface{} {
 	rules := make([]interface{}, 0, len(in))
 	for _, r := range in {
-		// Checking this is not an expire_rule.  LifeCycle rules are either archive or expire
-		if r.Expiration == nil {
+		// Checking this is an archive_rule, the other lifecycle rules do not have transitions
+		if len(r.Transitions) > 0 {
 			rule := make(map[string]interface{})
 
 			if r.Status != nil {
@@ -737,6 +737,104 @@ func expireRuleGet(in []*s3.LifecycleRule) []interface{} {
 	return rules
 }
 
+func noncurrentVersionExpirationRuleGet(in []*s3.LifecycleRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		if r.NoncurrentVersionExpiration != nil {
+			rule := map[string]interface{}{
+				"rule_id":         aws.StringValue(r.ID),
+				"enable":          aws.StringValue(r.Status) == "Enabled",
+				"noncurrent_days": int(aws.Int64Value(r.NoncurrentVersionExpiration.NoncurrentDays)),
+			}
+			if r.Filter != nil && r.Filter.Prefix != nil {
+				rule["prefix"] = *r.Filter.Prefix
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func abortIncompleteMultipartUploadRuleGet(in []*s3.LifecycleRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		if r.AbortIncompleteMultipartUpload != nil {
+			rule := map[string]interface{}{
+				"rule_id":               aws.StringValue(r.ID),
+				"enable":                aws.StringValue(r.Status) == "Enabled",
+				"days_after_initiation": int(aws.Int64Value(r.AbortIncompleteMultipartUpload.DaysAfterInitiation)),
+			}
+			if r.Filter != nil && r.Filter.Prefix != nil {
+				rule["prefix"] = *r.Filter.Prefix
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func replicationRuleGet(in *s3.ReplicationConfiguration) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	rules := make([]interface{}, 0, len(in.Rules))
+	for _, r := range in.Rules {
+		rule := map[string]interface{}{
+			"rule_id":  aws.StringValue(r.ID),
+			"enable":   aws.StringValue(r.Status) == "Enabled",
+			"priority": int(aws.Int64Value(r.Priority)),
+		}
+		if r.Filter != nil && r.Filter.Prefix != nil {
+			rule["prefix"] = *r.Filter.Prefix
+		}
+		if r.DeleteMarkerReplication != nil {
+			rule["deletemarker_replication_status"] = aws.StringValue(r.DeleteMarkerReplication.Status) == "Enabled"
+		}
+		if r.Destination != nil {
+			rule["destination_bucket_crn"] = aws.StringValue(r.Destination.Bucket)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func retentionRuleGet(in *s3.ProtectionConfiguration) []interface{} {
+	if in == nil || aws.StringValue(in.Status) != "COMPLIANCE" {
+		return []interface{}{}
+	}
+	rule := map[string]interface{}{
+		"permanent": aws.BoolValue(in.EnablePermanentRetention),
+	}
+	if in.DefaultRetention != nil {
+		rule["default"] = int(aws.Int64Value(in.DefaultRetention.Days))
+	}
+	if in.MaximumRetention != nil {
+		rule["maximum"] = int(aws.Int64Value(in.MaximumRetention.Days))
+	}
+	if in.MinimumRetention != nil {
+		rule["minimum"] = int(aws.Int64Value(in.MinimumRetention.Days))
+	}
+	return []interface{}{rule}
+}
+
+func objectLockGet(in *s3.ObjectLockConfiguration) []interface{} {
+	if in == nil || aws.StringValue(in.ObjectLockEnabled) != "Enabled" {
+		return []interface{}{}
+	}
+	objectLock := map[string]interface{}{
+		"default_retention_mode": "COMPLIANCE",
+	}
+	if in.Rule != nil && in.Rule.DefaultRetention != nil {
+		retention := in.Rule.DefaultRetention
+		if retention.Mode != nil {
+			objectLock["default_retention_mode"] = *retention.Mode
+		}
+		objectLock["default_retention_days"] = int(aws.Int64Value(retention.Days))
+		objectLock["default_retention_years"] = int(aws.Int64Value(retention.Years))
+	}
+	return []interface{}{objectLock}
+}
+
 func flattenLimits(in *whisk.Limits) []interface{} {
 	att := make(map[string]interface{})
 	if in.Timeout != nil {
@@ -1405,43 +1503,39 @@ func expandStringMap(inVal interface{}) map[string]string {
 
 // Cloud Internet Services
 func convertTfToCisThreeVar(glbTfId string) (glbId string, zoneId string, cisId string, err error) {
-	g := strings.SplitN(glbTfId, ":", 3)
-	glbId = g[0]
-	if len(g) > 2 {
-		zoneId = g[1]
-		cisId = g[2]
-	} else {
+	ids, cisId, err := splitCompositeID(glbTfId, 2)
+	glbId = ids[0]
+	if err != nil {
 		err = errors.New("cis_id or zone_id not passed")
 		return
 	}
+	zoneId = ids[1]
 	return
 }
 func convertCisToTfFourVar(firewallType string, ID string, ID2 string, cisID string) (buildID string) {
 	if ID != "" {
-		buildID = firewallType + ":" + ID + ":" + ID2 + ":" + cisID
+		buildID = buildCompositeID(cisID, firewallType, ID, ID2)
 	} else {
 		buildID = ""
 	}
 	return
 }
 func convertTfToCisFourVar(TfID string) (firewallType string, ID string, zoneID string, cisID string, err error) {
-	g := strings.SplitN(TfID, ":", 4)
-	firewallType = g[0]
-	if len(g) > 3 {
-		ID = g[1]
-		zoneID = g[2]
-		cisID = g[3]
-	} else {
+	ids, cisID, err := splitCompositeID(TfID, 3)
+	firewallType = ids[0]
+	if err != nil {
 		err = errors.New("Id or cis_id or zone_id not passed")
 		return
 	}
+	ID = ids[1]
+	zoneID = ids[2]
 	return
 }
 
 // Cloud Internet Services
 func convertCisToTfThreeVar(Id string, Id2 string, cisId string) (buildId string) {
 	if Id != "" {
-		buildId = Id + ":" + Id2 + ":" + cisId
+		buildId = buildCompositeID(cisId, Id, Id2)
 	} else {
 		buildId = ""
 	}
@@ -1451,13 +1545,13 @@ func convertCisToTfThreeVar(Id string, Id2 string, cisId string) (buildId string
 // Cloud Internet Services
 func convertTfToCisTwoVarSlice(tfIds []string) (Ids []string, cisId string, err error) {
 	for _, item := range tfIds {
-		Id := strings.SplitN(item, ":", 2)
-		if len(Id) < 2 {
+		ids, crn, splitErr := splitCompositeID(item, 1)
+		if splitErr != nil {
 			err = errors.New("cis_id not passed")
 			return
 		}
-		Ids = append(Ids, Id[0])
-		cisId = Id[1]
+		Ids = append(Ids, ids[0])
+		cisId = crn
 	}
 	return
 }
@@ -1465,7 +1559,7 @@ func convertTfToCisTwoVarSlice(tfIds []string) (Ids []string, cisId string, err
 // Cloud Internet Services
 func convertCisToTfTwoVarSlice(Ids []string, cisId string) (buildIds []string) {
 	for _, Id := range Ids {
-		buildIds = append(buildIds, Id+":"+cisId)
+		buildIds = append(buildIds, buildCompositeID(cisId, Id))
 	}
 	return
 }
@@ -1473,7 +1567,7 @@ func convertCisToTfTwoVarSlice(Ids []string, cisId string) (buildIds []string) {
 // Cloud Internet Services
 func convertCisToTfTwoVar(Id string, cisId string) (buildId string) {
 	if Id != "" {
-		buildId = Id + ":" + cisId
+		buildId = buildCompositeID(cisId, Id)
 	} else {
 		buildId = ""
 	}
@@ -1482,11 +1576,9 @@ func convertCisToTfTwoVar(Id string, cisId string) (buildId string) {
 
 // Cloud Internet Services
 func convertTftoCisTwoVar(tfId string) (Id string, cisId string, err error) {
-	g := strings.SplitN(tfId, ":", 2)
-	Id = g[0]
-	if len(g) > 1 {
-		cisId = g[1]
-	} else {
+	ids, cisId, err := splitCompositeID(tfId, 1)
+	Id = ids[0]
+	if err != nil {
 		err = errors.New(" cis_id or zone_id not passed")
 		return
 	}
@@ -1588,143 +1680,6 @@ func EscapeUrlParm(urlParm string) string {
 	return urlParm
 }
 
-func GetTags(d *schema.ResourceData, meta interface{}) error {
-	resourceID := d.Id()
-	gtClient, err := meta.(ClientSession).GlobalTaggingAPI()
-	if err != nil {
-		return fmt.Errorf("Error getting global tagging client settings: %s", err)
-	}
-	taggingResult, err := gtClient.Tags().GetTags(resourceID)
-	if err != nil {
-		return err
-	}
-	var taglist []string
-	for _, item := range taggingResult.Items {
-		taglist = append(taglist, item.Name)
-	}
-	d.Set("tags", flattenStringList(taglist))
-	return nil
-}
-
-func UpdateTags(d *schema.ResourceData, meta interface{}) error {
-	resourceID := d.Id()
-	gtClient, err := meta.(ClientSession).GlobalTaggingAPI()
-	if err != nil {
-		return fmt.Errorf("Error getting global tagging client settings: %s", err)
-	}
-	oldList, newList := d.GetChange("tags")
-	if oldList == nil {
-		oldList = new(schema.Set)
-	}
-	if newList == nil {
-		newList = new(schema.Set)
-	}
-	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
-	removeInt := olds.Difference(news).List()
-	addInt := news.Difference(olds).List()
-	add := make([]string, len(addInt))
-	for i, v := range addInt {
-		add[i] = fmt.Sprint(v)
-	}
-	remove := make([]string, len(removeInt))
-	for i, v := range removeInt {
-		remove[i] = fmt.Sprint(v)
-	}
-
-	if len(add) > 0 {
-		_, err := gtClient.Tags().AttachTags(resourceID, add)
-		if err != nil {
-			return fmt.Errorf("Error updating database tags %v : %s", add, err)
-		}
-	}
-	if len(remove) > 0 {
-		_, err := gtClient.Tags().DetachTags(resourceID, remove)
-		if err != nil {
-			return fmt.Errorf("Error detaching database tags %v: %s", remove, err)
-		}
-		for _, v := range remove {
-			_, err := gtClient.Tags().DeleteTag(v)
-			if err != nil {
-				return fmt.Errorf("Error deleting database tag %v: %s", v, err)
-			}
-		}
-	}
-	return nil
-}
-
-func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error) {
-
-	gtClient, err := meta.(ClientSession).GlobalTaggingAPI()
-	if err != nil {
-		return nil, fmt.Errorf("Error getting global tagging client settings: %s", err)
-	}
-	taggingResult, err := gtClient.Tags().GetTags(resourceCRN)
-	if err != nil {
-		return nil, err
-	}
-	var taglist []string
-	for _, item := range taggingResult.Items {
-		taglist = append(taglist, item.Name)
-	}
-	log.Println("tagList: ", taglist)
-	return newStringSet(resourceIBMVPCHash, taglist), nil
-}
-
-func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceCRN string) error {
-	gtClient, err := meta.(ClientSession).GlobalTaggingAPI()
-	if err != nil {
-		return fmt.Errorf("Error getting global tagging client settings: %s", err)
-	}
-	if oldList == nil {
-		oldList = new(schema.Set)
-	}
-	if newList == nil {
-		newList = new(schema.Set)
-	}
-	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
-	removeInt := olds.Difference(news).List()
-	addInt := news.Difference(olds).List()
-	add := make([]string, len(addInt))
-	for i, v := range addInt {
-		add[i] = fmt.Sprint(v)
-	}
-	remove := make([]string, len(removeInt))
-	for i, v := range removeInt {
-		remove[i] = fmt.Sprint(v)
-	}
-
-	schematicTags := os.Getenv("IC_ENV_TAGS")
-	var envTags []string
-	if schematicTags != "" {
-		envTags = strings.Split(schematicTags, ",")
-		add = append(add, envTags...)
-	}
-
-	if len(remove) > 0 {
-		_, err := gtClient.Tags().DetachTags(resourceCRN, remove)
-		if err != nil {
-			return fmt.Errorf("Error detaching database tags %v: %s", remove, err)
-		}
-		for _, v := range remove {
-			_, err := gtClient.Tags().DeleteTag(v)
-			if err != nil {
-				return fmt.Errorf("Error deleting database tag %v: %s", v, err)
-			}
-		}
-	}
-
-	if len(add) > 0 {
-		_, err := gtClient.Tags().AttachTags(resourceCRN, add)
-		if err != nil {
-			return fmt.Errorf("Error updating database tags %v : %s", add, err)
-		}
-	}
-
-	return nil
-}
-
 func getBaseController(meta interface{}) (string, error) {
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
@@ -1744,25 +1699,6 @@ func flattenSSLCiphers(ciphers []datatypes.Network_LBaaS_SSLCipher) *schema.Set
 	return newStringSet(schema.HashString, c)
 }
 
-func resourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
-
-	if diff.Id() != "" && diff.HasChange("tags") {
-		o, n := diff.GetChange("tags")
-		oldSet := o.(*schema.Set)
-		newSet := n.(*schema.Set)
-		removeInt := oldSet.Difference(newSet).List()
-		addInt := newSet.Difference(oldSet).List()
-		if v := os.Getenv("IC_ENV_TAGS"); v != "" {
-			s := strings.Split(v, ",")
-			if len(removeInt) == len(s) && len(addInt) == 0 {
-				fmt.Println("Suppresing the TAG diff ")
-				return diff.Clear("tags")
-			}
-		}
-	}
-	return nil
-}
-
 func flattenRoleData(object []iampapv2.Role, roleType string) []map[string]string {
 	var roles []map[string]string
 
@@ -1871,6 +1807,27 @@ func flattenKeyPolicies(policies []kp.Policy) []map[string]interface{} {
 	return policyMap
 }
 
+func flattenKeyRegistrations(registrations []kp.Registration) []map[string]interface{} {
+	registrationMap := make([]map[string]interface{}, 0, len(registrations))
+	for _, registration := range registrations {
+		registrationInstance := map[string]interface{}{
+			"resource_crn":         registration.ResourceCrn,
+			"description":          registration.Description,
+			"prevent_key_deletion": registration.PreventKeyDeletion,
+			"created_by":           registration.CreatedBy,
+			"updated_by":           registration.UpdatedBy,
+		}
+		if registration.CreationDate != nil {
+			registrationInstance["creation_date"] = registration.CreationDate.String()
+		}
+		if registration.LastUpdateDate != nil {
+			registrationInstance["last_update_date"] = registration.LastUpdateDate.String()
+		}
+		registrationMap = append(registrationMap, registrationInstance)
+	}
+	return registrationMap
+}
+
 // IgnoreSystemLabels returns non-IBM tag keys.
 func IgnoreSystemLabels(labels map[string]string) map[string]string {
 	result := make(map[string]string)
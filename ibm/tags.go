@@ -0,0 +1,345 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	tagTypeUser    = "user"
+	tagTypeAccess  = "access"
+	tagTypeService = "service"
+)
+
+// TagsConfig holds the tags of the provider configuration that apply to all the taggable resources
+type TagsConfig struct {
+	// DefaultTags are added to the tags of every taggable resource
+	DefaultTags []string
+
+	// IgnoreTagPrefixes are the prefixes of the tags managed outside of Terraform, these tags are
+	// neither read nor removed
+	IgnoreTagPrefixes []string
+
+	// DeleteDetachedTags deletes the tags from the account once they are detached from a resource
+	DeleteDetachedTags bool
+}
+
+// providerTagsConfig returns the tags configuration of the provider, meta is nil in the unit tests
+func providerTagsConfig(meta interface{}) TagsConfig {
+	if sess, ok := meta.(ClientSession); ok {
+		return sess.TagsConfig()
+	}
+	return TagsConfig{}
+}
+
+// ignored returns whether tag starts with one of the ignored prefixes
+func (c TagsConfig) ignored(tag string) bool {
+	for _, prefix := range c.IgnoreTagPrefixes {
+		if strings.HasPrefix(tag, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutIgnoredTags returns the tags that are not ignored
+func (c TagsConfig) withoutIgnoredTags(tags *schema.Set) *schema.Set {
+	result := newStringSet(resourceIBMVPCHash, nil)
+	if tags == nil {
+		return result
+	}
+	for _, tag := range tags.List() {
+		if !c.ignored(tag.(string)) {
+			result.Add(tag)
+		}
+	}
+	return result
+}
+
+// withDefaultTags returns the tags of a resource merged with the default tags
+func (c TagsConfig) withDefaultTags(tags *schema.Set) *schema.Set {
+	result := newStringSet(resourceIBMVPCHash, c.DefaultTags)
+	if tags != nil {
+		for _, tag := range tags.List() {
+			result.Add(tag)
+		}
+	}
+	return result
+}
+
+// resourceTags returns the tags of the tags attribute from all the tags of a resource, the default
+// tags are dropped unless they are also in the configured tags
+func (c TagsConfig) resourceTags(tagsAll, configured *schema.Set) *schema.Set {
+	defaults := newStringSet(resourceIBMVPCHash, c.DefaultTags)
+	result := newStringSet(resourceIBMVPCHash, nil)
+	if tagsAll == nil {
+		return result
+	}
+	for _, tag := range tagsAll.List() {
+		if !defaults.Contains(tag) || (configured != nil && configured.Contains(tag)) {
+			result.Add(tag)
+		}
+	}
+	return result
+}
+
+// setResourceTags sets the tags_all attribute of a resource to the tags read with GetTagsUsingCRN and
+// the tags attribute to the tags that are not only default tags
+func setResourceTags(d *schema.ResourceData, meta interface{}, tags *schema.Set) {
+	tagsConfig := providerTagsConfig(meta)
+	tagsAll := tagsConfig.withoutIgnoredTags(tags)
+	d.Set("tags_all", tagsAll)
+	d.Set("tags", tagsConfig.resourceTags(tagsAll, d.Get("tags").(*schema.Set)))
+}
+
+// GetTags sets the tags attribute to the tags of the resource d.Id()
+func GetTags(d *schema.ResourceData, meta interface{}) error {
+	tags, err := GetTagsUsingCRN(meta, d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("tags", tags)
+	return nil
+}
+
+// UpdateTags updates the tags of the resource d.Id() from the change of its tags_all attribute
+func UpdateTags(d *schema.ResourceData, meta interface{}) error {
+	oldList, newList := d.GetChange("tags_all")
+	return UpdateTagsUsingCRN(oldList, newList, meta, d.Id())
+}
+
+// GetTagsUsingCRN returns the user tags of the resource resourceCRN, without the ignored tags
+func GetTagsUsingCRN(meta interface{}, resourceCRN string) (*schema.Set, error) {
+	return GetGlobalTagsUsingCRN(meta, resourceCRN, tagTypeUser)
+}
+
+// GetGlobalTagsUsingCRN returns the tags of type tagType of the resource resourceCRN, without the
+// ignored tags
+func GetGlobalTagsUsingCRN(meta interface{}, resourceCRN, tagType string) (*schema.Set, error) {
+	gtClient, err := meta.(ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting global tagging client settings: %s", err)
+	}
+	accountID, err := tagsAccountID(meta, tagType)
+	if err != nil {
+		return nil, err
+	}
+	taglist, err := listTags(gtClient, &globaltaggingv1.ListTagsOptions{
+		AttachedTo: &resourceCRN,
+		TagType:    &tagType,
+		AccountID:  accountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Println("tagList: ", taglist)
+	return providerTagsConfig(meta).withoutIgnoredTags(newStringSet(resourceIBMVPCHash, taglist)), nil
+}
+
+// UpdateTagsUsingCRN updates the user tags of the resource resourceCRN, see UpdateGlobalTagsUsingCRN
+func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceCRN string) error {
+	return UpdateGlobalTagsUsingCRN(oldList, newList, meta, resourceCRN, tagTypeUser)
+}
+
+// UpdateGlobalTagsUsingCRN attaches the tags of newList that are not in oldList to the resource
+// resourceCRN and detaches the tags of oldList that are not in newList, the ignored tags are never
+// detached. The access tags are created before they are attached. The detached tags are only
+// deleted from the account when delete_detached_tags is set in the provider configuration.
+func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceCRN, tagType string) error {
+	gtClient, err := meta.(ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("Error getting global tagging client settings: %s", err)
+	}
+	accountID, err := tagsAccountID(meta, tagType)
+	if err != nil {
+		return err
+	}
+	tagsConfig := providerTagsConfig(meta)
+	add, remove := tagsChange(oldList, newList, tagsConfig)
+	resources := []globaltaggingv1.Resource{{ResourceID: &resourceCRN}}
+
+	if len(remove) > 0 {
+		results, response, err := gtClient.DetachTag(&globaltaggingv1.DetachTagOptions{
+			Resources: resources,
+			TagNames:  remove,
+			TagType:   &tagType,
+			AccountID: accountID,
+		})
+		if err == nil {
+			err = tagResultsError(results)
+		}
+		if err != nil {
+			return fmt.Errorf("Error detaching the %s tags %v from %s: %s\n%s", tagType, remove, resourceCRN, err, response)
+		}
+		if tagsConfig.DeleteDetachedTags {
+			for _, v := range remove {
+				deleteUnusedTag(gtClient, v, tagType, accountID)
+			}
+		}
+	}
+
+	if len(add) > 0 {
+		if tagType == tagTypeAccess {
+			if err := createAccessTags(gtClient, add); err != nil {
+				return err
+			}
+		}
+		results, response, err := gtClient.AttachTag(&globaltaggingv1.AttachTagOptions{
+			Resources: resources,
+			TagNames:  add,
+			TagType:   &tagType,
+			AccountID: accountID,
+		})
+		if err == nil {
+			err = tagResultsError(results)
+		}
+		if err != nil {
+			return fmt.Errorf("Error attaching the %s tags %v to %s: %s\n%s", tagType, add, resourceCRN, err, response)
+		}
+	}
+
+	return nil
+}
+
+// tagsAccountID returns the account of the service tags, the other tags belong to the account of
+// the caller
+func tagsAccountID(meta interface{}, tagType string) (*string, error) {
+	if tagType != tagTypeService {
+		return nil, nil
+	}
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	return &userDetails.userAccount, nil
+}
+
+// listTags returns the names of the tags listed with options, through all the pages
+func listTags(gtClient *globaltaggingv1.GlobalTaggingV1, options *globaltaggingv1.ListTagsOptions) ([]string, error) {
+	var tags []string
+	offset, limit := int64(0), int64(1000)
+	for {
+		options.Offset, options.Limit = &offset, &limit
+		tagList, response, err := gtClient.ListTags(options)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing the tags: %s\n%s", err, response)
+		}
+		for _, item := range tagList.Items {
+			if item.Name != nil {
+				tags = append(tags, *item.Name)
+			}
+		}
+		offset += int64(len(tagList.Items))
+		if int64(len(tagList.Items)) < limit || (tagList.TotalCount != nil && offset >= *tagList.TotalCount) {
+			return tags, nil
+		}
+	}
+}
+
+// createAccessTags creates the access tags of tags that do not exist in the account yet
+func createAccessTags(gtClient *globaltaggingv1.GlobalTaggingV1, tags []string) error {
+	tagType := tagTypeAccess
+	existing, err := listTags(gtClient, &globaltaggingv1.ListTagsOptions{TagType: &tagType})
+	if err != nil {
+		return err
+	}
+	known := newStringSet(resourceIBMVPCHash, existing)
+	var create []string
+	for _, tag := range tags {
+		if !known.Contains(tag) {
+			create = append(create, tag)
+		}
+	}
+	if len(create) == 0 {
+		return nil
+	}
+	_, response, err := gtClient.CreateTag(&globaltaggingv1.CreateTagOptions{
+		TagNames: create,
+		TagType:  &tagType,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating the access tags %v: %s\n%s", create, err, response)
+	}
+	return nil
+}
+
+// deleteUnusedTag deletes the tag from the account, the API refuses to delete a tag that is still
+// attached to a resource so a failure is only logged
+func deleteUnusedTag(gtClient *globaltaggingv1.GlobalTaggingV1, tag, tagType string, accountID *string) {
+	_, response, err := gtClient.DeleteTag(&globaltaggingv1.DeleteTagOptions{
+		TagName:   &tag,
+		TagType:   &tagType,
+		AccountID: accountID,
+	})
+	if err != nil {
+		log.Printf("[WARN] The %s tag %s was not deleted, it may still be attached to other resources: %s\n%s", tagType, tag, err, response)
+	}
+}
+
+// tagResultsError returns an error when the tags were not attached to or detached from a resource
+func tagResultsError(results *globaltaggingv1.TagResults) error {
+	if results == nil {
+		return nil
+	}
+	for _, result := range results.Results {
+		if result.IsError != nil && *result.IsError {
+			if result.ResourceID == nil {
+				return fmt.Errorf("The tagging request failed for a resource")
+			}
+			return fmt.Errorf("The tagging request failed for the resource %s", *result.ResourceID)
+		}
+	}
+	return nil
+}
+
+// tagsChange returns the tags to attach and the tags to detach to go from oldList to newList
+func tagsChange(oldList, newList interface{}, tagsConfig TagsConfig) (add, remove []string) {
+	olds, _ := oldList.(*schema.Set)
+	news, _ := newList.(*schema.Set)
+	if olds == nil {
+		olds = new(schema.Set)
+	}
+	if news == nil {
+		news = new(schema.Set)
+	}
+	for _, v := range news.Difference(olds).List() {
+		add = append(add, fmt.Sprint(v))
+	}
+	for _, v := range olds.Difference(news).List() {
+		if !tagsConfig.ignored(fmt.Sprint(v)) {
+			remove = append(remove, fmt.Sprint(v))
+		}
+	}
+	return add, remove
+}
+
+// resourceTagsCustomizeDiff plans the tags_all attribute of a taggable resource, its tags merged
+// with the default tags of the provider
+func resourceTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+	tagsAll := providerTagsConfig(meta).withDefaultTags(diff.Get("tags").(*schema.Set))
+	if old, ok := diff.Get("tags_all").(*schema.Set); diff.Id() == "" || !ok || !tagsAll.Equal(old) {
+		return diff.SetNew("tags_all", tagsAll.List())
+	}
+	return nil
+}
+
+// resourceTagsAllSchema is the schema of the tags_all attribute of the taggable resources
+func resourceTagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         resourceIBMVPCHash,
+		Description: "All the tags of the resource, including the default tags of the provider",
+	}
+}
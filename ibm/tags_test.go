@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+func sortedTags(tags []string) []string {
+	sort.Strings(tags)
+	return tags
+}
+
+// hashTag returns the key of tag in the flatmap of a tags attribute
+func hashTag(tag string) string {
+	return strconv.Itoa(resourceIBMVPCHash(tag))
+}
+
+func TestTagsConfig(t *testing.T) {
+	tagsConfig := TagsConfig{
+		DefaultTags:       []string{"env:test", "owner:team"},
+		IgnoreTagPrefixes: []string{"schematics:", "auto"},
+	}
+
+	tags := newStringSet(resourceIBMVPCHash, []string{"app:web", "owner:team"})
+	tagsAll := tagsConfig.withDefaultTags(tags)
+	assert.DeepEqual(t, []string{"app:web", "env:test", "owner:team"}, sortedTags(expandStringList(tagsAll.List())))
+
+	// owner:team is both a default tag and a tag of the resource, env:test is only a default tag
+	assert.DeepEqual(t, []string{"app:web", "owner:team"}, sortedTags(expandStringList(tagsConfig.resourceTags(tagsAll, tags).List())))
+	assert.DeepEqual(t, []string{"app:web"}, expandStringList(tagsConfig.resourceTags(tagsAll, nil).List()))
+
+	read := newStringSet(resourceIBMVPCHash, []string{"app:web", "schematics:ws1", "autoscaler", "env:test"})
+	assert.DeepEqual(t, []string{"app:web", "env:test"}, sortedTags(expandStringList(tagsConfig.withoutIgnoredTags(read).List())))
+	assert.Equal(t, 0, tagsConfig.withoutIgnoredTags(nil).Len())
+}
+
+func TestTagsChange(t *testing.T) {
+	tagsConfig := TagsConfig{IgnoreTagPrefixes: []string{"schematics:"}}
+	olds := newStringSet(resourceIBMVPCHash, []string{"app:web", "schematics:ws1", "env:test"})
+	news := newStringSet(resourceIBMVPCHash, []string{"app:api", "env:test"})
+
+	add, remove := tagsChange(olds, news, tagsConfig)
+	assert.DeepEqual(t, []string{"app:api"}, add)
+	assert.DeepEqual(t, []string{"app:web"}, remove)
+
+	add, remove = tagsChange(nil, news, tagsConfig)
+	assert.DeepEqual(t, []string{"app:api", "env:test"}, sortedTags(add))
+	assert.Assert(t, is.Len(remove, 0))
+}
+
+func TestResourceTagsCustomizeDiff(t *testing.T) {
+	meta := &clientSession{config: &Config{Tags: TagsConfig{DefaultTags: []string{"env:test"}}}}
+	r := resourceIBMISSSHKey()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":       "key",
+		"public_key": "ssh-rsa AAAA",
+		"tags":       []interface{}{"app:web"},
+	})
+
+	diff, err := r.Diff(context.Background(), nil, config, meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil)
+	assert.Equal(t, "2", diff.Attributes["tags_all.#"].New)
+
+	// No diff once the tags of the state match the tags of the configuration and the default tags
+	state := &terraform.InstanceState{
+		ID: "key1",
+		Attributes: map[string]string{
+			"id":                              "key1",
+			"name":                            "key",
+			"public_key":                      "ssh-rsa AAAA",
+			"tags.#":                          "1",
+			"tags." + hashTag("app:web"):      "app:web",
+			"tags_all.#":                      "2",
+			"tags_all." + hashTag("app:web"):  "app:web",
+			"tags_all." + hashTag("env:test"): "env:test",
+		},
+	}
+	diff, err = r.Diff(context.Background(), state, config, meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff == nil || diff.Empty(), "unexpected diff: %v", diff)
+
+	// A new default tag only changes tags_all
+	meta.config.Tags.DefaultTags = append(meta.config.Tags.DefaultTags, "owner:team")
+	diff, err = r.Diff(context.Background(), state, config, meta)
+	assert.NilError(t, err)
+	assert.Assert(t, diff != nil)
+	assert.Equal(t, "3", diff.Attributes["tags_all.#"].New)
+	_, ok := diff.Attributes["tags.#"]
+	assert.Assert(t, !ok)
+}
+
+// fakeGlobalTagging is an in-memory Global Tagging API, the tags of each type are attached to
+// resource CRNs
+type fakeGlobalTagging struct {
+	tags     map[string]map[string]bool            // tag type -> tags of the account
+	attached map[string]map[string]map[string]bool // tag type -> resource CRN -> tags
+}
+
+func (f *fakeGlobalTagging) ServeHTTP(w gohttp.ResponseWriter, r *gohttp.Request) {
+	tagType := r.URL.Query().Get("tag_type")
+	if f.tags[tagType] == nil {
+		f.tags[tagType] = map[string]bool{}
+		f.attached[tagType] = map[string]map[string]bool{}
+	}
+	body := struct {
+		Resources []struct {
+			ResourceID string `json:"resource_id"`
+		} `json:"resources"`
+		TagNames []string `json:"tag_names"`
+	}{}
+	json.NewDecoder(r.Body).Decode(&body)
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == gohttp.MethodGet && r.URL.Path == "/v3/tags":
+		var items []map[string]string
+		for tag := range f.tags[tagType] {
+			if attachedTo := r.URL.Query().Get("attached_to"); attachedTo == "" || f.attached[tagType][attachedTo][tag] {
+				items = append(items, map[string]string{"name": tag})
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"total_count": len(items), "items": items})
+	case r.Method == gohttp.MethodPost && r.URL.Path == "/v3/tags":
+		for _, tag := range body.TagNames {
+			f.tags[tagType][tag] = true
+		}
+		fmt.Fprint(w, `{"results": []}`)
+	case r.Method == gohttp.MethodPost && (r.URL.Path == "/v3/tags/attach" || r.URL.Path == "/v3/tags/detach"):
+		for _, resource := range body.Resources {
+			if f.attached[tagType][resource.ResourceID] == nil {
+				f.attached[tagType][resource.ResourceID] = map[string]bool{}
+			}
+			for _, tag := range body.TagNames {
+				if r.URL.Path == "/v3/tags/detach" {
+					delete(f.attached[tagType][resource.ResourceID], tag)
+					continue
+				}
+				if tagType == tagTypeAccess && !f.tags[tagType][tag] {
+					gohttp.Error(w, `{"errors": [{"message": "the access tag does not exist"}]}`, gohttp.StatusBadRequest)
+					return
+				}
+				f.tags[tagType][tag] = true
+				f.attached[tagType][resource.ResourceID][tag] = true
+			}
+		}
+		fmt.Fprint(w, `{"results": [{"resource_id": "crn", "is_error": false}]}`)
+	case r.Method == gohttp.MethodDelete && strings.HasPrefix(r.URL.Path, "/v3/tags/"):
+		tag := strings.TrimPrefix(r.URL.Path, "/v3/tags/")
+		for _, tags := range f.attached[tagType] {
+			if tags[tag] {
+				gohttp.Error(w, `{"errors": [{"message": "the tag is attached"}]}`, gohttp.StatusBadRequest)
+				return
+			}
+		}
+		delete(f.tags[tagType], tag)
+		fmt.Fprint(w, `{"results": [{"provider": "ghost", "is_error": false}]}`)
+	default:
+		gohttp.NotFound(w, r)
+	}
+}
+
+func fakeGlobalTaggingClientSession(t *testing.T, tagsConfig TagsConfig) (ClientSession, *fakeGlobalTagging) {
+	fake := &fakeGlobalTagging{
+		tags:     map[string]map[string]bool{},
+		attached: map[string]map[string]map[string]bool{},
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	client, err := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess := &clientSession{
+		config:             &Config{Tags: tagsConfig},
+		globalTaggingV1API: client,
+	}
+	sess.globalTaggingV1Once.Do(func() {})
+	return sess, fake
+}
+
+func TestUpdateGlobalTagsUsingCRN(t *testing.T) {
+	const vpcCRN, subnetCRN = "crn:v1:bluemix:public:is:us-south:a/account::vpc:vpc1", "crn:v1:bluemix:public:is:us-south:a/account::subnet:subnet1"
+	meta, fake := fakeGlobalTaggingClientSession(t, TagsConfig{})
+
+	// The access tags are created before they are attached
+	tags := newStringSet(resourceIBMVPCHash, []string{"project:blue", "env:prod"})
+	assert.Assert(t, is.Nil(UpdateGlobalTagsUsingCRN(nil, tags, meta, vpcCRN, tagTypeAccess)))
+	assert.Assert(t, is.Nil(UpdateGlobalTagsUsingCRN(nil, tags, meta, subnetCRN, tagTypeAccess)))
+	read, err := GetGlobalTagsUsingCRN(meta, vpcCRN, tagTypeAccess)
+	assert.NilError(t, err)
+	assert.Assert(t, tags.Equal(read))
+	read, err = GetTagsUsingCRN(meta, vpcCRN)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, read.Len())
+
+	// The detached tags stay in the account by default
+	assert.Assert(t, is.Nil(UpdateGlobalTagsUsingCRN(tags, newStringSet(resourceIBMVPCHash, []string{"env:prod"}), meta, vpcCRN, tagTypeAccess)))
+	assert.Assert(t, fake.tags[tagTypeAccess]["project:blue"])
+
+	// With delete_detached_tags, a tag is deleted once it is no longer attached to any resource
+	meta.(*clientSession).config.Tags.DeleteDetachedTags = true
+	assert.Assert(t, is.Nil(UpdateGlobalTagsUsingCRN(tags, nil, meta, subnetCRN, tagTypeAccess)))
+	assert.Assert(t, !(fake.tags[tagTypeAccess]["project:blue"]))
+	assert.Assert(t, fake.tags[tagTypeAccess]["env:prod"], "the tag attached to the VPC is not deleted")
+}
+
+func TestResourceTagRead(t *testing.T) {
+	const vpcCRN = "crn:v1:bluemix:public:is:us-south:a/account::vpc:vpc1"
+	meta, _ := fakeGlobalTaggingClientSession(t, TagsConfig{IgnoreTagPrefixes: []string{"schematics:"}})
+	assert.Assert(t, is.Nil(UpdateTagsUsingCRN(nil, newStringSet(resourceIBMVPCHash, []string{"env:prod", "owner:team", "schematics:ws1"}), meta, vpcCRN)))
+
+	r := resourceIBMResourceTag()
+	d := r.Data(nil)
+	d.SetId(buildCompositeID(vpcCRN, tagTypeUser))
+	diags := r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.Equal(t, vpcCRN, d.Get("resource_id"))
+	assert.DeepEqual(t, []string{"env:prod", "owner:team"}, sortedTags(expandStringList(d.Get("tags").(*schema.Set).List())))
+
+	// Only the tags of the configuration are read once the resource is created
+	d.Set("tags", []interface{}{"env:prod", "app:web"})
+	diags = r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.DeepEqual(t, []string{"env:prod"}, expandStringList(d.Get("tags").(*schema.Set).List()))
+
+	d.SetId("user:vpc1")
+	diags = r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, diags.HasError())
+}
+
+func TestTagResultsError(t *testing.T) {
+	failed, succeeded := true, false
+	resourceID := "crn:v1:bluemix:public:is:us-south:a/account::vpc:r006-1"
+
+	assert.Assert(t, is.Nil(tagResultsError(nil)))
+	assert.Assert(t, is.Nil(tagResultsError(&globaltaggingv1.TagResults{Results: []globaltaggingv1.TagResultsItem{{ResourceID: &resourceID, IsError: &succeeded}}})))
+	assert.Error(t, tagResultsError(&globaltaggingv1.TagResults{Results: []globaltaggingv1.TagResultsItem{{ResourceID: &resourceID, IsError: &failed}}}), "The tagging request failed for the resource "+resourceID)
+	assert.Error(t, tagResultsError(&globaltaggingv1.TagResults{Results: []globaltaggingv1.TagResultsItem{{IsError: &failed}}}), "The tagging request failed for a resource")
+}
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	gohttp "net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// retryMaxDelay caps the exponential backoff between two attempts
+	retryMaxDelay = 30 * time.Second
+	// retryAfterMaxDelay caps the wait requested by a Retry-After header
+	retryAfterMaxDelay = 5 * time.Minute
+)
+
+// retryTransport is the http.RoundTripper shared by the bluemix-go, IBM Cloud platform SDK,
+// SoftLayer, Key Protect and Power clients. It pools the connections and retries throttled
+// and failed requests with exponential backoff and jitter, honouring the Retry-After header.
+// Requests that are not idempotent are only retried when the server did not process them.
+// The attempt timeout applies to each attempt on its own, the waits between the attempts
+// are only bounded by the context of the request.
+type retryTransport struct {
+	transport      gohttp.RoundTripper
+	maxRetries     int
+	minDelay       time.Duration
+	maxDelay       time.Duration
+	attemptTimeout time.Duration
+}
+
+func newRetryTransport(maxRetries int, minDelay, attemptTimeout time.Duration) *retryTransport {
+	if minDelay <= 0 {
+		minDelay = time.Second
+	}
+	return &retryTransport{
+		transport:      DefaultTransport(),
+		maxRetries:     maxRetries,
+		minDelay:       minDelay,
+		maxDelay:       retryMaxDelay,
+		attemptTimeout: attemptTimeout,
+	}
+}
+
+// withAttemptTimeout returns a transport sharing the connections of t with another attempt timeout
+func (t *retryTransport) withAttemptTimeout(attemptTimeout time.Duration) *retryTransport {
+	transport := *t
+	transport.attemptTimeout = attemptTimeout
+	return &transport
+}
+
+// withMaxRetries returns a transport sharing the connections of t with another number of retries
+func (t *retryTransport) withMaxRetries(maxRetries int) *retryTransport {
+	transport := *t
+	transport.maxRetries = maxRetries
+	return &transport
+}
+
+// DefaultTransport returns a transport that keeps the connections alive and pools them per host
+func DefaultTransport() gohttp.RoundTripper {
+	transport := &gohttp.Transport{
+		Proxy: gohttp.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   20,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   20 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: false,
+		},
+	}
+	return transport
+}
+
+func (t *retryTransport) RoundTrip(req *gohttp.Request) (*gohttp.Response, error) {
+	attemptReq := req
+	for attempt := 0; ; attempt++ {
+		resp, err := t.roundTripAttempt(attemptReq)
+		if attempt >= t.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+		// A request body can only be sent again when it can be rewound
+		if req.Body != nil && req.Body != gohttp.NoBody && req.GetBody == nil {
+			return resp, err
+		}
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+			log.Printf("[DEBUG] %s %s returned %d, retrying in %s (%d/%d)", req.Method, req.URL.Redacted(), resp.StatusCode, wait, attempt+1, t.maxRetries)
+		} else {
+			log.Printf("[DEBUG] %s %s failed: %s, retrying in %s (%d/%d)", req.Method, req.URL.Redacted(), err, wait, attempt+1, t.maxRetries)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		attemptReq = req.Clone(req.Context())
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+	}
+}
+
+// roundTripAttempt sends the request once, the attempt timeout also covers the read of the
+// response body so it is only released when the body is closed
+func (t *retryTransport) roundTripAttempt(req *gohttp.Request) (*gohttp.Response, error) {
+	if t.attemptTimeout <= 0 {
+		return t.transport.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.attemptTimeout)
+	resp, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelReadCloser{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelReadCloser cancels the context of the attempt when the response body is closed
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelReadCloser) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// backoff returns the wait before the next attempt, either the wait asked by the
+// server in a Retry-After header or an exponential delay with jitter
+func (t *retryTransport) backoff(attempt int, resp *gohttp.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == 429 || resp.StatusCode == 503) {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > retryAfterMaxDelay {
+				wait = retryAfterMaxDelay
+			}
+			return wait
+		}
+	}
+	delay := t.minDelay << uint(attempt)
+	if delay <= 0 || delay > t.maxDelay {
+		delay = t.maxDelay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := gohttp.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// shouldRetry reports whether the request can be sent again. Rate limited requests and
+// connections that could not be established were not processed and are always retried,
+// other failures are only retried for idempotent requests.
+func shouldRetry(req *gohttp.Request, resp *gohttp.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+		var netErr net.Error
+		if errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+			return isIdempotent(req)
+		}
+		return false
+	}
+	switch resp.StatusCode {
+	case 429:
+		return true
+	case 408, 502, 503, 504, 520, 599:
+		return isIdempotent(req)
+	}
+	return false
+}
+
+// isIdempotent reports whether sending the request twice has the same effect as sending it once
+func isIdempotent(req *gohttp.Request) bool {
+	switch req.Method {
+	case gohttp.MethodGet, gohttp.MethodHead, gohttp.MethodOptions, gohttp.MethodTrace, gohttp.MethodPut, gohttp.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != "" || req.Header.Get("X-Idempotency-Key") != ""
+}
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/softlayer/softlayer-go/services"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+// statusServer answers with the given status codes in order and then with 200
+func statusServer(t *testing.T, statuses []int, retryAfter string) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		call := int(atomic.AddInt32(&calls, 1))
+		if r.Body != nil {
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Equal(t, strings.ToUpper(r.Method) != gohttp.MethodGet, len(body) > 0)
+		}
+		if call <= len(statuses) {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(statuses[call-1])
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	return server, &calls
+}
+
+func TestRetryTransportRetriesIdempotentRequests(t *testing.T) {
+	server, calls := statusServer(t, []int{503, 502}, "")
+	defer server.Close()
+
+	client := &gohttp.Client{Transport: newRetryTransport(3, time.Millisecond, 0)}
+	resp, err := client.Get(server.URL)
+	assert.NilError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestRetryTransportStopsAfterMaxRetries(t *testing.T) {
+	server, calls := statusServer(t, []int{504, 504, 504, 504}, "")
+	defer server.Close()
+
+	client := &gohttp.Client{Transport: newRetryTransport(2, time.Millisecond, 0)}
+	resp, err := client.Get(server.URL)
+	assert.NilError(t, err)
+	assert.Equal(t, 504, resp.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestRetryTransportRetriesThrottledPost(t *testing.T) {
+	server, calls := statusServer(t, []int{429}, "0")
+	defer server.Close()
+
+	client := &gohttp.Client{Transport: newRetryTransport(3, time.Millisecond, 0)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name": "vpc"}`))
+	assert.NilError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestRetryTransportDoesNotRetryFailedPost(t *testing.T) {
+	server, calls := statusServer(t, []int{503}, "")
+	defer server.Close()
+
+	client := &gohttp.Client{Transport: newRetryTransport(3, time.Millisecond, 0)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name": "vpc"}`))
+	assert.NilError(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+
+	// A request with an idempotency key can safely be sent again
+	req, _ := gohttp.NewRequest(gohttp.MethodPost, server.URL, strings.NewReader(`{"name": "vpc"}`))
+	req.Header.Set("X-Idempotency-Key", "key")
+	atomic.StoreInt32(calls, 0)
+	resp, err = client.Do(req)
+	assert.NilError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestRetryTransportCancelsWait(t *testing.T) {
+	server, _ := statusServer(t, []int{429}, "60")
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := gohttp.NewRequest(gohttp.MethodGet, server.URL, nil)
+	client := &gohttp.Client{Transport: newRetryTransport(3, time.Millisecond, 0)}
+	start := time.Now()
+	_, err := client.Do(req.WithContext(ctx))
+	assert.Assert(t, err != nil)
+	assert.Assert(t, time.Since(start) < 10*time.Second)
+}
+
+func TestRetryTransportAttemptTimeout(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		// The first attempt hangs, the next ones answer at once
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	client := &gohttp.Client{Transport: newRetryTransport(3, time.Millisecond, 200*time.Millisecond)}
+	resp, err := client.Get(server.URL)
+	assert.NilError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestRetryTransportAttemptTimeoutExcludesRetryAfter(t *testing.T) {
+	server, calls := statusServer(t, []int{429}, "1")
+	defer server.Close()
+
+	// The Retry-After wait is longer than the attempt timeout
+	client := &gohttp.Client{Transport: newRetryTransport(3, time.Millisecond, 500*time.Millisecond)}
+	resp, err := client.Get(server.URL)
+	assert.NilError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(body, 0))
+	assert.Assert(t, is.Nil(resp.Body.Close()))
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := newRetryTransport(10, time.Second, 0)
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := transport.backoff(attempt, nil)
+		expected := time.Second << uint(attempt)
+		if expected > retryMaxDelay {
+			expected = retryMaxDelay
+		}
+		assert.Assert(t, delay >= expected/2 && delay <= expected, "attempt %d waited %s", attempt, delay)
+	}
+
+	resp := &gohttp.Response{StatusCode: 429, Header: gohttp.Header{"Retry-After": []string{"7"}}}
+	assert.Equal(t, 7*time.Second, transport.backoff(0, resp))
+	resp.Header.Set("Retry-After", "3600")
+	assert.Equal(t, retryAfterMaxDelay, transport.backoff(0, resp))
+
+	wait, ok := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(gohttp.TimeFormat))
+	assert.Assert(t, ok)
+	assert.Assert(t, wait > 50*time.Second && wait <= time.Minute)
+	_, ok = parseRetryAfter("soon")
+	assert.Assert(t, !ok)
+}
+
+func TestSoftLayerSessionOnlyRetriesInTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(gohttp.StatusTooManyRequests)
+		w.Write([]byte(`{"error": "Rate limit exceeded", "code": "SoftLayer_Exception_WebService_RateLimitExceeded"}`))
+	}))
+	defer server.Close()
+
+	sess, err := newSession(&Config{SoftLayerEndpointURL: server.URL, RetryCount: 2, RetryDelay: time.Millisecond}, nil)
+	assert.NilError(t, err)
+	_, err = services.GetAccountService(sess.SoftLayerSession).GetObject()
+	assert.ErrorContains(t, err, "SoftLayer_Exception_WebService_RateLimitExceeded")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestKeyProtectTransportDoesNotRetry(t *testing.T) {
+	server, calls := statusServer(t, []int{503, 503}, "0")
+	defer server.Close()
+
+	sess, err := newSession(&Config{RetryCount: 2, RetryDelay: time.Millisecond}, nil)
+	assert.NilError(t, err)
+	client := &gohttp.Client{Transport: sess.KeyProtectTransport}
+	resp, err := client.Get(server.URL)
+	assert.NilError(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+
+	resp, err = sess.HTTPClient.Get(server.URL)
+	assert.NilError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -501,6 +502,25 @@ func validateRegexp(regex string) schema.SchemaValidateFunc {
 	}
 }
 
+// validateStringIntBetween checks that the string is an integer between min and max,
+// both inclusive, or one of the allowed values
+func validateStringIntBetween(min, max int, allowedValues []string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		for _, allowed := range allowedValues {
+			if value == allowed {
+				return
+			}
+		}
+		i, err := strconv.Atoi(value)
+		if err != nil || i < min || i > max {
+			errors = append(errors, fmt.Errorf(
+				"%q must be an integer between %d and %d or one of %q, got %q", k, min, max, allowedValues, value))
+		}
+		return
+	}
+}
+
 // NoZeroValues is a SchemaValidateFunc which tests if the provided value is
 // not a zero value. It's useful in situations where you want to catch
 // explicit zero values on things like required fields during validation.
@@ -1068,6 +1088,7 @@ const (
 	ValidateJSONString
 	ValidateJSONParam
 	ValidateBindedPackageName
+	ValidateStringIntBetween
 )
 
 // ValueType -- Copied from Terraform for now. You can refer to Terraform ValueType directly.
@@ -1125,6 +1146,39 @@ type ValidateSchema struct {
 	ForceNew bool
 }
 
+// Type of the rules between the parameters of a resource
+type ValidateRuleType int
+
+const (
+	// The Identifier parameter can not be set together with any of the Identifiers parameters
+	ConflictsWith ValidateRuleType = iota
+	// All the Identifiers parameters must be set when the Identifier parameter is set
+	RequiredWith
+	// Exactly one of the Identifiers parameters must be set
+	ExactlyOneOf
+	// The Identifier parameter only accepts AllowedValues when the WhenIdentifier parameter is set to WhenValue
+	AllowedValuesWhen
+	// The Identifier parameter only accepts AllowedValues when any of the Identifiers parameters is set,
+	// an unset Identifier parameter is checked with its zero value
+	AllowedValuesWith
+)
+
+// ValidateRule is used to describe a cross-field rule. The rules of a resource are checked
+// at plan time by the CustomizeDiff returned by InvokeCustomizeDiff.
+type ValidateRule struct {
+	Type ValidateRuleType
+
+	// This is the parameter name, it is not used by ExactlyOneOf.
+	Identifier string
+
+	// The related parameters of ConflictsWith, RequiredWith, ExactlyOneOf and AllowedValuesWith.
+	Identifiers []string
+
+	AllowedValues  string //Comma separated list of strings.
+	WhenIdentifier string
+	WhenValue      string
+}
+
 type ResourceValidator struct {
 	// This is the resource name - Found in provider.go of IBM Terraform provider.
 	// Ex: ibm_compute_monitor, ibm_compute_bare_metal, ibm_compute_dedicated_host, ibm_cis_global_load_balancer etc.,
@@ -1132,6 +1186,9 @@ type ResourceValidator struct {
 
 	// Array of validator objects. Each object refers to one parameter in the resource provider.
 	Schema []ValidateSchema
+
+	// Array of cross-field rules between the parameters of the resource.
+	Rules []ValidateRule
 }
 
 type ValidatorDict struct {
@@ -1147,53 +1204,206 @@ type ValidatorDict struct {
 var validatorDict = Validator()
 
 // This is the main validation function. This function will be used in all the provider code.
+// It panics when the resource or the parameter is not registered, so that a missing validator
+// is reported when the provider is initialised instead of silently skipping the validation.
 func InvokeValidator(resourceName, identifier string) schema.SchemaValidateFunc {
+	return invokeValidator(validatorDict.ResourceValidatorDictionary, "resource", resourceName, identifier)
+}
+
+func InvokeDataSourceValidator(resourceName, identifier string) schema.SchemaValidateFunc {
+	return invokeValidator(validatorDict.DataSourceValidatorDictionary, "data source", resourceName, identifier)
+}
+
+func invokeValidator(dictionary map[string]*ResourceValidator, kind, resourceName, identifier string) schema.SchemaValidateFunc {
 	// Loop through dictionary and identify the resource and then the parameter configuration.
-	var schemaToInvoke ValidateSchema
-	found := false
-	resourceItem := validatorDict.ResourceValidatorDictionary[resourceName]
-	if resourceItem.ResourceName == resourceName {
-		parameterValidateSchema := resourceItem.Schema
-		for _, validateSchema := range parameterValidateSchema {
-			if validateSchema.Identifier == identifier {
-				schemaToInvoke = validateSchema
-				found = true
-				break
+	resourceItem, ok := dictionary[resourceName]
+	if !ok || resourceItem.ResourceName != resourceName {
+		panic(fmt.Sprintf("No validator is registered for the %s %s", kind, resourceName))
+	}
+	for _, validateSchema := range resourceItem.Schema {
+		if validateSchema.Identifier == identifier {
+			validateFunc := invokeValidatorInternal(validateSchema)
+			if validateFunc == nil {
+				panic(fmt.Sprintf("Unsupported validate function %d for %q of the %s %s", validateSchema.ValidateFunctionIdentifier, identifier, kind, resourceName))
 			}
+			return validateFunc
 		}
 	}
+	panic(fmt.Sprintf("No validator is registered for %q of the %s %s", identifier, kind, resourceName))
+}
 
-	if found {
-		return invokeValidatorInternal(schemaToInvoke)
-	} else {
-		// Add error code later. TODO
-		return nil
+// InvokeCustomizeDiff returns the CustomizeDiff checking the cross-field rules of the resource at plan time.
+// It panics when the resource is not registered.
+func InvokeCustomizeDiff(resourceName string) schema.CustomizeDiffFunc {
+	resourceItem, ok := validatorDict.ResourceValidatorDictionary[resourceName]
+	if !ok || resourceItem.ResourceName != resourceName {
+		panic(fmt.Sprintf("No validator is registered for the resource %s", resourceName))
+	}
+	return func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		return resourceItem.validateRules(diff)
 	}
 }
 
-func InvokeDataSourceValidator(resourceName, identifier string) schema.SchemaValidateFunc {
-	// Loop through dictionary and identify the resource and then the parameter configuration.
-	var schemaToInvoke ValidateSchema
-	found := false
-
-	dataSourceItem := validatorDict.DataSourceValidatorDictionary[resourceName]
-	if dataSourceItem.ResourceName == resourceName {
-		parameterValidateSchema := dataSourceItem.Schema
-		for _, validateSchema := range parameterValidateSchema {
-			if validateSchema.Identifier == identifier {
-				schemaToInvoke = validateSchema
-				found = true
-				break
+// resourceDiff is the part of schema.ResourceDiff used to check the cross-field rules
+type resourceDiff interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+	NewValueKnown(key string) bool
+}
+
+// validateRules checks all the rules of the resource and reports every violation
+func (rv *ResourceValidator) validateRules(diff resourceDiff) error {
+	var violations []string
+	for _, rule := range rv.Rules {
+		if err := rule.validate(diff); err != nil {
+			violations = append(violations, err.Error())
+		}
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("Invalid configuration for %s: %s", rv.ResourceName, strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// validate checks the rule. The rule is skipped while one of its parameters is not known yet.
+func (rule ValidateRule) validate(diff resourceDiff) error {
+	identifiers := rule.identifiers()
+	for _, identifier := range identifiers {
+		if !diff.NewValueKnown(identifier) {
+			return nil
+		}
+	}
+	isSet := func(identifier string) bool {
+		_, ok := diff.GetOk(identifier)
+		return ok
+	}
+
+	switch rule.Type {
+	case ConflictsWith:
+		if !isSet(rule.Identifier) {
+			return nil
+		}
+		for _, identifier := range rule.Identifiers {
+			if isSet(identifier) {
+				return fmt.Errorf("%q conflicts with %q", rule.Identifier, identifier)
+			}
+		}
+	case RequiredWith:
+		if !isSet(rule.Identifier) {
+			return nil
+		}
+		for _, identifier := range rule.Identifiers {
+			if !isSet(identifier) {
+				return fmt.Errorf("%q is required when %q is set", identifier, rule.Identifier)
 			}
 		}
+	case ExactlyOneOf:
+		var set []string
+		for _, identifier := range rule.Identifiers {
+			if isSet(identifier) {
+				set = append(set, identifier)
+			}
+		}
+		if len(set) != 1 {
+			return fmt.Errorf("exactly one of %q must be set, got %q", rule.Identifiers, set)
+		}
+	case AllowedValuesWhen:
+		when, ok := diff.GetOk(rule.WhenIdentifier)
+		if !ok || fmt.Sprint(when) != rule.WhenValue {
+			return nil
+		}
+		value, ok := diff.GetOk(rule.Identifier)
+		if !ok {
+			return nil
+		}
+		allowedValues := ValidateSchema{Type: TypeString, AllowedValues: rule.AllowedValues}.GetValue(AllowedValues).([]string)
+		for _, allowed := range allowedValues {
+			if fmt.Sprint(value) == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q must contain a value from %#v when %q is %q, got %q", rule.Identifier, allowedValues, rule.WhenIdentifier, rule.WhenValue, value)
+	case AllowedValuesWith:
+		for _, identifier := range rule.Identifiers {
+			if !isSet(identifier) {
+				continue
+			}
+			value := diff.Get(rule.Identifier)
+			allowedValues := ValidateSchema{Type: TypeString, AllowedValues: rule.AllowedValues}.GetValue(AllowedValues).([]string)
+			for _, allowed := range allowedValues {
+				if fmt.Sprint(value) == allowed {
+					return nil
+				}
+			}
+			return fmt.Errorf("%q must contain a value from %#v when %q is set, got %q", rule.Identifier, allowedValues, identifier, fmt.Sprint(value))
+		}
+	default:
+		return fmt.Errorf("unknown rule type %d", rule.Type)
 	}
+	return nil
+}
 
-	if found {
-		return invokeValidatorInternal(schemaToInvoke)
-	} else {
-		// Add error code later. TODO
+// identifiers returns all the parameters the rule depends on
+func (rule ValidateRule) identifiers() []string {
+	var identifiers []string
+	if rule.Identifier != "" {
+		identifiers = append(identifiers, rule.Identifier)
+	}
+	identifiers = append(identifiers, rule.Identifiers...)
+	if rule.WhenIdentifier != "" {
+		identifiers = append(identifiers, rule.WhenIdentifier)
+	}
+	return identifiers
+}
+
+// validateIdentifiers checks that the validators of the registry are registered for resources
+// that exist in the provider and that their rules only refer to parameters of the resource.
+// The identifiers of the validate schemas can be shared between parameters, they are checked
+// by InvokeValidator instead.
+func (vd ValidatorDict) validateIdentifiers(resources, dataSources map[string]*schema.Resource) error {
+	check := func(dictionary map[string]*ResourceValidator, resources map[string]*schema.Resource, kind string) error {
+		for name, validator := range dictionary {
+			resource, ok := resources[name]
+			if !ok {
+				return fmt.Errorf("The validator of the %s %s is registered for an unknown %s", kind, name, kind)
+			}
+			if validator.ResourceName != name {
+				return fmt.Errorf("The validator registered for the %s %s is named %s", kind, name, validator.ResourceName)
+			}
+			for _, rule := range validator.Rules {
+				for _, identifier := range rule.identifiers() {
+					if !schemaHasPath(resource.Schema, identifier) {
+						return fmt.Errorf("A rule of the %s %s refers to the unknown parameter %q", kind, name, identifier)
+					}
+				}
+			}
+		}
 		return nil
 	}
+	if err := check(vd.ResourceValidatorDictionary, resources, "resource"); err != nil {
+		return err
+	}
+	return check(vd.DataSourceValidatorDictionary, dataSources, "data source")
+}
+
+// schemaHasPath reports whether the schema defines the attribute path, such as "archive_rule.0.days"
+func schemaHasPath(s map[string]*schema.Schema, path string) bool {
+	parts := strings.Split(path, ".")
+	attr, ok := s[parts[0]]
+	if !ok {
+		return false
+	}
+	rest := parts[1:]
+	if len(rest) > 0 {
+		if _, err := strconv.Atoi(rest[0]); err == nil {
+			rest = rest[1:]
+		}
+	}
+	if len(rest) == 0 {
+		return true
+	}
+	elem, ok := attr.Elem.(*schema.Resource)
+	return ok && schemaHasPath(elem.Schema, strings.Join(rest, "."))
 }
 
 // the function is currently modified to invoke SchemaValidateFunc directly.
@@ -1234,6 +1444,14 @@ func invokeValidatorInternal(schema ValidateSchema) schema.SchemaValidateFunc {
 		return validateJSONString()
 	case ValidateBindedPackageName:
 		return validateBindedPackageName()
+	case ValidateStringIntBetween:
+		minValue, _ := strconv.Atoi(schema.MinValue)
+		maxValue, _ := strconv.Atoi(schema.MaxValue)
+		var allowedValues []string
+		if schema.AllowedValues != "" {
+			allowedValues = schema.GetValue(AllowedValues).([]string)
+		}
+		return validateStringIntBetween(minValue, maxValue, allowedValues)
 
 	default:
 		return nil
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+// fakeResourceDiff returns the configured values, the values listed in unknown are not known yet
+type fakeResourceDiff struct {
+	values  map[string]interface{}
+	unknown map[string]bool
+}
+
+func (d fakeResourceDiff) Get(key string) interface{} {
+	return d.values[key]
+}
+
+func (d fakeResourceDiff) GetOk(key string) (interface{}, bool) {
+	v, ok := d.values[key]
+	return v, ok
+}
+
+func (d fakeResourceDiff) NewValueKnown(key string) bool {
+	return !d.unknown[key]
+}
+
+func TestValidateRules(t *testing.T) {
+	validator := &ResourceValidator{
+		ResourceName: "ibm_test",
+		Rules: []ValidateRule{
+			{Type: ConflictsWith, Identifier: "public_key", Identifiers: []string{"private_key"}},
+			{Type: RequiredWith, Identifier: "username", Identifiers: []string{"password"}},
+			{Type: ExactlyOneOf, Identifiers: []string{"region", "zone"}},
+			{Type: AllowedValuesWhen, Identifier: "profile", AllowedValues: "bx2-2x8, bx2-4x16", WhenIdentifier: "type", WhenValue: "balanced"},
+			{Type: AllowedValuesWith, Identifier: "encryption", AllowedValues: "true", Identifiers: []string{"key"}},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		values  map[string]interface{}
+		unknown map[string]bool
+		errors  []string
+	}{
+		{
+			name:   "valid",
+			values: map[string]interface{}{"region": "us-south", "username": "admin", "password": "secret", "type": "balanced", "profile": "bx2-4x16"},
+		},
+		{
+			name:   "conflicting parameters",
+			values: map[string]interface{}{"region": "us-south", "public_key": "a", "private_key": "b"},
+			errors: []string{`"public_key" conflicts with "private_key"`},
+		},
+		{
+			name:   "missing required parameter",
+			values: map[string]interface{}{"zone": "us-south-1", "username": "admin"},
+			errors: []string{`"password" is required when "username" is set`},
+		},
+		{
+			name:   "none of the parameters",
+			values: map[string]interface{}{},
+			errors: []string{`exactly one of ["region" "zone"] must be set, got []`},
+		},
+		{
+			name:   "value not allowed",
+			values: map[string]interface{}{"region": "us-south", "zone": "us-south-1", "type": "balanced", "profile": "cx2-2x4"},
+			errors: []string{`exactly one of ["region" "zone"] must be set, got ["region" "zone"]`, `"profile" must contain a value from`},
+		},
+		{
+			name:   "value allowed for another type",
+			values: map[string]interface{}{"region": "us-south", "type": "compute", "profile": "cx2-2x4"},
+		},
+		{
+			name:   "value not allowed with another parameter",
+			values: map[string]interface{}{"region": "us-south", "key": "crn:key", "encryption": false},
+			errors: []string{`"encryption" must contain a value from []string{"true"} when "key" is set, got "false"`},
+		},
+		{
+			name:   "value allowed with another parameter",
+			values: map[string]interface{}{"region": "us-south", "key": "crn:key", "encryption": true},
+		},
+		{
+			name:    "unknown values are skipped",
+			values:  map[string]interface{}{"region": "us-south", "public_key": "a", "private_key": "b"},
+			unknown: map[string]bool{"private_key": true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validator.validateRules(fakeResourceDiff{values: tc.values, unknown: tc.unknown})
+			if len(tc.errors) == 0 {
+				assert.NilError(t, err)
+				return
+			}
+			assert.Assert(t, err != nil)
+			assert.Assert(t, is.Contains(err.Error(), "Invalid configuration for ibm_test"))
+			for _, msg := range tc.errors {
+				assert.Assert(t, is.Contains(err.Error(), msg))
+			}
+		})
+	}
+}
+
+func TestValidateIdentifiers(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"ibm_test": {
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Optional: true},
+				"rule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"days": {Type: schema.TypeInt, Optional: true},
+						},
+					},
+				},
+			},
+		},
+	}
+	validatorDict := func(name string, rules ...ValidateRule) ValidatorDict {
+		return ValidatorDict{
+			ResourceValidatorDictionary: map[string]*ResourceValidator{
+				name: {ResourceName: name, Rules: rules},
+			},
+		}
+	}
+
+	assert.Assert(t, is.Nil(validatorDict("ibm_test", ValidateRule{Type: RequiredWith, Identifier: "rule.0.days", Identifiers: []string{"name"}}).validateIdentifiers(resources, nil)))
+	assert.Error(t, validatorDict("ibm_missing").validateIdentifiers(resources, nil), "The validator of the resource ibm_missing is registered for an unknown resource")
+	assert.Error(t, validatorDict("ibm_test", ValidateRule{Type: ConflictsWith, Identifier: "name", Identifiers: []string{"rule.0.months"}}).validateIdentifiers(resources, nil), `A rule of the resource ibm_test refers to the unknown parameter "rule.0.months"`)
+	assert.Error(t, ValidatorDict{DataSourceValidatorDictionary: map[string]*ResourceValidator{"ibm_test": {ResourceName: "ibm_test"}}}.validateIdentifiers(nil, nil), "The validator of the data source ibm_test is registered for an unknown data source")
+}
+
+func assertPanicsWithValue(t *testing.T, want interface{}, f func()) {
+	t.Helper()
+	defer func() {
+		assert.Equal(t, recover(), want)
+	}()
+	f()
+}
+
+func TestInvokeValidatorPanicsForUnknownIdentifiers(t *testing.T) {
+	assert.Assert(t, InvokeValidator("ibm_is_vpc", isVPCName) != nil)
+	assertPanicsWithValue(t, "No validator is registered for \"unknown\" of the resource ibm_is_vpc", func() {
+		InvokeValidator("ibm_is_vpc", "unknown")
+	})
+	assertPanicsWithValue(t, "No validator is registered for the resource ibm_unknown", func() {
+		InvokeValidator("ibm_unknown", "name")
+	})
+	assertPanicsWithValue(t, "No validator is registered for the data source ibm_unknown", func() {
+		InvokeDataSourceValidator("ibm_unknown", "name")
+	})
+	assertPanicsWithValue(t, "No validator is registered for the resource ibm_unknown", func() {
+		InvokeCustomizeDiff("ibm_unknown")
+	})
+}
+
+func TestCOSBucketLocationConflicts(t *testing.T) {
+	validate := func(location map[string]interface{}) diag.Diagnostics {
+		config := map[string]interface{}{
+			"bucket_name":          "a-bucket",
+			"resource_instance_id": "crn:v1:bluemix:public:cloud-object-storage:global:a/4ea1882a2d3401ed1e459979941966ea:31fa970d-51d0-4b05-893e-251cba75a7b3::",
+			"storage_class":        "standard",
+		}
+		for k, v := range location {
+			config[k] = v
+		}
+		return resourceIBMCOS().Validate(terraform.NewResourceConfigRaw(config))
+	}
+	assert.Assert(t, !(validate(map[string]interface{}{"region_location": "us-south"}).HasError()))
+	assert.Assert(t, validate(map[string]interface{}{"region_location": "us-south", "single_site_location": "ams03"}).HasError())
+}
+
+func TestCOSBucketValidateRules(t *testing.T) {
+	validator := validatorDict.ResourceValidatorDictionary["ibm_cos_bucket"]
+	objectLock := []interface{}{map[string]interface{}{"default_retention_days": 1}}
+
+	assert.NilError(t, validator.validateRules(fakeResourceDiff{values: map[string]interface{}{"region_location": "us-south"}}))
+	assert.ErrorContains(t, validator.validateRules(fakeResourceDiff{values: map[string]interface{}{}}), "exactly one of")
+	assert.ErrorContains(t, validator.validateRules(fakeResourceDiff{values: map[string]interface{}{
+		"region_location": "us-south", "object_lock": objectLock,
+	}}), `"object_versioning.0.enable" must contain a value from []string{"true"} when "object_lock" is set`)
+	assert.ErrorContains(t, validator.validateRules(fakeResourceDiff{values: map[string]interface{}{
+		"region_location": "us-south", "replication_rule": []interface{}{map[string]interface{}{"enable": true}}, "object_versioning.0.enable": false,
+	}}), `"object_versioning.0.enable" must contain a value from []string{"true"} when "replication_rule" is set, got "false"`)
+	assert.NilError(t, validator.validateRules(fakeResourceDiff{values: map[string]interface{}{
+		"region_location": "us-south", "object_lock": objectLock, "object_versioning.0.enable": true,
+	}}))
+	assert.ErrorContains(t, validator.validateRules(fakeResourceDiff{values: map[string]interface{}{
+		"region_location": "us-south", "object_lock": objectLock, "object_versioning.0.enable": true,
+		"object_lock.0.default_retention_days": 1, "object_lock.0.default_retention_years": 1,
+	}}), `"object_lock.0.default_retention_days" conflicts with "object_lock.0.default_retention_years"`)
+}
+
+func TestFirewallSharedValidateRules(t *testing.T) {
+	validator := validatorDict.ResourceValidatorDictionary["ibm_hardware_firewall_shared"]
+	assert.NilError(t, validator.validateRules(fakeResourceDiff{values: map[string]interface{}{"virtual_instance_id": 1}}))
+	assert.ErrorContains(t, validator.validateRules(fakeResourceDiff{values: map[string]interface{}{}}), "exactly one of")
+	assert.ErrorContains(t, validator.validateRules(fakeResourceDiff{values: map[string]interface{}{"virtual_instance_id": 1, "hardware_instance_id": 2}}), "exactly one of")
+}
@@ -0,0 +1,363 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	vpcBareMetalServerStopTypeSoft = "soft"
+	vpcBareMetalServerStopTypeHard = "hard"
+)
+
+// vpcReference is a reference to a resource of the VPC API, by ID or by name
+type vpcReference struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Href *string `json:"href,omitempty"`
+}
+
+// vpcReservedIP is the reserved IP of a network interface
+type vpcReservedIP struct {
+	Address *string `json:"address,omitempty"`
+}
+
+// vpcBareMetalServerCPU is the processor configuration of a bare metal server
+type vpcBareMetalServerCPU struct {
+	Architecture   string `json:"architecture"`
+	CoreCount      int64  `json:"core_count"`
+	SocketCount    int64  `json:"socket_count"`
+	ThreadsPerCore int64  `json:"threads_per_core"`
+}
+
+// vpcBareMetalServerDisk is a local disk of a bare metal server
+type vpcBareMetalServerDisk struct {
+	ID            string `json:"id"`
+	Href          string `json:"href"`
+	Name          string `json:"name"`
+	Size          int64  `json:"size"`
+	InterfaceType string `json:"interface_type"`
+	ResourceType  string `json:"resource_type"`
+}
+
+// vpcBareMetalServer is a bare metal server of the VPC infrastructure
+type vpcBareMetalServer struct {
+	ID                      string                     `json:"id"`
+	CRN                     string                     `json:"crn"`
+	Href                    string                     `json:"href"`
+	Name                    string                     `json:"name"`
+	Status                  string                     `json:"status"`
+	Bandwidth               int64                      `json:"bandwidth"`
+	Memory                  int64                      `json:"memory"`
+	CPU                     *vpcBareMetalServerCPU     `json:"cpu,omitempty"`
+	Disks                   []vpcBareMetalServerDisk   `json:"disks"`
+	Profile                 *vpcReference              `json:"profile,omitempty"`
+	Zone                    *vpcReference              `json:"zone,omitempty"`
+	VPC                     *vpcReference              `json:"vpc,omitempty"`
+	ResourceGroup           *vpcReference              `json:"resource_group,omitempty"`
+	PrimaryNetworkInterface *vpcBareMetalServerNICRef  `json:"primary_network_interface,omitempty"`
+	NetworkInterfaces       []vpcBareMetalServerNICRef `json:"network_interfaces"`
+	StatusReasons           []vpcBareMetalServerReason `json:"status_reasons"`
+	ResourceType            string                     `json:"resource_type"`
+	CreatedAt               string                     `json:"created_at"`
+	BootTarget              *vpcReference              `json:"boot_target,omitempty"`
+}
+
+// vpcBareMetalServerReason is the reason of the status of a bare metal server
+type vpcBareMetalServerReason struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// vpcBareMetalServerNICRef is a reference to a network interface of a bare metal server
+type vpcBareMetalServerNICRef struct {
+	ID        string         `json:"id"`
+	Name      string         `json:"name"`
+	Href      string         `json:"href"`
+	PrimaryIP *vpcReservedIP `json:"primary_ip,omitempty"`
+	Subnet    *vpcReference  `json:"subnet,omitempty"`
+}
+
+// vpcBareMetalServerNIC is a network interface of a bare metal server
+type vpcBareMetalServerNIC struct {
+	ID                      string         `json:"id"`
+	Name                    string         `json:"name"`
+	Href                    string         `json:"href"`
+	InterfaceType           string         `json:"interface_type"`
+	AllowedVlans            []int64        `json:"allowed_vlans,omitempty"`
+	Vlan                    int64          `json:"vlan,omitempty"`
+	AllowInterfaceToFloat   bool           `json:"allow_interface_to_float"`
+	AllowIPSpoofing         bool           `json:"allow_ip_spoofing"`
+	EnableInfrastructureNat bool           `json:"enable_infrastructure_nat"`
+	MacAddress              string         `json:"mac_address"`
+	PortSpeed               int64          `json:"port_speed"`
+	PrimaryIP               *vpcReservedIP `json:"primary_ip,omitempty"`
+	SecurityGroups          []vpcReference `json:"security_groups"`
+	Subnet                  *vpcReference  `json:"subnet,omitempty"`
+	Status                  string         `json:"status"`
+	Type                    string         `json:"type"`
+	ResourceType            string         `json:"resource_type"`
+}
+
+// vpcBareMetalServerNICPrototype is the request body of the creation of a network interface of a
+// bare metal server
+type vpcBareMetalServerNICPrototype struct {
+	Name                    *string        `json:"name,omitempty"`
+	InterfaceType           string         `json:"interface_type"`
+	AllowedVlans            []int64        `json:"allowed_vlans,omitempty"`
+	Vlan                    *int64         `json:"vlan,omitempty"`
+	AllowInterfaceToFloat   *bool          `json:"allow_interface_to_float,omitempty"`
+	AllowIPSpoofing         *bool          `json:"allow_ip_spoofing,omitempty"`
+	EnableInfrastructureNat *bool          `json:"enable_infrastructure_nat,omitempty"`
+	PrimaryIP               *vpcReservedIP `json:"primary_ip,omitempty"`
+	SecurityGroups          []vpcReference `json:"security_groups,omitempty"`
+	Subnet                  vpcReference   `json:"subnet"`
+}
+
+// vpcBareMetalServerInitialization is the initialization of a bare metal server, the image and the
+// keys it is provisioned with
+type vpcBareMetalServerInitialization struct {
+	Image    vpcReference   `json:"image"`
+	Keys     []vpcReference `json:"keys"`
+	UserData *string        `json:"user_data,omitempty"`
+}
+
+// vpcBareMetalServerPrototype is the request body of the creation of a bare metal server
+type vpcBareMetalServerPrototype struct {
+	Name                    *string                          `json:"name,omitempty"`
+	Profile                 vpcReference                     `json:"profile"`
+	Zone                    vpcReference                     `json:"zone"`
+	VPC                     *vpcReference                    `json:"vpc,omitempty"`
+	ResourceGroup           *vpcReference                    `json:"resource_group,omitempty"`
+	Initialization          vpcBareMetalServerInitialization `json:"initialization"`
+	PrimaryNetworkInterface vpcBareMetalServerNICPrototype   `json:"primary_network_interface"`
+}
+
+// vpcProfileValue is a property of a profile, with a fixed value or a range or a set of values
+type vpcProfileValue struct {
+	Type    string        `json:"type"`
+	Value   interface{}   `json:"value,omitempty"`
+	Default interface{}   `json:"default,omitempty"`
+	Values  []interface{} `json:"values,omitempty"`
+	Min     interface{}   `json:"min,omitempty"`
+	Max     interface{}   `json:"max,omitempty"`
+}
+
+// intValue returns the fixed or default integer value of the property
+func (v vpcProfileValue) intValue() int {
+	for _, value := range []interface{}{v.Value, v.Default} {
+		if number, ok := value.(float64); ok {
+			return int(number)
+		}
+	}
+	return 0
+}
+
+// stringValue returns the fixed or default string value of the property
+func (v vpcProfileValue) stringValue() string {
+	for _, value := range []interface{}{v.Value, v.Default} {
+		if str, ok := value.(string); ok {
+			return str
+		}
+	}
+	return ""
+}
+
+// stringValues returns the values of an enum property
+func (v vpcProfileValue) stringValues() []string {
+	values := make([]string, 0, len(v.Values))
+	for _, value := range v.Values {
+		if str, ok := value.(string); ok {
+			values = append(values, str)
+		}
+	}
+	return values
+}
+
+// vpcBareMetalServerProfileDisk is a local disk configuration of a bare metal server profile
+type vpcBareMetalServerProfileDisk struct {
+	Quantity                vpcProfileValue `json:"quantity"`
+	Size                    vpcProfileValue `json:"size"`
+	SupportedInterfaceTypes vpcProfileValue `json:"supported_interface_types"`
+}
+
+// vpcBareMetalServerProfile is a profile of the bare metal servers
+type vpcBareMetalServerProfile struct {
+	Name            string                          `json:"name"`
+	Family          string                          `json:"family"`
+	Href            string                          `json:"href"`
+	ResourceType    string                          `json:"resource_type"`
+	Bandwidth       vpcProfileValue                 `json:"bandwidth"`
+	CPUArchitecture vpcProfileValue                 `json:"cpu_architecture"`
+	CPUCoreCount    vpcProfileValue                 `json:"cpu_core_count"`
+	CPUSocketCount  vpcProfileValue                 `json:"cpu_socket_count"`
+	Memory          vpcProfileValue                 `json:"memory"`
+	OSArchitecture  vpcProfileValue                 `json:"os_architecture"`
+	Disks           []vpcBareMetalServerProfileDisk `json:"disks"`
+}
+
+// vpcBareMetalServers is the bare metal servers API of the VPC infrastructure, the vpcv1 client of
+// vpc-go-sdk does not provide it
+type vpcBareMetalServers interface {
+	GetBareMetalServer(id string) (*vpcBareMetalServer, *core.DetailedResponse, error)
+	CreateBareMetalServer(prototype *vpcBareMetalServerPrototype) (*vpcBareMetalServer, *core.DetailedResponse, error)
+	UpdateBareMetalServer(id string, name string) (*vpcBareMetalServer, *core.DetailedResponse, error)
+	DeleteBareMetalServer(id string) (*core.DetailedResponse, error)
+	GetBareMetalServerInitialization(id string) (*vpcBareMetalServerInitialization, *core.DetailedResponse, error)
+	StartBareMetalServer(id string) (*core.DetailedResponse, error)
+	StopBareMetalServer(id string, stopType string) (*core.DetailedResponse, error)
+	RestartBareMetalServer(id string) (*core.DetailedResponse, error)
+	UpdateBareMetalServerDisk(serverID string, id string, name string) (*vpcBareMetalServerDisk, *core.DetailedResponse, error)
+	GetBareMetalServerNetworkInterface(serverID string, id string) (*vpcBareMetalServerNIC, *core.DetailedResponse, error)
+	CreateBareMetalServerNetworkInterface(serverID string, prototype *vpcBareMetalServerNICPrototype) (*vpcBareMetalServerNIC, *core.DetailedResponse, error)
+	UpdateBareMetalServerNetworkInterface(serverID string, id string, patch map[string]interface{}) (*vpcBareMetalServerNIC, *core.DetailedResponse, error)
+	DeleteBareMetalServerNetworkInterface(serverID string, id string) (*core.DetailedResponse, error)
+	ListBareMetalServerProfiles() ([]vpcBareMetalServerProfile, error)
+	GetBareMetalServerProfile(name string) (*vpcBareMetalServerProfile, *core.DetailedResponse, error)
+}
+
+type bareMetalServers struct {
+	vpc *vpcv1.VpcV1
+}
+
+// vpcBareMetalServersAPI returns the bare metal servers API of the VPC service configured in vpc
+func vpcBareMetalServersAPI(vpc *vpcv1.VpcV1) vpcBareMetalServers {
+	return &bareMetalServers{vpc: vpc}
+}
+
+func (r *bareMetalServers) GetBareMetalServer(id string) (*vpcBareMetalServer, *core.DetailedResponse, error) {
+	server := &vpcBareMetalServer{}
+	response, err := vpcRequest(r.vpc, core.GET, "/bare_metal_servers/{id}", map[string]string{"id": id}, nil, nil, server)
+	if err != nil {
+		return nil, response, err
+	}
+	return server, response, nil
+}
+
+func (r *bareMetalServers) CreateBareMetalServer(prototype *vpcBareMetalServerPrototype) (*vpcBareMetalServer, *core.DetailedResponse, error) {
+	server := &vpcBareMetalServer{}
+	response, err := vpcRequest(r.vpc, core.POST, "/bare_metal_servers", nil, prototype, nil, server)
+	if err != nil {
+		return nil, response, err
+	}
+	return server, response, nil
+}
+
+func (r *bareMetalServers) UpdateBareMetalServer(id string, name string) (*vpcBareMetalServer, *core.DetailedResponse, error) {
+	server := &vpcBareMetalServer{}
+	patch := map[string]interface{}{"name": name}
+	response, err := vpcRequest(r.vpc, core.PATCH, "/bare_metal_servers/{id}", map[string]string{"id": id}, patch, nil, server)
+	if err != nil {
+		return nil, response, err
+	}
+	return server, response, nil
+}
+
+func (r *bareMetalServers) DeleteBareMetalServer(id string) (*core.DetailedResponse, error) {
+	return vpcRequest(r.vpc, core.DELETE, "/bare_metal_servers/{id}", map[string]string{"id": id}, nil, nil, nil)
+}
+
+func (r *bareMetalServers) GetBareMetalServerInitialization(id string) (*vpcBareMetalServerInitialization, *core.DetailedResponse, error) {
+	initialization := &vpcBareMetalServerInitialization{}
+	response, err := vpcRequest(r.vpc, core.GET, "/bare_metal_servers/{id}/initialization", map[string]string{"id": id}, nil, nil, initialization)
+	if err != nil {
+		return nil, response, err
+	}
+	return initialization, response, nil
+}
+
+func (r *bareMetalServers) StartBareMetalServer(id string) (*core.DetailedResponse, error) {
+	return vpcRequest(r.vpc, core.POST, "/bare_metal_servers/{id}/start", map[string]string{"id": id}, nil, nil, nil)
+}
+
+func (r *bareMetalServers) StopBareMetalServer(id string, stopType string) (*core.DetailedResponse, error) {
+	body := map[string]interface{}{"type": stopType}
+	return vpcRequest(r.vpc, core.POST, "/bare_metal_servers/{id}/stop", map[string]string{"id": id}, body, nil, nil)
+}
+
+func (r *bareMetalServers) RestartBareMetalServer(id string) (*core.DetailedResponse, error) {
+	return vpcRequest(r.vpc, core.POST, "/bare_metal_servers/{id}/restart", map[string]string{"id": id}, nil, nil, nil)
+}
+
+func (r *bareMetalServers) UpdateBareMetalServerDisk(serverID string, id string, name string) (*vpcBareMetalServerDisk, *core.DetailedResponse, error) {
+	disk := &vpcBareMetalServerDisk{}
+	patch := map[string]interface{}{"name": name}
+	pathParams := map[string]string{"bare_metal_server_id": serverID, "id": id}
+	response, err := vpcRequest(r.vpc, core.PATCH, "/bare_metal_servers/{bare_metal_server_id}/disks/{id}", pathParams, patch, nil, disk)
+	if err != nil {
+		return nil, response, err
+	}
+	return disk, response, nil
+}
+
+func (r *bareMetalServers) GetBareMetalServerNetworkInterface(serverID string, id string) (*vpcBareMetalServerNIC, *core.DetailedResponse, error) {
+	nic := &vpcBareMetalServerNIC{}
+	pathParams := map[string]string{"bare_metal_server_id": serverID, "id": id}
+	response, err := vpcRequest(r.vpc, core.GET, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces/{id}", pathParams, nil, nil, nic)
+	if err != nil {
+		return nil, response, err
+	}
+	return nic, response, nil
+}
+
+func (r *bareMetalServers) CreateBareMetalServerNetworkInterface(serverID string, prototype *vpcBareMetalServerNICPrototype) (*vpcBareMetalServerNIC, *core.DetailedResponse, error) {
+	nic := &vpcBareMetalServerNIC{}
+	pathParams := map[string]string{"bare_metal_server_id": serverID}
+	response, err := vpcRequest(r.vpc, core.POST, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces", pathParams, prototype, nil, nic)
+	if err != nil {
+		return nil, response, err
+	}
+	return nic, response, nil
+}
+
+func (r *bareMetalServers) UpdateBareMetalServerNetworkInterface(serverID string, id string, patch map[string]interface{}) (*vpcBareMetalServerNIC, *core.DetailedResponse, error) {
+	nic := &vpcBareMetalServerNIC{}
+	pathParams := map[string]string{"bare_metal_server_id": serverID, "id": id}
+	response, err := vpcRequest(r.vpc, core.PATCH, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces/{id}", pathParams, patch, nil, nic)
+	if err != nil {
+		return nil, response, err
+	}
+	return nic, response, nil
+}
+
+func (r *bareMetalServers) DeleteBareMetalServerNetworkInterface(serverID string, id string) (*core.DetailedResponse, error) {
+	pathParams := map[string]string{"bare_metal_server_id": serverID, "id": id}
+	return vpcRequest(r.vpc, core.DELETE, "/bare_metal_servers/{bare_metal_server_id}/network_interfaces/{id}", pathParams, nil, nil, nil)
+}
+
+func (r *bareMetalServers) ListBareMetalServerProfiles() ([]vpcBareMetalServerProfile, error) {
+	start := ""
+	allrecs := []vpcBareMetalServerProfile{}
+	for {
+		collection := struct {
+			Profiles []vpcBareMetalServerProfile `json:"profiles"`
+			Next     *vpcPageLink                `json:"next"`
+		}{}
+		query := map[string]string{}
+		if start != "" {
+			query["start"] = start
+		}
+		_, err := vpcRequest(r.vpc, core.GET, "/bare_metal_server/profiles", nil, nil, query, &collection)
+		if err != nil {
+			return nil, err
+		}
+		allrecs = append(allrecs, collection.Profiles...)
+		start = GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+func (r *bareMetalServers) GetBareMetalServerProfile(name string) (*vpcBareMetalServerProfile, *core.DetailedResponse, error) {
+	profile := &vpcBareMetalServerProfile{}
+	response, err := vpcRequest(r.vpc, core.GET, "/bare_metal_server/profiles/{name}", map[string]string{"name": name}, nil, nil, profile)
+	if err != nil {
+		return nil, response, err
+	}
+	return profile, response, nil
+}
@@ -0,0 +1,333 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+// fakeBareMetalServers is an in-memory bare metal servers API of the VPC infrastructure, the power
+// actions complete immediately
+type fakeBareMetalServers struct {
+	servers        map[string]*vpcBareMetalServer
+	initialization map[string]*vpcBareMetalServerInitialization
+	nics           map[string]map[string]*vpcBareMetalServerNIC
+	profiles       []vpcBareMetalServerProfile
+	nextID         int
+}
+
+func (f *fakeBareMetalServers) ServeHTTP(w gohttp.ResponseWriter, r *gohttp.Request) {
+	if r.URL.Query().Get("version") == "" || r.URL.Query().Get("generation") != "2" {
+		gohttp.Error(w, `{"errors": [{"message": "missing version or generation"}]}`, gohttp.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+
+	if strings.HasPrefix(r.URL.Path, "/bare_metal_server/profiles") {
+		f.serveProfiles(w, r)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/bare_metal_servers/"), "/")
+	server := f.servers[parts[0]]
+	switch {
+	case server == nil:
+		gohttp.Error(w, `{"errors": [{"message": "bare metal server not found"}]}`, gohttp.StatusNotFound)
+	case len(parts) == 1 && r.Method == gohttp.MethodGet:
+		json.NewEncoder(w).Encode(server)
+	case len(parts) == 1 && r.Method == gohttp.MethodPatch:
+		json.NewDecoder(r.Body).Decode(server)
+		json.NewEncoder(w).Encode(server)
+	case len(parts) == 1 && r.Method == gohttp.MethodDelete:
+		delete(f.servers, server.ID)
+		w.WriteHeader(gohttp.StatusAccepted)
+	case len(parts) == 2 && parts[1] == "initialization":
+		json.NewEncoder(w).Encode(f.initialization[server.ID])
+	case len(parts) == 2 && parts[1] == "start":
+		server.Status = isBareMetalServerStatusRunning
+		w.WriteHeader(gohttp.StatusNoContent)
+	case len(parts) == 2 && parts[1] == "stop":
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["type"] == "" {
+			gohttp.Error(w, `{"errors": [{"message": "missing stop type"}]}`, gohttp.StatusBadRequest)
+			return
+		}
+		server.Status = isBareMetalServerStatusStopped
+		w.WriteHeader(gohttp.StatusNoContent)
+	case len(parts) >= 2 && parts[1] == "network_interfaces":
+		f.serveNetworkInterfaces(w, r, server, parts[2:])
+	default:
+		gohttp.NotFound(w, r)
+	}
+}
+
+func (f *fakeBareMetalServers) serveNetworkInterfaces(w gohttp.ResponseWriter, r *gohttp.Request, server *vpcBareMetalServer, parts []string) {
+	if len(parts) == 0 && r.Method == gohttp.MethodPost {
+		prototype := vpcBareMetalServerNICPrototype{}
+		json.NewDecoder(r.Body).Decode(&prototype)
+		f.nextID++
+		nic := &vpcBareMetalServerNIC{
+			ID:            fmt.Sprintf("0717-nic%d", f.nextID),
+			InterfaceType: prototype.InterfaceType,
+			AllowedVlans:  prototype.AllowedVlans,
+			Subnet:        &prototype.Subnet,
+			Status:        isBareMetalServerNicStatusAvailable,
+			Type:          "secondary",
+		}
+		if prototype.Name != nil {
+			nic.Name = *prototype.Name
+		}
+		if prototype.Vlan != nil {
+			nic.Vlan = *prototype.Vlan
+		}
+		f.nics[server.ID][nic.ID] = nic
+		server.NetworkInterfaces = append(server.NetworkInterfaces, vpcBareMetalServerNICRef{ID: nic.ID, Name: nic.Name, Subnet: nic.Subnet})
+		w.WriteHeader(gohttp.StatusCreated)
+		json.NewEncoder(w).Encode(nic)
+		return
+	}
+	nic := f.nics[server.ID][parts[0]]
+	switch {
+	case nic == nil:
+		gohttp.Error(w, `{"errors": [{"message": "network interface not found"}]}`, gohttp.StatusNotFound)
+	case r.Method == gohttp.MethodGet:
+		json.NewEncoder(w).Encode(nic)
+	case r.Method == gohttp.MethodPatch:
+		if r.Header.Get("Content-Type") != "application/merge-patch+json" {
+			gohttp.Error(w, `{"errors": [{"message": "unsupported content type"}]}`, gohttp.StatusUnsupportedMediaType)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(nic)
+		json.NewEncoder(w).Encode(nic)
+	case r.Method == gohttp.MethodDelete:
+		delete(f.nics[server.ID], nic.ID)
+		w.WriteHeader(gohttp.StatusAccepted)
+	default:
+		gohttp.NotFound(w, r)
+	}
+}
+
+func (f *fakeBareMetalServers) serveProfiles(w gohttp.ResponseWriter, r *gohttp.Request) {
+	name := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/bare_metal_server/profiles"), "/")
+	if name == "" {
+		json.NewEncoder(w).Encode(map[string]interface{}{"profiles": f.profiles})
+		return
+	}
+	for _, profile := range f.profiles {
+		if profile.Name == name {
+			json.NewEncoder(w).Encode(profile)
+			return
+		}
+	}
+	gohttp.Error(w, `{"errors": [{"message": "profile not found"}]}`, gohttp.StatusNotFound)
+}
+
+// addServer adds a running bare metal server with a primary network interface to the fake API
+func (f *fakeBareMetalServers) addServer(id string) *vpcBareMetalServer {
+	subnet, image, key, zone, profile := "subnet1", "image1", "key1", "us-south-1", "bx2-metal-192x768"
+	primnic := &vpcBareMetalServerNIC{
+		ID:                      id + "-nic",
+		Name:                    "eth0",
+		InterfaceType:           isBareMetalServerNicInterfaceTypePCI,
+		EnableInfrastructureNat: true,
+		PortSpeed:               100000,
+		Subnet:                  &vpcReference{ID: &subnet},
+		Status:                  isBareMetalServerNicStatusAvailable,
+		Type:                    "primary",
+	}
+	server := &vpcBareMetalServer{
+		ID:                      id,
+		Name:                    "bms-" + id,
+		Status:                  isBareMetalServerStatusRunning,
+		Memory:                  768,
+		CPU:                     &vpcBareMetalServerCPU{Architecture: "amd64", CoreCount: 96, SocketCount: 4, ThreadsPerCore: 2},
+		Disks:                   []vpcBareMetalServerDisk{{ID: "disk1", Name: "disk-1", Size: 960, InterfaceType: "sata"}},
+		Profile:                 &vpcReference{Name: &profile},
+		Zone:                    &vpcReference{Name: &zone},
+		PrimaryNetworkInterface: &vpcBareMetalServerNICRef{ID: primnic.ID, Name: primnic.Name, Subnet: primnic.Subnet},
+		NetworkInterfaces:       []vpcBareMetalServerNICRef{{ID: primnic.ID, Name: primnic.Name, Subnet: primnic.Subnet}},
+	}
+	f.servers[id] = server
+	f.initialization[id] = &vpcBareMetalServerInitialization{Image: vpcReference{ID: &image}, Keys: []vpcReference{{ID: &key}}}
+	f.nics[id] = map[string]*vpcBareMetalServerNIC{primnic.ID: primnic}
+	return server
+}
+
+func fakeBareMetalServersClientSession(t *testing.T) (ClientSession, *fakeBareMetalServers) {
+	fake := &fakeBareMetalServers{
+		servers:        map[string]*vpcBareMetalServer{},
+		initialization: map[string]*vpcBareMetalServerInitialization{},
+		nics:           map[string]map[string]*vpcBareMetalServerNIC{},
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	client, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess := &clientSession{vpcAPI: client}
+	sess.vpcOnce.Do(func() {})
+	return sess, fake
+}
+
+func TestVPCBareMetalServersAPI(t *testing.T) {
+	meta, fake := fakeBareMetalServersClientSession(t)
+	fake.addServer("0717-bms1")
+	client, err := bareMetalServersClient(meta)
+	assert.NilError(t, err)
+
+	_, err = client.StopBareMetalServer("0717-bms1", vpcBareMetalServerStopTypeSoft)
+	assert.NilError(t, err)
+	server, _, err := client.GetBareMetalServer("0717-bms1")
+	assert.NilError(t, err)
+	assert.Equal(t, isBareMetalServerStatusStopped, server.Status)
+	_, err = client.StartBareMetalServer("0717-bms1")
+	assert.NilError(t, err)
+	assert.Equal(t, isBareMetalServerStatusRunning, fake.servers["0717-bms1"].Status)
+
+	updated, _, err := client.UpdateBareMetalServer("0717-bms1", "bms-renamed")
+	assert.NilError(t, err)
+	assert.Equal(t, "bms-renamed", updated.Name)
+
+	subnet, vlan := "subnet2", int64(10)
+	nic, _, err := client.CreateBareMetalServerNetworkInterface("0717-bms1", &vpcBareMetalServerNICPrototype{
+		InterfaceType: isBareMetalServerNicInterfaceTypeVlan,
+		Vlan:          &vlan,
+		Subnet:        vpcReference{ID: &subnet},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, int64(10), fake.nics["0717-bms1"][nic.ID].Vlan)
+
+	patched, _, err := client.UpdateBareMetalServerNetworkInterface("0717-bms1", "0717-bms1-nic", map[string]interface{}{isBareMetalServerNicAllowedVlans: []int64{10, 20}})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []int64{10, 20}, patched.AllowedVlans)
+
+	_, err = client.DeleteBareMetalServerNetworkInterface("0717-bms1", nic.ID)
+	assert.NilError(t, err)
+	_, response, err := client.GetBareMetalServerNetworkInterface("0717-bms1", nic.ID)
+	assert.Assert(t, err != nil)
+	assert.Assert(t, response != nil)
+	assert.Equal(t, 404, response.StatusCode)
+}
+
+func TestResourceIBMISBareMetalServerRead(t *testing.T) {
+	meta, fake := fakeBareMetalServersClientSession(t)
+	fake.addServer("0717-bms1")
+	client, _ := bareMetalServersClient(meta)
+	subnet := "subnet2"
+	_, _, err := client.CreateBareMetalServerNetworkInterface("0717-bms1", &vpcBareMetalServerNICPrototype{
+		InterfaceType: isBareMetalServerNicInterfaceTypePCI,
+		Subnet:        vpcReference{ID: &subnet},
+	})
+	assert.NilError(t, err)
+
+	r := resourceIBMISBareMetalServer()
+	d := r.Data(nil)
+	d.SetId("0717-bms1")
+	diags := r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.Equal(t, "bms-0717-bms1", d.Get(isBareMetalServerName))
+	assert.Equal(t, "image1", d.Get(isBareMetalServerImage))
+	assert.Equal(t, 1, d.Get(isBareMetalServerKeys).(*schema.Set).Len())
+	assert.Equal(t, isBareMetalServerStatusRunning, d.Get(isBareMetalServerStatus))
+	assert.Equal(t, 96, d.Get("cpu.0.core_count"))
+	assert.Equal(t, 960, d.Get("disks.0.size"))
+	assert.Equal(t, "0717-bms1-nic", d.Get("primary_network_interface.0.id"))
+	assert.Equal(t, true, d.Get("primary_network_interface.0.enable_infrastructure_nat"))
+	assert.Equal(t, 100000, d.Get("primary_network_interface.0.port_speed"))
+	// The primary network interface is not listed with the secondary network interfaces
+	assert.Equal(t, 1, d.Get("network_interfaces.#"))
+	assert.Equal(t, "subnet2", d.Get("network_interfaces.0.subnet"))
+
+	// A deleted bare metal server is removed from the state
+	_, err = client.DeleteBareMetalServer("0717-bms1")
+	assert.NilError(t, err)
+	diags = r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.Equal(t, "", d.Id())
+}
+
+func TestBareMetalServerNICPatch(t *testing.T) {
+	oldNic := map[string]interface{}{
+		isBareMetalServerNicName:                    "eth0",
+		isBareMetalServerNicAllowIPSpoofing:         false,
+		isBareMetalServerNicEnableInfrastructureNat: true,
+		isBareMetalServerNicAllowedVlans:            schema.NewSet(schema.HashInt, []interface{}{10}),
+	}
+	newNic := map[string]interface{}{
+		isBareMetalServerNicName:                    "eth0",
+		isBareMetalServerNicAllowIPSpoofing:         false,
+		isBareMetalServerNicEnableInfrastructureNat: true,
+		isBareMetalServerNicAllowedVlans:            schema.NewSet(schema.HashInt, []interface{}{10}),
+	}
+	assert.Assert(t, is.Len(bareMetalServerNICPatch(oldNic, newNic), 0))
+
+	newNic[isBareMetalServerNicName] = "eth1"
+	newNic[isBareMetalServerNicAllowIPSpoofing] = true
+	newNic[isBareMetalServerNicAllowedVlans] = schema.NewSet(schema.HashInt, nil)
+	assert.DeepEqual(t, map[string]interface{}{
+		isBareMetalServerNicName:            "eth1",
+		isBareMetalServerNicAllowIPSpoofing: true,
+		isBareMetalServerNicAllowedVlans:    []int64{},
+	}, bareMetalServerNICPatch(oldNic, newNic))
+
+	// An unset name is computed and is not patched
+	newNic[isBareMetalServerNicName] = ""
+	_, ok := bareMetalServerNICPatch(oldNic, newNic)[isBareMetalServerNicName]
+	assert.Assert(t, !ok)
+}
+
+func TestDataSourceIBMISBareMetalServerProfileRead(t *testing.T) {
+	meta, fake := fakeBareMetalServersClientSession(t)
+	fake.profiles = []vpcBareMetalServerProfile{{
+		Name:            "bx2-metal-192x768",
+		Family:          "balanced",
+		Bandwidth:       vpcProfileValue{Type: "fixed", Value: 100000},
+		CPUArchitecture: vpcProfileValue{Type: "fixed", Value: "amd64"},
+		CPUCoreCount:    vpcProfileValue{Type: "fixed", Value: 96},
+		CPUSocketCount:  vpcProfileValue{Type: "fixed", Value: 4},
+		Memory:          vpcProfileValue{Type: "fixed", Value: 768},
+		OSArchitecture:  vpcProfileValue{Type: "enum", Default: "amd64", Values: []interface{}{"amd64"}},
+		Disks: []vpcBareMetalServerProfileDisk{{
+			Quantity:                vpcProfileValue{Type: "fixed", Value: 1},
+			Size:                    vpcProfileValue{Type: "fixed", Value: 960},
+			SupportedInterfaceTypes: vpcProfileValue{Type: "enum", Default: "sata", Values: []interface{}{"sata"}},
+		}},
+	}}
+
+	r := dataSourceIBMISBareMetalServerProfile()
+	d := r.Data(nil)
+	d.Set(isBareMetalServerProfileName, "bx2-metal-192x768")
+	diags := r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.Equal(t, "bx2-metal-192x768", d.Id())
+	assert.Equal(t, "amd64", d.Get(isBareMetalServerProfileCPUArchitecture))
+	assert.Equal(t, 96, d.Get(isBareMetalServerProfileCPUCoreCount))
+	assert.Equal(t, 768, d.Get(isBareMetalServerProfileMemory))
+	assert.DeepEqual(t, []interface{}{"amd64"}, d.Get(isBareMetalServerProfileOSArchitecture))
+	assert.Equal(t, 960, d.Get("disks.0.size"))
+	assert.Equal(t, "sata", d.Get("disks.0.supported_interface_types.0"))
+
+	r = dataSourceIBMISBareMetalServerProfiles()
+	d = r.Data(nil)
+	diags = r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.Equal(t, 1, d.Get("profiles.#"))
+	assert.Equal(t, "balanced", d.Get("profiles.0.family"))
+}
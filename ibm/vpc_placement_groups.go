@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	vpcPlacementGroupStrategyHostSpread  = "host_spread"
+	vpcPlacementGroupStrategyPowerSpread = "power_spread"
+)
+
+// vpcPlacementGroup is a placement group of the VPC infrastructure
+type vpcPlacementGroup struct {
+	ID             string                        `json:"id"`
+	CRN            string                        `json:"crn"`
+	Href           string                        `json:"href"`
+	Name           string                        `json:"name"`
+	Strategy       string                        `json:"strategy"`
+	LifecycleState string                        `json:"lifecycle_state"`
+	ResourceType   string                        `json:"resource_type"`
+	CreatedAt      string                        `json:"created_at"`
+	ResourceGroup  *vpcv1.ResourceGroupReference `json:"resource_group,omitempty"`
+}
+
+// vpcPlacementGroupPrototype is the request body of the creation of a placement group
+type vpcPlacementGroupPrototype struct {
+	Name          string                       `json:"name,omitempty"`
+	Strategy      string                       `json:"strategy"`
+	ResourceGroup *vpcv1.ResourceGroupIdentity `json:"resource_group,omitempty"`
+}
+
+// vpcPlacementGroups is the placement groups API of the VPC infrastructure, the vpcv1 client of
+// vpc-go-sdk does not provide it
+type vpcPlacementGroups interface {
+	ListPlacementGroups() ([]vpcPlacementGroup, error)
+	GetPlacementGroup(id string) (*vpcPlacementGroup, *core.DetailedResponse, error)
+	CreatePlacementGroup(prototype *vpcPlacementGroupPrototype) (*vpcPlacementGroup, *core.DetailedResponse, error)
+	UpdatePlacementGroup(id string, name string) (*vpcPlacementGroup, *core.DetailedResponse, error)
+	DeletePlacementGroup(id string) (*core.DetailedResponse, error)
+}
+
+type placementGroups struct {
+	vpc *vpcv1.VpcV1
+}
+
+// vpcPlacementGroupsAPI returns the placement groups API of the VPC service configured in vpc
+func vpcPlacementGroupsAPI(vpc *vpcv1.VpcV1) vpcPlacementGroups {
+	return &placementGroups{vpc: vpc}
+}
+
+func (r *placementGroups) ListPlacementGroups() ([]vpcPlacementGroup, error) {
+	start := ""
+	allrecs := []vpcPlacementGroup{}
+	for {
+		collection := struct {
+			PlacementGroups []vpcPlacementGroup `json:"placement_groups"`
+			Next            *vpcPageLink        `json:"next"`
+		}{}
+		query := map[string]string{}
+		if start != "" {
+			query["start"] = start
+		}
+		_, err := vpcRequest(r.vpc, core.GET, "/placement_groups", nil, nil, query, &collection)
+		if err != nil {
+			return nil, err
+		}
+		allrecs = append(allrecs, collection.PlacementGroups...)
+		start = GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+func (r *placementGroups) GetPlacementGroup(id string) (*vpcPlacementGroup, *core.DetailedResponse, error) {
+	placementGroup := &vpcPlacementGroup{}
+	response, err := vpcRequest(r.vpc, core.GET, "/placement_groups/{id}", map[string]string{"id": id}, nil, nil, placementGroup)
+	if err != nil {
+		return nil, response, err
+	}
+	return placementGroup, response, nil
+}
+
+func (r *placementGroups) CreatePlacementGroup(prototype *vpcPlacementGroupPrototype) (*vpcPlacementGroup, *core.DetailedResponse, error) {
+	placementGroup := &vpcPlacementGroup{}
+	response, err := vpcRequest(r.vpc, core.POST, "/placement_groups", nil, prototype, nil, placementGroup)
+	if err != nil {
+		return nil, response, err
+	}
+	return placementGroup, response, nil
+}
+
+func (r *placementGroups) UpdatePlacementGroup(id string, name string) (*vpcPlacementGroup, *core.DetailedResponse, error) {
+	placementGroup := &vpcPlacementGroup{}
+	patch := map[string]interface{}{"name": name}
+	response, err := vpcRequest(r.vpc, core.PATCH, "/placement_groups/{id}", map[string]string{"id": id}, patch, nil, placementGroup)
+	if err != nil {
+		return nil, response, err
+	}
+	return placementGroup, response, nil
+}
+
+func (r *placementGroups) DeletePlacementGroup(id string) (*core.DetailedResponse, error) {
+	return vpcRequest(r.vpc, core.DELETE, "/placement_groups/{id}", map[string]string{"id": id}, nil, nil, nil)
+}
+
+// vpcInstancePlacementTarget is the placement target of an instance, a dedicated host, a dedicated
+// host group or a placement group. The Instance of vpcv1 does not provide it
+type vpcInstancePlacementTarget struct {
+	ID           string `json:"id"`
+	ResourceType string `json:"resource_type"`
+}
+
+// getVPCInstancePlacementTarget returns the placement target of the instance, nil when the instance
+// has none
+func getVPCInstancePlacementTarget(vpc *vpcv1.VpcV1, instanceID string) (*vpcInstancePlacementTarget, *core.DetailedResponse, error) {
+	instance := struct {
+		PlacementTarget *vpcInstancePlacementTarget `json:"placement_target"`
+	}{}
+	response, err := vpcRequest(vpc, core.GET, "/instances/{id}", map[string]string{"id": instanceID}, nil, nil, &instance)
+	if err != nil {
+		return nil, response, err
+	}
+	return instance.PlacementTarget, response, nil
+}
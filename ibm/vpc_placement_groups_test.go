@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"gotest.tools/assert"
+	is "gotest.tools/assert/cmp"
+)
+
+// fakePlacementGroups is an in-memory placement groups API of the VPC infrastructure, the list is
+// returned one placement group per page
+type fakePlacementGroups struct {
+	placementGroups map[string]*vpcPlacementGroup
+	nextID          int
+	// instances holds the placement targets of the instances by instance ID
+	instances map[string]*vpcInstancePlacementTarget
+}
+
+func (f *fakePlacementGroups) ServeHTTP(w gohttp.ResponseWriter, r *gohttp.Request) {
+	if r.URL.Query().Get("version") == "" || r.URL.Query().Get("generation") != "2" {
+		gohttp.Error(w, `{"errors": [{"message": "missing version or generation"}]}`, gohttp.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	id := strings.TrimPrefix(r.URL.Path, "/placement_groups/")
+	placementGroup := f.placementGroups[id]
+
+	switch {
+	case r.Method == gohttp.MethodGet && strings.HasPrefix(r.URL.Path, "/instances/"):
+		placementTarget, ok := f.instances[strings.TrimPrefix(r.URL.Path, "/instances/")]
+		if !ok {
+			gohttp.Error(w, `{"errors": [{"message": "instance not found"}]}`, gohttp.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": "instance", "placement_target": placementTarget})
+	case r.Method == gohttp.MethodGet && r.URL.Path == "/placement_groups":
+		ids := []string{}
+		for id := range f.placementGroups {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		start := 0
+		if r.URL.Query().Get("start") != "" {
+			start = sort.SearchStrings(ids, r.URL.Query().Get("start"))
+		}
+		collection := map[string]interface{}{"placement_groups": []*vpcPlacementGroup{}}
+		if start < len(ids) {
+			collection["placement_groups"] = []*vpcPlacementGroup{f.placementGroups[ids[start]]}
+		}
+		if start+1 < len(ids) {
+			collection["next"] = map[string]string{"href": "https://vpc/placement_groups?start=" + ids[start+1]}
+		}
+		json.NewEncoder(w).Encode(collection)
+	case r.Method == gohttp.MethodPost && r.URL.Path == "/placement_groups":
+		prototype := vpcPlacementGroupPrototype{}
+		json.NewDecoder(r.Body).Decode(&prototype)
+		f.nextID++
+		placementGroup := &vpcPlacementGroup{
+			ID:             fmt.Sprintf("r006-pg%d", f.nextID),
+			Name:           prototype.Name,
+			Strategy:       prototype.Strategy,
+			LifecycleState: isPlacementGroupStable,
+			ResourceType:   "placement_group",
+		}
+		if prototype.ResourceGroup != nil {
+			placementGroup.ResourceGroup = &vpcv1.ResourceGroupReference{ID: prototype.ResourceGroup.ID}
+		}
+		f.placementGroups[placementGroup.ID] = placementGroup
+		w.WriteHeader(gohttp.StatusCreated)
+		json.NewEncoder(w).Encode(placementGroup)
+	case placementGroup == nil:
+		gohttp.Error(w, `{"errors": [{"message": "placement group not found"}]}`, gohttp.StatusNotFound)
+	case r.Method == gohttp.MethodGet:
+		json.NewEncoder(w).Encode(placementGroup)
+	case r.Method == gohttp.MethodPatch:
+		if r.Header.Get("Content-Type") != "application/merge-patch+json" {
+			gohttp.Error(w, `{"errors": [{"message": "unsupported content type"}]}`, gohttp.StatusUnsupportedMediaType)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(placementGroup)
+		json.NewEncoder(w).Encode(placementGroup)
+	case r.Method == gohttp.MethodDelete:
+		delete(f.placementGroups, id)
+		w.WriteHeader(gohttp.StatusAccepted)
+	default:
+		gohttp.NotFound(w, r)
+	}
+}
+
+func fakePlacementGroupsClientSession(t *testing.T) (ClientSession, *fakePlacementGroups) {
+	fake := &fakePlacementGroups{placementGroups: map[string]*vpcPlacementGroup{}, instances: map[string]*vpcInstancePlacementTarget{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	client, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess := &clientSession{vpcAPI: client}
+	sess.vpcOnce.Do(func() {})
+	return sess, fake
+}
+
+func TestVPCPlacementGroupsAPI(t *testing.T) {
+	meta, fake := fakePlacementGroupsClientSession(t)
+	client, err := placementGroupsClient(meta)
+	assert.NilError(t, err)
+
+	rg := "rg1"
+	created, _, err := client.CreatePlacementGroup(&vpcPlacementGroupPrototype{
+		Name:          "pg-spread",
+		Strategy:      vpcPlacementGroupStrategyHostSpread,
+		ResourceGroup: &vpcv1.ResourceGroupIdentity{ID: &rg},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "r006-pg1", created.ID)
+	assert.Equal(t, "rg1", *fake.placementGroups[created.ID].ResourceGroup.ID)
+	_, _, err = client.CreatePlacementGroup(&vpcPlacementGroupPrototype{Strategy: vpcPlacementGroupStrategyPowerSpread})
+	assert.NilError(t, err)
+
+	// The placement groups of all the pages are listed
+	placementGroups, err := client.ListPlacementGroups()
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(placementGroups, 2))
+
+	updated, _, err := client.UpdatePlacementGroup(created.ID, "pg-renamed")
+	assert.NilError(t, err)
+	assert.Equal(t, "pg-renamed", updated.Name)
+	assert.Equal(t, vpcPlacementGroupStrategyHostSpread, updated.Strategy)
+
+	_, err = client.DeletePlacementGroup(created.ID)
+	assert.NilError(t, err)
+	_, response, err := client.GetPlacementGroup(created.ID)
+	assert.Assert(t, err != nil)
+	assert.Assert(t, response != nil)
+	assert.Equal(t, 404, response.StatusCode)
+}
+
+func TestResourceIBMISPlacementGroupRead(t *testing.T) {
+	meta, _ := fakePlacementGroupsClientSession(t)
+	client, _ := placementGroupsClient(meta)
+	created, _, err := client.CreatePlacementGroup(&vpcPlacementGroupPrototype{Name: "pg-spread", Strategy: vpcPlacementGroupStrategyPowerSpread})
+	assert.NilError(t, err)
+
+	r := resourceIBMISPlacementGroup()
+	d := r.Data(nil)
+	d.SetId(created.ID)
+	diags := r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.Equal(t, "pg-spread", d.Get(isPlacementGroupName))
+	assert.Equal(t, vpcPlacementGroupStrategyPowerSpread, d.Get(isPlacementGroupStrategy))
+	assert.Equal(t, isPlacementGroupStable, d.Get(isPlacementGroupLifecycleState))
+
+	// A deleted placement group is removed from the state
+	_, err = client.DeletePlacementGroup(created.ID)
+	assert.NilError(t, err)
+	diags = r.ReadContext(context.Background(), d, meta)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	assert.Equal(t, "", d.Id())
+}
+
+func TestVPCInstancePlacementTarget(t *testing.T) {
+	meta, fake := fakePlacementGroupsClientSession(t)
+	fake.instances["instance1"] = &vpcInstancePlacementTarget{ID: "r006-pg1", ResourceType: "placement_group"}
+	fake.instances["instance2"] = nil
+	client, _ := meta.VpcV1API()
+
+	placementTarget, _, err := getVPCInstancePlacementTarget(client, "instance1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []interface{}{
+		map[string]interface{}{isInstancePlacementTargetPlacementGroup: "r006-pg1"},
+	}, flattenInstancePlacementTarget(placementTarget))
+
+	placementTarget, _, err = getVPCInstancePlacementTarget(client, "instance2")
+	assert.NilError(t, err)
+	assert.Assert(t, is.Nil(placementTarget))
+	assert.DeepEqual(t, []interface{}{}, flattenInstancePlacementTarget(placementTarget))
+
+	assert.DeepEqual(t, []interface{}{
+		map[string]interface{}{isInstancePlacementTargetDedicatedHostGroup: "dhg1"},
+	}, flattenInstancePlacementTarget(&vpcInstancePlacementTarget{ID: "dhg1", ResourceType: "dedicated_host_group"}))
+
+	_, response, err := getVPCInstancePlacementTarget(client, "unknown")
+	assert.Assert(t, err != nil)
+	assert.Assert(t, response != nil)
+	assert.Equal(t, 404, response.StatusCode)
+}
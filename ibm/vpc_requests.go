@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// vpcPageLink is the link to the next page of a collection of the VPC API
+type vpcPageLink struct {
+	Href *string `json:"href"`
+}
+
+// vpcRequest sends a request of the VPC API that the vpcv1 client of vpc-go-sdk does not provide,
+// with the endpoint, the authenticator and the version of vpc. The response body is decoded in
+// result when it is not nil.
+//
+// The releases of vpc-go-sdk providing the placement groups, the placement targets of the instances
+// and the bare metal servers, v0.8.0 and later, no longer ship the vpcclassicv1 package of the
+// Generation 1 resources, so these requests are kept until the Generation 1 support is removed.
+func vpcRequest(vpc *vpcv1.VpcV1, method string, path string, pathParams map[string]string, body interface{}, query map[string]string, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	_, err := builder.ResolveRequestURL(vpc.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", *vpc.Version)
+	builder.AddQuery("generation", "2")
+	for name, value := range query {
+		builder.AddQuery(name, value)
+	}
+	if body != nil {
+		contentType := "application/json"
+		if method == core.PATCH {
+			contentType = "application/merge-patch+json"
+		}
+		builder.AddHeader("Content-Type", contentType)
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return vpc.Service.Request(request, result)
+}
@@ -13,4 +13,7 @@ func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: ibm.Provider,
 	})
+	if err := ibm.CloseEventStreamsAdminClients(); err != nil {
+		log.Println(err)
+	}
 }